@@ -0,0 +1,35 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/config"
+	"miniflux.app/http/response/json"
+	"miniflux.app/version"
+)
+
+func (h *handler) about(w http.ResponseWriter, r *http.Request) {
+	json.OK(w, r, &aboutResponse{
+		Info: version.Current(),
+		Limits: aboutLimits{
+			PollingFrequency:      config.Opts.PollingFrequency(),
+			BatchSize:             config.Opts.BatchSize(),
+			HTTPClientTimeout:     config.Opts.HTTPClientTimeout(),
+			HTTPClientMaxBodySize: config.Opts.HTTPClientMaxBodySize(),
+			ArchiveReadDays:       config.Opts.CleanupArchiveReadDays(),
+			ArchiveUnreadDays:     config.Opts.CleanupArchiveUnreadDays(),
+		},
+		Features: aboutFeatures{
+			PollingScheduler:    config.Opts.PollingScheduler(),
+			ProxyImages:         config.Opts.ProxyImages(),
+			OAuth2Provider:      config.Opts.OAuth2Provider(),
+			FetchViaProxy:       config.Opts.HasHTTPClientProxyConfigured(),
+			YouTubeAPI:          config.Opts.YouTubeAPIKey() != "",
+			FeedRecommendations: config.Opts.HasFeedRecommendations(),
+		},
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/integration"
+	"miniflux.app/logger"
+)
+
+func (h *handler) getEntryAnnotations(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	entry, err := h.store.NewEntryQueryBuilder(userID).WithEntryID(entryID).GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	annotations, err := h.store.EntryAnnotations(userID, entry.ID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, annotations)
+}
+
+func (h *handler) createAnnotation(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	entry, err := h.store.NewEntryQueryBuilder(userID).WithEntryID(entryID).GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	annotation, err := decodeAnnotationPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := annotation.Validate(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	annotation.UserID = userID
+	annotation.EntryID = entry.ID
+	annotation.EntryURL = entry.URL
+	annotation.EntryTitle = entry.Title
+
+	if err := h.store.CreateAnnotation(annotation); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if settings, err := h.store.Integration(userID); err == nil && settings.HypothesisEnabled {
+		go func() {
+			if hypothesisID := integration.SendAnnotation(annotation, settings); hypothesisID != "" {
+				if err := h.store.UpdateAnnotationHypothesisID(annotation.ID, hypothesisID); err != nil {
+					logger.Error("[API][Annotation] %v", err)
+				}
+			}
+		}()
+	}
+
+	json.Created(w, r, annotation)
+}
+
+func (h *handler) removeAnnotation(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	annotationID := request.RouteInt64Param(r, "annotationID")
+
+	annotation, err := h.store.AnnotationByID(userID, annotationID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if annotation == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveAnnotation(userID, annotation.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if settings, err := h.store.Integration(userID); err == nil {
+		go integration.RemoveAnnotation(annotation, settings)
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) exportAnnotations(w http.ResponseWriter, r *http.Request) {
+	annotations, err := h.store.Annotations(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, annotations.WebAnnotationCollection())
+}
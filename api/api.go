@@ -16,7 +16,7 @@ import (
 
 // Serve declares API routes for the application.
 func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHandler *feed.Handler) {
-	handler := &handler{store, pool, feedHandler}
+	handler := &handler{store, pool, feedHandler, newRefreshJobs()}
 
 	sr := router.PathPrefix("/v1").Subrouter()
 	middleware := newMiddleware(store)
@@ -30,26 +30,68 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	sr.HandleFunc("/users/{userID:[0-9]+}", handler.updateUser).Methods(http.MethodPut)
 	sr.HandleFunc("/users/{userID:[0-9]+}", handler.removeUser).Methods(http.MethodDelete)
 	sr.HandleFunc("/users/{username}", handler.userByUsername).Methods(http.MethodGet)
+	sr.HandleFunc("/reports", handler.getDatabaseReport).Methods(http.MethodGet)
 	sr.HandleFunc("/me", handler.currentUser).Methods(http.MethodGet)
+	sr.HandleFunc("/me/digest", handler.getDigest).Methods(http.MethodGet)
+	sr.HandleFunc("/me/preferences", handler.getAllPreferences).Methods(http.MethodGet)
+	sr.HandleFunc("/me/preferences/{namespace}", handler.getPreferences).Methods(http.MethodGet)
+	sr.HandleFunc("/me/preferences/{namespace}", handler.updatePreferences).Methods(http.MethodPut)
+	sr.HandleFunc("/me/preferences/{namespace}", handler.removePreferences).Methods(http.MethodDelete)
+	sr.HandleFunc("/about", handler.about).Methods(http.MethodGet)
+	sr.HandleFunc("/domain_rules", handler.createDomainRule).Methods(http.MethodPost)
+	sr.HandleFunc("/domain_rules", handler.getDomainRules).Methods(http.MethodGet)
+	sr.HandleFunc("/domain_rules/builtin", handler.getBuiltinDomainRules).Methods(http.MethodGet)
+	sr.HandleFunc("/domain_rules/{ruleID:[0-9]+}", handler.updateDomainRule).Methods(http.MethodPut)
+	sr.HandleFunc("/domain_rules/{ruleID:[0-9]+}", handler.removeDomainRule).Methods(http.MethodDelete)
+	sr.HandleFunc("/integrations", handler.getIntegration).Methods(http.MethodGet)
+	sr.HandleFunc("/integrations", handler.updateIntegration).Methods(http.MethodPut)
+	sr.HandleFunc("/integrations", handler.resetIntegration).Methods(http.MethodDelete)
 	sr.HandleFunc("/categories", handler.createCategory).Methods(http.MethodPost)
 	sr.HandleFunc("/categories", handler.getCategories).Methods(http.MethodGet)
 	sr.HandleFunc("/categories/{categoryID}", handler.updateCategory).Methods(http.MethodPut)
 	sr.HandleFunc("/categories/{categoryID}", handler.removeCategory).Methods(http.MethodDelete)
+	sr.HandleFunc("/categories/{categoryID}/refresh", handler.refreshCategory).Methods(http.MethodPut)
 	sr.HandleFunc("/discover", handler.getSubscriptions).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds", handler.createFeed).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds", handler.getFeeds).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/refresh", handler.refreshAllFeeds).Methods(http.MethodPut)
+	sr.HandleFunc("/feeds/counters", handler.fetchCounters).Methods(http.MethodGet)
+	sr.HandleFunc("/feeds/move", handler.moveFeeds).Methods(http.MethodPut)
 	sr.HandleFunc("/feeds/{feedID}/refresh", handler.refreshFeed).Methods(http.MethodPut)
 	sr.HandleFunc("/feeds/{feedID}", handler.getFeed).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/{feedID}", handler.updateFeed).Methods(http.MethodPut)
 	sr.HandleFunc("/feeds/{feedID}", handler.removeFeed).Methods(http.MethodDelete)
 	sr.HandleFunc("/feeds/{feedID}/icon", handler.feedIcon).Methods(http.MethodGet)
+	sr.HandleFunc("/feeds/{feedID}/icon", handler.updateFeedIcon).Methods(http.MethodPut)
+	sr.HandleFunc("/feeds/{feedID}/icon", handler.removeFeedIcon).Methods(http.MethodDelete)
+	sr.HandleFunc("/icons", handler.feedIcons).Methods(http.MethodGet)
+	sr.HandleFunc("/jobs/{jobID}", handler.getRefreshJob).Methods(http.MethodGet)
 	sr.HandleFunc("/export", handler.exportFeeds).Methods(http.MethodGet)
 	sr.HandleFunc("/import", handler.importFeeds).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds/{feedID}/entries", handler.getFeedEntries).Methods(http.MethodGet)
+	sr.HandleFunc("/feeds/{feedID}/stats", handler.getFeedEntryCounts).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/{feedID}/entries/{entryID}", handler.getFeedEntry).Methods(http.MethodGet)
 	sr.HandleFunc("/entries", handler.getEntries).Methods(http.MethodGet)
 	sr.HandleFunc("/entries", handler.setEntryStatus).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/tombstones", handler.getEntryTombstones).Methods(http.MethodGet)
+	sr.HandleFunc("/entries/batch", handler.getEntriesByIDs).Methods(http.MethodGet, http.MethodPost)
 	sr.HandleFunc("/entries/{entryID}", handler.getEntry).Methods(http.MethodGet)
 	sr.HandleFunc("/entries/{entryID}/bookmark", handler.toggleBookmark).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/{entryID}/position", handler.updateReadingPosition).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/{entryID}/annotations", handler.getEntryAnnotations).Methods(http.MethodGet)
+	sr.HandleFunc("/entries/{entryID}/annotations", handler.createAnnotation).Methods(http.MethodPost)
+	sr.HandleFunc("/annotations/export", handler.exportAnnotations).Methods(http.MethodGet)
+	sr.HandleFunc("/annotations/{annotationID:[0-9]+}", handler.removeAnnotation).Methods(http.MethodDelete)
+	sr.HandleFunc("/queue", handler.getPlaybackQueue).Methods(http.MethodGet)
+	sr.HandleFunc("/queue", handler.reorderPlaybackQueue).Methods(http.MethodPut)
+	sr.HandleFunc("/queue/{entryID}", handler.addToPlaybackQueue).Methods(http.MethodPut)
+	sr.HandleFunc("/queue/{entryID}", handler.removeFromPlaybackQueue).Methods(http.MethodDelete)
+	sr.HandleFunc("/saved-searches", handler.getSavedSearches).Methods(http.MethodGet)
+	sr.HandleFunc("/saved-searches", handler.createSavedSearch).Methods(http.MethodPost)
+	sr.HandleFunc("/saved-searches/{savedSearchID}", handler.removeSavedSearch).Methods(http.MethodDelete)
+	sr.HandleFunc("/trending", handler.getTrendingTopics).Methods(http.MethodGet)
+	sr.HandleFunc("/triggers/starred_entries", handler.starredEntriesTrigger).Methods(http.MethodGet)
+	sr.HandleFunc("/triggers/search_entries", handler.searchEntriesTrigger).Methods(http.MethodGet)
+	sr.HandleFunc("/ifttt/triggers/new_starred_entry", handler.iftttStarredEntriesTrigger).Methods(http.MethodPost)
+	sr.HandleFunc("/ifttt/triggers/new_search_entry", handler.iftttSearchEntriesTrigger).Methods(http.MethodPost)
 }
@@ -40,16 +40,27 @@ func (h *handler) createCategory(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
 	categoryID := request.RouteInt64Param(r, "categoryID")
 
-	category, err := decodeCategoryPayload(r.Body)
+	changes, err := decodeCategoryModificationPayload(r.Body)
 	if err != nil {
 		json.BadRequest(w, r, err)
 		return
 	}
 
-	category.UserID = request.UserID(r)
-	category.ID = categoryID
+	category, err := h.store.Category(userID, categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	changes.Update(category)
 	if err := category.ValidateCategoryModification(); err != nil {
 		json.BadRequest(w, r, err)
 		return
@@ -71,9 +82,45 @@ func (h *handler) getCategories(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	switch request.QueryStringParam(r, "hidden", "") {
+	case "true":
+		categories = categories.Hidden()
+	case "false":
+		categories = categories.Visible()
+	}
+
 	json.OK(w, r, categories)
 }
 
+func (h *handler) refreshCategory(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+
+	if !h.store.CategoryExists(userID, categoryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	jobs, err := h.store.NewCategoryBatch(userID, categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	feedIDs := make([]int64, 0, len(jobs))
+	for _, job := range jobs {
+		feedIDs = append(feedIDs, job.FeedID)
+	}
+
+	job, err := h.startRefreshJob(userID, feedIDs)
+	if err != nil {
+		json.TooManyRequests(w, r, err)
+		return
+	}
+
+	json.Accepted(w, r, job)
+}
+
 func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	categoryID := request.RouteInt64Param(r, "categoryID")
@@ -83,6 +130,21 @@ func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if targetCategoryID := request.QueryInt64Param(r, "move_to", 0); targetCategoryID > 0 {
+		if targetCategoryID == categoryID || !h.store.CategoryExists(userID, targetCategoryID) {
+			json.BadRequest(w, r, errors.New("Unable to find the category that should receive the feeds"))
+			return
+		}
+
+		if err := h.store.RemoveCategoryAndMoveFeeds(userID, categoryID, targetCategoryID); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		json.NoContent(w, r)
+		return
+	}
+
 	if err := h.store.RemoveCategory(userID, categoryID); err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -0,0 +1,48 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+const maxDigestLimit = 100
+
+func (h *handler) getDigest(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	// The previous visit in the web interface is used unless the client remembers its own.
+	since := user.DigestSince(time.Now())
+	if timestamp := request.QueryInt64Param(r, "since", 0); timestamp > 0 {
+		since = time.Unix(timestamp, 0)
+	}
+
+	limit := request.QueryIntParam(r, "limit", model.DigestEntriesLimit)
+	if limit <= 0 || limit > maxDigestLimit {
+		limit = maxDigestLimit
+	}
+
+	digest, err := h.store.Digest(user.ID, since, limit)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, digest)
+}
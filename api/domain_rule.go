@@ -0,0 +1,142 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/reader/rewrite"
+	"miniflux.app/reader/scraper"
+)
+
+func (h *handler) getBuiltinDomainRules(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	json.OK(w, r, &builtinDomainRules{
+		RewriteRules: rewrite.PredefinedRules(),
+		ScraperRules: scraper.PredefinedRules(),
+	})
+}
+
+func (h *handler) getDomainRules(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	rules, err := h.store.DomainRules()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, rules)
+}
+
+func (h *handler) createDomainRule(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	rule, err := decodeDomainRulePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := rule.ValidateDomainRule(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if h.store.DomainRuleExists(rule.Domain) {
+		json.BadRequest(w, r, errors.New("A rule already exists for this domain"))
+		return
+	}
+
+	if err := h.store.CreateDomainRule(rule); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, rule)
+}
+
+func (h *handler) updateDomainRule(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	ruleID := request.RouteInt64Param(r, "ruleID")
+	originalRule, err := h.store.DomainRuleByID(ruleID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if originalRule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	rule, err := decodeDomainRulePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	rule.ID = originalRule.ID
+	rule.CreatedAt = originalRule.CreatedAt
+	if err := rule.ValidateDomainRule(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if h.store.AnotherDomainRuleExists(rule.ID, rule.Domain) {
+		json.BadRequest(w, r, errors.New("A rule already exists for this domain"))
+		return
+	}
+
+	if err := h.store.UpdateDomainRule(rule); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, rule)
+}
+
+func (h *handler) removeDomainRule(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	ruleID := request.RouteInt64Param(r, "ruleID")
+	rule, err := h.store.DomainRuleByID(ruleID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if rule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveDomainRule(rule.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
@@ -6,15 +6,20 @@ package api // import "miniflux.app/api"
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/integration"
 	"miniflux.app/model"
 	"miniflux.app/storage"
 )
 
+// maxBatchEntries is the maximum number of entries returned by a batch request.
+const maxBatchEntries = 100
+
 func (h *handler) getFeedEntry(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	entryID := request.RouteInt64Param(r, "entryID")
@@ -93,6 +98,20 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 		return
 	}
 
+	fields, err := parseEntryFields(r)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	enclosureType := request.QueryStringParam(r, "has_enclosure", "")
+	if enclosureType != "" {
+		if err := model.ValidateEnclosureType(enclosureType); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
 	userID := request.UserID(r)
 	categoryID := request.QueryInt64Param(r, "category_id", 0)
 	if categoryID > 0 && !h.store.CategoryExists(userID, categoryID) {
@@ -114,8 +133,13 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 	builder.WithDirection(direction)
 	builder.WithOffset(offset)
 	builder.WithLimit(limit)
+	builder.WithEnclosureType(enclosureType)
 	configureFilters(builder, r)
 
+	if len(fields) > 0 && !hasField(fields, "content") {
+		builder.WithoutContent()
+	}
+
 	entries, err := builder.GetEntries()
 	if err != nil {
 		json.ServerError(w, r, err)
@@ -128,7 +152,77 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 		return
 	}
 
-	json.OK(w, r, &entriesResponse{Total: count, Entries: entries})
+	writeEntries(w, r, count, entries, fields)
+}
+
+func (h *handler) getEntriesByIDs(w http.ResponseWriter, r *http.Request) {
+	entryIDs := request.QueryInt64ParamList(r, "entry_id")
+	if r.Method == http.MethodPost {
+		var err error
+		if entryIDs, err = request.DecodeEntryIDs(r.Body); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	fields, err := parseEntryFields(r)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if len(entryIDs) == 0 {
+		json.BadRequest(w, r, errors.New("At least one entry ID is required"))
+		return
+	}
+
+	if len(entryIDs) > maxBatchEntries {
+		json.BadRequest(w, r, fmt.Errorf("No more than %d entries can be requested at once", maxBatchEntries))
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithEntryIDs(entryIDs)
+	builder.WithOrder(model.DefaultSortingOrder)
+	builder.WithDirection(model.DefaultSortingDirection)
+
+	if len(fields) > 0 && !hasField(fields, "content") {
+		builder.WithoutContent()
+	}
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	writeEntries(w, r, len(entries), entries, fields)
+}
+
+func writeEntries(w http.ResponseWriter, r *http.Request, total int, entries model.Entries, fields []string) {
+	if len(fields) == 0 {
+		json.OK(w, r, &entriesResponse{Total: total, Entries: entries})
+		return
+	}
+
+	partialEntries, err := selectEntryFields(entries, fields)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &partialEntriesResponse{Total: total, Entries: partialEntries})
+}
+
+func (h *handler) getEntryTombstones(w http.ResponseWriter, r *http.Request) {
+	changedAfter := request.QueryInt64Param(r, "changed_after", 0)
+	entryIDs, err := h.store.EntryTombstones(request.UserID(r), time.Unix(changedAfter, 0))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &entryTombstonesResponse{EntryIDs: entryIDs})
 }
 
 func (h *handler) setEntryStatus(w http.ResponseWriter, r *http.Request) {
@@ -152,8 +246,39 @@ func (h *handler) setEntryStatus(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
 	entryID := request.RouteInt64Param(r, "entryID")
-	if err := h.store.ToggleBookmark(request.UserID(r), entryID); err != nil {
+	if err := h.store.ToggleBookmark(userID, entryID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry, err := h.store.NewEntryQueryBuilder(userID).WithEntryID(entryID).GetEntry(); err == nil && entry != nil && entry.Starred {
+		if settings, err := h.store.Integration(userID); err == nil {
+			go func() {
+				integration.SendStarredEntry(entry, settings)
+				integration.ArchiveEntries(h.store, model.Entries{entry}, settings)
+			}()
+		}
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) updateReadingPosition(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	position, err := decodeReadingPositionPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateReadingPosition(position); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.UpdateEntryReadingPosition(request.UserID(r), entryID, position); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
@@ -182,6 +307,11 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 		builder.AfterDate(time.Unix(afterTimestamp, 0))
 	}
 
+	changedAfterTimestamp := request.QueryInt64Param(r, "changed_after", 0)
+	if changedAfterTimestamp > 0 {
+		builder.AfterChangedDate(time.Unix(changedAfterTimestamp, 0))
+	}
+
 	categoryID := request.QueryInt64Param(r, "category_id", 0)
 	if categoryID > 0 {
 		builder.WithCategoryID(categoryID)
@@ -191,6 +321,10 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 		builder.WithStarred()
 	}
 
+	if request.HasQueryParam(r, "globally_visible") {
+		builder.WithGloballyVisible()
+	}
+
 	searchQuery := request.QueryStringParam(r, "search", "")
 	if searchQuery != "" {
 		builder.WithSearchQuery(searchQuery)
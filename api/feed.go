@@ -10,6 +10,9 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+
+	"golang.org/x/net/html/charset"
 )
 
 func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
@@ -52,6 +55,7 @@ func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
 		feedInfo.ScraperRules,
 		feedInfo.RewriteRules,
 		feedInfo.FetchViaProxy,
+		&feedInfo.FeedAuthentication,
 	)
 	if err != nil {
 		json.ServerError(w, r, err)
@@ -74,13 +78,13 @@ func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.feedHandler.RefreshFeed(userID, feedID)
+	job, err := h.startRefreshJob(userID, []int64{feedID})
 	if err != nil {
-		json.ServerError(w, r, err)
+		json.TooManyRequests(w, r, err)
 		return
 	}
 
-	json.NoContent(w, r)
+	json.Accepted(w, r, job)
 }
 
 func (h *handler) refreshAllFeeds(w http.ResponseWriter, r *http.Request) {
@@ -126,6 +130,25 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if originalFeed.Encoding != "" {
+		if enc, _ := charset.Lookup(originalFeed.Encoding); enc == nil {
+			json.BadRequest(w, r, errors.New("This encoding is not supported"))
+			return
+		}
+	}
+
+	if err := model.ValidateRequestLimits(originalFeed.RequestTimeout, originalFeed.MaxBodySize); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if originalFeed.CronExpression != "" {
+		if err := originalFeed.ScheduleNextCronCheck(h.store.UserTimezone(userID)); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
 	if err := h.store.UpdateFeed(originalFeed); err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -147,9 +170,23 @@ func (h *handler) getFeeds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if request.QueryStringParam(r, "dormant", "") == "true" {
+		feeds = feeds.Dormant()
+	}
+
 	json.OK(w, r, feeds)
 }
 
+func (h *handler) fetchCounters(w http.ResponseWriter, r *http.Request) {
+	counters, err := h.store.FetchCounters(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, counters)
+}
+
 func (h *handler) getFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	feed, err := h.store.FeedByID(request.UserID(r), feedID)
@@ -166,6 +203,28 @@ func (h *handler) getFeed(w http.ResponseWriter, r *http.Request) {
 	json.OK(w, r, feed)
 }
 
+func (h *handler) moveFeeds(w http.ResponseWriter, r *http.Request) {
+	feedIDs, categoryID, err := decodeMoveFeedsPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	if !h.store.CategoryExists(userID, categoryID) {
+		json.BadRequest(w, r, errors.New("This category does not exist or does not belong to this user"))
+		return
+	}
+
+	moved, err := h.store.MoveFeeds(userID, feedIDs, categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, map[string]int64{"moved": moved})
+}
+
 func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	userID := request.UserID(r)
@@ -182,3 +241,27 @@ func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 
 	json.NoContent(w, r)
 }
+
+func (h *handler) getFeedEntryCounts(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID := request.UserID(r)
+
+	days := request.QueryIntParam(r, "days", 90)
+	if days < 1 || days > 365 {
+		json.BadRequest(w, r, errors.New("The number of days must be between 1 and 365"))
+		return
+	}
+
+	if !h.store.FeedExists(userID, feedID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	entryCounts, err := h.store.FeedEntryCounts(userID, feedID, days)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, entryCounts)
+}
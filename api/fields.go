@@ -0,0 +1,94 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/model"
+)
+
+// entryFields contains the JSON keys of entries that can be selected with the "fields" parameter.
+var entryFields = jsonFieldNames(model.Entry{})
+
+func jsonFieldNames(value interface{}) map[string]bool {
+	names := make(map[string]bool)
+	t := reflect.TypeOf(value)
+
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+
+	return names
+}
+
+// parseEntryFields returns the fields given as "fields=id,title" or "fields=id&fields=title".
+// An empty list means that all fields are returned.
+func parseEntryFields(r *http.Request) ([]string, error) {
+	var fields []string
+
+	for _, value := range request.QueryStringParamList(r, "fields") {
+		for _, field := range strings.Split(value, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+
+			if !entryFields[field] {
+				return nil, fmt.Errorf("Invalid field: %s", field)
+			}
+
+			fields = append(fields, field)
+		}
+	}
+
+	return fields, nil
+}
+
+func hasField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selectEntryFields removes the fields that are not requested from the JSON representation of the entries.
+func selectEntryFields(entries model.Entries, fields []string) ([]map[string]json.RawMessage, error) {
+	results := make([]map[string]json.RawMessage, 0, len(entries))
+
+	for _, entry := range entries {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		var all map[string]json.RawMessage
+		if err := json.Unmarshal(data, &all); err != nil {
+			return nil, err
+		}
+
+		partial := make(map[string]json.RawMessage, len(fields))
+		for _, field := range fields {
+			if value, found := all[field]; found {
+				partial[field] = value
+			}
+		}
+
+		results = append(results, partial)
+	}
+
+	return results, nil
+}
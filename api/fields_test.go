@@ -0,0 +1,66 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestParseEntryFields(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/v1/entries?fields=id,%20title&fields=status", nil)
+	fields, err := parseEntryFields(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fields) != 3 || fields[0] != "id" || fields[1] != "title" || fields[2] != "status" {
+		t.Errorf(`Unexpected fields: %v`, fields)
+	}
+}
+
+func TestParseInvalidEntryFields(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/v1/entries?fields=id,password", nil)
+	if _, err := parseEntryFields(r); err == nil {
+		t.Error(`An unknown field should return an error`)
+	}
+}
+
+func TestParseEntryFieldsWithoutParameter(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/v1/entries", nil)
+	fields, err := parseEntryFields(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fields) != 0 {
+		t.Errorf(`No field should be selected, got %v`, fields)
+	}
+}
+
+func TestSelectEntryFields(t *testing.T) {
+	entries := model.Entries{
+		&model.Entry{ID: 1, Title: "Title", Content: "Some content", Feed: &model.Feed{ID: 2}},
+	}
+
+	results, err := selectEntryFields(entries, []string{"id", "title", "feed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 || len(results[0]) != 3 {
+		t.Fatalf(`Unexpected results: %v`, results)
+	}
+
+	if string(results[0]["title"]) != `"Title"` {
+		t.Errorf(`Unexpected title, got %s`, results[0]["title"])
+	}
+
+	if _, found := results[0]["content"]; found {
+		t.Error(`The content should not be returned`)
+	}
+}
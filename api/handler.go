@@ -14,4 +14,5 @@ type handler struct {
 	store       *storage.Storage
 	pool        *worker.Pool
 	feedHandler *feed.Handler
+	refreshJobs *refreshJobs
 }
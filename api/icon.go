@@ -5,12 +5,21 @@
 package api // import "miniflux.app/api"
 
 import (
+	"bytes"
+	"errors"
+	"io/ioutil"
 	"net/http"
+	"strings"
 
+	"miniflux.app/crypto"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
 )
 
+// Uploaded icons larger than this size are rejected.
+const maxIconSize = 512 * 1024
+
 func (h *handler) feedIcon(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 
@@ -32,7 +41,103 @@ func (h *handler) feedIcon(w http.ResponseWriter, r *http.Request) {
 
 	json.OK(w, r, &feedIcon{
 		ID:       icon.ID,
+		FeedID:   feedID,
 		MimeType: icon.MimeType,
 		Data:     icon.DataURL(),
 	})
 }
+
+func (h *handler) feedIcons(w http.ResponseWriter, r *http.Request) {
+	icons, err := h.store.IconsByFeedID(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	feedIcons := make([]*feedIcon, 0, len(icons))
+	for feedID, icon := range icons {
+		feedIcons = append(feedIcons, &feedIcon{
+			ID:       icon.ID,
+			FeedID:   feedID,
+			MimeType: icon.MimeType,
+			Data:     icon.DataURL(),
+		})
+	}
+
+	json.OK(w, r, feedIcons)
+}
+
+func (h *handler) updateFeedIcon(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	feedID := request.RouteInt64Param(r, "feedID")
+
+	if !h.store.FeedExists(userID, feedID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	defer r.Body.Close()
+	content, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxIconSize))
+	if err != nil {
+		json.BadRequest(w, r, errors.New("The icon is too large"))
+		return
+	}
+
+	mimeType, err := detectIconMimeType(content)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	icon := &model.Icon{
+		Hash:     crypto.HashFromBytes(content),
+		MimeType: mimeType,
+		Content:  content,
+	}
+
+	if err := h.store.UpdateFeedIcon(feedID, icon); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, &feedIcon{
+		ID:       icon.ID,
+		FeedID:   feedID,
+		MimeType: icon.MimeType,
+		Data:     icon.DataURL(),
+	})
+}
+
+func (h *handler) removeFeedIcon(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	feedID := request.RouteInt64Param(r, "feedID")
+
+	if !h.store.FeedExists(userID, feedID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveFeedIcon(feedID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+// detectIconMimeType sniffs the content of an uploaded icon, the Content-Type header is not reliable.
+func detectIconMimeType(content []byte) (string, error) {
+	if len(content) == 0 {
+		return "", errors.New("The icon is empty")
+	}
+
+	mimeType := http.DetectContentType(content)
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return mimeType, nil
+	case strings.HasPrefix(mimeType, "text/") && bytes.Contains(content, []byte("<svg")):
+		return "image/svg+xml", nil
+	default:
+		return "", errors.New("The icon must be an image")
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import "testing"
+
+func TestDetectIconMimeType(t *testing.T) {
+	scenarios := map[string]string{
+		"\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR":                                 "image/png",
+		"GIF89a\x01\x00\x01\x00":                                              "image/gif",
+		`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`: "image/svg+xml",
+	}
+
+	for content, expected := range scenarios {
+		mimeType, err := detectIconMimeType([]byte(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if mimeType != expected {
+			t.Errorf(`Unexpected mime type, got %q instead of %q`, mimeType, expected)
+		}
+	}
+
+	for _, content := range []string{"", "<html><body>Not an icon</body></html>"} {
+		if _, err := detectIconMimeType([]byte(content)); err == nil {
+			t.Errorf(`Content %q should be rejected`, content)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/integration/custombookmark"
+	"miniflux.app/integration/ntfy"
+	"miniflux.app/model"
+)
+
+func (h *handler) getIntegration(w http.ResponseWriter, r *http.Request) {
+	integration, err := h.store.Integration(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, integration)
+}
+
+func (h *handler) updateIntegration(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	integration, err := h.store.Integration(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if err := decodeIntegrationPayload(r.Body, integration); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if integration.FeverUsername != "" && h.store.HasDuplicateFeverUsername(userID, integration.FeverUsername) {
+		json.BadRequest(w, r, errors.New("This Fever username is already used"))
+		return
+	}
+
+	if integration.HypothesisEnabled && (integration.HypothesisToken == "" || integration.HypothesisGroup == "") {
+		json.BadRequest(w, r, errors.New("The Hypothesis API token and group ID are required"))
+		return
+	}
+
+	if integration.CustomBookmarkEnabled {
+		err := custombookmark.Validate(
+			integration.CustomBookmarkURL,
+			integration.CustomBookmarkMethod,
+			integration.CustomBookmarkHeaders,
+			integration.CustomBookmarkBody,
+		)
+
+		if err != nil {
+			json.BadRequest(w, r, fmt.Errorf("Invalid custom bookmark service settings: %v", err))
+			return
+		}
+	}
+
+	if integration.NtfyEnabled {
+		if err := ntfy.ValidateTopicURL(integration.NtfyTopicURL); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	integration.UpdateFeverToken()
+
+	if err := h.store.UpdateIntegration(integration); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, integration)
+}
+
+func (h *handler) resetIntegration(w http.ResponseWriter, r *http.Request) {
+	if err := h.store.UpdateIntegration(&model.Integration{UserID: request.UserID(r)}); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
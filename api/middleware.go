@@ -7,6 +7,7 @@ package api // import "miniflux.app/api"
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
@@ -25,7 +26,7 @@ func (m *middleware) handleCORS(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "X-Auth-Token")
+		w.Header().Set("Access-Control-Allow-Headers", "X-Auth-Token, Authorization")
 		if r.Method == http.MethodOptions {
 			w.WriteHeader(http.StatusOK)
 			return
@@ -39,6 +40,11 @@ func (m *middleware) apiKeyAuth(next http.Handler) http.Handler {
 		clientIP := request.ClientIP(r)
 		token := r.Header.Get("X-Auth-Token")
 
+		// Automation services like IFTTT only send the key as a bearer token.
+		if authorization := r.Header.Get("Authorization"); token == "" && strings.HasPrefix(authorization, "Bearer ") {
+			token = strings.TrimPrefix(authorization, "Bearer ")
+		}
+
 		if token == "" {
 			logger.Debug("[API][TokenAuth] [ClientIP=%s] No API Key provided, go to the next middleware", clientIP)
 			next.ServeHTTP(w, r)
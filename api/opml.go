@@ -7,9 +7,10 @@ package api // import "miniflux.app/api"
 import (
 	"net/http"
 
+	"miniflux.app/crypto"
 	"miniflux.app/http/request"
+	"miniflux.app/http/response"
 	"miniflux.app/http/response/json"
-	"miniflux.app/http/response/xml"
 	"miniflux.app/reader/opml"
 )
 
@@ -21,7 +22,11 @@ func (h *handler) exportFeeds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	xml.OK(w, r, opml)
+	response.New(w, r).WithPrivateCaching(crypto.Hash(opml), func(b *response.Builder) {
+		b.WithHeader("Content-Type", "text/xml; charset=utf-8")
+		b.WithBody(opml)
+		b.Write()
+	})
 }
 
 func (h *handler) importFeeds(w http.ResponseWriter, r *http.Request) {
@@ -6,14 +6,18 @@ package api // import "miniflux.app/api"
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"miniflux.app/model"
+	"miniflux.app/version"
 )
 
 type feedIcon struct {
 	ID       int64  `json:"id"`
+	FeedID   int64  `json:"feed_id"`
 	MimeType string `json:"mime_type"`
 	Data     string `json:"data"`
 }
@@ -23,6 +27,44 @@ type entriesResponse struct {
 	Entries model.Entries `json:"entries"`
 }
 
+type partialEntriesResponse struct {
+	Total   int                          `json:"total"`
+	Entries []map[string]json.RawMessage `json:"entries"`
+}
+
+type currentUserResponse struct {
+	*model.User
+	Features *model.Features `json:"features"`
+}
+
+type aboutResponse struct {
+	*version.Info
+	Limits   aboutLimits   `json:"limits"`
+	Features aboutFeatures `json:"features"`
+}
+
+type aboutLimits struct {
+	PollingFrequency      int   `json:"polling_frequency"`
+	BatchSize             int   `json:"batch_size"`
+	HTTPClientTimeout     int   `json:"http_client_timeout"`
+	HTTPClientMaxBodySize int64 `json:"http_client_max_body_size"`
+	ArchiveReadDays       int   `json:"archive_read_days"`
+	ArchiveUnreadDays     int   `json:"archive_unread_days"`
+}
+
+type aboutFeatures struct {
+	PollingScheduler    string `json:"polling_scheduler"`
+	ProxyImages         string `json:"proxy_images"`
+	OAuth2Provider      string `json:"oauth2_provider"`
+	FetchViaProxy       bool   `json:"fetch_via_proxy"`
+	YouTubeAPI          bool   `json:"youtube_api"`
+	FeedRecommendations bool   `json:"feed_recommendations"`
+}
+
+type entryTombstonesResponse struct {
+	EntryIDs []int64 `json:"entry_ids"`
+}
+
 type feedCreation struct {
 	FeedURL       string `json:"feed_url"`
 	CategoryID    int64  `json:"category_id"`
@@ -33,6 +75,12 @@ type feedCreation struct {
 	FetchViaProxy bool   `json:"fetch_via_proxy"`
 	ScraperRules  string `json:"scraper_rules"`
 	RewriteRules  string `json:"rewrite_rules"`
+	model.FeedAuthentication
+}
+
+type builtinDomainRules struct {
+	RewriteRules map[string]string `json:"rewrite_rules"`
+	ScraperRules map[string]string `json:"scraper_rules"`
 }
 
 type subscriptionDiscovery struct {
@@ -44,22 +92,41 @@ type subscriptionDiscovery struct {
 }
 
 type feedModification struct {
-	FeedURL      *string `json:"feed_url"`
-	SiteURL      *string `json:"site_url"`
-	Title        *string `json:"title"`
-	ScraperRules *string `json:"scraper_rules"`
-	RewriteRules *string `json:"rewrite_rules"`
-	Crawler      *bool   `json:"crawler"`
-	UserAgent    *string `json:"user_agent"`
-	Username     *string `json:"username"`
-	Password     *string `json:"password"`
-	CategoryID   *int64  `json:"category_id"`
-	Disabled     *bool   `json:"disabled"`
+	FeedURL            *string `json:"feed_url"`
+	SiteURL            *string `json:"site_url"`
+	Title              *string `json:"title"`
+	ScraperRules       *string `json:"scraper_rules"`
+	RewriteRules       *string `json:"rewrite_rules"`
+	Crawler            *bool   `json:"crawler"`
+	UserAgent          *string `json:"user_agent"`
+	Username           *string `json:"username"`
+	Password           *string `json:"password"`
+	CategoryID         *int64  `json:"category_id"`
+	Disabled           *bool   `json:"disabled"`
+	OpenExternalLink   *bool   `json:"open_external_link"`
+	Encoding           *string `json:"encoding"`
+	BearerToken        *string `json:"bearer_token"`
+	OAuth2TokenURL     *string `json:"oauth2_token_url"`
+	OAuth2ClientID     *string `json:"oauth2_client_id"`
+	OAuth2ClientSecret *string `json:"oauth2_client_secret"`
+	OAuth2Scopes       *string `json:"oauth2_scopes"`
+	FetchScores        *bool   `json:"fetch_scores"`
+	MinimumScore       *int    `json:"minimum_score"`
+	BlockedAuthors     *string `json:"blocked_authors"`
+	AutoStar           *bool   `json:"auto_star"`
+	ArchivePages       *bool   `json:"archive_pages"`
+	HideGlobally       *bool   `json:"hide_globally"`
+	NotifyErrors       *bool   `json:"notify_errors"`
+	CronExpression     *string `json:"cron_expression"`
+	RequestTimeout     *int    `json:"request_timeout"`
+	MaxBodySize        *int    `json:"max_body_size"`
+	CustomTitle        *string `json:"custom_title"`
 }
 
 func (f *feedModification) Update(feed *model.Feed) {
-	if f.FeedURL != nil && *f.FeedURL != "" {
+	if f.FeedURL != nil && *f.FeedURL != "" && *f.FeedURL != feed.FeedURL {
 		feed.FeedURL = *f.FeedURL
+		feed.Dead = false
 	}
 
 	if f.SiteURL != nil && *f.SiteURL != "" {
@@ -101,17 +168,102 @@ func (f *feedModification) Update(feed *model.Feed) {
 	if f.Disabled != nil {
 		feed.Disabled = *f.Disabled
 	}
+
+	if f.OpenExternalLink != nil {
+		feed.OpenExternalLink = *f.OpenExternalLink
+	}
+
+	if f.Encoding != nil {
+		feed.Encoding = *f.Encoding
+	}
+
+	if f.BearerToken != nil {
+		feed.BearerToken = *f.BearerToken
+	}
+
+	if f.OAuth2TokenURL != nil {
+		feed.OAuth2TokenURL = *f.OAuth2TokenURL
+	}
+
+	if f.OAuth2ClientID != nil {
+		feed.OAuth2ClientID = *f.OAuth2ClientID
+	}
+
+	if f.OAuth2ClientSecret != nil {
+		feed.OAuth2ClientSecret = *f.OAuth2ClientSecret
+	}
+
+	if f.OAuth2Scopes != nil {
+		feed.OAuth2Scopes = *f.OAuth2Scopes
+	}
+
+	if f.FetchScores != nil {
+		feed.FetchScores = *f.FetchScores
+	}
+
+	if f.MinimumScore != nil {
+		feed.MinimumScore = *f.MinimumScore
+	}
+
+	if f.BlockedAuthors != nil {
+		feed.BlockedAuthors = *f.BlockedAuthors
+	}
+
+	if f.AutoStar != nil {
+		feed.AutoStar = *f.AutoStar
+	}
+
+	if f.ArchivePages != nil {
+		feed.ArchivePages = *f.ArchivePages
+	}
+
+	if f.HideGlobally != nil {
+		feed.HideGlobally = *f.HideGlobally
+	}
+
+	if f.NotifyErrors != nil {
+		feed.NotifyErrors = *f.NotifyErrors
+	}
+
+	if f.CronExpression != nil {
+		feed.CronExpression = *f.CronExpression
+	}
+
+	if f.RequestTimeout != nil {
+		feed.RequestTimeout = *f.RequestTimeout
+	}
+
+	if f.MaxBodySize != nil {
+		feed.MaxBodySize = *f.MaxBodySize
+	}
+
+	if f.CustomTitle != nil {
+		feed.WithCustomTitle(*f.CustomTitle)
+	}
 }
 
 type userModification struct {
-	Username       *string `json:"username"`
-	Password       *string `json:"password"`
-	IsAdmin        *bool   `json:"is_admin"`
-	Theme          *string `json:"theme"`
-	Language       *string `json:"language"`
-	Timezone       *string `json:"timezone"`
-	EntryDirection *string `json:"entry_sorting_direction"`
-	EntriesPerPage *int    `json:"entries_per_page"`
+	Username               *string `json:"username"`
+	Password               *string `json:"password"`
+	IsAdmin                *bool   `json:"is_admin"`
+	Theme                  *string `json:"theme"`
+	Language               *string `json:"language"`
+	Timezone               *string `json:"timezone"`
+	EntryDirection         *string `json:"entry_sorting_direction"`
+	EntriesPerPage         *int    `json:"entries_per_page"`
+	MarkReadOnOriginalLink *bool   `json:"mark_read_on_original_link"`
+	YouTubeEmbedURL        *string `json:"youtube_embed_url"`
+	BlockedAuthors         *string `json:"blocked_authors"`
+	AutoStarKeywords       *string `json:"auto_star_keywords"`
+	AutoStarAuthors        *string `json:"auto_star_authors"`
+	EmailRecipients        *string `json:"email_recipients"`
+	HomePage               *string `json:"home_page"`
+	EntryTimezone          *string `json:"entry_timezone"`
+	TimestampFormat        *string `json:"timestamp_format"`
+	NotificationEmail      *string `json:"notification_email"`
+	KeepStarredEntries     *bool   `json:"keep_starred_entries"`
+	ArchiveReadDays        *int    `json:"archive_read_days"`
+	AcceptReceivedEntries  *bool   `json:"accept_received_entries"`
 }
 
 func (u *userModification) Update(user *model.User) {
@@ -146,6 +298,61 @@ func (u *userModification) Update(user *model.User) {
 	if u.EntriesPerPage != nil {
 		user.EntriesPerPage = *u.EntriesPerPage
 	}
+
+	if u.MarkReadOnOriginalLink != nil {
+		user.MarkReadOnOriginalLink = *u.MarkReadOnOriginalLink
+	}
+
+	if u.YouTubeEmbedURL != nil {
+		user.YouTubeEmbedURL = *u.YouTubeEmbedURL
+		if normalizedURL := model.NormalizeYouTubeEmbedURL(user.YouTubeEmbedURL); normalizedURL != "" {
+			user.YouTubeEmbedURL = normalizedURL
+		}
+	}
+
+	if u.BlockedAuthors != nil {
+		user.BlockedAuthors = *u.BlockedAuthors
+	}
+
+	if u.AutoStarKeywords != nil {
+		user.AutoStarKeywords = *u.AutoStarKeywords
+	}
+
+	if u.AutoStarAuthors != nil {
+		user.AutoStarAuthors = *u.AutoStarAuthors
+	}
+
+	if u.EmailRecipients != nil {
+		user.EmailRecipients = *u.EmailRecipients
+	}
+
+	if u.HomePage != nil {
+		user.HomePage = *u.HomePage
+	}
+
+	if u.EntryTimezone != nil {
+		user.EntryTimezone = *u.EntryTimezone
+	}
+
+	if u.TimestampFormat != nil {
+		user.TimestampFormat = *u.TimestampFormat
+	}
+
+	if u.NotificationEmail != nil {
+		user.NotificationEmail = *u.NotificationEmail
+	}
+
+	if u.KeepStarredEntries != nil {
+		user.KeepStarredEntries = *u.KeepStarredEntries
+	}
+
+	if u.ArchiveReadDays != nil {
+		user.ArchiveReadDays = *u.ArchiveReadDays
+	}
+
+	if u.AcceptReceivedEntries != nil {
+		user.AcceptReceivedEntries = *u.AcceptReceivedEntries
+	}
 }
 
 func decodeUserModificationPayload(r io.ReadCloser) (*userModification, error) {
@@ -200,6 +407,45 @@ func decodeEntryStatusPayload(r io.ReadCloser) ([]int64, string, error) {
 	return p.EntryIDs, p.Status, nil
 }
 
+func decodeReadingPositionPayload(r io.ReadCloser) (float64, error) {
+	type payload struct {
+		Position *float64 `json:"position"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return 0, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if p.Position == nil {
+		return 0, errors.New("The reading position is required")
+	}
+
+	return *p.Position, nil
+}
+
+func decodeMoveFeedsPayload(r io.ReadCloser) ([]int64, int64, error) {
+	type payload struct {
+		FeedIDs    []int64 `json:"feed_ids"`
+		CategoryID int64   `json:"category_id"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, 0, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if err := model.ValidateFeedsMove(p.FeedIDs, p.CategoryID); err != nil {
+		return nil, 0, err
+	}
+
+	return p.FeedIDs, p.CategoryID, nil
+}
+
 func decodeFeedCreationPayload(r io.ReadCloser) (*feedCreation, error) {
 	defer r.Close()
 
@@ -224,6 +470,55 @@ func decodeFeedModificationPayload(r io.ReadCloser) (*feedModification, error) {
 	return &feed, nil
 }
 
+// decodeIntegrationPayload overwrites only the settings present in the payload.
+func decodeIntegrationPayload(r io.ReadCloser, integration *model.Integration) error {
+	defer r.Close()
+
+	userID := integration.UserID
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(integration); err != nil {
+		return fmt.Errorf("Unable to decode integration JSON object: %v", err)
+	}
+
+	integration.UserID = userID
+
+	// A null list of categories removes the restriction of the service.
+	for service, categoryIDs := range integration.CategoryRoutes {
+		if categoryIDs == nil {
+			delete(integration.CategoryRoutes, service)
+		}
+	}
+
+	return nil
+}
+
+type categoryModification struct {
+	Title  *string `json:"title"`
+	Hidden *bool   `json:"hidden"`
+}
+
+func (c *categoryModification) Update(category *model.Category) {
+	if c.Title != nil {
+		category.Title = *c.Title
+	}
+
+	if c.Hidden != nil {
+		category.Hidden = *c.Hidden
+	}
+}
+
+func decodeCategoryModificationPayload(r io.ReadCloser) (*categoryModification, error) {
+	defer r.Close()
+
+	var category categoryModification
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&category); err != nil {
+		return nil, fmt.Errorf("Unable to decode category modification JSON object: %v", err)
+	}
+
+	return &category, nil
+}
+
 func decodeCategoryPayload(r io.ReadCloser) (*model.Category, error) {
 	var category model.Category
 
@@ -235,3 +530,104 @@ func decodeCategoryPayload(r io.ReadCloser) (*model.Category, error) {
 
 	return &category, nil
 }
+
+func decodeDomainRulePayload(r io.ReadCloser) (*model.DomainRule, error) {
+	defer r.Close()
+
+	var rule model.DomainRule
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&rule); err != nil {
+		return nil, fmt.Errorf("Unable to decode domain rule JSON object: %v", err)
+	}
+
+	rule.Domain = strings.ToLower(strings.TrimSpace(rule.Domain))
+	return &rule, nil
+}
+
+func decodeAnnotationPayload(r io.ReadCloser) (*model.Annotation, error) {
+	defer r.Close()
+
+	var payload struct {
+		Quote string `json:"quote"`
+		Note  string `json:"note"`
+	}
+
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("Unable to decode annotation JSON object: %v", err)
+	}
+
+	return &model.Annotation{Quote: payload.Quote, Note: payload.Note}, nil
+}
+
+// maxPreferencesPayloadSize limits the size of the preferences sent in a single request.
+const maxPreferencesPayloadSize = 1024 * 1024
+
+func decodePreferencesPayload(r io.ReadCloser) (model.Preferences, error) {
+	defer r.Close()
+
+	var preferences model.Preferences
+	decoder := json.NewDecoder(io.LimitReader(r, maxPreferencesPayloadSize))
+	if err := decoder.Decode(&preferences); err != nil {
+		return nil, fmt.Errorf("Unable to decode preferences JSON object: %v", err)
+	}
+
+	if len(preferences) == 0 {
+		return nil, fmt.Errorf("The preferences JSON object is empty")
+	}
+
+	return preferences, nil
+}
+
+// decodeIFTTTTriggerPayload returns the trigger fields and the number of items requested by IFTTT.
+func decodeIFTTTTriggerPayload(r io.ReadCloser) (map[string]string, int, error) {
+	defer r.Close()
+
+	var payload struct {
+		TriggerFields map[string]string `json:"trigger_fields"`
+		Limit         *int              `json:"limit"`
+	}
+
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&payload); err != nil && err != io.EOF {
+		return nil, 0, fmt.Errorf("Unable to decode trigger JSON object: %v", err)
+	}
+
+	limit := defaultTriggerLimit
+	if payload.Limit != nil {
+		limit = *payload.Limit
+	}
+
+	if payload.TriggerFields == nil {
+		payload.TriggerFields = make(map[string]string)
+	}
+
+	return payload.TriggerFields, limit, nil
+}
+
+func decodeSavedSearchPayload(r io.ReadCloser) (*model.SavedSearch, error) {
+	type payload struct {
+		Query      string `json:"query"`
+		FeedID     int64  `json:"feed_id"`
+		CategoryID int64  `json:"category_id"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	savedSearch := &model.SavedSearch{
+		Query:      strings.TrimSpace(p.Query),
+		FeedID:     p.FeedID,
+		CategoryID: p.CategoryID,
+	}
+
+	if err := model.ValidateSearchQuery(savedSearch.Query); err != nil {
+		return nil, err
+	}
+
+	return savedSearch, nil
+}
@@ -5,6 +5,8 @@
 package api // import "miniflux.app/api"
 
 import (
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"miniflux.app/model"
@@ -165,6 +167,30 @@ func TestUpdateFeedDisabled(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedOpenExternalLink(t *testing.T) {
+	valueTrue := true
+	valueFalse := false
+	scenarios := []struct {
+		changes  *feedModification
+		feed     *model.Feed
+		expected bool
+	}{
+		{&feedModification{}, &model.Feed{OpenExternalLink: true}, true},
+		{&feedModification{OpenExternalLink: &valueTrue}, &model.Feed{OpenExternalLink: false}, true},
+		{&feedModification{OpenExternalLink: &valueFalse}, &model.Feed{OpenExternalLink: true}, false},
+	}
+
+	for _, scenario := range scenarios {
+		scenario.changes.Update(scenario.feed)
+		if scenario.feed.OpenExternalLink != scenario.expected {
+			t.Errorf(`Unexpected result, got %v, want: %v`,
+				scenario.feed.OpenExternalLink,
+				scenario.expected,
+			)
+		}
+	}
+}
+
 func TestUpdateFeedCategory(t *testing.T) {
 	categoryID := int64(1)
 	changes := &feedModification{CategoryID: &categoryID}
@@ -217,3 +243,222 @@ func TestUserThemeWhenNotSet(t *testing.T) {
 		t.Fatal(`The user Theme should not be modified`)
 	}
 }
+
+func TestUpdateFeedAuthentication(t *testing.T) {
+	bearerToken := "token"
+	tokenURL := "https://example.org/oauth/token"
+	emptyValue := ""
+	feed := &model.Feed{OAuth2ClientID: "client", OAuth2Scopes: "read"}
+
+	changes := &feedModification{BearerToken: &bearerToken, OAuth2TokenURL: &tokenURL, OAuth2Scopes: &emptyValue}
+	changes.Update(feed)
+
+	if feed.BearerToken != "token" {
+		t.Errorf(`Unexpected bearer token: %q`, feed.BearerToken)
+	}
+
+	if feed.OAuth2TokenURL != tokenURL {
+		t.Errorf(`Unexpected OAuth2 token URL: %q`, feed.OAuth2TokenURL)
+	}
+
+	if feed.OAuth2ClientID != "client" {
+		t.Errorf(`The OAuth2 client ID should not be modified: %q`, feed.OAuth2ClientID)
+	}
+
+	if feed.OAuth2Scopes != "" {
+		t.Errorf(`The OAuth2 scopes should be cleared: %q`, feed.OAuth2Scopes)
+	}
+}
+
+func TestUpdateFeedScores(t *testing.T) {
+	fetchScores := true
+	minimumScore := 50
+	feed := &model.Feed{}
+
+	changes := &feedModification{FetchScores: &fetchScores, MinimumScore: &minimumScore}
+	changes.Update(feed)
+
+	if !feed.FetchScores {
+		t.Error(`The scores should be fetched`)
+	}
+
+	if feed.MinimumScore != 50 {
+		t.Errorf(`Unexpected minimum score: %d`, feed.MinimumScore)
+	}
+}
+
+func TestUpdateFeedBlockedAuthors(t *testing.T) {
+	blockedAuthors := "John Doe"
+	feed := &model.Feed{}
+
+	changes := &feedModification{BlockedAuthors: &blockedAuthors}
+	changes.Update(feed)
+
+	if feed.BlockedAuthors != "John Doe" {
+		t.Errorf(`Unexpected blocked authors: %q`, feed.BlockedAuthors)
+	}
+}
+
+func TestUpdateFeedAutoStar(t *testing.T) {
+	autoStar := true
+	feed := &model.Feed{}
+
+	changes := &feedModification{AutoStar: &autoStar}
+	changes.Update(feed)
+
+	if !feed.AutoStar {
+		t.Error(`The feed entries should be starred automatically`)
+	}
+}
+
+func TestUpdateFeedHideGlobally(t *testing.T) {
+	hideGlobally := true
+	feed := &model.Feed{}
+
+	changes := &feedModification{HideGlobally: &hideGlobally}
+	changes.Update(feed)
+
+	if !feed.HideGlobally {
+		t.Error(`The feed should be hidden from the global unread list`)
+	}
+}
+
+func TestUpdateFeedCustomTitle(t *testing.T) {
+	customTitle := "My feed"
+	feed := &model.Feed{Title: "Upstream title"}
+
+	changes := &feedModification{CustomTitle: &customTitle}
+	changes.Update(feed)
+
+	if feed.CustomTitle != customTitle || feed.Title != "Upstream title" {
+		t.Errorf(`Unexpected titles: %q / %q`, feed.CustomTitle, feed.Title)
+	}
+
+	customTitle = ""
+	changes.Update(feed)
+
+	if feed.CustomTitle != "" {
+		t.Errorf(`An empty custom title should restore the original title`)
+	}
+}
+
+func TestDecodeIntegrationPayload(t *testing.T) {
+	integration := &model.Integration{UserID: 1, PinboardEnabled: true, PinboardToken: "token", WallabagURL: "https://example.org/"}
+	payload := `{"user_id": 2, "pinboard_enabled": false, "wallabag_enabled": true}`
+	if err := decodeIntegrationPayload(ioutil.NopCloser(strings.NewReader(payload)), integration); err != nil {
+		t.Fatal(err)
+	}
+
+	if integration.UserID != 1 {
+		t.Errorf(`The user ID should not be modified, got %d`, integration.UserID)
+	}
+
+	if integration.PinboardEnabled || !integration.WallabagEnabled {
+		t.Errorf(`The given settings should be updated`)
+	}
+
+	if integration.PinboardToken != "token" || integration.WallabagURL != "https://example.org/" {
+		t.Errorf(`The other settings should be kept`)
+	}
+
+	payload = `{"category_routes": {"pinboard": [1], "pocket": [], "wallabag": null}}`
+	integration.CategoryRoutes = map[string][]int64{"wallabag": {2}}
+	if err := decodeIntegrationPayload(ioutil.NopCloser(strings.NewReader(payload)), integration); err != nil {
+		t.Fatal(err)
+	}
+
+	if !integration.AcceptsCategory("pinboard", 1) || integration.AcceptsCategory("pocket", 1) || !integration.AcceptsCategory("wallabag", 1) {
+		t.Errorf(`Unexpected category routes: %v`, integration.CategoryRoutes)
+	}
+
+	if err := decodeIntegrationPayload(ioutil.NopCloser(strings.NewReader(`{"pinboard_enabled": "yes"}`)), integration); err == nil {
+		t.Error(`An invalid payload should generate an error`)
+	}
+}
+
+func TestDecodeIFTTTTriggerPayload(t *testing.T) {
+	payload := `{"trigger_fields": {"search": "golang"}, "limit": 0}`
+	fields, limit, err := decodeIFTTTTriggerPayload(ioutil.NopCloser(strings.NewReader(payload)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fields["search"] != "golang" || limit != 0 {
+		t.Errorf(`Unexpected trigger fields %v and limit %d`, fields, limit)
+	}
+
+	fields, limit, err = decodeIFTTTTriggerPayload(ioutil.NopCloser(strings.NewReader(``)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fields == nil || limit != defaultTriggerLimit {
+		t.Errorf(`An empty payload should use the default limit, got %d`, limit)
+	}
+
+	if _, _, err := decodeIFTTTTriggerPayload(ioutil.NopCloser(strings.NewReader(`{"limit": "ten"}`))); err == nil {
+		t.Error(`An invalid payload should generate an error`)
+	}
+}
+
+func TestDecodePreferencesPayload(t *testing.T) {
+	payload := `{"font_size": 16, "theme": "dark", "layout": {"columns": 2}, "old_key": null}`
+	preferences, err := decodePreferencesPayload(ioutil.NopCloser(strings.NewReader(payload)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(preferences["theme"]) != `"dark"` || string(preferences["layout"]) != `{"columns": 2}` {
+		t.Errorf(`Unexpected preferences: %v`, preferences)
+	}
+
+	if !model.IsPreferenceRemoval(preferences["old_key"]) {
+		t.Errorf(`The null value should be kept to remove the key`)
+	}
+
+	for _, payload := range []string{``, `{}`, `["theme"]`} {
+		if _, err := decodePreferencesPayload(ioutil.NopCloser(strings.NewReader(payload))); err == nil {
+			t.Errorf(`The payload %q should generate an error`, payload)
+		}
+	}
+}
+
+func TestUpdateCategoryHidden(t *testing.T) {
+	hidden := true
+	changes := &categoryModification{Hidden: &hidden}
+	category := &model.Category{Title: "Example"}
+	changes.Update(category)
+
+	if !category.Hidden {
+		t.Fatal(`The category should be hidden`)
+	}
+
+	if category.Title != "Example" {
+		t.Fatalf(`The title should not be modified, got %q`, category.Title)
+	}
+}
+
+func TestUpdateCategoryTitleWhenNotSet(t *testing.T) {
+	changes := &categoryModification{}
+	category := &model.Category{Title: "Example", Hidden: true}
+	changes.Update(category)
+
+	if category.Title != "Example" || !category.Hidden {
+		t.Fatalf(`The category should not be modified, got %+v`, category)
+	}
+}
+
+func TestDecodeMoveFeedsPayload(t *testing.T) {
+	feedIDs, categoryID, err := decodeMoveFeedsPayload(ioutil.NopCloser(strings.NewReader(`{"feed_ids": [1, 2], "category_id": 3}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feedIDs) != 2 || feedIDs[1] != 2 || categoryID != 3 {
+		t.Errorf(`Unexpected payload: %v, %d`, feedIDs, categoryID)
+	}
+
+	if _, _, err := decodeMoveFeedsPayload(ioutil.NopCloser(strings.NewReader(`{"feed_ids": [], "category_id": 3}`))); err == nil {
+		t.Error(`A payload without feeds should generate an error`)
+	}
+}
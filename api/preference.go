@@ -0,0 +1,115 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"fmt"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) getAllPreferences(w http.ResponseWriter, r *http.Request) {
+	preferences, err := h.store.AllPreferences(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, preferences)
+}
+
+func (h *handler) getPreferences(w http.ResponseWriter, r *http.Request) {
+	namespace := request.RouteStringParam(r, "namespace")
+	if err := model.ValidatePreferenceName(namespace); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	preferences, err := h.store.Preferences(request.UserID(r), namespace)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, preferences)
+}
+
+func (h *handler) updatePreferences(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	namespace := request.RouteStringParam(r, "namespace")
+	if err := model.ValidatePreferenceName(namespace); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	changes, err := decodePreferencesPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := changes.Validate(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	current, err := h.store.Preferences(userID, namespace)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	count, err := h.store.CountPreferences(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	for key, value := range changes {
+		_, exists := current[key]
+		switch {
+		case exists && model.IsPreferenceRemoval(value):
+			count--
+		case !exists && !model.IsPreferenceRemoval(value):
+			count++
+		}
+	}
+
+	if count > model.MaxPreferencesPerUser {
+		json.BadRequest(w, r, fmt.Errorf("A user cannot save more than %d preferences", model.MaxPreferencesPerUser))
+		return
+	}
+
+	if err := h.store.UpdatePreferences(userID, namespace, changes); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	preferences, err := h.store.Preferences(userID, namespace)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, preferences)
+}
+
+func (h *handler) removePreferences(w http.ResponseWriter, r *http.Request) {
+	namespace := request.RouteStringParam(r, "namespace")
+	if err := model.ValidatePreferenceName(namespace); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.RemovePreferences(request.UserID(r), namespace); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
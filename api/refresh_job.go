@@ -0,0 +1,196 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/live"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+const (
+	// Finished jobs are forgotten after this delay.
+	refreshJobRetention = time.Hour
+
+	// Maximum number of unfinished jobs per user.
+	maxActiveRefreshJobs = 3
+)
+
+var (
+	errTooManyRefreshJobs = errors.New("Too many refresh jobs are already running")
+	errRefreshQueueFull   = errors.New("The refresh queue is full, try again later")
+)
+
+type refreshJobs struct {
+	mu   sync.Mutex
+	jobs map[string]*model.RefreshJob
+}
+
+func newRefreshJobs() *refreshJobs {
+	return &refreshJobs{jobs: make(map[string]*model.RefreshJob)}
+}
+
+func (j *refreshJobs) create(userID int64, feedIDs []int64) (*model.RefreshJob, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.removeExpiredJobs()
+
+	activeJobs := 0
+	for _, job := range j.jobs {
+		if job.UserID == userID && job.FinishedAt == nil {
+			activeJobs++
+		}
+	}
+
+	if activeJobs >= maxActiveRefreshJobs {
+		return nil, errTooManyRefreshJobs
+	}
+
+	job := &model.RefreshJob{
+		ID:        crypto.GenerateRandomStringHex(16),
+		UserID:    userID,
+		FeedIDs:   feedIDs,
+		Status:    model.RefreshJobStatusPending,
+		Errors:    make([]string, 0),
+		CreatedAt: time.Now(),
+	}
+	j.jobs[job.ID] = job
+	return j.copy(job), nil
+}
+
+func (j *refreshJobs) get(userID int64, jobID string) *model.RefreshJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.removeExpiredJobs()
+
+	job, found := j.jobs[jobID]
+	if !found || job.UserID != userID {
+		return nil
+	}
+
+	return j.copy(job)
+}
+
+func (j *refreshJobs) update(jobID string, fn func(job *model.RefreshJob)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if job, found := j.jobs[jobID]; found {
+		fn(job)
+	}
+}
+
+func (j *refreshJobs) remove(jobID string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	delete(j.jobs, jobID)
+}
+
+// removeExpiredJobs must be called with the mutex locked.
+func (j *refreshJobs) removeExpiredJobs() {
+	for id, job := range j.jobs {
+		if job.FinishedAt != nil && time.Since(*job.FinishedAt) > refreshJobRetention {
+			delete(j.jobs, id)
+		}
+	}
+}
+
+func (j *refreshJobs) copy(job *model.RefreshJob) *model.RefreshJob {
+	jobCopy := *job
+	jobCopy.Errors = append([]string{}, job.Errors...)
+	return &jobCopy
+}
+
+// startRefreshJob refreshes the given feeds with the worker pool and returns the job tracking the progress.
+func (h *handler) startRefreshJob(userID int64, feedIDs []int64) (*model.RefreshJob, error) {
+	job, err := h.refreshJobs.create(userID, feedIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	queued := h.pool.TryRun(func() {
+		h.refreshJobs.update(job.ID, func(job *model.RefreshJob) {
+			job.Status = model.RefreshJobStatusRunning
+		})
+
+		for _, feedID := range feedIDs {
+			entryCount, err := h.refreshFeedAndCountEntries(userID, feedID)
+			if err != nil {
+				logger.Error("[API:RefreshJob] feedID=%d: %v", feedID, err)
+			}
+
+			h.store.Events().Publish(userID, live.EventFeedRefreshed, feedID)
+
+			h.refreshJobs.update(job.ID, func(job *model.RefreshJob) {
+				job.Completed++
+				job.EntryCount += entryCount
+				if err != nil {
+					job.Errors = append(job.Errors, err.Error())
+				}
+			})
+		}
+
+		h.refreshJobs.update(job.ID, func(job *model.RefreshJob) {
+			now := time.Now()
+			job.FinishedAt = &now
+			job.Status = model.RefreshJobStatusDone
+			if len(job.Errors) > 0 {
+				job.Status = model.RefreshJobStatusFailed
+			}
+		})
+	})
+
+	if !queued {
+		h.refreshJobs.remove(job.ID)
+		return nil, errRefreshQueueFull
+	}
+
+	return job, nil
+}
+
+// refreshFeedAndCountEntries refreshes a feed and returns the number of new entries.
+func (h *handler) refreshFeedAndCountEntries(userID, feedID int64) (int, error) {
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithFeedID(feedID)
+	builder.WithOrder("id")
+	builder.WithDirection("desc")
+	builder.WithLimit(1)
+	entryIDs, err := builder.GetEntryIDs()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := h.feedHandler.RefreshFeed(userID, feedID); err != nil {
+		return 0, err
+	}
+
+	builder = h.store.NewEntryQueryBuilder(userID)
+	builder.WithFeedID(feedID)
+	if len(entryIDs) > 0 {
+		builder.AfterEntryID(entryIDs[0])
+	}
+
+	return builder.CountEntries()
+}
+
+func (h *handler) getRefreshJob(w http.ResponseWriter, r *http.Request) {
+	job := h.refreshJobs.get(request.UserID(r), request.RouteStringParam(r, "jobID"))
+	if job == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, job)
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestRefreshJobsLimitActiveJobs(t *testing.T) {
+	jobs := newRefreshJobs()
+
+	for i := 0; i < maxActiveRefreshJobs; i++ {
+		if _, err := jobs.create(1, []int64{1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := jobs.create(1, []int64{1}); err != errTooManyRefreshJobs {
+		t.Fatalf(`The number of active jobs should be limited, got %v`, err)
+	}
+
+	if _, err := jobs.create(2, []int64{1}); err != nil {
+		t.Fatalf(`The limit should apply per user: %v`, err)
+	}
+}
+
+func TestRefreshJobsRemoveExpiredJobs(t *testing.T) {
+	jobs := newRefreshJobs()
+
+	expired, _ := jobs.create(1, []int64{1})
+	finished, _ := jobs.create(1, []int64{1})
+
+	jobs.update(expired.ID, func(job *model.RefreshJob) {
+		finishedAt := time.Now().Add(-refreshJobRetention - time.Minute)
+		job.FinishedAt = &finishedAt
+	})
+
+	jobs.update(finished.ID, func(job *model.RefreshJob) {
+		finishedAt := time.Now()
+		job.FinishedAt = &finishedAt
+	})
+
+	if jobs.get(1, expired.ID) != nil {
+		t.Error(`The expired job should be removed`)
+	}
+
+	if jobs.get(1, finished.ID) == nil {
+		t.Error(`The recently finished job should be kept`)
+	}
+
+	if jobs.get(2, finished.ID) != nil {
+		t.Error(`The job should not be visible to other users`)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) getDatabaseReport(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	report, err := h.store.DatabaseReport()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	report.UseTimezone(request.UserTimezone(r))
+	json.OK(w, r, report)
+}
@@ -0,0 +1,79 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) getSavedSearches(w http.ResponseWriter, r *http.Request) {
+	savedSearches, err := h.store.SavedSearches(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, savedSearches)
+}
+
+func (h *handler) createSavedSearch(w http.ResponseWriter, r *http.Request) {
+	savedSearch, err := decodeSavedSearchPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	savedSearch.UserID = request.UserID(r)
+	if savedSearch.FeedID != 0 && !h.store.FeedExists(savedSearch.UserID, savedSearch.FeedID) {
+		json.BadRequest(w, r, errors.New("This feed does not exist"))
+		return
+	}
+
+	if savedSearch.CategoryID != 0 && !h.store.CategoryExists(savedSearch.UserID, savedSearch.CategoryID) {
+		json.BadRequest(w, r, errors.New("This category does not exist"))
+		return
+	}
+
+	if err := h.store.CreateSavedSearch(savedSearch); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	// Reload the saved search to return the title of its feed or category.
+	savedSearch, err = h.store.SavedSearchByID(savedSearch.UserID, savedSearch.ID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, savedSearch)
+}
+
+func (h *handler) removeSavedSearch(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	savedSearchID := request.RouteInt64Param(r, "savedSearchID")
+
+	savedSearch, err := h.store.SavedSearchByID(userID, savedSearchID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if savedSearch == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveSavedSearch(userID, savedSearch.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
@@ -7,6 +7,7 @@ package api // import "miniflux.app/api"
 import (
 	"net/http"
 
+	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
 	"miniflux.app/reader/subscription"
 )
@@ -18,12 +19,23 @@ func (h *handler) getSubscriptions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if blockedErr := h.feedHandler.CheckBlockedFeed(subscriptionInfo.URL); blockedErr != nil {
+		json.BadRequest(w, r, blockedErr)
+		return
+	}
+
+	var rssBridgeURL string
+	if integration, err := h.store.Integration(request.UserID(r)); err == nil && integration.RSSBridgeEnabled {
+		rssBridgeURL = integration.RSSBridgeURL
+	}
+
 	subscriptions, finderErr := subscription.FindSubscriptions(
 		subscriptionInfo.URL,
 		subscriptionInfo.UserAgent,
 		subscriptionInfo.Username,
 		subscriptionInfo.Password,
 		subscriptionInfo.FetchViaProxy,
+		rssBridgeURL,
 	)
 	if finderErr != nil {
 		json.ServerError(w, r, finderErr)
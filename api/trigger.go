@@ -0,0 +1,194 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+)
+
+// Polling triggers return the most recent items first, automation services
+// remember the IDs already seen to detect the new ones.
+const (
+	defaultTriggerLimit  = 50
+	maxTriggerLimit      = 100
+	triggerExcerptLength = 500
+)
+
+type triggerItem struct {
+	ID          string       `json:"id"`
+	EntryID     int64        `json:"entry_id"`
+	Title       string       `json:"title"`
+	URL         string       `json:"url"`
+	CommentsURL string       `json:"comments_url"`
+	Author      string       `json:"author"`
+	Excerpt     string       `json:"excerpt"`
+	Content     string       `json:"content"`
+	Tags        []string     `json:"tags"`
+	FeedID      int64        `json:"feed_id"`
+	FeedTitle   string       `json:"feed_title"`
+	FeedSiteURL string       `json:"feed_site_url"`
+	Category    string       `json:"category"`
+	PublishedAt time.Time    `json:"published_at"`
+	ChangedAt   time.Time    `json:"changed_at"`
+	Meta        *triggerMeta `json:"meta,omitempty"`
+}
+
+// triggerMeta holds the fields required by IFTTT to deduplicate items.
+type triggerMeta struct {
+	ID        string `json:"id"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+type iftttTriggerResponse struct {
+	Data []*triggerItem `json:"data"`
+}
+
+func newTriggerItem(entry *model.Entry) *triggerItem {
+	item := &triggerItem{
+		ID:          strconv.FormatInt(entry.ID, 10),
+		EntryID:     entry.ID,
+		Title:       entry.Title,
+		URL:         entry.URL,
+		CommentsURL: entry.CommentsURL,
+		Author:      entry.Author,
+		Excerpt:     sanitizer.Excerpt(entry.Content, triggerExcerptLength),
+		Content:     entry.Content,
+		Tags:        entry.Tags,
+		FeedID:      entry.FeedID,
+		PublishedAt: entry.Date,
+		ChangedAt:   entry.ChangedAt,
+	}
+
+	if item.Tags == nil {
+		item.Tags = []string{}
+	}
+
+	if entry.Feed != nil {
+		item.FeedTitle = entry.Feed.DisplayTitle()
+		item.FeedSiteURL = entry.Feed.SiteURL
+		if entry.Feed.Category != nil {
+			item.Category = entry.Feed.Category.Title
+		}
+	}
+
+	return item
+}
+
+func (h *handler) starredEntriesTrigger(w http.ResponseWriter, r *http.Request) {
+	limit := request.QueryIntParam(r, "limit", defaultTriggerLimit)
+	items, err := h.findTriggerItems(request.UserID(r), "", limit, true)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, items)
+}
+
+func (h *handler) searchEntriesTrigger(w http.ResponseWriter, r *http.Request) {
+	search := request.QueryStringParam(r, "search", "")
+	if search == "" {
+		json.BadRequest(w, r, errors.New("The search parameter is required"))
+		return
+	}
+
+	limit := request.QueryIntParam(r, "limit", defaultTriggerLimit)
+	items, err := h.findTriggerItems(request.UserID(r), search, limit, false)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, items)
+}
+
+func (h *handler) iftttStarredEntriesTrigger(w http.ResponseWriter, r *http.Request) {
+	_, limit, err := decodeIFTTTTriggerPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	h.renderIFTTTTrigger(w, r, "", limit, true)
+}
+
+func (h *handler) iftttSearchEntriesTrigger(w http.ResponseWriter, r *http.Request) {
+	fields, limit, err := decodeIFTTTTriggerPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if fields["search"] == "" {
+		json.BadRequest(w, r, errors.New("The search trigger field is required"))
+		return
+	}
+
+	h.renderIFTTTTrigger(w, r, fields["search"], limit, false)
+}
+
+func (h *handler) renderIFTTTTrigger(w http.ResponseWriter, r *http.Request, search string, limit int, starred bool) {
+	response := &iftttTriggerResponse{Data: make([]*triggerItem, 0)}
+
+	// IFTTT sends a limit of 0 to check that the trigger is working.
+	if limit > 0 {
+		items, err := h.findTriggerItems(request.UserID(r), search, limit, starred)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		for _, item := range items {
+			timestamp := item.PublishedAt
+			if starred {
+				timestamp = item.ChangedAt
+			}
+			item.Meta = &triggerMeta{ID: item.ID, Timestamp: timestamp.Unix()}
+		}
+
+		response.Data = items
+	}
+
+	json.OK(w, r, response)
+}
+
+func (h *handler) findTriggerItems(userID int64, search string, limit int, starred bool) ([]*triggerItem, error) {
+	if limit <= 0 || limit > maxTriggerLimit {
+		limit = maxTriggerLimit
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithLimit(limit)
+	builder.WithDirection("desc")
+
+	if starred {
+		builder.WithStarred()
+		builder.WithOrder("changed_at")
+	} else {
+		builder.WithSearchQuery(search)
+		builder.WithOrder("id")
+	}
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*triggerItem, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, newTriggerItem(entry))
+	}
+
+	return items, nil
+}
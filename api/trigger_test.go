@@ -0,0 +1,40 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestNewTriggerItem(t *testing.T) {
+	entry := &model.Entry{
+		ID:      42,
+		Title:   "Title",
+		Content: "<p>Some <b>content</b></p>",
+		Feed: &model.Feed{
+			Title:    "Feed",
+			Category: &model.Category{Title: "News"},
+		},
+	}
+
+	item := newTriggerItem(entry)
+	if item.ID != "42" || item.EntryID != 42 {
+		t.Errorf(`The trigger item should use the entry ID as string, got %q`, item.ID)
+	}
+
+	if item.Excerpt != "Some content" {
+		t.Errorf(`Unexpected excerpt: %q`, item.Excerpt)
+	}
+
+	if item.FeedTitle != "Feed" || item.Category != "News" {
+		t.Errorf(`Unexpected feed information: %q, %q`, item.FeedTitle, item.Category)
+	}
+
+	if item.Tags == nil {
+		t.Error(`Tags should never be null`)
+	}
+}
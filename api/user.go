@@ -8,8 +8,10 @@ import (
 	"errors"
 	"net/http"
 
+	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
 )
 
 func (h *handler) currentUser(w http.ResponseWriter, r *http.Request) {
@@ -19,7 +21,13 @@ func (h *handler) currentUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	json.OK(w, r, user)
+	integration, err := h.store.Integration(user.ID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &currentUserResponse{User: user, Features: model.NewFeatures(config.Opts.ProxyImages(), integration)})
 }
 
 func (h *handler) createUser(w http.ResponseWriter, r *http.Request) {
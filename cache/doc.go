@@ -0,0 +1,10 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package cache implements an in-memory LRU cache and a minimal Redis client used to share sessions and counters between instances.
+
+*/
+package cache // import "miniflux.app/cache"
@@ -0,0 +1,84 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LRU is an in-memory cache that removes the least recently used item when it is full.
+type LRU struct {
+	sync.Mutex
+	capacity int
+	items    map[interface{}]*list.Element
+	order    *list.List
+}
+
+type lruItem struct {
+	key   interface{}
+	value interface{}
+}
+
+// NewLRU returns a cache holding at most the given number of items.
+func NewLRU(capacity int) *LRU {
+	return &LRU{
+		capacity: capacity,
+		items:    make(map[interface{}]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the value of the given key, the boolean is false when the key doesn't exist.
+func (c *LRU) Get(key interface{}) (interface{}, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	element, found := c.items[key]
+	if !found {
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return element.Value.(*lruItem).value, true
+}
+
+// Set adds or replaces a value.
+func (c *LRU) Set(key, value interface{}) {
+	c.Lock()
+	defer c.Unlock()
+
+	if element, found := c.items[key]; found {
+		element.Value.(*lruItem).value = value
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruItem{key: key, value: value})
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Delete removes the given key.
+func (c *LRU) Delete(key interface{}) {
+	c.Lock()
+	defer c.Unlock()
+
+	if element, found := c.items[key]; found {
+		c.order.Remove(element)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of items in the cache.
+func (c *LRU) Len() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.order.Len()
+}
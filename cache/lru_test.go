@@ -0,0 +1,50 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import "testing"
+
+func TestLRUEvictsLeastRecentlyUsedItem(t *testing.T) {
+	c := NewLRU(2)
+	c.Set(1, "a")
+	c.Set(2, "b")
+
+	if value, found := c.Get(1); !found || value != "a" {
+		t.Fatalf(`Unexpected value %v`, value)
+	}
+
+	c.Set(3, "c")
+
+	if _, found := c.Get(2); found {
+		t.Error(`The least recently used item should be removed`)
+	}
+
+	if _, found := c.Get(1); !found {
+		t.Error(`The recently used item should be kept`)
+	}
+
+	if c.Len() != 2 {
+		t.Errorf(`Unexpected length, got %d`, c.Len())
+	}
+}
+
+func TestLRUReplaceAndDelete(t *testing.T) {
+	c := NewLRU(2)
+	c.Set("key", 1)
+	c.Set("key", 2)
+
+	if value, _ := c.Get("key"); value != 2 {
+		t.Errorf(`The value should be replaced, got %v`, value)
+	}
+
+	c.Delete("key")
+	if _, found := c.Get("key"); found {
+		t.Error(`The key should be deleted`)
+	}
+
+	if c.Len() != 0 {
+		t.Errorf(`Unexpected length, got %d`, c.Len())
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRedisPort = "6379"
+	maxIdleConns     = 10
+	maxUpdateRetries = 5
+	networkTimeout   = 5 * time.Second
+)
+
+// Redis is a small client for the subset of Redis commands used by Miniflux.
+type Redis struct {
+	addr     string
+	password string
+	db       int
+	idle     chan *redisConn
+}
+
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewRedis returns a Redis client from an URL like "redis://:password@localhost:6379/0".
+func NewRedis(redisURL string) (*Redis, error) {
+	u, err := url.Parse(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("cache: invalid Redis URL: %v", err)
+	}
+
+	if u.Scheme != "redis" || u.Hostname() == "" {
+		return nil, fmt.Errorf("cache: invalid Redis URL %q", redisURL)
+	}
+
+	r := &Redis{
+		addr: u.Host,
+		idle: make(chan *redisConn, maxIdleConns),
+	}
+
+	if u.Port() == "" {
+		r.addr = net.JoinHostPort(u.Hostname(), defaultRedisPort)
+	}
+
+	if u.User != nil {
+		r.password, _ = u.User.Password()
+	}
+
+	if path := strings.Trim(u.Path, "/"); path != "" {
+		if r.db, err = strconv.Atoi(path); err != nil {
+			return nil, fmt.Errorf("cache: invalid Redis database %q", path)
+		}
+	}
+
+	return r, nil
+}
+
+// Ping checks the connection to the server.
+func (r *Redis) Ping() error {
+	_, err := r.do("PING")
+	return err
+}
+
+// Get returns the value of the given key, the boolean is false when the key doesn't exist.
+func (r *Redis) Get(key string) (string, bool, error) {
+	reply, err := r.do("GET", key)
+	if err != nil {
+		return "", false, err
+	}
+
+	if reply == nil {
+		return "", false, nil
+	}
+
+	return reply.(string), true, nil
+}
+
+// Set stores a value that expires after the given duration.
+func (r *Redis) Set(key, value string, ttl time.Duration) error {
+	_, err := r.do("SET", key, value, "PX", strconv.FormatInt(int64(ttl/time.Millisecond), 10))
+	return err
+}
+
+// Update replaces the value of an existing key with the result of the given function and resets its expiration.
+// The key is watched during the change, the update is retried when another client modifies it in the meantime.
+// The boolean is false when the key doesn't exist.
+func (r *Redis) Update(key string, ttl time.Duration, update func(value string) (string, error)) (bool, error) {
+	for i := 0; i < maxUpdateRetries; i++ {
+		var found, committed bool
+		err := r.withConn(func(c *redisConn) error {
+			var err error
+			found, committed, err = c.update(key, ttl, update)
+			return err
+		})
+
+		if err != nil || !found || committed {
+			return found, err
+		}
+	}
+
+	return true, fmt.Errorf("cache: key %q modified concurrently too many times", key)
+}
+
+// Delete removes the given keys.
+func (r *Redis) Delete(keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	_, err := r.do("DEL", keys...)
+	return err
+}
+
+func (r *Redis) do(command string, args ...string) (reply interface{}, err error) {
+	err = r.withConn(func(c *redisConn) error {
+		reply, err = c.do(command, args...)
+		return err
+	})
+	return reply, err
+}
+
+func (r *Redis) withConn(fn func(c *redisConn) error) error {
+	c, err := r.conn()
+	if err != nil {
+		return err
+	}
+
+	if err := fn(c); err != nil {
+		// Errors returned by the server don't break the protocol, the connection can be reused.
+		if _, ok := err.(redisError); !ok {
+			c.conn.Close()
+			return err
+		}
+
+		r.release(c)
+		return err
+	}
+
+	r.release(c)
+	return nil
+}
+
+func (r *Redis) conn() (*redisConn, error) {
+	select {
+	case c := <-r.idle:
+		return c, nil
+	default:
+	}
+
+	conn, err := net.DialTimeout("tcp", r.addr, networkTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("cache: unable to connect to Redis: %v", err)
+	}
+
+	c := &redisConn{conn: conn, reader: bufio.NewReader(conn)}
+
+	if r.password != "" {
+		if _, err := c.do("AUTH", r.password); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	if r.db != 0 {
+		if _, err := c.do("SELECT", strconv.Itoa(r.db)); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (r *Redis) release(c *redisConn) {
+	select {
+	case r.idle <- c:
+	default:
+		c.conn.Close()
+	}
+}
+
+type redisError string
+
+func (e redisError) Error() string {
+	return "cache: " + string(e)
+}
+
+func (c *redisConn) do(command string, args ...string) (interface{}, error) {
+	c.conn.SetDeadline(time.Now().Add(networkTimeout))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n$%d\r\n%s\r\n", len(args)+1, len(command), command)
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+
+	if _, err := io.WriteString(c.conn, b.String()); err != nil {
+		return nil, fmt.Errorf("cache: unable to send command: %v", err)
+	}
+
+	return c.readReply()
+}
+
+// update runs a WATCH/MULTI/EXEC transaction, committed is false when the key was modified before EXEC.
+func (c *redisConn) update(key string, ttl time.Duration, update func(value string) (string, error)) (found, committed bool, err error) {
+	if _, err := c.do("WATCH", key); err != nil {
+		return false, false, err
+	}
+
+	reply, err := c.do("GET", key)
+	if err == nil && reply != nil {
+		var value string
+		if value, err = update(reply.(string)); err == nil {
+			if _, err = c.do("MULTI"); err != nil {
+				return true, false, err
+			}
+
+			if _, err = c.do("SET", key, value, "PX", strconv.FormatInt(int64(ttl/time.Millisecond), 10)); err != nil {
+				c.do("DISCARD")
+				return true, false, err
+			}
+
+			reply, err = c.do("EXEC")
+			return true, err == nil && reply != nil, err
+		}
+	}
+
+	// The connection goes back to the pool, it must not watch the key anymore.
+	if _, unwatchErr := c.do("UNWATCH"); unwatchErr != nil && err == nil {
+		err = unwatchErr
+	}
+
+	return reply != nil, false, err
+}
+
+func (c *redisConn) readReply() (interface{}, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("cache: unable to read reply: %v", err)
+	}
+
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("cache: empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, redisError(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("cache: invalid bulk size %q", line)
+		}
+
+		if size < 0 {
+			return nil, nil
+		}
+
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(c.reader, data); err != nil {
+			return nil, fmt.Errorf("cache: unable to read reply: %v", err)
+		}
+
+		return string(data[:size]), nil
+	case '*':
+		count, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("cache: invalid array size %q", line)
+		}
+
+		if count < 0 {
+			return nil, nil
+		}
+
+		items := make([]interface{}, count)
+		for i := range items {
+			item, err := c.readReply()
+			if _, ok := err.(redisError); err != nil && !ok {
+				return nil, err
+			}
+
+			if err != nil {
+				items[i] = err
+			} else {
+				items[i] = item
+			}
+		}
+
+		return items, nil
+	default:
+		return nil, fmt.Errorf("cache: unsupported reply %q", line)
+	}
+}
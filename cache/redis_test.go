@@ -0,0 +1,282 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRedis implements just enough of the protocol to test the client.
+type fakeRedis struct {
+	sync.Mutex
+	listener net.Listener
+	data     map[string]string
+	commands []string
+
+	// abortedTransactions is the number of next EXEC commands failing as if the watched key was modified.
+	abortedTransactions int
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &fakeRedis{listener: listener, data: make(map[string]string)}
+	go f.serve()
+	return f
+}
+
+func (f *fakeRedis) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeRedis) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	var queued [][]string
+
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		f.Lock()
+		f.commands = append(f.commands, strings.Join(args, " "))
+		switch args[0] {
+		case "PING":
+			io.WriteString(conn, "+PONG\r\n")
+		case "AUTH":
+			if args[1] == "secret" {
+				io.WriteString(conn, "+OK\r\n")
+			} else {
+				io.WriteString(conn, "-ERR invalid password\r\n")
+			}
+		case "SELECT":
+			io.WriteString(conn, "+OK\r\n")
+		case "WATCH", "UNWATCH":
+			io.WriteString(conn, "+OK\r\n")
+		case "MULTI":
+			queued = [][]string{}
+			io.WriteString(conn, "+OK\r\n")
+		case "DISCARD":
+			queued = nil
+			io.WriteString(conn, "+OK\r\n")
+		case "EXEC":
+			if f.abortedTransactions > 0 {
+				f.abortedTransactions--
+				io.WriteString(conn, "*-1\r\n")
+			} else {
+				fmt.Fprintf(conn, "*%d\r\n", len(queued))
+				for _, command := range queued {
+					f.data[command[1]] = command[2]
+					io.WriteString(conn, "+OK\r\n")
+				}
+			}
+			queued = nil
+		case "SET":
+			if queued != nil {
+				queued = append(queued, args)
+				io.WriteString(conn, "+QUEUED\r\n")
+			} else {
+				f.data[args[1]] = args[2]
+				io.WriteString(conn, "+OK\r\n")
+			}
+		case "GET":
+			if value, found := f.data[args[1]]; found {
+				fmt.Fprintf(conn, "$%d\r\n%s\r\n", len(value), value)
+			} else {
+				io.WriteString(conn, "$-1\r\n")
+			}
+		case "DEL":
+			for _, key := range args[1:] {
+				delete(f.data, key)
+			}
+			fmt.Fprintf(conn, ":%d\r\n", len(args)-1)
+		default:
+			io.WriteString(conn, "-ERR unknown command\r\n")
+		}
+		f.Unlock()
+	}
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	count, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+	args := make([]string, count)
+	for i := range args {
+		if _, err := reader.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		value, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(value, "\r\n")
+	}
+
+	return args, nil
+}
+
+func TestNewRedisWithInvalidURL(t *testing.T) {
+	for _, redisURL := range []string{"http://localhost", "redis://", "redis://localhost/abc"} {
+		if _, err := NewRedis(redisURL); err == nil {
+			t.Errorf(`The URL %q should be invalid`, redisURL)
+		}
+	}
+}
+
+func TestNewRedisWithDefaultPort(t *testing.T) {
+	r, err := NewRedis("redis://:secret@localhost/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.addr != "localhost:6379" {
+		t.Errorf(`Unexpected address, got %q`, r.addr)
+	}
+
+	if r.password != "secret" {
+		t.Errorf(`Unexpected password, got %q`, r.password)
+	}
+
+	if r.db != 2 {
+		t.Errorf(`Unexpected database, got %d`, r.db)
+	}
+}
+
+func TestRedisCommands(t *testing.T) {
+	server := newFakeRedis(t)
+	defer server.listener.Close()
+
+	r, err := NewRedis("redis://:secret@" + server.listener.Addr().String() + "/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Ping(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found, err := r.Get("missing"); err != nil || found {
+		t.Errorf(`A missing key should not be found: %v`, err)
+	}
+
+	if err := r.Set("key", "some value", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	value, found, err := r.Get("key")
+	if err != nil || !found || value != "some value" {
+		t.Errorf(`Unexpected value %q (%v, %v)`, value, found, err)
+	}
+
+	if err := r.Delete("key"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found, _ := r.Get("key"); found {
+		t.Error(`The key should be deleted`)
+	}
+
+	server.Lock()
+	defer server.Unlock()
+
+	expected := []string{"AUTH secret", "SELECT 1", "PING", "GET missing", "SET key some value PX 60000", "GET key", "DEL key", "GET key"}
+	if strings.Join(server.commands, "|") != strings.Join(expected, "|") {
+		t.Errorf(`Unexpected commands: %q`, server.commands)
+	}
+}
+
+func TestRedisUpdate(t *testing.T) {
+	server := newFakeRedis(t)
+	defer server.listener.Close()
+
+	r, _ := NewRedis("redis://" + server.listener.Addr().String())
+	appendValue := func(value string) (string, error) { return value + "+", nil }
+
+	if found, err := r.Update("missing", time.Minute, appendValue); err != nil || found {
+		t.Errorf(`A missing key should not be updated: %v`, err)
+	}
+
+	r.Set("key", "value", time.Minute)
+
+	server.Lock()
+	server.abortedTransactions = 1
+	server.commands = nil
+	server.Unlock()
+
+	if found, err := r.Update("key", time.Minute, appendValue); err != nil || !found {
+		t.Fatalf(`The key should be updated: %v`, err)
+	}
+
+	if value, _, _ := r.Get("key"); value != "value+" {
+		t.Errorf(`Unexpected value %q`, value)
+	}
+
+	server.Lock()
+	defer server.Unlock()
+
+	transaction := []string{"WATCH key", "GET key", "MULTI", "SET key value+ PX 60000", "EXEC"}
+	expected := append(append(transaction, transaction...), "GET key")
+	if strings.Join(server.commands, "|") != strings.Join(expected, "|") {
+		t.Errorf(`Unexpected commands: %q`, server.commands)
+	}
+}
+
+func TestRedisUpdateWithError(t *testing.T) {
+	server := newFakeRedis(t)
+	defer server.listener.Close()
+
+	r, _ := NewRedis("redis://" + server.listener.Addr().String())
+	r.Set("key", "value", time.Minute)
+
+	_, err := r.Update("key", time.Minute, func(string) (string, error) { return "", errors.New("invalid value") })
+	if err == nil {
+		t.Fatal(`The error of the update function should be returned`)
+	}
+
+	if value, _, _ := r.Get("key"); value != "value" {
+		t.Errorf(`The value should not change, got %q`, value)
+	}
+
+	server.Lock()
+	defer server.Unlock()
+
+	if server.commands[len(server.commands)-2] != "UNWATCH" {
+		t.Errorf(`The key should be unwatched: %q`, server.commands)
+	}
+}
+
+func TestRedisWithInvalidPassword(t *testing.T) {
+	server := newFakeRedis(t)
+	defer server.listener.Close()
+
+	r, _ := NewRedis("redis://:wrong@" + server.listener.Addr().String())
+	if err := r.Ping(); err == nil {
+		t.Error(`An invalid password should return an error`)
+	}
+}
@@ -7,9 +7,12 @@ package cli // import "miniflux.app/cli"
 import (
 	"flag"
 	"fmt"
+	"time"
 
+	"miniflux.app/cache"
 	"miniflux.app/config"
 	"miniflux.app/database"
+	"miniflux.app/locale"
 	"miniflux.app/logger"
 	"miniflux.app/storage"
 	"miniflux.app/version"
@@ -96,14 +99,23 @@ func Parse() {
 		return
 	}
 
+	if translationsDir := config.Opts.TranslationsDir(); translationsDir != "" {
+		if err := locale.LoadTranslations(translationsDir); err != nil {
+			logger.Fatal("%v", err)
+		}
+	}
+
 	if config.Opts.IsDefaultDatabaseURL() {
 		logger.Info("The default value for DATABASE_URL is used")
 	}
 
 	db, err := database.NewConnectionPool(
+		config.Opts.DatabaseDriver(),
 		config.Opts.DatabaseURL(),
 		config.Opts.DatabaseMinConns(),
 		config.Opts.DatabaseMaxConns(),
+		config.Opts.DatabaseConnectionLifetime(),
+		config.Opts.DatabaseMaxIdleTime(),
 	)
 	if err != nil {
 		logger.Fatal("Unable to connect to the database: %v", err)
@@ -116,6 +128,22 @@ func Parse() {
 	}
 
 	store := storage.NewStorage(db)
+	if config.Opts.HasDatabaseStatementCache() {
+		store.EnableStatementCache()
+	}
+
+	if redisURL := config.Opts.RedisURL(); redisURL != "" {
+		redis, err := cache.NewRedis(redisURL)
+		if err != nil {
+			logger.Fatal("%v", err)
+		}
+
+		if err := redis.Ping(); err != nil {
+			logger.Fatal("Unable to connect to Redis: %v", err)
+		}
+
+		store.EnableCache(redis, time.Duration(config.Opts.CleanupRemoveSessionsDays())*24*time.Hour)
+	}
 
 	if flagResetFeedErrors {
 		store.ResetFeedErrors()
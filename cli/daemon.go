@@ -31,6 +31,7 @@ func startDaemon(store *storage.Storage) {
 
 	feedHandler := feed.NewFeedHandler(store)
 	pool := worker.NewPool(feedHandler, config.Opts.WorkerPoolSize())
+	feedHandler.WithTaskRunner(pool)
 
 	if config.Opts.HasSchedulerService() && !config.Opts.HasMaintenanceMode() {
 		scheduler.Serve(store, pool)
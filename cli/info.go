@@ -13,6 +13,7 @@ import (
 
 func info() {
 	fmt.Println("Version:", version.Version)
+	fmt.Println("Commit:", version.Commit)
 	fmt.Println("Build Date:", version.BuildDate)
 	fmt.Println("Go Version:", runtime.Version())
 	fmt.Println("Compiler:", runtime.Compiler)
@@ -11,6 +11,8 @@ import (
 	"io/ioutil"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Client holds API procedure calls.
@@ -43,6 +45,79 @@ func (c *Client) Me() (*User, error) {
 	return user, nil
 }
 
+// Preferences returns the settings saved in the given namespace.
+func (c *Client) Preferences(namespace string) (Preferences, error) {
+	body, err := c.request.Get("/v1/me/preferences/" + url.PathEscape(namespace))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var preferences Preferences
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&preferences); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return preferences, nil
+}
+
+// UpdatePreferences saves settings in the given namespace, nil values remove the keys.
+func (c *Client) UpdatePreferences(namespace string, changes map[string]interface{}) (Preferences, error) {
+	body, err := c.request.Put("/v1/me/preferences/"+url.PathEscape(namespace), changes)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var preferences Preferences
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&preferences); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return preferences, nil
+}
+
+// DeletePreferences removes all the settings of the given namespace.
+func (c *Client) DeletePreferences(namespace string) error {
+	return c.request.Delete("/v1/me/preferences/" + url.PathEscape(namespace))
+}
+
+// Version returns the build information of the server.
+func (c *Client) Version() (*VersionInfo, error) {
+	body, err := c.request.Get("/version")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var info *VersionInfo
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&info); err != nil {
+		return nil, fmt.Errorf("miniflux: json error (%v)", err)
+	}
+
+	return info, nil
+}
+
+// About returns the build information, the limits and the features of the server.
+func (c *Client) About() (*About, error) {
+	body, err := c.request.Get("/v1/about")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var about *About
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&about); err != nil {
+		return nil, fmt.Errorf("miniflux: json error (%v)", err)
+	}
+
+	return about, nil
+}
+
 // Users returns all users.
 func (c *Client) Users() (Users, error) {
 	body, err := c.request.Get("/v1/users")
@@ -60,6 +135,23 @@ func (c *Client) Users() (Users, error) {
 	return users, nil
 }
 
+// DatabaseReport returns the database size and the resources used by each user.
+func (c *Client) DatabaseReport() (*DatabaseReport, error) {
+	body, err := c.request.Get("/v1/reports")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var report *DatabaseReport
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&report); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return report, nil
+}
+
 // UserByID returns a single user.
 func (c *Client) UserByID(userID int64) (*User, error) {
 	body, err := c.request.Get(fmt.Sprintf("/v1/users/%d", userID))
@@ -207,11 +299,173 @@ func (c *Client) UpdateCategory(categoryID int64, title string) (*Category, erro
 	return category, nil
 }
 
+// SetCategoryHidden hides or shows a category in the navigation and the global unread list.
+func (c *Client) SetCategoryHidden(categoryID int64, hidden bool) (*Category, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/categories/%d", categoryID), map[string]interface{}{
+		"hidden": hidden,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var category *Category
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&category); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return category, nil
+}
+
 // DeleteCategory removes a category.
 func (c *Client) DeleteCategory(categoryID int64) error {
 	return c.request.Delete(fmt.Sprintf("/v1/categories/%d", categoryID))
 }
 
+// DeleteCategoryAndMoveFeeds moves the feeds of a category to another one and removes the category.
+func (c *Client) DeleteCategoryAndMoveFeeds(categoryID, targetCategoryID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/categories/%d?move_to=%d", categoryID, targetCategoryID))
+}
+
+// Integration gets the third-party services settings.
+func (c *Client) Integration() (*Integration, error) {
+	body, err := c.request.Get("/v1/integrations")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var integration *Integration
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&integration); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return integration, nil
+}
+
+// UpdateIntegration updates the third-party services settings.
+func (c *Client) UpdateIntegration(changes *IntegrationModification) (*Integration, error) {
+	body, err := c.request.Put("/v1/integrations", changes)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var integration *Integration
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&integration); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return integration, nil
+}
+
+// ResetIntegration disables all third-party services and removes their settings.
+func (c *Client) ResetIntegration() error {
+	return c.request.Delete("/v1/integrations")
+}
+
+// RefreshCategory refreshes all feeds of a category in the background and returns the job tracking the progress.
+func (c *Client) RefreshCategory(categoryID int64) (*RefreshJob, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/categories/%d/refresh", categoryID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var job *RefreshJob
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&job); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return job, nil
+}
+
+// DomainRules gets the instance-wide domain rules.
+func (c *Client) DomainRules() (DomainRules, error) {
+	body, err := c.request.Get("/v1/domain_rules")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var rules DomainRules
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return rules, nil
+}
+
+// BuiltinDomainRules gets the rules shipped with the application.
+func (c *Client) BuiltinDomainRules() (*BuiltinDomainRules, error) {
+	body, err := c.request.Get("/v1/domain_rules/builtin")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var rules *BuiltinDomainRules
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return rules, nil
+}
+
+// CreateDomainRule creates a new instance-wide domain rule.
+func (c *Client) CreateDomainRule(domain, rewriteRules, scraperRules string) (*DomainRule, error) {
+	body, err := c.request.Post("/v1/domain_rules", map[string]interface{}{
+		"domain":        domain,
+		"rewrite_rules": rewriteRules,
+		"scraper_rules": scraperRules,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var rule *DomainRule
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&rule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return rule, nil
+}
+
+// UpdateDomainRule updates an instance-wide domain rule.
+func (c *Client) UpdateDomainRule(ruleID int64, domain, rewriteRules, scraperRules string) (*DomainRule, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/domain_rules/%d", ruleID), map[string]interface{}{
+		"domain":        domain,
+		"rewrite_rules": rewriteRules,
+		"scraper_rules": scraperRules,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var rule *DomainRule
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&rule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return rule, nil
+}
+
+// DeleteDomainRule removes an instance-wide domain rule.
+func (c *Client) DeleteDomainRule(ruleID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/domain_rules/%d", ruleID))
+}
+
 // Feeds gets all feeds.
 func (c *Client) Feeds() (Feeds, error) {
 	body, err := c.request.Get("/v1/feeds")
@@ -229,6 +483,23 @@ func (c *Client) Feeds() (Feeds, error) {
 	return feeds, nil
 }
 
+// FetchCounters fetches the number of read and unread entries of each feed and category.
+func (c *Client) FetchCounters() (*FeedCounters, error) {
+	body, err := c.request.Get("/v1/feeds/counters")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var counters *FeedCounters
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&counters); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return counters, nil
+}
+
 // Export creates OPML file.
 func (c *Client) Export() ([]byte, error) {
 	body, err := c.request.Get("/v1/export")
@@ -315,12 +586,70 @@ func (c *Client) RefreshAllFeeds() error {
 	return err
 }
 
+// MoveFeeds moves several feeds to another category and returns the number of feeds moved.
+func (c *Client) MoveFeeds(feedIDs []int64, categoryID int64) (int64, error) {
+	type payload struct {
+		FeedIDs    []int64 `json:"feed_ids"`
+		CategoryID int64   `json:"category_id"`
+	}
+
+	body, err := c.request.Put("/v1/feeds/move", &payload{FeedIDs: feedIDs, CategoryID: categoryID})
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	var result struct {
+		Moved int64 `json:"moved"`
+	}
+
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result.Moved, nil
+}
+
 // RefreshFeed refreshes a feed.
 func (c *Client) RefreshFeed(feedID int64) error {
-	_, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/refresh", feedID), nil)
+	_, err := c.StartFeedRefresh(feedID)
 	return err
 }
 
+// StartFeedRefresh refreshes a feed in the background and returns the job tracking the progress.
+func (c *Client) StartFeedRefresh(feedID int64) (*RefreshJob, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/refresh", feedID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var job *RefreshJob
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&job); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return job, nil
+}
+
+// RefreshJob gets the progress of a refresh job.
+func (c *Client) RefreshJob(jobID string) (*RefreshJob, error) {
+	body, err := c.request.Get("/v1/jobs/" + url.PathEscape(jobID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var job *RefreshJob
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&job); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return job, nil
+}
+
 // DeleteFeed removes a feed.
 func (c *Client) DeleteFeed(feedID int64) error {
 	return c.request.Delete(fmt.Sprintf("/v1/feeds/%d", feedID))
@@ -343,6 +672,62 @@ func (c *Client) FeedIcon(feedID int64) (*FeedIcon, error) {
 	return feedIcon, nil
 }
 
+// FeedEntryCounts gets the number of entries published by a feed during each of the last days.
+func (c *Client) FeedEntryCounts(feedID int64, days int) (FeedEntryCounts, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/stats?days=%d", feedID, days))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var entryCounts FeedEntryCounts
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&entryCounts); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return entryCounts, nil
+}
+
+// FeedIcons gets the icons of all feeds.
+func (c *Client) FeedIcons() (FeedIcons, error) {
+	body, err := c.request.Get("/v1/icons")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feedIcons FeedIcons
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&feedIcons); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feedIcons, nil
+}
+
+// UpdateFeedIcon replaces the icon of a feed by the given image.
+func (c *Client) UpdateFeedIcon(feedID int64, f io.ReadCloser) (*FeedIcon, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/icon", feedID), f)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feedIcon *FeedIcon
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&feedIcon); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feedIcon, nil
+}
+
+// DeleteFeedIcon removes the icon of a feed, it will be discovered again during the next refresh.
+func (c *Client) DeleteFeedIcon(feedID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/feeds/%d/icon", feedID))
+}
+
 // FeedEntry gets a single feed entry.
 func (c *Client) FeedEntry(feedID, entryID int64) (*Entry, error) {
 	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/entries/%d", feedID, entryID))
@@ -426,12 +811,241 @@ func (c *Client) UpdateEntries(entryIDs []int64, status string) error {
 	return err
 }
 
+// EntriesByIDs fetches a list of entries by their IDs.
+func (c *Client) EntriesByIDs(entryIDs []int64) (*EntryResultSet, error) {
+	type payload struct {
+		EntryIDs []int64 `json:"entry_ids"`
+	}
+
+	body, err := c.request.Post("/v1/entries/batch", &payload{EntryIDs: entryIDs})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// EntryTombstones returns the IDs of entries deleted or removed after the given Unix timestamp.
+func (c *Client) EntryTombstones(changedAfter int64) ([]int64, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/tombstones?changed_after=%d", changedAfter))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result struct {
+		EntryIDs []int64 `json:"entry_ids"`
+	}
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result.EntryIDs, nil
+}
+
 // ToggleBookmark toggles entry bookmark value.
 func (c *Client) ToggleBookmark(entryID int64) error {
 	_, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/bookmark", entryID), nil)
 	return err
 }
 
+// UpdateReadingPosition saves the reading position of an entry, between 0 and 1.
+func (c *Client) UpdateReadingPosition(entryID int64, position float64) error {
+	_, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/position", entryID), map[string]float64{"position": position})
+	return err
+}
+
+// PlaybackQueue gets the audio entries queued for playback.
+func (c *Client) PlaybackQueue() (PlaybackQueue, error) {
+	body, err := c.request.Get("/v1/queue")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var queue PlaybackQueue
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&queue); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return queue, nil
+}
+
+// AddToPlaybackQueue appends an entry having an audio attachment to the playback queue.
+func (c *Client) AddToPlaybackQueue(entryID int64) error {
+	_, err := c.request.Put(fmt.Sprintf("/v1/queue/%d", entryID), nil)
+	return err
+}
+
+// RemoveFromPlaybackQueue removes an entry from the playback queue.
+func (c *Client) RemoveFromPlaybackQueue(entryID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/queue/%d", entryID))
+}
+
+// ReorderPlaybackQueue moves the given entries to the top of the playback queue, in the given order.
+func (c *Client) ReorderPlaybackQueue(entryIDs []int64) error {
+	_, err := c.request.Put("/v1/queue", map[string][]int64{"entry_ids": entryIDs})
+	return err
+}
+
+// SavedSearches gets the saved searches of the user.
+func (c *Client) SavedSearches() (SavedSearches, error) {
+	body, err := c.request.Get("/v1/saved-searches")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearches SavedSearches
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&savedSearches); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearches, nil
+}
+
+// CreateSavedSearch saves a search query, limited to a feed or a category when their ID is not zero.
+func (c *Client) CreateSavedSearch(query string, feedID, categoryID int64) (*SavedSearch, error) {
+	body, err := c.request.Post("/v1/saved-searches", map[string]interface{}{
+		"query":       query,
+		"feed_id":     feedID,
+		"category_id": categoryID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearch *SavedSearch
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&savedSearch); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearch, nil
+}
+
+// DeleteSavedSearch removes a saved search.
+func (c *Client) DeleteSavedSearch(savedSearchID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/saved-searches/%d", savedSearchID))
+}
+
+// EntryAnnotations gets the annotations written on an entry.
+func (c *Client) EntryAnnotations(entryID int64) (Annotations, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/annotations", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var annotations Annotations
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&annotations); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return annotations, nil
+}
+
+// CreateAnnotation highlights a text and/or adds a note to an entry.
+func (c *Client) CreateAnnotation(entryID int64, quote, note string) (*Annotation, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/entries/%d/annotations", entryID), map[string]interface{}{
+		"quote": quote,
+		"note":  note,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var annotation *Annotation
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&annotation); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return annotation, nil
+}
+
+// DeleteAnnotation removes an annotation.
+func (c *Client) DeleteAnnotation(annotationID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/annotations/%d", annotationID))
+}
+
+// ExportAnnotations gets all annotations in the W3C Web Annotation format.
+func (c *Client) ExportAnnotations() ([]byte, error) {
+	body, err := c.request.Get("/v1/annotations/export")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// TrendingTopics gets the topics shared by several feeds during the last 24 hours.
+func (c *Client) TrendingTopics() (TrendingTopics, error) {
+	body, err := c.request.Get("/v1/trending")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var topics TrendingTopics
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&topics); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return topics, nil
+}
+
+// Digest gets the unread entries received since the given date, or since the previous visit when the date is zero.
+func (c *Client) Digest(since time.Time, limit int) (*Digest, error) {
+	values := url.Values{}
+	if !since.IsZero() {
+		values.Set("since", strconv.FormatInt(since.Unix(), 10))
+	}
+
+	if limit > 0 {
+		values.Set("limit", strconv.Itoa(limit))
+	}
+
+	path := "/v1/me/digest"
+	if len(values) > 0 {
+		path += "?" + values.Encode()
+	}
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var digest *Digest
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&digest); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return digest, nil
+}
+
 func buildFilterQueryString(path string, filter *Filter) string {
 	if filter != nil {
 		values := url.Values{}
@@ -460,6 +1074,10 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("after", strconv.FormatInt(filter.After, 10))
 		}
 
+		if filter.ChangedAfter > 0 {
+			values.Set("changed_after", strconv.FormatInt(filter.ChangedAfter, 10))
+		}
+
 		if filter.AfterEntryID > 0 {
 			values.Set("after_entry_id", strconv.FormatInt(filter.AfterEntryID, 10))
 		}
@@ -476,6 +1094,10 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("starred", "1")
 		}
 
+		if filter.GloballyVisible {
+			values.Set("globally_visible", "1")
+		}
+
 		if filter.Search != "" {
 			values.Set("search", filter.Search)
 		}
@@ -488,10 +1110,18 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("feed_id", strconv.FormatInt(filter.FeedID, 10))
 		}
 
+		if filter.HasEnclosure != "" {
+			values.Set("has_enclosure", filter.HasEnclosure)
+		}
+
 		for _, status := range filter.Statuses {
 			values.Add("status", status)
 		}
 
+		if len(filter.Fields) > 0 {
+			values.Set("fields", strings.Join(filter.Fields, ","))
+		}
+
 		path = fmt.Sprintf("%s?%s", path, values.Encode())
 	}
 
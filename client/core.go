@@ -5,6 +5,7 @@
 package client // import "miniflux.app/client"
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -18,17 +19,28 @@ const (
 
 // User represents a user in the system.
 type User struct {
-	ID             int64             `json:"id"`
-	Username       string            `json:"username"`
-	Password       string            `json:"password,omitempty"`
-	IsAdmin        bool              `json:"is_admin"`
-	Theme          string            `json:"theme"`
-	Language       string            `json:"language"`
-	Timezone       string            `json:"timezone"`
-	EntryDirection string            `json:"entry_sorting_direction"`
-	EntriesPerPage int               `json:"entries_per_page"`
-	LastLoginAt    *time.Time        `json:"last_login_at"`
-	Extra          map[string]string `json:"extra"`
+	ID                        int64             `json:"id"`
+	Username                  string            `json:"username"`
+	Password                  string            `json:"password,omitempty"`
+	IsAdmin                   bool              `json:"is_admin"`
+	Theme                     string            `json:"theme"`
+	Language                  string            `json:"language"`
+	Timezone                  string            `json:"timezone"`
+	EntryDirection            string            `json:"entry_sorting_direction"`
+	EntriesPerPage            int               `json:"entries_per_page"`
+	HomePage                  string            `json:"home_page"`
+	EntryTimezone             string            `json:"entry_timezone"`
+	TimestampFormat           string            `json:"timestamp_format"`
+	NotificationEmail         string            `json:"notification_email"`
+	NotificationEmailVerified bool              `json:"notification_email_verified"`
+	KeepStarredEntries        bool              `json:"keep_starred_entries"`
+	ArchiveReadDays           int               `json:"archive_read_days"`
+	AcceptReceivedEntries     bool              `json:"accept_received_entries"`
+	LastLoginAt               *time.Time        `json:"last_login_at"`
+	LastSeenAt                *time.Time        `json:"last_seen_at"`
+	PreviousVisitAt           *time.Time        `json:"previous_visit_at"`
+	Extra                     map[string]string `json:"extra"`
+	Features                  *Features         `json:"features,omitempty"`
 }
 
 func (u User) String() string {
@@ -37,33 +49,282 @@ func (u User) String() string {
 
 // UserModification is used to update a user.
 type UserModification struct {
-	Username       *string `json:"username"`
-	Password       *string `json:"password"`
-	IsAdmin        *bool   `json:"is_admin"`
-	Theme          *string `json:"theme"`
-	Language       *string `json:"language"`
-	Timezone       *string `json:"timezone"`
-	EntryDirection *string `json:"entry_sorting_direction"`
-	EntriesPerPage *int    `json:"entries_per_page"`
+	Username              *string `json:"username"`
+	Password              *string `json:"password"`
+	IsAdmin               *bool   `json:"is_admin"`
+	Theme                 *string `json:"theme"`
+	Language              *string `json:"language"`
+	Timezone              *string `json:"timezone"`
+	EntryDirection        *string `json:"entry_sorting_direction"`
+	EntriesPerPage        *int    `json:"entries_per_page"`
+	HomePage              *string `json:"home_page"`
+	EntryTimezone         *string `json:"entry_timezone"`
+	TimestampFormat       *string `json:"timestamp_format"`
+	NotificationEmail     *string `json:"notification_email"`
+	KeepStarredEntries    *bool   `json:"keep_starred_entries"`
+	ArchiveReadDays       *int    `json:"archive_read_days"`
+	AcceptReceivedEntries *bool   `json:"accept_received_entries"`
 }
 
+// Preferences holds the settings saved by a client in its namespace.
+type Preferences map[string]json.RawMessage
+
 // Users represents a list of users.
 type Users []User
 
+// DatabaseReport gives an overview of the database usage.
+type DatabaseReport struct {
+	DatabaseSize int64         `json:"database_size"`
+	StorageBytes int64         `json:"storage_bytes"`
+	FeedCount    int           `json:"feed_count"`
+	EntryCount   int           `json:"entry_count"`
+	Users        []*UserReport `json:"users"`
+}
+
+// UserReport represents the resources used by a user.
+type UserReport struct {
+	UserID       int64      `json:"user_id"`
+	Username     string     `json:"username"`
+	IsAdmin      bool       `json:"is_admin"`
+	FeedCount    int        `json:"feed_count"`
+	EntryCount   int        `json:"entry_count"`
+	StorageBytes int64      `json:"storage_bytes"`
+	LastLoginAt  *time.Time `json:"last_login_at"`
+	LastSeenAt   *time.Time `json:"last_seen_at"`
+}
+
+// VersionInfo represents the build information of the server.
+type VersionInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+}
+
+// About represents the build information, the limits and the optional features of the server.
+type About struct {
+	VersionInfo
+	Limits   AboutLimits   `json:"limits"`
+	Features AboutFeatures `json:"features"`
+}
+
+// AboutLimits represents the limits of the server.
+type AboutLimits struct {
+	PollingFrequency      int   `json:"polling_frequency"`
+	BatchSize             int   `json:"batch_size"`
+	HTTPClientTimeout     int   `json:"http_client_timeout"`
+	HTTPClientMaxBodySize int64 `json:"http_client_max_body_size"`
+	ArchiveReadDays       int   `json:"archive_read_days"`
+	ArchiveUnreadDays     int   `json:"archive_unread_days"`
+}
+
+// AboutFeatures represents the optional features enabled on the server.
+type AboutFeatures struct {
+	PollingScheduler    string `json:"polling_scheduler"`
+	ProxyImages         string `json:"proxy_images"`
+	OAuth2Provider      string `json:"oauth2_provider"`
+	FetchViaProxy       bool   `json:"fetch_via_proxy"`
+	YouTubeAPI          bool   `json:"youtube_api"`
+	FeedRecommendations bool   `json:"feed_recommendations"`
+}
+
+// Features represents the optional subsystems available to a user.
+type Features struct {
+	ImageProxy    bool     `json:"image_proxy"`
+	SearchBackend string   `json:"search_backend"`
+	WebSub        bool     `json:"websub"`
+	Integrations  []string `json:"integrations"`
+}
+
 // Category represents a feed category.
 type Category struct {
 	ID     int64  `json:"id,omitempty"`
 	Title  string `json:"title,omitempty"`
 	UserID int64  `json:"user_id,omitempty"`
+	Hidden bool   `json:"hidden"`
 }
 
 func (c Category) String() string {
 	return fmt.Sprintf("#%d %s", c.ID, c.Title)
 }
 
+// Integration represents third-party services settings.
+type Integration struct {
+	UserID                 int64  `json:"user_id"`
+	PinboardEnabled        bool   `json:"pinboard_enabled"`
+	PinboardToken          string `json:"pinboard_token"`
+	PinboardTags           string `json:"pinboard_tags"`
+	PinboardMarkAsUnread   bool   `json:"pinboard_mark_as_unread"`
+	InstapaperEnabled      bool   `json:"instapaper_enabled"`
+	InstapaperUsername     string `json:"instapaper_username"`
+	InstapaperPassword     string `json:"instapaper_password"`
+	FeverEnabled           bool   `json:"fever_enabled"`
+	FeverUsername          string `json:"fever_username"`
+	FeverPassword          string `json:"fever_password"`
+	WallabagEnabled        bool   `json:"wallabag_enabled"`
+	WallabagURL            string `json:"wallabag_url"`
+	WallabagClientID       string `json:"wallabag_client_id"`
+	WallabagClientSecret   string `json:"wallabag_client_secret"`
+	WallabagUsername       string `json:"wallabag_username"`
+	WallabagPassword       string `json:"wallabag_password"`
+	NunuxKeeperEnabled     bool   `json:"nunux_keeper_enabled"`
+	NunuxKeeperURL         string `json:"nunux_keeper_url"`
+	NunuxKeeperAPIKey      string `json:"nunux_keeper_api_key"`
+	PocketEnabled          bool   `json:"pocket_enabled"`
+	PocketAccessToken      string `json:"pocket_access_token"`
+	PocketConsumerKey      string `json:"pocket_consumer_key"`
+	RSSBridgeEnabled       bool   `json:"rssbridge_enabled"`
+	RSSBridgeURL           string `json:"rssbridge_url"`
+	PinboardCategoryTag    bool   `json:"pinboard_category_tag"`
+	PinboardEntryTags      bool   `json:"pinboard_entry_tags"`
+	PinboardPromptTags     bool   `json:"pinboard_prompt_tags"`
+	WallabagTags           string `json:"wallabag_tags"`
+	WallabagArchive        bool   `json:"wallabag_archive"`
+	InstapaperFolderID     string `json:"instapaper_folder_id"`
+	PocketCategoryTag      bool   `json:"pocket_category_tag"`
+	CustomBookmarkEnabled  bool   `json:"custom_bookmark_enabled"`
+	CustomBookmarkURL      string `json:"custom_bookmark_url"`
+	CustomBookmarkMethod   string `json:"custom_bookmark_method"`
+	CustomBookmarkHeaders  string `json:"custom_bookmark_headers"`
+	CustomBookmarkBody     string `json:"custom_bookmark_body"`
+	MarkdownEnabled        bool   `json:"markdown_enabled"`
+	MarkdownWebDAVURL      string `json:"markdown_webdav_url"`
+	MarkdownWebDAVUsername string `json:"markdown_webdav_username"`
+	MarkdownWebDAVPassword string `json:"markdown_webdav_password"`
+	MarkdownStarred        bool   `json:"markdown_starred"`
+	HypothesisEnabled      bool   `json:"hypothesis_enabled"`
+	HypothesisToken        string `json:"hypothesis_token"`
+	HypothesisGroup        string `json:"hypothesis_group"`
+	ZoteroEnabled          bool   `json:"zotero_enabled"`
+	ZoteroLibraryType      string `json:"zotero_library_type"`
+	ZoteroLibraryID        string `json:"zotero_library_id"`
+	ZoteroAPIKey           string `json:"zotero_api_key"`
+	ZoteroCollectionKey    string `json:"zotero_collection_key"`
+	KarakeepEnabled        bool   `json:"karakeep_enabled"`
+	KarakeepURL            string `json:"karakeep_url"`
+	KarakeepAPIKey         string `json:"karakeep_api_key"`
+	KarakeepTags           string `json:"karakeep_tags"`
+	EspialEnabled          bool   `json:"espial_enabled"`
+	EspialURL              string `json:"espial_url"`
+	EspialAPIKey           string `json:"espial_api_key"`
+	EspialTags             string `json:"espial_tags"`
+	LinkAceEnabled         bool   `json:"linkace_enabled"`
+	LinkAceURL             string `json:"linkace_url"`
+	LinkAceAPIKey          string `json:"linkace_api_key"`
+	LinkAceTags            string `json:"linkace_tags"`
+	LinkAceLists           string `json:"linkace_lists"`
+	LinkAceCategoryTag     bool   `json:"linkace_category_tag"`
+	LinkAceIsPrivate       bool   `json:"linkace_is_private"`
+	WaybackEnabled         bool   `json:"wayback_enabled"`
+	ArchiveBoxEnabled      bool   `json:"archivebox_enabled"`
+	ArchiveBoxURL          string `json:"archivebox_url"`
+	ArchiveBoxAPIKey       string `json:"archivebox_api_key"`
+	ArchiveBoxTags         string `json:"archivebox_tags"`
+	NtfyEnabled            bool   `json:"ntfy_enabled"`
+	NtfyTopicURL           string `json:"ntfy_topic_url"`
+	NtfyToken              string `json:"ntfy_token"`
+
+	CategoryRoutes map[string][]int64 `json:"category_routes"`
+}
+
+// IntegrationModification represents changes to third-party services settings.
+type IntegrationModification struct {
+	PinboardEnabled        *bool   `json:"pinboard_enabled"`
+	PinboardToken          *string `json:"pinboard_token"`
+	PinboardTags           *string `json:"pinboard_tags"`
+	PinboardMarkAsUnread   *bool   `json:"pinboard_mark_as_unread"`
+	InstapaperEnabled      *bool   `json:"instapaper_enabled"`
+	InstapaperUsername     *string `json:"instapaper_username"`
+	InstapaperPassword     *string `json:"instapaper_password"`
+	FeverEnabled           *bool   `json:"fever_enabled"`
+	FeverUsername          *string `json:"fever_username"`
+	FeverPassword          *string `json:"fever_password"`
+	WallabagEnabled        *bool   `json:"wallabag_enabled"`
+	WallabagURL            *string `json:"wallabag_url"`
+	WallabagClientID       *string `json:"wallabag_client_id"`
+	WallabagClientSecret   *string `json:"wallabag_client_secret"`
+	WallabagUsername       *string `json:"wallabag_username"`
+	WallabagPassword       *string `json:"wallabag_password"`
+	NunuxKeeperEnabled     *bool   `json:"nunux_keeper_enabled"`
+	NunuxKeeperURL         *string `json:"nunux_keeper_url"`
+	NunuxKeeperAPIKey      *string `json:"nunux_keeper_api_key"`
+	PocketEnabled          *bool   `json:"pocket_enabled"`
+	PocketAccessToken      *string `json:"pocket_access_token"`
+	PocketConsumerKey      *string `json:"pocket_consumer_key"`
+	RSSBridgeEnabled       *bool   `json:"rssbridge_enabled"`
+	RSSBridgeURL           *string `json:"rssbridge_url"`
+	PinboardCategoryTag    *bool   `json:"pinboard_category_tag"`
+	PinboardEntryTags      *bool   `json:"pinboard_entry_tags"`
+	PinboardPromptTags     *bool   `json:"pinboard_prompt_tags"`
+	WallabagTags           *string `json:"wallabag_tags"`
+	WallabagArchive        *bool   `json:"wallabag_archive"`
+	InstapaperFolderID     *string `json:"instapaper_folder_id"`
+	PocketCategoryTag      *bool   `json:"pocket_category_tag"`
+	CustomBookmarkEnabled  *bool   `json:"custom_bookmark_enabled"`
+	CustomBookmarkURL      *string `json:"custom_bookmark_url"`
+	CustomBookmarkMethod   *string `json:"custom_bookmark_method"`
+	CustomBookmarkHeaders  *string `json:"custom_bookmark_headers"`
+	CustomBookmarkBody     *string `json:"custom_bookmark_body"`
+	MarkdownEnabled        *bool   `json:"markdown_enabled"`
+	MarkdownWebDAVURL      *string `json:"markdown_webdav_url"`
+	MarkdownWebDAVUsername *string `json:"markdown_webdav_username"`
+	MarkdownWebDAVPassword *string `json:"markdown_webdav_password"`
+	MarkdownStarred        *bool   `json:"markdown_starred"`
+	HypothesisEnabled      *bool   `json:"hypothesis_enabled"`
+	HypothesisToken        *string `json:"hypothesis_token"`
+	HypothesisGroup        *string `json:"hypothesis_group"`
+	ZoteroEnabled          *bool   `json:"zotero_enabled"`
+	ZoteroLibraryType      *string `json:"zotero_library_type"`
+	ZoteroLibraryID        *string `json:"zotero_library_id"`
+	ZoteroAPIKey           *string `json:"zotero_api_key"`
+	ZoteroCollectionKey    *string `json:"zotero_collection_key"`
+	KarakeepEnabled        *bool   `json:"karakeep_enabled"`
+	KarakeepURL            *string `json:"karakeep_url"`
+	KarakeepAPIKey         *string `json:"karakeep_api_key"`
+	KarakeepTags           *string `json:"karakeep_tags"`
+	EspialEnabled          *bool   `json:"espial_enabled"`
+	EspialURL              *string `json:"espial_url"`
+	EspialAPIKey           *string `json:"espial_api_key"`
+	EspialTags             *string `json:"espial_tags"`
+	LinkAceEnabled         *bool   `json:"linkace_enabled"`
+	LinkAceURL             *string `json:"linkace_url"`
+	LinkAceAPIKey          *string `json:"linkace_api_key"`
+	LinkAceTags            *string `json:"linkace_tags"`
+	LinkAceLists           *string `json:"linkace_lists"`
+	LinkAceCategoryTag     *bool   `json:"linkace_category_tag"`
+	LinkAceIsPrivate       *bool   `json:"linkace_is_private"`
+	WaybackEnabled         *bool   `json:"wayback_enabled"`
+	ArchiveBoxEnabled      *bool   `json:"archivebox_enabled"`
+	ArchiveBoxURL          *string `json:"archivebox_url"`
+	ArchiveBoxAPIKey       *string `json:"archivebox_api_key"`
+	ArchiveBoxTags         *string `json:"archivebox_tags"`
+	NtfyEnabled            *bool   `json:"ntfy_enabled"`
+	NtfyTopicURL           *string `json:"ntfy_topic_url"`
+	NtfyToken              *string `json:"ntfy_token"`
+
+	CategoryRoutes map[string][]int64 `json:"category_routes,omitempty"`
+}
+
 // Categories represents a list of categories.
 type Categories []*Category
 
+// DomainRule represents instance-wide rewrite and scraper rules for a website.
+type DomainRule struct {
+	ID           int64     `json:"id"`
+	Domain       string    `json:"domain"`
+	RewriteRules string    `json:"rewrite_rules"`
+	ScraperRules string    `json:"scraper_rules"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// DomainRules represents a list of domain rules.
+type DomainRules []*DomainRule
+
+// BuiltinDomainRules represents the rules shipped with the application.
+type BuiltinDomainRules struct {
+	RewriteRules map[string]string `json:"rewrite_rules"`
+	ScraperRules map[string]string `json:"scraper_rules"`
+}
+
 // Subscription represents a feed subscription.
 type Subscription struct {
 	Title string `json:"title"`
@@ -80,99 +341,290 @@ type Subscriptions []*Subscription
 
 // Feed represents a Miniflux feed.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at,omitempty"`
-	EtagHeader         string    `json:"etag_header,omitempty"`
-	LastModifiedHeader string    `json:"last_modified_header,omitempty"`
-	ParsingErrorMsg    string    `json:"parsing_error_message,omitempty"`
-	ParsingErrorCount  int       `json:"parsing_error_count,omitempty"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	Crawler            bool      `json:"crawler"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Category           *Category `json:"category,omitempty"`
+	ID                   int64      `json:"id"`
+	UserID               int64      `json:"user_id"`
+	FeedURL              string     `json:"feed_url"`
+	SiteURL              string     `json:"site_url"`
+	Title                string     `json:"title"`
+	CustomTitle          string     `json:"custom_title"`
+	CheckedAt            time.Time  `json:"checked_at,omitempty"`
+	EtagHeader           string     `json:"etag_header,omitempty"`
+	LastModifiedHeader   string     `json:"last_modified_header,omitempty"`
+	ParsingErrorMsg      string     `json:"parsing_error_message,omitempty"`
+	ParsingErrorCount    int        `json:"parsing_error_count,omitempty"`
+	ScraperRules         string     `json:"scraper_rules"`
+	RewriteRules         string     `json:"rewrite_rules"`
+	Crawler              bool       `json:"crawler"`
+	UserAgent            string     `json:"user_agent"`
+	Username             string     `json:"username"`
+	Password             string     `json:"password"`
+	OpenExternalLink     bool       `json:"open_external_link"`
+	Encoding             string     `json:"encoding"`
+	BearerToken          string     `json:"bearer_token"`
+	OAuth2TokenURL       string     `json:"oauth2_token_url"`
+	OAuth2ClientID       string     `json:"oauth2_client_id"`
+	OAuth2ClientSecret   string     `json:"oauth2_client_secret"`
+	OAuth2Scopes         string     `json:"oauth2_scopes"`
+	FetchScores          bool       `json:"fetch_scores"`
+	MinimumScore         int        `json:"minimum_score"`
+	BlockedAuthors       string     `json:"blocked_authors"`
+	AutoStar             bool       `json:"auto_star"`
+	ArchivePages         bool       `json:"archive_pages"`
+	HideGlobally         bool       `json:"hide_globally"`
+	NotifyErrors         bool       `json:"notify_errors"`
+	OrphanedSaves        bool       `json:"orphaned_saves"`
+	CronExpression       string     `json:"cron_expression"`
+	RequestTimeout       int        `json:"request_timeout"`
+	MaxBodySize          int        `json:"max_body_size"`
+	Dead                 bool       `json:"dead"`
+	PreviousFeedURL      string     `json:"previous_feed_url"`
+	FeedURLUpdatedAt     *time.Time `json:"feed_url_updated_at"`
+	CanonicalFeedURL     string     `json:"canonical_feed_url"`
+	LastFetchURL         string     `json:"last_fetch_url"`
+	LastFetchContentType string     `json:"last_fetch_content_type"`
+	LastFetchSize        int        `json:"last_fetch_size"`
+	LastReadAt           *time.Time `json:"last_read_at"`
+	Unused               bool       `json:"unused"`
+	LastEntryAt          *time.Time `json:"last_entry_at"`
+	Dormant              bool       `json:"dormant"`
+	Category             *Category  `json:"category,omitempty"`
 }
 
 // FeedModification represents changes for a feed.
 type FeedModification struct {
-	FeedURL      *string `json:"feed_url"`
-	SiteURL      *string `json:"site_url"`
-	Title        *string `json:"title"`
-	ScraperRules *string `json:"scraper_rules"`
-	RewriteRules *string `json:"rewrite_rules"`
-	Crawler      *bool   `json:"crawler"`
-	UserAgent    *string `json:"user_agent"`
-	Username     *string `json:"username"`
-	Password     *string `json:"password"`
-	CategoryID   *int64  `json:"category_id"`
+	FeedURL            *string `json:"feed_url"`
+	SiteURL            *string `json:"site_url"`
+	Title              *string `json:"title"`
+	ScraperRules       *string `json:"scraper_rules"`
+	RewriteRules       *string `json:"rewrite_rules"`
+	Crawler            *bool   `json:"crawler"`
+	UserAgent          *string `json:"user_agent"`
+	Username           *string `json:"username"`
+	Password           *string `json:"password"`
+	CategoryID         *int64  `json:"category_id"`
+	OpenExternalLink   *bool   `json:"open_external_link"`
+	Encoding           *string `json:"encoding"`
+	BearerToken        *string `json:"bearer_token"`
+	OAuth2TokenURL     *string `json:"oauth2_token_url"`
+	OAuth2ClientID     *string `json:"oauth2_client_id"`
+	OAuth2ClientSecret *string `json:"oauth2_client_secret"`
+	OAuth2Scopes       *string `json:"oauth2_scopes"`
+	FetchScores        *bool   `json:"fetch_scores"`
+	MinimumScore       *int    `json:"minimum_score"`
+	BlockedAuthors     *string `json:"blocked_authors"`
+	AutoStar           *bool   `json:"auto_star"`
+	ArchivePages       *bool   `json:"archive_pages"`
+	HideGlobally       *bool   `json:"hide_globally"`
+	NotifyErrors       *bool   `json:"notify_errors"`
+	CronExpression     *string `json:"cron_expression"`
+	RequestTimeout     *int    `json:"request_timeout"`
+	MaxBodySize        *int    `json:"max_body_size"`
+	CustomTitle        *string `json:"custom_title"`
+}
+
+// FeedCounters represents the number of read and unread entries of each feed and category.
+type FeedCounters struct {
+	ReadCounters           map[int64]int `json:"reads"`
+	UnreadCounters         map[int64]int `json:"unreads"`
+	CategoryReadCounters   map[int64]int `json:"category_reads"`
+	CategoryUnreadCounters map[int64]int `json:"category_unreads"`
+}
+
+// Refresh job statuses.
+const (
+	RefreshJobStatusPending = "pending"
+	RefreshJobStatusRunning = "running"
+	RefreshJobStatusDone    = "done"
+	RefreshJobStatusFailed  = "failed"
+)
+
+// RefreshJob represents a feed refresh running in the background.
+type RefreshJob struct {
+	ID         string     `json:"id"`
+	UserID     int64      `json:"user_id"`
+	FeedIDs    []int64    `json:"feed_ids"`
+	Status     string     `json:"status"`
+	Completed  int        `json:"completed"`
+	EntryCount int        `json:"entry_count"`
+	Errors     []string   `json:"errors"`
+	CreatedAt  time.Time  `json:"created_at"`
+	FinishedAt *time.Time `json:"finished_at"`
 }
 
 // FeedIcon represents the feed icon.
 type FeedIcon struct {
 	ID       int64  `json:"id"`
+	FeedID   int64  `json:"feed_id"`
 	MimeType string `json:"mime_type"`
 	Data     string `json:"data"`
 }
 
+// FeedIcons represents a list of feed icons.
+type FeedIcons []*FeedIcon
+
 // Feeds represents a list of feeds.
 type Feeds []*Feed
 
+// FeedEntryCount represents the number of entries published by a feed during one day.
+type FeedEntryCount struct {
+	Day   string `json:"day"`
+	Count int    `json:"count"`
+}
+
+// FeedEntryCounts represents the daily volume of a feed.
+type FeedEntryCounts []*FeedEntryCount
+
 // Entry represents a subscription item in the system.
 type Entry struct {
-	ID         int64      `json:"id"`
-	UserID     int64      `json:"user_id"`
-	FeedID     int64      `json:"feed_id"`
-	Status     string     `json:"status"`
-	Hash       string     `json:"hash"`
-	Title      string     `json:"title"`
-	URL        string     `json:"url"`
-	Date       time.Time  `json:"published_at"`
-	Content    string     `json:"content"`
-	Author     string     `json:"author"`
-	ShareCode  string     `json:"share_code"`
-	Starred    bool       `json:"starred"`
-	Enclosures Enclosures `json:"enclosures,omitempty"`
-	Feed       *Feed      `json:"feed,omitempty"`
+	ID               int64      `json:"id"`
+	UserID           int64      `json:"user_id"`
+	FeedID           int64      `json:"feed_id"`
+	Status           string     `json:"status"`
+	Hash             string     `json:"hash"`
+	Title            string     `json:"title"`
+	URL              string     `json:"url"`
+	Date             time.Time  `json:"published_at"`
+	ChangedAt        time.Time  `json:"changed_at"`
+	Content          string     `json:"content"`
+	Author           string     `json:"author"`
+	ShareCode        string     `json:"share_code"`
+	Starred          bool       `json:"starred"`
+	RemovedTrackers  int        `json:"removed_trackers"`
+	ReadingTime      int        `json:"reading_time"`
+	Score            int        `json:"score"`
+	CommentsCount    int        `json:"comments_count"`
+	ReadingPosition  float64    `json:"reading_position"`
+	Tags             []string   `json:"tags"`
+	Latitude         *float64   `json:"latitude,omitempty"`
+	Longitude        *float64   `json:"longitude,omitempty"`
+	Enclosures       Enclosures `json:"enclosures,omitempty"`
+	PrimaryEnclosure *Enclosure `json:"primary_enclosure,omitempty"`
+	Archives         Archives   `json:"archives,omitempty"`
+	Feed             *Feed      `json:"feed,omitempty"`
 }
 
 // Entries represents a list of entries.
 type Entries []*Entry
 
+// TrendingTopic represents a cluster of related entries published by several feeds.
+type TrendingTopic struct {
+	Title   string  `json:"title"`
+	Score   int     `json:"score"`
+	Entries Entries `json:"entries"`
+}
+
+// TrendingTopics represents a list of trending topics.
+type TrendingTopics []*TrendingTopic
+
+// Digest summarizes the unread entries received since the last visit.
+type Digest struct {
+	Since      time.Time         `json:"since"`
+	Total      int               `json:"total"`
+	Categories []*DigestCategory `json:"categories"`
+	Entries    Entries           `json:"entries"`
+}
+
+// DigestCategory represents the number of new entries in a category.
+type DigestCategory struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+	Count int    `json:"count"`
+}
+
+// Annotation represents a highlight and/or a note written on an entry.
+type Annotation struct {
+	ID           int64     `json:"id"`
+	UserID       int64     `json:"user_id"`
+	EntryID      int64     `json:"entry_id"`
+	Quote        string    `json:"quote"`
+	Note         string    `json:"note"`
+	HypothesisID string    `json:"hypothesis_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	EntryURL     string    `json:"entry_url"`
+	EntryTitle   string    `json:"entry_title"`
+}
+
+// Annotations represents a list of annotations.
+type Annotations []*Annotation
+
+// QueueItem represents an audio entry waiting in the playback queue.
+type QueueItem struct {
+	EntryID   int64  `json:"entry_id"`
+	Position  int    `json:"position"`
+	Title     string `json:"title"`
+	FeedID    int64  `json:"feed_id"`
+	FeedTitle string `json:"feed_title"`
+	AudioURL  string `json:"audio_url"`
+	MimeType  string `json:"mime_type"`
+	Duration  int    `json:"duration"`
+}
+
+// PlaybackQueue represents the queued audio entries, in playback order.
+type PlaybackQueue []*QueueItem
+
+// SavedSearch represents a search query saved by the user, optionally limited to a feed or a category.
+type SavedSearch struct {
+	ID            int64     `json:"id"`
+	UserID        int64     `json:"user_id"`
+	Query         string    `json:"query"`
+	FeedID        int64     `json:"feed_id"`
+	CategoryID    int64     `json:"category_id"`
+	FeedTitle     string    `json:"feed_title,omitempty"`
+	CategoryTitle string    `json:"category_title,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// SavedSearches represents a list of saved searches.
+type SavedSearches []*SavedSearch
+
 // Enclosure represents an attachment.
 type Enclosure struct {
-	ID       int64  `json:"id"`
-	UserID   int64  `json:"user_id"`
-	EntryID  int64  `json:"entry_id"`
-	URL      string `json:"url"`
-	MimeType string `json:"mime_type"`
-	Size     int    `json:"size"`
+	ID        int64  `json:"id"`
+	UserID    int64  `json:"user_id"`
+	EntryID   int64  `json:"entry_id"`
+	URL       string `json:"url"`
+	MimeType  string `json:"mime_type"`
+	Size      int    `json:"size"`
+	Duration  int    `json:"duration"`
+	PosterURL string `json:"poster_url"`
 }
 
 // Enclosures represents a list of attachments.
 type Enclosures []*Enclosure
 
+// Archive represents a snapshot of the entry web page kept by an archiving service.
+type Archive struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	EntryID   int64     `json:"entry_id"`
+	Service   string    `json:"service"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Archives represents a list of snapshots.
+type Archives []*Archive
+
 // Filter is used to filter entries.
 type Filter struct {
-	Status        string
-	Offset        int
-	Limit         int
-	Order         string
-	Direction     string
-	Starred       bool
-	Before        int64
-	After         int64
-	BeforeEntryID int64
-	AfterEntryID  int64
-	Search        string
-	CategoryID    int64
-	FeedID        int64
-	Statuses      []string
+	Status          string
+	Offset          int
+	Limit           int
+	Order           string
+	Direction       string
+	Starred         bool
+	GloballyVisible bool
+	Before          int64
+	After           int64
+	ChangedAfter    int64
+	BeforeEntryID   int64
+	AfterEntryID    int64
+	Search          string
+	CategoryID      int64
+	FeedID          int64
+	HasEnclosure    string
+	Statuses        []string
+	Fields          []string
 }
 
 // EntryResultSet represents the response when fetching entries.
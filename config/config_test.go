@@ -7,7 +7,9 @@ package config // import "miniflux.app/config"
 import (
 	"io/ioutil"
 	"os"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestDebugModeOn(t *testing.T) {
@@ -480,6 +482,70 @@ func TestCleanupFrequencyHours(t *testing.T) {
 	}
 }
 
+func TestDefaultTrendingFrequencyMinutesValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultTrendingFrequencyMinutes
+	result := opts.TrendingFrequencyMinutes()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRENDING_FREQUENCY_MINUTES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestTrendingFrequencyMinutes(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TRENDING_FREQUENCY_MINUTES", "15")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 15
+	result := opts.TrendingFrequencyMinutes()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRENDING_FREQUENCY_MINUTES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestFeedRecommendationsDisabledByDefault(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasFeedRecommendations() {
+		t.Fatal(`Feed recommendations should be disabled by default`)
+	}
+}
+
+func TestFeedRecommendations(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("FEED_RECOMMENDATIONS", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasFeedRecommendations() {
+		t.Fatal(`Feed recommendations should be enabled`)
+	}
+}
+
 func TestDefaultCleanupArchiveReadDaysValue(t *testing.T) {
 	os.Clearenv()
 
@@ -516,6 +582,41 @@ func TestCleanupArchiveReadDays(t *testing.T) {
 	}
 }
 
+func TestDefaultCleanupArchiveReadDaysMaxValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 365
+	result := opts.CleanupArchiveReadDaysMax()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_ARCHIVE_READ_DAYS_MAX value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCleanupArchiveReadDaysMax(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CLEANUP_ARCHIVE_READ_DAYS_MAX", "730")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 730
+	result := opts.CleanupArchiveReadDaysMax()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_ARCHIVE_READ_DAYS_MAX value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestDefaultCleanupRemoveSessionsDaysValue(t *testing.T) {
 	os.Clearenv()
 
@@ -761,6 +862,42 @@ func TestDefautSchedulerCountBasedMinInterval(t *testing.T) {
 	}
 }
 
+func TestSchedulerEntryFrequencyWindowDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_WINDOW_DAYS", "28")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 28
+	result := opts.SchedulerEntryFrequencyWindowDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCHEDULER_ENTRY_FREQUENCY_WINDOW_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSchedulerEntryFrequencyWindowDaysOutOfRange(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_WINDOW_DAYS", "0")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSchedulerEntryFrequencyWindowDays
+	result := opts.SchedulerEntryFrequencyWindowDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCHEDULER_ENTRY_FREQUENCY_WINDOW_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestOAuth2UserCreationWhenUnset(t *testing.T) {
 	os.Clearenv()
 
@@ -1181,6 +1318,166 @@ func TestPocketConsumerKeyFromUserPrefs(t *testing.T) {
 	}
 }
 
+func TestInstapaperFullAPI(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("INSTAPAPER_CONSUMER_KEY", "key")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasInstapaperFullAPI() {
+		t.Fatal(`The Instapaper full API should require the consumer secret`)
+	}
+
+	os.Setenv("INSTAPAPER_CONSUMER_SECRET", "secret")
+
+	opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasInstapaperFullAPI() {
+		t.Fatal(`The Instapaper full API should be enabled`)
+	}
+
+	if opts.InstapaperConsumerKey() != "key" || opts.InstapaperConsumerSecret() != "secret" {
+		t.Errorf(`Unexpected Instapaper consumer credentials, got %q and %q`, opts.InstapaperConsumerKey(), opts.InstapaperConsumerSecret())
+	}
+}
+
+func TestYouTubeAPIKeyFromEnvVariable(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("YOUTUBE_API_KEY", "something")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "something"
+	result := opts.YouTubeAPIKey()
+
+	if result != expected {
+		t.Fatalf(`Unexpected YOUTUBE_API_KEY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestFetchYouTubeWatchTime(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.FetchYouTubeWatchTime() {
+		t.Fatal(`The YouTube watch time should not be fetched by default`)
+	}
+
+	os.Setenv("FETCH_YOUTUBE_WATCH_TIME", "1")
+
+	opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.FetchYouTubeWatchTime() {
+		t.Fatal(`Unexpected FETCH_YOUTUBE_WATCH_TIME value`)
+	}
+}
+
+func TestSnapshotQuota(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.SnapshotQuota() != defaultSnapshotQuota*1024*1024 {
+		t.Fatalf(`Unexpected default SNAPSHOT_QUOTA value, got %d`, opts.SnapshotQuota())
+	}
+
+	if opts.SnapshotRetentionDays() != defaultSnapshotRetentionDays {
+		t.Fatalf(`Unexpected default SNAPSHOT_RETENTION_DAYS value, got %d`, opts.SnapshotRetentionDays())
+	}
+
+	os.Setenv("SNAPSHOT_QUOTA", "42")
+	os.Setenv("SNAPSHOT_RETENTION_DAYS", "30")
+
+	opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.SnapshotQuota() != 42*1024*1024 {
+		t.Fatalf(`Unexpected SNAPSHOT_QUOTA value, got %d`, opts.SnapshotQuota())
+	}
+
+	if opts.SnapshotRetentionDays() != 30 {
+		t.Fatalf(`Unexpected SNAPSHOT_RETENTION_DAYS value, got %d`, opts.SnapshotRetentionDays())
+	}
+}
+
+func TestSMTPDefaultValues(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasSMTP() {
+		t.Fatal(`SMTP should be disabled by default`)
+	}
+
+	if opts.SMTPPort() != defaultSMTPPort {
+		t.Fatalf(`Unexpected SMTP_PORT value, got %d instead of %d`, opts.SMTPPort(), defaultSMTPPort)
+	}
+}
+
+func TestSMTPFromEnvVariables(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_HOST", "smtp.example.org")
+	os.Setenv("SMTP_PORT", "465")
+	os.Setenv("SMTP_USERNAME", "miniflux")
+	os.Setenv("SMTP_PASSWORD", "secret")
+	os.Setenv("SMTP_FROM", "Miniflux <miniflux@example.org>")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasSMTP() {
+		t.Fatal(`SMTP should be enabled`)
+	}
+
+	if opts.SMTPHost() != "smtp.example.org" {
+		t.Errorf(`Unexpected SMTP_HOST value, got %q`, opts.SMTPHost())
+	}
+
+	if opts.SMTPPort() != 465 {
+		t.Errorf(`Unexpected SMTP_PORT value, got %d`, opts.SMTPPort())
+	}
+
+	if opts.SMTPUsername() != "miniflux" || opts.SMTPPassword() != "secret" {
+		t.Errorf(`Unexpected SMTP credentials, got %q and %q`, opts.SMTPUsername(), opts.SMTPPassword())
+	}
+
+	if opts.SMTPFrom() != "Miniflux <miniflux@example.org>" {
+		t.Errorf(`Unexpected SMTP_FROM value, got %q`, opts.SMTPFrom())
+	}
+}
+
 func TestProxyImages(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "all")
@@ -1315,6 +1612,59 @@ func TestDefaultHTTPClientMaxBodySizeValue(t *testing.T) {
 	}
 }
 
+func TestDefaultHTTPClientAllowedSchemesValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := []string{"http", "https"}
+	result := opts.HTTPClientAllowedSchemes()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected HTTP_CLIENT_ALLOWED_SCHEMES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestHTTPClientAllowedNetworks(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWED_NETWORKS", "192.168.1.0/24, 10.0.0.1/32")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := []string{"192.168.1.0/24", "10.0.0.1/32"}
+	result := opts.HTTPClientAllowedNetworks()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected HTTP_CLIENT_ALLOWED_NETWORKS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestHTTPClientDeniedNetworks(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_DENIED_NETWORKS", "127.0.0.0/8")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := []string{"127.0.0.0/8"}
+	result := opts.HTTPClientDeniedNetworks()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf(`Unexpected HTTP_CLIENT_DENIED_NETWORKS value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestParseConfigFile(t *testing.T) {
 	content := []byte(`
  # This is a comment
@@ -1431,3 +1781,637 @@ func TestAuthProxyUserCreationAdmin(t *testing.T) {
 		t.Fatalf(`Unexpected AUTH_PROXY_USER_CREATION value, got %v instead of %v`, result, expected)
 	}
 }
+
+func TestDefaultPollingJitterMinutesValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultPollingJitterMinutes
+	result := opts.PollingJitterMinutes()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_JITTER_MINUTES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestPollingJitterMinutes(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_JITTER_MINUTES", "10")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 10
+	result := opts.PollingJitterMinutes()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_JITTER_MINUTES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestPollingSpreadBatch(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasPollingSpreadBatch() {
+		t.Fatal(`Batches should not be spread by default`)
+	}
+
+	os.Setenv("POLLING_SPREAD_BATCH", "1")
+	opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasPollingSpreadBatch() {
+		t.Fatal(`Batches should be spread`)
+	}
+}
+
+func TestDefaultPollingErrorPolicy(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.PollingParsingErrorLimit() != defaultPollingParsingErrorLimit {
+		t.Fatalf(`Unexpected POLLING_PARSING_ERROR_LIMIT value, got %v`, opts.PollingParsingErrorLimit())
+	}
+
+	if opts.PollingErrorBackoffMultiplier() != defaultPollingErrorBackoffMultiplier {
+		t.Fatalf(`Unexpected POLLING_ERROR_BACKOFF_MULTIPLIER value, got %v`, opts.PollingErrorBackoffMultiplier())
+	}
+
+	if opts.PollingErrorBackoffMaxInterval() != defaultPollingErrorBackoffMaxInterval {
+		t.Fatalf(`Unexpected POLLING_ERROR_BACKOFF_MAX_INTERVAL value, got %v`, opts.PollingErrorBackoffMaxInterval())
+	}
+
+	if opts.PollingErrorDisableAfterWeeks() != 0 {
+		t.Fatalf(`Failing feeds should not be disabled by default`)
+	}
+}
+
+func TestPollingErrorPolicy(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_PARSING_ERROR_LIMIT", "0")
+	os.Setenv("POLLING_ERROR_BACKOFF_MULTIPLIER", "4")
+	os.Setenv("POLLING_ERROR_BACKOFF_MAX_INTERVAL", "720")
+	os.Setenv("POLLING_ERROR_DISABLE_AFTER_WEEKS", "6")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.PollingParsingErrorLimit() != 0 {
+		t.Fatalf(`Unexpected POLLING_PARSING_ERROR_LIMIT value, got %v`, opts.PollingParsingErrorLimit())
+	}
+
+	if opts.PollingErrorBackoffMultiplier() != 4 {
+		t.Fatalf(`Unexpected POLLING_ERROR_BACKOFF_MULTIPLIER value, got %v`, opts.PollingErrorBackoffMultiplier())
+	}
+
+	if opts.PollingErrorBackoffMaxInterval() != 720 {
+		t.Fatalf(`Unexpected POLLING_ERROR_BACKOFF_MAX_INTERVAL value, got %v`, opts.PollingErrorBackoffMaxInterval())
+	}
+
+	if opts.PollingErrorDisableAfterWeeks() != 6 {
+		t.Fatalf(`Unexpected POLLING_ERROR_DISABLE_AFTER_WEEKS value, got %v`, opts.PollingErrorDisableAfterWeeks())
+	}
+}
+
+func TestPollingErrorNotificationThreshold(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_ERROR_NOTIFICATION_THRESHOLD", "5")
+	os.Setenv("POLLING_PARSING_ERROR_LIMIT", "10")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.PollingErrorNotificationThreshold() != 5 {
+		t.Fatalf(`Unexpected POLLING_ERROR_NOTIFICATION_THRESHOLD value, got %v`, opts.PollingErrorNotificationThreshold())
+	}
+}
+
+func TestPollingErrorNotificationThresholdAboveParsingErrorLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_ERROR_NOTIFICATION_THRESHOLD", "5")
+
+	if _, err := NewParser().ParseEnvironmentVariables(); err == nil {
+		t.Fatal(`A notification threshold above the parsing error limit must be rejected`)
+	}
+
+	os.Setenv("POLLING_PARSING_ERROR_LIMIT", "0")
+
+	if _, err := NewParser().ParseEnvironmentVariables(); err != nil {
+		t.Fatalf(`Any notification threshold should be accepted without parsing error limit: %v`, err)
+	}
+}
+
+func TestPollingErrorDisableAfterWeeksReplacesParsingErrorLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_ERROR_DISABLE_AFTER_WEEKS", "2")
+	os.Setenv("POLLING_ERROR_NOTIFICATION_THRESHOLD", "5")
+
+	opts, err := NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if result := opts.PollingParsingErrorLimit(); result != 0 {
+		t.Fatalf(`The parsing error limit should be disabled, got %v`, result)
+	}
+}
+
+func TestPollingErrorNotificationThresholdMustBePositive(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_ERROR_NOTIFICATION_THRESHOLD", "0")
+
+	if _, err := NewParser().ParseEnvironmentVariables(); err == nil {
+		t.Fatal(`A notification threshold of 0 must be rejected`)
+	}
+}
+
+func TestSessionLifetimeDaysAboveCleanupRemoveSessionsDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SESSION_LIFETIME_DAYS", "60")
+	os.Setenv("CLEANUP_REMOVE_SESSIONS_DAYS", "14")
+
+	opts, err := NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if result := opts.SessionLifetimeDays(); result != 14 {
+		t.Fatalf(`The session lifetime should be limited to the retention of the sessions, got %v`, result)
+	}
+}
+
+func TestSessionLifetimeDaysMustBePositive(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SESSION_LIFETIME_DAYS", "0")
+
+	if _, err := NewParser().ParseEnvironmentVariables(); err == nil {
+		t.Fatal(`A session lifetime of 0 must be rejected`)
+	}
+}
+
+func TestHTTPClientLimitsOutOfBounds(t *testing.T) {
+	for key, value := range map[string]string{
+		"HTTP_CLIENT_TIMEOUT":       "0",
+		"HTTP_CLIENT_MAX_BODY_SIZE": "4096",
+	} {
+		os.Clearenv()
+		os.Setenv(key, value)
+
+		if _, err := NewParser().ParseEnvironmentVariables(); err == nil {
+			t.Errorf(`%s=%s must be rejected`, key, value)
+		}
+	}
+}
+
+func TestPollingApplySelfLink(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_APPLY_SELF_LINK", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasPollingApplySelfLink() {
+		t.Fatal(`Unexpected POLLING_APPLY_SELF_LINK value`)
+	}
+}
+
+func TestDefaultPollingApplySelfLink(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasPollingApplySelfLink() {
+		t.Fatal(`The self link should not be applied by default`)
+	}
+}
+
+func TestDefaultDatabasePoolSettings(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.DatabaseDriver() != "postgres" {
+		t.Fatalf(`Unexpected DATABASE_DRIVER value, got %q`, opts.DatabaseDriver())
+	}
+
+	if opts.DatabaseConnectionLifetime() != 0 {
+		t.Fatalf(`Unexpected DATABASE_CONNECTION_LIFETIME value, got %v`, opts.DatabaseConnectionLifetime())
+	}
+
+	if opts.DatabaseMaxIdleTime() != 0 {
+		t.Fatalf(`Unexpected DATABASE_MAX_IDLE_TIME value, got %v`, opts.DatabaseMaxIdleTime())
+	}
+}
+
+func TestDatabasePoolSettings(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("DATABASE_DRIVER", "pgx")
+	os.Setenv("DATABASE_CONNECTION_LIFETIME", "30")
+	os.Setenv("DATABASE_MAX_IDLE_TIME", "5")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.DatabaseDriver() != "pgx" {
+		t.Fatalf(`Unexpected DATABASE_DRIVER value, got %q`, opts.DatabaseDriver())
+	}
+
+	if opts.DatabaseConnectionLifetime() != 30*time.Minute {
+		t.Fatalf(`Unexpected DATABASE_CONNECTION_LIFETIME value, got %v`, opts.DatabaseConnectionLifetime())
+	}
+
+	if opts.DatabaseMaxIdleTime() != 5*time.Minute {
+		t.Fatalf(`Unexpected DATABASE_MAX_IDLE_TIME value, got %v`, opts.DatabaseMaxIdleTime())
+	}
+}
+
+func TestDatabaseStatementCache(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasDatabaseStatementCache() {
+		t.Fatal(`The statement cache should be disabled by default`)
+	}
+
+	os.Setenv("DATABASE_STATEMENT_CACHE", "1")
+	opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasDatabaseStatementCache() {
+		t.Fatal(`The statement cache should be enabled`)
+	}
+}
+
+func TestRedisURL(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.RedisURL() != "" {
+		t.Fatalf(`Redis should be disabled by default, got %q`, opts.RedisURL())
+	}
+
+	os.Setenv("REDIS_URL", "redis://localhost:6379/1")
+	opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.RedisURL() != "redis://localhost:6379/1" {
+		t.Fatalf(`Unexpected REDIS_URL value, got %q`, opts.RedisURL())
+	}
+}
+
+func TestCleanupUnusedFeedsMonths(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CLEANUP_UNUSED_FEEDS_MONTHS", "3")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 3
+	result := opts.CleanupUnusedFeedsMonths()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_UNUSED_FEEDS_MONTHS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDormantFeedsDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("DORMANT_FEEDS_DAYS", "60")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 60
+	result := opts.DormantFeedsDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected DORMANT_FEEDS_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSMTPMaxRecipientsPerHour(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_HOST", "smtp.example.org")
+	os.Setenv("SMTP_FROM", "miniflux@example.org")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.SMTPMaxRecipientsPerHour() != defaultSMTPMaxRecipientsPerHour || !opts.HasEmailSharing() {
+		t.Fatalf(`Unexpected SMTP_MAX_RECIPIENTS_PER_HOUR default value, got %v`, opts.SMTPMaxRecipientsPerHour())
+	}
+}
+
+func TestDisableEmailSharing(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_HOST", "smtp.example.org")
+	os.Setenv("SMTP_FROM", "miniflux@example.org")
+	os.Setenv("SMTP_MAX_RECIPIENTS_PER_HOUR", "0")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasEmailSharing() {
+		t.Fatal(`Sending entries by email should be disabled`)
+	}
+
+	if !opts.HasSMTP() {
+		t.Fatal(`The SMTP server should still be configured for the notifications`)
+	}
+}
+
+func TestMarkdownExportDir(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("MARKDOWN_EXPORT_DIR", "/var/lib/miniflux/notes")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "/var/lib/miniflux/notes"
+	result := opts.MarkdownExportDir()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MARKDOWN_EXPORT_DIR value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCommentsFollowDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("COMMENTS_FOLLOW_DAYS", "3")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 3
+	result := opts.CommentsFollowDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected COMMENTS_FOLLOW_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultPollingSharedFetchMinutes(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultPollingSharedFetchMinutes
+	result := opts.PollingSharedFetchMinutes()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_SHARED_FETCH_MINUTES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestPollingSharedFetchMinutes(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_SHARED_FETCH_MINUTES", "0")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 0
+	result := opts.PollingSharedFetchMinutes()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_SHARED_FETCH_MINUTES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultContentSecurityPolicyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultContentSecurityPolicy
+	result := opts.ContentSecurityPolicy()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CONTENT_SECURITY_POLICY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestContentSecurityPolicy(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CONTENT_SECURITY_POLICY", "default-src 'self'; font-src https://fonts.example.org")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "default-src 'self'; font-src https://fonts.example.org"
+	result := opts.ContentSecurityPolicy()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CONTENT_SECURITY_POLICY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestReferrerPolicy(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("REFERRER_POLICY", "same-origin")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "same-origin"
+	result := opts.ReferrerPolicy()
+
+	if result != expected {
+		t.Fatalf(`Unexpected REFERRER_POLICY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultFrameOptionsValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "DENY"
+	result := opts.FrameOptions()
+
+	if result != expected {
+		t.Fatalf(`Unexpected FRAME_OPTIONS value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultCookieSameSiteValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "lax"
+	result := opts.CookieSameSite()
+
+	if result != expected {
+		t.Fatalf(`Unexpected COOKIE_SAME_SITE value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestCookieNamePrefix(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("COOKIE_NAME_PREFIX", "reader_")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "reader_"
+	result := opts.CookieNamePrefix()
+
+	if result != expected {
+		t.Fatalf(`Unexpected COOKIE_NAME_PREFIX value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultSessionLifetimeDaysValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 30
+	result := opts.SessionLifetimeDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SESSION_LIFETIME_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSessionLifetimeDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SESSION_LIFETIME_DAYS", "7")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 7
+	result := opts.SessionLifetimeDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SESSION_LIFETIME_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestTranslationsDir(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TRANSLATIONS_DIR", "/etc/miniflux/translations")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "/etc/miniflux/translations"
+	result := opts.TranslationsDir()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRANSLATIONS_DIR value, got %q instead of %q`, result, expected)
+	}
+}
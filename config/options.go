@@ -7,6 +7,7 @@ package config // import "miniflux.app/config"
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 const (
@@ -24,11 +25,26 @@ const (
 	defaultBatchSize                          = 10
 	defaultPollingScheduler                   = "round_robin"
 	defaultSchedulerEntryFrequencyMinInterval = 5
+	defaultSchedulerEntryFrequencyWindowDays  = 7
+	defaultPollingJitterMinutes               = 0
+	defaultPollingSpreadBatch                 = false
+	defaultPollingParsingErrorLimit           = 3
+	defaultPollingErrorBackoffMultiplier      = 2
+	defaultPollingErrorBackoffMaxInterval     = 24 * 60
+	defaultPollingErrorDisableAfterWeeks      = 0
+	defaultPollingErrorNotificationThreshold  = 3
+	defaultPollingApplySelfLink               = false
+	defaultPollingSharedFetchMinutes          = 10
 	defaultSchedulerEntryFrequencyMaxInterval = 24 * 60
 	defaultRunMigrations                      = false
 	defaultDatabaseURL                        = "user=postgres password=postgres dbname=miniflux2 sslmode=disable"
 	defaultDatabaseMaxConns                   = 20
 	defaultDatabaseMinConns                   = 1
+	defaultDatabaseDriver                     = "postgres"
+	defaultDatabaseConnectionLifetime         = 0
+	defaultDatabaseMaxIdleTime                = 0
+	defaultDatabaseStatementCache             = false
+	defaultRedisURL                           = ""
 	defaultListenAddr                         = "127.0.0.1:8080"
 	defaultCertFile                           = ""
 	defaultKeyFile                            = ""
@@ -36,8 +52,12 @@ const (
 	defaultCertCache                          = "/tmp/cert_cache"
 	defaultCleanupFrequencyHours              = 24
 	defaultCleanupArchiveReadDays             = 60
+	defaultCleanupArchiveReadDaysMax          = 365
 	defaultCleanupArchiveUnreadDays           = 180
+	defaultCleanupUnusedFeedsMonths           = 6
 	defaultCleanupRemoveSessionsDays          = 30
+	defaultTrendingFrequencyMinutes           = 60
+	defaultFeedRecommendations                = false
 	defaultProxyImages                        = "http-only"
 	defaultCreateAdmin                        = false
 	defaultAdminUsername                      = ""
@@ -49,9 +69,21 @@ const (
 	defaultOAuth2OidcDiscoveryEndpoint        = ""
 	defaultOAuth2Provider                     = ""
 	defaultPocketConsumerKey                  = ""
+	defaultMarkdownExportDir                  = ""
+	defaultInstapaperConsumerKey              = ""
+	defaultInstapaperConsumerSecret           = ""
+	defaultYouTubeAPIKey                      = ""
+	defaultSMTPHost                           = ""
+	defaultSMTPPort                           = 587
+	defaultSMTPUsername                       = ""
+	defaultSMTPPassword                       = ""
+	defaultSMTPFrom                           = ""
 	defaultHTTPClientTimeout                  = 20
 	defaultHTTPClientMaxBodySize              = 15
 	defaultHTTPClientProxy                    = ""
+	defaultHTTPClientAllowedSchemes           = "http,https"
+	defaultHTTPClientAllowedNetworks          = ""
+	defaultHTTPClientDeniedNetworks           = "0.0.0.0/8,10.0.0.0/8,100.64.0.0/10,127.0.0.0/8,169.254.0.0/16,172.16.0.0/12,192.168.0.0/16,198.18.0.0/15,224.0.0.0/4,::/128,::1/128,::ffff:0:0/96,64:ff9b::/96,fc00::/7,fe80::/10,ff00::/8"
 	defaultAuthProxyHeader                    = ""
 	defaultAuthProxyUserCreation              = false
 	defaultMaintenanceMode                    = false
@@ -59,6 +91,29 @@ const (
 	defaultMetricsCollector                   = false
 	defaultMetricsRefreshInterval             = 60
 	defaultMetricsAllowedNetworks             = "127.0.0.1/8"
+	defaultContentSecurityPolicy              = "default-src 'self'; img-src *; media-src *; frame-src *"
+	defaultReferrerPolicy                     = "no-referrer"
+	defaultFrameOptions                       = "DENY"
+	defaultCookieSameSite                     = "lax"
+	defaultCookieSecure                       = false
+	defaultCookieNamePrefix                   = ""
+	defaultSessionLifetimeDays                = 30
+	defaultTranslationsDir                    = ""
+	defaultCommentsFollowDays                 = 7
+	defaultDormantFeedsDays                   = 180
+	defaultSMTPMaxRecipientsPerHour           = 30
+	defaultFetchYouTubeWatchTime              = false
+	defaultSnapshotQuota                      = 500
+	defaultSnapshotRetentionDays              = 0
+)
+
+// Bounds of the HTTP client timeout in seconds and of the maximum body size in megabytes,
+// the values chosen for each feed are checked against the same bounds.
+const (
+	MinHTTPClientTimeout     = 1
+	MaxHTTPClientTimeout     = 600
+	MinHTTPClientMaxBodySize = 1
+	MaxHTTPClientMaxBodySize = 1024
 )
 
 // Options contains configuration options.
@@ -75,6 +130,11 @@ type Options struct {
 	databaseURL                        string
 	databaseMaxConns                   int
 	databaseMinConns                   int
+	databaseDriver                     string
+	databaseConnectionLifetime         int
+	databaseMaxIdleTime                int
+	databaseStatementCache             bool
+	redisURL                           string
 	runMigrations                      bool
 	listenAddr                         string
 	certFile                           string
@@ -83,12 +143,26 @@ type Options struct {
 	certKeyFile                        string
 	cleanupFrequencyHours              int
 	cleanupArchiveReadDays             int
+	cleanupArchiveReadDaysMax          int
 	cleanupArchiveUnreadDays           int
+	cleanupUnusedFeedsMonths           int
 	cleanupRemoveSessionsDays          int
+	trendingFrequencyMinutes           int
+	feedRecommendations                bool
 	pollingFrequency                   int
 	batchSize                          int
 	pollingScheduler                   string
 	schedulerEntryFrequencyMinInterval int
+	schedulerEntryFrequencyWindowDays  int
+	pollingJitterMinutes               int
+	pollingSpreadBatch                 bool
+	pollingParsingErrorLimit           int
+	pollingErrorBackoffMultiplier      int
+	pollingErrorBackoffMaxInterval     int
+	pollingErrorDisableAfterWeeks      int
+	pollingErrorNotificationThreshold  int
+	pollingApplySelfLink               bool
+	pollingSharedFetchMinutes          int
 	schedulerEntryFrequencyMaxInterval int
 	workerPoolSize                     int
 	createAdmin                        bool
@@ -102,9 +176,21 @@ type Options struct {
 	oauth2OidcDiscoveryEndpoint        string
 	oauth2Provider                     string
 	pocketConsumerKey                  string
+	markdownExportDir                  string
+	instapaperConsumerKey              string
+	instapaperConsumerSecret           string
+	youTubeAPIKey                      string
+	smtpHost                           string
+	smtpPort                           int
+	smtpUsername                       string
+	smtpPassword                       string
+	smtpFrom                           string
 	httpClientTimeout                  int
 	httpClientMaxBodySize              int64
 	httpClientProxy                    string
+	httpClientAllowedSchemes           []string
+	httpClientAllowedNetworks          []string
+	httpClientDeniedNetworks           []string
 	authProxyHeader                    string
 	authProxyUserCreation              bool
 	maintenanceMode                    bool
@@ -112,6 +198,20 @@ type Options struct {
 	metricsCollector                   bool
 	metricsRefreshInterval             int
 	metricsAllowedNetworks             []string
+	contentSecurityPolicy              string
+	referrerPolicy                     string
+	frameOptions                       string
+	cookieSameSite                     string
+	cookieSecure                       bool
+	cookieNamePrefix                   string
+	sessionLifetimeDays                int
+	translationsDir                    string
+	commentsFollowDays                 int
+	dormantFeedsDays                   int
+	smtpMaxRecipientsPerHour           int
+	fetchYouTubeWatchTime              bool
+	snapshotQuota                      int
+	snapshotRetentionDays              int
 }
 
 // NewOptions returns Options with default values.
@@ -129,6 +229,11 @@ func NewOptions() *Options {
 		databaseURL:                        defaultDatabaseURL,
 		databaseMaxConns:                   defaultDatabaseMaxConns,
 		databaseMinConns:                   defaultDatabaseMinConns,
+		databaseDriver:                     defaultDatabaseDriver,
+		databaseConnectionLifetime:         defaultDatabaseConnectionLifetime,
+		databaseMaxIdleTime:                defaultDatabaseMaxIdleTime,
+		databaseStatementCache:             defaultDatabaseStatementCache,
+		redisURL:                           defaultRedisURL,
 		runMigrations:                      defaultRunMigrations,
 		listenAddr:                         defaultListenAddr,
 		certFile:                           defaultCertFile,
@@ -137,12 +242,26 @@ func NewOptions() *Options {
 		certKeyFile:                        defaultKeyFile,
 		cleanupFrequencyHours:              defaultCleanupFrequencyHours,
 		cleanupArchiveReadDays:             defaultCleanupArchiveReadDays,
+		cleanupArchiveReadDaysMax:          defaultCleanupArchiveReadDaysMax,
 		cleanupArchiveUnreadDays:           defaultCleanupArchiveUnreadDays,
+		cleanupUnusedFeedsMonths:           defaultCleanupUnusedFeedsMonths,
 		cleanupRemoveSessionsDays:          defaultCleanupRemoveSessionsDays,
+		trendingFrequencyMinutes:           defaultTrendingFrequencyMinutes,
+		feedRecommendations:                defaultFeedRecommendations,
 		pollingFrequency:                   defaultPollingFrequency,
 		batchSize:                          defaultBatchSize,
 		pollingScheduler:                   defaultPollingScheduler,
 		schedulerEntryFrequencyMinInterval: defaultSchedulerEntryFrequencyMinInterval,
+		schedulerEntryFrequencyWindowDays:  defaultSchedulerEntryFrequencyWindowDays,
+		pollingJitterMinutes:               defaultPollingJitterMinutes,
+		pollingSpreadBatch:                 defaultPollingSpreadBatch,
+		pollingParsingErrorLimit:           defaultPollingParsingErrorLimit,
+		pollingErrorBackoffMultiplier:      defaultPollingErrorBackoffMultiplier,
+		pollingErrorBackoffMaxInterval:     defaultPollingErrorBackoffMaxInterval,
+		pollingErrorDisableAfterWeeks:      defaultPollingErrorDisableAfterWeeks,
+		pollingErrorNotificationThreshold:  defaultPollingErrorNotificationThreshold,
+		pollingApplySelfLink:               defaultPollingApplySelfLink,
+		pollingSharedFetchMinutes:          defaultPollingSharedFetchMinutes,
 		schedulerEntryFrequencyMaxInterval: defaultSchedulerEntryFrequencyMaxInterval,
 		workerPoolSize:                     defaultWorkerPoolSize,
 		createAdmin:                        defaultCreateAdmin,
@@ -154,9 +273,21 @@ func NewOptions() *Options {
 		oauth2OidcDiscoveryEndpoint:        defaultOAuth2OidcDiscoveryEndpoint,
 		oauth2Provider:                     defaultOAuth2Provider,
 		pocketConsumerKey:                  defaultPocketConsumerKey,
+		markdownExportDir:                  defaultMarkdownExportDir,
+		instapaperConsumerKey:              defaultInstapaperConsumerKey,
+		instapaperConsumerSecret:           defaultInstapaperConsumerSecret,
+		youTubeAPIKey:                      defaultYouTubeAPIKey,
+		smtpHost:                           defaultSMTPHost,
+		smtpPort:                           defaultSMTPPort,
+		smtpUsername:                       defaultSMTPUsername,
+		smtpPassword:                       defaultSMTPPassword,
+		smtpFrom:                           defaultSMTPFrom,
 		httpClientTimeout:                  defaultHTTPClientTimeout,
 		httpClientMaxBodySize:              defaultHTTPClientMaxBodySize * 1024 * 1024,
 		httpClientProxy:                    defaultHTTPClientProxy,
+		httpClientAllowedSchemes:           parseStringList(defaultHTTPClientAllowedSchemes, nil),
+		httpClientAllowedNetworks:          parseStringList(defaultHTTPClientAllowedNetworks, nil),
+		httpClientDeniedNetworks:           parseStringList(defaultHTTPClientDeniedNetworks, nil),
 		authProxyHeader:                    defaultAuthProxyHeader,
 		authProxyUserCreation:              defaultAuthProxyUserCreation,
 		maintenanceMode:                    defaultMaintenanceMode,
@@ -164,6 +295,20 @@ func NewOptions() *Options {
 		metricsCollector:                   defaultMetricsCollector,
 		metricsRefreshInterval:             defaultMetricsRefreshInterval,
 		metricsAllowedNetworks:             []string{defaultMetricsAllowedNetworks},
+		contentSecurityPolicy:              defaultContentSecurityPolicy,
+		referrerPolicy:                     defaultReferrerPolicy,
+		frameOptions:                       defaultFrameOptions,
+		cookieSameSite:                     defaultCookieSameSite,
+		cookieSecure:                       defaultCookieSecure,
+		cookieNamePrefix:                   defaultCookieNamePrefix,
+		sessionLifetimeDays:                defaultSessionLifetimeDays,
+		translationsDir:                    defaultTranslationsDir,
+		commentsFollowDays:                 defaultCommentsFollowDays,
+		dormantFeedsDays:                   defaultDormantFeedsDays,
+		smtpMaxRecipientsPerHour:           defaultSMTPMaxRecipientsPerHour,
+		fetchYouTubeWatchTime:              defaultFetchYouTubeWatchTime,
+		snapshotQuota:                      defaultSnapshotQuota,
+		snapshotRetentionDays:              defaultSnapshotRetentionDays,
 	}
 }
 
@@ -222,6 +367,31 @@ func (o *Options) DatabaseMinConns() int {
 	return o.databaseMinConns
 }
 
+// DatabaseDriver returns the name of the database/sql driver.
+func (o *Options) DatabaseDriver() string {
+	return o.databaseDriver
+}
+
+// DatabaseConnectionLifetime returns the maximum amount of time a connection may be reused.
+func (o *Options) DatabaseConnectionLifetime() time.Duration {
+	return time.Duration(o.databaseConnectionLifetime) * time.Minute
+}
+
+// DatabaseMaxIdleTime returns the maximum amount of time a connection may be idle before being closed.
+func (o *Options) DatabaseMaxIdleTime() time.Duration {
+	return time.Duration(o.databaseMaxIdleTime) * time.Minute
+}
+
+// HasDatabaseStatementCache returns true if prepared statements of frequent queries are reused.
+func (o *Options) HasDatabaseStatementCache() bool {
+	return o.databaseStatementCache
+}
+
+// RedisURL returns the Redis connection URL, an empty value disables the cache.
+func (o *Options) RedisURL() string {
+	return o.redisURL
+}
+
 // ListenAddr returns the listen address for the HTTP server.
 func (o *Options) ListenAddr() string {
 	return o.listenAddr
@@ -375,6 +545,21 @@ func (o *Options) PocketConsumerKey(defaultValue string) string {
 	return defaultValue
 }
 
+// InstapaperConsumerKey returns the Instapaper OAuth consumer key used to access the full API.
+func (o *Options) InstapaperConsumerKey() string {
+	return o.instapaperConsumerKey
+}
+
+// InstapaperConsumerSecret returns the Instapaper OAuth consumer secret.
+func (o *Options) InstapaperConsumerSecret() string {
+	return o.instapaperConsumerSecret
+}
+
+// HasInstapaperFullAPI returns true if the Instapaper OAuth consumer credentials are configured.
+func (o *Options) HasInstapaperFullAPI() bool {
+	return o.instapaperConsumerKey != "" && o.instapaperConsumerSecret != ""
+}
+
 // HTTPClientTimeout returns the time limit in seconds before the HTTP client cancel the request.
 func (o *Options) HTTPClientTimeout() int {
 	return o.httpClientTimeout
@@ -395,6 +580,21 @@ func (o *Options) HasHTTPClientProxyConfigured() bool {
 	return o.httpClientProxy != ""
 }
 
+// HTTPClientAllowedSchemes returns the URL schemes that feeds, websites and proxied media can use.
+func (o *Options) HTTPClientAllowedSchemes() []string {
+	return o.httpClientAllowedSchemes
+}
+
+// HTTPClientAllowedNetworks returns the networks reachable by the HTTP client even if they are denied, e.g. for intranet feeds.
+func (o *Options) HTTPClientAllowedNetworks() []string {
+	return o.httpClientAllowedNetworks
+}
+
+// HTTPClientDeniedNetworks returns the networks the HTTP client is not allowed to connect to.
+func (o *Options) HTTPClientDeniedNetworks() []string {
+	return o.httpClientDeniedNetworks
+}
+
 // AuthProxyHeader returns an HTTP header name that contains username for
 // authentication using auth proxy.
 func (o *Options) AuthProxyHeader() string {
@@ -422,6 +622,191 @@ func (o *Options) MetricsAllowedNetworks() []string {
 	return o.metricsAllowedNetworks
 }
 
+// YouTubeAPIKey returns the YouTube Data API key used to fetch video durations.
+func (o *Options) YouTubeAPIKey() string {
+	return o.youTubeAPIKey
+}
+
+// SnapshotQuota returns the maximum size in bytes of the web page copies of each user, 0 means no limit.
+func (o *Options) SnapshotQuota() int64 {
+	return int64(o.snapshotQuota) * 1024 * 1024
+}
+
+// SnapshotRetentionDays returns the number of days the web page copies are kept, 0 means forever.
+func (o *Options) SnapshotRetentionDays() int {
+	return o.snapshotRetentionDays
+}
+
+// FetchYouTubeWatchTime returns true if the duration of the new YouTube videos is fetched as their reading time.
+func (o *Options) FetchYouTubeWatchTime() bool {
+	return o.fetchYouTubeWatchTime
+}
+
+// SMTPHost returns the hostname of the SMTP server used to send emails.
+func (o *Options) SMTPHost() string {
+	return o.smtpHost
+}
+
+// SMTPPort returns the port of the SMTP server.
+func (o *Options) SMTPPort() int {
+	return o.smtpPort
+}
+
+// SMTPUsername returns the username used to authenticate against the SMTP server.
+func (o *Options) SMTPUsername() string {
+	return o.smtpUsername
+}
+
+// SMTPPassword returns the password used to authenticate against the SMTP server.
+func (o *Options) SMTPPassword() string {
+	return o.smtpPassword
+}
+
+// SMTPFrom returns the sender address of emails.
+func (o *Options) SMTPFrom() string {
+	return o.smtpFrom
+}
+
+// HasSMTP returns true if an SMTP server and a sender address are configured.
+func (o *Options) HasSMTP() bool {
+	return o.smtpHost != "" && o.smtpFrom != ""
+}
+
+// SMTPMaxRecipientsPerHour returns the number of addresses a user can send entries to within an hour.
+func (o *Options) SMTPMaxRecipientsPerHour() int {
+	return o.smtpMaxRecipientsPerHour
+}
+
+// HasEmailSharing returns true if the users can send entries by email.
+func (o *Options) HasEmailSharing() bool {
+	return o.HasSMTP() && o.smtpMaxRecipientsPerHour > 0
+}
+
+// TrendingFrequencyMinutes returns the interval in minutes for the trending topics job.
+func (o *Options) TrendingFrequencyMinutes() int {
+	return o.trendingFrequencyMinutes
+}
+
+// HasFeedRecommendations returns true if users can see feeds popular among other users of the instance.
+func (o *Options) HasFeedRecommendations() bool {
+	return o.feedRecommendations
+}
+
+// PollingJitterMinutes returns the maximum random delay added to the next check of a feed.
+func (o *Options) PollingJitterMinutes() int {
+	return o.pollingJitterMinutes
+}
+
+// HasPollingSpreadBatch returns true if the jobs of a batch are spread over the polling frequency.
+func (o *Options) HasPollingSpreadBatch() bool {
+	return o.pollingSpreadBatch
+}
+
+// PollingParsingErrorLimit returns the number of consecutive errors after which a feed is not refreshed anymore, 0 means no limit.
+func (o *Options) PollingParsingErrorLimit() int {
+	return o.pollingParsingErrorLimit
+}
+
+// PollingErrorBackoffMultiplier returns the factor applied to the polling interval after each consecutive error.
+func (o *Options) PollingErrorBackoffMultiplier() int {
+	return o.pollingErrorBackoffMultiplier
+}
+
+// PollingErrorBackoffMaxInterval returns the maximum interval in minutes between two checks of a failing feed.
+func (o *Options) PollingErrorBackoffMaxInterval() int {
+	return o.pollingErrorBackoffMaxInterval
+}
+
+// PollingErrorDisableAfterWeeks returns the number of weeks of consecutive errors after which a feed is disabled, 0 means never.
+func (o *Options) PollingErrorDisableAfterWeeks() int {
+	return o.pollingErrorDisableAfterWeeks
+}
+
+// HasPollingApplySelfLink returns true if feed URLs are automatically replaced by the URL advertised in the feed (rel=self).
+func (o *Options) HasPollingApplySelfLink() bool {
+	return o.pollingApplySelfLink
+}
+
+// CleanupUnusedFeedsMonths returns the number of months without reading after which a feed is suggested for removal.
+func (o *Options) CleanupUnusedFeedsMonths() int {
+	return o.cleanupUnusedFeedsMonths
+}
+
+// MarkdownExportDir returns the folder where the Markdown notes of users without WebDAV server are written.
+func (o *Options) MarkdownExportDir() string {
+	return o.markdownExportDir
+}
+
+// CommentsFollowDays returns the number of days during which the comments of a followed entry are fetched.
+func (o *Options) CommentsFollowDays() int {
+	return o.commentsFollowDays
+}
+
+// PollingSharedFetchMinutes returns the number of minutes during which a downloaded feed is reused for the other subscribers of the same URL.
+func (o *Options) PollingSharedFetchMinutes() int {
+	return o.pollingSharedFetchMinutes
+}
+
+// ContentSecurityPolicy returns the Content-Security-Policy header of the user interface.
+func (o *Options) ContentSecurityPolicy() string {
+	return o.contentSecurityPolicy
+}
+
+// ReferrerPolicy returns the Referrer-Policy header of the user interface.
+func (o *Options) ReferrerPolicy() string {
+	return o.referrerPolicy
+}
+
+// FrameOptions returns the X-Frame-Options header of the user interface.
+func (o *Options) FrameOptions() string {
+	return o.frameOptions
+}
+
+// CookieSameSite returns the SameSite attribute of the cookies: lax, strict or none.
+func (o *Options) CookieSameSite() string {
+	return o.cookieSameSite
+}
+
+// CookieSecure returns true if the cookies must be sent only over HTTPS even if the request is not detected as HTTPS.
+func (o *Options) CookieSecure() bool {
+	return o.cookieSecure
+}
+
+// CookieNamePrefix returns the prefix added to the name of the cookies.
+func (o *Options) CookieNamePrefix() string {
+	return o.cookieNamePrefix
+}
+
+// SessionLifetimeDays returns the number of days after which users have to sign in again.
+func (o *Options) SessionLifetimeDays() int {
+	return o.sessionLifetimeDays
+}
+
+// TranslationsDir returns the directory of the translation files loaded at startup.
+func (o *Options) TranslationsDir() string {
+	return o.translationsDir
+}
+
+// SchedulerEntryFrequencyWindowDays returns the number of days of statistics used to compute the posting frequency of feeds.
+func (o *Options) SchedulerEntryFrequencyWindowDays() int {
+	return o.schedulerEntryFrequencyWindowDays
+}
+
+// DormantFeedsDays returns the number of days without new entries after which a feed is flagged as dormant.
+func (o *Options) DormantFeedsDays() int {
+	return o.dormantFeedsDays
+}
+
+// PollingErrorNotificationThreshold returns the number of consecutive errors after which the user is notified.
+func (o *Options) PollingErrorNotificationThreshold() int {
+	return o.pollingErrorNotificationThreshold
+}
+
+// CleanupArchiveReadDaysMax returns the highest number of days users can choose to keep their read items, 0 disables the user setting.
+func (o *Options) CleanupArchiveReadDaysMax() int {
+	return o.cleanupArchiveReadDaysMax
+}
+
 func (o *Options) String() string {
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("LOG_DATE_TIME: %v\n", o.logDateTime))
@@ -437,6 +822,11 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("DATABASE_URL: %v\n", o.databaseURL))
 	builder.WriteString(fmt.Sprintf("DATABASE_MAX_CONNS: %v\n", o.databaseMaxConns))
 	builder.WriteString(fmt.Sprintf("DATABASE_MIN_CONNS: %v\n", o.databaseMinConns))
+	builder.WriteString(fmt.Sprintf("DATABASE_DRIVER: %v\n", o.databaseDriver))
+	builder.WriteString(fmt.Sprintf("DATABASE_CONNECTION_LIFETIME: %v\n", o.databaseConnectionLifetime))
+	builder.WriteString(fmt.Sprintf("DATABASE_MAX_IDLE_TIME: %v\n", o.databaseMaxIdleTime))
+	builder.WriteString(fmt.Sprintf("DATABASE_STATEMENT_CACHE: %v\n", o.databaseStatementCache))
+	builder.WriteString(fmt.Sprintf("REDIS_URL: %v\n", o.redisURL))
 	builder.WriteString(fmt.Sprintf("RUN_MIGRATIONS: %v\n", o.runMigrations))
 	builder.WriteString(fmt.Sprintf("CERT_FILE: %v\n", o.certFile))
 	builder.WriteString(fmt.Sprintf("KEY_FILE: %v\n", o.certKeyFile))
@@ -444,19 +834,42 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("CERT_CACHE: %v\n", o.certCache))
 	builder.WriteString(fmt.Sprintf("CLEANUP_FREQUENCY_HOURS: %v\n", o.cleanupFrequencyHours))
 	builder.WriteString(fmt.Sprintf("CLEANUP_ARCHIVE_READ_DAYS: %v\n", o.cleanupArchiveReadDays))
+	builder.WriteString(fmt.Sprintf("CLEANUP_ARCHIVE_READ_DAYS_MAX: %v\n", o.cleanupArchiveReadDaysMax))
 	builder.WriteString(fmt.Sprintf("CLEANUP_ARCHIVE_UNREAD_DAYS: %v\n", o.cleanupArchiveUnreadDays))
+	builder.WriteString(fmt.Sprintf("CLEANUP_UNUSED_FEEDS_MONTHS: %v\n", o.cleanupUnusedFeedsMonths))
 	builder.WriteString(fmt.Sprintf("CLEANUP_REMOVE_SESSIONS_DAYS: %v\n", o.cleanupRemoveSessionsDays))
+	builder.WriteString(fmt.Sprintf("TRENDING_FREQUENCY_MINUTES: %v\n", o.trendingFrequencyMinutes))
+	builder.WriteString(fmt.Sprintf("FEED_RECOMMENDATIONS: %v\n", o.feedRecommendations))
 	builder.WriteString(fmt.Sprintf("WORKER_POOL_SIZE: %v\n", o.workerPoolSize))
 	builder.WriteString(fmt.Sprintf("POLLING_FREQUENCY: %v\n", o.pollingFrequency))
 	builder.WriteString(fmt.Sprintf("BATCH_SIZE: %v\n", o.batchSize))
 	builder.WriteString(fmt.Sprintf("POLLING_SCHEDULER: %v\n", o.pollingScheduler))
 	builder.WriteString(fmt.Sprintf("SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL: %v\n", o.schedulerEntryFrequencyMaxInterval))
 	builder.WriteString(fmt.Sprintf("SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL: %v\n", o.schedulerEntryFrequencyMinInterval))
+	builder.WriteString(fmt.Sprintf("SCHEDULER_ENTRY_FREQUENCY_WINDOW_DAYS: %v\n", o.schedulerEntryFrequencyWindowDays))
+	builder.WriteString(fmt.Sprintf("POLLING_JITTER_MINUTES: %v\n", o.pollingJitterMinutes))
+	builder.WriteString(fmt.Sprintf("POLLING_SPREAD_BATCH: %v\n", o.pollingSpreadBatch))
+	builder.WriteString(fmt.Sprintf("POLLING_PARSING_ERROR_LIMIT: %v\n", o.pollingParsingErrorLimit))
+	builder.WriteString(fmt.Sprintf("POLLING_ERROR_BACKOFF_MULTIPLIER: %v\n", o.pollingErrorBackoffMultiplier))
+	builder.WriteString(fmt.Sprintf("POLLING_ERROR_BACKOFF_MAX_INTERVAL: %v\n", o.pollingErrorBackoffMaxInterval))
+	builder.WriteString(fmt.Sprintf("POLLING_ERROR_DISABLE_AFTER_WEEKS: %v\n", o.pollingErrorDisableAfterWeeks))
+	builder.WriteString(fmt.Sprintf("POLLING_ERROR_NOTIFICATION_THRESHOLD: %v\n", o.pollingErrorNotificationThreshold))
+	builder.WriteString(fmt.Sprintf("POLLING_APPLY_SELF_LINK: %v\n", o.pollingApplySelfLink))
+	builder.WriteString(fmt.Sprintf("POLLING_SHARED_FETCH_MINUTES: %v\n", o.pollingSharedFetchMinutes))
 	builder.WriteString(fmt.Sprintf("PROXY_IMAGES: %v\n", o.proxyImages))
 	builder.WriteString(fmt.Sprintf("CREATE_ADMIN: %v\n", o.createAdmin))
 	builder.WriteString(fmt.Sprintf("ADMIN_USERNAME: %v\n", o.adminUsername))
 	builder.WriteString(fmt.Sprintf("ADMIN_PASSWORD: %v\n", o.adminPassword))
 	builder.WriteString(fmt.Sprintf("POCKET_CONSUMER_KEY: %v\n", o.pocketConsumerKey))
+	builder.WriteString(fmt.Sprintf("MARKDOWN_EXPORT_DIR: %v\n", o.markdownExportDir))
+	builder.WriteString(fmt.Sprintf("INSTAPAPER_CONSUMER_KEY: %v\n", o.instapaperConsumerKey))
+	builder.WriteString(fmt.Sprintf("INSTAPAPER_CONSUMER_SECRET: %v\n", o.instapaperConsumerSecret))
+	builder.WriteString(fmt.Sprintf("YOUTUBE_API_KEY: %v\n", o.youTubeAPIKey))
+	builder.WriteString(fmt.Sprintf("SMTP_HOST: %v\n", o.smtpHost))
+	builder.WriteString(fmt.Sprintf("SMTP_PORT: %v\n", o.smtpPort))
+	builder.WriteString(fmt.Sprintf("SMTP_USERNAME: %v\n", o.smtpUsername))
+	builder.WriteString(fmt.Sprintf("SMTP_PASSWORD: %v\n", o.smtpPassword))
+	builder.WriteString(fmt.Sprintf("SMTP_FROM: %v\n", o.smtpFrom))
 	builder.WriteString(fmt.Sprintf("OAUTH2_USER_CREATION: %v\n", o.oauth2UserCreationAllowed))
 	builder.WriteString(fmt.Sprintf("OAUTH2_CLIENT_ID: %v\n", o.oauth2ClientID))
 	builder.WriteString(fmt.Sprintf("OAUTH2_CLIENT_SECRET: %v\n", o.oauth2ClientSecret))
@@ -466,6 +879,9 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_TIMEOUT: %v\n", o.httpClientTimeout))
 	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_MAX_BODY_SIZE: %v\n", o.httpClientMaxBodySize))
 	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_PROXY: %v\n", o.httpClientProxy))
+	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_ALLOWED_SCHEMES: %v\n", o.httpClientAllowedSchemes))
+	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_ALLOWED_NETWORKS: %v\n", o.httpClientAllowedNetworks))
+	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_DENIED_NETWORKS: %v\n", o.httpClientDeniedNetworks))
 	builder.WriteString(fmt.Sprintf("AUTH_PROXY_HEADER: %v\n", o.authProxyHeader))
 	builder.WriteString(fmt.Sprintf("AUTH_PROXY_USER_CREATION: %v\n", o.authProxyUserCreation))
 	builder.WriteString(fmt.Sprintf("MAINTENANCE_MODE: %v\n", o.maintenanceMode))
@@ -473,5 +889,19 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("METRICS_COLLECTOR: %v\n", o.metricsCollector))
 	builder.WriteString(fmt.Sprintf("METRICS_REFRESH_INTERVAL: %v\n", o.metricsRefreshInterval))
 	builder.WriteString(fmt.Sprintf("METRICS_ALLOWED_NETWORKS: %v\n", o.metricsAllowedNetworks))
+	builder.WriteString(fmt.Sprintf("CONTENT_SECURITY_POLICY: %v\n", o.contentSecurityPolicy))
+	builder.WriteString(fmt.Sprintf("REFERRER_POLICY: %v\n", o.referrerPolicy))
+	builder.WriteString(fmt.Sprintf("FRAME_OPTIONS: %v\n", o.frameOptions))
+	builder.WriteString(fmt.Sprintf("COOKIE_SAME_SITE: %v\n", o.cookieSameSite))
+	builder.WriteString(fmt.Sprintf("COOKIE_SECURE: %v\n", o.cookieSecure))
+	builder.WriteString(fmt.Sprintf("COOKIE_NAME_PREFIX: %v\n", o.cookieNamePrefix))
+	builder.WriteString(fmt.Sprintf("SESSION_LIFETIME_DAYS: %v\n", o.sessionLifetimeDays))
+	builder.WriteString(fmt.Sprintf("TRANSLATIONS_DIR: %v\n", o.translationsDir))
+	builder.WriteString(fmt.Sprintf("COMMENTS_FOLLOW_DAYS: %v\n", o.commentsFollowDays))
+	builder.WriteString(fmt.Sprintf("DORMANT_FEEDS_DAYS: %v\n", o.dormantFeedsDays))
+	builder.WriteString(fmt.Sprintf("SMTP_MAX_RECIPIENTS_PER_HOUR: %v\n", o.smtpMaxRecipientsPerHour))
+	builder.WriteString(fmt.Sprintf("FETCH_YOUTUBE_WATCH_TIME: %v\n", o.fetchYouTubeWatchTime))
+	builder.WriteString(fmt.Sprintf("SNAPSHOT_QUOTA: %v\n", o.snapshotQuota))
+	builder.WriteString(fmt.Sprintf("SNAPSHOT_RETENTION_DAYS: %v\n", o.snapshotRetentionDays))
 	return builder.String()
 }
@@ -94,6 +94,18 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.databaseMaxConns = parseInt(value, defaultDatabaseMaxConns)
 		case "DATABASE_MIN_CONNS":
 			p.opts.databaseMinConns = parseInt(value, defaultDatabaseMinConns)
+		case "DATABASE_DRIVER":
+			p.opts.databaseDriver = parseString(value, defaultDatabaseDriver)
+		case "DATABASE_CONNECTION_LIFETIME":
+			p.opts.databaseConnectionLifetime = parseInt(value, defaultDatabaseConnectionLifetime)
+		case "DATABASE_MAX_IDLE_TIME":
+			p.opts.databaseMaxIdleTime = parseInt(value, defaultDatabaseMaxIdleTime)
+		case "DATABASE_STATEMENT_CACHE":
+			p.opts.databaseStatementCache = parseBool(value, defaultDatabaseStatementCache)
+		case "REDIS_URL":
+			p.opts.redisURL = parseString(value, defaultRedisURL)
+		case "REDIS_URL_FILE":
+			p.opts.redisURL = readSecretFile(value, defaultRedisURL)
 		case "RUN_MIGRATIONS":
 			p.opts.runMigrations = parseBool(value, defaultRunMigrations)
 		case "DISABLE_HSTS":
@@ -116,10 +128,18 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.cleanupFrequencyHours = parseInt(value, defaultCleanupFrequencyHours)
 		case "CLEANUP_ARCHIVE_READ_DAYS":
 			p.opts.cleanupArchiveReadDays = parseInt(value, defaultCleanupArchiveReadDays)
+		case "CLEANUP_ARCHIVE_READ_DAYS_MAX":
+			p.opts.cleanupArchiveReadDaysMax = parseInt(value, defaultCleanupArchiveReadDaysMax)
 		case "CLEANUP_ARCHIVE_UNREAD_DAYS":
 			p.opts.cleanupArchiveUnreadDays = parseInt(value, defaultCleanupArchiveUnreadDays)
+		case "CLEANUP_UNUSED_FEEDS_MONTHS":
+			p.opts.cleanupUnusedFeedsMonths = parseInt(value, defaultCleanupUnusedFeedsMonths)
 		case "CLEANUP_REMOVE_SESSIONS_DAYS":
 			p.opts.cleanupRemoveSessionsDays = parseInt(value, defaultCleanupRemoveSessionsDays)
+		case "TRENDING_FREQUENCY_MINUTES":
+			p.opts.trendingFrequencyMinutes = parseInt(value, defaultTrendingFrequencyMinutes)
+		case "FEED_RECOMMENDATIONS":
+			p.opts.feedRecommendations = parseBool(value, defaultFeedRecommendations)
 		case "WORKER_POOL_SIZE":
 			p.opts.workerPoolSize = parseInt(value, defaultWorkerPoolSize)
 		case "POLLING_FREQUENCY":
@@ -132,6 +152,29 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.schedulerEntryFrequencyMaxInterval = parseInt(value, defaultSchedulerEntryFrequencyMaxInterval)
 		case "SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL":
 			p.opts.schedulerEntryFrequencyMinInterval = parseInt(value, defaultSchedulerEntryFrequencyMinInterval)
+		case "SCHEDULER_ENTRY_FREQUENCY_WINDOW_DAYS":
+			p.opts.schedulerEntryFrequencyWindowDays = parseInt(value, defaultSchedulerEntryFrequencyWindowDays)
+			if p.opts.schedulerEntryFrequencyWindowDays < 1 || p.opts.schedulerEntryFrequencyWindowDays > 365 {
+				p.opts.schedulerEntryFrequencyWindowDays = defaultSchedulerEntryFrequencyWindowDays
+			}
+		case "POLLING_JITTER_MINUTES":
+			p.opts.pollingJitterMinutes = parseInt(value, defaultPollingJitterMinutes)
+		case "POLLING_SPREAD_BATCH":
+			p.opts.pollingSpreadBatch = parseBool(value, defaultPollingSpreadBatch)
+		case "POLLING_PARSING_ERROR_LIMIT":
+			p.opts.pollingParsingErrorLimit = parseInt(value, defaultPollingParsingErrorLimit)
+		case "POLLING_ERROR_BACKOFF_MULTIPLIER":
+			p.opts.pollingErrorBackoffMultiplier = parseInt(value, defaultPollingErrorBackoffMultiplier)
+		case "POLLING_ERROR_BACKOFF_MAX_INTERVAL":
+			p.opts.pollingErrorBackoffMaxInterval = parseInt(value, defaultPollingErrorBackoffMaxInterval)
+		case "POLLING_ERROR_DISABLE_AFTER_WEEKS":
+			p.opts.pollingErrorDisableAfterWeeks = parseInt(value, defaultPollingErrorDisableAfterWeeks)
+		case "POLLING_ERROR_NOTIFICATION_THRESHOLD":
+			p.opts.pollingErrorNotificationThreshold = parseInt(value, defaultPollingErrorNotificationThreshold)
+		case "POLLING_APPLY_SELF_LINK":
+			p.opts.pollingApplySelfLink = parseBool(value, defaultPollingApplySelfLink)
+		case "POLLING_SHARED_FETCH_MINUTES":
+			p.opts.pollingSharedFetchMinutes = parseInt(value, defaultPollingSharedFetchMinutes)
 		case "PROXY_IMAGES":
 			p.opts.proxyImages = parseString(value, defaultProxyImages)
 		case "CREATE_ADMIN":
@@ -148,6 +191,32 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.pocketConsumerKey = parseString(value, defaultPocketConsumerKey)
 		case "POCKET_CONSUMER_KEY_FILE":
 			p.opts.pocketConsumerKey = readSecretFile(value, defaultPocketConsumerKey)
+		case "MARKDOWN_EXPORT_DIR":
+			p.opts.markdownExportDir = parseString(value, defaultMarkdownExportDir)
+		case "INSTAPAPER_CONSUMER_KEY":
+			p.opts.instapaperConsumerKey = parseString(value, defaultInstapaperConsumerKey)
+		case "INSTAPAPER_CONSUMER_KEY_FILE":
+			p.opts.instapaperConsumerKey = readSecretFile(value, defaultInstapaperConsumerKey)
+		case "INSTAPAPER_CONSUMER_SECRET":
+			p.opts.instapaperConsumerSecret = parseString(value, defaultInstapaperConsumerSecret)
+		case "INSTAPAPER_CONSUMER_SECRET_FILE":
+			p.opts.instapaperConsumerSecret = readSecretFile(value, defaultInstapaperConsumerSecret)
+		case "YOUTUBE_API_KEY":
+			p.opts.youTubeAPIKey = parseString(value, defaultYouTubeAPIKey)
+		case "YOUTUBE_API_KEY_FILE":
+			p.opts.youTubeAPIKey = readSecretFile(value, defaultYouTubeAPIKey)
+		case "SMTP_HOST":
+			p.opts.smtpHost = parseString(value, defaultSMTPHost)
+		case "SMTP_PORT":
+			p.opts.smtpPort = parseInt(value, defaultSMTPPort)
+		case "SMTP_USERNAME":
+			p.opts.smtpUsername = parseString(value, defaultSMTPUsername)
+		case "SMTP_PASSWORD":
+			p.opts.smtpPassword = parseString(value, defaultSMTPPassword)
+		case "SMTP_PASSWORD_FILE":
+			p.opts.smtpPassword = readSecretFile(value, defaultSMTPPassword)
+		case "SMTP_FROM":
+			p.opts.smtpFrom = parseString(value, defaultSMTPFrom)
 		case "OAUTH2_USER_CREATION":
 			p.opts.oauth2UserCreationAllowed = parseBool(value, defaultOAuth2UserCreation)
 		case "OAUTH2_CLIENT_ID":
@@ -170,6 +239,12 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.httpClientMaxBodySize = int64(parseInt(value, defaultHTTPClientMaxBodySize) * 1024 * 1024)
 		case "HTTP_CLIENT_PROXY":
 			p.opts.httpClientProxy = parseString(value, defaultHTTPClientProxy)
+		case "HTTP_CLIENT_ALLOWED_SCHEMES":
+			p.opts.httpClientAllowedSchemes = parseStringList(value, parseStringList(defaultHTTPClientAllowedSchemes, nil))
+		case "HTTP_CLIENT_ALLOWED_NETWORKS":
+			p.opts.httpClientAllowedNetworks = parseStringList(value, nil)
+		case "HTTP_CLIENT_DENIED_NETWORKS":
+			p.opts.httpClientDeniedNetworks = parseStringList(value, parseStringList(defaultHTTPClientDeniedNetworks, nil))
 		case "AUTH_PROXY_HEADER":
 			p.opts.authProxyHeader = parseString(value, defaultAuthProxyHeader)
 		case "AUTH_PROXY_USER_CREATION":
@@ -184,12 +259,73 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.metricsRefreshInterval = parseInt(value, defaultMetricsRefreshInterval)
 		case "METRICS_ALLOWED_NETWORKS":
 			p.opts.metricsAllowedNetworks = parseStringList(value, []string{defaultMetricsAllowedNetworks})
+		case "CONTENT_SECURITY_POLICY":
+			p.opts.contentSecurityPolicy = parseString(value, defaultContentSecurityPolicy)
+		case "REFERRER_POLICY":
+			p.opts.referrerPolicy = parseString(value, defaultReferrerPolicy)
+		case "FRAME_OPTIONS":
+			p.opts.frameOptions = parseString(value, defaultFrameOptions)
+		case "COOKIE_SAME_SITE":
+			p.opts.cookieSameSite = parseString(value, defaultCookieSameSite)
+		case "COOKIE_SECURE":
+			p.opts.cookieSecure = parseBool(value, defaultCookieSecure)
+		case "COOKIE_NAME_PREFIX":
+			p.opts.cookieNamePrefix = parseString(value, defaultCookieNamePrefix)
+		case "SESSION_LIFETIME_DAYS":
+			p.opts.sessionLifetimeDays = parseInt(value, defaultSessionLifetimeDays)
+		case "TRANSLATIONS_DIR":
+			p.opts.translationsDir = parseString(value, defaultTranslationsDir)
+		case "COMMENTS_FOLLOW_DAYS":
+			p.opts.commentsFollowDays = parseInt(value, defaultCommentsFollowDays)
+		case "DORMANT_FEEDS_DAYS":
+			p.opts.dormantFeedsDays = parseInt(value, defaultDormantFeedsDays)
+		case "SMTP_MAX_RECIPIENTS_PER_HOUR":
+			p.opts.smtpMaxRecipientsPerHour = parseInt(value, defaultSMTPMaxRecipientsPerHour)
+		case "FETCH_YOUTUBE_WATCH_TIME":
+			p.opts.fetchYouTubeWatchTime = parseBool(value, defaultFetchYouTubeWatchTime)
+		case "SNAPSHOT_QUOTA":
+			p.opts.snapshotQuota = parseInt(value, defaultSnapshotQuota)
+		case "SNAPSHOT_RETENTION_DAYS":
+			p.opts.snapshotRetentionDays = parseInt(value, defaultSnapshotRetentionDays)
 		}
 	}
 
 	if port != "" {
 		p.opts.listenAddr = ":" + port
 	}
+
+	if p.opts.httpClientTimeout < MinHTTPClientTimeout || p.opts.httpClientTimeout > MaxHTTPClientTimeout {
+		return fmt.Errorf("HTTP_CLIENT_TIMEOUT must be between %d and %d seconds", MinHTTPClientTimeout, MaxHTTPClientTimeout)
+	}
+
+	if size := p.opts.httpClientMaxBodySize / 1024 / 1024; size < MinHTTPClientMaxBodySize || size > MaxHTTPClientMaxBodySize {
+		return fmt.Errorf("HTTP_CLIENT_MAX_BODY_SIZE must be between %d and %d megabytes", MinHTTPClientMaxBodySize, MaxHTTPClientMaxBodySize)
+	}
+
+	// The failing feeds must keep being refreshed to be disabled after a number of weeks,
+	// this policy replaces the parsing error limit.
+	if p.opts.pollingErrorDisableAfterWeeks > 0 {
+		p.opts.pollingParsingErrorLimit = 0
+	}
+
+	// Failing feeds are not refreshed anymore once they reach the parsing error limit.
+	if p.opts.pollingErrorNotificationThreshold < 1 {
+		return errors.New("POLLING_ERROR_NOTIFICATION_THRESHOLD must be greater than 0")
+	}
+
+	if limit := p.opts.pollingParsingErrorLimit; limit > 0 && p.opts.pollingErrorNotificationThreshold > limit {
+		return fmt.Errorf("POLLING_ERROR_NOTIFICATION_THRESHOLD (%d) must not be greater than POLLING_PARSING_ERROR_LIMIT (%d)", p.opts.pollingErrorNotificationThreshold, limit)
+	}
+
+	if p.opts.sessionLifetimeDays < 1 {
+		return errors.New("SESSION_LIFETIME_DAYS must be greater than 0")
+	}
+
+	// The sessions are removed from the database before the end of a longer lifetime.
+	if p.opts.sessionLifetimeDays > p.opts.cleanupRemoveSessionsDays {
+		p.opts.sessionLifetimeDays = p.opts.cleanupRemoveSessionsDays
+	}
+
 	return nil
 }
 
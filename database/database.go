@@ -6,20 +6,41 @@ package database // import "miniflux.app/database"
 
 import (
 	"database/sql"
+	"fmt"
+	"strings"
+	"time"
 
 	// Postgresql driver import
 	_ "github.com/lib/pq"
 )
 
 // NewConnectionPool configures the database connection pool.
-func NewConnectionPool(dsn string, minConnections, maxConnections int) (*sql.DB, error) {
-	db, err := sql.Open("postgres", dsn)
+// Any PostgreSQL driver for database/sql compiled in the binary can be used, lib/pq is registered as "postgres".
+// A zero duration means that connections are not closed because of their age or idle time.
+func NewConnectionPool(driver, dsn string, minConnections, maxConnections int, connectionLifetime, maxIdleTime time.Duration) (*sql.DB, error) {
+	if !isDriverRegistered(driver) {
+		return nil, fmt.Errorf("database: the driver %q is not compiled in the binary, the available drivers are: %s", driver, strings.Join(sql.Drivers(), ", "))
+	}
+
+	db, err := sql.Open(driver, dsn)
 	if err != nil {
 		return nil, err
 	}
 
 	db.SetMaxOpenConns(maxConnections)
 	db.SetMaxIdleConns(minConnections)
+	db.SetConnMaxLifetime(connectionLifetime)
+	setConnMaxIdleTime(db, maxIdleTime)
 
 	return db, nil
 }
+
+func isDriverRegistered(driver string) bool {
+	for _, name := range sql.Drivers() {
+		if name == driver {
+			return true
+		}
+	}
+
+	return false
+}
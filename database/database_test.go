@@ -0,0 +1,23 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package database // import "miniflux.app/database"
+
+import (
+	"testing"
+)
+
+func TestNewConnectionPoolWithUnknownDriver(t *testing.T) {
+	if _, err := NewConnectionPool("unknown", "", 1, 1, 0, 0); err == nil {
+		t.Fatal(`A driver which is not compiled in the binary should be rejected`)
+	}
+}
+
+func TestNewConnectionPoolWithDefaultDriver(t *testing.T) {
+	db, err := NewConnectionPool("postgres", "sslmode=disable", 1, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+}
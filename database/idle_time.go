@@ -0,0 +1,16 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build go1.15
+
+package database // import "miniflux.app/database"
+
+import (
+	"database/sql"
+	"time"
+)
+
+func setConnMaxIdleTime(db *sql.DB, maxIdleTime time.Duration) {
+	db.SetConnMaxIdleTime(maxIdleTime)
+}
@@ -0,0 +1,21 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build !go1.15
+
+package database // import "miniflux.app/database"
+
+import (
+	"database/sql"
+	"time"
+
+	"miniflux.app/logger"
+)
+
+// Go 1.14 cannot close idle connections after a delay.
+func setConnMaxIdleTime(db *sql.DB, maxIdleTime time.Duration) {
+	if maxIdleTime > 0 {
+		logger.Info("[Database] DATABASE_MAX_IDLE_TIME requires Go 1.15 or later, the setting is ignored")
+	}
+}
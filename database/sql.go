@@ -115,6 +115,62 @@ create table sessions (
     created_at timestamp with time zone not null default now(),
     primary key(id)
 );`,
+	"schema_version_100": `alter table category_members add column accepted_at timestamp with time zone;
+alter table category_members alter column member_category_id drop not null;
+update category_members set accepted_at=created_at;
+
+create table shared_feed_exclusions (
+    user_id int not null references users(id) on delete cascade,
+    feed_id bigint not null references feeds(id) on delete cascade,
+    primary key(user_id, feed_id)
+);
+`,
+	"schema_version_101": `-- The trigram indexes speed up the search qualifiers, they are skipped when the database user cannot create the extension.
+do $$
+begin
+    create extension if not exists pg_trgm;
+    create index entries_title_trgm_idx on entries using gin (title gin_trgm_ops);
+    create index entries_author_trgm_idx on entries using gin (author gin_trgm_ops);
+exception when insufficient_privilege or undefined_file then
+    raise notice 'pg_trgm is not available, the search qualifiers are not indexed';
+end
+$$;
+`,
+	"schema_version_102": `alter table integrations add column restricted_services text[] not null default '{}';
+update integrations set restricted_services=array(select distinct service from integration_routes r where r.user_id=integrations.user_id);
+`,
+	"schema_version_103": `alter table integrations add column ntfy_enabled bool not null default 'f';
+alter table integrations add column ntfy_topic_url text not null default '';
+alter table integrations add column ntfy_token text not null default '';
+alter table users add column notification_email_verified bool not null default 'f';
+alter table users add column notification_email_token text not null default '';
+`,
+	"schema_version_104": `alter table integrations add column instapaper_folders jsonb not null default '[]';
+`,
+	"schema_version_105": `alter table users add column accept_received_entries bool not null default 'f';
+`,
+	"schema_version_106": `alter table saved_searches add column feed_id bigint references feeds(id) on delete cascade;
+alter table saved_searches add column category_id int references categories(id) on delete cascade;
+alter table saved_searches drop constraint saved_searches_user_id_query_key;
+create unique index saved_searches_user_id_query_scope_idx on saved_searches(user_id, query, coalesce(feed_id, 0), coalesce(category_id, 0));
+`,
+	"schema_version_107": `create table sent_emails (
+    id bigserial not null,
+    user_id int not null,
+    recipients int not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index sent_emails_user_id_created_at_idx on sent_emails(user_id, created_at);
+`,
+	"schema_version_108": `drop index if exists feeds_title_trgm_idx;
+`,
+	"schema_version_109": `alter table entry_snapshots add column size int not null default 0;
+update entry_snapshots set size=octet_length(content);
+create index entry_snapshots_user_id_idx on entry_snapshots(user_id);
+`,
 	"schema_version_11": `alter table integrations add column wallabag_enabled bool default 'f';
 alter table integrations add column wallabag_url text default '';
 alter table integrations add column wallabag_client_id text default '';
@@ -191,8 +247,62 @@ create index entries_user_feed_idx on entries (user_id, feed_id);
 `,
 	"schema_version_36": `CREATE INDEX entries_feed_id_status_hash_idx ON entries USING btree (feed_id, status, hash);`,
 	"schema_version_37": `CREATE INDEX entries_user_id_status_starred_idx ON entries (user_id, status, starred);`,
+	"schema_version_38": `alter table users add column mark_read_on_original_link boolean default 'f';`,
+	"schema_version_39": `alter table feeds add column open_external_link boolean default 'f';
+`,
 	"schema_version_4": `create type entry_sorting_direction as enum('asc', 'desc');
 alter table users add column entry_direction entry_sorting_direction default 'asc';
+`,
+	"schema_version_40": `create table domain_rules (
+    id serial not null,
+    domain text not null,
+    rewrite_rules text not null default '',
+    scraper_rules text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique(domain)
+);
+`,
+	"schema_version_41": `alter table entries add column removed_trackers int not null default 0;
+`,
+	"schema_version_42": `alter table feeds add column encoding text not null default '';
+`,
+	"schema_version_43": `alter table feeds add column bearer_token text not null default '';
+alter table feeds add column oauth2_token_url text not null default '';
+alter table feeds add column oauth2_client_id text not null default '';
+alter table feeds add column oauth2_client_secret text not null default '';
+alter table feeds add column oauth2_scopes text not null default '';
+`,
+	"schema_version_44": `alter table integrations add column rssbridge_enabled bool default 'f';
+alter table integrations add column rssbridge_url text default '';
+`,
+	"schema_version_45": `alter table entries add column reading_time int not null default 0;
+alter table users add column youtube_embed_url text not null default '';
+`,
+	"schema_version_46": `alter table entries add column score int not null default 0;
+alter table entries add column comments_count int not null default 0;
+alter table feeds add column fetch_scores bool not null default 'f';
+alter table feeds add column minimum_score int not null default 0;
+`,
+	"schema_version_47": `create type muted_keyword_action as enum('drop', 'read');
+
+create table muted_keywords (
+    id serial not null,
+    user_id int not null references users(id) on delete cascade,
+    pattern text not null,
+    action muted_keyword_action not null default 'drop',
+    expires_at timestamp with time zone,
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique (user_id, pattern)
+);
+`,
+	"schema_version_48": `alter table feeds add column blocked_authors text not null default '';
+alter table users add column blocked_authors text not null default '';
+`,
+	"schema_version_49": `alter table feeds add column auto_star bool not null default 'f';
+alter table users add column auto_star_keywords text not null default '';
+alter table users add column auto_star_authors text not null default '';
 `,
 	"schema_version_5": `create table integrations (
     user_id int not null,
@@ -209,52 +319,445 @@ alter table users add column entry_direction entry_sorting_direction default 'as
     fever_token text default '',
     primary key(user_id)
 )
+`,
+	"schema_version_50": `create table trending_entries (
+    user_id int not null,
+    entry_id bigint not null,
+    topic_id int not null,
+    score int not null,
+    primary key (user_id, entry_id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+`,
+	"schema_version_51": `create table entry_tombstones (
+    user_id int not null,
+    entry_id bigint not null,
+    removed_at timestamp with time zone not null default now(),
+    primary key (user_id, entry_id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index entry_tombstones_removed_at_idx on entry_tombstones(user_id, removed_at);
+`,
+	"schema_version_52": `alter table feeds add column custom_title text;
+update feeds set custom_title=title;
+`,
+	"schema_version_53": `alter table feeds add column hide_globally bool not null default 'f';
+`,
+	"schema_version_54": `alter table feeds add column cron_expression text not null default '';
+`,
+	"schema_version_55": `alter table feeds add column failing_since timestamp with time zone;
+`,
+	"schema_version_56": `alter table feeds add column dead bool not null default 'f';
+alter table feeds add column permanent_redirect_count int not null default 0;
+alter table feeds add column previous_feed_url text not null default '';
+alter table feeds add column feed_url_updated_at timestamp with time zone;
+`,
+	"schema_version_57": `alter table feeds add column canonical_feed_url text not null default '';
+`,
+	"schema_version_58": `alter table feeds add column last_fetch_url text not null default '';
+alter table feeds add column last_fetch_content_type text not null default '';
+alter table feeds add column last_fetch_size int not null default 0;
+`,
+	"schema_version_59": `alter table feeds add column request_timeout int not null default 0;
+alter table feeds add column max_body_size int not null default 0;
 `,
 	"schema_version_6": `alter table feeds add column scraper_rules text default '';
+`,
+	"schema_version_60": `alter table entries add column reading_position real not null default 0;
+`,
+	"schema_version_61": `alter table users add column email_recipients text not null default '';
+`,
+	"schema_version_62": `alter table entries add column tags text[] not null default '{}';
+alter table integrations add column pinboard_category_tag bool not null default 'f';
+alter table integrations add column pinboard_entry_tags bool not null default 'f';
+alter table integrations add column pinboard_prompt_tags bool not null default 'f';
+`,
+	"schema_version_63": `alter table integrations add column wallabag_tags text not null default '';
+alter table integrations add column wallabag_archive bool not null default 'f';
+`,
+	"schema_version_64": `alter table integrations add column instapaper_folder_id text not null default '';
+`,
+	"schema_version_65": `alter table integrations add column pocket_category_tag bool not null default 'f';
+`,
+	"schema_version_66": `alter table integrations add column custom_bookmark_enabled bool not null default 'f';
+alter table integrations add column custom_bookmark_url text not null default '';
+alter table integrations add column custom_bookmark_method text not null default 'POST';
+alter table integrations add column custom_bookmark_headers text not null default '';
+alter table integrations add column custom_bookmark_body text not null default '';
+`,
+	"schema_version_67": `create table integration_routes (
+    user_id int not null,
+    service text not null,
+    category_id int not null,
+    primary key (user_id, service, category_id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete cascade
+);
+`,
+	"schema_version_68": `alter table enclosures add column duration int not null default 0;
+`,
+	"schema_version_69": `alter table enclosures add column poster_url text not null default '';
 `,
 	"schema_version_7": `alter table feeds add column rewrite_rules text default '';
+`,
+	"schema_version_70": `alter table entries add column latitude double precision;
+alter table entries add column longitude double precision;
+`,
+	"schema_version_71": `alter table users add column home_page text not null default 'unread';
+`,
+	"schema_version_72": `create table user_preferences (
+    user_id bigint not null references users(id) on delete cascade,
+    namespace text not null,
+    key text not null,
+    value jsonb not null,
+    updated_at timestamp with time zone not null default now(),
+    primary key (user_id, namespace, key)
+);
+`,
+	"schema_version_73": `alter table entries add column created_at timestamp with time zone not null default now();
+update entries set created_at = published_at;
+alter table users add column last_seen_at timestamp with time zone;
+alter table users add column previous_visit_at timestamp with time zone;
+`,
+	"schema_version_74": `alter table feeds add column created_at timestamp with time zone not null default now();
+alter table feeds add column last_read_at timestamp with time zone;
+alter table feeds add column unused bool not null default 'f';
+update feeds set created_at = coalesce((select min(e.created_at) from entries e where e.feed_id = feeds.id), now());
+update feeds set last_read_at = (select max(e.changed_at) from entries e where e.feed_id = feeds.id and (e.status = 'read' or e.starred));
+`,
+	"schema_version_75": `alter table integrations add column markdown_enabled bool not null default 'f';
+alter table integrations add column markdown_webdav_url text not null default '';
+alter table integrations add column markdown_webdav_username text not null default '';
+alter table integrations add column markdown_webdav_password text not null default '';
+alter table integrations add column markdown_starred bool not null default 'f';
+`,
+	"schema_version_76": `create table annotations (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    quote text not null default '',
+    note text not null default '',
+    hypothesis_id text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+create index annotations_entry_idx on annotations(entry_id);
+alter table integrations add column hypothesis_enabled bool not null default 'f';
+alter table integrations add column hypothesis_token text not null default '';
+alter table integrations add column hypothesis_group text not null default '';
+`,
+	"schema_version_77": `alter table integrations add column zotero_enabled bool not null default 'f';
+alter table integrations add column zotero_library_type text not null default 'user';
+alter table integrations add column zotero_library_id text not null default '';
+alter table integrations add column zotero_api_key text not null default '';
+alter table integrations add column zotero_collection_key text not null default '';
+`,
+	"schema_version_78": `alter table integrations add column karakeep_enabled bool not null default 'f';
+alter table integrations add column karakeep_url text not null default '';
+alter table integrations add column karakeep_api_key text not null default '';
+alter table integrations add column karakeep_tags text not null default '';
+`,
+	"schema_version_79": `alter table integrations add column espial_enabled bool not null default 'f';
+alter table integrations add column espial_url text not null default '';
+alter table integrations add column espial_api_key text not null default '';
+alter table integrations add column espial_tags text not null default 'miniflux';
 `,
 	"schema_version_8": `alter table feeds add column crawler boolean default 'f';
+`,
+	"schema_version_80": `alter table integrations add column linkace_enabled bool not null default 'f';
+alter table integrations add column linkace_url text not null default '';
+alter table integrations add column linkace_api_key text not null default '';
+alter table integrations add column linkace_tags text not null default '';
+alter table integrations add column linkace_lists text not null default '';
+alter table integrations add column linkace_category_tag bool not null default 'f';
+alter table integrations add column linkace_is_private bool not null default 't';
+`,
+	"schema_version_81": `create table entry_archives (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    service text not null,
+    url text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    unique (entry_id, service),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+alter table integrations add column wayback_enabled bool not null default 'f';
+`,
+	"schema_version_82": `alter table integrations add column archivebox_enabled bool not null default 'f';
+alter table integrations add column archivebox_url text not null default '';
+alter table integrations add column archivebox_api_key text not null default '';
+alter table integrations add column archivebox_tags text not null default '';
+`,
+	"schema_version_83": `alter table feeds add column archive_pages bool not null default 'f';
+
+create table entry_snapshots (
+    entry_id bigint not null primary key,
+    user_id int not null,
+    content bytea not null,
+    created_at timestamp with time zone not null default now(),
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (user_id) references users(id) on delete cascade
+);
+`,
+	"schema_version_84": `alter table entries add column comments_feed_url text not null default '';
+
+create table entry_comment_subscriptions (
+    entry_id bigint not null primary key,
+    user_id int not null,
+    expires_at timestamp with time zone not null,
+    checked_at timestamp with time zone,
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table entry_comments (
+    id bigserial not null primary key,
+    entry_id bigint not null,
+    hash text not null,
+    author text not null default '',
+    url text not null default '',
+    content text not null default '',
+    published_at timestamp with time zone not null,
+    unique (entry_id, hash),
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+`,
+	"schema_version_85": `create table received_entries (
+    id bigserial not null primary key,
+    sender_id int not null,
+    recipient_id int not null,
+    title text not null,
+    url text not null,
+    author text not null default '',
+    content text not null default '',
+    feed_title text not null default '',
+    published_at timestamp with time zone not null,
+    message text not null default '',
+    created_at timestamp with time zone not null default now(),
+    foreign key (sender_id) references users(id) on delete cascade,
+    foreign key (recipient_id) references users(id) on delete cascade
+);
+
+create index received_entries_recipient_idx on received_entries(recipient_id, created_at);
+`,
+	"schema_version_86": `create table category_members (
+    category_id int not null,
+    user_id int not null,
+    member_category_id int not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (category_id, user_id),
+    foreign key (category_id) references categories(id) on delete cascade,
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (member_category_id) references categories(id) on delete cascade
+);
+
+create index category_members_member_category_idx on category_members(member_category_id);
+
+alter table feeds add column shared_feed_id bigint references feeds(id) on delete cascade;
+create index feeds_shared_feed_idx on feeds(shared_feed_id);
+`,
+	"schema_version_87": `create table feed_contents (
+    url_hash text not null primary key,
+    feed_url text not null,
+    effective_url text not null default '',
+    permanent_redirect bool not null default 'f',
+    content_type text not null default '',
+    etag_header text not null default '',
+    last_modified_header text not null default '',
+    content bytea not null,
+    fetched_at timestamp with time zone not null default now()
+);
+`,
+	"schema_version_88": `create table blocked_feeds (
+    id bigserial not null primary key,
+    pattern text not null unique,
+    reason text not null default '',
+    created_at timestamp with time zone not null default now()
+);
+`,
+	"schema_version_89": `alter table entries add column published_offset int;
+alter table users add column entry_timezone text not null default 'local';
 `,
 	"schema_version_9": `alter table sessions rename to user_sessions;`,
+	"schema_version_90": `alter table users add column timestamp_format text not null default 'relative';
+`,
+	"schema_version_91": `create table feed_entry_counts (
+    feed_id bigint not null,
+    day date not null,
+    entry_count int not null default 0,
+    primary key (feed_id, day),
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+
+insert into feed_entry_counts (feed_id, day, entry_count)
+    select feed_id, (published_at at time zone 'UTC')::date, count(*)
+    from entries
+    where published_at > now() - interval '365 days'
+    group by 1, 2;
+`,
+	"schema_version_92": `alter table feeds add column last_entry_at timestamp with time zone;
+alter table feeds add column dormant bool not null default 'f';
+
+update feeds set last_entry_at=e.created_at
+    from (select feed_id, max(created_at) as created_at from entries group by feed_id) e
+    where e.feed_id=feeds.id;
+`,
+	"schema_version_93": `alter table feeds add column notify_errors bool not null default 'f';
+alter table users add column notification_email text not null default '';
+`,
+	"schema_version_94": `alter table categories add column hidden bool not null default 'f';
+`,
+	"schema_version_95": `alter table users add column keep_starred_entries bool not null default 'f';
+alter table feeds add column orphaned_saves bool not null default 'f';
+`,
+	"schema_version_96": `alter table users add column archive_read_days int not null default 0;
+`,
+	"schema_version_97": `create table search_history (
+    id serial not null,
+    user_id int not null references users(id) on delete cascade,
+    query text not null,
+    searched_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique (user_id, query)
+);
+
+create table saved_searches (
+    id serial not null,
+    user_id int not null references users(id) on delete cascade,
+    query text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique (user_id, query)
+);
+`,
+	"schema_version_98": `create index entries_tags_idx on entries using gin(tags);
+`,
+	"schema_version_99": `create table playback_queue (
+    user_id int not null references users(id) on delete cascade,
+    entry_id bigint not null references entries(id) on delete cascade,
+    position int not null default 0,
+    primary key(user_id, entry_id)
+);
+
+create index playback_queue_user_position_idx on playback_queue(user_id, position);
+`,
 }
 
 var SqlMapChecksums = map[string]string{
-	"schema_version_1":  "00b2fa9e945565625c93ef9d4242a8b6583dc3cd7edf38d2fc95c0f3f7b926ae",
-	"schema_version_10": "8faf15ddeff7c8cc305e66218face11ed92b97df2bdc2d0d7944d61441656795",
-	"schema_version_11": "dc5bbc302e01e425b49c48ddcd8e29e3ab2bb8e73a6cd1858a6ba9fbec0b5243",
-	"schema_version_12": "a95abab6cdf64811fc744abd37457e2928939d999c5ef00d2bdd9398e16f32fb",
-	"schema_version_13": "9073fae1e796936f4a43a8120ebdb4218442fe7d346ace6387556a357c2d7edf",
-	"schema_version_14": "4622e42c4a5a88b6fe1e61f3d367b295968f7260ab5b96481760775ba9f9e1fe",
-	"schema_version_15": "13ff91462bdf4cda5a94a4c7a09f757761b0f2c32b4be713ba4786a4837750e4",
-	"schema_version_16": "9d006faca62fd7ab787f64aef0e0a5933d142466ec4cab0e096bb920d2797e34",
-	"schema_version_17": "b9f15d6217275fedcf6d948dd85ebe978b869bf37f42a86fd5b50a51919fa0e1",
-	"schema_version_18": "c0ec24847612c7f2dc326cf735baffba79391a56aedd73292371a39f38724a71",
-	"schema_version_19": "a83f77b41cc213d282805a5b518f15abbf96331599119f0ef4aca4be037add7b",
-	"schema_version_2":  "e8e9ff32478df04fcddad10a34cba2e8bb1e67e7977b5bd6cdc4c31ec94282b4",
-	"schema_version_20": "5d414c0cfc0da2863c641079afa58b7ff42dccb0f0e01c822ad435c3e3aa9201",
-	"schema_version_21": "77da01ee38918ff4fe33985fbb20ed3276a717a7584c2ca9ebcf4d4ab6cb6910",
-	"schema_version_22": "51ed5fbcae9877e57274511f0ef8c61d254ebd78dfbcbc043a2acd30f4c93ca3",
-	"schema_version_23": "cb3512d328436447f114e305048c0daa8af7505cfe5eab02778b0de1156081b2",
-	"schema_version_24": "1224754c5b9c6b4038599852bbe72656d21b09cb018d3970bd7c00f0019845bf",
-	"schema_version_25": "5262d2d4c88d637b6603a1fcd4f68ad257bd59bd1adf89c58a18ee87b12050d7",
-	"schema_version_26": "64f14add40691f18f514ac0eed10cd9b19c83a35e5c3d8e0bce667e0ceca9094",
-	"schema_version_27": "4235396b37fd7f52ff6f7526416042bb1649701233e2d99f0bcd583834a0a967",
-	"schema_version_28": "a64b5ba0b37fe3f209617b7d0e4dd05018d2b8362d2c9c528ba8cce19b77e326",
-	"schema_version_29": "527403d951d025b387baf7b1ab80c014752c5429cc0b9851aeb34b7716cf2c68",
-	"schema_version_3":  "a54745dbc1c51c000f74d4e5068f1e2f43e83309f023415b1749a47d5c1e0f12",
-	"schema_version_30": "3ec48a9b2e7a0fc32c85f31652f723565c34213f5f2d7e5e5076aad8f0b40d23",
-	"schema_version_31": "9290ef295731b03ddfe32dcaded0be70d41b63572420ad379cf2874a9b54581c",
-	"schema_version_32": "5b4de8dd2d7e3c6ae4150e0e3931df2ee989f2c667145bd67294e5a5f3fae456",
-	"schema_version_33": "bf38514efeb6c12511f41b1cc484f92722240b0a6ae874c32a958dfea3433d02",
-	"schema_version_34": "1a3e036f652fc98b7564a27013f04e1eb36dd0d68893c723168f134dc1065822",
-	"schema_version_35": "162a55df78eed4b9c9c141878132d5f1d97944b96f35a79e38f55716cdd6b3d2",
-	"schema_version_36": "8164be7818268ad3d4bdcad03a7868b58e32b27cde9b4f056cd82f7b182a0722",
-	"schema_version_37": "fc9eb1b452341664ddf24c1a9cf01502ac2578136e54a4853081652959285cb9",
-	"schema_version_4":  "216ea3a7d3e1704e40c797b5dc47456517c27dbb6ca98bf88812f4f63d74b5d9",
-	"schema_version_5":  "46397e2f5f2c82116786127e9f6a403e975b14d2ca7b652a48cd1ba843e6a27c",
-	"schema_version_6":  "9d05b4fb223f0e60efc716add5048b0ca9c37511cf2041721e20505d6d798ce4",
-	"schema_version_7":  "33f298c9aa30d6de3ca28e1270df51c2884d7596f1283a75716e2aeb634cd05c",
-	"schema_version_8":  "9922073fc4032d8922617ec6a6a07ae8d4817846c138760fb96cb5608ab83bfc",
-	"schema_version_9":  "de5ba954752fe808a993feef5bf0c6f808e0a4ced5379de8bec8342678150892",
+	"schema_version_1":   "00b2fa9e945565625c93ef9d4242a8b6583dc3cd7edf38d2fc95c0f3f7b926ae",
+	"schema_version_10":  "8faf15ddeff7c8cc305e66218face11ed92b97df2bdc2d0d7944d61441656795",
+	"schema_version_100": "41e4894774446e94ecc859024a18818a02c4efae6f17238d23a65e43a2bf2742",
+	"schema_version_101": "ea958ce01fde4317eb3d4938e03571d49a5483e10eba74106edd75860af1eaf2",
+	"schema_version_102": "bfdc0f3a42ce10a38893acf6973bc3383ff5ecdc1e08b55fc463e36dae287979",
+	"schema_version_103": "78ca68b7ffb94e1f882ce47fd064d0e26fc8932ef2e0bb74d3735403250c6a20",
+	"schema_version_104": "0d7b0f3019e19bf59f8bf0e818eff686f1c8df9810fcc6e8e8a302d2f096b7e2",
+	"schema_version_105": "1ba68216776d57b62c0bc85bb13f552124ee9dc6befe1fc27797def4b1e9b2ce",
+	"schema_version_106": "02578c7ca9daa8c30b369fa0e7227bd0644735cfea58e64f61463eef95c93c12",
+	"schema_version_107": "8cac28fcc1bc083d0162ca0ec292afd4b2df9c12baaa8bcc1074fff9b9bc720b",
+	"schema_version_108": "e53920086de2bfbb017397b6118ab9f11f030cce98bc5584df086a63dbec4c8a",
+	"schema_version_109": "37dd113a5104c6cb82d9e9a0f8d39441f10fb930b053adab4e020a38db1f7c20",
+	"schema_version_11":  "dc5bbc302e01e425b49c48ddcd8e29e3ab2bb8e73a6cd1858a6ba9fbec0b5243",
+	"schema_version_12":  "a95abab6cdf64811fc744abd37457e2928939d999c5ef00d2bdd9398e16f32fb",
+	"schema_version_13":  "9073fae1e796936f4a43a8120ebdb4218442fe7d346ace6387556a357c2d7edf",
+	"schema_version_14":  "4622e42c4a5a88b6fe1e61f3d367b295968f7260ab5b96481760775ba9f9e1fe",
+	"schema_version_15":  "13ff91462bdf4cda5a94a4c7a09f757761b0f2c32b4be713ba4786a4837750e4",
+	"schema_version_16":  "9d006faca62fd7ab787f64aef0e0a5933d142466ec4cab0e096bb920d2797e34",
+	"schema_version_17":  "b9f15d6217275fedcf6d948dd85ebe978b869bf37f42a86fd5b50a51919fa0e1",
+	"schema_version_18":  "c0ec24847612c7f2dc326cf735baffba79391a56aedd73292371a39f38724a71",
+	"schema_version_19":  "a83f77b41cc213d282805a5b518f15abbf96331599119f0ef4aca4be037add7b",
+	"schema_version_2":   "e8e9ff32478df04fcddad10a34cba2e8bb1e67e7977b5bd6cdc4c31ec94282b4",
+	"schema_version_20":  "5d414c0cfc0da2863c641079afa58b7ff42dccb0f0e01c822ad435c3e3aa9201",
+	"schema_version_21":  "77da01ee38918ff4fe33985fbb20ed3276a717a7584c2ca9ebcf4d4ab6cb6910",
+	"schema_version_22":  "51ed5fbcae9877e57274511f0ef8c61d254ebd78dfbcbc043a2acd30f4c93ca3",
+	"schema_version_23":  "cb3512d328436447f114e305048c0daa8af7505cfe5eab02778b0de1156081b2",
+	"schema_version_24":  "1224754c5b9c6b4038599852bbe72656d21b09cb018d3970bd7c00f0019845bf",
+	"schema_version_25":  "5262d2d4c88d637b6603a1fcd4f68ad257bd59bd1adf89c58a18ee87b12050d7",
+	"schema_version_26":  "64f14add40691f18f514ac0eed10cd9b19c83a35e5c3d8e0bce667e0ceca9094",
+	"schema_version_27":  "4235396b37fd7f52ff6f7526416042bb1649701233e2d99f0bcd583834a0a967",
+	"schema_version_28":  "a64b5ba0b37fe3f209617b7d0e4dd05018d2b8362d2c9c528ba8cce19b77e326",
+	"schema_version_29":  "527403d951d025b387baf7b1ab80c014752c5429cc0b9851aeb34b7716cf2c68",
+	"schema_version_3":   "a54745dbc1c51c000f74d4e5068f1e2f43e83309f023415b1749a47d5c1e0f12",
+	"schema_version_30":  "3ec48a9b2e7a0fc32c85f31652f723565c34213f5f2d7e5e5076aad8f0b40d23",
+	"schema_version_31":  "9290ef295731b03ddfe32dcaded0be70d41b63572420ad379cf2874a9b54581c",
+	"schema_version_32":  "5b4de8dd2d7e3c6ae4150e0e3931df2ee989f2c667145bd67294e5a5f3fae456",
+	"schema_version_33":  "bf38514efeb6c12511f41b1cc484f92722240b0a6ae874c32a958dfea3433d02",
+	"schema_version_34":  "1a3e036f652fc98b7564a27013f04e1eb36dd0d68893c723168f134dc1065822",
+	"schema_version_35":  "162a55df78eed4b9c9c141878132d5f1d97944b96f35a79e38f55716cdd6b3d2",
+	"schema_version_36":  "8164be7818268ad3d4bdcad03a7868b58e32b27cde9b4f056cd82f7b182a0722",
+	"schema_version_37":  "fc9eb1b452341664ddf24c1a9cf01502ac2578136e54a4853081652959285cb9",
+	"schema_version_38":  "788229826bdac3b23f57c5592d10d4a6bf53f58918f87d5e462a07a3e0d2e98e",
+	"schema_version_39":  "be9b51413ba0dcd732ccef93e15ab8686ca2a8f4545108cff74c18aa90db3f75",
+	"schema_version_4":   "216ea3a7d3e1704e40c797b5dc47456517c27dbb6ca98bf88812f4f63d74b5d9",
+	"schema_version_40":  "8e93b2afb20f7a216664de29e3dbcd2ef006ae6e67194286a2fde8d76549be17",
+	"schema_version_41":  "2c45f18857b5d5cf3a218f809a532466513459d9de8eed5a28148477c6adca5c",
+	"schema_version_42":  "ef7d6dc02aa5306fad02121221228ec22c6e0987e366ef4713e9a66d867f375c",
+	"schema_version_43":  "e7e16dc7dfd62f95c9fd7e216405d440bdde6a6818a26cec8d379ec670acd1ab",
+	"schema_version_44":  "4e4e7c2a5a15994ab02bde24a8bb07d9f0f9db8680dfdafb646506820488aaf0",
+	"schema_version_45":  "9538a7c69df2b58b9b51ffca176185b3048b0e15f5a49f26ca9583292361d7c9",
+	"schema_version_46":  "79c4b638c2e13dd5c8717892389278473b5e6318e93e5fa88b26fbac0af68e5e",
+	"schema_version_47":  "58c7148a97c9bd663354c1de32e2e22816040ac7fc5eb8bee2e1386cfa64c530",
+	"schema_version_48":  "927e3555dcbd8f3be9ac038e9b84ec5f3745d0ef9e4260b3a5733600a7474fb8",
+	"schema_version_49":  "52b6f1616a2a546400f766f49ef28750398cd505a49f2afb39f5b7c0cbf8dfc5",
+	"schema_version_5":   "46397e2f5f2c82116786127e9f6a403e975b14d2ca7b652a48cd1ba843e6a27c",
+	"schema_version_50":  "cbf36b50da39d3cd9433a6d92efadacde1dbafa8abbd39e616586deed94e11f8",
+	"schema_version_51":  "4eb290635f38081eb1de6db9c8483e2dba6e4b2cf066af13ff2c199260a0d302",
+	"schema_version_52":  "7f8327b7a9995b9c7e849d557f9b9bef9da6fbb4a2def454ef3dac0cd4618cdf",
+	"schema_version_53":  "e018d56779a076795fa10267b5293990c6b60f457f8b339443ecb1f34faac163",
+	"schema_version_54":  "422ae09bc5023579cd52c275866957548f9a970aa9083aa788db7b6f68a6b2bb",
+	"schema_version_55":  "88dd36a049a40f2163492a53e92076e3eb44ef529d5e020a19694da17e624b9d",
+	"schema_version_56":  "3f15fcd4dba8f3e7489a37d758869e4c7118103c706557846dfb8650c2c3a7f4",
+	"schema_version_57":  "dd02a55e1037b373c0b1f87acb8c9701606a849a965a56e82d4e11c9daf9aaed",
+	"schema_version_58":  "c413405e5df540359a03cb28f20b49805fdcbfec9a132abfbd06ab948662ae3b",
+	"schema_version_59":  "386fa6c47847aeb7d6b2ab425592abb33260af66f91b8fa7cb2d40dbf24e9d9f",
+	"schema_version_6":   "9d05b4fb223f0e60efc716add5048b0ca9c37511cf2041721e20505d6d798ce4",
+	"schema_version_60":  "e9e02cf8c8e741c7e45101c6af4d4c65f6b52bfd36c9b3a1f152d77d4454d819",
+	"schema_version_61":  "c2f44768cacbe9ab34846e087e86892e8ea86ed2e4b324167d2de4f882a4979b",
+	"schema_version_62":  "de7d3534b95f8d684208d4b114e4512c4e1b1b009a81eceefbbd786053a93a10",
+	"schema_version_63":  "8c7464406862f7cdf692f3f8c10142441d036b5946af936a1ff08f816dddd651",
+	"schema_version_64":  "52c2898931b9b43263ce39073a27a4e8ef61892c9f5d7a2f66986c504b000fd6",
+	"schema_version_65":  "095fcaf83e74c99733f60e51e144857c5f5f48a9eb1f5f2a8fff82f5975903c6",
+	"schema_version_66":  "af891439659821f91648047c85a9212ba6ccff41ff72f6fd0a1c9e77a4123e16",
+	"schema_version_67":  "76a98db7f80edf3b757960f44a26899f2a1529943043562b079b020e1dfeae6a",
+	"schema_version_68":  "c7f88e17cd5b5694500270d087566047f82a5f4090771643c69e4e7b5a805dba",
+	"schema_version_69":  "42fed945a782bd5077ece34330ccc5fa1ed4c6401a50cbaa4601c7c304168aff",
+	"schema_version_7":   "33f298c9aa30d6de3ca28e1270df51c2884d7596f1283a75716e2aeb634cd05c",
+	"schema_version_70":  "48e730b7f50fe965d8a3bd28932b51b3cec891551cdc33a5b0c3de619f0bcba3",
+	"schema_version_71":  "30ae304d23dbea8573dad1906de9d4f347e58b5ed089058fc8aaddbbadc42589",
+	"schema_version_72":  "ee7a0771e258d42a3865a551f0b1507ba79fcbda1be817bedc378b07e217c537",
+	"schema_version_73":  "9457bca7af45e0b5f3fdb60dcf5c8d4270cb0822688de759f185418d2a2163a2",
+	"schema_version_74":  "2b23dfb970815d84bd34775d3e9b2c80ba5a906c3c049ede4c8fff26f49760e0",
+	"schema_version_75":  "a2e8d3fa3fec25fd2c6b1330e975efd2702c8ef4668e30d678c598bd994b996e",
+	"schema_version_76":  "dd0972e7bdbb47c31f2dddad4a96b5b8b6f05a35749c85b771756b5e4cb422d4",
+	"schema_version_77":  "e19aea8fabf923cab43a0bc2bff1f1a295bc37ad8510521bf1bacbe79e9b87b4",
+	"schema_version_78":  "a06c242791682dd86d8f0283730a2bb33fb20882f333ddd0de60a0791301ffa2",
+	"schema_version_79":  "5361907a151bdb21ec5ff48a26dbafa68e45405db8ac4c55b02dfadd0e228ee2",
+	"schema_version_8":   "9922073fc4032d8922617ec6a6a07ae8d4817846c138760fb96cb5608ab83bfc",
+	"schema_version_80":  "a063243ca5f30c02f3f29ed5811a4530c42baee7fbd55d30aea4e72de63ead82",
+	"schema_version_81":  "add17a034f022faae8bd2eccfc8109f3dd036ee5f9fa77282608d2c3fd4a3b5f",
+	"schema_version_82":  "bb30f5318e8473eb7009bb1ac0767ffc3ac70d1f442fc2fc2f70ef1852916c6a",
+	"schema_version_83":  "f3afa2eb63fdc40ab19e3a731611beb8cc3ffb4c603dd1e3e8d6342cd86c0481",
+	"schema_version_84":  "7f50f0527cba18da0f076656b2127c02f84e9254ce3d5a0d01e6449f0235bf6a",
+	"schema_version_85":  "0b5e95b98763f9dc3c433deb90b47ab7ae4855ee25843257d54e481c2afc26db",
+	"schema_version_86":  "4d2ae247389a7e671435bd09576fd28f4ed3c87bea0eb82e145791fee8b18b91",
+	"schema_version_87":  "0f4390e231fa751bc8b1a78ea30ed6770d71948aad073288b11b1d532eb9a247",
+	"schema_version_88":  "55ac213c40abb57aea4a3e68166ca68bea2f82c8c30422629631ee118b69a5b5",
+	"schema_version_89":  "e5a6937826fa18cce01d35d021b13b59595850be92edc5210baa2f43eaed9fa0",
+	"schema_version_9":   "de5ba954752fe808a993feef5bf0c6f808e0a4ced5379de8bec8342678150892",
+	"schema_version_90":  "addd501bc685d4c85ed26f775898bced651b0a84c3cc9fcf1a6dd9994c22a56f",
+	"schema_version_91":  "09bedd1bbb924e16f3095aac946da4e3daffa3327d86cd6c193eca4c9ca7afe8",
+	"schema_version_92":  "aa88be728487037173d22b7e502ef70913e21b42eff2ac67ca4d76018d56671d",
+	"schema_version_93":  "8e1b6fa023af9905bd92f1a2d5f3f9130e5cd421d9dc610b6a65270324a38bca",
+	"schema_version_94":  "0e1fb7f592abfcd7d99d8a48d25945497138fa22b825c8da6f0416fbb65778e2",
+	"schema_version_95":  "b684382d56e12d402fe3cebe7e02a33829c052719ae1a0a1ec917910552a46cf",
+	"schema_version_96":  "8a7f9aa9dcf375a446575690d2afc3d836c2ba484917587628fa702796db55cc",
+	"schema_version_97":  "ffbe5ddee62890bdb2158b39695a14cd31311d533ba359b0e15d0c24f14bbf9f",
+	"schema_version_98":  "1094682ec6ac13674a5a78377598f594f4667d59a9c9b938b74933686a1c51ef",
+	"schema_version_99":  "d47782b8bd2d5cd4753c5ad24f63f617aabe9198fd04a64122654e633a46dd9a",
 }
@@ -0,0 +1,9 @@
+alter table category_members add column accepted_at timestamp with time zone;
+alter table category_members alter column member_category_id drop not null;
+update category_members set accepted_at=created_at;
+
+create table shared_feed_exclusions (
+    user_id int not null references users(id) on delete cascade,
+    feed_id bigint not null references feeds(id) on delete cascade,
+    primary key(user_id, feed_id)
+);
@@ -0,0 +1,10 @@
+-- The trigram indexes speed up the search qualifiers, they are skipped when the database user cannot create the extension.
+do $$
+begin
+    create extension if not exists pg_trgm;
+    create index entries_title_trgm_idx on entries using gin (title gin_trgm_ops);
+    create index entries_author_trgm_idx on entries using gin (author gin_trgm_ops);
+exception when insufficient_privilege or undefined_file then
+    raise notice 'pg_trgm is not available, the search qualifiers are not indexed';
+end
+$$;
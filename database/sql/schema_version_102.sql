@@ -0,0 +1,2 @@
+alter table integrations add column restricted_services text[] not null default '{}';
+update integrations set restricted_services=array(select distinct service from integration_routes r where r.user_id=integrations.user_id);
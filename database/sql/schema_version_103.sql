@@ -0,0 +1,5 @@
+alter table integrations add column ntfy_enabled bool not null default 'f';
+alter table integrations add column ntfy_topic_url text not null default '';
+alter table integrations add column ntfy_token text not null default '';
+alter table users add column notification_email_verified bool not null default 'f';
+alter table users add column notification_email_token text not null default '';
@@ -0,0 +1 @@
+alter table integrations add column instapaper_folders jsonb not null default '[]';
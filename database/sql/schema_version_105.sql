@@ -0,0 +1 @@
+alter table users add column accept_received_entries bool not null default 'f';
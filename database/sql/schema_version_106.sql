@@ -0,0 +1,4 @@
+alter table saved_searches add column feed_id bigint references feeds(id) on delete cascade;
+alter table saved_searches add column category_id int references categories(id) on delete cascade;
+alter table saved_searches drop constraint saved_searches_user_id_query_key;
+create unique index saved_searches_user_id_query_scope_idx on saved_searches(user_id, query, coalesce(feed_id, 0), coalesce(category_id, 0));
@@ -0,0 +1,10 @@
+create table sent_emails (
+    id bigserial not null,
+    user_id int not null,
+    recipients int not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index sent_emails_user_id_created_at_idx on sent_emails(user_id, created_at);
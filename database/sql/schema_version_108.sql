@@ -0,0 +1 @@
+drop index if exists feeds_title_trgm_idx;
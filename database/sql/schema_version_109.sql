@@ -0,0 +1,3 @@
+alter table entry_snapshots add column size int not null default 0;
+update entry_snapshots set size=octet_length(content);
+create index entry_snapshots_user_id_idx on entry_snapshots(user_id);
@@ -0,0 +1 @@
+alter table users add column mark_read_on_original_link boolean default 'f';
\ No newline at end of file
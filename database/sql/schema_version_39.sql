@@ -0,0 +1 @@
+alter table feeds add column open_external_link boolean default 'f';
@@ -0,0 +1,9 @@
+create table domain_rules (
+    id serial not null,
+    domain text not null,
+    rewrite_rules text not null default '',
+    scraper_rules text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique(domain)
+);
@@ -0,0 +1 @@
+alter table entries add column removed_trackers int not null default 0;
@@ -0,0 +1 @@
+alter table feeds add column encoding text not null default '';
@@ -0,0 +1,5 @@
+alter table feeds add column bearer_token text not null default '';
+alter table feeds add column oauth2_token_url text not null default '';
+alter table feeds add column oauth2_client_id text not null default '';
+alter table feeds add column oauth2_client_secret text not null default '';
+alter table feeds add column oauth2_scopes text not null default '';
@@ -0,0 +1,2 @@
+alter table integrations add column rssbridge_enabled bool default 'f';
+alter table integrations add column rssbridge_url text default '';
@@ -0,0 +1,2 @@
+alter table entries add column reading_time int not null default 0;
+alter table users add column youtube_embed_url text not null default '';
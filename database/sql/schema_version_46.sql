@@ -0,0 +1,4 @@
+alter table entries add column score int not null default 0;
+alter table entries add column comments_count int not null default 0;
+alter table feeds add column fetch_scores bool not null default 'f';
+alter table feeds add column minimum_score int not null default 0;
@@ -0,0 +1,12 @@
+create type muted_keyword_action as enum('drop', 'read');
+
+create table muted_keywords (
+    id serial not null,
+    user_id int not null references users(id) on delete cascade,
+    pattern text not null,
+    action muted_keyword_action not null default 'drop',
+    expires_at timestamp with time zone,
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique (user_id, pattern)
+);
@@ -0,0 +1,2 @@
+alter table feeds add column blocked_authors text not null default '';
+alter table users add column blocked_authors text not null default '';
@@ -0,0 +1,3 @@
+alter table feeds add column auto_star bool not null default 'f';
+alter table users add column auto_star_keywords text not null default '';
+alter table users add column auto_star_authors text not null default '';
@@ -0,0 +1,9 @@
+create table trending_entries (
+    user_id int not null,
+    entry_id bigint not null,
+    topic_id int not null,
+    score int not null,
+    primary key (user_id, entry_id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
@@ -0,0 +1,9 @@
+create table entry_tombstones (
+    user_id int not null,
+    entry_id bigint not null,
+    removed_at timestamp with time zone not null default now(),
+    primary key (user_id, entry_id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index entry_tombstones_removed_at_idx on entry_tombstones(user_id, removed_at);
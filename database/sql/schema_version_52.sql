@@ -0,0 +1,2 @@
+alter table feeds add column custom_title text;
+update feeds set custom_title=title;
@@ -0,0 +1 @@
+alter table feeds add column hide_globally bool not null default 'f';
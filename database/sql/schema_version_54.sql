@@ -0,0 +1 @@
+alter table feeds add column cron_expression text not null default '';
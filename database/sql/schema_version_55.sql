@@ -0,0 +1 @@
+alter table feeds add column failing_since timestamp with time zone;
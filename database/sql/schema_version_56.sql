@@ -0,0 +1,4 @@
+alter table feeds add column dead bool not null default 'f';
+alter table feeds add column permanent_redirect_count int not null default 0;
+alter table feeds add column previous_feed_url text not null default '';
+alter table feeds add column feed_url_updated_at timestamp with time zone;
@@ -0,0 +1 @@
+alter table feeds add column canonical_feed_url text not null default '';
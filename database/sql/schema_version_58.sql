@@ -0,0 +1,3 @@
+alter table feeds add column last_fetch_url text not null default '';
+alter table feeds add column last_fetch_content_type text not null default '';
+alter table feeds add column last_fetch_size int not null default 0;
@@ -0,0 +1,2 @@
+alter table feeds add column request_timeout int not null default 0;
+alter table feeds add column max_body_size int not null default 0;
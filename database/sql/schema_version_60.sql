@@ -0,0 +1 @@
+alter table entries add column reading_position real not null default 0;
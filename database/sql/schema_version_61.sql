@@ -0,0 +1 @@
+alter table users add column email_recipients text not null default '';
@@ -0,0 +1,4 @@
+alter table entries add column tags text[] not null default '{}';
+alter table integrations add column pinboard_category_tag bool not null default 'f';
+alter table integrations add column pinboard_entry_tags bool not null default 'f';
+alter table integrations add column pinboard_prompt_tags bool not null default 'f';
@@ -0,0 +1,2 @@
+alter table integrations add column wallabag_tags text not null default '';
+alter table integrations add column wallabag_archive bool not null default 'f';
@@ -0,0 +1 @@
+alter table integrations add column instapaper_folder_id text not null default '';
@@ -0,0 +1 @@
+alter table integrations add column pocket_category_tag bool not null default 'f';
@@ -0,0 +1,5 @@
+alter table integrations add column custom_bookmark_enabled bool not null default 'f';
+alter table integrations add column custom_bookmark_url text not null default '';
+alter table integrations add column custom_bookmark_method text not null default 'POST';
+alter table integrations add column custom_bookmark_headers text not null default '';
+alter table integrations add column custom_bookmark_body text not null default '';
@@ -0,0 +1,8 @@
+create table integration_routes (
+    user_id int not null,
+    service text not null,
+    category_id int not null,
+    primary key (user_id, service, category_id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete cascade
+);
@@ -0,0 +1 @@
+alter table enclosures add column duration int not null default 0;
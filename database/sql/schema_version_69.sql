@@ -0,0 +1 @@
+alter table enclosures add column poster_url text not null default '';
@@ -0,0 +1,2 @@
+alter table entries add column latitude double precision;
+alter table entries add column longitude double precision;
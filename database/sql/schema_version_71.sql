@@ -0,0 +1 @@
+alter table users add column home_page text not null default 'unread';
@@ -0,0 +1,8 @@
+create table user_preferences (
+    user_id bigint not null references users(id) on delete cascade,
+    namespace text not null,
+    key text not null,
+    value jsonb not null,
+    updated_at timestamp with time zone not null default now(),
+    primary key (user_id, namespace, key)
+);
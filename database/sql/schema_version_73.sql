@@ -0,0 +1,4 @@
+alter table entries add column created_at timestamp with time zone not null default now();
+update entries set created_at = published_at;
+alter table users add column last_seen_at timestamp with time zone;
+alter table users add column previous_visit_at timestamp with time zone;
@@ -0,0 +1,5 @@
+alter table feeds add column created_at timestamp with time zone not null default now();
+alter table feeds add column last_read_at timestamp with time zone;
+alter table feeds add column unused bool not null default 'f';
+update feeds set created_at = coalesce((select min(e.created_at) from entries e where e.feed_id = feeds.id), now());
+update feeds set last_read_at = (select max(e.changed_at) from entries e where e.feed_id = feeds.id and (e.status = 'read' or e.starred));
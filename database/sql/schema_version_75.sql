@@ -0,0 +1,5 @@
+alter table integrations add column markdown_enabled bool not null default 'f';
+alter table integrations add column markdown_webdav_url text not null default '';
+alter table integrations add column markdown_webdav_username text not null default '';
+alter table integrations add column markdown_webdav_password text not null default '';
+alter table integrations add column markdown_starred bool not null default 'f';
@@ -0,0 +1,16 @@
+create table annotations (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    quote text not null default '',
+    note text not null default '',
+    hypothesis_id text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+create index annotations_entry_idx on annotations(entry_id);
+alter table integrations add column hypothesis_enabled bool not null default 'f';
+alter table integrations add column hypothesis_token text not null default '';
+alter table integrations add column hypothesis_group text not null default '';
@@ -0,0 +1,5 @@
+alter table integrations add column zotero_enabled bool not null default 'f';
+alter table integrations add column zotero_library_type text not null default 'user';
+alter table integrations add column zotero_library_id text not null default '';
+alter table integrations add column zotero_api_key text not null default '';
+alter table integrations add column zotero_collection_key text not null default '';
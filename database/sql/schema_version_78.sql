@@ -0,0 +1,4 @@
+alter table integrations add column karakeep_enabled bool not null default 'f';
+alter table integrations add column karakeep_url text not null default '';
+alter table integrations add column karakeep_api_key text not null default '';
+alter table integrations add column karakeep_tags text not null default '';
@@ -0,0 +1,4 @@
+alter table integrations add column espial_enabled bool not null default 'f';
+alter table integrations add column espial_url text not null default '';
+alter table integrations add column espial_api_key text not null default '';
+alter table integrations add column espial_tags text not null default 'miniflux';
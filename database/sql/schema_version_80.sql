@@ -0,0 +1,7 @@
+alter table integrations add column linkace_enabled bool not null default 'f';
+alter table integrations add column linkace_url text not null default '';
+alter table integrations add column linkace_api_key text not null default '';
+alter table integrations add column linkace_tags text not null default '';
+alter table integrations add column linkace_lists text not null default '';
+alter table integrations add column linkace_category_tag bool not null default 'f';
+alter table integrations add column linkace_is_private bool not null default 't';
@@ -0,0 +1,13 @@
+create table entry_archives (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    service text not null,
+    url text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    unique (entry_id, service),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+alter table integrations add column wayback_enabled bool not null default 'f';
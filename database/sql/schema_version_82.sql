@@ -0,0 +1,4 @@
+alter table integrations add column archivebox_enabled bool not null default 'f';
+alter table integrations add column archivebox_url text not null default '';
+alter table integrations add column archivebox_api_key text not null default '';
+alter table integrations add column archivebox_tags text not null default '';
@@ -0,0 +1,10 @@
+alter table feeds add column archive_pages bool not null default 'f';
+
+create table entry_snapshots (
+    entry_id bigint not null primary key,
+    user_id int not null,
+    content bytea not null,
+    created_at timestamp with time zone not null default now(),
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (user_id) references users(id) on delete cascade
+);
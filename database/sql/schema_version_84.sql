@@ -0,0 +1,22 @@
+alter table entries add column comments_feed_url text not null default '';
+
+create table entry_comment_subscriptions (
+    entry_id bigint not null primary key,
+    user_id int not null,
+    expires_at timestamp with time zone not null,
+    checked_at timestamp with time zone,
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table entry_comments (
+    id bigserial not null primary key,
+    entry_id bigint not null,
+    hash text not null,
+    author text not null default '',
+    url text not null default '',
+    content text not null default '',
+    published_at timestamp with time zone not null,
+    unique (entry_id, hash),
+    foreign key (entry_id) references entries(id) on delete cascade
+);
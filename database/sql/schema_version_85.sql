@@ -0,0 +1,17 @@
+create table received_entries (
+    id bigserial not null primary key,
+    sender_id int not null,
+    recipient_id int not null,
+    title text not null,
+    url text not null,
+    author text not null default '',
+    content text not null default '',
+    feed_title text not null default '',
+    published_at timestamp with time zone not null,
+    message text not null default '',
+    created_at timestamp with time zone not null default now(),
+    foreign key (sender_id) references users(id) on delete cascade,
+    foreign key (recipient_id) references users(id) on delete cascade
+);
+
+create index received_entries_recipient_idx on received_entries(recipient_id, created_at);
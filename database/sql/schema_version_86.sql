@@ -0,0 +1,15 @@
+create table category_members (
+    category_id int not null,
+    user_id int not null,
+    member_category_id int not null,
+    created_at timestamp with time zone not null default now(),
+    primary key (category_id, user_id),
+    foreign key (category_id) references categories(id) on delete cascade,
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (member_category_id) references categories(id) on delete cascade
+);
+
+create index category_members_member_category_idx on category_members(member_category_id);
+
+alter table feeds add column shared_feed_id bigint references feeds(id) on delete cascade;
+create index feeds_shared_feed_idx on feeds(shared_feed_id);
@@ -0,0 +1,11 @@
+create table feed_contents (
+    url_hash text not null primary key,
+    feed_url text not null,
+    effective_url text not null default '',
+    permanent_redirect bool not null default 'f',
+    content_type text not null default '',
+    etag_header text not null default '',
+    last_modified_header text not null default '',
+    content bytea not null,
+    fetched_at timestamp with time zone not null default now()
+);
@@ -0,0 +1,6 @@
+create table blocked_feeds (
+    id bigserial not null primary key,
+    pattern text not null unique,
+    reason text not null default '',
+    created_at timestamp with time zone not null default now()
+);
@@ -0,0 +1,2 @@
+alter table entries add column published_offset int;
+alter table users add column entry_timezone text not null default 'local';
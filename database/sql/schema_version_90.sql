@@ -0,0 +1 @@
+alter table users add column timestamp_format text not null default 'relative';
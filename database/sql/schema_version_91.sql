@@ -0,0 +1,13 @@
+create table feed_entry_counts (
+    feed_id bigint not null,
+    day date not null,
+    entry_count int not null default 0,
+    primary key (feed_id, day),
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+
+insert into feed_entry_counts (feed_id, day, entry_count)
+    select feed_id, (published_at at time zone 'UTC')::date, count(*)
+    from entries
+    where published_at > now() - interval '365 days'
+    group by 1, 2;
@@ -0,0 +1,6 @@
+alter table feeds add column last_entry_at timestamp with time zone;
+alter table feeds add column dormant bool not null default 'f';
+
+update feeds set last_entry_at=e.created_at
+    from (select feed_id, max(created_at) as created_at from entries group by feed_id) e
+    where e.feed_id=feeds.id;
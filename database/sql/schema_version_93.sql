@@ -0,0 +1,2 @@
+alter table feeds add column notify_errors bool not null default 'f';
+alter table users add column notification_email text not null default '';
@@ -0,0 +1 @@
+alter table categories add column hidden bool not null default 'f';
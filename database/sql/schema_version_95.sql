@@ -0,0 +1,2 @@
+alter table users add column keep_starred_entries bool not null default 'f';
+alter table feeds add column orphaned_saves bool not null default 'f';
@@ -0,0 +1 @@
+alter table users add column archive_read_days int not null default 0;
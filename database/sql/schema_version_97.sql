@@ -0,0 +1,17 @@
+create table search_history (
+    id serial not null,
+    user_id int not null references users(id) on delete cascade,
+    query text not null,
+    searched_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique (user_id, query)
+);
+
+create table saved_searches (
+    id serial not null,
+    user_id int not null references users(id) on delete cascade,
+    query text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique (user_id, query)
+);
@@ -0,0 +1 @@
+create index entries_tags_idx on entries using gin(tags);
@@ -0,0 +1,8 @@
+create table playback_queue (
+    user_id int not null references users(id) on delete cascade,
+    entry_id bigint not null references entries(id) on delete cascade,
+    position int not null default 0,
+    primary key(user_id, entry_id)
+);
+
+create index playback_queue_user_position_idx on playback_queue(user_id, position);
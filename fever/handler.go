@@ -143,7 +143,7 @@ func (h *handler) handleFeeds(w http.ResponseWriter, r *http.Request) {
 	for _, f := range feeds {
 		subscripion := feed{
 			ID:          f.ID,
-			Title:       f.Title,
+			Title:       f.DisplayTitle(),
 			URL:         f.FeedURL,
 			SiteURL:     f.SiteURL,
 			IsSpark:     0,
@@ -435,6 +435,7 @@ func (h *handler) handleWriteItems(w http.ResponseWriter, r *http.Request) {
 
 		go func() {
 			integration.SendEntry(entry, settings)
+			integration.ArchiveEntries(h.store, model.Entries{entry}, settings)
 		}()
 	case "unsaved":
 		logger.Debug("[Fever] Mark entry #%d as unsaved for user #%d", entryID, userID)
@@ -191,6 +191,10 @@ func main() {
 			"ui/static/js/keyboard_handler.js",
 			"ui/static/js/request_builder.js",
 			"ui/static/js/modal_handler.js",
+			"ui/static/js/live_update_handler.js",
+			"ui/static/js/entry_status_synchronizer.js",
+			"ui/static/js/reading_position_handler.js",
+			"ui/static/js/playback_queue_handler.js",
 			"ui/static/js/app.js",
 			"ui/static/js/bootstrap.js",
 		},
@@ -211,6 +215,7 @@ func main() {
 		"dark_sans_serif":   []string{"ui/static/css/dark.css", "ui/static/css/sans_serif.css", "ui/static/css/common.css"},
 		"system_serif":      []string{"ui/static/css/system.css", "ui/static/css/serif.css", "ui/static/css/common.css"},
 		"system_sans_serif": []string{"ui/static/css/system.css", "ui/static/css/sans_serif.css", "ui/static/css/common.css"},
+		"high_contrast":     []string{"ui/static/css/high_contrast.css", "ui/static/css/sans_serif.css", "ui/static/css/common.css"},
 	})
 
 	generateBinaryBundle("ui/static/bin.go", glob("ui/static/bin/*"))
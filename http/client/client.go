@@ -23,6 +23,8 @@ import (
 	"miniflux.app/timer"
 	url_helper "miniflux.app/url"
 	"miniflux.app/version"
+
+	"golang.org/x/oauth2/clientcredentials"
 )
 
 const (
@@ -51,8 +53,12 @@ type Client struct {
 	requestUsername            string
 	requestPassword            string
 	requestUserAgent           string
+	requestEncoding            string
+	requestOAuth2Config        *clientcredentials.Config
+	requestHeaders             http.Header
 
 	useProxy bool
+	policy   *NetworkPolicy
 
 	ClientTimeout     int
 	ClientMaxBodySize int64
@@ -60,6 +66,8 @@ type Client struct {
 }
 
 // New initializes a new HTTP client.
+// The network policy doesn't apply, the integrations reach the endpoints configured by the user,
+// and these are usually self-hosted on a local network.
 func New(url string) *Client {
 	return &Client{
 		inputURL:          url,
@@ -77,6 +85,7 @@ func NewClientWithConfig(url string, opts *config.Options) *Client {
 		ClientTimeout:     opts.HTTPClientTimeout(),
 		ClientMaxBodySize: opts.HTTPClientMaxBodySize(),
 		ClientProxyURL:    opts.HTTPClientProxy(),
+		policy:            newNetworkPolicyWithConfig(opts),
 	}
 }
 
@@ -97,7 +106,7 @@ func (c *Client) String() string {
 		c.requestURL,
 		etagHeader,
 		lastModifiedHeader,
-		c.requestAuthorizationHeader != "" || (c.requestUsername != "" && c.requestPassword != ""),
+		c.requestAuthorizationHeader != "" || c.requestOAuth2Config != nil || (c.requestUsername != "" && c.requestPassword != ""),
 		c.requestUserAgent,
 	)
 }
@@ -117,13 +126,55 @@ func (c *Client) WithAuthorization(authorization string) *Client {
 	return c
 }
 
+// WithHeader adds a custom HTTP header to the request.
+func (c *Client) WithHeader(key, value string) *Client {
+	if c.requestHeaders == nil {
+		c.requestHeaders = make(http.Header)
+	}
+	c.requestHeaders.Add(key, value)
+	return c
+}
+
+// WithBearerToken defines the token sent in the Authorization HTTP header.
+func (c *Client) WithBearerToken(token string) *Client {
+	if token != "" {
+		c.requestAuthorizationHeader = "Bearer " + token
+	}
+	return c
+}
+
+// WithOAuth2ClientCredentials fetches an access token with the OAuth2 client credentials flow before each request.
+func (c *Client) WithOAuth2ClientCredentials(tokenURL, clientID, clientSecret string, scopes []string) *Client {
+	if tokenURL != "" && clientID != "" {
+		c.requestOAuth2Config = &clientcredentials.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			TokenURL:     tokenURL,
+			Scopes:       scopes,
+		}
+	}
+	return c
+}
+
 // WithCacheHeaders defines caching headers.
 func (c *Client) WithCacheHeaders(etagHeader, lastModifiedHeader string) *Client {
-	c.requestLastModifiedHeader = etagHeader
+	c.requestEtagHeader = etagHeader
 	c.requestLastModifiedHeader = lastModifiedHeader
 	return c
 }
 
+// WithTimeout overrides the request timeout (in seconds).
+func (c *Client) WithTimeout(timeout int) *Client {
+	c.ClientTimeout = timeout
+	return c
+}
+
+// WithMaxBodySize overrides the maximum response size (in bytes).
+func (c *Client) WithMaxBodySize(size int64) *Client {
+	c.ClientMaxBodySize = size
+	return c
+}
+
 // WithProxy enable proxy for the current HTTP request.
 func (c *Client) WithProxy() *Client {
 	c.useProxy = true
@@ -138,6 +189,12 @@ func (c *Client) WithUserAgent(userAgent string) *Client {
 	return c
 }
 
+// WithEncoding overrides the character encoding announced by the remote server.
+func (c *Client) WithEncoding(encoding string) *Client {
+	c.requestEncoding = encoding
+	return c
+}
+
 // Get performs a GET HTTP request.
 func (c *Client) Get() (*Response, error) {
 	request, err := c.buildRequest(http.MethodGet, nil)
@@ -175,6 +232,31 @@ func (c *Client) PostJSON(data interface{}) (*Response, error) {
 	return c.executeRequest(request)
 }
 
+// SendJSON performs an HTTP request with the given method and an already encoded JSON payload.
+func (c *Client) SendJSON(method string, payload []byte) (*Response, error) {
+	request, err := c.buildRequest(method, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Header.Get("Content-Type") == "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	return c.executeRequest(request)
+}
+
+// Send performs an HTTP request with the given method, content type and payload.
+func (c *Client) Send(method, contentType string, payload []byte) (*Response, error) {
+	request, err := c.buildRequest(method, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", contentType)
+	return c.executeRequest(request)
+}
+
 func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[HttpClient] inputURL=%s", c.inputURL))
 
@@ -183,6 +265,12 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 		c.String(),
 	)
 
+	if c.policy != nil {
+		if err := c.policy.CheckURL(request.URL); err != nil {
+			return nil, err
+		}
+	}
+
 	client := c.buildClient()
 	resp, err := client.Do(request)
 	if resp != nil {
@@ -192,6 +280,8 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 	if err != nil {
 		if uerr, ok := err.(*url.Error); ok {
 			switch uerr.Err.(type) {
+			case *errors.LocalizedError:
+				err = uerr.Err
 			case x509.CertificateInvalidError, x509.HostnameError:
 				err = errors.NewLocalizedError(errInvalidCertificate, uerr.Err)
 			case *net.OpError:
@@ -231,8 +321,12 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 		Expires:       resp.Header.Get("Expires"),
 		ContentType:   resp.Header.Get("Content-Type"),
 		ContentLength: resp.ContentLength,
+		BodySize:      len(buf),
+		encoding:      c.requestEncoding,
 	}
 
+	response.PermanentRedirect = isPermanentRedirect(resp.Request)
+
 	logger.Debug("[HttpClient:After] Method=%s %s; Response => %s",
 		request.Method,
 		c.String(),
@@ -262,19 +356,22 @@ func (c *Client) buildRequest(method string, body io.Reader) (*http.Request, err
 		request.SetBasicAuth(c.requestUsername, c.requestPassword)
 	}
 
+	if c.requestOAuth2Config != nil {
+		httpClient := c.buildClient()
+		token, err := getTokenSource(c.requestOAuth2Config, &httpClient).Token()
+		if err != nil {
+			return nil, fmt.Errorf("client: unable to fetch OAuth2 access token: %v", err)
+		}
+		token.SetAuthHeader(request)
+	}
+
 	return request, nil
 }
 
 func (c *Client) buildClient() http.Client {
 	client := http.Client{Timeout: time.Duration(c.ClientTimeout) * time.Second}
 	transport := &http.Transport{
-		DialContext: (&net.Dialer{
-			// Default is 30s.
-			Timeout: 10 * time.Second,
-
-			// Default is 30s.
-			KeepAlive: 15 * time.Second,
-		}).DialContext,
+		DialContext: newDialer().DialContext,
 
 		// Default is 100.
 		MaxIdleConns: 50,
@@ -293,11 +390,29 @@ func (c *Client) buildClient() http.Client {
 		}
 	}
 
+	// The proxy resolves the host names, the policy applies only to direct connections.
+	if c.policy != nil {
+		client.CheckRedirect = c.policy.CheckRedirect
+		if transport.Proxy == nil {
+			transport.DialContext = c.policy.DialContext(newDialer())
+		}
+	}
+
 	client.Transport = transport
 
 	return client
 }
 
+func newDialer() *net.Dialer {
+	return &net.Dialer{
+		// Default is 30s.
+		Timeout: 10 * time.Second,
+
+		// Default is 30s.
+		KeepAlive: 15 * time.Second,
+	}
+}
+
 func (c *Client) buildHeaders() http.Header {
 	headers := make(http.Header)
 	headers.Add("User-Agent", c.requestUserAgent)
@@ -315,6 +430,25 @@ func (c *Client) buildHeaders() http.Header {
 		headers.Add("Authorization", c.requestAuthorizationHeader)
 	}
 
+	for key, values := range c.requestHeaders {
+		headers[key] = values
+	}
+
 	headers.Add("Connection", "close")
 	return headers
 }
+
+// isPermanentRedirect returns true if every redirect that led to this request was permanent.
+func isPermanentRedirect(request *http.Request) bool {
+	if request.Response == nil {
+		return false
+	}
+
+	for r := request; r.Response != nil; r = r.Response.Request {
+		if r.Response.StatusCode != http.StatusMovedPermanently && r.Response.StatusCode != http.StatusPermanentRedirect {
+			return false
+		}
+	}
+
+	return true
+}
@@ -4,7 +4,13 @@
 
 package client // import "miniflux.app/http/client"
 
-import "testing"
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
 
 func TestClientWithDelay(t *testing.T) {
 	clt := New("http://httpbin.org/delay/5")
@@ -49,3 +55,200 @@ func TestClientWithBasicAuth(t *testing.T) {
 		t.Fatalf(`The client should be authenticated successfully: %v`, err)
 	}
 }
+
+func TestClientWithBearerToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer ts.Close()
+
+	clt := New(ts.URL)
+	clt.WithBearerToken("secret")
+	response, err := clt.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf(`Unexpected response status code: %d`, response.StatusCode)
+	}
+}
+
+func TestClientWithOAuth2ClientCredentials(t *testing.T) {
+	tokenRequests := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		r.ParseForm()
+		if r.PostForm.Get("grant_type") != "client_credentials" || r.PostForm.Get("scope") != "read feeds" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "token123", "token_type": "bearer", "expires_in": 3600}`)
+	}))
+	defer tokenServer.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token123" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer ts.Close()
+
+	for i := 0; i < 2; i++ {
+		clt := New(ts.URL)
+		clt.WithOAuth2ClientCredentials(tokenServer.URL, "client", "secret", []string{"read", "feeds"})
+		response, err := clt.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if response.StatusCode != http.StatusOK {
+			t.Fatalf(`Unexpected response status code: %d`, response.StatusCode)
+		}
+	}
+
+	if tokenRequests != 1 {
+		t.Fatalf(`The access token should be reused, got %d token requests`, tokenRequests)
+	}
+}
+
+func TestRemoveIdleTokenSources(t *testing.T) {
+	now := time.Now()
+
+	tokenSourcesMutex.Lock()
+	defer tokenSourcesMutex.Unlock()
+
+	tokenSources["idle"] = &cachedTokenSource{lastUsedAt: now.Add(-tokenSourceIdleTTL - time.Minute)}
+	tokenSources["active"] = &cachedTokenSource{lastUsedAt: now}
+	removeIdleTokenSources(now)
+
+	if _, found := tokenSources["idle"]; found {
+		t.Error(`The idle token source should be removed`)
+	}
+
+	if _, found := tokenSources["active"]; !found {
+		t.Error(`The active token source should be kept`)
+	}
+
+	delete(tokenSources, "active")
+}
+
+func TestClientWithOAuth2ClientCredentialsError(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer tokenServer.Close()
+
+	clt := New("http://localhost/feed.xml")
+	clt.WithOAuth2ClientCredentials(tokenServer.URL, "client", "wrong", nil)
+	if _, err := clt.Get(); err == nil {
+		t.Fatal(`The client should fails when the access token cannot be fetched`)
+	}
+}
+
+func TestClientWithPermanentRedirect(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/old.xml", http.RedirectHandler("/feed.xml", http.StatusMovedPermanently))
+	mux.Handle("/moved.xml", http.RedirectHandler("/old.xml", http.StatusPermanentRedirect))
+	mux.Handle("/temporary.xml", http.RedirectHandler("/old.xml", http.StatusFound))
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("feed"))
+	})
+
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	scenarios := map[string]bool{
+		"/feed.xml":      false,
+		"/old.xml":       true,
+		"/moved.xml":     true,
+		"/temporary.xml": false,
+	}
+
+	for path, expected := range scenarios {
+		response, err := New(ts.URL + path).Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if response.EffectiveURL != ts.URL+"/feed.xml" {
+			t.Errorf(`Unexpected effective URL for %q: %q`, path, response.EffectiveURL)
+		}
+
+		if response.PermanentRedirect != expected {
+			t.Errorf(`Unexpected permanent redirect flag for %q: got %v instead of %v`, path, response.PermanentRedirect, expected)
+		}
+	}
+}
+
+func TestClientBodySize(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		w.Write([]byte("<rss></rss>"))
+	}))
+	defer ts.Close()
+
+	response, err := New(ts.URL).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.BodySize != 11 {
+		t.Errorf(`Unexpected body size, got %d`, response.BodySize)
+	}
+
+	if response.ContentType != "application/rss+xml" {
+		t.Errorf(`Unexpected content type, got %q`, response.ContentType)
+	}
+}
+
+func TestClientWithMaxBodySize(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "100")
+		w.Write(make([]byte, 100))
+	}))
+	defer ts.Close()
+
+	if _, err := New(ts.URL).WithMaxBodySize(10).Get(); err == nil {
+		t.Fatal(`The client should fails when reading a response too large`)
+	}
+
+	if _, err := New(ts.URL).WithMaxBodySize(100).Get(); err != nil {
+		t.Fatalf(`The client should accept the response: %v`, err)
+	}
+}
+
+func TestClientWithTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(1500 * time.Millisecond)
+	}))
+	defer ts.Close()
+
+	if _, err := New(ts.URL).WithTimeout(1).Get(); err == nil {
+		t.Fatal(`The client should fails when the request times out`)
+	}
+}
+
+func TestClientWithCacheHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"1234"` && r.Header.Get("If-Modified-Since") == "Wed, 21 Oct 2015 07:28:00 GMT" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte("feed"))
+	}))
+	defer ts.Close()
+
+	response, err := New(ts.URL).WithCacheHeaders(`"1234"`, "Wed, 21 Oct 2015 07:28:00 GMT").Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.StatusCode != http.StatusNotModified {
+		t.Fatalf(`The request should be conditional, got status code %d`, response.StatusCode)
+	}
+}
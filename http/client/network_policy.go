@@ -0,0 +1,178 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/errors"
+	"miniflux.app/logger"
+)
+
+var (
+	errDeniedScheme  = "This URL scheme is not allowed (%s)"
+	errDeniedNetwork = "This address is not allowed, it points to a denied network (%s)"
+)
+
+// NetworkPolicy restricts the destinations of outgoing requests,
+// so feeds and proxied media cannot be used to reach internal services.
+type NetworkPolicy struct {
+	allowedSchemes  []string
+	allowedNetworks []*net.IPNet
+	deniedNetworks  []*net.IPNet
+}
+
+// NewNetworkPolicy returns a policy from lists of URL schemes and networks in CIDR notation or single addresses.
+// The allowed networks take precedence over the denied networks.
+func NewNetworkPolicy(allowedSchemes, allowedNetworks, deniedNetworks []string) *NetworkPolicy {
+	policy := &NetworkPolicy{
+		allowedNetworks: parseNetworks(allowedNetworks),
+		deniedNetworks:  parseNetworks(deniedNetworks),
+	}
+
+	for _, scheme := range allowedSchemes {
+		if scheme = strings.ToLower(strings.TrimSpace(scheme)); scheme != "" {
+			policy.allowedSchemes = append(policy.allowedSchemes, scheme)
+		}
+	}
+
+	return policy
+}
+
+func newNetworkPolicyWithConfig(opts *config.Options) *NetworkPolicy {
+	return NewNetworkPolicy(opts.HTTPClientAllowedSchemes(), opts.HTTPClientAllowedNetworks(), opts.HTTPClientDeniedNetworks())
+}
+
+// CheckURL returns an error if the scheme of the URL is not allowed.
+func (p *NetworkPolicy) CheckURL(u *url.URL) error {
+	for _, scheme := range p.allowedSchemes {
+		if strings.ToLower(u.Scheme) == scheme {
+			return nil
+		}
+	}
+
+	return errors.NewLocalizedError(errDeniedScheme, u.Scheme)
+}
+
+// IsAllowedIP returns true if the client can connect to this IP address.
+func (p *NetworkPolicy) IsAllowedIP(ip net.IP) bool {
+	for _, network := range p.allowedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	for _, network := range p.deniedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DialContext resolves the host name and connects only to allowed addresses.
+// The addresses are checked after the name resolution to prevent DNS rebinding.
+func (p *NetworkPolicy) DialContext(dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+
+		var lastErr error
+		for _, ip := range addresses {
+			if !p.IsAllowedIP(ip.IP) {
+				logger.Debug("[HttpClient] Denied connection to %s (%s)", ip.IP, host)
+				lastErr = errors.NewLocalizedError(errDeniedNetwork, ip.IP)
+				continue
+			}
+
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip.IP.String(), port))
+			if err == nil {
+				return conn, nil
+			}
+			lastErr = err
+		}
+
+		if lastErr == nil {
+			lastErr = errors.NewLocalizedError(errDeniedNetwork, host)
+		}
+
+		return nil, lastErr
+	}
+}
+
+// CheckRedirect applies the policy to redirections, with the same limit as the default client.
+func (p *NetworkPolicy) CheckRedirect(request *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return errors.NewLocalizedError("stopped after 10 redirects")
+	}
+
+	return p.CheckURL(request.URL)
+}
+
+// Transport returns an HTTP transport applying the policy, with the same settings as the feed fetcher.
+func (p *NetworkPolicy) Transport() *http.Transport {
+	return &http.Transport{
+		DialContext: p.DialContext(newDialer()),
+
+		// Default is 100.
+		MaxIdleConns: 50,
+
+		// Default is 90s.
+		IdleConnTimeout: 10 * time.Second,
+	}
+}
+
+func parseNetworks(networks []string) []*net.IPNet {
+	var result []*net.IPNet
+	for _, network := range networks {
+		network = strings.TrimSpace(network)
+		if network == "" {
+			continue
+		}
+
+		// A single address is a network of one host.
+		if ip := net.ParseIP(network); ip != nil {
+			if ip.To4() != nil {
+				network += "/32"
+			} else {
+				network += "/128"
+			}
+		}
+
+		_, ipNet, err := net.ParseCIDR(network)
+		if err != nil {
+			logger.Error("[HttpClient] Invalid network %q: %v", network, err)
+			continue
+		}
+
+		// IPv4-mapped addresses are compared in their IPv4 form, so the IPv4 networks already apply to them
+		// and the whole IPv4-mapped range would match every IPv4 address.
+		if ipNet.IP.To4() != nil && len(ipNet.Mask) == net.IPv6len {
+			ones, _ := ipNet.Mask.Size()
+			if ones <= 96 {
+				continue
+			}
+			ipNet = &net.IPNet{IP: ipNet.IP.To4(), Mask: net.CIDRMask(ones-96, 32)}
+		}
+
+		result = append(result, ipNet)
+	}
+
+	return result
+}
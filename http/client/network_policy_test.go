@@ -0,0 +1,152 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"net"
+	"net/url"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func TestNetworkPolicyDeniedNetworks(t *testing.T) {
+	policy := NewNetworkPolicy([]string{"http", "https"}, nil, []string{"127.0.0.0/8", "10.0.0.0/8", "::1/128"})
+
+	scenarios := map[string]bool{
+		"127.0.0.1":     false,
+		"10.1.2.3":      false,
+		"::1":           false,
+		"93.184.216.34": true,
+		"2001:db8::1":   true,
+	}
+
+	for input, expected := range scenarios {
+		if result := policy.IsAllowedIP(net.ParseIP(input)); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, input, result, expected)
+		}
+	}
+}
+
+func TestNetworkPolicyAllowedNetworksOverrideDeniedNetworks(t *testing.T) {
+	policy := NewNetworkPolicy(nil, []string{"10.0.0.0/24"}, []string{"10.0.0.0/8"})
+
+	if !policy.IsAllowedIP(net.ParseIP("10.0.0.12")) {
+		t.Error(`The allowed network should take precedence over the denied network`)
+	}
+
+	if policy.IsAllowedIP(net.ParseIP("10.0.1.12")) {
+		t.Error(`The address should be denied`)
+	}
+}
+
+func TestNetworkPolicyParseNetworks(t *testing.T) {
+	policy := NewNetworkPolicy(nil, nil, []string{"invalid", "", "192.168.0.0/16", "10.0.0.1"})
+
+	if len(policy.deniedNetworks) != 2 {
+		t.Fatalf(`Unexpected number of networks, got %d instead of 2`, len(policy.deniedNetworks))
+	}
+
+	if policy.IsAllowedIP(net.ParseIP("10.0.0.1")) || !policy.IsAllowedIP(net.ParseIP("10.0.0.2")) {
+		t.Error(`A single address should only match this address`)
+	}
+}
+
+func TestNetworkPolicyIPv4MappedNetworks(t *testing.T) {
+	policy := NewNetworkPolicy(nil, nil, []string{"::ffff:0:0/96", "::ffff:10.0.0.0/104"})
+
+	if policy.IsAllowedIP(net.ParseIP("10.1.2.3")) || policy.IsAllowedIP(net.ParseIP("::ffff:10.1.2.3")) {
+		t.Error(`An IPv4-mapped network should apply to the IPv4 addresses`)
+	}
+
+	if !policy.IsAllowedIP(net.ParseIP("93.184.216.34")) {
+		t.Error(`The IPv4-mapped range should not match every IPv4 address`)
+	}
+}
+
+func TestNetworkPolicyCheckURL(t *testing.T) {
+	policy := NewNetworkPolicy([]string{"http", "HTTPS"}, nil, nil)
+
+	scenarios := map[string]bool{
+		"http://example.org/feed.xml":  true,
+		"HTTPS://example.org/feed.xml": true,
+		"file:///etc/passwd":           false,
+		"gopher://example.org/":        false,
+	}
+
+	for input, expected := range scenarios {
+		u, _ := url.Parse(input)
+		if err := policy.CheckURL(u); (err == nil) != expected {
+			t.Errorf(`Unexpected result for %q: %v`, input, err)
+		}
+	}
+}
+
+func TestClientWithDeniedNetwork(t *testing.T) {
+	clt := New("http://127.0.0.1:1/feed.xml")
+	clt.policy = NewNetworkPolicy([]string{"http"}, nil, []string{"127.0.0.0/8"})
+
+	_, err := clt.Get()
+	if err == nil {
+		t.Fatal(`The request should be denied`)
+	}
+
+	if err.Error() != "This address is not allowed, it points to a denied network (127.0.0.1)" {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+}
+
+func TestNetworkPolicyDefaultDeniedNetworks(t *testing.T) {
+	os.Clearenv()
+
+	opts, err := config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := newNetworkPolicyWithConfig(opts)
+	scenarios := map[string]bool{
+		"::":              false,
+		"::ffff:10.0.0.1": false,
+		"::ffff:7f00:1":   false,
+		"64:ff9b::a00:1":  false,
+		"198.18.0.1":      false,
+		"224.0.0.251":     false,
+		"ff02::1":         false,
+		"93.184.216.34":   true,
+		"2606:4700::6810": true,
+	}
+
+	for input, expected := range scenarios {
+		if result := policy.IsAllowedIP(net.ParseIP(input)); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, input, result, expected)
+		}
+	}
+}
+
+func TestClientWithConfigAppliesNetworkPolicy(t *testing.T) {
+	os.Clearenv()
+
+	opts, err := config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewClientWithConfig("http://127.0.0.1:1/feed.xml", opts).Get()
+	if err == nil {
+		t.Fatal(`The request of a feed should be denied`)
+	}
+
+	if err.Error() != "This address is not allowed, it points to a denied network (127.0.0.1)" {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+}
+
+func TestClientForIntegrationsIgnoresNetworkPolicy(t *testing.T) {
+	if New("http://127.0.0.1:1/webhook").policy != nil {
+		t.Fatal(`The endpoints of the integrations should not be restricted`)
+	}
+}
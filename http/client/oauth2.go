@@ -0,0 +1,62 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/crypto"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// tokenSourceIdleTTL is how long an unused token source is kept, the credentials may have been changed or removed since.
+const tokenSourceIdleTTL = time.Hour
+
+// Token sources are shared between requests to reuse access tokens until they expire.
+var (
+	tokenSourcesMutex sync.Mutex
+	tokenSources      = make(map[string]*cachedTokenSource)
+)
+
+type cachedTokenSource struct {
+	tokenSource oauth2.TokenSource
+	lastUsedAt  time.Time
+}
+
+func getTokenSource(cfg *clientcredentials.Config, httpClient *http.Client) oauth2.TokenSource {
+	key := crypto.Hash(strings.Join([]string{cfg.TokenURL, cfg.ClientID, cfg.ClientSecret, strings.Join(cfg.Scopes, " ")}, "\n"))
+	now := time.Now()
+
+	tokenSourcesMutex.Lock()
+	defer tokenSourcesMutex.Unlock()
+
+	removeIdleTokenSources(now)
+
+	if cached, found := tokenSources[key]; found {
+		cached.lastUsedAt = now
+		return cached.tokenSource
+	}
+
+	// The token source refreshes the access token automatically when it expires.
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+	tokenSource := cfg.TokenSource(ctx)
+	tokenSources[key] = &cachedTokenSource{tokenSource: tokenSource, lastUsedAt: now}
+	return tokenSource
+}
+
+// removeIdleTokenSources must be called with the mutex locked.
+func removeIdleTokenSources(now time.Time) {
+	for key, cached := range tokenSources {
+		if now.Sub(cached.lastUsedAt) > tokenSourceIdleTTL {
+			delete(tokenSources, key)
+		}
+	}
+}
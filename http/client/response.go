@@ -28,6 +28,14 @@ type Response struct {
 	Expires       string
 	ContentType   string
 	ContentLength int64
+
+	// BodySize is the number of bytes received, ContentLength is only the value announced by the server.
+	BodySize int
+
+	// PermanentRedirect is true when the request went only through permanent redirects (301 or 308).
+	PermanentRedirect bool
+
+	encoding string
 }
 
 func (r *Response) String() string {
@@ -48,6 +56,11 @@ func (r *Response) IsNotFound() bool {
 	return r.StatusCode == 404 || r.StatusCode == 410
 }
 
+// IsGone returns true if the resource has been intentionally removed.
+func (r *Response) IsGone() bool {
+	return r.StatusCode == 410
+}
+
 // IsNotAuthorized returns true if the resource require authentication.
 func (r *Response) IsNotAuthorized() bool {
 	return r.StatusCode == 401
@@ -87,6 +100,12 @@ func (r *Response) IsModified(etag, lastModified string) bool {
 // - Feeds with encoding specified only in XML document and not in HTTP header
 // - Feeds with wrong encoding defined and already in UTF-8
 func (r *Response) EnsureUnicodeBody() (err error) {
+	// The encoding has been overridden because the remote server is announcing the wrong one.
+	if r.encoding != "" {
+		r.Body, err = charset.NewReaderLabel(r.encoding, r.Body)
+		return err
+	}
+
 	if r.ContentType != "" {
 		// JSON feeds are always in UTF-8.
 		if strings.Contains(r.ContentType, "json") {
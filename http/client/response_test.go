@@ -29,6 +29,23 @@ func TestIsNotFound(t *testing.T) {
 	}
 }
 
+func TestIsGone(t *testing.T) {
+	scenarios := map[int]bool{
+		200: false,
+		404: false,
+		410: true,
+	}
+
+	for input, expected := range scenarios {
+		r := &Response{StatusCode: input}
+		actual := r.IsGone()
+
+		if actual != expected {
+			t.Errorf(`Unexpected result, got %v instead of %v for status code %d`, actual, expected, input)
+		}
+	}
+}
+
 func TestIsNotAuthorized(t *testing.T) {
 	scenarios := map[int]bool{
 		200: false,
@@ -147,3 +164,25 @@ func TestEnsureUnicodeWithHTMLDocuments(t *testing.T) {
 		}
 	}
 }
+
+func TestEnsureUnicodeWithEncodingOverride(t *testing.T) {
+	// "café" encoded in ISO-8859-1 but announced as UTF-8 by the server.
+	content := []byte("<rss><channel><title>caf\xe9</title></channel></rss>")
+
+	r := &Response{Body: bytes.NewReader(content), ContentType: "text/xml; charset=utf-8", encoding: "iso-8859-1"}
+	if err := r.EnsureUnicodeBody(); err != nil {
+		t.Fatalf(`Unicode conversion error: %v`, err)
+	}
+
+	expected := "<rss><channel><title>café</title></channel></rss>"
+	if body := r.BodyAsString(); body != expected {
+		t.Errorf(`Unexpected body, got %q instead of %q`, body, expected)
+	}
+}
+
+func TestEnsureUnicodeWithInvalidEncodingOverride(t *testing.T) {
+	r := &Response{Body: bytes.NewReader([]byte("<rss></rss>")), ContentType: "text/xml", encoding: "invalid-encoding"}
+	if err := r.EnsureUnicodeBody(); err == nil {
+		t.Fatal(`Invalid encodings should return an error`)
+	}
+}
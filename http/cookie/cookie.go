@@ -6,42 +6,72 @@ package cookie // import "miniflux.app/http/cookie"
 
 import (
 	"net/http"
+	"strings"
 	"time"
+
+	"miniflux.app/config"
 )
 
 // Cookie names.
 const (
 	CookieAppSessionID  = "MinifluxAppSessionID"
 	CookieUserSessionID = "MinifluxUserSessionID"
-
-	// Cookie duration in days.
-	cookieDuration = 30
 )
 
+// Name returns the name of the cookie with the prefix of the instance.
+func Name(name string, opts *config.Options) string {
+	return opts.CookieNamePrefix() + name
+}
+
 // New creates a new cookie.
-func New(name, value string, isHTTPS bool, path string) *http.Cookie {
-	return &http.Cookie{
-		Name:     name,
-		Value:    value,
-		Path:     basePath(path),
-		Secure:   isHTTPS,
-		HttpOnly: true,
-		Expires:  time.Now().Add(cookieDuration * 24 * time.Hour),
-		SameSite: http.SameSiteLaxMode,
-	}
+func New(name, value string, opts *config.Options) *http.Cookie {
+	cookie := newCookie(name, opts)
+	cookie.Value = value
+	cookie.Expires = time.Now().Add(time.Duration(opts.SessionLifetimeDays()) * 24 * time.Hour)
+	return cookie
 }
 
 // Expired returns an expired cookie.
-func Expired(name string, isHTTPS bool, path string) *http.Cookie {
-	return &http.Cookie{
-		Name:     name,
-		Value:    "",
-		Path:     basePath(path),
-		Secure:   isHTTPS,
+func Expired(name string, opts *config.Options) *http.Cookie {
+	cookie := newCookie(name, opts)
+	cookie.MaxAge = -1
+	cookie.Expires = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	return cookie
+}
+
+func newCookie(name string, opts *config.Options) *http.Cookie {
+	prefix := opts.CookieNamePrefix()
+	sameSite := sameSiteMode(opts.CookieSameSite())
+
+	cookie := &http.Cookie{
+		Name:     Name(name, opts),
+		Path:     basePath(opts.BasePath()),
 		HttpOnly: true,
-		MaxAge:   -1,
-		Expires:  time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
-		SameSite: http.SameSiteLaxMode,
+		SameSite: sameSite,
+	}
+
+	// Browsers reject cookies without the secure attribute in these cases.
+	cookie.Secure = opts.HTTPS || opts.CookieSecure() ||
+		sameSite == http.SameSiteNoneMode ||
+		strings.HasPrefix(prefix, "__Secure-") ||
+		strings.HasPrefix(prefix, "__Host-")
+
+	// Cookies with the "__Host-" prefix must be valid for the whole domain.
+	if strings.HasPrefix(prefix, "__Host-") {
+		cookie.Path = "/"
+	}
+
+	return cookie
+}
+
+func sameSiteMode(value string) http.SameSite {
+	switch strings.ToLower(value) {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteLaxMode
 	}
 }
 
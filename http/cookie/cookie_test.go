@@ -0,0 +1,89 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cookie // import "miniflux.app/http/cookie"
+
+import (
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+)
+
+func parseOptions(t *testing.T, env map[string]string) *config.Options {
+	os.Clearenv()
+	for key, value := range env {
+		os.Setenv(key, value)
+	}
+
+	opts, err := config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	return opts
+}
+
+func TestNewCookieWithDefaultOptions(t *testing.T) {
+	opts := parseOptions(t, nil)
+	cookie := New(CookieUserSessionID, "token", opts)
+
+	if cookie.Name != "MinifluxUserSessionID" {
+		t.Errorf(`Unexpected cookie name: %q`, cookie.Name)
+	}
+
+	if cookie.Path != "/" || cookie.Secure || !cookie.HttpOnly || cookie.SameSite != http.SameSiteLaxMode {
+		t.Errorf(`Unexpected cookie attributes: %v`, cookie)
+	}
+
+	if expires := time.Until(cookie.Expires); expires < 29*24*time.Hour || expires > 30*24*time.Hour {
+		t.Errorf(`Unexpected cookie expiration: %v`, cookie.Expires)
+	}
+}
+
+func TestNewCookieWithCustomOptions(t *testing.T) {
+	opts := parseOptions(t, map[string]string{
+		"BASE_URL":              "http://example.org/reader",
+		"COOKIE_SAME_SITE":      "strict",
+		"COOKIE_SECURE":         "1",
+		"COOKIE_NAME_PREFIX":    "instance1_",
+		"SESSION_LIFETIME_DAYS": "2",
+	})
+	cookie := New(CookieAppSessionID, "id", opts)
+
+	if cookie.Name != "instance1_MinifluxAppSessionID" {
+		t.Errorf(`Unexpected cookie name: %q`, cookie.Name)
+	}
+
+	if cookie.Path != "/reader" || !cookie.Secure || cookie.SameSite != http.SameSiteStrictMode {
+		t.Errorf(`Unexpected cookie attributes: %v`, cookie)
+	}
+
+	if time.Until(cookie.Expires) > 2*24*time.Hour {
+		t.Errorf(`Unexpected cookie expiration: %v`, cookie.Expires)
+	}
+}
+
+func TestSameSiteNoneCookieIsSecure(t *testing.T) {
+	opts := parseOptions(t, map[string]string{"COOKIE_SAME_SITE": "none"})
+	cookie := Expired(CookieUserSessionID, opts)
+
+	if !cookie.Secure || cookie.SameSite != http.SameSiteNoneMode || cookie.MaxAge != -1 {
+		t.Errorf(`Unexpected cookie attributes: %v`, cookie)
+	}
+}
+
+func TestHostPrefixedCookie(t *testing.T) {
+	opts := parseOptions(t, map[string]string{
+		"BASE_URL":           "https://example.org/reader",
+		"COOKIE_NAME_PREFIX": "__Host-",
+	})
+	cookie := New(CookieUserSessionID, "token", opts)
+
+	if cookie.Name != "__Host-MinifluxUserSessionID" || cookie.Path != "/" || !cookie.Secure {
+		t.Errorf(`Unexpected cookie attributes: %v`, cookie)
+	}
+}
@@ -25,6 +25,7 @@ const (
 	FlashErrorMessageContextKey
 	PocketRequestTokenContextKey
 	ClientIPContextKey
+	NonceContextKey
 )
 
 // IsAdminUser checks if the logged user is administrator.
@@ -109,6 +110,11 @@ func ClientIP(r *http.Request) string {
 	return getContextStringValue(r, ClientIPContextKey)
 }
 
+// Nonce returns the nonce allowing the scripts and stylesheets of the page.
+func Nonce(r *http.Request) string {
+	return getContextStringValue(r, NonceContextKey)
+}
+
 func getContextStringValue(r *http.Request, key ContextKey) string {
 	if v := r.Context().Value(key); v != nil {
 		value, valid := v.(string)
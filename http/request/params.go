@@ -94,6 +94,19 @@ func QueryInt64Param(r *http.Request, param string, defaultValue int64) int64 {
 	return val
 }
 
+// QueryInt64ParamList returns all positive integers associated to the parameter, invalid values are ignored.
+func QueryInt64ParamList(r *http.Request, param string) []int64 {
+	var results []int64
+	for _, value := range QueryStringParamList(r, param) {
+		val, err := strconv.ParseInt(value, 10, 64)
+		if err == nil && val > 0 {
+			results = append(results, val)
+		}
+	}
+
+	return results
+}
+
 // HasQueryParam checks if the query string contains the given parameter.
 func HasQueryParam(r *http.Request, param string) bool {
 	values := r.URL.Query()
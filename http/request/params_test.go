@@ -195,6 +195,21 @@ func TestQueryInt64Param(t *testing.T) {
 	}
 }
 
+func TestQueryInt64ParamList(t *testing.T) {
+	u, _ := url.Parse("http://example.org/?id=42&id=invalid&id=-5&id=&id=84")
+	r := &http.Request{URL: u}
+
+	result := QueryInt64ParamList(r, "id")
+	if len(result) != 2 || result[0] != 42 || result[1] != 84 {
+		t.Errorf(`Unexpected result, got %v`, result)
+	}
+
+	result = QueryInt64ParamList(r, "missing key")
+	if len(result) != 0 {
+		t.Errorf(`Unexpected result, got %v`, result)
+	}
+}
+
 func TestHasQueryParam(t *testing.T) {
 	u, _ := url.Parse("http://example.org/?key=42")
 	r := &http.Request{URL: u}
@@ -0,0 +1,27 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package request // import "miniflux.app/http/request"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// DecodeEntryIDs returns the entry IDs of a {"entry_ids": [...]} JSON body.
+func DecodeEntryIDs(body io.ReadCloser) ([]int64, error) {
+	type payload struct {
+		EntryIDs []int64 `json:"entry_ids"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(body)
+	defer body.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return p.EntryIDs, nil
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package request // import "miniflux.app/http/request"
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestDecodeEntryIDs(t *testing.T) {
+	entryIDs, err := DecodeEntryIDs(ioutil.NopCloser(strings.NewReader(`{"entry_ids": [1, 2, 3]}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entryIDs) != 3 || entryIDs[0] != 1 || entryIDs[2] != 3 {
+		t.Errorf(`Unexpected entry IDs: %v`, entryIDs)
+	}
+
+	if _, err := DecodeEntryIDs(ioutil.NopCloser(strings.NewReader(`{"entry_ids": "1"}`))); err == nil {
+		t.Error(`An invalid payload should generate an error`)
+	}
+}
@@ -58,11 +58,21 @@ func (b *Builder) WithoutCompression() *Builder {
 
 // WithCaching adds caching headers to the response.
 func (b *Builder) WithCaching(etag string, duration time.Duration, callback func(*Builder)) {
-	b.headers["ETag"] = etag
-	b.headers["Cache-Control"] = "public"
-	b.headers["Expires"] = time.Now().Add(duration).Format(time.RFC1123)
+	b.headers["Cache-Control"] = fmt.Sprintf("public, max-age=%d", int(duration.Seconds()))
+	b.headers["Expires"] = time.Now().Add(duration).UTC().Format(http.TimeFormat)
+	b.withETag(etag, callback)
+}
+
+// WithPrivateCaching allows only the web browser to store the response, it has to be revalidated on each request.
+func (b *Builder) WithPrivateCaching(etag string, callback func(*Builder)) {
+	b.headers["Cache-Control"] = "private, no-cache"
+	b.withETag(etag, callback)
+}
+
+func (b *Builder) withETag(etag string, callback func(*Builder)) {
+	b.headers["ETag"] = `"` + etag + `"`
 
-	if etag == b.r.Header.Get("If-None-Match") {
+	if matchETag(b.r.Header.Get("If-None-Match"), etag) {
 		b.statusCode = http.StatusNotModified
 		b.body = nil
 		b.Write()
@@ -71,6 +81,23 @@ func (b *Builder) WithCaching(etag string, duration time.Duration, callback func
 	}
 }
 
+// matchETag compares the entity tag with the list sent by the client, weak tags are accepted as well.
+func matchETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+
+		candidate = strings.Trim(strings.TrimPrefix(candidate, "W/"), `"`)
+		if candidate != "" && candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Write generates the HTTP response.
 func (b *Builder) Write() {
 	if b.body == nil {
@@ -95,8 +122,11 @@ func (b *Builder) Write() {
 func (b *Builder) writeHeaders() {
 	b.headers["X-XSS-Protection"] = "1; mode=block"
 	b.headers["X-Content-Type-Options"] = "nosniff"
-	b.headers["X-Frame-Options"] = "DENY"
-	b.headers["Content-Security-Policy"] = "default-src 'self'; img-src *; media-src *; frame-src *"
+
+	// The server middleware could have already set the headers configured for the instance,
+	// and handlers serving third-party documents provide a stricter policy.
+	b.withDefaultHeader("X-Frame-Options", "DENY")
+	b.withDefaultHeader("Content-Security-Policy", "default-src 'self'; img-src *; media-src *; frame-src *")
 
 	for key, value := range b.headers {
 		b.w.Header().Set(key, value)
@@ -105,12 +135,22 @@ func (b *Builder) writeHeaders() {
 	b.w.WriteHeader(b.statusCode)
 }
 
+func (b *Builder) withDefaultHeader(key, value string) {
+	if _, found := b.headers[key]; !found && b.w.Header().Get(key) == "" {
+		b.headers[key] = value
+	}
+}
+
 func (b *Builder) compress(data []byte) {
+	if b.enableCompression {
+		b.headers["Vary"] = "Accept-Encoding"
+	}
+
 	if b.enableCompression && len(data) > compressionThreshold {
 		acceptEncoding := b.r.Header.Get("Accept-Encoding")
 
 		switch {
-		case strings.Contains(acceptEncoding, "gzip"):
+		case acceptsEncoding(acceptEncoding, "gzip"):
 			b.headers["Content-Encoding"] = "gzip"
 			b.writeHeaders()
 
@@ -118,7 +158,7 @@ func (b *Builder) compress(data []byte) {
 			defer gzipWriter.Close()
 			gzipWriter.Write(data)
 			return
-		case strings.Contains(acceptEncoding, "deflate"):
+		case acceptsEncoding(acceptEncoding, "deflate"):
 			b.headers["Content-Encoding"] = "deflate"
 			b.writeHeaders()
 
@@ -133,6 +173,27 @@ func (b *Builder) compress(data []byte) {
 	b.w.Write(data)
 }
 
+// acceptsEncoding returns true if the encoding is listed in the Accept-Encoding header without being refused with "q=0".
+func acceptsEncoding(acceptEncoding, encoding string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		params := strings.Split(part, ";")
+		if !strings.EqualFold(strings.TrimSpace(params[0]), encoding) {
+			continue
+		}
+
+		for _, param := range params[1:] {
+			param = strings.Replace(param, " ", "", -1)
+			if strings.HasPrefix(param, "q=") && strings.Trim(param[2:], "0.") == "" {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
 // New creates a new response builder.
 func New(w http.ResponseWriter, r *http.Request) *Builder {
 	return &Builder{w: w, r: r, statusCode: http.StatusOK, headers: make(map[string]string), enableCompression: true}
@@ -43,6 +43,58 @@ func TestResponseHasCommonHeaders(t *testing.T) {
 	}
 }
 
+func TestBuildResponseWithCustomContentSecurityPolicy(t *testing.T) {
+	r, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		New(w, r).WithHeader("Content-Security-Policy", "default-src 'none'").Write()
+	})
+
+	handler.ServeHTTP(w, r)
+	resp := w.Result()
+
+	expected := "default-src 'none'"
+	actual := resp.Header.Get("Content-Security-Policy")
+	if actual != expected {
+		t.Fatalf(`Unexpected header value, got %q instead of %q`, actual, expected)
+	}
+}
+
+func TestBuildResponseKeepsConfiguredSecurityHeaders(t *testing.T) {
+	r, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	w.Header().Set("X-Frame-Options", "SAMEORIGIN")
+	w.Header().Set("Content-Security-Policy", "default-src 'self' https://fonts.example.org")
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		New(w, r).Write()
+	})
+
+	handler.ServeHTTP(w, r)
+	resp := w.Result()
+
+	headers := map[string]string{
+		"X-Frame-Options":         "SAMEORIGIN",
+		"Content-Security-Policy": "default-src 'self' https://fonts.example.org",
+	}
+
+	for header, expected := range headers {
+		actual := resp.Header.Get(header)
+		if actual != expected {
+			t.Fatalf(`Unexpected header value, got %q instead of %q`, actual, expected)
+		}
+	}
+}
+
 func TestBuildResponseWithCustomStatusCode(t *testing.T) {
 	r, err := http.NewRequest("GET", "/", nil)
 	if err != nil {
@@ -179,7 +231,7 @@ func TestBuildResponseWithCachingEnabled(t *testing.T) {
 		t.Fatalf(`Unexpected body, got %s instead of %s`, actualBody, expectedBody)
 	}
 
-	expectedHeader := "public"
+	expectedHeader := "public, max-age=60"
 	actualHeader := resp.Header.Get("Cache-Control")
 	if actualHeader != expectedHeader {
 		t.Fatalf(`Unexpected cache control header, got %q instead of %q`, actualHeader, expectedHeader)
@@ -220,7 +272,7 @@ func TestBuildResponseWithCachingAndEtag(t *testing.T) {
 		t.Fatalf(`Unexpected body, got %s instead of %s`, actualBody, expectedBody)
 	}
 
-	expectedHeader := "public"
+	expectedHeader := "public, max-age=60"
 	actualHeader := resp.Header.Get("Cache-Control")
 	if actualHeader != expectedHeader {
 		t.Fatalf(`Unexpected cache control header, got %q instead of %q`, actualHeader, expectedHeader)
@@ -231,6 +283,70 @@ func TestBuildResponseWithCachingAndEtag(t *testing.T) {
 	}
 }
 
+func TestBuildResponseWithCachingAndQuotedEtags(t *testing.T) {
+	scenarios := map[string]int{
+		`"etag"`:               http.StatusNotModified,
+		`W/"etag"`:             http.StatusNotModified,
+		`"other", "etag"`:      http.StatusNotModified,
+		`*`:                    http.StatusNotModified,
+		`"other"`:              http.StatusOK,
+		`"etag-with-suffix"`:   http.StatusOK,
+		`W/"other", W/"etag2"`: http.StatusOK,
+	}
+
+	for ifNoneMatch, expectedStatusCode := range scenarios {
+		r, err := http.NewRequest("GET", "/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Header.Set("If-None-Match", ifNoneMatch)
+
+		w := httptest.NewRecorder()
+		New(w, r).WithCaching("etag", 1*time.Minute, func(b *Builder) {
+			b.WithBody("cached body")
+			b.Write()
+		})
+
+		resp := w.Result()
+		if resp.StatusCode != expectedStatusCode {
+			t.Errorf(`Unexpected status code for %q, got %d instead of %d`, ifNoneMatch, resp.StatusCode, expectedStatusCode)
+		}
+
+		if resp.Header.Get("ETag") != `"etag"` {
+			t.Errorf(`Unexpected ETag header, got %q`, resp.Header.Get("ETag"))
+		}
+	}
+}
+
+func TestBuildResponseWithPrivateCaching(t *testing.T) {
+	r, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("If-None-Match", `"etag"`)
+
+	w := httptest.NewRecorder()
+	New(w, r).WithPrivateCaching("etag", func(b *Builder) {
+		b.WithBody("private body")
+		b.Write()
+	})
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusNotModified {
+		t.Fatalf(`Unexpected status code, got %d`, resp.StatusCode)
+	}
+
+	expectedHeader := "private, no-cache"
+	actualHeader := resp.Header.Get("Cache-Control")
+	if actualHeader != expectedHeader {
+		t.Fatalf(`Unexpected cache control header, got %q instead of %q`, actualHeader, expectedHeader)
+	}
+
+	if resp.Header.Get("Expires") != "" {
+		t.Fatalf(`Expires header should not be set`)
+	}
+}
+
 func TestBuildResponseWithGzipCompression(t *testing.T) {
 	body := strings.Repeat("a", compressionThreshold+1)
 	r, err := http.NewRequest("GET", "/", nil)
@@ -349,3 +465,43 @@ func TestBuildResponseWithoutCompressionHeader(t *testing.T) {
 		t.Fatalf(`Unexpected header value, got %q instead of %q`, actual, expected)
 	}
 }
+
+func TestBuildResponseWithRefusedCompression(t *testing.T) {
+	body := strings.Repeat("a", compressionThreshold+1)
+	r, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("Accept-Encoding", "gzip;q=0, deflate")
+
+	w := httptest.NewRecorder()
+	New(w, r).WithBody(body).Write()
+
+	resp := w.Result()
+	if encoding := resp.Header.Get("Content-Encoding"); encoding != "deflate" {
+		t.Fatalf(`Unexpected content encoding, got %q`, encoding)
+	}
+
+	if vary := resp.Header.Get("Vary"); vary != "Accept-Encoding" {
+		t.Fatalf(`Unexpected Vary header, got %q`, vary)
+	}
+}
+
+func TestAcceptsEncoding(t *testing.T) {
+	scenarios := map[string]bool{
+		"gzip":                true,
+		"GZIP, deflate":       true,
+		"deflate, gzip;q=0.5": true,
+		"gzip;q=0":            false,
+		"gzip; q=0.0, br":     false,
+		"br":                  false,
+		"":                    false,
+		"x-gzip":              false,
+	}
+
+	for acceptEncoding, expected := range scenarios {
+		if result := acceptsEncoding(acceptEncoding, "gzip"); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, acceptEncoding, result, expected)
+		}
+	}
+}
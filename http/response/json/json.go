@@ -32,6 +32,15 @@ func Created(w http.ResponseWriter, r *http.Request, body interface{}) {
 	builder.Write()
 }
 
+// Accepted sends an accepted response to the client.
+func Accepted(w http.ResponseWriter, r *http.Request, body interface{}) {
+	builder := response.New(w, r)
+	builder.WithStatus(http.StatusAccepted)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithBody(toJSON(body))
+	builder.Write()
+}
+
 // NoContent sends a no content response to the client.
 func NoContent(w http.ResponseWriter, r *http.Request) {
 	builder := response.New(w, r)
@@ -84,6 +93,17 @@ func Forbidden(w http.ResponseWriter, r *http.Request) {
 	builder.Write()
 }
 
+// TooManyRequests sends a too many requests error to the client.
+func TooManyRequests(w http.ResponseWriter, r *http.Request, err error) {
+	logger.Error("[HTTP:Too Many Requests] %s => %v", r.URL, err)
+
+	builder := response.New(w, r)
+	builder.WithStatus(http.StatusTooManyRequests)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithBody(toJSONError(err))
+	builder.Write()
+}
+
 // NotFound sends a page not found error to the client.
 func NotFound(w http.ResponseWriter, r *http.Request) {
 	logger.Error("[HTTP:Not Found] %s", r.URL)
@@ -0,0 +1,69 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package response // import "miniflux.app/http/response"
+
+import (
+	"strings"
+)
+
+// ContentSecurityPolicyWithNonce allows the scripts and stylesheets having the nonce in addition to the sources of the policy.
+// When the policy has no script-src or style-src directive, the sources of default-src are used.
+// Browsers ignore 'unsafe-inline' when a nonce is present, so the directives allowing inline code are left unchanged.
+func ContentSecurityPolicyWithNonce(policy, nonce string) string {
+	if nonce == "" {
+		return policy
+	}
+
+	var directives []string
+	var defaultSources []string
+	found := map[string]bool{}
+
+	for _, directive := range strings.Split(policy, ";") {
+		fields := strings.Fields(directive)
+		if len(fields) == 0 {
+			continue
+		}
+
+		name := strings.ToLower(fields[0])
+		switch name {
+		case "default-src":
+			defaultSources = fields[1:]
+		case "script-src", "style-src":
+			found[name] = true
+			fields = append([]string{fields[0]}, withNonce(fields[1:], nonce)...)
+		}
+
+		directives = append(directives, strings.Join(fields, " "))
+	}
+
+	// Without default-src, the scripts and stylesheets are not restricted anyway.
+	if defaultSources != nil {
+		for _, name := range []string{"script-src", "style-src"} {
+			if !found[name] {
+				directives = append(directives, name+" "+strings.Join(withNonce(defaultSources, nonce), " "))
+			}
+		}
+	}
+
+	return strings.Join(directives, "; ")
+}
+
+func withNonce(sources []string, nonce string) []string {
+	for _, source := range sources {
+		if strings.ToLower(source) == "'unsafe-inline'" {
+			return sources
+		}
+	}
+
+	var result []string
+	for _, source := range sources {
+		// No other source can be listed with 'none'.
+		if source != "'none'" {
+			result = append(result, source)
+		}
+	}
+
+	return append(result, "'nonce-"+nonce+"'")
+}
@@ -0,0 +1,31 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package response // import "miniflux.app/http/response"
+
+import "testing"
+
+func TestContentSecurityPolicyWithNonce(t *testing.T) {
+	scenarios := map[string]string{
+		"default-src 'self'; img-src *": "default-src 'self'; img-src *; script-src 'self' 'nonce-abc'; style-src 'self' 'nonce-abc'",
+		"default-src 'self'; script-src 'self' https://cdn.example.org; style-src 'none'": "default-src 'self'; script-src 'self' https://cdn.example.org 'nonce-abc'; style-src 'nonce-abc'",
+		"img-src *;": "img-src *",
+		"default-src 'self'; script-src 'self' 'unsafe-inline'": "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'nonce-abc'",
+		"default-src 'self' 'unsafe-inline'":                    "default-src 'self' 'unsafe-inline'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline'",
+	}
+
+	for policy, expected := range scenarios {
+		result := ContentSecurityPolicyWithNonce(policy, "abc")
+		if result != expected {
+			t.Errorf(`Unexpected policy, got %q instead of %q`, result, expected)
+		}
+	}
+}
+
+func TestContentSecurityPolicyWithoutNonce(t *testing.T) {
+	policy := "default-src 'self'"
+	if result := ContentSecurityPolicyWithNonce(policy, ""); result != policy {
+		t.Errorf(`Unexpected policy, got %q instead of %q`, result, policy)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package integration // import "miniflux.app/integration"
+
+import (
+	"miniflux.app/integration/archivebox"
+	"miniflux.app/integration/wayback"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+// ArchiveEntries sends the saved or starred entries to the archiving services and remembers the snapshot links.
+func ArchiveEntries(store *storage.Storage, entries model.Entries, integration *model.Integration) {
+	for _, entry := range entries {
+		if integration.WaybackEnabled {
+			snapshotURL, err := wayback.NewClient().Save(entry.URL)
+			if err != nil {
+				logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+			} else {
+				saveEntryArchive(store, entry, model.ArchiveServiceWayback, snapshotURL)
+			}
+		}
+
+		if integration.ArchiveBoxEnabled {
+			client := archivebox.NewClient(integration.ArchiveBoxURL, integration.ArchiveBoxAPIKey)
+			snapshotURL, err := client.AddURL(entry.URL, splitTagList(integration.ArchiveBoxTags))
+			if err != nil {
+				logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+			} else {
+				saveEntryArchive(store, entry, model.ArchiveServiceArchiveBox, snapshotURL)
+			}
+		}
+	}
+}
+
+func saveEntryArchive(store *storage.Storage, entry *model.Entry, service, snapshotURL string) {
+	archive := &model.EntryArchive{
+		UserID:  entry.UserID,
+		EntryID: entry.ID,
+		Service: service,
+		URL:     snapshotURL,
+	}
+
+	if err := store.SaveEntryArchive(archive); err != nil {
+		logger.Error("[Integration] UserID #%d: %v", entry.UserID, err)
+	}
+}
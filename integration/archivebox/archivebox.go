@@ -0,0 +1,93 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package archivebox // import "miniflux.app/integration/archivebox"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"miniflux.app/http/client"
+)
+
+// ArchiveBox fetches the page and runs all extractors before answering.
+const addTimeout = 300
+
+// Client represents an ArchiveBox client.
+type Client struct {
+	baseURL string
+	apiKey  string
+}
+
+// AddURL asks ArchiveBox to take a snapshot of the page and returns the link to the snapshot.
+func (c *Client) AddURL(link string, tags []string) (string, error) {
+	if c.baseURL == "" || c.apiKey == "" {
+		return "", fmt.Errorf("archivebox: missing credentials")
+	}
+
+	apiURL, err := getEndpoint(c.baseURL, "/api/v1/cli/add")
+	if err != nil {
+		return "", err
+	}
+
+	type body struct {
+		URLs   []string `json:"urls"`
+		Tag    string   `json:"tag"`
+		Depth  int      `json:"depth"`
+		Parser string   `json:"parser"`
+	}
+
+	clt := client.New(apiURL)
+	clt.WithHeader("X-ArchiveBox-API-Key", c.apiKey)
+	clt.WithTimeout(addTimeout)
+	response, err := clt.PostJSON(&body{
+		URLs:   []string{link},
+		Tag:    strings.Join(tags, ","),
+		Parser: "url_list",
+	})
+	if err != nil {
+		return "", fmt.Errorf("archivebox: unable to send url: %v", err)
+	}
+
+	if response.StatusCode >= 400 {
+		return "", fmt.Errorf("archivebox: unable to send url, status=%d", response.StatusCode)
+	}
+
+	var result struct {
+		Success bool     `json:"success"`
+		Errors  []string `json:"errors"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("archivebox: unable to decode response: %v", err)
+	}
+
+	if !result.Success {
+		return "", fmt.Errorf("archivebox: unable to archive url: %s", strings.Join(result.Errors, ", "))
+	}
+
+	return SnapshotURL(c.baseURL, link), nil
+}
+
+// SnapshotURL returns the page of the instance redirecting to the latest snapshot of the link.
+func SnapshotURL(baseURL, link string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/archive/" + link
+}
+
+// NewClient returns a new ArchiveBox client.
+func NewClient(baseURL, apiKey string) *Client {
+	return &Client{baseURL: baseURL, apiKey: apiKey}
+}
+
+func getEndpoint(baseURL, pathURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("archivebox: invalid API endpoint: %v", err)
+	}
+	u.Path = path.Join(u.Path, pathURL)
+	return u.String(), nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package archivebox // import "miniflux.app/integration/archivebox"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSnapshotURL(t *testing.T) {
+	result := SnapshotURL("https://archive.example.org/", "https://example.org/article?id=1")
+	expected := "https://archive.example.org/archive/https://example.org/article?id=1"
+
+	if result != expected {
+		t.Errorf(`Unexpected snapshot link, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestAddURL(t *testing.T) {
+	var payload map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/cli/add" || r.Header.Get("X-ArchiveBox-API-Key") != "secret" {
+			t.Errorf(`Unexpected request: %s %v`, r.URL.Path, r.Header)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+
+		w.Write([]byte(`{"success": true, "errors": [], "result": []}`))
+	}))
+	defer server.Close()
+
+	snapshot, err := NewClient(server.URL, "secret").AddURL("https://example.org/", []string{"miniflux", "news"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if snapshot != server.URL+"/archive/https://example.org/" {
+		t.Errorf(`Unexpected snapshot link: %q`, snapshot)
+	}
+
+	if payload["tag"] != "miniflux,news" {
+		t.Errorf(`Unexpected payload: %v`, payload)
+	}
+}
+
+func TestAddURLWithErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success": false, "errors": ["Unable to fetch the page"]}`))
+	}))
+	defer server.Close()
+
+	if _, err := NewClient(server.URL, "secret").AddURL("https://example.org/", nil); err == nil {
+		t.Error(`ArchiveBox errors should be reported`)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package custombookmark // import "miniflux.app/integration/custombookmark"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"miniflux.app/http/client"
+)
+
+// DefaultBodyTemplate is suggested to users who start configuring the service.
+const DefaultBodyTemplate = `{"url": {{ .URL }}, "title": {{ .Title }}, "tags": {{ .Tags }}}`
+
+var (
+	allowedMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch}
+
+	// Headers set by the HTTP client which cannot be overridden.
+	reservedHeaders = []string{
+		"Content-Length",
+		"Content-Type",
+		"Cookie",
+		"Host",
+		"Proxy-Authorization",
+		"Transfer-Encoding",
+		"User-Agent",
+	}
+)
+
+// Entry holds the values available in the body template.
+type Entry struct {
+	URL          string
+	Title        string
+	Content      string
+	Author       string
+	CommentsURL  string
+	FeedTitle    string
+	FeedSiteURL  string
+	CategoryName string
+	Tags         []string
+	Date         time.Time
+}
+
+// Header represents a custom HTTP header sent with each request.
+type Header struct {
+	Name  string
+	Value string
+}
+
+// Client represents a generic REST bookmark service.
+type Client struct {
+	endpoint     string
+	method       string
+	headers      string
+	bodyTemplate string
+}
+
+// AddEntry sends the entry to the service.
+func (c *Client) AddEntry(entry *Entry) error {
+	if err := Validate(c.endpoint, c.method, c.headers, c.bodyTemplate); err != nil {
+		return fmt.Errorf("custombookmark: %v", err)
+	}
+
+	headers, err := ParseHeaders(c.headers)
+	if err != nil {
+		return fmt.Errorf("custombookmark: %v", err)
+	}
+
+	tpl, err := ParseBodyTemplate(c.bodyTemplate)
+	if err != nil {
+		return fmt.Errorf("custombookmark: %v", err)
+	}
+
+	body, err := renderBody(tpl, entry)
+	if err != nil {
+		return fmt.Errorf("custombookmark: %v", err)
+	}
+
+	clt := client.New(c.endpoint)
+	for _, header := range headers {
+		clt.WithHeader(header.Name, header.Value)
+	}
+
+	response, err := clt.SendJSON(NormalizeMethod(c.method), body)
+	if err != nil {
+		return fmt.Errorf("custombookmark: unable to send entry: %v", err)
+	}
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("custombookmark: unable to send entry, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// Validate returns an error if the settings cannot be used to send entries.
+func Validate(endpoint, method, headers, bodyTemplate string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %q", endpoint)
+	}
+
+	if !isAllowedMethod(NormalizeMethod(method)) {
+		return fmt.Errorf("unsupported method %q", method)
+	}
+
+	if _, err := ParseHeaders(headers); err != nil {
+		return err
+	}
+
+	tpl, err := ParseBodyTemplate(bodyTemplate)
+	if err != nil {
+		return err
+	}
+
+	sample := &Entry{
+		URL:          "https://example.org/article",
+		Title:        "Title",
+		Content:      "<p>Content</p>",
+		CategoryName: "Category",
+		Tags:         []string{"tag"},
+		Date:         time.Now(),
+	}
+
+	_, err = renderBody(tpl, sample)
+	return err
+}
+
+// NormalizeMethod returns the HTTP method in upper case, POST is used by default.
+func NormalizeMethod(method string) string {
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if method == "" {
+		return http.MethodPost
+	}
+
+	return method
+}
+
+// ParseHeaders reads one "Name: value" header per line, blank lines are ignored.
+// The headers set by the HTTP client, like Content-Type and User-Agent, are rejected.
+func ParseHeaders(text string) ([]*Header, error) {
+	var headers []*Header
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		name := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || name == "" || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("invalid header %q", line)
+		}
+
+		name = textproto.CanonicalMIMEHeaderKey(name)
+		if isReservedHeader(name) {
+			return nil, fmt.Errorf("the header %q cannot be changed", name)
+		}
+
+		headers = append(headers, &Header{
+			Name:  name,
+			Value: strings.TrimSpace(parts[1]),
+		})
+	}
+
+	return headers, nil
+}
+
+// ParseBodyTemplate parses the JSON body template, an empty template uses DefaultBodyTemplate.
+// Placeholders like {{ .Title }} are replaced by JSON values, quotes must not be added around them.
+func ParseBodyTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		text = DefaultBodyTemplate
+	}
+
+	tpl, err := template.New("body").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid body template: %v", err)
+	}
+
+	return tpl, nil
+}
+
+func renderBody(tpl *template.Template, entry *Entry) ([]byte, error) {
+	values := map[string]interface{}{
+		"URL":         entry.URL,
+		"Title":       entry.Title,
+		"Content":     entry.Content,
+		"Author":      entry.Author,
+		"CommentsURL": entry.CommentsURL,
+		"Feed":        entry.FeedTitle,
+		"FeedURL":     entry.FeedSiteURL,
+		"Category":    entry.CategoryName,
+		"Tags":        entry.Tags,
+		"Date":        entry.Date.UTC().Format(time.RFC3339),
+	}
+
+	if entry.Tags == nil {
+		values["Tags"] = []string{}
+	}
+
+	data := make(map[string]string, len(values))
+	for key, value := range values {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		data[key] = string(encoded)
+	}
+
+	var buffer bytes.Buffer
+	if err := tpl.Execute(&buffer, data); err != nil {
+		return nil, fmt.Errorf("invalid body template: %v", err)
+	}
+
+	if !json.Valid(buffer.Bytes()) {
+		return nil, fmt.Errorf("the body template does not produce valid JSON")
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func isAllowedMethod(method string) bool {
+	for _, allowed := range allowedMethods {
+		if method == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isReservedHeader(name string) bool {
+	for _, reserved := range reservedHeaders {
+		if name == reserved {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewClient returns a new client for a generic REST bookmark service.
+func NewClient(endpoint, method, headers, bodyTemplate string) *Client {
+	return &Client{
+		endpoint:     endpoint,
+		method:       method,
+		headers:      headers,
+		bodyTemplate: bodyTemplate,
+	}
+}
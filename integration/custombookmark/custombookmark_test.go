@@ -0,0 +1,146 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package custombookmark // import "miniflux.app/integration/custombookmark"
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseHeaders(t *testing.T) {
+	headers, err := ParseHeaders("x-api-key: secret\n\nX-Token: a:b  \n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(headers) != 2 {
+		t.Fatalf(`Unexpected number of headers: %d`, len(headers))
+	}
+
+	if headers[0].Name != "X-Api-Key" || headers[0].Value != "secret" {
+		t.Errorf(`Unexpected header: %+v`, headers[0])
+	}
+
+	if headers[1].Name != "X-Token" || headers[1].Value != "a:b" {
+		t.Errorf(`Unexpected header: %+v`, headers[1])
+	}
+}
+
+func TestParseAuthorizationHeader(t *testing.T) {
+	headers, err := ParseHeaders("authorization: Token secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(headers) != 1 || headers[0].Name != "Authorization" || headers[0].Value != "Token secret" {
+		t.Errorf(`Unexpected headers: %+v`, headers)
+	}
+}
+
+func TestParseInvalidHeaders(t *testing.T) {
+	for _, text := range []string{"NoColon", ": value", "Bad Name: value", "content-type: text/plain", "User-Agent: Bot", "Host: internal"} {
+		if _, err := ParseHeaders(text); err == nil {
+			t.Errorf(`The header %q should be invalid`, text)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate("https://example.org/api", "", "", ""); err != nil {
+		t.Errorf(`The default settings should be valid: %v`, err)
+	}
+
+	scenarios := map[string][]string{
+		"invalid URL":      {"example.org", "POST", "", ""},
+		"invalid method":   {"https://example.org/", "GET", "", ""},
+		"unknown field":    {"https://example.org/", "POST", "", `{"url": {{ .Link }}}`},
+		"quoted value":     {"https://example.org/", "POST", "", `{"url": "{{ .URL }}"}`},
+		"invalid template": {"https://example.org/", "POST", "", `{"url": {{ .URL }`},
+	}
+
+	for name, args := range scenarios {
+		if err := Validate(args[0], args[1], args[2], args[3]); err == nil {
+			t.Errorf(`The settings with %s should be invalid`, name)
+		}
+	}
+}
+
+func TestAddEntryWithInvalidSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf(`Unexpected request: %s %v`, r.Method, r.Header)
+	}))
+	defer server.Close()
+
+	if err := NewClient(server.URL, "DELETE", "", "").AddEntry(&Entry{URL: "https://example.org/"}); err == nil {
+		t.Error(`A method which is not allowed should be rejected`)
+	}
+
+	if err := NewClient(server.URL, "POST", "User-Agent: Bookmarker", "").AddEntry(&Entry{URL: "https://example.org/"}); err == nil {
+		t.Error(`A reserved header should be rejected`)
+	}
+}
+
+func TestAddEntry(t *testing.T) {
+	var payload map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.Header.Get("X-Api-Key") != "secret" {
+			t.Errorf(`Unexpected request: %s %v`, r.Method, r.Header)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf(`Invalid JSON body %q: %v`, body, err)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	clt := NewClient(
+		server.URL,
+		"put",
+		"X-Api-Key: secret",
+		`{"link": {{ .URL }}, "name": {{ .Title }}, "labels": {{ .Tags }}, "folder": {{ .Category }}}`,
+	)
+
+	err := clt.AddEntry(&Entry{
+		URL:          "https://example.org/",
+		Title:        `Title with "quotes"`,
+		CategoryName: "News",
+		Date:         time.Now(),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if payload["name"] != `Title with "quotes"` || payload["folder"] != "News" {
+		t.Errorf(`Unexpected payload: %v`, payload)
+	}
+
+	if labels, ok := payload["labels"].([]interface{}); !ok || len(labels) != 0 {
+		t.Errorf(`Tags should be an empty list: %v`, payload["labels"])
+	}
+}
+
+func TestAddEntryWithAuthorizationHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf(`Unexpected Authorization header: %q`, r.Header.Get("Authorization"))
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	if err := NewClient(server.URL, "POST", "Authorization: Bearer secret", "").AddEntry(&Entry{URL: "https://example.org/"}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package espial // import "miniflux.app/integration/espial"
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"miniflux.app/http/client"
+)
+
+// Document represents a bookmark sent to Espial.
+type Document struct {
+	URL         string `json:"url"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Tags        string `json:"tags,omitempty"`
+	ToRead      bool   `json:"toread"`
+}
+
+// Client represents an Espial client.
+type Client struct {
+	baseURL string
+	apiKey  string
+}
+
+// AddEntry saves the link to Espial, bookmarks are added to the reading list.
+func (c *Client) AddEntry(link, title, description string, tags []string) error {
+	if c.baseURL == "" || c.apiKey == "" {
+		return fmt.Errorf("espial: missing credentials")
+	}
+
+	doc := &Document{
+		URL:         link,
+		Title:       title,
+		Description: description,
+		Tags:        FormatTags(tags),
+		ToRead:      true,
+	}
+
+	apiURL, err := getAPIEndpoint(c.baseURL, "/api/add")
+	if err != nil {
+		return err
+	}
+
+	clt := client.New(apiURL)
+	clt.WithAuthorization("ApiKey " + c.apiKey)
+	response, err := clt.PostJSON(doc)
+	if err != nil {
+		return fmt.Errorf("espial: unable to send entry: %v", err)
+	}
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("espial: unable to send entry, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// FormatTags returns the space separated list of tags expected by Espial.
+// Spaces are not allowed in tags, they are replaced by underscores.
+func FormatTags(tags []string) string {
+	var results []string
+	seen := make(map[string]bool)
+
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(tag), "_")
+		if tag == "" || seen[strings.ToLower(tag)] {
+			continue
+		}
+
+		seen[strings.ToLower(tag)] = true
+		results = append(results, tag)
+	}
+
+	return strings.Join(results, " ")
+}
+
+// NewClient returns a new Espial client.
+func NewClient(baseURL, apiKey string) *Client {
+	return &Client{baseURL: baseURL, apiKey: apiKey}
+}
+
+func getAPIEndpoint(baseURL, pathURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("espial: invalid API endpoint: %v", err)
+	}
+	u.Path = path.Join(u.Path, pathURL)
+	return u.String(), nil
+}
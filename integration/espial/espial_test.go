@@ -0,0 +1,63 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package espial // import "miniflux.app/integration/espial"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFormatTags(t *testing.T) {
+	scenarios := map[string][]string{
+		"":                    nil,
+		"miniflux Go news":    {"miniflux", "Go", " news ", "go"},
+		"Science_Fiction web": {"Science  Fiction", "", "web"},
+	}
+
+	for expected, tags := range scenarios {
+		if result := FormatTags(tags); result != expected {
+			t.Errorf(`Unexpected tags for %v, got %q instead of %q`, tags, result, expected)
+		}
+	}
+}
+
+func TestAddEntry(t *testing.T) {
+	var doc Document
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/espial/api/add" || r.Header.Get("Authorization") != "ApiKey secret" {
+			t.Errorf(`Unexpected request: %s %v`, r.URL.Path, r.Header)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&doc); err != nil {
+			t.Error(err)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	clt := NewClient(server.URL+"/espial", "secret")
+	if err := clt.AddEntry("https://example.org/", "Example", "Excerpt", []string{"miniflux", "news"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.URL != "https://example.org/" || doc.Tags != "miniflux news" || !doc.ToRead {
+		t.Errorf(`Unexpected document: %+v`, doc)
+	}
+}
+
+func TestAddEntryWithServerFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	if err := NewClient(server.URL, "invalid").AddEntry("https://example.org/", "", "", nil); err == nil {
+		t.Error(`An invalid API key should be reported`)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package hypothesis // import "miniflux.app/integration/hypothesis"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"miniflux.app/http/client"
+)
+
+const defaultBaseURL = "https://api.hypothes.is/api"
+
+// Annotation represents a highlight or a note on a web page.
+type Annotation struct {
+	URL   string
+	Title string
+	Quote string
+	Text  string
+}
+
+// Client represents a Hypothesis client.
+type Client struct {
+	baseURL string
+	token   string
+	group   string
+}
+
+// AddAnnotation publishes the annotation and returns its Hypothesis identifier.
+func (c *Client) AddAnnotation(annotation *Annotation) (string, error) {
+	if c.token == "" {
+		return "", fmt.Errorf("hypothesis: missing API token")
+	}
+
+	// Annotations are never published to the public group by default.
+	if c.group == "" {
+		return "", fmt.Errorf("hypothesis: missing group ID")
+	}
+
+	type selector struct {
+		Type  string `json:"type"`
+		Exact string `json:"exact"`
+	}
+
+	type target struct {
+		Source   string      `json:"source"`
+		Selector []*selector `json:"selector,omitempty"`
+	}
+
+	type document struct {
+		Title []string `json:"title,omitempty"`
+	}
+
+	type body struct {
+		URI      string    `json:"uri"`
+		Document *document `json:"document,omitempty"`
+		Text     string    `json:"text"`
+		Group    string    `json:"group"`
+		Target   []*target `json:"target"`
+	}
+
+	data := &body{
+		URI:    annotation.URL,
+		Text:   annotation.Text,
+		Group:  c.group,
+		Target: []*target{{Source: annotation.URL}},
+	}
+
+	if annotation.Title != "" {
+		data.Document = &document{Title: []string{annotation.Title}}
+	}
+
+	if annotation.Quote != "" {
+		data.Target[0].Selector = []*selector{{Type: "TextQuoteSelector", Exact: annotation.Quote}}
+	}
+
+	clt := client.New(c.baseURL + "/annotations")
+	clt.WithBearerToken(c.token)
+	response, err := clt.PostJSON(data)
+	if err != nil {
+		return "", fmt.Errorf("hypothesis: unable to send annotation: %v", err)
+	}
+
+	if response.StatusCode >= 400 {
+		return "", fmt.Errorf("hypothesis: unable to send annotation, status=%d", response.StatusCode)
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("hypothesis: unable to decode annotation response: %v", err)
+	}
+
+	return result.ID, nil
+}
+
+// RemoveAnnotation deletes an annotation previously published.
+func (c *Client) RemoveAnnotation(id string) error {
+	if c.token == "" {
+		return fmt.Errorf("hypothesis: missing API token")
+	}
+
+	clt := client.New(c.baseURL + "/annotations/" + id)
+	clt.WithBearerToken(c.token)
+	response, err := clt.SendJSON(http.MethodDelete, nil)
+	if err != nil {
+		return fmt.Errorf("hypothesis: unable to remove annotation: %v", err)
+	}
+
+	// The annotation may have been deleted on Hypothesis already.
+	if response.StatusCode >= 400 && !response.IsNotFound() {
+		return fmt.Errorf("hypothesis: unable to remove annotation, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new Hypothesis client publishing the annotations to the given group.
+func NewClient(token, group string) *Client {
+	return &Client{baseURL: defaultBaseURL, token: token, group: group}
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package hypothesis // import "miniflux.app/integration/hypothesis"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddAnnotation(t *testing.T) {
+	var payload map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/annotations" || r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf(`Unexpected request: %s %v`, r.URL.Path, r.Header)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+
+		w.Write([]byte(`{"id": "abc123"}`))
+	}))
+	defer server.Close()
+
+	clt := NewClient("secret", "group")
+	clt.baseURL = server.URL
+
+	id, err := clt.AddAnnotation(&Annotation{
+		URL:   "https://example.org/article",
+		Title: "Article",
+		Quote: "Highlighted text",
+		Text:  "My note",
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id != "abc123" {
+		t.Errorf(`Unexpected identifier: %q`, id)
+	}
+
+	if payload["uri"] != "https://example.org/article" || payload["text"] != "My note" || payload["group"] != "group" {
+		t.Errorf(`Unexpected payload: %v`, payload)
+	}
+
+	targets, ok := payload["target"].([]interface{})
+	if !ok || len(targets) != 1 {
+		t.Fatalf(`Unexpected target: %v`, payload["target"])
+	}
+
+	selectors := targets[0].(map[string]interface{})["selector"].([]interface{})
+	if selectors[0].(map[string]interface{})["exact"] != "Highlighted text" {
+		t.Errorf(`Unexpected selector: %v`, selectors)
+	}
+}
+
+func TestAddAnnotationWithoutToken(t *testing.T) {
+	if _, err := NewClient("", "group").AddAnnotation(&Annotation{URL: "https://example.org/"}); err == nil {
+		t.Error(`An annotation should not be sent without token`)
+	}
+}
+
+func TestAddAnnotationWithoutGroup(t *testing.T) {
+	if _, err := NewClient("secret", "").AddAnnotation(&Annotation{URL: "https://example.org/"}); err == nil {
+		t.Error(`An annotation should not be sent without group`)
+	}
+}
+
+func TestRemoveMissingAnnotation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/annotations/abc123" {
+			t.Errorf(`Unexpected request: %s %s`, r.Method, r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	clt := NewClient("secret", "group")
+	clt.baseURL = server.URL
+
+	if err := clt.RemoveAnnotation("abc123"); err != nil {
+		t.Errorf(`Annotations already deleted should be ignored: %v`, err)
+	}
+}
@@ -5,29 +5,116 @@
 package instapaper // import "miniflux.app/integration/instapaper"
 
 import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"miniflux.app/crypto"
 	"miniflux.app/http/client"
 )
 
+const defaultBaseURL = "https://www.instapaper.com"
+
+// Folder represents an Instapaper folder.
+type Folder struct {
+	ID    int64  `json:"folder_id"`
+	Title string `json:"title"`
+}
+
 // Client represents an Instapaper client.
+//
+// The simple API only needs the user credentials but always saves links in the unread folder.
+// Listing folders and saving into a folder requires the full API and an OAuth consumer key.
 type Client struct {
-	username string
-	password string
+	baseURL        string
+	username       string
+	password       string
+	consumerKey    string
+	consumerSecret string
 }
 
-// AddURL sends a link to Instapaper.
-func (c *Client) AddURL(link, title string) error {
+// AddURL sends a link to Instapaper, with the description shown under the title.
+// The folder is ignored when the full API is not available.
+func (c *Client) AddURL(link, title, description, folderID string) error {
 	if c.username == "" || c.password == "" {
 		return fmt.Errorf("instapaper: missing credentials")
 	}
 
+	if c.consumerKey == "" || c.consumerSecret == "" {
+		return c.addURLWithSimpleAPI(link, title, description)
+	}
+
+	token, tokenSecret, err := c.accessToken()
+	if err != nil {
+		return err
+	}
+
+	values := url.Values{}
+	values.Add("url", link)
+	values.Add("title", title)
+	values.Add("description", description)
+	if folderID != "" {
+		values.Add("folder_id", folderID)
+	}
+
+	response, err := c.signedRequest("/api/1/bookmarks/add", values, token, tokenSecret)
+	if err != nil {
+		return fmt.Errorf("instapaper: unable to send url: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("instapaper: unable to send url, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// Folders returns the folders created by the user, this requires the full API.
+func (c *Client) Folders() ([]*Folder, error) {
+	if c.consumerKey == "" || c.consumerSecret == "" {
+		return nil, fmt.Errorf("instapaper: missing consumer key")
+	}
+
+	if c.username == "" || c.password == "" {
+		return nil, fmt.Errorf("instapaper: missing credentials")
+	}
+
+	token, tokenSecret, err := c.accessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.signedRequest("/api/1/folders/list", url.Values{}, token, tokenSecret)
+	if err != nil {
+		return nil, fmt.Errorf("instapaper: unable to list folders: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return nil, fmt.Errorf("instapaper: unable to list folders, status=%d", response.StatusCode)
+	}
+
+	var folders []*Folder
+	if err := json.NewDecoder(response.Body).Decode(&folders); err != nil {
+		return nil, fmt.Errorf("instapaper: unable to decode folders: %v", err)
+	}
+
+	return folders, nil
+}
+
+func (c *Client) addURLWithSimpleAPI(link, title, description string) error {
 	values := url.Values{}
 	values.Add("url", link)
 	values.Add("title", title)
+	values.Add("selection", description)
 
-	apiURL := "https://www.instapaper.com/api/add?" + values.Encode()
+	apiURL := c.baseURL + "/api/add?" + values.Encode()
 	clt := client.New(apiURL)
 	clt.WithCredentials(c.username, c.password)
 	response, err := clt.Get()
@@ -42,7 +129,101 @@ func (c *Client) AddURL(link, title string) error {
 	return nil
 }
 
-// NewClient returns a new Instapaper client.
-func NewClient(username, password string) *Client {
-	return &Client{username: username, password: password}
+// accessToken exchanges the user credentials for an OAuth token (xAuth).
+func (c *Client) accessToken() (string, string, error) {
+	values := url.Values{}
+	values.Add("x_auth_username", c.username)
+	values.Add("x_auth_password", c.password)
+	values.Add("x_auth_mode", "client_auth")
+
+	response, err := c.signedRequest("/api/1/oauth/access_token", values, "", "")
+	if err != nil {
+		return "", "", fmt.Errorf("instapaper: unable to get access token: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return "", "", fmt.Errorf("instapaper: unable to get access token, status=%d", response.StatusCode)
+	}
+
+	result, err := url.ParseQuery(response.BodyAsString())
+	if err != nil {
+		return "", "", fmt.Errorf("instapaper: unable to decode access token: %v", err)
+	}
+
+	token, tokenSecret := result.Get("oauth_token"), result.Get("oauth_token_secret")
+	if token == "" || tokenSecret == "" {
+		return "", "", fmt.Errorf("instapaper: empty access token")
+	}
+
+	return token, tokenSecret, nil
+}
+
+func (c *Client) signedRequest(path string, values url.Values, token, tokenSecret string) (*client.Response, error) {
+	endpoint := c.baseURL + path
+
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     c.consumerKey,
+		"oauth_nonce":            crypto.GenerateRandomStringHex(16),
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+
+	if token != "" {
+		oauthParams["oauth_token"] = token
+	}
+
+	oauthParams["oauth_signature"] = oauthSignature("POST", endpoint, values, oauthParams, c.consumerSecret, tokenSecret)
+
+	var header []string
+	for key, value := range oauthParams {
+		header = append(header, fmt.Sprintf(`%s="%s"`, key, oauthEscape(value)))
+	}
+	sort.Strings(header)
+
+	clt := client.New(endpoint)
+	clt.WithAuthorization("OAuth " + strings.Join(header, ", "))
+	return clt.PostForm(values)
+}
+
+// oauthSignature computes the HMAC-SHA1 signature described in RFC 5849, section 3.4.
+func oauthSignature(method, endpoint string, values url.Values, oauthParams map[string]string, consumerSecret, tokenSecret string) string {
+	var params []string
+	for key, list := range values {
+		for _, value := range list {
+			params = append(params, oauthEscape(key)+"="+oauthEscape(value))
+		}
+	}
+
+	for key, value := range oauthParams {
+		params = append(params, oauthEscape(key)+"="+oauthEscape(value))
+	}
+
+	sort.Strings(params)
+
+	base := strings.Join([]string{
+		method,
+		oauthEscape(endpoint),
+		oauthEscape(strings.Join(params, "&")),
+	}, "&")
+
+	mac := hmac.New(sha1.New, []byte(oauthEscape(consumerSecret)+"&"+oauthEscape(tokenSecret)))
+	mac.Write([]byte(base))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// oauthEscape percent-encodes everything except unreserved characters, as required by OAuth.
+func oauthEscape(value string) string {
+	return strings.Replace(url.QueryEscape(value), "+", "%20", -1)
+}
+
+// NewClient returns a new Instapaper client, the consumer key and secret are optional.
+func NewClient(username, password, consumerKey, consumerSecret string) *Client {
+	return &Client{
+		baseURL:        defaultBaseURL,
+		username:       username,
+		password:       password,
+		consumerKey:    consumerKey,
+		consumerSecret: consumerSecret,
+	}
 }
@@ -0,0 +1,104 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package instapaper // import "miniflux.app/integration/instapaper"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestOAuthSignature(t *testing.T) {
+	// Example from the OAuth Core 1.0 specification, appendix A.5.
+	values := url.Values{}
+	values.Add("file", "vacation.jpg")
+	values.Add("size", "original")
+
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     "dpf43f3p2l4k3l03",
+		"oauth_token":            "nnch734d00sl2jdk",
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        "1191242096",
+		"oauth_nonce":            "kllo9940pd9333jh",
+		"oauth_version":          "1.0",
+	}
+
+	expected := "tR3+Ty81lMeYAr/Fid0kMTYa/WM="
+	result := oauthSignature("GET", "http://photos.example.net/photos", values, oauthParams, "kd94hf93k423kf44", "pfkkdhi9sl3r4s00")
+	if result != expected {
+		t.Errorf(`Unexpected signature, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestAddURLWithFolder(t *testing.T) {
+	var saved url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "OAuth ") {
+			t.Errorf(`Unsigned request to %s`, r.URL.Path)
+		}
+
+		r.ParseForm()
+
+		switch r.URL.Path {
+		case "/api/1/oauth/access_token":
+			if r.PostForm.Get("x_auth_username") != "user" || r.PostForm.Get("x_auth_mode") != "client_auth" {
+				t.Errorf(`Unexpected xAuth parameters: %v`, r.PostForm)
+			}
+			w.Write([]byte("oauth_token=token&oauth_token_secret=secret"))
+		case "/api/1/bookmarks/add":
+			if !strings.Contains(r.Header.Get("Authorization"), `oauth_token="token"`) {
+				t.Errorf(`The access token is missing from the request`)
+			}
+			saved = r.PostForm
+			w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clt := NewClient("user", "pass", "key", "secret")
+	clt.baseURL = server.URL
+
+	if err := clt.AddURL("https://example.org/", "Title", "Summary", "42"); err != nil {
+		t.Fatal(err)
+	}
+
+	if saved.Get("folder_id") != "42" || saved.Get("description") != "Summary" || saved.Get("url") != "https://example.org/" {
+		t.Errorf(`Unexpected bookmark parameters: %v`, saved)
+	}
+}
+
+func TestAddURLWithSimpleAPI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if r.URL.Path != "/api/add" || !ok || username != "user" || password != "pass" {
+			t.Errorf(`Unexpected request to %s`, r.URL.Path)
+		}
+
+		if r.URL.Query().Get("selection") != "Summary" {
+			t.Errorf(`Unexpected selection: %q`, r.URL.Query().Get("selection"))
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	clt := NewClient("user", "pass", "", "")
+	clt.baseURL = server.URL
+
+	if err := clt.AddURL("https://example.org/", "Title", "Summary", "42"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFoldersRequireConsumerKey(t *testing.T) {
+	if _, err := NewClient("user", "pass", "", "").Folders(); err == nil {
+		t.Error(`Listing folders without consumer key should fail`)
+	}
+}
@@ -5,24 +5,92 @@
 package integration // import "miniflux.app/integration"
 
 import (
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
 	"miniflux.app/config"
+	"miniflux.app/integration/custombookmark"
+	"miniflux.app/integration/espial"
+	"miniflux.app/integration/hypothesis"
 	"miniflux.app/integration/instapaper"
+	"miniflux.app/integration/karakeep"
+	"miniflux.app/integration/linkace"
+	"miniflux.app/integration/markdown"
 	"miniflux.app/integration/nunuxkeeper"
 	"miniflux.app/integration/pinboard"
 	"miniflux.app/integration/pocket"
 	"miniflux.app/integration/wallabag"
+	"miniflux.app/integration/zotero"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+)
+
+const (
+	espialDescriptionLength     = 500
+	instapaperDescriptionLength = 500
+	linkaceDescriptionLength    = 500
+	zoteroAbstractLength        = 1000
 )
 
 // SendEntry send the entry to the activated providers.
 func SendEntry(entry *model.Entry, integration *model.Integration) {
-	if integration.PinboardEnabled {
+	SendEntryWithTags(entry, integration, "")
+}
+
+// SendEntryWithTags send the entry to the activated providers with additional tags chosen when saving it.
+func SendEntryWithTags(entry *model.Entry, integration *model.Integration, tags string) {
+	SendEntriesWithTags(model.Entries{entry}, integration, tags)
+}
+
+// SendEntriesWithTags send several entries to the activated providers.
+// Providers supporting batches, like Pocket, receive all the entries in a single request.
+func SendEntriesWithTags(entries model.Entries, integration *model.Integration, tags string) {
+	for _, entry := range entries {
+		sendEntry(entry, integration, tags)
+	}
+
+	if integration.PocketEnabled {
+		var items []*pocket.Item
+		for _, entry := range entries {
+			if integration.AcceptsCategory("pocket", entryCategoryID(entry)) {
+				items = append(items, &pocket.Item{
+					URL:   entry.URL,
+					Title: entry.Title,
+					Tags:  pocketTags(entry, integration, tags),
+				})
+			}
+		}
+
+		client := pocket.NewClient(config.Opts.PocketConsumerKey(integration.PocketConsumerKey), integration.PocketAccessToken)
+
+		var err error
+		switch len(items) {
+		case 0:
+		case 1:
+			err = client.AddURL(items[0].URL, items[0].Title, items[0].Tags)
+		default:
+			err = client.AddURLs(items)
+		}
+
+		if err != nil {
+			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		}
+	}
+}
+
+// sendEntry sends the entry to the providers that accept only one entry at a time.
+func sendEntry(entry *model.Entry, integration *model.Integration, tags string) {
+	categoryID := entryCategoryID(entry)
+
+	if integration.PinboardEnabled && integration.AcceptsCategory("pinboard", categoryID) {
 		client := pinboard.NewClient(integration.PinboardToken)
 		err := client.AddBookmark(
 			entry.URL,
 			entry.Title,
-			integration.PinboardTags,
+			pinboardTags(entry, integration, tags),
 			integration.PinboardMarkAsUnread,
 		)
 
@@ -31,14 +99,27 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 		}
 	}
 
-	if integration.InstapaperEnabled {
-		client := instapaper.NewClient(integration.InstapaperUsername, integration.InstapaperPassword)
-		if err := client.AddURL(entry.URL, entry.Title); err != nil {
+	if integration.InstapaperEnabled && integration.AcceptsCategory("instapaper", categoryID) {
+		client := instapaper.NewClient(
+			integration.InstapaperUsername,
+			integration.InstapaperPassword,
+			config.Opts.InstapaperConsumerKey(),
+			config.Opts.InstapaperConsumerSecret(),
+		)
+
+		err := client.AddURL(
+			entry.URL,
+			entry.Title,
+			sanitizer.Excerpt(entry.Content, instapaperDescriptionLength),
+			integration.InstapaperFolderID,
+		)
+
+		if err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
 		}
 	}
 
-	if integration.WallabagEnabled {
+	if integration.WallabagEnabled && integration.AcceptsCategory("wallabag", categoryID) {
 		client := wallabag.NewClient(
 			integration.WallabagURL,
 			integration.WallabagClientID,
@@ -47,12 +128,59 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 			integration.WallabagPassword,
 		)
 
-		if err := client.AddEntry(entry.URL, entry.Title); err != nil {
+		err := client.AddEntry(
+			entry.URL,
+			entry.Title,
+			entryOriginURL(entry),
+			wallabagTags(integration, tags),
+			integration.WallabagArchive,
+		)
+
+		if err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
 		}
 	}
 
-	if integration.NunuxKeeperEnabled {
+	if integration.EspialEnabled && integration.AcceptsCategory("espial", categoryID) {
+		client := espial.NewClient(integration.EspialURL, integration.EspialAPIKey)
+		err := client.AddEntry(
+			entry.URL,
+			entry.Title,
+			sanitizer.Excerpt(entry.Content, espialDescriptionLength),
+			append(strings.Fields(integration.EspialTags), strings.Fields(tags)...),
+		)
+
+		if err != nil {
+			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		}
+	}
+
+	if integration.KarakeepEnabled && integration.AcceptsCategory("karakeep", categoryID) {
+		client := karakeep.NewClient(integration.KarakeepURL, integration.KarakeepAPIKey)
+		if err := client.AddBookmark(entry.URL, entry.Title, karakeepTags(entry, integration, tags)); err != nil {
+			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		}
+	}
+
+	if integration.LinkAceEnabled && integration.AcceptsCategory("linkace", categoryID) {
+		lists, err := linkace.ParseListIDs(integration.LinkAceLists)
+		if err == nil {
+			client := linkace.NewClient(integration.LinkAceURL, integration.LinkAceAPIKey, integration.LinkAceIsPrivate)
+			err = client.AddLink(
+				entry.URL,
+				entry.Title,
+				sanitizer.Excerpt(entry.Content, linkaceDescriptionLength),
+				linkaceTags(entry, integration, tags),
+				lists,
+			)
+		}
+
+		if err != nil {
+			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		}
+	}
+
+	if integration.NunuxKeeperEnabled && integration.AcceptsCategory("nunux_keeper", categoryID) {
 		client := nunuxkeeper.NewClient(
 			integration.NunuxKeeperURL,
 			integration.NunuxKeeperAPIKey,
@@ -63,10 +191,247 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 		}
 	}
 
-	if integration.PocketEnabled {
-		client := pocket.NewClient(config.Opts.PocketConsumerKey(integration.PocketConsumerKey), integration.PocketAccessToken)
-		if err := client.AddURL(entry.URL, entry.Title); err != nil {
+	if integration.CustomBookmarkEnabled && integration.AcceptsCategory("custom_bookmark", categoryID) {
+		client := custombookmark.NewClient(
+			integration.CustomBookmarkURL,
+			integration.CustomBookmarkMethod,
+			integration.CustomBookmarkHeaders,
+			integration.CustomBookmarkBody,
+		)
+
+		if err := client.AddEntry(customBookmarkEntry(entry, tags)); err != nil {
+			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		}
+	}
+
+	if integration.MarkdownEnabled && integration.AcceptsCategory("markdown", categoryID) {
+		addMarkdownNote(entry, integration, tags)
+	}
+
+	if integration.ZoteroEnabled && integration.AcceptsCategory("zotero", categoryID) {
+		client := zotero.NewClient(
+			integration.ZoteroLibraryType,
+			integration.ZoteroLibraryID,
+			integration.ZoteroAPIKey,
+			integration.ZoteroCollectionKey,
+		)
+
+		if err := client.AddItem(zoteroItem(entry, tags)); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
 		}
 	}
 }
+
+// SendStarredEntry exports the entry starred by the user as Markdown note when enabled.
+func SendStarredEntry(entry *model.Entry, integration *model.Integration) {
+	if integration.MarkdownEnabled && integration.MarkdownStarred && integration.AcceptsCategory("markdown", entryCategoryID(entry)) {
+		addMarkdownNote(entry, integration, "")
+	}
+}
+
+// SendAnnotation publishes the annotation to Hypothesis when enabled and returns the Hypothesis identifier.
+func SendAnnotation(annotation *model.Annotation, integration *model.Integration) string {
+	if !integration.HypothesisEnabled {
+		return ""
+	}
+
+	client := hypothesis.NewClient(integration.HypothesisToken, integration.HypothesisGroup)
+	id, err := client.AddAnnotation(&hypothesis.Annotation{
+		URL:   annotation.EntryURL,
+		Title: annotation.EntryTitle,
+		Quote: annotation.Quote,
+		Text:  annotation.Note,
+	})
+
+	if err != nil {
+		logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+	}
+
+	return id
+}
+
+// RemoveAnnotation deletes the annotation from Hypothesis if it was published there.
+func RemoveAnnotation(annotation *model.Annotation, integration *model.Integration) {
+	if !integration.HypothesisEnabled || annotation.HypothesisID == "" {
+		return
+	}
+
+	client := hypothesis.NewClient(integration.HypothesisToken, integration.HypothesisGroup)
+	if err := client.RemoveAnnotation(annotation.HypothesisID); err != nil {
+		logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+	}
+}
+
+func addMarkdownNote(entry *model.Entry, integration *model.Integration, tags string) {
+	client := markdown.NewClient(
+		integration.MarkdownWebDAVURL,
+		integration.MarkdownWebDAVUsername,
+		integration.MarkdownWebDAVPassword,
+		MarkdownLocalDir(integration.UserID),
+	)
+
+	if err := client.AddNote(markdownNote(entry, tags)); err != nil {
+		logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+	}
+}
+
+// MarkdownLocalDir returns the folder where the notes of the user are written, or an empty string if disabled.
+func MarkdownLocalDir(userID int64) string {
+	if config.Opts.MarkdownExportDir() == "" {
+		return ""
+	}
+
+	return filepath.Join(config.Opts.MarkdownExportDir(), strconv.FormatInt(userID, 10))
+}
+
+// pinboardTags combines the default tags with the ones derived from the category and the entry.
+func pinboardTags(entry *model.Entry, integration *model.Integration, tags string) string {
+	results := strings.Fields(integration.PinboardTags)
+
+	if integration.PinboardCategoryTag && entry.Feed != nil && entry.Feed.Category != nil {
+		results = append(results, entry.Feed.Category.Title)
+	}
+
+	if integration.PinboardEntryTags {
+		results = append(results, entry.Tags...)
+	}
+
+	results = append(results, strings.Fields(tags)...)
+	return pinboard.FormatTags(results)
+}
+
+// pocketTags returns the category of the entry when enabled, followed by the tags chosen when saving it.
+func pocketTags(entry *model.Entry, integration *model.Integration, tags string) []string {
+	var results []string
+	if integration.PocketCategoryTag && entry.Feed != nil && entry.Feed.Category != nil {
+		results = append(results, entry.Feed.Category.Title)
+	}
+
+	return append(results, strings.Fields(tags)...)
+}
+
+// wallabagTags returns the default tags followed by the ones chosen when saving the entry.
+func wallabagTags(integration *model.Integration, tags string) []string {
+	return append(splitTagList(integration.WallabagTags), strings.Fields(tags)...)
+}
+
+// karakeepTags returns the default tags, the tags of the entry and the ones chosen when saving it, without duplicates.
+func karakeepTags(entry *model.Entry, integration *model.Integration, tags string) []string {
+	var results []string
+	seen := make(map[string]bool)
+
+	candidates := append(splitTagList(integration.KarakeepTags), entry.Tags...)
+	for _, tag := range append(candidates, strings.Fields(tags)...) {
+		if key := strings.ToLower(tag); !seen[key] {
+			seen[key] = true
+			results = append(results, tag)
+		}
+	}
+
+	return results
+}
+
+// linkaceTags returns the default tags, the category of the entry when enabled and the tags chosen when saving it.
+func linkaceTags(entry *model.Entry, integration *model.Integration, tags string) []string {
+	results := splitTagList(integration.LinkAceTags)
+	if integration.LinkAceCategoryTag && entry.Feed != nil && entry.Feed.Category != nil {
+		results = append(results, entry.Feed.Category.Title)
+	}
+
+	return append(results, strings.Fields(tags)...)
+}
+
+// splitTagList returns the non-empty tags of a comma-separated list.
+func splitTagList(list string) []string {
+	var results []string
+	for _, tag := range strings.Split(list, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			results = append(results, tag)
+		}
+	}
+
+	return results
+}
+
+// customBookmarkEntry returns the values available in the body template of the custom bookmark service.
+func customBookmarkEntry(entry *model.Entry, tags string) *custombookmark.Entry {
+	result := &custombookmark.Entry{
+		URL:         entry.URL,
+		Title:       entry.Title,
+		Content:     entry.Content,
+		Author:      entry.Author,
+		CommentsURL: entry.CommentsURL,
+		Tags:        append(append([]string{}, entry.Tags...), strings.Fields(tags)...),
+		Date:        entry.Date,
+	}
+
+	if entry.Feed != nil {
+		result.FeedTitle = entry.Feed.DisplayTitle()
+		result.FeedSiteURL = entry.Feed.SiteURL
+		if entry.Feed.Category != nil {
+			result.CategoryName = entry.Feed.Category.Title
+		}
+	}
+
+	return result
+}
+
+// markdownNote returns the entry as Markdown note, with the tags of the entry and the ones chosen when saving it.
+func markdownNote(entry *model.Entry, tags string) *markdown.Note {
+	note := &markdown.Note{
+		URL:         entry.URL,
+		Title:       entry.Title,
+		Content:     entry.Content,
+		Author:      entry.Author,
+		CommentsURL: entry.CommentsURL,
+		Tags:        append(append([]string{}, entry.Tags...), strings.Fields(tags)...),
+		Date:        entry.Date,
+		SavedAt:     time.Now(),
+	}
+
+	if entry.Feed != nil {
+		note.FeedTitle = entry.Feed.DisplayTitle()
+		if entry.Feed.Category != nil {
+			note.Category = entry.Feed.Category.Title
+		}
+	}
+
+	return note
+}
+
+// zoteroItem returns the metadata of the entry, the DOI is looked up in the links and the content.
+func zoteroItem(entry *model.Entry, tags string) *zotero.Item {
+	item := &zotero.Item{
+		URL:      entry.URL,
+		Title:    entry.Title,
+		Author:   entry.Author,
+		Abstract: sanitizer.Excerpt(entry.Content, zoteroAbstractLength),
+		DOI:      zotero.FindDOI(entry.URL, entry.CommentsURL, entry.Content),
+		Tags:     append(append([]string{}, entry.Tags...), strings.Fields(tags)...),
+		Date:     entry.Date,
+	}
+
+	if entry.Feed != nil {
+		item.Publication = entry.Feed.DisplayTitle()
+	}
+
+	return item
+}
+
+// entryCategoryID returns the category of the feed, used to route the entry to the services.
+func entryCategoryID(entry *model.Entry) int64 {
+	if entry.Feed != nil && entry.Feed.Category != nil {
+		return entry.Feed.Category.ID
+	}
+
+	return 0
+}
+
+// entryOriginURL returns the website of the feed, where the entry has been found.
+func entryOriginURL(entry *model.Entry) string {
+	if entry.Feed != nil {
+		return entry.Feed.SiteURL
+	}
+
+	return ""
+}
@@ -0,0 +1,114 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package karakeep // import "miniflux.app/integration/karakeep"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+
+	"miniflux.app/http/client"
+)
+
+// Client represents a Karakeep client.
+type Client struct {
+	baseURL string
+	apiKey  string
+}
+
+// AddBookmark saves the link and attaches the tags to the bookmark.
+func (c *Client) AddBookmark(link, title string, tags []string) error {
+	if c.baseURL == "" || c.apiKey == "" {
+		return fmt.Errorf("karakeep: missing credentials")
+	}
+
+	apiURL, err := getAPIEndpoint(c.baseURL, "/api/v1/bookmarks")
+	if err != nil {
+		return err
+	}
+
+	type bookmark struct {
+		Type  string `json:"type"`
+		URL   string `json:"url"`
+		Title string `json:"title,omitempty"`
+	}
+
+	clt := client.New(apiURL)
+	clt.WithBearerToken(c.apiKey)
+	response, err := clt.PostJSON(&bookmark{Type: "link", URL: link, Title: title})
+	if err != nil {
+		return fmt.Errorf("karakeep: unable to send entry: %v", err)
+	}
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("karakeep: unable to send entry, status=%d", response.StatusCode)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return fmt.Errorf("karakeep: unable to decode bookmark response: %v", err)
+	}
+
+	if result.ID == "" {
+		return fmt.Errorf("karakeep: the bookmark response has no identifier")
+	}
+
+	return c.attachTags(result.ID, tags)
+}
+
+func (c *Client) attachTags(bookmarkID string, tags []string) error {
+	apiURL, err := getAPIEndpoint(c.baseURL, "/api/v1/bookmarks/"+url.PathEscape(bookmarkID)+"/tags")
+	if err != nil {
+		return err
+	}
+
+	type tag struct {
+		TagName string `json:"tagName"`
+	}
+
+	type body struct {
+		Tags []*tag `json:"tags"`
+	}
+
+	data := &body{}
+	for _, name := range tags {
+		data.Tags = append(data.Tags, &tag{TagName: name})
+	}
+
+	clt := client.New(apiURL)
+	clt.WithBearerToken(c.apiKey)
+	response, err := clt.PostJSON(data)
+	if err != nil {
+		return fmt.Errorf("karakeep: unable to attach tags: %v", err)
+	}
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("karakeep: unable to attach tags, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new Karakeep client.
+func NewClient(baseURL, apiKey string) *Client {
+	return &Client{baseURL: baseURL, apiKey: apiKey}
+}
+
+func getAPIEndpoint(baseURL, pathURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("karakeep: invalid API endpoint: %v", err)
+	}
+	u.Path = path.Join(u.Path, pathURL)
+	return u.String(), nil
+}
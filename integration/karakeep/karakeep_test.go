@@ -0,0 +1,74 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package karakeep // import "miniflux.app/integration/karakeep"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddBookmarkWithTags(t *testing.T) {
+	var bookmark map[string]string
+	var tags struct {
+		Tags []map[string]string `json:"tags"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf(`Unexpected authorization header: %q`, r.Header.Get("Authorization"))
+		}
+
+		switch r.URL.Path {
+		case "/karakeep/api/v1/bookmarks":
+			json.NewDecoder(r.Body).Decode(&bookmark)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": "bm1", "title": null}`))
+		case "/karakeep/api/v1/bookmarks/bm1/tags":
+			json.NewDecoder(r.Body).Decode(&tags)
+			w.Write([]byte(`{"attached": ["t1", "t2"]}`))
+		default:
+			t.Errorf(`Unexpected request to %s`, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	clt := NewClient(server.URL+"/karakeep/", "secret")
+	if err := clt.AddBookmark("https://example.org/", "Example", []string{"news", "go"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if bookmark["type"] != "link" || bookmark["url"] != "https://example.org/" || bookmark["title"] != "Example" {
+		t.Errorf(`Unexpected bookmark: %v`, bookmark)
+	}
+
+	if len(tags.Tags) != 2 || tags.Tags[0]["tagName"] != "news" {
+		t.Errorf(`Unexpected tags: %v`, tags.Tags)
+	}
+}
+
+func TestAddBookmarkWithoutTags(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	if err := NewClient(server.URL, "secret").AddBookmark("https://example.org/", "", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 1 {
+		t.Errorf(`Only the bookmark should be created, got %d requests`, requests)
+	}
+}
+
+func TestAddBookmarkWithoutCredentials(t *testing.T) {
+	if err := NewClient("https://karakeep.example.org", "").AddBookmark("https://example.org/", "", nil); err == nil {
+		t.Error(`The API key should be required`)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package linkace // import "miniflux.app/integration/linkace"
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"miniflux.app/http/client"
+)
+
+// Link represents a link sent to LinkAce.
+type Link struct {
+	URL         string   `json:"url"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags"`
+	Lists       []int64  `json:"lists"`
+	IsPrivate   bool     `json:"is_private"`
+}
+
+// Client represents a LinkAce client.
+type Client struct {
+	baseURL   string
+	apiKey    string
+	isPrivate bool
+}
+
+// AddLink saves the link to LinkAce, inside the given lists and with the given tags.
+func (c *Client) AddLink(link, title, description string, tags []string, lists []int64) error {
+	if c.baseURL == "" || c.apiKey == "" {
+		return fmt.Errorf("linkace: missing credentials")
+	}
+
+	data := &Link{
+		URL:         link,
+		Title:       title,
+		Description: description,
+		Tags:        FormatTags(tags),
+		Lists:       lists,
+		IsPrivate:   c.isPrivate,
+	}
+
+	if data.Lists == nil {
+		data.Lists = []int64{}
+	}
+
+	apiURL, err := getAPIEndpoint(c.baseURL, "/api/v1/links")
+	if err != nil {
+		return err
+	}
+
+	clt := client.New(apiURL)
+	clt.WithBearerToken(c.apiKey)
+	clt.WithHeader("Accept", "application/json")
+	response, err := clt.PostJSON(data)
+	if err != nil {
+		return fmt.Errorf("linkace: unable to send entry: %v", err)
+	}
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("linkace: unable to send entry, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// FormatTags removes empty and duplicated tags, LinkAce does not accept commas in tag names.
+func FormatTags(tags []string) []string {
+	results := make([]string, 0, len(tags))
+	seen := make(map[string]bool)
+
+	for _, tag := range tags {
+		tag = strings.TrimSpace(strings.Replace(tag, ",", " ", -1))
+		if tag == "" || seen[strings.ToLower(tag)] {
+			continue
+		}
+
+		seen[strings.ToLower(tag)] = true
+		results = append(results, tag)
+	}
+
+	return results
+}
+
+// ParseListIDs reads a comma separated list of LinkAce list IDs.
+func ParseListIDs(text string) ([]int64, error) {
+	var ids []int64
+
+	for _, value := range strings.Split(text, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid list ID %q", value)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// NewClient returns a new LinkAce client.
+func NewClient(baseURL, apiKey string, isPrivate bool) *Client {
+	return &Client{baseURL: baseURL, apiKey: apiKey, isPrivate: isPrivate}
+}
+
+func getAPIEndpoint(baseURL, pathURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("linkace: invalid API endpoint: %v", err)
+	}
+	u.Path = path.Join(u.Path, pathURL)
+	return u.String(), nil
+}
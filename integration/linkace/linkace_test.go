@@ -0,0 +1,76 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package linkace // import "miniflux.app/integration/linkace"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestFormatTags(t *testing.T) {
+	result := FormatTags([]string{"News", " news ", "", "Tips,Tricks", "Go"})
+	expected := []string{"News", "Tips Tricks", "Go"}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf(`Unexpected tags, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestParseListIDs(t *testing.T) {
+	ids, err := ParseListIDs(" 3, 12 ,,")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(ids, []int64{3, 12}) {
+		t.Errorf(`Unexpected list IDs: %v`, ids)
+	}
+
+	for _, text := range []string{"reading", "3,-1", "0"} {
+		if _, err := ParseListIDs(text); err == nil {
+			t.Errorf(`The list IDs %q should be invalid`, text)
+		}
+	}
+}
+
+func TestAddLink(t *testing.T) {
+	var link Link
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/links" || r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf(`Unexpected request: %s %v`, r.URL.Path, r.Header)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&link); err != nil {
+			t.Error(err)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	clt := NewClient(server.URL, "secret", true)
+	if err := clt.AddLink("https://example.org/", "Example", "", []string{"news"}, []int64{3}); err != nil {
+		t.Fatal(err)
+	}
+
+	if link.URL != "https://example.org/" || !link.IsPrivate || len(link.Tags) != 1 || len(link.Lists) != 1 || link.Lists[0] != 3 {
+		t.Errorf(`Unexpected link: %+v`, link)
+	}
+}
+
+func TestAddLinkWithValidationError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+	defer server.Close()
+
+	if err := NewClient(server.URL, "secret", false).AddLink("invalid", "", "", nil, nil); err == nil {
+		t.Error(`Rejected links should be reported`)
+	}
+}
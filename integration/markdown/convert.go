@@ -0,0 +1,200 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package markdown // import "miniflux.app/integration/markdown"
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	blankLinesRegex   = regexp.MustCompile(`\n{3,}`)
+	markdownEscaper   = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `_`, `\_`, "`", "\\`", `[`, `\[`, `]`, `\]`)
+	whitespacesRegex  = regexp.MustCompile(`\s+`)
+	lineStartingRegex = regexp.MustCompile(`(?m)^(\s*)([#>+-]|\d+\.)(\s)`)
+)
+
+// ConvertHTML converts the sanitized content of an entry to Markdown.
+// Unsupported elements are replaced by their text.
+func ConvertHTML(input string) string {
+	nodes, err := html.ParseFragment(strings.NewReader(input), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return ""
+	}
+
+	var builder strings.Builder
+	for _, node := range nodes {
+		convertNode(&builder, node, "")
+	}
+
+	output := blankLinesRegex.ReplaceAllString(builder.String(), "\n\n")
+	return strings.TrimSpace(output)
+}
+
+func convertNode(builder *strings.Builder, node *html.Node, prefix string) {
+	switch node.Type {
+	case html.TextNode:
+		text := whitespacesRegex.ReplaceAllString(node.Data, " ")
+		text = markdownEscaper.Replace(text)
+		builder.WriteString(lineStartingRegex.ReplaceAllString(text, `$1\$2$3`))
+		return
+	case html.ElementNode:
+	default:
+		convertChildren(builder, node, prefix)
+		return
+	}
+
+	switch node.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(node.Data[1] - '0')
+		builder.WriteString("\n\n" + prefix + strings.Repeat("#", level) + " ")
+		builder.WriteString(strings.TrimSpace(childrenText(node, prefix)))
+		builder.WriteString("\n\n")
+	case atom.P, atom.Div, atom.Figure, atom.Section, atom.Article:
+		builder.WriteString("\n\n" + prefix)
+		convertChildren(builder, node, prefix)
+		builder.WriteString("\n\n")
+	case atom.Br:
+		builder.WriteString("  \n" + prefix)
+	case atom.Hr:
+		builder.WriteString("\n\n" + prefix + "---\n\n")
+	case atom.Strong, atom.B:
+		writeWrapped(builder, "**", childrenText(node, prefix))
+	case atom.Em, atom.I:
+		writeWrapped(builder, "_", childrenText(node, prefix))
+	case atom.Del, atom.S:
+		writeWrapped(builder, "~~", childrenText(node, prefix))
+	case atom.Code:
+		builder.WriteString(inlineCode(textContent(node)))
+	case atom.Pre:
+		builder.WriteString("\n\n" + prefix + "```\n")
+		for _, line := range strings.Split(strings.Trim(textContent(node), "\n"), "\n") {
+			builder.WriteString(prefix + line + "\n")
+		}
+		builder.WriteString(prefix + "```\n\n")
+	case atom.A:
+		text := strings.TrimSpace(childrenText(node, prefix))
+		href := attribute(node, "href")
+		switch {
+		case href == "":
+			builder.WriteString(text)
+		case text == "":
+			builder.WriteString("<" + href + ">")
+		default:
+			builder.WriteString("[" + text + "](" + escapeURL(href) + ")")
+		}
+	case atom.Img:
+		if src := attribute(node, "src"); src != "" {
+			builder.WriteString("![" + markdownEscaper.Replace(attribute(node, "alt")) + "](" + escapeURL(src) + ")")
+		}
+	case atom.Blockquote:
+		var quote strings.Builder
+		convertChildren(&quote, node, "")
+		builder.WriteString("\n\n")
+		for _, line := range strings.Split(strings.TrimSpace(blankLinesRegex.ReplaceAllString(quote.String(), "\n\n")), "\n") {
+			builder.WriteString(strings.TrimRight(prefix+"> "+line, " ") + "\n")
+		}
+		builder.WriteString("\n")
+	case atom.Ul, atom.Ol:
+		builder.WriteString("\n\n")
+		index := 1
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode || child.DataAtom != atom.Li {
+				continue
+			}
+
+			marker := "- "
+			if node.DataAtom == atom.Ol {
+				marker = fmt.Sprintf("%d. ", index)
+				index++
+			}
+
+			item := strings.TrimSpace(childrenText(child, prefix+strings.Repeat(" ", len(marker))))
+			builder.WriteString(prefix + marker + item + "\n")
+		}
+		builder.WriteString("\n")
+	case atom.Script, atom.Style, atom.Iframe, atom.Video, atom.Audio:
+		if src := attribute(node, "src"); src != "" {
+			builder.WriteString("<" + src + ">")
+		}
+	default:
+		convertChildren(builder, node, prefix)
+	}
+}
+
+func convertChildren(builder *strings.Builder, node *html.Node, prefix string) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		convertNode(builder, child, prefix)
+	}
+}
+
+func childrenText(node *html.Node, prefix string) string {
+	var builder strings.Builder
+	convertChildren(&builder, node, prefix)
+	return blankLinesRegex.ReplaceAllString(builder.String(), "\n\n")
+}
+
+func textContent(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+
+	var builder strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		builder.WriteString(textContent(child))
+	}
+
+	return builder.String()
+}
+
+func writeWrapped(builder *strings.Builder, marker, text string) {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		builder.WriteString(text)
+		return
+	}
+
+	// Markers must touch the text, surrounding spaces are kept outside.
+	if strings.HasPrefix(text, " ") {
+		builder.WriteString(" ")
+	}
+
+	builder.WriteString(marker + trimmed + marker)
+
+	if strings.HasSuffix(text, " ") {
+		builder.WriteString(" ")
+	}
+}
+
+func inlineCode(text string) string {
+	fence := "`"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		return fence + " " + text + " " + fence
+	}
+
+	return fence + text + fence
+}
+
+func escapeURL(link string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(link)
+}
+
+func attribute(node *html.Node, name string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == name {
+			return strings.TrimSpace(attr.Val)
+		}
+	}
+
+	return ""
+}
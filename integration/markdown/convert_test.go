@@ -0,0 +1,31 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package markdown // import "miniflux.app/integration/markdown"
+
+import "testing"
+
+func TestConvertHTML(t *testing.T) {
+	scenarios := map[string]string{
+		`<p>Hello <strong>World</strong></p>`:                             `Hello **World**`,
+		`<h2>Title</h2><p>Text</p>`:                                       "## Title\n\nText",
+		`<p><a href="https://example.org/a b">Link</a></p>`:               `[Link](https://example.org/a%20b)`,
+		`<p><img src="https://example.org/image.png" alt="An image"></p>`: `![An image](https://example.org/image.png)`,
+		`<ul><li>One</li><li>Two</li></ul>`:                               "- One\n- Two",
+		`<ol><li>One</li><li><em>Two</em></li></ol>`:                      "1. One\n2. _Two_",
+		`<blockquote><p>Quote</p><p>Second</p></blockquote>`:              "> Quote\n>\n> Second",
+		`<pre><code>a := 1
+b := 2</code></pre>`: "```\na := 1\nb := 2\n```",
+		`<p>Use <code>go test</code> * 2</p>`:  "Use `go test` \\* 2",
+		`<p># Not a title</p>`:                 `\# Not a title`,
+		`<p>Line<br>Break</p>`:                 "Line  \nBreak",
+		`<script>alert(1)</script><p>Text</p>`: "Text",
+	}
+
+	for input, expected := range scenarios {
+		if result := ConvertHTML(input); result != expected {
+			t.Errorf(`Unexpected conversion of %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
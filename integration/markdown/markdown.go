@@ -0,0 +1,162 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package markdown // import "miniflux.app/integration/markdown"
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/http/client"
+)
+
+const maxFileNameLength = 100
+
+var invalidFileNameRegex = regexp.MustCompile(`[\\/:*?"<>|#^\[\]\x00-\x1f]+`)
+
+// Note represents an entry saved as Markdown file.
+type Note struct {
+	URL         string
+	Title       string
+	Content     string
+	Author      string
+	CommentsURL string
+	FeedTitle   string
+	Category    string
+	Tags        []string
+	Date        time.Time
+	SavedAt     time.Time
+}
+
+// FileName returns the name of the Markdown file, notes apps use it as title.
+func (n *Note) FileName() string {
+	title := invalidFileNameRegex.ReplaceAllString(n.Title, " ")
+	title = strings.Trim(strings.Join(strings.Fields(title), " "), ". ")
+	if title == "" {
+		title = "Untitled"
+	}
+
+	if runes := []rune(title); len(runes) > maxFileNameLength {
+		title = strings.TrimSpace(string(runes[:maxFileNameLength]))
+	}
+
+	return n.Date.Format("2006-01-02") + " " + title + ".md"
+}
+
+// Render returns the note with a YAML front matter followed by the content converted to Markdown.
+func (n *Note) Render() []byte {
+	var builder strings.Builder
+	builder.WriteString("---\n")
+	writeField(&builder, "title", n.Title)
+	writeField(&builder, "url", n.URL)
+	writeField(&builder, "author", n.Author)
+	writeField(&builder, "feed", n.FeedTitle)
+	writeField(&builder, "category", n.Category)
+	writeField(&builder, "comments", n.CommentsURL)
+
+	builder.WriteString("tags: [")
+	for i, tag := range n.Tags {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(strconv.Quote(tag))
+	}
+	builder.WriteString("]\n")
+
+	builder.WriteString("date: " + n.Date.UTC().Format(time.RFC3339) + "\n")
+	builder.WriteString("saved: " + n.SavedAt.UTC().Format(time.RFC3339) + "\n")
+	builder.WriteString("---\n\n")
+
+	builder.WriteString("# " + strings.TrimSpace(n.Title) + "\n\n")
+	if content := ConvertHTML(n.Content); content != "" {
+		builder.WriteString(content + "\n\n")
+	}
+	builder.WriteString("[Original article](" + escapeURL(n.URL) + ")\n")
+
+	return []byte(builder.String())
+}
+
+// writeField writes a front matter field, empty values are skipped.
+func writeField(builder *strings.Builder, name, value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		builder.WriteString(name + ": " + strconv.Quote(value) + "\n")
+	}
+}
+
+// Client writes notes to a WebDAV folder, or to a local folder when no URL is defined.
+type Client struct {
+	webdavURL string
+	username  string
+	password  string
+	localDir  string
+}
+
+// AddNote writes the note, an existing file with the same name is replaced.
+func (c *Client) AddNote(note *Note) error {
+	if c.webdavURL != "" {
+		return c.putWebDAV(note)
+	}
+
+	if c.localDir == "" {
+		return fmt.Errorf("markdown: no WebDAV URL or local folder defined")
+	}
+
+	if err := os.MkdirAll(c.localDir, 0750); err != nil {
+		return fmt.Errorf("markdown: unable to create folder: %v", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(c.localDir, note.FileName()), note.Render(), 0640); err != nil {
+		return fmt.Errorf("markdown: unable to write note: %v", err)
+	}
+
+	return nil
+}
+
+func (c *Client) putWebDAV(note *Note) error {
+	endpoint := strings.TrimSuffix(c.webdavURL, "/") + "/" + url.PathEscape(note.FileName())
+
+	clt := client.New(endpoint)
+	if c.username != "" {
+		clt.WithCredentials(c.username, c.password)
+	}
+
+	response, err := clt.Send(http.MethodPut, "text/markdown; charset=utf-8", note.Render())
+	if err != nil {
+		return fmt.Errorf("markdown: unable to upload note: %v", err)
+	}
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("markdown: unable to upload note, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// ValidateWebDAVURL returns an error if the URL cannot be used as WebDAV folder.
+func ValidateWebDAVURL(webdavURL string) error {
+	u, err := url.Parse(webdavURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %q", webdavURL)
+	}
+
+	return nil
+}
+
+// NewClient returns a new client, the local folder is used when the WebDAV URL is empty.
+func NewClient(webdavURL, username, password, localDir string) *Client {
+	return &Client{
+		webdavURL: strings.TrimSpace(webdavURL),
+		username:  username,
+		password:  password,
+		localDir:  localDir,
+	}
+}
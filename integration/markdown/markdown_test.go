@@ -0,0 +1,124 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package markdown // import "miniflux.app/integration/markdown"
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestNote() *Note {
+	return &Note{
+		URL:      "https://example.org/article",
+		Title:    `Go 1.15: what's "new"?`,
+		Content:  `<p>Some <em>content</em></p>`,
+		Category: "Programming",
+		Tags:     []string{"go", "release notes"},
+		Date:     time.Date(2020, 8, 11, 10, 0, 0, 0, time.UTC),
+		SavedAt:  time.Date(2020, 8, 12, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestFileName(t *testing.T) {
+	scenarios := map[string]string{
+		`Go 1.15: what's "new"?`: `2020-08-11 Go 1.15 what's new.md`,
+		`a/b\c`:                  `2020-08-11 a b c.md`,
+		`...`:                    `2020-08-11 Untitled.md`,
+		strings.Repeat("a", 150): `2020-08-11 ` + strings.Repeat("a", maxFileNameLength) + `.md`,
+	}
+
+	for title, expected := range scenarios {
+		note := newTestNote()
+		note.Title = title
+		if result := note.FileName(); result != expected {
+			t.Errorf(`Unexpected file name for %q, got %q instead of %q`, title, result, expected)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	expected := `---
+title: "Go 1.15: what's \"new\"?"
+url: "https://example.org/article"
+category: "Programming"
+tags: ["go", "release notes"]
+date: 2020-08-11T10:00:00Z
+saved: 2020-08-12T10:00:00Z
+---
+
+# Go 1.15: what's "new"?
+
+Some _content_
+
+[Original article](https://example.org/article)
+`
+
+	if result := string(newTestNote().Render()); result != expected {
+		t.Errorf(`Unexpected note:\n%s`, result)
+	}
+}
+
+func TestAddNoteToLocalFolder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "markdown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	note := newTestNote()
+	folder := filepath.Join(dir, "user")
+	if err := NewClient("", "", "", folder).AddNote(note); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(folder, note.FileName()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != string(note.Render()) {
+		t.Errorf(`Unexpected file content: %s`, data)
+	}
+}
+
+func TestAddNoteToWebDAV(t *testing.T) {
+	var path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		if r.Method != http.MethodPut || username != "user" || password != "secret" {
+			t.Errorf(`Unexpected request: %s %v`, r.Method, r.Header)
+		}
+
+		data, _ := ioutil.ReadAll(r.Body)
+		path, body = r.URL.Path, string(data)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	note := newTestNote()
+	if err := NewClient(server.URL+"/notes/", "user", "secret", "").AddNote(note); err != nil {
+		t.Fatal(err)
+	}
+
+	if path != "/notes/"+note.FileName() {
+		t.Errorf(`Unexpected path: %q`, path)
+	}
+
+	if body != string(note.Render()) {
+		t.Errorf(`Unexpected body: %s`, body)
+	}
+}
+
+func TestAddNoteWithoutTarget(t *testing.T) {
+	if err := NewClient("", "", "", "").AddNote(newTestNote()); err == nil {
+		t.Error(`An error should be returned when no target is defined`)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ntfy // import "miniflux.app/integration/ntfy"
+
+import (
+	"fmt"
+	"net/url"
+
+	"miniflux.app/http/client"
+)
+
+// Client represents a ntfy client.
+type Client struct {
+	topicURL string
+	token    string
+}
+
+// SendMessage publishes a notification to the topic, clickURL is opened when the notification is tapped.
+func (c *Client) SendMessage(title, message, clickURL string) error {
+	if c.topicURL == "" {
+		return fmt.Errorf("ntfy: missing topic URL")
+	}
+
+	if err := ValidateTopicURL(c.topicURL); err != nil {
+		return err
+	}
+
+	clt := client.New(c.topicURL)
+	if c.token != "" {
+		clt.WithBearerToken(c.token)
+	}
+	clt.WithHeader("Title", title)
+	if clickURL != "" {
+		clt.WithHeader("Click", clickURL)
+	}
+
+	response, err := clt.Send("POST", "text/plain; charset=utf-8", []byte(message))
+	if err != nil {
+		return fmt.Errorf("ntfy: unable to send notification: %v", err)
+	}
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("ntfy: unable to send notification, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// ValidateTopicURL checks that the topic URL is an absolute HTTP URL with a topic name.
+func ValidateTopicURL(topicURL string) error {
+	u, err := url.Parse(topicURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(u.Path) < 2 {
+		return fmt.Errorf("ntfy: invalid topic URL")
+	}
+
+	return nil
+}
+
+// NewClient returns a new ntfy client.
+func NewClient(topicURL, token string) *Client {
+	return &Client{topicURL: topicURL, token: token}
+}
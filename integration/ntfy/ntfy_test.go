@@ -0,0 +1,65 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ntfy // import "miniflux.app/integration/ntfy"
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSendMessage(t *testing.T) {
+	var body []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/miniflux" {
+			t.Errorf(`Unexpected request: %s %s`, r.Method, r.URL.Path)
+		}
+
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("Title") != "Title" || r.Header.Get("Click") != "https://example.org/" {
+			t.Errorf(`Unexpected headers: %v`, r.Header)
+		}
+
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	if err := NewClient(server.URL+"/miniflux", "secret").SendMessage("Title", "Message", "https://example.org/"); err != nil {
+		t.Fatal(err)
+	}
+
+	if string(body) != "Message" {
+		t.Errorf(`Unexpected message: %q`, body)
+	}
+}
+
+func TestSendMessageWithServerFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	if err := NewClient(server.URL+"/miniflux", "").SendMessage("Title", "Message", ""); err == nil {
+		t.Error(`A rejected notification should be reported`)
+	}
+}
+
+func TestValidateTopicURL(t *testing.T) {
+	scenarios := map[string]bool{
+		"https://ntfy.sh/miniflux": true,
+		"http://ntfy.local/alerts": true,
+		"https://ntfy.sh/":         false,
+		"https://ntfy.sh":          false,
+		"ftp://ntfy.sh/miniflux":   false,
+		"miniflux":                 false,
+	}
+
+	for topicURL, valid := range scenarios {
+		if err := ValidateTopicURL(topicURL); (err == nil) != valid {
+			t.Errorf(`Unexpected validation result for %q: %v`, topicURL, err)
+		}
+	}
+}
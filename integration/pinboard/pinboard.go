@@ -7,10 +7,14 @@ package pinboard // import "miniflux.app/integration/pinboard"
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"miniflux.app/http/client"
 )
 
+// maxTags is the maximum number of tags accepted by Pinboard for a bookmark.
+const maxTags = 100
+
 // Client represents a Pinboard client.
 type Client struct {
 	authToken string
@@ -47,6 +51,32 @@ func (c *Client) AddBookmark(link, title, tags string, markAsUnread bool) error
 	return nil
 }
 
+// FormatTags returns the space separated list of tags expected by Pinboard.
+// Spaces and commas are not allowed in tags, they are replaced by underscores.
+func FormatTags(tags []string) string {
+	var results []string
+	seen := make(map[string]bool)
+
+	for _, tag := range tags {
+		tag = strings.Join(strings.FieldsFunc(tag, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t' || r == '\n'
+		}), "_")
+
+		if tag == "" || seen[strings.ToLower(tag)] {
+			continue
+		}
+
+		if len(results) == maxTags {
+			break
+		}
+
+		seen[strings.ToLower(tag)] = true
+		results = append(results, tag)
+	}
+
+	return strings.Join(results, " ")
+}
+
 // NewClient returns a new Pinboard client.
 func NewClient(authToken string) *Client {
 	return &Client{authToken: authToken}
@@ -0,0 +1,39 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package pinboard // import "miniflux.app/integration/pinboard"
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFormatTags(t *testing.T) {
+	scenarios := map[string][]string{
+		"":                         nil,
+		"go":                       {"go"},
+		"go web":                   {"go", "web", "Go"},
+		"Tech_News go_web":         {"Tech News", " ", "go,web"},
+		"reading_list later":       {"reading  list", "later", "later"},
+		"multi_line_category tags": {"multi\nline\tcategory", "tags"},
+	}
+
+	for expected, tags := range scenarios {
+		if result := FormatTags(tags); result != expected {
+			t.Errorf(`Unexpected tags for %v, got %q instead of %q`, tags, result, expected)
+		}
+	}
+}
+
+func TestFormatTagsLimit(t *testing.T) {
+	var tags []string
+	for i := 0; i < maxTags+10; i++ {
+		tags = append(tags, fmt.Sprintf("tag%d", i))
+	}
+
+	if count := len(strings.Fields(FormatTags(tags))); count != maxTags {
+		t.Errorf(`Unexpected number of tags, got %d instead of %d`, count, maxTags)
+	}
+}
@@ -5,19 +5,31 @@
 package pocket // import "miniflux.app/integration/pocket"
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"miniflux.app/http/client"
 )
 
+const defaultBaseURL = "https://getpocket.com"
+
+// Item represents a link to save into Pocket.
+type Item struct {
+	URL   string
+	Title string
+	Tags  []string
+}
+
 // Client represents a Pocket client.
 type Client struct {
+	baseURL     string
 	consumerKey string
 	accessToken string
 }
 
 // AddURL sends a single link to Pocket.
-func (c *Client) AddURL(link, title string) error {
+func (c *Client) AddURL(link, title string, tags []string) error {
 	if c.consumerKey == "" || c.accessToken == "" {
 		return fmt.Errorf("pocket: missing credentials")
 	}
@@ -27,6 +39,7 @@ func (c *Client) AddURL(link, title string) error {
 		ConsumerKey string `json:"consumer_key"`
 		Title       string `json:"title,omitempty"`
 		URL         string `json:"url"`
+		Tags        string `json:"tags,omitempty"`
 	}
 
 	data := &body{
@@ -34,9 +47,10 @@ func (c *Client) AddURL(link, title string) error {
 		ConsumerKey: c.consumerKey,
 		Title:       title,
 		URL:         link,
+		Tags:        FormatTags(tags),
 	}
 
-	clt := client.New("https://getpocket.com/v3/add")
+	clt := client.New(c.baseURL + "/v3/add")
 	response, err := clt.PostJSON(data)
 	if err != nil {
 		return fmt.Errorf("pocket: unable to send url: %v", err)
@@ -49,7 +63,98 @@ func (c *Client) AddURL(link, title string) error {
 	return nil
 }
 
+// AddURLs sends several links to Pocket in a single request.
+// The batch endpoint requires the "Modify" permission on the consumer key.
+func (c *Client) AddURLs(items []*Item) error {
+	if c.consumerKey == "" || c.accessToken == "" {
+		return fmt.Errorf("pocket: missing credentials")
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	type action struct {
+		Action string `json:"action"`
+		URL    string `json:"url"`
+		Title  string `json:"title,omitempty"`
+		Tags   string `json:"tags,omitempty"`
+	}
+
+	type body struct {
+		AccessToken string    `json:"access_token"`
+		ConsumerKey string    `json:"consumer_key"`
+		Actions     []*action `json:"actions"`
+	}
+
+	data := &body{
+		AccessToken: c.accessToken,
+		ConsumerKey: c.consumerKey,
+	}
+
+	for _, item := range items {
+		data.Actions = append(data.Actions, &action{
+			Action: "add",
+			URL:    item.URL,
+			Title:  item.Title,
+			Tags:   FormatTags(item.Tags),
+		})
+	}
+
+	clt := client.New(c.baseURL + "/v3/send")
+	response, err := clt.PostJSON(data)
+	if err != nil {
+		return fmt.Errorf("pocket: unable to send urls: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("pocket: unable to send urls, status=%d", response.StatusCode)
+	}
+
+	var result struct {
+		Status        int           `json:"status"`
+		ActionResults []interface{} `json:"action_results"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return fmt.Errorf("pocket: unable to decode send response: %v", err)
+	}
+
+	if result.Status != 1 {
+		return fmt.Errorf("pocket: unable to send urls, unexpected status %d", result.Status)
+	}
+
+	failures := 0
+	for _, actionResult := range result.ActionResults {
+		if actionResult == false {
+			failures++
+		}
+	}
+
+	if failures > 0 {
+		return fmt.Errorf("pocket: %d of %d urls have not been saved", failures, len(items))
+	}
+
+	return nil
+}
+
+// FormatTags returns the comma separated list expected by Pocket, commas are not allowed in tag names.
+func FormatTags(tags []string) string {
+	var results []string
+	seen := make(map[string]bool)
+
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(strings.Replace(tag, ",", " ", -1)), " ")
+		if tag != "" && !seen[strings.ToLower(tag)] {
+			seen[strings.ToLower(tag)] = true
+			results = append(results, tag)
+		}
+	}
+
+	return strings.Join(results, ",")
+}
+
 // NewClient returns a new Pocket client.
 func NewClient(consumerKey, accessToken string) *Client {
-	return &Client{consumerKey, accessToken}
+	return &Client{baseURL: defaultBaseURL, consumerKey: consumerKey, accessToken: accessToken}
 }
@@ -0,0 +1,80 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package pocket // import "miniflux.app/integration/pocket"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFormatTags(t *testing.T) {
+	scenarios := map[string][]string{
+		"":                  nil,
+		"Go,news":           {"Go", " news ", "News", "go"},
+		"Science Fiction":   {"Science  Fiction"},
+		"Tips Tricks,Linux": {"Tips,Tricks", "", "Linux"},
+	}
+
+	for expected, tags := range scenarios {
+		if result := FormatTags(tags); result != expected {
+			t.Errorf(`Unexpected tags for %v, got %q instead of %q`, tags, result, expected)
+		}
+	}
+}
+
+func TestAddURLs(t *testing.T) {
+	var payload struct {
+		Actions []map[string]string `json:"actions"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/send" {
+			t.Errorf(`Unexpected request to %s`, r.URL.Path)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+
+		w.Write([]byte(`{"status": 1, "action_results": [{"item_id": "1"}, {"item_id": "2"}]}`))
+	}))
+	defer server.Close()
+
+	clt := NewClient("key", "token")
+	clt.baseURL = server.URL
+
+	err := clt.AddURLs([]*Item{
+		{URL: "https://example.org/1", Title: "First", Tags: []string{"News"}},
+		{URL: "https://example.org/2", Title: "Second"},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(payload.Actions) != 2 {
+		t.Fatalf(`Unexpected number of actions: %d`, len(payload.Actions))
+	}
+
+	if payload.Actions[0]["action"] != "add" || payload.Actions[0]["url"] != "https://example.org/1" || payload.Actions[0]["tags"] != "News" {
+		t.Errorf(`Unexpected action: %v`, payload.Actions[0])
+	}
+}
+
+func TestAddURLsWithFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status": 1, "action_results": [{"item_id": "1"}, false]}`))
+	}))
+	defer server.Close()
+
+	clt := NewClient("key", "token")
+	clt.baseURL = server.URL
+
+	if err := clt.AddURLs([]*Item{{URL: "https://example.org/1"}, {URL: "invalid"}}); err == nil {
+		t.Error(`Partial failures should be reported`)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rssbridge // import "miniflux.app/integration/rssbridge"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"miniflux.app/http/client"
+)
+
+// Bridge represents a feed generated by RSS-Bridge.
+type Bridge struct {
+	URL        string     `json:"url"`
+	BridgeMeta BridgeMeta `json:"bridgeMeta"`
+}
+
+// BridgeMeta contains the description of the bridge used to generate the feed.
+type BridgeMeta struct {
+	Name string `json:"name"`
+}
+
+// DetectBridges returns the feeds that RSS-Bridge is able to generate for the given website.
+func DetectBridges(rssBridgeURL, websiteURL string) ([]*Bridge, error) {
+	u, err := url.Parse(rssBridgeURL)
+	if err != nil {
+		return nil, fmt.Errorf("rss-bridge: invalid endpoint: %v", err)
+	}
+
+	values := u.Query()
+	values.Set("action", "findfeed")
+	values.Set("format", "atom")
+	values.Set("url", websiteURL)
+	u.RawQuery = values.Encode()
+
+	// The RSS-Bridge instance is configured by the user, the network policy of the feeds doesn't apply.
+	clt := client.New(u.String())
+	response, err := clt.Get()
+	if err != nil {
+		return nil, fmt.Errorf("rss-bridge: unable to detect bridges: %v", err)
+	}
+
+	// RSS-Bridge returns a 404 when no bridge matches the website.
+	if response.StatusCode == 404 {
+		return nil, nil
+	}
+
+	if response.HasServerFailure() {
+		return nil, fmt.Errorf("rss-bridge: unable to detect bridges, status=%d", response.StatusCode)
+	}
+
+	var bridges []*Bridge
+	if err := json.NewDecoder(response.Body).Decode(&bridges); err != nil {
+		return nil, fmt.Errorf("rss-bridge: unable to decode bridge response: %v", err)
+	}
+
+	return bridges, nil
+}
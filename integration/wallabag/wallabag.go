@@ -9,10 +9,23 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 
+	"miniflux.app/crypto"
 	"miniflux.app/http/client"
 )
 
+// tokenExpirationMargin avoids using a token that expires while the request is in flight.
+const tokenExpirationMargin = time.Minute
+
+// tokenIdleTTL is how long the tokens of an account are kept without being used, the credentials may have been changed or removed since.
+const tokenIdleTTL = 24 * time.Hour
+
+// tokens keeps the access tokens of each account to avoid authenticating for every saved entry.
+var tokens = &tokenCache{tokens: make(map[string]*cachedToken)}
+
 // Client represents a Wallabag client.
 type Client struct {
 	baseURL      string
@@ -23,7 +36,8 @@ type Client struct {
 }
 
 // AddEntry sends a link to Wallabag.
-func (c *Client) AddEntry(link, title string) error {
+// The origin URL is the website where the article was found, tags are created when they do not exist.
+func (c *Client) AddEntry(link, title, originURL string, tags []string, archive bool) error {
 	if c.baseURL == "" || c.clientID == "" || c.clientSecret == "" || c.username == "" || c.password == "" {
 		return fmt.Errorf("wallabag: missing credentials")
 	}
@@ -33,20 +47,30 @@ func (c *Client) AddEntry(link, title string) error {
 		return err
 	}
 
-	return c.createEntry(accessToken, link, title)
-}
+	payload := map[string]interface{}{
+		"url":        link,
+		"title":      title,
+		"origin_url": originURL,
+		"tags":       strings.Join(tags, ","),
+		"archive":    boolToInt(archive),
+	}
 
-func (c *Client) createEntry(accessToken, link, title string) error {
-	endpoint, err := getAPIEndpoint(c.baseURL, "/api/entries.json")
+	response, err := c.createEntry(accessToken, payload)
 	if err != nil {
-		return fmt.Errorf("wallbag: unable to get entries endpoint: %v", err)
+		return err
 	}
 
-	clt := client.New(endpoint)
-	clt.WithAuthorization("Bearer " + accessToken)
-	response, err := clt.PostJSON(map[string]string{"url": link, "title": title})
-	if err != nil {
-		return fmt.Errorf("wallabag: unable to post entry: %v", err)
+	// The token may have been revoked on the server side before its expiration.
+	if response.IsNotAuthorized() {
+		tokens.remove(c.cacheKey())
+
+		if accessToken, err = c.getAccessToken(); err != nil {
+			return err
+		}
+
+		if response, err = c.createEntry(accessToken, payload); err != nil {
+			return err
+		}
 	}
 
 	if response.HasServerFailure() {
@@ -56,7 +80,44 @@ func (c *Client) createEntry(accessToken, link, title string) error {
 	return nil
 }
 
+func (c *Client) createEntry(accessToken string, payload map[string]interface{}) (*client.Response, error) {
+	endpoint, err := getAPIEndpoint(c.baseURL, "/api/entries.json")
+	if err != nil {
+		return nil, fmt.Errorf("wallbag: unable to get entries endpoint: %v", err)
+	}
+
+	clt := client.New(endpoint)
+	clt.WithAuthorization("Bearer " + accessToken)
+	response, err := clt.PostJSON(payload)
+	if err != nil {
+		return nil, fmt.Errorf("wallabag: unable to post entry: %v", err)
+	}
+
+	return response, nil
+}
+
+// getAccessToken returns the cached token if it is still valid, refreshes it if possible,
+// and authenticates with the username and password otherwise.
 func (c *Client) getAccessToken() (string, error) {
+	key := c.cacheKey()
+	cached := tokens.get(key)
+	if cached != nil && time.Now().Before(cached.expiresAt) {
+		return cached.accessToken, nil
+	}
+
+	if cached != nil && cached.refreshToken != "" {
+		values := url.Values{}
+		values.Add("grant_type", "refresh_token")
+		values.Add("client_id", c.clientID)
+		values.Add("client_secret", c.clientSecret)
+		values.Add("refresh_token", cached.refreshToken)
+
+		if token, err := c.requestToken(values); err == nil {
+			tokens.set(key, token)
+			return token.AccessToken, nil
+		}
+	}
+
 	values := url.Values{}
 	values.Add("grant_type", "password")
 	values.Add("client_id", c.clientID)
@@ -64,27 +125,38 @@ func (c *Client) getAccessToken() (string, error) {
 	values.Add("username", c.username)
 	values.Add("password", c.password)
 
+	token, err := c.requestToken(values)
+	if err != nil {
+		tokens.remove(key)
+		return "", err
+	}
+
+	tokens.set(key, token)
+	return token.AccessToken, nil
+}
+
+func (c *Client) requestToken(values url.Values) (*tokenResponse, error) {
 	endpoint, err := getAPIEndpoint(c.baseURL, "/oauth/v2/token")
 	if err != nil {
-		return "", fmt.Errorf("wallbag: unable to get token endpoint: %v", err)
+		return nil, fmt.Errorf("wallbag: unable to get token endpoint: %v", err)
 	}
 
 	clt := client.New(endpoint)
 	response, err := clt.PostForm(values)
 	if err != nil {
-		return "", fmt.Errorf("wallabag: unable to get access token: %v", err)
+		return nil, fmt.Errorf("wallabag: unable to get access token: %v", err)
 	}
 
 	if response.HasServerFailure() {
-		return "", fmt.Errorf("wallabag: request failed, status=%d", response.StatusCode)
+		return nil, fmt.Errorf("wallabag: request failed, status=%d", response.StatusCode)
 	}
 
-	token, err := decodeTokenResponse(response.Body)
-	if err != nil {
-		return "", err
-	}
+	return decodeTokenResponse(response.Body)
+}
 
-	return token.AccessToken, nil
+// cacheKey identifies the account, a change of the credentials invalidates the cached token.
+func (c *Client) cacheKey() string {
+	return crypto.Hash(strings.Join([]string{c.baseURL, c.clientID, c.clientSecret, c.username, c.password}, "\x00"))
 }
 
 // NewClient returns a new Wallabag client.
@@ -97,10 +169,17 @@ func getAPIEndpoint(baseURL, path string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("wallabag: invalid API endpoint: %v", err)
 	}
-	u.Path = path
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
 	return u.String(), nil
 }
 
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
+
 type tokenResponse struct {
 	AccessToken  string `json:"access_token"`
 	Expires      int    `json:"expires_in"`
@@ -117,5 +196,61 @@ func decodeTokenResponse(body io.Reader) (*tokenResponse, error) {
 		return nil, fmt.Errorf("wallabag: unable to decode token response: %v", err)
 	}
 
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("wallabag: empty access token")
+	}
+
 	return &token, nil
 }
+
+type cachedToken struct {
+	accessToken  string
+	refreshToken string
+	expiresAt    time.Time
+	lastUsedAt   time.Time
+}
+
+type tokenCache struct {
+	sync.Mutex
+	tokens map[string]*cachedToken
+}
+
+func (t *tokenCache) get(key string) *cachedToken {
+	t.Lock()
+	defer t.Unlock()
+
+	now := time.Now()
+	t.removeIdleTokens(now)
+
+	cached := t.tokens[key]
+	if cached != nil {
+		cached.lastUsedAt = now
+	}
+	return cached
+}
+
+func (t *tokenCache) set(key string, token *tokenResponse) {
+	t.Lock()
+	defer t.Unlock()
+	t.tokens[key] = &cachedToken{
+		accessToken:  token.AccessToken,
+		refreshToken: token.RefreshToken,
+		expiresAt:    time.Now().Add(time.Duration(token.Expires)*time.Second - tokenExpirationMargin),
+		lastUsedAt:   time.Now(),
+	}
+}
+
+func (t *tokenCache) remove(key string) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.tokens, key)
+}
+
+// removeIdleTokens must be called with the mutex locked.
+func (t *tokenCache) removeIdleTokens(now time.Time) {
+	for key, cached := range t.tokens {
+		if now.Sub(cached.lastUsedAt) > tokenIdleTTL {
+			delete(t.tokens, key)
+		}
+	}
+}
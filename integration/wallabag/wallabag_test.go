@@ -0,0 +1,94 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package wallabag // import "miniflux.app/integration/wallabag"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestServer(t *testing.T, tokenRequests, entryRequests *int32, rejectFirstEntry bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/v2/token":
+			n := atomic.AddInt32(tokenRequests, 1)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token":  fmt.Sprintf("token%d", n),
+				"expires_in":    3600,
+				"refresh_token": "refresh",
+			})
+		case "/api/entries.json":
+			n := atomic.AddInt32(entryRequests, 1)
+			if rejectFirstEntry && n == 1 {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Error(err)
+			}
+
+			if payload["tags"] != "a,b" || payload["archive"] != float64(1) || payload["origin_url"] != "https://example.org/" {
+				t.Errorf(`Unexpected payload: %v`, payload)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestAddEntryReusesAccessToken(t *testing.T) {
+	var tokenRequests, entryRequests int32
+	server := newTestServer(t, &tokenRequests, &entryRequests, false)
+	defer server.Close()
+
+	client := NewClient(server.URL, "id", "secret", "user", "password")
+	for i := 0; i < 2; i++ {
+		if err := client.AddEntry("https://example.org/article", "Title", "https://example.org/", []string{"a", "b"}, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if tokenRequests != 1 || entryRequests != 2 {
+		t.Errorf(`Unexpected number of requests, got %d token and %d entry requests`, tokenRequests, entryRequests)
+	}
+}
+
+func TestAddEntryAuthenticatesAgainWhenTokenIsRejected(t *testing.T) {
+	var tokenRequests, entryRequests int32
+	server := newTestServer(t, &tokenRequests, &entryRequests, true)
+	defer server.Close()
+
+	client := NewClient(server.URL, "id", "secret", "user", "password")
+	if err := client.AddEntry("https://example.org/article", "Title", "https://example.org/", []string{"a", "b"}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if tokenRequests != 2 || entryRequests != 2 {
+		t.Errorf(`Unexpected number of requests, got %d token and %d entry requests`, tokenRequests, entryRequests)
+	}
+}
+
+func TestTokenCacheRemovesIdleTokens(t *testing.T) {
+	cache := &tokenCache{tokens: map[string]*cachedToken{
+		"idle":   {accessToken: "a", lastUsedAt: time.Now().Add(-tokenIdleTTL - time.Minute)},
+		"active": {accessToken: "b", lastUsedAt: time.Now()},
+	}}
+
+	if cache.get("active") == nil {
+		t.Fatal(`The active token should be kept`)
+	}
+
+	if len(cache.tokens) != 1 || cache.get("idle") != nil {
+		t.Error(`The idle token should be removed`)
+	}
+}
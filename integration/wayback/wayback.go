@@ -0,0 +1,52 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package wayback // import "miniflux.app/integration/wayback"
+
+import (
+	"fmt"
+	"regexp"
+
+	"miniflux.app/http/client"
+)
+
+const (
+	defaultSaveURL = "https://web.archive.org/save/"
+
+	// Capturing a page usually takes a few seconds but can be much longer for heavy pages.
+	saveTimeout = 120
+)
+
+var snapshotRegex = regexp.MustCompile(`^https?://[^/]+/web/\d{14}/`)
+
+// Client represents a Save Page Now client.
+type Client struct {
+	saveURL string
+}
+
+// Save asks the Internet Archive to capture the page and returns the link to the snapshot.
+func (c *Client) Save(link string) (string, error) {
+	clt := client.New(c.saveURL + link)
+	clt.WithTimeout(saveTimeout)
+	response, err := clt.Get()
+	if err != nil {
+		return "", fmt.Errorf("wayback: unable to save %q: %v", link, err)
+	}
+
+	if response.StatusCode >= 400 {
+		return "", fmt.Errorf("wayback: unable to save %q, status=%d", link, response.StatusCode)
+	}
+
+	// The service redirects to the snapshot once the capture is done.
+	if !snapshotRegex.MatchString(response.EffectiveURL) {
+		return "", fmt.Errorf("wayback: no snapshot found for %q", link)
+	}
+
+	return response.EffectiveURL, nil
+}
+
+// NewClient returns a new Save Page Now client.
+func NewClient() *Client {
+	return &Client{saveURL: defaultSaveURL}
+}
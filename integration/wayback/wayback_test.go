@@ -0,0 +1,48 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package wayback // import "miniflux.app/integration/wayback"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSave(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/save/https://example.org/article":
+			w.Header().Set("Location", "/web/20200504103000/https://example.org/article")
+			w.WriteHeader(http.StatusFound)
+		case "/web/20200504103000/https://example.org/article":
+			w.Write([]byte("snapshot"))
+		default:
+			t.Errorf(`Unexpected request to %s`, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	clt := &Client{saveURL: server.URL + "/save/"}
+	snapshot, err := clt.Save("https://example.org/article")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if snapshot != server.URL+"/web/20200504103000/https://example.org/article" {
+		t.Errorf(`Unexpected snapshot link: %q`, snapshot)
+	}
+}
+
+func TestSaveWithoutSnapshot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("Sorry, this URL is excluded"))
+	}))
+	defer server.Close()
+
+	clt := &Client{saveURL: server.URL + "/save/"}
+	if _, err := clt.Save("https://example.org/"); err == nil {
+		t.Error(`A missing snapshot should be reported`)
+	}
+}
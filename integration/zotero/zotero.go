@@ -0,0 +1,172 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package zotero // import "miniflux.app/integration/zotero"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"miniflux.app/http/client"
+)
+
+const defaultBaseURL = "https://api.zotero.org"
+
+// Library types supported by the Zotero API.
+const (
+	LibraryTypeUser  = "user"
+	LibraryTypeGroup = "group"
+)
+
+var doiRegex = regexp.MustCompile(`\b10\.\d{4,9}/[^\s"'<>&]+`)
+
+// Item holds the metadata of an entry saved to Zotero.
+type Item struct {
+	URL         string
+	Title       string
+	Author      string
+	Abstract    string
+	Publication string
+	DOI         string
+	Tags        []string
+	Date        time.Time
+}
+
+// Client represents a Zotero client.
+type Client struct {
+	baseURL       string
+	libraryType   string
+	libraryID     string
+	apiKey        string
+	collectionKey string
+}
+
+// AddItem creates the item in the library, inside the collection when one is configured.
+// Entries with a DOI are saved as journal articles, the other ones as web pages.
+func (c *Client) AddItem(item *Item) error {
+	if c.libraryID == "" || c.apiKey == "" {
+		return fmt.Errorf("zotero: missing credentials")
+	}
+
+	data := map[string]interface{}{
+		"title":        item.Title,
+		"url":          item.URL,
+		"abstractNote": item.Abstract,
+		"accessDate":   time.Now().UTC().Format(time.RFC3339),
+		"creators":     []map[string]string{},
+		"tags":         []map[string]string{},
+		"collections":  []string{},
+	}
+
+	if item.DOI != "" {
+		data["itemType"] = "journalArticle"
+		data["DOI"] = item.DOI
+		data["publicationTitle"] = item.Publication
+	} else {
+		data["itemType"] = "webpage"
+		data["websiteTitle"] = item.Publication
+	}
+
+	if !item.Date.IsZero() {
+		data["date"] = item.Date.Format("2006-01-02")
+	}
+
+	if item.Author != "" {
+		data["creators"] = []map[string]string{{"creatorType": "author", "name": item.Author}}
+	}
+
+	var tags []map[string]string
+	for _, tag := range item.Tags {
+		tags = append(tags, map[string]string{"tag": tag})
+	}
+	if len(tags) > 0 {
+		data["tags"] = tags
+	}
+
+	if c.collectionKey != "" {
+		data["collections"] = []string{c.collectionKey}
+	}
+
+	apiURL := fmt.Sprintf("%s/%ss/%s/items", c.baseURL, c.libraryType, url.PathEscape(c.libraryID))
+	clt := client.New(apiURL)
+	clt.WithHeader("Zotero-API-Key", c.apiKey)
+	clt.WithHeader("Zotero-API-Version", "3")
+	response, err := clt.PostJSON([]interface{}{data})
+	if err != nil {
+		return fmt.Errorf("zotero: unable to send item: %v", err)
+	}
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("zotero: unable to send item, status=%d", response.StatusCode)
+	}
+
+	// The API answers with a status 200 even when the item is rejected.
+	var result struct {
+		Failed map[string]struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"failed"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return fmt.Errorf("zotero: unable to decode response: %v", err)
+	}
+
+	for _, failure := range result.Failed {
+		return fmt.Errorf("zotero: item rejected, code=%d: %s", failure.Code, failure.Message)
+	}
+
+	return nil
+}
+
+// Validate returns an error if the library settings cannot be used.
+func Validate(libraryType, libraryID, apiKey string) error {
+	if libraryType != LibraryTypeUser && libraryType != LibraryTypeGroup {
+		return fmt.Errorf("unsupported library type %q", libraryType)
+	}
+
+	if libraryID == "" || strings.Trim(libraryID, "0123456789") != "" {
+		return fmt.Errorf("the library ID must be a number")
+	}
+
+	if apiKey == "" {
+		return fmt.Errorf("the API key is mandatory")
+	}
+
+	return nil
+}
+
+// FindDOI returns the first Digital Object Identifier found in the given links or texts.
+func FindDOI(values ...string) string {
+	for _, value := range values {
+		if unescaped, err := url.PathUnescape(value); err == nil {
+			value = unescaped
+		}
+
+		if doi := doiRegex.FindString(value); doi != "" {
+			return strings.TrimRight(doi, ".,;:)]")
+		}
+	}
+
+	return ""
+}
+
+// NewClient returns a new Zotero client, the user library is used by default.
+func NewClient(libraryType, libraryID, apiKey, collectionKey string) *Client {
+	if libraryType == "" {
+		libraryType = LibraryTypeUser
+	}
+
+	return &Client{
+		baseURL:       defaultBaseURL,
+		libraryType:   libraryType,
+		libraryID:     libraryID,
+		apiKey:        apiKey,
+		collectionKey: collectionKey,
+	}
+}
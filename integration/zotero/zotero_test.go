@@ -0,0 +1,133 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package zotero // import "miniflux.app/integration/zotero"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFindDOI(t *testing.T) {
+	scenarios := map[string][]string{
+		"10.1038/s41586-020-2649-2":  {"https://www.nature.com/articles/s41586-020-2649-2", `<p>See <a href="https://doi.org/10.1038/s41586-020-2649-2">the paper</a>.</p>`},
+		"10.1000/xyz123":             {"https://example.org/doi/10.1000%2Fxyz123"},
+		"10.1016/j.cell.2020.01.001": {"(doi:10.1016/j.cell.2020.01.001)."},
+		"":                           {"https://example.org/article/10.5/", "no identifier"},
+	}
+
+	for expected, values := range scenarios {
+		if result := FindDOI(values...); result != expected {
+			t.Errorf(`Unexpected DOI for %v, got %q instead of %q`, values, result, expected)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate(LibraryTypeGroup, "12345", "key"); err != nil {
+		t.Errorf(`The settings should be valid: %v`, err)
+	}
+
+	scenarios := map[string][]string{
+		"unknown library type": {"team", "12345", "key"},
+		"invalid library ID":   {LibraryTypeUser, "me", "key"},
+		"missing API key":      {LibraryTypeUser, "12345", ""},
+	}
+
+	for name, args := range scenarios {
+		if err := Validate(args[0], args[1], args[2]); err == nil {
+			t.Errorf(`The settings with %s should be invalid`, name)
+		}
+	}
+}
+
+func TestAddJournalArticle(t *testing.T) {
+	var payload []map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/42/items" || r.Header.Get("Zotero-API-Key") != "secret" {
+			t.Errorf(`Unexpected request: %s %v`, r.URL.Path, r.Header)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+
+		w.Write([]byte(`{"successful": {"0": {"key": "ABCD2345"}}, "failed": {}}`))
+	}))
+	defer server.Close()
+
+	clt := NewClient(LibraryTypeGroup, "42", "secret", "COLL1234")
+	clt.baseURL = server.URL
+
+	err := clt.AddItem(&Item{
+		URL:         "https://example.org/article",
+		Title:       "Article",
+		Author:      "Jane Doe",
+		Publication: "Journal",
+		DOI:         "10.1000/xyz123",
+		Tags:        []string{"biology"},
+		Date:        time.Date(2020, time.May, 4, 12, 0, 0, 0, time.UTC),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(payload) != 1 {
+		t.Fatalf(`Unexpected payload: %v`, payload)
+	}
+
+	item := payload[0]
+	if item["itemType"] != "journalArticle" || item["DOI"] != "10.1000/xyz123" || item["publicationTitle"] != "Journal" || item["date"] != "2020-05-04" {
+		t.Errorf(`Unexpected item: %v`, item)
+	}
+
+	if collections := item["collections"].([]interface{}); len(collections) != 1 || collections[0] != "COLL1234" {
+		t.Errorf(`Unexpected collections: %v`, collections)
+	}
+}
+
+func TestAddWebPage(t *testing.T) {
+	var payload []map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"successful": {"0": {"key": "ABCD2345"}}, "failed": {}}`))
+	}))
+	defer server.Close()
+
+	clt := NewClient("", "1", "secret", "")
+	clt.baseURL = server.URL
+
+	if err := clt.AddItem(&Item{URL: "https://example.org/post", Title: "Post", Publication: "Blog"}); err != nil {
+		t.Fatal(err)
+	}
+
+	item := payload[0]
+	if item["itemType"] != "webpage" || item["websiteTitle"] != "Blog" {
+		t.Errorf(`Unexpected item: %v`, item)
+	}
+
+	if _, found := item["DOI"]; found {
+		t.Error(`Web pages do not have a DOI field`)
+	}
+}
+
+func TestAddRejectedItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"successful": {}, "failed": {"0": {"code": 400, "message": "Invalid field"}}}`))
+	}))
+	defer server.Close()
+
+	clt := NewClient(LibraryTypeUser, "1", "secret", "")
+	clt.baseURL = server.URL
+
+	if err := clt.AddItem(&Item{URL: "https://example.org/"}); err == nil {
+		t.Error(`Rejected items should be reported`)
+	}
+}
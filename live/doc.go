@@ -0,0 +1,10 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package live broadcasts events to the web browsers of a user to keep the open pages up to date.
+
+*/
+package live // import "miniflux.app/live"
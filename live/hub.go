@@ -0,0 +1,81 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package live // import "miniflux.app/live"
+
+import "sync"
+
+// Events are dropped when a subscriber doesn't read them fast enough.
+const subscriberBufferSize = 32
+
+// Event types.
+const (
+	EventCountersChanged = "counters"
+	EventFeedRefreshed   = "feed_refreshed"
+	EventEntryShared     = "entry_shared"
+	EventEntryUnshared   = "entry_unshared"
+)
+
+// Event is sent to the subscribers of a user.
+type Event struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data,omitempty"`
+}
+
+// Hub dispatches events to the subscribers of each user.
+// Events are only delivered to the subscribers connected to the same instance.
+type Hub struct {
+	sync.Mutex
+	subscribers map[int64]map[chan Event]bool
+}
+
+// NewHub returns a new Hub.
+func NewHub() *Hub {
+	return &Hub{subscribers: make(map[int64]map[chan Event]bool)}
+}
+
+// Subscribe returns a channel receiving the events of the given user.
+func (h *Hub) Subscribe(userID int64) chan Event {
+	h.Lock()
+	defer h.Unlock()
+
+	events := make(chan Event, subscriberBufferSize)
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[chan Event]bool)
+	}
+	h.subscribers[userID][events] = true
+
+	return events
+}
+
+// Unsubscribe stops sending events to the given channel.
+func (h *Hub) Unsubscribe(userID int64, events chan Event) {
+	h.Lock()
+	defer h.Unlock()
+
+	delete(h.subscribers[userID], events)
+	if len(h.subscribers[userID]) == 0 {
+		delete(h.subscribers, userID)
+	}
+}
+
+// HasSubscribers returns true if the user has at least one open page.
+func (h *Hub) HasSubscribers(userID int64) bool {
+	h.Lock()
+	defer h.Unlock()
+	return len(h.subscribers[userID]) > 0
+}
+
+// Publish sends an event to all subscribers of the user without blocking.
+func (h *Hub) Publish(userID int64, eventType string, data interface{}) {
+	h.Lock()
+	defer h.Unlock()
+
+	for events := range h.subscribers[userID] {
+		select {
+		case events <- Event{Type: eventType, Data: data}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package live // import "miniflux.app/live"
+
+import "testing"
+
+func TestPublishToSubscribers(t *testing.T) {
+	hub := NewHub()
+	first := hub.Subscribe(1)
+	second := hub.Subscribe(1)
+	other := hub.Subscribe(2)
+
+	hub.Publish(1, EventFeedRefreshed, int64(42))
+
+	for _, events := range []chan Event{first, second} {
+		select {
+		case event := <-events:
+			if event.Type != EventFeedRefreshed || event.Data != int64(42) {
+				t.Errorf(`Unexpected event: %+v`, event)
+			}
+		default:
+			t.Error(`The event should be delivered to every subscriber of the user`)
+		}
+	}
+
+	select {
+	case event := <-other:
+		t.Errorf(`The event should not be delivered to another user: %+v`, event)
+	default:
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	hub := NewHub()
+	events := hub.Subscribe(1)
+
+	if !hub.HasSubscribers(1) {
+		t.Fatal(`The user should have a subscriber`)
+	}
+
+	hub.Unsubscribe(1, events)
+	hub.Publish(1, EventCountersChanged, nil)
+
+	if hub.HasSubscribers(1) {
+		t.Fatal(`The user should not have any subscriber`)
+	}
+
+	if len(events) != 0 {
+		t.Error(`No event should be delivered after unsubscribing`)
+	}
+}
+
+func TestPublishDoesNotBlock(t *testing.T) {
+	hub := NewHub()
+	events := hub.Subscribe(1)
+
+	for i := 0; i < subscriberBufferSize*2; i++ {
+		hub.Publish(1, EventCountersChanged, nil)
+	}
+
+	if len(events) != subscriberBufferSize {
+		t.Errorf(`Unexpected number of buffered events, got %d`, len(events))
+	}
+}
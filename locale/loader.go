@@ -0,0 +1,120 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package locale // import "miniflux.app/locale"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// The description of the language is stored with the messages under this key.
+const metadataKey = "_language"
+
+var languageCodeRegex = regexp.MustCompile(`^[a-z]{2,3}(_[A-Z]{2})?$`)
+
+type languageMetadata struct {
+	Name        string `json:"name"`
+	Direction   string `json:"direction"`
+	PluralForms string `json:"plural_forms"`
+}
+
+// LoadTranslations adds the translation files of the directory to the catalog, each file is named after
+// the language code, like "he_IL.json". A file for a built-in language replaces only the messages it contains.
+func LoadTranslations(directory string) error {
+	filenames, err := filepath.Glob(filepath.Join(directory, "*.json"))
+	if err != nil {
+		return fmt.Errorf("locale: unable to list translation files: %v", err)
+	}
+
+	for _, filename := range filenames {
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("locale: unable to read translation file: %v", err)
+		}
+
+		language := strings.TrimSuffix(filepath.Base(filename), ".json")
+		if err := registerTranslations(language, data); err != nil {
+			return fmt.Errorf("locale: unable to load %q: %v", filename, err)
+		}
+	}
+
+	return nil
+}
+
+func registerTranslations(language string, data []byte) error {
+	if !languageCodeRegex.MatchString(language) {
+		return fmt.Errorf("invalid language code %q", language)
+	}
+
+	messages, metadata, err := parseTranslationFile(data)
+	if err != nil {
+		return err
+	}
+
+	var pluralForm pluralFormFunc
+	if metadata.PluralForms != "" {
+		if pluralForm, err = parsePluralForms(metadata.PluralForms); err != nil {
+			return err
+		}
+	}
+
+	switch metadata.Direction {
+	case "", "ltr", "rtl":
+	default:
+		return fmt.Errorf("invalid text direction %q", metadata.Direction)
+	}
+
+	catalog, found := defaultCatalog[language]
+	if !found {
+		// The messages not translated yet are displayed in English.
+		catalog = make(translationDict)
+		for key, value := range defaultCatalog["en_US"] {
+			catalog[key] = value
+		}
+		defaultCatalog[language] = catalog
+	}
+
+	for key, value := range messages {
+		catalog[key] = value
+	}
+
+	if metadata.Name != "" {
+		availableLanguages[language] = metadata.Name
+	} else if _, found := availableLanguages[language]; !found {
+		availableLanguages[language] = language
+	}
+
+	if metadata.Direction != "" {
+		rightToLeftLanguages[language] = metadata.Direction == "rtl"
+	}
+
+	if pluralForm != nil {
+		pluralForms[language] = pluralForm
+	}
+
+	return nil
+}
+
+func parseTranslationFile(data []byte) (translationDict, *languageMetadata, error) {
+	messages, err := parseTranslationDict(string(data))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metadata := &languageMetadata{}
+	if value, found := messages[metadataKey]; found {
+		encoded, _ := json.Marshal(value)
+		if err := json.Unmarshal(encoded, metadata); err != nil {
+			return nil, nil, fmt.Errorf("invalid language description: %v", err)
+		}
+		delete(messages, metadataKey)
+	}
+
+	return messages, metadata, nil
+}
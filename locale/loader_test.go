@@ -0,0 +1,99 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package locale // import "miniflux.app/locale"
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadTranslations(t *testing.T) {
+	directory, err := ioutil.TempDir("", "translations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	data := `{
+		"_language": {"name": "עברית", "direction": "rtl", "plural_forms": "nplurals=3; plural=(n == 1 ? 0 : n == 2 ? 1 : 2);"},
+		"menu.unread": "לא נקראו",
+		"plural.feed.error_count": ["שגיאה %d", "%d שגיאות", "%d שגיאות"]
+	}`
+	if err := ioutil.WriteFile(filepath.Join(directory, "he_IL.json"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(directory, "fr_FR.json"), []byte(`{"menu.unread": "À lire"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	frenchMessages := make(translationDict)
+	for key, value := range defaultCatalog["fr_FR"] {
+		frenchMessages[key] = value
+	}
+
+	defer func() {
+		delete(defaultCatalog, "he_IL")
+		delete(availableLanguages, "he_IL")
+		delete(rightToLeftLanguages, "he_IL")
+		delete(pluralForms, "he_IL")
+		defaultCatalog["fr_FR"] = frenchMessages
+	}()
+
+	if err := LoadTranslations(directory); err != nil {
+		t.Fatal(err)
+	}
+
+	if name := AvailableLanguages()["he_IL"]; name != "עברית" {
+		t.Errorf(`Unexpected language name: %q`, name)
+	}
+
+	if direction := Direction("he_IL"); direction != "rtl" {
+		t.Errorf(`Unexpected text direction: %q`, direction)
+	}
+
+	printer := NewPrinter("he_IL")
+	if message := printer.Printf("menu.unread"); message != "לא נקראו" {
+		t.Errorf(`Unexpected translation: %q`, message)
+	}
+
+	if message := printer.Printf("menu.starred"); message != NewPrinter("en_US").Printf("menu.starred") {
+		t.Errorf(`Missing translations should be displayed in English, got %q`, message)
+	}
+
+	if message := printer.Plural("plural.feed.error_count", 2, 2); message != "2 שגיאות" {
+		t.Errorf(`Unexpected plural translation: %q`, message)
+	}
+
+	french := NewPrinter("fr_FR")
+	if message := french.Printf("menu.unread"); message != "À lire" {
+		t.Errorf(`The built-in translation should be replaced, got %q`, message)
+	}
+
+	if message := french.Printf("menu.starred"); message != frenchMessages["menu.starred"] {
+		t.Errorf(`The other built-in translations should be kept, got %q`, message)
+	}
+}
+
+func TestRegisterInvalidTranslations(t *testing.T) {
+	scenarios := map[string]string{
+		"../en_US": `{}`,
+		"xx_XX":    `{"_language": {"direction": "up"}}`,
+		"xx_YY":    `{"_language": {"plural_forms": "plural=(n"}}`,
+		"xx_ZZ":    `{`,
+	}
+
+	for language, data := range scenarios {
+		if err := registerTranslations(language, []byte(data)); err == nil {
+			t.Errorf(`An error should be returned for %q`, language)
+		}
+
+		if _, found := defaultCatalog[language]; found {
+			t.Errorf(`The invalid language %q should not be registered`, language)
+		}
+	}
+}
@@ -4,19 +4,55 @@
 
 package locale // import "miniflux.app/locale"
 
+import "strings"
+
+var availableLanguages = map[string]string{
+	"en_US": "English",
+	"es_ES": "Español",
+	"fr_FR": "Français",
+	"de_DE": "Deutsch",
+	"pl_PL": "Polski",
+	"pt_BR": "Português Brasileiro",
+	"zh_CN": "简体中文",
+	"nl_NL": "Nederlands",
+	"ru_RU": "Русский",
+	"it_IT": "Italiano",
+	"ja_JP": "日本語",
+}
+
+// Languages written from right to left, translation files can declare other ones.
+var rightToLeftLanguages = map[string]bool{
+	"ar":  true,
+	"ckb": true,
+	"fa":  true,
+	"he":  true,
+	"ur":  true,
+	"yi":  true,
+}
+
 // AvailableLanguages returns the list of available languages.
 func AvailableLanguages() map[string]string {
-	return map[string]string{
-		"en_US": "English",
-		"es_ES": "Español",
-		"fr_FR": "Français",
-		"de_DE": "Deutsch",
-		"pl_PL": "Polski",
-		"pt_BR": "Português Brasileiro",
-		"zh_CN": "简体中文",
-		"nl_NL": "Nederlands",
-		"ru_RU": "Русский",
-		"it_IT": "Italiano",
-		"ja_JP": "日本語",
+	languages := make(map[string]string, len(availableLanguages))
+	for code, name := range availableLanguages {
+		languages[code] = name
+	}
+	return languages
+}
+
+// Direction returns "rtl" for languages written from right to left and "ltr" otherwise.
+func Direction(language string) string {
+	rightToLeft, found := rightToLeftLanguages[language]
+	if !found {
+		rightToLeft = rightToLeftLanguages[strings.SplitN(language, "_", 2)[0]]
+	}
+
+	if rightToLeft {
+		return "rtl"
 	}
+	return "ltr"
+}
+
+// HTMLLanguage returns the language code in the format of the HTML lang attribute, like "pt-BR".
+func HTMLLanguage(language string) string {
+	return strings.Replace(language, "_", "-", -1)
 }
@@ -22,3 +22,25 @@ func TestAvailableLanguages(t *testing.T) {
 		t.Errorf(`We must have at least the default language (en_US)`)
 	}
 }
+
+func TestDirection(t *testing.T) {
+	scenarios := map[string]string{
+		"en_US": "ltr",
+		"fr_FR": "ltr",
+		"ar_AR": "rtl",
+		"fa":    "rtl",
+		"he_IL": "rtl",
+	}
+
+	for language, expected := range scenarios {
+		if result := Direction(language); result != expected {
+			t.Errorf(`Unexpected direction for %q, got %q instead of %q`, language, result, expected)
+		}
+	}
+}
+
+func TestHTMLLanguage(t *testing.T) {
+	if result := HTMLLanguage("pt_BR"); result != "pt-BR" {
+		t.Errorf(`Unexpected HTML language code: %q`, result)
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package locale // import "miniflux.app/locale"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parsePluralForms returns the plural function of a gettext "Plural-Forms" header,
+// for example "nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 ? 1 : 2);".
+func parsePluralForms(header string) (pluralFormFunc, error) {
+	expression := header
+	for _, part := range strings.Split(header, ";") {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, "plural=") {
+			expression = strings.TrimPrefix(part, "plural=")
+		}
+	}
+
+	tokens, err := tokenizePluralExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pluralParser{tokens: tokens}
+	node, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.position < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token %q in plural expression", p.tokens[p.position])
+	}
+
+	return pluralFormFunc(node), nil
+}
+
+func tokenizePluralExpression(expression string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(expression) && expression[j] >= '0' && expression[j] <= '9' {
+				j++
+			}
+			tokens = append(tokens, expression[i:j])
+			i = j
+		case i+1 < len(expression) && isPluralOperator(expression[i:i+2]):
+			tokens = append(tokens, expression[i:i+2])
+			i += 2
+		case strings.IndexByte("n?:()<>!%*/+-", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			return nil, fmt.Errorf("invalid character %q in plural expression", c)
+		}
+	}
+
+	return tokens, nil
+}
+
+type pluralNode func(n int) int
+
+type pluralParser struct {
+	tokens   []string
+	position int
+}
+
+func (p *pluralParser) peek() string {
+	if p.position < len(p.tokens) {
+		return p.tokens[p.position]
+	}
+	return ""
+}
+
+func (p *pluralParser) accept(tokens ...string) string {
+	current := p.peek()
+	for _, token := range tokens {
+		if current == token {
+			p.position++
+			return token
+		}
+	}
+	return ""
+}
+
+// parseTernary parses "condition ? a : b", the other levels follow the precedence of C operators.
+func (p *pluralParser) parseTernary() (pluralNode, error) {
+	condition, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.accept("?") == "" {
+		return condition, nil
+	}
+
+	whenTrue, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.accept(":") == "" {
+		return nil, fmt.Errorf("missing ':' in plural expression")
+	}
+
+	whenFalse, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(n int) int {
+		if condition(n) != 0 {
+			return whenTrue(n)
+		}
+		return whenFalse(n)
+	}, nil
+}
+
+var pluralOperators = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *pluralParser) parseBinary(level int) (pluralNode, error) {
+	if level == len(pluralOperators) {
+		return p.parseUnary()
+	}
+
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		operator := p.accept(pluralOperators[level]...)
+		if operator == "" {
+			return left, nil
+		}
+
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		left = applyPluralOperator(operator, left, right)
+	}
+}
+
+func (p *pluralParser) parseUnary() (pluralNode, error) {
+	if p.accept("!") != "" {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(n int) int { return boolToInt(operand(n) == 0) }, nil
+	}
+
+	if p.accept("(") != "" {
+		node, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.accept(")") == "" {
+			return nil, fmt.Errorf("missing ')' in plural expression")
+		}
+		return node, nil
+	}
+
+	token := p.peek()
+	if token == "n" {
+		p.position++
+		return func(n int) int { return n }, nil
+	}
+
+	value, err := strconv.Atoi(token)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected token %q in plural expression", token)
+	}
+
+	p.position++
+	return func(n int) int { return value }, nil
+}
+
+func applyPluralOperator(operator string, left, right pluralNode) pluralNode {
+	return func(n int) int {
+		a, b := left(n), right(n)
+		switch operator {
+		case "||":
+			return boolToInt(a != 0 || b != 0)
+		case "&&":
+			return boolToInt(a != 0 && b != 0)
+		case "==":
+			return boolToInt(a == b)
+		case "!=":
+			return boolToInt(a != b)
+		case "<":
+			return boolToInt(a < b)
+		case "<=":
+			return boolToInt(a <= b)
+		case ">":
+			return boolToInt(a > b)
+		case ">=":
+			return boolToInt(a >= b)
+		case "+":
+			return a + b
+		case "-":
+			return a - b
+		case "*":
+			return a * b
+		case "/", "%":
+			if b == 0 {
+				return 0
+			}
+			if operator == "/" {
+				return a / b
+			}
+			return a % b
+		}
+		return 0
+	}
+}
+
+func isPluralOperator(token string) bool {
+	for _, operators := range pluralOperators {
+		for _, operator := range operators {
+			if operator == token {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
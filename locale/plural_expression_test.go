@@ -0,0 +1,61 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package locale // import "miniflux.app/locale"
+
+import "testing"
+
+func TestParsePluralFormsMatchesBuiltinRules(t *testing.T) {
+	scenarios := map[string]string{
+		"default": "nplurals=2; plural=(n != 1);",
+		"ar_AR":   "nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n%100>=3 && n%100<=10 ? 3 : n%100>=11 ? 4 : 5);",
+		"cs_CZ":   "nplurals=3; plural=(n==1) ? 0 : (n>=2 && n<=4) ? 1 : 2;",
+		"pl_PL":   "nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+		"pt_BR":   "nplurals=2; plural=(n > 1);",
+		"ru_RU":   "nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+		"zh_CN":   "nplurals=1; plural=0;",
+	}
+
+	for language, header := range scenarios {
+		pluralForm, err := parsePluralForms(header)
+		if err != nil {
+			t.Fatalf(`Unable to parse the plural forms of %q: %v`, language, err)
+		}
+
+		for n := 0; n <= 250; n++ {
+			if result, expected := pluralForm(n), pluralForms[language](n); result != expected {
+				t.Fatalf(`Unexpected plural form for %q and n=%d, got %d instead of %d`, language, n, result, expected)
+			}
+		}
+	}
+}
+
+func TestParsePluralFormsWithOperators(t *testing.T) {
+	pluralForm, err := parsePluralForms("plural=!(n - 1) ? 0 : n * 2 / 4 + 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scenarios := map[int]int{1: 0, 2: 2, 4: 3, 10: 6}
+	for n, expected := range scenarios {
+		if result := pluralForm(n); result != expected {
+			t.Errorf(`Unexpected plural form for n=%d, got %d instead of %d`, n, result, expected)
+		}
+	}
+}
+
+func TestParseInvalidPluralForms(t *testing.T) {
+	for _, header := range []string{
+		"plural=(n != 1",
+		"plural=n ? 1",
+		"plural=n $ 2",
+		"plural=x",
+		"plural=n 1",
+		"plural=",
+	} {
+		if _, err := parsePluralForms(header); err == nil {
+			t.Errorf(`An error should be returned for %q`, header)
+		}
+	}
+}
@@ -53,7 +53,7 @@ func (p *Printer) Plural(key string, n int, args ...interface{}) string {
 		}
 
 		index := pluralForm(n)
-		if len(plurals) > index {
+		if index >= 0 && index < len(plurals) {
 			return fmt.Sprintf(plurals[index], args...)
 		}
 	}
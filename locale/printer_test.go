@@ -172,3 +172,22 @@ func TestTranslatePluralWithInvalidValues(t *testing.T) {
 		t.Errorf(`Wrong translation, got %q instead of %q`, translation, expected)
 	}
 }
+
+func TestTranslatePluralWithOutOfRangeForm(t *testing.T) {
+	defaultCatalog = catalog{
+		"he_IL": translationDict{
+			"number_of_users": []string{"%d user", "%d users"},
+		},
+	}
+
+	for _, index := range []int{-1, 2} {
+		index := index
+		pluralForms["he_IL"] = func(n int) int { return index }
+		translation := NewPrinter("he_IL").Plural("number_of_users", 2)
+		if translation != "number_of_users" {
+			t.Errorf(`Wrong translation for the plural form %d, got %q`, index, translation)
+		}
+	}
+
+	delete(pluralForms, "he_IL")
+}
@@ -12,9 +12,26 @@ var translations = map[string]string{
     "action.save": "Speichern",
     "action.or": "oder",
     "action.cancel": "abbrechen",
+    "action.send": "Senden",
+    "action.close": "Schließen",
     "action.remove": "Entfernen",
+    "action.add_to_queue": "Zur Warteschlange hinzufügen",
+    "action.play_queue": "Warteschlange abspielen",
+    "action.move_up": "Nach oben",
+    "action.move_down": "Nach unten",
+    "action.open": "Öffnen",
+    "action.save_search": "Diese Suche speichern",
+    "action.unsubscribe_selected": "Ausgewählte Abonnements entfernen",
+    "action.keep_selected": "Ausgewählte Abonnements behalten",
     "action.remove_feed": "Dieses Abonnement entfernen",
+    "action.use_canonical_feed_url": "Diese URL verwenden",
     "action.update": "Aktualisieren",
+    "action.send_verification_link": "Bestätigungslink senden",
+    "action.block_feed": "Sperren",
+    "action.share_category": "Teilen",
+    "action.hide_category": "Ausblenden",
+    "action.unhide_category": "Wieder anzeigen",
+    "action.move_feeds_and_remove": "Verschieben und entfernen",
     "action.edit": "Bearbeiten",
     "action.download": "Herunterladen",
     "action.import": "Importieren",
@@ -24,36 +41,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "Angemeldet als %s",
     "menu.unread": "Ungelesen",
     "menu.starred": "Lesezeichen",
+    "menu.trending": "Trends",
+    "menu.podcasts": "Podcasts",
+    "menu.playback_queue": "Wiedergabeliste",
     "menu.history": "Verlauf",
     "menu.feeds": "Abonnements",
     "menu.categories": "Kategorien",
+    "menu.today": "Heute",
     "menu.settings": "Einstellungen",
     "menu.logout": "Abmelden",
+    "menu.skip_to_content": "Zum Inhalt springen",
     "menu.preferences": "Einstellungen",
     "menu.integrations": "Dienste",
     "menu.sessions": "Sitzungen",
     "menu.users": "Benutzer",
+    "menu.reports": "Berichte",
+    "menu.blocked_feeds": "Gesperrte Feeds",
     "menu.about": "Über",
     "menu.export": "Exportieren",
     "menu.import": "Importieren",
     "menu.create_category": "Kategorie anlegen",
+    "menu.hidden_categories": "Ausgeblendete Kategorien",
     "menu.mark_page_as_read": "Diese Seite als gelesen markieren",
+    "menu.saved_searches": "Gespeicherte Suchen",
+    "menu.clear_search_history": "Suchverlauf löschen",
     "menu.mark_all_as_read": "Alle als gelesen markieren",
     "menu.show_all_entries": "Zeige alle Artikel",
+    "menu.show_all_feeds": "Alle Abonnements anzeigen",
     "menu.show_only_unread_entries": "Nur ungelesene Artikel anzeigen",
     "menu.refresh_feed": "Aktualisieren",
     "menu.refresh_all_feeds": "Alle Abonnements im Hintergrund aktualisieren",
     "menu.edit_feed": "Bearbeiten",
     "menu.edit_category": "Bearbeiten",
     "menu.add_feed": "Abonnement hinzufügen",
+    "menu.feed_recommendations": "Empfehlungen",
     "menu.add_user": "Benutzer anlegen",
     "menu.flush_history": "Verlauf leeren",
     "menu.feed_entries": "Artikel",
     "menu.api_keys": "API-Schlüssel",
     "menu.create_api_key": "Erstellen Sie einen neuen API-Schlüssel",
+    "menu.muted_keywords": "Stummgeschaltete Schlüsselwörter",
+    "menu.create_muted_keyword": "Neues Schlüsselwort stummschalten",
     "menu.shared_entries": "Geteilte Artikel",
+    "menu.received_entries": "Erhaltene Artikel",
     "search.label": "Suche",
     "search.placeholder": "Suche...",
+    "search.placeholder_feed": "In diesem Abonnement suchen...",
+    "search.placeholder_category": "In dieser Kategorie suchen...",
     "pagination.next": "Nächste",
     "pagination.previous": "Vorherige",
     "entry.status.unread": "Ungelesen",
@@ -66,19 +100,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "Markiert",
     "entry.bookmark.toast.off": "Nicht markiert",
     "entry.state.saving": "Speichern...",
+    "entry.state.queued": "In der Warteschlange",
     "entry.state.loading": "Lade...",
+    "entry.state.archiving": "Archivieren...",
     "entry.save.label": "Speichern",
     "entry.save.title": "Diesen Artikel speichern",
     "entry.save.completed": "Erledigt!",
     "entry.save.toast.completed": "Artikel gespeichert",
+    "entry.save.prompt_tags": "Zusätzliche Tags (durch Leerzeichen getrennt)",
+    "entry.select.label": "Auswählen",
+    "entry.bulk.selected": "%d ausgewählt",
+    "entry.bulk.save": "Ausgewählte Artikel speichern",
+    "entry.bulk.save.completed": "Artikel an Drittanbieterdienste gesendet",
+    "entry.bulk.clear": "Auswahl aufheben",
     "entry.scraper.label": "Inhalt herunterladen",
+    "entry.snapshot.title": "Die von Miniflux gespeicherte Kopie der Webseite öffnen",
+    "entry.snapshot.label": "Offline-Kopie",
+    "entry.snapshot.create.title": "Eine vollständige Kopie der Webseite speichern, die auch offline lesbar bleibt",
+    "entry.snapshot.create.label": "Seite archivieren",
+    "entry.snapshot.create.toast": "Webseite archiviert",
     "entry.scraper.title": "Inhalt herunterladen",
     "entry.scraper.completed": "Erledigt!",
     "entry.original.label": "Original-Artikel",
     "entry.comments.label": "Kommentare",
+    "entry.location.title": "Ort auf einer Karte anzeigen",
+    "entry.location.label": "Karte",
+    "entry.archives.title": "Von Archivierungsdiensten gespeicherte Kopien der Webseite",
+    "entry.archives.label": "Archivierte Kopie:",
     "entry.comments.title": "Kommentare anzeigen",
+    "entry.print.label": "Drucken",
+    "entry.print.title": "Drucken oder als PDF speichern",
+    "entry.print.source": "Quelle",
+    "entry.print.url": "URL",
+    "entry.print.date": "Datum",
+    "entry.email.label": "E-Mail",
+    "entry.email.title": "Per E-Mail teilen",
+    "email.entry.signature": "Geteilt von %s aus %s mit Miniflux",
+    "email.feed_error.subject": "Das Abonnement „%s“ schlägt wiederholt fehl",
+    "email.feed_error.body": "Miniflux konnte das Abonnement „%s“ (%s) %d Mal hintereinander nicht aktualisieren.\n\nLetzter Fehler: %s\n\nSie können das Abonnement hier überprüfen: %s",
+    "email.notification_email_verification.subject": "Bestätigen Sie Ihre E-Mail-Adresse für die Miniflux-Benachrichtigungen",
+    "email.notification_email_verification.body": "Der Miniflux-Benutzer \"%s\" möchte Benachrichtigungen an diese Adresse erhalten.\n\nÖffnen Sie diesen Link, um sie zu bestätigen: %s\n\nWenn Sie dies nicht angefordert haben, können Sie diese E-Mail ignorieren.",
     "entry.share.label": "Teilen",
     "entry.share.title": "Diesen Artikel teilen",
+    "entry.send.label": "Senden",
+    "entry.send.title": "Diesen Artikel an einen anderen Benutzer senden",
     "entry.unshare.label": "Nicht teilen",
     "entry.shared_entry.title": "Öffnen Sie den öffentlichen Link",
     "entry.shared_entry.label": "Teilen",
@@ -86,10 +151,41 @@ var translations = map[string]string{
         "%d Minute zu lesen",
         "%d Minuten zu lesen"
     ],
+    "entry.video_duration": [
+        "%d Minute Video",
+        "%d Minuten Video"
+    ],
+    "entry.score": [
+        "%d Punkt",
+        "%d Punkte"
+    ],
+    "entry.comments_count": [
+        "%d Kommentar",
+        "%d Kommentare"
+    ],
+    "entry.duplicates": [
+        "%d ähnlicher Artikel",
+        "%d ähnliche Artikel"
+    ],
+    "entry.removed_trackers": [
+        "%d Tracker entfernt",
+        "%d Tracker entfernt"
+    ],
     "page.shared_entries.title": "Geteilte Artikel",
+    "page.received_entries.title": "Erhaltene Artikel",
+    "page.received_entries.sender": "Gesendet von %s",
+    "page.entry_send.title": "An einen anderen Benutzer senden",
     "page.unread.title": "Ungelesen",
     "page.starred.title": "Lesezeichen",
+    "page.orphaned_saves.title": "Verwaiste Lesezeichen",
+    "page.trending.title": "Trends",
+    "page.trending.feed_count": [
+        "(%d Abonnement)",
+        "(%d Abonnements)"
+    ],
     "page.categories.title": "Kategorien",
+    "page.hidden_categories.title": "Ausgeblendete Kategorien",
+    "page.hidden_categories.help": "Ausgeblendete Kategorien halten ihre Abonnements aktuell, ihre Artikel erscheinen aber nicht in der Liste der ungelesenen Artikel und deren Zählern.",
     "page.categories.no_feed": "Kein Abonnement.",
     "page.categories.entries": "Artikel",
     "page.categories.feeds": "Abonnements",
@@ -100,25 +196,89 @@ var translations = map[string]string{
     "page.new_category.title": "Neue Kategorie",
     "page.new_user.title": "Neuer Benutzer",
     "page.edit_category.title": "Kategorie bearbeiten: %s",
+    "page.remove_category.title": "Kategorie entfernen: %s",
+    "page.remove_category.help": [
+        "Diese Kategorie enthält %d Abonnement. Es wird vor dem Entfernen in die folgende Kategorie verschoben.",
+        "Diese Kategorie enthält %d Abonnements. Sie werden vor dem Entfernen in die folgende Kategorie verschoben."
+    ],
+    "page.edit_category.members": "Mitglieder",
+    "page.edit_category.member_since": "Mitglied seit",
+    "page.edit_category.members_help": "Mitglieder sehen die Abonnements dieser Kategorie in ihrem eigenen Konto mit ihrem eigenen Lese- und Lesezeichenstatus, sobald sie die Einladung annehmen. Die Feeds werden für alle nur einmal abgerufen.",
+    "page.edit_category.shared_by": "Diese Kategorie wird von %s geteilt. Neue Artikel werden von ihrem Besitzer abgerufen.",
+    "page.edit_category.invitation_pending": "Einladung ausstehend",
+    "page.categories.invitation": "%s lädt Sie ein, der Kategorie „%s“ zu folgen.",
+    "action.accept_invitation": "Annehmen",
+    "action.decline_invitation": "Ablehnen",
+    "action.leave_shared_category": "Dieser Kategorie nicht mehr folgen",
     "page.edit_user.title": "Benutzer bearbeiten: %s",
     "page.feeds.title": "Abonnements",
     "page.feeds.last_check": "Letzte Aktualisierung:",
+    "page.feed_entries.volume": [
+        "%d Artikel in den letzten %d Tagen",
+        "%d Artikel in den letzten %d Tagen"
+    ],
     "page.feeds.unread_counter": "Anzahl der ungelesenen Artikel",
     "page.feeds.read_counter": "Anzahl der gelesenen Artikel",
     "page.feeds.error_count": [
         "%d Fehler",
         "%d Fehler"
     ],
+    "page.feeds.dead": "Vom Herausgeber entfernt",
+    "page.feeds.dormant": "Inaktiv",
+    "page.feeds.dormant_title": "Dieses Abonnement hat seit langem nichts veröffentlicht",
+    "page.feeds.move.hint": "Ziehen Sie Abonnements auf eine Kategorie oder wählen Sie mehrere aus und klicken Sie auf eine Kategorie:",
+    "page.feeds.move.selected": "Die %d ausgewählten Abonnements verschieben nach:",
+    "page.feeds.move.loading": "Verschieben...",
+    "page.unused_feeds.title": "Ungelesene Abonnements",
+    "page.unused_feeds.description": [
+        "Sie haben seit %d Monat nichts aus diesen Abonnements gelesen oder markiert.",
+        "Sie haben seit %d Monaten nichts aus diesen Abonnements gelesen oder markiert."
+    ],
+    "page.unused_feeds.last_read": "Zuletzt gelesen:",
+    "page.unused_feeds.never_read": "Nie gelesen",
     "page.history.title": "Verlauf",
+    "page.today.title": "Heute",
+    "page.podcasts.title": "Podcasts",
+    "page.playback_queue.title": "Wiedergabeliste",
+    "page.podcasts.total_duration": "Gesamte Hördauer: %s",
+    "page.digest.title": "Seit Ihrem letzten Besuch",
+    "page.digest.since": "Ungelesene Artikel erhalten seit",
+    "page.digest.continue": "Weiterlesen",
+    "page.digest.top_entries": "Top-Artikel",
+    "page.digest.entry_count": [
+        "%d neuer Artikel",
+        "%d neue Artikel"
+    ],
     "page.import.title": "Importieren",
     "page.search.title": "Suchergebnisse",
+    "page.search.syntax_help": "Verfeinern Sie die Suche mit title:, author:, feed:, tag:, before:2006-01-02 und after:2006-01-02, verwenden Sie Anführungszeichen für exakte Ausdrücke und Schrägstriche für reguläre Ausdrücke, zum Beispiel title:/^release/.",
+    "page.search.saved": "Gespeicherte Suche",
+    "page.saved_searches.title": "Gespeicherte Suchen",
+    "page.saved_searches.recent": "Letzte Suchen",
+    "page.saved_searches.table.query": "Suche",
+    "page.saved_searches.table.actions": "Aktionen",
+    "page.search.scope_feed": "Abonnement: %s",
+    "page.search.scope_category": "Kategorie: %s",
+    "page.search.all_entries": "Alle Artikel durchsuchen",
     "page.about.title": "Über",
     "page.about.credits": "Urheberrechte",
     "page.about.version": "Version:",
     "page.about.build_date": "Datum der Kompilierung:",
+    "page.about.features": "Funktionen",
+    "page.about.image_proxy": "Bild-Proxy:",
+    "page.about.search_backend": "Suchmaschine:",
+    "page.about.websub": "WebSub:",
+    "page.about.integrations": "Integrationen:",
+    "page.about.enabled": "Aktiviert",
+    "page.about.disabled": "Deaktiviert",
     "page.about.author": "Autor:",
     "page.about.license": "Lizenz:",
     "page.add_feed.title": "Neues Abonnement",
+    "page.feed_recommendations.title": "Empfohlene Abonnements",
+    "page.feed_recommendations.table.feed": "Abonnement",
+    "page.feed_recommendations.table.category": "Kategorie",
+    "page.feed_recommendations.table.subscribers": "Abonnenten",
+    "page.feed_recommendations.table.actions": "Aktionen",
     "page.add_feed.no_category": "Es ist keine Kategorie vorhanden. Wenigstens eine Kategorie muss angelegt sein.",
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Abonnement suchen",
@@ -129,8 +289,23 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "Zuletzt geändert:",
     "page.edit_feed.etag_header": "ETag-Kopfzeile:",
     "page.edit_feed.no_header": "Nicht verfügbar",
+    "page.edit_feed.last_fetch_url": "Endgültige URL:",
+    "page.edit_feed.last_fetch_content_type": "Content-Type-Kopfzeile:",
+    "page.edit_feed.last_fetch_size": "Größe:",
+    "page.edit_feed.bytes": [
+        "%d Byte",
+        "%d Bytes"
+    ],
     "page.edit_feed.last_parsing_error": "Letzter Analysefehler",
+    "page.edit_feed.canonical_feed_url": "Dieses Abonnement gibt eine andere URL an: %s",
     "page.entry.attachments": "Anlagen",
+    "page.entry.comments": "Kommentare",
+    "page.entry.comments.follow": "Diskussion verfolgen",
+    "page.entry.comments.unfollow": "Nicht mehr verfolgen",
+    "page.entry.comments.following": [
+        "Neue Kommentare werden noch %d Tag lang abgerufen.",
+        "Neue Kommentare werden noch %d Tage lang abgerufen."
+    ],
     "page.keyboard_shortcuts.title": "Tastenkürzel",
     "page.keyboard_shortcuts.subtitle.sections": "Navigation zwischen den Menüpunkten",
     "page.keyboard_shortcuts.subtitle.items": "Navigation zwischen den Artikeln",
@@ -151,6 +326,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.open_item": "Gewählten Artikel öffnen",
     "page.keyboard_shortcuts.open_original": "Original-Artikel öffnen",
     "page.keyboard_shortcuts.open_original_same_window": "Öffne den Original-Link in der aktuellen Registerkarte",
+    "page.keyboard_shortcuts.open_original_background": "Original-Link in einem Hintergrund-Tab öffnen",
     "page.keyboard_shortcuts.open_comments": "Kommentare öffnen",
     "page.keyboard_shortcuts.open_comments_same_window": "Öffne den Kommentare-Link in der aktuellen Registerkarte",
     "page.keyboard_shortcuts.toggle_read_status": "Gewählten Artikel als gelesen/ungelesen markieren",
@@ -163,6 +339,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "Fokus auf das Suchformular setzen",
     "page.keyboard_shortcuts.close_modal": "Liste der Tastenkürzel schließen",
     "page.users.title": "Benutzer",
+    "page.reports.title": "Berichte",
+    "page.reports.database_size": "Datenbankgröße",
+    "page.reports.storage": "Speicher",
+    "page.reports.feeds": "Abonnements",
+    "page.reports.entries": "Artikel",
+    "page.reports.last_activity": "Letzte Aktivität",
     "page.users.username": "Benutzername",
     "page.users.never_logged": "Niemals",
     "page.users.admin.yes": "Ja",
@@ -202,30 +384,92 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Aktionen",
     "page.api_keys.never_used": "Nie benutzt",
     "page.new_api_key.title": "Neuer API-Schlüssel",
+    "page.muted_keywords.title": "Stummgeschaltete Schlüsselwörter",
+    "page.blocked_feeds.title": "Gesperrte Feeds",
+    "page.blocked_feeds.help": "Niemand kann diese Feeds auf dieser Instanz abonnieren. Das Sperren einer Domain sperrt auch alle ihre Subdomains. Bestehende Abonnements werden deaktiviert.",
+    "page.blocked_feeds.table.pattern": "Feed-URL oder Domain",
+    "page.blocked_feeds.table.reason": "Grund",
+    "page.blocked_feeds.table.created_at": "Gesperrt",
+    "page.blocked_feeds.table.actions": "Aktionen",
+    "page.muted_keywords.help": "Neue Artikel aller Abonnements, die einem dieser Schlüsselwörter oder regulären Ausdrücke entsprechen, werden ignoriert oder als gelesen markiert.",
+    "page.muted_keywords.table.pattern": "Schlüsselwort oder regulärer Ausdruck",
+    "page.muted_keywords.table.action": "Aktion",
+    "page.muted_keywords.table.expires_at": "Ablaufdatum",
+    "page.muted_keywords.table.actions": "Aktionen",
+    "page.muted_keywords.never_expires": "Nie",
+    "page.muted_keywords.expired": "Abgelaufen",
+    "page.new_muted_keyword.title": "Neues stummgeschaltetes Schlüsselwort",
+    "page.entry_email.title": "Per E-Mail teilen",
     "alert.no_shared_entry": "Es existieren derzeit keine geteilten Artikel.",
+    "alert.blocked_feed_created": [
+        "Der Feed wurde gesperrt, %d bestehendes Abonnement wurde deaktiviert.",
+        "Der Feed wurde gesperrt, %d bestehende Abonnements wurden deaktiviert."
+    ],
+    "alert.no_received_entry": "Es gibt keinen erhaltenen Artikel.",
     "alert.no_bookmark": "Es existiert derzeit kein Lesezeichen.",
+    "alert.no_trending_topic": "Es gibt derzeit keine Trends.",
     "alert.no_category": "Es ist keine Kategorie vorhanden.",
+    "alert.no_hidden_category": "Es gibt keine ausgeblendete Kategorie.",
+    "alert.no_category_to_move_feeds": "Erstellen Sie zuerst eine andere Kategorie, die die Abonnements dieser Kategorie aufnimmt.",
+    "alert.category_invitation_sent": "Eine Einladung, dieser Kategorie zu folgen, wurde an %s gesendet.",
+    "alert.no_feed_recommendation": "Es gibt derzeit keine Empfehlungen für Ihre Kategorien.",
     "alert.no_category_entry": "Es befindet sich kein Artikel in dieser Kategorie.",
     "alert.no_feed_entry": "Es existiert kein Artikel für dieses Abonnement.",
     "alert.no_feed": "Es sind keine Abonnements vorhanden.",
+    "alert.unused_feeds": [
+        "%d Abonnement wurde lange nicht gelesen, überprüfen Sie es.",
+        "%d Abonnements wurden lange nicht gelesen, überprüfen Sie sie."
+    ],
+    "alert.dormant_feeds": [
+        "%d Abonnement hat seit langem nichts veröffentlicht, überprüfen Sie es.",
+        "%d Abonnements haben seit langem nichts veröffentlicht, überprüfen Sie sie."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Abonnements ohne neue Artikel seit %d Tag.",
+        "Abonnements ohne neue Artikel seit %d Tagen."
+    ],
+    "alert.no_unused_feed": "Sie lesen alle Ihre Abonnements, nichts aufzuräumen.",
     "alert.no_feed_in_category": "Für diese Kategorie gibt es kein Abonnement.",
     "alert.no_history": "Es existiert zur Zeit kein Verlauf.",
     "alert.feed_error": "Es gibt ein Problem mit diesem Abonnement",
+    "alert.feed_dead": "Dieses Abonnement ist nicht mehr verfügbar und wird nicht mehr automatisch aktualisiert",
     "alert.no_search_result": "Es gibt kein Ergebnis für diese Suche.",
+    "alert.no_saved_search": "Es gibt keine gespeicherte Suche.",
     "alert.no_unread_entry": "Es existiert kein ungelesener Artikel.",
+    "alert.no_today_entry": "Heute wurde kein Artikel veröffentlicht.",
+    "alert.no_podcast_entry": "Es gibt keine ungelesenen Podcast-Folgen.",
+    "alert.no_queued_entry": "Die Wiedergabeliste ist leer, fügen Sie Folgen auf der Podcast-Seite hinzu.",
+    "alert.no_digest_entry": "Nichts Neues seit Ihrem letzten Besuch.",
     "alert.no_user": "Sie sind der einzige Benutzer.",
     "alert.account_unlinked": "Ihr externer Account ist jetzt getrennt!",
     "alert.account_linked": "Ihr externes Konto wurde verknüpft!",
     "alert.pocket_linked": "Ihr Pocket Konto ist jetzt verknüpft!",
     "alert.prefs_saved": "Einstellungen gespeichert!",
+    "alert.notification_email_verification_sent": "Ein Bestätigungslink wurde an die E-Mail-Adresse für Benachrichtigungen gesendet.",
+    "alert.notification_email_verified": "Die E-Mail-Adresse für Benachrichtigungen ist bestätigt.",
+    "alert.entry_sent_by_email": "Der Artikel wurde per E-Mail gesendet.",
     "error.unlink_account_without_password": "Sie müssen ein Passwort festlegen, sonst können Sie sich nicht erneut anmelden.",
     "error.duplicate_linked_account": "Es ist bereits jemand mit diesem Anbieter assoziiert!",
     "error.duplicate_fever_username": "Es existiert bereits jemand mit diesem Fever Benutzernamen!",
+    "error.custom_bookmark_invalid": "Ungültige Einstellungen für den eigenen Lesezeichendienst: %v.",
+    "error.markdown_invalid": "Ungültige Einstellungen für Markdown-Notizen: %v.",
+    "error.markdown_webdav_url_required": "Die URL des WebDAV-Ordners ist erforderlich, um Markdown-Notizen zu speichern.",
+    "error.hypothesis_token_required": "Das Hypothesis-API-Token ist erforderlich.",
+    "error.hypothesis_group_required": "Die Hypothesis-Gruppen-ID ist erforderlich.",
+    "error.zotero_invalid": "Ungültige Zotero-Einstellungen: %v.",
+    "error.instapaper_invalid": "Verbindung zu Instapaper mit diesen Zugangsdaten nicht möglich: %v.",
+    "error.ntfy_invalid_topic_url": "Die URL des ntfy-Themas ist ungültig.",
+    "error.linkace_invalid_lists": "Ungültige LinkAce-Listen: %v.",
+    "error.archivebox_credentials_required": "Die ArchiveBox Server-URL und der API-Schlüssel sind erforderlich.",
     "error.pocket_request_token": "Anfrage-Token konnte nicht von Pocket abgerufen werden!",
     "error.pocket_access_token": "Zugriffstoken konnte nicht von Pocket abgerufen werden!",
     "error.category_already_exists": "Diese Kategorie existiert bereits.",
+    "error.category_member_not_found": "Dieser Benutzer existiert nicht.",
+    "error.category_shared_with_you": "Diese Kategorie wird von %s geteilt und kann nicht erneut geteilt werden.",
     "error.unable_to_create_category": "Diese Kategorie konnte nicht angelegt werden.",
     "error.unable_to_update_category": "Diese Kategorie konnte nicht aktualisiert werden.",
+    "error.unable_to_remove_category": "Diese Kategorie konnte nicht entfernt werden.",
+    "error.category_remove_target": "Wählen Sie eine andere Kategorie für die Abonnements.",
     "error.user_already_exists": "Dieser Benutzer existiert bereits.",
     "error.unable_to_create_user": "Dieser Benutzer kann nicht erstellt werden.",
     "error.unable_to_update_user": "Dieser Benutzer konnte nicht aktualisiert werden.",
@@ -234,30 +478,81 @@ var translations = map[string]string{
     "error.empty_file": "Diese Datei ist leer.",
     "error.bad_credentials": "Benutzername oder Passwort ungültig.",
     "error.fields_mandatory": "Alle Felder sind obligatorisch.",
+    "error.no_feed_selected": "Kein Abonnement ausgewählt.",
     "error.title_required": "Der Titel ist obligatorisch.",
     "error.different_passwords": "Passwörter stimmen nicht überein.",
     "error.password_min_length": "Wenigstens 6 Zeichen müssen genutzt werden.",
     "error.settings_mandatory_fields": "Die Felder für Benutzername, Thema, Sprache und Zeitzone sind obligatorisch.",
     "error.entries_per_page_invalid": "Die Anzahl der Einträge pro Seite ist ungültig.",
+    "error.invalid_youtube_embed_url": "Die URL der Invidious- oder Piped-Instanz ist ungültig.",
+    "error.invalid_auto_star_keywords": "Eines der Stichwörter für automatische Lesezeichen ist kein gültiger regulärer Ausdruck.",
+    "error.invalid_email_recipients": "Einer der Empfänger ist ungültig oder es gibt zu viele Empfänger.",
+    "error.invalid_notification_email": "Die E-Mail-Adresse für Benachrichtigungen ist ungültig.",
+    "error.invalid_notification_email_token": "Dieser Bestätigungslink ist ungültig oder wurde bereits verwendet.",
+    "error.invalid_home_page": "Die Startseite ist ungültig, für eine Suche ist ein Suchbegriff erforderlich.",
+    "error.invalid_entry_timezone": "Die Zeitzone der Veröffentlichungsdaten ist ungültig.",
+    "error.invalid_timestamp_format": "Das Format der Datumsangaben ist ungültig.",
+    "error.unable_to_send_email": "Die E-Mail konnte nicht gesendet werden.",
+    "error.too_many_sent_emails": "Sie haben zu viele E-Mails gesendet, bitte versuchen Sie es später erneut.",
     "error.feed_mandatory_fields": "Die URL und die Kategorie sind obligatorisch.",
+    "error.invalid_encoding": "Diese Zeichenkodierung wird nicht unterstützt.",
+    "error.invalid_cron_expression": "Ungültiger Cron-Ausdruck.",
+    "error.invalid_feed_request_limits": "Das Zeitlimit der Anfrage oder die maximale Größe liegt außerhalb des erlaubten Bereichs.",
+    "error.feed_disabled_after_errors": [
+        "Dieses Abonnement wurde nach %d Woche mit Fehlern automatisch deaktiviert: %s",
+        "Dieses Abonnement wurde nach %d Wochen mit Fehlern automatisch deaktiviert: %s"
+    ],
     "error.user_mandatory_fields": "Der Benutzername ist obligatorisch.",
     "error.api_key_already_exists": "Dieser API-Schlüssel ist bereits vorhanden.",
     "error.unable_to_create_api_key": "Dieser API-Schlüssel kann nicht erstellt werden.",
+    "error.muted_keyword_already_exists": "Dieses Schlüsselwort ist bereits stummgeschaltet.",
+    "error.blocked_feed_already_exists": "Diese Feed-URL oder Domain ist bereits gesperrt.",
+    "error.invalid_blocked_feed": "Geben Sie eine vollständige Feed-URL oder einen Domainnamen ein.",
+    "error.unable_to_block_feed": "Dieser Feed kann nicht gesperrt werden.",
+    "error.unable_to_create_muted_keyword": "Dieses Schlüsselwort kann nicht stummgeschaltet werden.",
+    "error.invalid_muted_keyword": "Ungültiges Schlüsselwort oder ungültiger regulärer Ausdruck.",
+    "error.invalid_expiration_date": "Ungültiges Ablaufdatum.",
+    "error.too_many_sent_entries": "Sie haben zu viele Artikel gesendet, bitte versuchen Sie es später erneut.",
     "form.feed.label.title": "Titel",
+    "form.feed.help.original_title": "Ursprünglicher Titel: %s",
+    "form.feed.help.previous_feed_url": "Die Abonnement-URL wurde automatisch aktualisiert. Vorherige URL: %s",
     "form.feed.label.site_url": "Webseite-URL",
     "form.feed.label.feed_url": "Abonnement-URL",
     "form.feed.label.category": "Kategorie",
     "form.feed.label.crawler": "Inhalt herunterladen",
     "form.feed.label.feed_username": "Benutzername des Abonnements",
     "form.feed.label.feed_password": "Passwort des Abonnements",
+    "form.feed.label.bearer_token": "Bearer-Token des Abonnements",
+    "form.feed.label.oauth2_token_url": "OAuth2-Token-URL",
+    "form.feed.label.oauth2_client_id": "OAuth2-Client-ID",
+    "form.feed.label.oauth2_client_secret": "OAuth2-Client-Geheimnis",
+    "form.feed.label.oauth2_scopes": "OAuth2-Bereiche (durch Leerzeichen getrennt)",
     "form.feed.label.user_agent": "Standardbenutzeragenten überschreiben",
     "form.feed.label.scraper_rules": "Extraktionsregeln",
     "form.feed.label.rewrite_rules": "Umschreiberegeln",
+    "form.feed.label.encoding": "Zeichenkodierung erzwingen",
     "form.feed.label.ignore_http_cache": "Ignoriere HTTP-cache",
     "form.feed.label.fetch_via_proxy": "Über Proxy abrufen",
     "form.feed.label.disabled": "Dieses Abonnement nicht aktualisieren",
+    "form.feed.label.open_external_link": "Artikel direkt auf der Original-Webseite öffnen",
+    "form.feed.label.fetch_scores": "Punktzahl und Anzahl der Kommentare von Reddit und Hacker News abrufen",
+    "form.feed.label.minimum_score": "Artikel mit einer Punktzahl unter diesem Wert ignorieren",
+    "form.feed.label.blocked_authors": "Artikel dieser Autoren ignorieren (einer pro Zeile)",
+    "form.feed.label.auto_star": "Neue Artikel dieses Abonnements automatisch als Lesezeichen markieren",
+    "form.feed.label.archive_pages": "Eine vollständige Kopie der Webseite neuer Artikel speichern",
+    "form.feed.label.hide_globally": "Artikel in der globalen Liste der ungelesenen Artikel ausblenden",
+    "form.feed.label.notify_errors": "Benachrichtigung senden, wenn dieses Abonnement wiederholt fehlschlägt",
+    "form.feed.label.cron_expression": "Aktualisierungsplan (Cron-Ausdruck)",
+    "form.feed.help.cron_expression": "Minute, Stunde, Tag des Monats, Monat und Wochentag in Ihrer Zeitzone. Leer lassen, um die Standardplanung zu verwenden.",
+    "form.feed.label.request_timeout": "Zeitlimit der Anfrage (Sekunden)",
+    "form.feed.label.max_body_size": "Maximale Größe (MB)",
+    "form.feed.help.http_client_limits": "0 verwendet die globalen Einstellungen.",
     "form.category.label.title": "Titel",
+    "form.category.label.move_feeds_to": "Abonnements verschieben nach",
+    "form.category.label.member_username": "Mit Benutzer teilen",
     "form.user.label.username": "Benutzername",
+    "form.entry_send.label.username": "Benutzername des Empfängers",
+    "form.entry_send.label.message": "Nachricht (optional)",
     "form.user.label.password": "Passwort",
     "form.user.label.confirmation": "Passwort Bestätigung",
     "form.user.label.admin": "Administrator",
@@ -266,10 +561,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "Thema",
     "form.prefs.label.entry_sorting": "Sortierung der Artikel",
     "form.prefs.label.entries_per_page": "Einträge pro Seite",
+    "form.prefs.label.home_page": "Startseite",
+    "form.prefs.label.home_page_search": "Suchbegriff der Startseite",
+    "form.prefs.label.entry_timezone": "Veröffentlichungsdaten",
+    "form.prefs.select.entry_timezone_local": "In meiner Zeitzone",
+    "form.prefs.select.entry_timezone_original": "In der Zeitzone des Abonnements",
+    "form.prefs.select.entry_timezone_both": "In beiden Zeitzonen",
+    "form.prefs.label.timestamp_format": "Datumsangaben in den Listen",
+    "form.prefs.select.timestamp_relative": "Relativ (vor 2 Stunden)",
+    "form.prefs.select.timestamp_absolute": "Absolut (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "Älteste Artikel zuerst",
     "form.prefs.select.recent_first": "Neueste Artikel zuerst",
+    "form.prefs.select.home_page_category": "Kategorie",
+    "form.prefs.select.home_page_search": "Suche",
     "form.prefs.label.keyboard_shortcuts": "Tastaturkürzel aktivieren",
     "form.prefs.label.show_reading_time": "Geschätzte Lesezeit für Artikel anzeigen",
+    "form.prefs.label.mark_read_on_original_link": "Artikel beim Öffnen des Original-Links als gelesen markieren",
+    "form.prefs.label.keep_starred_entries": "Markierte Artikel behalten, wenn ihr Abonnement oder ihre Kategorie entfernt wird",
+    "form.prefs.label.accept_received_entries": "Von anderen Benutzern gesendete Artikel annehmen",
+    "form.prefs.label.archive_read_days": "Tage, die gelesene Artikel aufbewahrt werden",
+    "form.prefs.help.archive_read_days": "Leer lassen, um den Standardwert von %d Tagen zu verwenden, das Maximum beträgt %d Tage.",
+    "form.prefs.label.youtube_embed_url": "Invidious- oder Piped-Instanz zum Abspielen von YouTube-Videos",
+    "form.prefs.label.blocked_authors": "Artikel dieser Autoren in allen Abonnements ignorieren (einer pro Zeile)",
+    "form.prefs.label.auto_star_keywords": "Neue Artikel, die diesen Stichwörtern entsprechen, automatisch als Lesezeichen markieren (ein regulärer Ausdruck pro Zeile)",
+    "form.prefs.label.auto_star_authors": "Neue Artikel dieser Autoren automatisch als Lesezeichen markieren (einer pro Zeile)",
+    "form.prefs.label.email_recipients": "Standardempfänger beim Teilen per E-Mail (durch Kommas getrennt)",
+    "form.prefs.label.notification_email": "E-Mail-Adresse für Benachrichtigungen",
+    "form.prefs.help.notification_email_unverified": "Diese Adresse ist noch nicht bestätigt, es werden keine Benachrichtigungen an sie gesendet.",
     "form.prefs.label.custom_css": "Benutzerdefiniertes CSS",
     "form.import.label.file": "OPML Datei",
     "form.import.label.url": "URL",
@@ -280,12 +598,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "Artikel in Pinboard speichern",
     "form.integration.pinboard_token": "Pinboard API Token",
     "form.integration.pinboard_tags": "Pinboard Tags",
+    "form.integration.pinboard_category_tag": "Die Kategorie des Abonnements als Tag hinzufügen",
+    "form.integration.pinboard_entry_tags": "Die Tags des Artikels hinzufügen",
+    "form.integration.pinboard_prompt_tags": "Beim Speichern eines Artikels nach zusätzlichen Tags fragen",
     "form.integration.pinboard_bookmark": "Lesezeichen als ungelesen markieren",
     "form.integration.instapaper_activate": "Artikel in Instapaper speichern",
     "form.integration.instapaper_username": "Instapaper Benutzername",
     "form.integration.instapaper_password": "Instapaper Passwort",
+    "form.integration.instapaper_folder": "Instapaper-Ordner",
+    "form.integration.instapaper_folder_unread": "Ungelesen (Standard)",
+    "form.integration.instapaper_folder_id": "Instapaper-Ordner-ID",
+    "form.integration.instapaper_folder_help": "Die Ordner werden aufgelistet, sobald die Integration gespeichert ist. Speichern Sie erneut, um die Liste zu aktualisieren. Leer lassen, um Artikel im Ordner „Ungelesen“ zu speichern.",
     "form.integration.pocket_activate": "Artikel in Pocket speichern",
     "form.integration.pocket_consumer_key": "Pocket Consumer Key",
+    "form.integration.pocket_category_tag": "Die Kategorie des Abonnements als Tag hinzufügen",
     "form.integration.pocket_access_token": "Pocket Access Token",
     "form.integration.pocket_connect_link": "Verbinden Sie Ihr Pocket Konto",
     "form.integration.wallabag_activate": "Artikel in Wallabag speichern",
@@ -294,12 +620,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Wallabag Client-Secret",
     "form.integration.wallabag_username": "Wallabag Benutzername",
     "form.integration.wallabag_password": "Wallabag Passwort",
+    "form.integration.wallabag_tags": "Wallabag-Tags (durch Kommas getrennt)",
+    "form.integration.wallabag_archive": "In Wallabag gespeicherte Artikel archivieren",
     "form.integration.nunux_keeper_activate": "Artikel in Nunux Keeper speichern",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API-Endpunkt",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API-Schlüssel",
+    "form.integration.karakeep_activate": "Artikel in Karakeep speichern",
+    "form.integration.karakeep_endpoint": "Karakeep-Server-URL",
+    "form.integration.karakeep_api_key": "Karakeep-API-Schlüssel",
+    "form.integration.karakeep_tags": "Karakeep-Tags (durch Kommas getrennt)",
+    "form.integration.archivebox_activate": "Gespeicherte und favorisierte Artikel mit ArchiveBox archivieren",
+    "form.integration.archivebox_endpoint": "ArchiveBox Server-URL",
+    "form.integration.archivebox_api_key": "ArchiveBox API-Schlüssel",
+    "form.integration.archivebox_tags": "ArchiveBox Tags (durch Kommas getrennt)",
+    "form.integration.archivebox_help": "Der Link zur Kopie wird im Artikel angezeigt, sobald ArchiveBox die Seite archiviert hat.",
+    "form.integration.ntfy_activate": "Push-Benachrichtigungen mit ntfy senden",
+    "form.integration.ntfy_topic_url": "URL des ntfy-Themas",
+    "form.integration.ntfy_token": "ntfy-Zugriffstoken (optional)",
+    "form.integration.ntfy_help": "Feeds mit aktivierten Fehlerbenachrichtigungen werden an dieses Thema gemeldet, wenn sie wiederholt fehlschlagen.",
+    "form.integration.espial_activate": "Artikel in Espial speichern",
+    "form.integration.espial_endpoint": "Espial-Server-URL",
+    "form.integration.espial_api_key": "Espial-API-Schlüssel",
+    "form.integration.espial_tags": "Espial-Tags (durch Leerzeichen getrennt)",
+    "form.integration.linkace_activate": "Artikel in LinkAce speichern",
+    "form.integration.linkace_endpoint": "LinkAce-Server-URL",
+    "form.integration.linkace_api_key": "LinkAce-API-Token",
+    "form.integration.linkace_tags": "LinkAce-Tags (durch Kommas getrennt)",
+    "form.integration.linkace_lists": "LinkAce-Listen-IDs (durch Kommas getrennt)",
+    "form.integration.linkace_category_tag": "Kategorie als Tag hinzufügen",
+    "form.integration.linkace_is_private": "Links als privat speichern",
+    "form.integration.custom_bookmark": "Eigener Lesezeichendienst",
+    "form.integration.custom_bookmark_activate": "Artikel in einem eigenen Lesezeichendienst speichern",
+    "form.integration.custom_bookmark_endpoint": "API-Endpunkt",
+    "form.integration.custom_bookmark_method": "HTTP-Methode",
+    "form.integration.custom_bookmark_headers": "HTTP-Header (einer pro Zeile)",
+    "form.integration.custom_bookmark_body": "Vorlage für den JSON-Inhalt",
+    "form.integration.custom_bookmark_body_help": "Platzhalter werden durch JSON-Werte ersetzt, keine Anführungszeichen hinzufügen:",
+    "form.integration.markdown": "Markdown-Notizen",
+    "form.integration.markdown_activate": "Artikel als Markdown-Dateien speichern, für Notiz-Apps wie Obsidian",
+    "form.integration.markdown_starred": "Auch markierte Artikel speichern",
+    "form.integration.markdown_webdav_url": "URL des WebDAV-Ordners",
+    "form.integration.markdown_webdav_username": "WebDAV-Benutzername",
+    "form.integration.markdown_webdav_password": "WebDAV-Passwort",
+    "form.integration.markdown_local_help": "Lassen Sie die URL leer, um die Dateien in den vom Administrator konfigurierten Ordner zu schreiben.",
+    "form.integration.wayback_activate": "Gespeicherte und markierte Artikel mit der Wayback Machine archivieren",
+    "form.integration.wayback_help": "Die Webseite wird an das Internet Archive gesendet und der Link zur Kopie wird beim Artikel angezeigt.",
+    "form.integration.zotero_activate": "Artikel in Zotero speichern",
+    "form.integration.zotero_library_type": "Zotero-Bibliothek",
+    "form.integration.zotero_library_user": "Persönliche Bibliothek",
+    "form.integration.zotero_library_group": "Gruppenbibliothek",
+    "form.integration.zotero_library_id": "Zotero-Benutzer- oder Gruppen-ID",
+    "form.integration.zotero_api_key": "Zotero-API-Schlüssel",
+    "form.integration.zotero_collection_key": "Zotero-Sammlungsschlüssel (optional)",
+    "form.integration.zotero_help": "Artikel mit einem DOI werden als Zeitschriftenartikel gespeichert, alle anderen als Webseiten.",
+    "form.integration.hypothesis_activate": "Anmerkungen zu Artikeln auf Hypothesis veröffentlichen",
+    "form.integration.hypothesis_token": "Hypothesis-API-Token",
+    "form.integration.hypothesis_token_help": "Das Token kann auf der Entwicklerseite Ihres Hypothesis-Kontos erstellt werden.",
+    "form.integration.hypothesis_group": "Hypothesis-Gruppen-ID",
+    "form.integration.hypothesis_group_help": "Die Anmerkungen sind für die Mitglieder dieser Gruppe sichtbar, die Gruppe __world__ macht sie öffentlich.",
+    "form.integration.hypothesis_export_help": "Alle Anmerkungen können auch im W3C-Web-Annotation-Format über die API heruntergeladen werden:",
+    "form.integration.rssbridge_activate": "RSS-Bridge verwenden, wenn eine Webseite keinen Feed hat",
+    "form.integration.rssbridge_url": "URL des RSS-Bridge-Servers",
+    "form.integration.routing": "Kategorien",
+    "form.integration.routing_help": "Auf Kategorien beschränkte Dienste erhalten nur die Artikel der ausgewählten Kategorien und nichts, wenn keine Kategorie ausgewählt ist.",
+    "form.integration.routing_restrict": "Auf die ausgewählten Kategorien beschränken",
     "form.api_key.label.description": "API-Schlüsselbezeichnung",
+    "form.muted_keyword.label.pattern": "Schlüsselwort oder regulärer Ausdruck",
+    "form.blocked_feed.label.pattern": "Feed-URL oder Domain",
+    "form.blocked_feed.help.pattern": "Eine vollständige URL sperrt nur diesen Feed, ein Domainname sperrt alle Feeds der Domain und ihrer Subdomains.",
+    "form.blocked_feed.label.reason": "Den Benutzern angezeigter Grund (optional)",
+    "form.muted_keyword.label.action": "Passende Artikel",
+    "form.muted_keyword.label.expires_at": "Ablaufdatum (optional)",
+    "form.entry_email.label.recipients": "Empfänger (durch Kommas getrennt)",
+    "form.entry_email.label.note": "Persönliche Notiz (optional)",
+    "form.muted_keyword.select.drop": "Ignorieren",
+    "form.muted_keyword.select.read": "Als gelesen markieren",
     "form.submit.loading": "Lade...",
     "form.submit.saving": "Speichern...",
+    "form.submit.sending": "Senden...",
     "time_elapsed.not_yet": "noch nicht",
     "time_elapsed.yesterday": "gestern",
     "time_elapsed.now": "gerade",
@@ -328,6 +726,7 @@ var translations = map[string]string{
         "vor %d Jahren"
     ],
     "This feed already exists (%s)": "Diese Abonnement existiert bereits (%s)",
+    "This feed has been blocked by the administrator (%s)": "Dieser Feed wurde vom Administrator gesperrt (%s)",
     "Unable to fetch feed (Status Code = %d)": "Abonnement konnte nicht abgerufen werden (code=%d)",
     "Unable to open this link: %v": "Dieser Link konnte nicht geöffnet werden: %v",
     "Unable to analyze this page: %v": "Diese Seite konnte nicht analysiert werden: %v",
@@ -344,9 +743,12 @@ var translations = map[string]string{
     "This website is temporarily unreachable (original error: %q)": "Diese Webseite ist vorübergehend nicht erreichbar (ursprünglicher Fehler: %q)",
     "This website is permanently unreachable (original error: %q)": "Diese Webseite ist dauerhaft nicht erreichbar (ursprünglicher Fehler: %q)",
     "Website unreachable, the request timed out after %d seconds": "Webseite nicht erreichbar, die Anfrage endete nach %d Sekunden",
+    "This URL scheme is not allowed (%s)": "Dieses URL-Schema ist nicht erlaubt (%s)",
+    "This address is not allowed, it points to a denied network (%s)": "Diese Adresse ist nicht erlaubt, sie verweist auf ein gesperrtes Netzwerk (%s)",
     "You are not authorized to access this resource (invalid username/password)": "Sie sind nicht berechtigt, auf diese Ressource zuzugreifen (Benutzername/Passwort ungültig)",
     "Unable to fetch this resource (Status Code = %d)": "Ressource konnte nicht abgerufen werden (code=%d)",
-    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Ressource nicht gefunden (404), dieses Abonnement existiert nicht mehr, überprüfen Sie die Abonnement-URL"
+    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Ressource nicht gefunden (404), dieses Abonnement existiert nicht mehr, überprüfen Sie die Abonnement-URL",
+    "This feed has been permanently removed by its publisher (410 Gone)": "Dieses Abonnement wurde vom Herausgeber dauerhaft entfernt (410 Gone)"
 }
 `,
 	"en_US": `{
@@ -358,9 +760,26 @@ var translations = map[string]string{
     "action.save": "Save",
     "action.or": "or",
     "action.cancel": "cancel",
+    "action.send": "Send",
+    "action.close": "Close",
     "action.remove": "Remove",
+    "action.add_to_queue": "Add to queue",
+    "action.play_queue": "Play the queue",
+    "action.move_up": "Move up",
+    "action.move_down": "Move down",
+    "action.open": "Open",
+    "action.save_search": "Save this search",
+    "action.unsubscribe_selected": "Unsubscribe from selected feeds",
+    "action.keep_selected": "Keep selected feeds",
     "action.remove_feed": "Remove this feed",
+    "action.use_canonical_feed_url": "Use this URL",
     "action.update": "Update",
+    "action.send_verification_link": "Send a verification link",
+    "action.block_feed": "Block",
+    "action.share_category": "Share",
+    "action.hide_category": "Hide",
+    "action.unhide_category": "Show again",
+    "action.move_feeds_and_remove": "Move and remove",
     "action.edit": "Edit",
     "action.download": "Download",
     "action.import": "Import",
@@ -370,36 +789,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "Logged as %s",
     "menu.unread": "Unread",
     "menu.starred": "Starred",
+    "menu.trending": "Trending",
+    "menu.podcasts": "Podcasts",
+    "menu.playback_queue": "Playback queue",
     "menu.history": "History",
     "menu.feeds": "Feeds",
     "menu.categories": "Categories",
+    "menu.today": "Today",
     "menu.settings": "Settings",
     "menu.logout": "Logout",
+    "menu.skip_to_content": "Skip to content",
     "menu.preferences": "Preferences",
     "menu.integrations": "Integrations",
     "menu.sessions": "Sessions",
     "menu.users": "Users",
+    "menu.reports": "Reports",
+    "menu.blocked_feeds": "Blocked Feeds",
     "menu.about": "About",
     "menu.export": "Export",
     "menu.import": "Import",
     "menu.create_category": "Create a category",
+    "menu.hidden_categories": "Hidden categories",
     "menu.mark_page_as_read": "Mark this page as read",
+    "menu.saved_searches": "Saved searches",
+    "menu.clear_search_history": "Clear search history",
     "menu.mark_all_as_read": "Mark all as read",
     "menu.show_all_entries": "Show all entries",
+    "menu.show_all_feeds": "Show all feeds",
     "menu.show_only_unread_entries": "Show only unread entries",
     "menu.refresh_feed": "Refresh",
     "menu.refresh_all_feeds": "Refresh all feeds in the background",
     "menu.edit_feed": "Edit",
     "menu.edit_category": "Edit",
     "menu.add_feed": "Add subscription",
+    "menu.feed_recommendations": "Recommendations",
     "menu.add_user": "Add user",
     "menu.flush_history": "Flush history",
     "menu.feed_entries": "Entries",
     "menu.api_keys": "API Keys",
     "menu.create_api_key": "Create a new API key",
+    "menu.muted_keywords": "Muted Keywords",
+    "menu.create_muted_keyword": "Mute a new keyword",
     "menu.shared_entries": "Shared entries",
+    "menu.received_entries": "Received entries",
     "search.label": "Search",
     "search.placeholder": "Search...",
+    "search.placeholder_feed": "Search in this feed...",
+    "search.placeholder_category": "Search in this category...",
     "pagination.next": "Next",
     "pagination.previous": "Previous",
     "entry.status.unread": "Unread",
@@ -412,19 +848,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "Starred",
     "entry.bookmark.toast.off": "Unstarred",
     "entry.state.saving": "Saving...",
+    "entry.state.queued": "Queued",
     "entry.state.loading": "Loading...",
+    "entry.state.archiving": "Archiving...",
     "entry.save.label": "Save",
     "entry.save.title": "Save this article",
     "entry.save.completed": "Done!",
     "entry.save.toast.completed": "Article saved",
+    "entry.save.prompt_tags": "Additional tags (separated by spaces)",
+    "entry.select.label": "Select",
+    "entry.bulk.selected": "%d selected",
+    "entry.bulk.save": "Save selected entries",
+    "entry.bulk.save.completed": "Entries sent to third-party services",
+    "entry.bulk.clear": "Clear selection",
     "entry.scraper.label": "Original",
+    "entry.snapshot.title": "Open the copy of the web page kept by Miniflux",
+    "entry.snapshot.label": "Offline copy",
+    "entry.snapshot.create.title": "Keep a copy of the full web page, viewable even if the website goes offline",
+    "entry.snapshot.create.label": "Archive page",
+    "entry.snapshot.create.toast": "Web page archived",
     "entry.scraper.title": "Fetch original content",
     "entry.scraper.completed": "Done!",
     "entry.original.label": "Original",
     "entry.comments.label": "Comments",
+    "entry.location.title": "Show the location on a map",
+    "entry.location.label": "Map",
+    "entry.archives.title": "Copies of the web page kept by archiving services",
+    "entry.archives.label": "Archived copy:",
     "entry.comments.title": "View Comments",
+    "entry.print.label": "Print",
+    "entry.print.title": "Print or save as PDF",
+    "entry.print.source": "Source",
+    "entry.print.url": "URL",
+    "entry.print.date": "Date",
+    "entry.email.label": "Email",
+    "entry.email.title": "Share by email",
+    "email.entry.signature": "Shared by %s from %s with Miniflux",
+    "email.feed_error.subject": "The feed \"%s\" keeps failing",
+    "email.feed_error.body": "Miniflux was unable to refresh the feed \"%s\" (%s) %d times in a row.\n\nLast error: %s\n\nYou can review the feed here: %s",
+    "email.notification_email_verification.subject": "Confirm your email address for the Miniflux notifications",
+    "email.notification_email_verification.body": "The Miniflux user \"%s\" wants to receive notifications at this address.\n\nOpen this link to confirm it: %s\n\nIf you didn't ask for it, you can ignore this email.",
     "entry.share.label": "Share",
     "entry.share.title": "Share this article",
+    "entry.send.label": "Send",
+    "entry.send.title": "Send this article to another user",
     "entry.unshare.label": "Unshare",
     "entry.shared_entry.title": "Open the public link",
     "entry.shared_entry.label": "Share",
@@ -432,10 +899,41 @@ var translations = map[string]string{
         "%d minute read",
         "%d minutes read"
     ],
+    "entry.video_duration": [
+        "%d minute video",
+        "%d minutes video"
+    ],
+    "entry.score": [
+        "%d point",
+        "%d points"
+    ],
+    "entry.comments_count": [
+        "%d comment",
+        "%d comments"
+    ],
+    "entry.duplicates": [
+        "%d similar entry",
+        "%d similar entries"
+    ],
+    "entry.removed_trackers": [
+        "%d tracker removed",
+        "%d trackers removed"
+    ],
     "page.shared_entries.title": "Shared Entries",
+    "page.received_entries.title": "Received Entries",
+    "page.received_entries.sender": "Sent by %s",
+    "page.entry_send.title": "Send to another user",
     "page.unread.title": "Unread",
     "page.starred.title": "Starred",
+    "page.orphaned_saves.title": "Orphaned saves",
+    "page.trending.title": "Trending",
+    "page.trending.feed_count": [
+        "(%d feed)",
+        "(%d feeds)"
+    ],
     "page.categories.title": "Categories",
+    "page.hidden_categories.title": "Hidden Categories",
+    "page.hidden_categories.help": "Hidden categories keep their subscriptions up to date but their articles are left out of the unread list and its counters.",
     "page.categories.no_feed": "No feed.",
     "page.categories.entries": "Articles",
     "page.categories.feeds": "Subscriptions",
@@ -446,25 +944,89 @@ var translations = map[string]string{
     "page.new_category.title": "New Category",
     "page.new_user.title": "New User",
     "page.edit_category.title": "Edit Category: %s",
+    "page.remove_category.title": "Remove Category: %s",
+    "page.remove_category.help": [
+        "This category contains %d feed. It will be moved to the category below before the removal.",
+        "This category contains %d feeds. They will be moved to the category below before the removal."
+    ],
+    "page.edit_category.members": "Members",
+    "page.edit_category.member_since": "Member since",
+    "page.edit_category.members_help": "Members see the feeds of this category in their own account with their own read and starred status, once they accept the invitation. Feeds are fetched only once for everyone.",
+    "page.edit_category.shared_by": "This category is shared by %s. New entries are fetched by its owner.",
+    "page.edit_category.invitation_pending": "Invitation pending",
+    "page.categories.invitation": "%s invites you to follow the category “%s”.",
+    "action.accept_invitation": "Accept",
+    "action.decline_invitation": "Decline",
+    "action.leave_shared_category": "Stop following this category",
     "page.edit_user.title": "Edit User: %s",
     "page.feeds.title": "Feeds",
     "page.feeds.last_check": "Last check:",
+    "page.feed_entries.volume": [
+        "%d entry during the last %d days",
+        "%d entries during the last %d days"
+    ],
     "page.feeds.unread_counter": "Number of unread entries",
     "page.feeds.read_counter": "Number of read entries",
     "page.feeds.error_count": [
         "%d error",
         "%d errors"
     ],
+    "page.feeds.dead": "Removed by the publisher",
+    "page.feeds.dormant": "Dormant",
+    "page.feeds.dormant_title": "This feed hasn't published anything for a long time",
+    "page.feeds.move.hint": "Drag feeds to a category, or select several feeds and click a category:",
+    "page.feeds.move.selected": "Move the %d selected feeds to:",
+    "page.feeds.move.loading": "Moving...",
+    "page.unused_feeds.title": "Unread Feeds",
+    "page.unused_feeds.description": [
+        "You haven't read or starred anything from these feeds for %d month.",
+        "You haven't read or starred anything from these feeds for %d months."
+    ],
+    "page.unused_feeds.last_read": "Last read:",
+    "page.unused_feeds.never_read": "Never read",
     "page.history.title": "History",
+    "page.today.title": "Today",
+    "page.podcasts.title": "Podcasts",
+    "page.playback_queue.title": "Playback Queue",
+    "page.podcasts.total_duration": "Total listening time: %s",
+    "page.digest.title": "Since your last visit",
+    "page.digest.since": "Unread articles received since",
+    "page.digest.continue": "Continue reading",
+    "page.digest.top_entries": "Top articles",
+    "page.digest.entry_count": [
+        "%d new article",
+        "%d new articles"
+    ],
     "page.import.title": "Import",
     "page.search.title": "Search Results",
+    "page.search.syntax_help": "Narrow down the search with title:, author:, feed:, tag:, before:2006-01-02 and after:2006-01-02, use quotes for exact phrases and slashes for regular expressions, for example title:/^release/.",
+    "page.search.saved": "Saved search",
+    "page.saved_searches.title": "Saved Searches",
+    "page.saved_searches.recent": "Recent Searches",
+    "page.saved_searches.table.query": "Search",
+    "page.saved_searches.table.actions": "Actions",
+    "page.search.scope_feed": "Feed: %s",
+    "page.search.scope_category": "Category: %s",
+    "page.search.all_entries": "Search all entries",
     "page.about.title": "About",
     "page.about.credits": "Credits",
     "page.about.version": "Version:",
     "page.about.build_date": "Build Date:",
+    "page.about.features": "Features",
+    "page.about.image_proxy": "Image proxy:",
+    "page.about.search_backend": "Search engine:",
+    "page.about.websub": "WebSub:",
+    "page.about.integrations": "Integrations:",
+    "page.about.enabled": "Enabled",
+    "page.about.disabled": "Disabled",
     "page.about.author": "Author:",
     "page.about.license": "License:",
     "page.add_feed.title": "New Subscription",
+    "page.feed_recommendations.title": "Recommended Feeds",
+    "page.feed_recommendations.table.feed": "Feed",
+    "page.feed_recommendations.table.category": "Category",
+    "page.feed_recommendations.table.subscribers": "Subscribers",
+    "page.feed_recommendations.table.actions": "Actions",
     "page.add_feed.no_category": "There is no category. You must have at least one category.",
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Find a subscription",
@@ -475,8 +1037,23 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "LastModified header:",
     "page.edit_feed.etag_header": "ETag header:",
     "page.edit_feed.no_header": "None",
+    "page.edit_feed.last_fetch_url": "Final URL:",
+    "page.edit_feed.last_fetch_content_type": "Content-Type header:",
+    "page.edit_feed.last_fetch_size": "Size:",
+    "page.edit_feed.bytes": [
+        "%d byte",
+        "%d bytes"
+    ],
     "page.edit_feed.last_parsing_error": "Last Parsing Error",
+    "page.edit_feed.canonical_feed_url": "This feed advertises a different URL: %s",
     "page.entry.attachments": "Attachments",
+    "page.entry.comments": "Comments",
+    "page.entry.comments.follow": "Follow the discussion",
+    "page.entry.comments.unfollow": "Stop following",
+    "page.entry.comments.following": [
+        "New comments will be fetched for %d more day.",
+        "New comments will be fetched for %d more days."
+    ],
     "page.keyboard_shortcuts.title": "Keyboard Shortcuts",
     "page.keyboard_shortcuts.subtitle.sections": "Sections Navigation",
     "page.keyboard_shortcuts.subtitle.items": "Items Navigation",
@@ -497,6 +1074,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.open_item": "Open selected item",
     "page.keyboard_shortcuts.open_original": "Open original link",
     "page.keyboard_shortcuts.open_original_same_window": "Open original link in current tab",
+    "page.keyboard_shortcuts.open_original_background": "Open original link in background tab",
     "page.keyboard_shortcuts.open_comments": "Open comments link",
     "page.keyboard_shortcuts.open_comments_same_window": "Open comments link in current tab",
     "page.keyboard_shortcuts.toggle_read_status": "Toggle read/unread",
@@ -509,6 +1087,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "Set focus on search form",
     "page.keyboard_shortcuts.close_modal": "Close modal dialog",
     "page.users.title": "Users",
+    "page.reports.title": "Reports",
+    "page.reports.database_size": "Database size",
+    "page.reports.storage": "Storage",
+    "page.reports.feeds": "Feeds",
+    "page.reports.entries": "Articles",
+    "page.reports.last_activity": "Last activity",
     "page.users.username": "Username",
     "page.users.never_logged": "Never",
     "page.users.admin.yes": "Yes",
@@ -548,30 +1132,92 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Actions",
     "page.api_keys.never_used": "Never Used",
     "page.new_api_key.title": "New API Key",
+    "page.muted_keywords.title": "Muted Keywords",
+    "page.blocked_feeds.title": "Blocked Feeds",
+    "page.blocked_feeds.help": "Nobody can subscribe to these feeds on this instance. Blocking a domain also blocks all its subdomains. Existing subscriptions are disabled.",
+    "page.blocked_feeds.table.pattern": "Feed URL or domain",
+    "page.blocked_feeds.table.reason": "Reason",
+    "page.blocked_feeds.table.created_at": "Blocked",
+    "page.blocked_feeds.table.actions": "Actions",
+    "page.muted_keywords.help": "New entries of all your feeds matching one of these keywords or regular expressions are ignored or marked as read.",
+    "page.muted_keywords.table.pattern": "Keyword or Regex",
+    "page.muted_keywords.table.action": "Action",
+    "page.muted_keywords.table.expires_at": "Expiration Date",
+    "page.muted_keywords.table.actions": "Actions",
+    "page.muted_keywords.never_expires": "Never",
+    "page.muted_keywords.expired": "Expired",
+    "page.new_muted_keyword.title": "New Muted Keyword",
+    "page.entry_email.title": "Share by email",
     "alert.no_shared_entry": "There is no shared entry.",
+    "alert.blocked_feed_created": [
+        "The feed has been blocked, %d existing subscription has been disabled.",
+        "The feed has been blocked, %d existing subscriptions have been disabled."
+    ],
+    "alert.no_received_entry": "There is no received entry.",
     "alert.no_bookmark": "There is no bookmark at the moment.",
+    "alert.no_trending_topic": "There is no trending topic at the moment.",
     "alert.no_category": "There is no category.",
+    "alert.no_hidden_category": "There is no hidden category.",
+    "alert.no_category_to_move_feeds": "Create another category first to receive the feeds of this one.",
+    "alert.category_invitation_sent": "An invitation to follow this category has been sent to %s.",
+    "alert.no_feed_recommendation": "There is no recommendation for your categories at the moment.",
     "alert.no_category_entry": "There are no articles in this category.",
     "alert.no_feed_entry": "There are no articles for this feed.",
     "alert.no_feed": "You don't have any subscriptions.",
+    "alert.unused_feeds": [
+        "%d feed hasn't been read for a long time, review it.",
+        "%d feeds haven't been read for a long time, review them."
+    ],
+    "alert.dormant_feeds": [
+        "%d feed hasn't published anything for a long time, review it.",
+        "%d feeds haven't published anything for a long time, review them."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Feeds without new entries for %d day.",
+        "Feeds without new entries for %d days."
+    ],
+    "alert.no_unused_feed": "You read all your subscriptions, nothing to clean up.",
     "alert.no_feed_in_category": "There is no subscription for this category.",
     "alert.no_history": "There is no history at the moment.",
     "alert.feed_error": "There is a problem with this feed",
+    "alert.feed_dead": "This feed is no longer available and is not refreshed automatically anymore",
     "alert.no_search_result": "There are no results for this search.",
+    "alert.no_saved_search": "There is no saved search.",
     "alert.no_unread_entry": "There are no unread articles.",
+    "alert.no_today_entry": "No article has been published today.",
+    "alert.no_podcast_entry": "There are no unread podcast episodes.",
+    "alert.no_queued_entry": "The playback queue is empty, add episodes from the Podcasts page.",
+    "alert.no_digest_entry": "Nothing new since your last visit.",
     "alert.no_user": "You are the only user.",
     "alert.account_unlinked": "Your external account is now dissociated!",
     "alert.account_linked": "Your external account is now linked!",
     "alert.pocket_linked": "Your Pocket account is now linked!",
     "alert.prefs_saved": "Preferences saved!",
+    "alert.notification_email_verification_sent": "A verification link has been sent to the notification email address.",
+    "alert.notification_email_verified": "The notification email address is verified.",
+    "alert.entry_sent_by_email": "The entry has been sent by email.",
     "error.unlink_account_without_password": "You must define a password otherwise you won't be able to login again.",
     "error.duplicate_linked_account": "There is already someone associated with this provider!",
     "error.duplicate_fever_username": "There is already someone else with the same Fever username!",
+    "error.custom_bookmark_invalid": "Invalid custom bookmark service settings: %v.",
+    "error.markdown_invalid": "Invalid Markdown notes settings: %v.",
+    "error.markdown_webdav_url_required": "The WebDAV folder URL is required to save Markdown notes.",
+    "error.hypothesis_token_required": "The Hypothesis API token is required.",
+    "error.hypothesis_group_required": "The Hypothesis group ID is required.",
+    "error.zotero_invalid": "Invalid Zotero settings: %v.",
+    "error.instapaper_invalid": "Unable to connect to Instapaper with these credentials: %v.",
+    "error.ntfy_invalid_topic_url": "The ntfy topic URL is invalid.",
+    "error.linkace_invalid_lists": "Invalid LinkAce lists: %v.",
+    "error.archivebox_credentials_required": "The ArchiveBox server URL and API key are required.",
     "error.pocket_request_token": "Unable to fetch request token from Pocket!",
     "error.pocket_access_token": "Unable to fetch access token from Pocket!",
     "error.category_already_exists": "This category already exists.",
+    "error.category_member_not_found": "This user does not exist.",
+    "error.category_shared_with_you": "This category is shared by %s and cannot be shared again.",
     "error.unable_to_create_category": "Unable to create this category.",
     "error.unable_to_update_category": "Unable to update this category.",
+    "error.unable_to_remove_category": "Unable to remove this category.",
+    "error.category_remove_target": "Choose another category for the feeds.",
     "error.user_already_exists": "This user already exists.",
     "error.unable_to_create_user": "Unable to create this user.",
     "error.unable_to_update_user": "Unable to update this user.",
@@ -580,30 +1226,81 @@ var translations = map[string]string{
     "error.empty_file": "This file is empty.",
     "error.bad_credentials": "Invalid username or password.",
     "error.fields_mandatory": "All fields are mandatory.",
+    "error.no_feed_selected": "No feed selected.",
     "error.title_required": "The title is mandatory.",
     "error.different_passwords": "Passwords are not the same.",
     "error.password_min_length": "The password must have at least 6 characters.",
     "error.settings_mandatory_fields": "The username, theme, language and timezone fields are mandatory.",
     "error.entries_per_page_invalid": "The number of entries per page is not valid.",
+    "error.invalid_youtube_embed_url": "The Invidious or Piped instance URL is not valid.",
+    "error.invalid_auto_star_keywords": "One of the auto-star keywords is not a valid regular expression.",
+    "error.invalid_email_recipients": "One of the email recipients is invalid or there are too many recipients.",
+    "error.invalid_notification_email": "The email address for the notifications is invalid.",
+    "error.invalid_notification_email_token": "This verification link is invalid or has already been used.",
+    "error.invalid_home_page": "The home page is invalid, a search query is required to open a search.",
+    "error.invalid_entry_timezone": "The timezone of the publication dates is invalid.",
+    "error.invalid_timestamp_format": "The format of the dates is invalid.",
+    "error.unable_to_send_email": "Unable to send the email.",
+    "error.too_many_sent_emails": "You have sent too many emails, please try again later.",
     "error.feed_mandatory_fields": "The URL and the category are mandatory.",
+    "error.invalid_encoding": "This character encoding is not supported.",
+    "error.invalid_cron_expression": "Invalid cron expression.",
+    "error.invalid_feed_request_limits": "The request timeout or the maximum size is out of the allowed range.",
+    "error.feed_disabled_after_errors": [
+        "This feed has been disabled automatically after failing for %d week: %s",
+        "This feed has been disabled automatically after failing for %d weeks: %s"
+    ],
     "error.user_mandatory_fields": "The username is mandatory.",
     "error.api_key_already_exists": "This API Key already exists.",
     "error.unable_to_create_api_key": "Unable to create this API Key.",
+    "error.muted_keyword_already_exists": "This keyword is already muted.",
+    "error.blocked_feed_already_exists": "This feed URL or domain is already blocked.",
+    "error.invalid_blocked_feed": "Enter a complete feed URL or a domain name.",
+    "error.unable_to_block_feed": "Unable to block this feed.",
+    "error.unable_to_create_muted_keyword": "Unable to mute this keyword.",
+    "error.invalid_muted_keyword": "Invalid keyword or regular expression.",
+    "error.invalid_expiration_date": "Invalid expiration date.",
+    "error.too_many_sent_entries": "You have sent too many entries, please try again later.",
     "form.feed.label.title": "Title",
+    "form.feed.help.original_title": "Original title: %s",
+    "form.feed.help.previous_feed_url": "The feed URL has been updated automatically. Previous URL: %s",
     "form.feed.label.site_url": "Site URL",
     "form.feed.label.feed_url": "Feed URL",
     "form.feed.label.category": "Category",
     "form.feed.label.crawler": "Fetch original content",
     "form.feed.label.feed_username": "Feed Username",
     "form.feed.label.feed_password": "Feed Password",
+    "form.feed.label.bearer_token": "Feed Bearer Token",
+    "form.feed.label.oauth2_token_url": "OAuth2 Token URL",
+    "form.feed.label.oauth2_client_id": "OAuth2 Client ID",
+    "form.feed.label.oauth2_client_secret": "OAuth2 Client Secret",
+    "form.feed.label.oauth2_scopes": "OAuth2 Scopes (separated by spaces)",
     "form.feed.label.user_agent": "Override Default User Agent",
     "form.feed.label.scraper_rules": "Scraper Rules",
     "form.feed.label.rewrite_rules": "Rewrite Rules",
+    "form.feed.label.encoding": "Character Encoding Override",
     "form.feed.label.ignore_http_cache": "Ignore HTTP cache",
     "form.feed.label.fetch_via_proxy": "Fetch via proxy",
     "form.feed.label.disabled": "Do not refresh this feed",
+    "form.feed.label.open_external_link": "Open entries directly on the original website",
+    "form.feed.label.fetch_scores": "Fetch score and number of comments from Reddit and Hacker News",
+    "form.feed.label.minimum_score": "Ignore entries with a score lower than",
+    "form.feed.label.blocked_authors": "Ignore entries written by these authors (one per line)",
+    "form.feed.label.auto_star": "Star automatically new entries of this feed",
+    "form.feed.label.archive_pages": "Keep a copy of the full web page of new entries",
+    "form.feed.label.hide_globally": "Hide entries in the global unread list",
+    "form.feed.label.notify_errors": "Send a notification when this feed keeps failing",
+    "form.feed.label.cron_expression": "Refresh schedule (cron expression)",
+    "form.feed.help.cron_expression": "Minute, hour, day of month, month and day of week in your timezone. Leave empty to use the default scheduler.",
+    "form.feed.label.request_timeout": "Request Timeout (seconds)",
+    "form.feed.label.max_body_size": "Maximum Size (MB)",
+    "form.feed.help.http_client_limits": "Use 0 to keep the global settings.",
     "form.category.label.title": "Title",
+    "form.category.label.move_feeds_to": "Move the feeds to",
+    "form.category.label.member_username": "Share with user",
     "form.user.label.username": "Username",
+    "form.entry_send.label.username": "Recipient username",
+    "form.entry_send.label.message": "Message (optional)",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Password Confirmation",
     "form.user.label.admin": "Administrator",
@@ -612,10 +1309,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "Theme",
     "form.prefs.label.entry_sorting": "Entry Sorting",
     "form.prefs.label.entries_per_page": "Entries per page",
+    "form.prefs.label.home_page": "Home page",
+    "form.prefs.label.home_page_search": "Search query opened on the home page",
+    "form.prefs.label.entry_timezone": "Publication dates",
+    "form.prefs.select.entry_timezone_local": "In my timezone",
+    "form.prefs.select.entry_timezone_original": "In the timezone of the feed",
+    "form.prefs.select.entry_timezone_both": "In both timezones",
+    "form.prefs.label.timestamp_format": "Dates in the lists",
+    "form.prefs.select.timestamp_relative": "Relative (2 hours ago)",
+    "form.prefs.select.timestamp_absolute": "Absolute (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "Older entries first",
     "form.prefs.select.recent_first": "Recent entries first",
+    "form.prefs.select.home_page_category": "Category",
+    "form.prefs.select.home_page_search": "Search",
     "form.prefs.label.keyboard_shortcuts": "Enable keyboard shortcuts",
     "form.prefs.label.show_reading_time": "Show estimated reading time for articles",
+    "form.prefs.label.mark_read_on_original_link": "Mark entries as read when opening the original link",
+    "form.prefs.label.keep_starred_entries": "Keep starred articles when their feed or category is removed",
+    "form.prefs.label.accept_received_entries": "Accept entries sent by other users",
+    "form.prefs.label.archive_read_days": "Days to keep read articles",
+    "form.prefs.help.archive_read_days": "Leave empty to use the default of %d days, the maximum is %d days.",
+    "form.prefs.label.youtube_embed_url": "Invidious or Piped instance used to play YouTube videos",
+    "form.prefs.label.blocked_authors": "Ignore entries written by these authors in all feeds (one per line)",
+    "form.prefs.label.auto_star_keywords": "Star automatically new entries matching these keywords (one regular expression per line)",
+    "form.prefs.label.auto_star_authors": "Star automatically new entries from these authors (one per line)",
+    "form.prefs.label.email_recipients": "Default recipients when sharing by email (comma separated)",
+    "form.prefs.label.notification_email": "Email address for the notifications",
+    "form.prefs.help.notification_email_unverified": "This address is not verified yet, no notification is sent to it.",
     "form.prefs.label.custom_css": "Custom CSS",
     "form.import.label.file": "OPML file",
     "form.import.label.url": "URL",
@@ -626,12 +1346,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "Save articles to Pinboard",
     "form.integration.pinboard_token": "Pinboard API Token",
     "form.integration.pinboard_tags": "Pinboard Tags",
+    "form.integration.pinboard_category_tag": "Add the category of the feed as tag",
+    "form.integration.pinboard_entry_tags": "Add the tags of the article",
+    "form.integration.pinboard_prompt_tags": "Ask for additional tags when saving an article",
     "form.integration.pinboard_bookmark": "Mark bookmark as unread",
     "form.integration.instapaper_activate": "Save articles to Instapaper",
     "form.integration.instapaper_username": "Instapaper Username",
     "form.integration.instapaper_password": "Instapaper Password",
+    "form.integration.instapaper_folder": "Instapaper Folder",
+    "form.integration.instapaper_folder_unread": "Unread (default)",
+    "form.integration.instapaper_folder_id": "Instapaper Folder ID",
+    "form.integration.instapaper_folder_help": "The folders are listed once the integration is saved, save again to update the list. Leave empty to save articles in the Unread folder.",
     "form.integration.pocket_activate": "Save articles to Pocket",
     "form.integration.pocket_consumer_key": "Pocket Consumer Key",
+    "form.integration.pocket_category_tag": "Add the category of the feed as tag",
     "form.integration.pocket_access_token": "Pocket Access Token",
     "form.integration.pocket_connect_link": "Connect your Pocket account",
     "form.integration.wallabag_activate": "Save articles to Wallabag",
@@ -640,12 +1368,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Wallabag Client Secret",
     "form.integration.wallabag_username": "Wallabag Username",
     "form.integration.wallabag_password": "Wallabag Password",
+    "form.integration.wallabag_tags": "Wallabag Tags (comma separated)",
+    "form.integration.wallabag_archive": "Archive the articles saved to Wallabag",
     "form.integration.nunux_keeper_activate": "Save articles to Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
+    "form.integration.karakeep_activate": "Save articles to Karakeep",
+    "form.integration.karakeep_endpoint": "Karakeep server URL",
+    "form.integration.karakeep_api_key": "Karakeep API key",
+    "form.integration.karakeep_tags": "Karakeep tags (comma separated)",
+    "form.integration.archivebox_activate": "Archive saved and starred articles with ArchiveBox",
+    "form.integration.archivebox_endpoint": "ArchiveBox server URL",
+    "form.integration.archivebox_api_key": "ArchiveBox API key",
+    "form.integration.archivebox_tags": "ArchiveBox tags (comma separated)",
+    "form.integration.archivebox_help": "The link to the snapshot is shown on the article once ArchiveBox has finished archiving the page.",
+    "form.integration.ntfy_activate": "Send push notifications with ntfy",
+    "form.integration.ntfy_topic_url": "ntfy topic URL",
+    "form.integration.ntfy_token": "ntfy access token (optional)",
+    "form.integration.ntfy_help": "Feeds with error notifications enabled are reported to this topic when they keep failing.",
+    "form.integration.espial_activate": "Save articles to Espial",
+    "form.integration.espial_endpoint": "Espial server URL",
+    "form.integration.espial_api_key": "Espial API key",
+    "form.integration.espial_tags": "Espial tags (space separated)",
+    "form.integration.linkace_activate": "Save articles to LinkAce",
+    "form.integration.linkace_endpoint": "LinkAce server URL",
+    "form.integration.linkace_api_key": "LinkAce API token",
+    "form.integration.linkace_tags": "LinkAce tags (comma separated)",
+    "form.integration.linkace_lists": "LinkAce list IDs (comma separated)",
+    "form.integration.linkace_category_tag": "Add the category as tag",
+    "form.integration.linkace_is_private": "Save links as private",
+    "form.integration.custom_bookmark": "Custom bookmark service",
+    "form.integration.custom_bookmark_activate": "Save articles to a custom bookmark service",
+    "form.integration.custom_bookmark_endpoint": "API Endpoint",
+    "form.integration.custom_bookmark_method": "HTTP Method",
+    "form.integration.custom_bookmark_headers": "HTTP Headers (one per line)",
+    "form.integration.custom_bookmark_body": "JSON Body Template",
+    "form.integration.custom_bookmark_body_help": "Placeholders are replaced by JSON values, do not add quotes around them:",
+    "form.integration.markdown": "Markdown notes",
+    "form.integration.markdown_activate": "Save articles as Markdown files, for notes apps like Obsidian",
+    "form.integration.markdown_starred": "Also save starred articles",
+    "form.integration.markdown_webdav_url": "WebDAV folder URL",
+    "form.integration.markdown_webdav_username": "WebDAV Username",
+    "form.integration.markdown_webdav_password": "WebDAV Password",
+    "form.integration.markdown_local_help": "Leave the URL empty to write the files in the folder configured by the administrator.",
+    "form.integration.wayback_activate": "Archive saved and starred articles with the Wayback Machine",
+    "form.integration.wayback_help": "The web page is submitted to the Internet Archive and the link to the copy is shown on the article.",
+    "form.integration.zotero_activate": "Save articles to Zotero",
+    "form.integration.zotero_library_type": "Zotero library",
+    "form.integration.zotero_library_user": "Personal library",
+    "form.integration.zotero_library_group": "Group library",
+    "form.integration.zotero_library_id": "Zotero user or group ID",
+    "form.integration.zotero_api_key": "Zotero API key",
+    "form.integration.zotero_collection_key": "Zotero collection key (optional)",
+    "form.integration.zotero_help": "Articles linking to a DOI are saved as journal articles, the other ones as web pages.",
+    "form.integration.hypothesis_activate": "Publish the annotations of articles to Hypothesis",
+    "form.integration.hypothesis_token": "Hypothesis API token",
+    "form.integration.hypothesis_token_help": "The token can be generated on the developer page of your Hypothesis account.",
+    "form.integration.hypothesis_group": "Hypothesis group ID",
+    "form.integration.hypothesis_group_help": "The annotations are visible to the members of this group, the group __world__ makes them public.",
+    "form.integration.hypothesis_export_help": "All annotations can also be downloaded in the W3C Web Annotation format with the API:",
+    "form.integration.rssbridge_activate": "Use RSS-Bridge when a website doesn't have any feed",
+    "form.integration.rssbridge_url": "RSS-Bridge server URL",
+    "form.integration.routing": "Categories",
+    "form.integration.routing_help": "Services limited to some categories only receive the articles of the selected categories, and nothing when no category is selected.",
+    "form.integration.routing_restrict": "Limit to the selected categories",
     "form.api_key.label.description": "API Key Label",
+    "form.muted_keyword.label.pattern": "Keyword or regular expression",
+    "form.blocked_feed.label.pattern": "Feed URL or domain",
+    "form.blocked_feed.help.pattern": "A complete URL blocks only this feed, a domain name blocks all the feeds of the domain and its subdomains.",
+    "form.blocked_feed.label.reason": "Reason shown to users (optional)",
+    "form.muted_keyword.label.action": "Matching entries",
+    "form.muted_keyword.label.expires_at": "Expiration date (optional)",
+    "form.entry_email.label.recipients": "Recipients (comma separated)",
+    "form.entry_email.label.note": "Personal note (optional)",
+    "form.muted_keyword.select.drop": "Ignore",
+    "form.muted_keyword.select.read": "Mark as read",
     "form.submit.loading": "Loading...",
     "form.submit.saving": "Saving...",
+    "form.submit.sending": "Sending...",
     "time_elapsed.not_yet": "not yet",
     "time_elapsed.yesterday": "yesterday",
     "time_elapsed.now": "just now",
@@ -684,9 +1484,26 @@ var translations = map[string]string{
     "action.save": "Guardar",
     "action.or": "o",
     "action.cancel": "Cancelar",
+    "action.send": "Enviar",
+    "action.close": "Cerrar",
     "action.remove": "Quitar",
+    "action.add_to_queue": "Añadir a la cola",
+    "action.play_queue": "Reproducir la cola",
+    "action.move_up": "Subir",
+    "action.move_down": "Bajar",
+    "action.open": "Abrir",
+    "action.save_search": "Guardar esta búsqueda",
+    "action.unsubscribe_selected": "Unsubscribe from selected feeds",
+    "action.keep_selected": "Keep selected feeds",
     "action.remove_feed": "Quitar esta fuente",
+    "action.use_canonical_feed_url": "Usar esta URL",
     "action.update": "Actualizar",
+    "action.send_verification_link": "Send a verification link",
+    "action.block_feed": "Block",
+    "action.share_category": "Share",
+    "action.hide_category": "Hide",
+    "action.unhide_category": "Show again",
+    "action.move_feeds_and_remove": "Move and remove",
     "action.edit": "Editar",
     "action.download": "Descargar",
     "action.import": "Importar",
@@ -696,36 +1513,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "Registrado como %s",
     "menu.unread": "No leídos",
     "menu.starred": "Marcadores",
+    "menu.trending": "Trending",
+    "menu.podcasts": "Pódcasts",
+    "menu.playback_queue": "Cola de reproducción",
     "menu.history": "Historial",
     "menu.feeds": "Fuentes",
     "menu.categories": "Categorias",
+    "menu.today": "Hoy",
     "menu.settings": "Configuración",
     "menu.logout": "Cerrar sesión",
+    "menu.skip_to_content": "Saltar al contenido",
     "menu.preferences": "Preferencias",
     "menu.integrations": "Integraciones",
     "menu.sessions": "Sesiones",
     "menu.users": "Usuarios",
+    "menu.reports": "Reports",
+    "menu.blocked_feeds": "Blocked Feeds",
     "menu.about": "Acerca de",
     "menu.export": "Exportar",
     "menu.import": "Importar",
     "menu.create_category": "Crear una categoría",
+    "menu.hidden_categories": "Hidden categories",
     "menu.mark_page_as_read": "Marcar esta pagína como leída",
+    "menu.saved_searches": "Búsquedas guardadas",
+    "menu.clear_search_history": "Borrar el historial de búsqueda",
     "menu.mark_all_as_read": "Marcar todos como leídos",
     "menu.show_all_entries": "Mostrar todas las entradas",
+    "menu.show_all_feeds": "Show all feeds",
     "menu.show_only_unread_entries": "Mostrar solo las entradas no leídas",
     "menu.refresh_feed": "Refrescar",
     "menu.refresh_all_feeds": "Refrescar todas las fuentes en el fondo",
     "menu.edit_feed": "Editar",
     "menu.edit_category": "Editar",
     "menu.add_feed": "Agregar suscripción",
+    "menu.feed_recommendations": "Recommendations",
     "menu.add_user": "Agregar usuario",
     "menu.flush_history": "Borrar historial",
     "menu.feed_entries": "Artículos",
     "menu.api_keys": "Claves API",
     "menu.create_api_key": "Crear una nueva clave API",
+    "menu.muted_keywords": "Palabras clave silenciadas",
+    "menu.create_muted_keyword": "Silenciar una nueva palabra clave",
     "menu.shared_entries": "Entradas compartidas",
+    "menu.received_entries": "Received entries",
     "search.label": "Buscar",
     "search.placeholder": "Búsqueda...",
+    "search.placeholder_feed": "Buscar en esta fuente...",
+    "search.placeholder_category": "Buscar en esta categoría...",
     "pagination.next": "Siguiente",
     "pagination.previous": "Anterior",
     "entry.status.unread": "No leído",
@@ -738,19 +1572,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "Sembrado de estrellas",
     "entry.bookmark.toast.off": "Sin estrellas",
     "entry.state.saving": "Guardando...",
+    "entry.state.queued": "En la cola",
     "entry.state.loading": "Cargando...",
+    "entry.state.archiving": "Archiving...",
     "entry.save.label": "Guardar",
     "entry.save.title": "Guardar este articulo",
     "entry.save.completed": "¡Hecho!",
     "entry.save.toast.completed": "Artículo guardado",
+    "entry.save.prompt_tags": "Etiquetas adicionales (separadas por espacios)",
+    "entry.select.label": "Seleccionar",
+    "entry.bulk.selected": "%d seleccionados",
+    "entry.bulk.save": "Guardar los artículos seleccionados",
+    "entry.bulk.save.completed": "Entries sent to third-party services",
+    "entry.bulk.clear": "Borrar la selección",
     "entry.scraper.label": "Obtener contenido original",
+    "entry.snapshot.title": "Open the copy of the web page kept by Miniflux",
+    "entry.snapshot.label": "Offline copy",
+    "entry.snapshot.create.title": "Keep a copy of the full web page, viewable even if the website goes offline",
+    "entry.snapshot.create.label": "Archive page",
+    "entry.snapshot.create.toast": "Web page archived",
     "entry.scraper.title": "Obtener contenido original",
     "entry.scraper.completed": "¡Hecho!",
     "entry.original.label": "Original",
     "entry.comments.label": "Comentarios",
+    "entry.location.title": "Mostrar la ubicación en un mapa",
+    "entry.location.label": "Mapa",
+    "entry.archives.title": "Copies of the web page kept by archiving services",
+    "entry.archives.label": "Archived copy:",
     "entry.comments.title": "Ver comentarios",
+    "entry.print.label": "Imprimir",
+    "entry.print.title": "Imprimir o guardar como PDF",
+    "entry.print.source": "Fuente",
+    "entry.print.url": "URL",
+    "entry.print.date": "Fecha",
+    "entry.email.label": "Correo",
+    "entry.email.title": "Compartir por correo",
+    "email.entry.signature": "Compartido por %s desde %s con Miniflux",
+    "email.feed_error.subject": "The feed \"%s\" keeps failing",
+    "email.feed_error.body": "Miniflux was unable to refresh the feed \"%s\" (%s) %d times in a row.\n\nLast error: %s\n\nYou can review the feed here: %s",
+    "email.notification_email_verification.subject": "Confirm your email address for the Miniflux notifications",
+    "email.notification_email_verification.body": "The Miniflux user \"%s\" wants to receive notifications at this address.\n\nOpen this link to confirm it: %s\n\nIf you didn't ask for it, you can ignore this email.",
     "entry.share.label": "Comparta",
     "entry.share.title": "Comparta este articulo",
+    "entry.send.label": "Send",
+    "entry.send.title": "Send this article to another user",
     "entry.unshare.label": "No compartir",
     "entry.shared_entry.title": "Abrir el enlace público",
     "entry.shared_entry.label": "Compartir",
@@ -758,10 +1623,41 @@ var translations = map[string]string{
         "%d minuto de lectura",
         "%d minutos de lectura"
     ],
+    "entry.video_duration": [
+        "Vídeo de %d minuto",
+        "Vídeo de %d minutos"
+    ],
+    "entry.score": [
+        "%d punto",
+        "%d puntos"
+    ],
+    "entry.comments_count": [
+        "%d comentario",
+        "%d comentarios"
+    ],
+    "entry.duplicates": [
+        "%d similar entry",
+        "%d similar entries"
+    ],
+    "entry.removed_trackers": [
+        "%d rastreador eliminado",
+        "%d rastreadores eliminados"
+    ],
     "page.shared_entries.title": "Entradas compartidas",
+    "page.received_entries.title": "Received Entries",
+    "page.received_entries.sender": "Sent by %s",
+    "page.entry_send.title": "Send to another user",
     "page.unread.title": "No leídos",
     "page.starred.title": "Marcadores",
+    "page.orphaned_saves.title": "Orphaned saves",
+    "page.trending.title": "Trending",
+    "page.trending.feed_count": [
+        "(%d feed)",
+        "(%d feeds)"
+    ],
     "page.categories.title": "Categorias",
+    "page.hidden_categories.title": "Hidden Categories",
+    "page.hidden_categories.help": "Hidden categories keep their subscriptions up to date but their articles are left out of the unread list and its counters.",
     "page.categories.no_feed": "No fuente.",
     "page.categories.entries": "Artículos",
     "page.categories.feeds": "Suscripciones",
@@ -772,25 +1668,89 @@ var translations = map[string]string{
     "page.new_category.title": "Nueva categoría",
     "page.new_user.title": "Nuevo usario",
     "page.edit_category.title": "Editar categoría: %s",
+    "page.remove_category.title": "Remove Category: %s",
+    "page.remove_category.help": [
+        "This category contains %d feed. It will be moved to the category below before the removal.",
+        "This category contains %d feeds. They will be moved to the category below before the removal."
+    ],
+    "page.edit_category.members": "Members",
+    "page.edit_category.member_since": "Member since",
+    "page.edit_category.members_help": "Members see the feeds of this category in their own account with their own read and starred status, once they accept the invitation. Feeds are fetched only once for everyone.",
+    "page.edit_category.shared_by": "This category is shared by %s. New entries are fetched by its owner.",
+    "page.edit_category.invitation_pending": "Invitation pending",
+    "page.categories.invitation": "%s invites you to follow the category “%s”.",
+    "action.accept_invitation": "Accept",
+    "action.decline_invitation": "Decline",
+    "action.leave_shared_category": "Stop following this category",
     "page.edit_user.title": "Editar usuario: %s",
     "page.feeds.title": "Fuentes",
     "page.feeds.last_check": "Última verificación:",
+    "page.feed_entries.volume": [
+        "%d entry during the last %d days",
+        "%d entries during the last %d days"
+    ],
     "page.feeds.unread_counter": "Número de entradas no leídas",
     "page.feeds.read_counter": "Número de entradas leídas",
     "page.feeds.error_count": [
         "%d error",
         "%d errores"
     ],
+    "page.feeds.dead": "Eliminado por el editor",
+    "page.feeds.dormant": "Dormant",
+    "page.feeds.dormant_title": "This feed hasn't published anything for a long time",
+    "page.feeds.move.hint": "Drag feeds to a category, or select several feeds and click a category:",
+    "page.feeds.move.selected": "Move the %d selected feeds to:",
+    "page.feeds.move.loading": "Moving...",
+    "page.unused_feeds.title": "Unread Feeds",
+    "page.unused_feeds.description": [
+        "You haven't read or starred anything from these feeds for %d month.",
+        "You haven't read or starred anything from these feeds for %d months."
+    ],
+    "page.unused_feeds.last_read": "Last read:",
+    "page.unused_feeds.never_read": "Never read",
     "page.history.title": "Historial",
+    "page.today.title": "Hoy",
+    "page.podcasts.title": "Pódcasts",
+    "page.playback_queue.title": "Cola de reproducción",
+    "page.podcasts.total_duration": "Tiempo total de escucha: %s",
+    "page.digest.title": "Since your last visit",
+    "page.digest.since": "Unread articles received since",
+    "page.digest.continue": "Continue reading",
+    "page.digest.top_entries": "Top articles",
+    "page.digest.entry_count": [
+        "%d new article",
+        "%d new articles"
+    ],
     "page.import.title": "Importar",
     "page.search.title": "Resultados de la búsqueda",
+    "page.search.syntax_help": "Afine la búsqueda con title:, author:, feed:, tag:, before:2006-01-02 y after:2006-01-02, use comillas para frases exactas y barras para expresiones regulares, por ejemplo title:/^release/.",
+    "page.search.saved": "Búsqueda guardada",
+    "page.saved_searches.title": "Búsquedas guardadas",
+    "page.saved_searches.recent": "Búsquedas recientes",
+    "page.saved_searches.table.query": "Búsqueda",
+    "page.saved_searches.table.actions": "Acciones",
+    "page.search.scope_feed": "Fuente: %s",
+    "page.search.scope_category": "Categoría: %s",
+    "page.search.all_entries": "Buscar en todos los artículos",
     "page.about.title": "Acerca de",
     "page.about.credits": "Creditos",
     "page.about.version": "Versión:",
     "page.about.build_date": "Fecha de construcción:",
+    "page.about.features": "Features",
+    "page.about.image_proxy": "Image proxy:",
+    "page.about.search_backend": "Search engine:",
+    "page.about.websub": "WebSub:",
+    "page.about.integrations": "Integrations:",
+    "page.about.enabled": "Enabled",
+    "page.about.disabled": "Disabled",
     "page.about.author": "Autor:",
     "page.about.license": "Licencia:",
     "page.add_feed.title": "Nueva suscripción",
+    "page.feed_recommendations.title": "Recommended Feeds",
+    "page.feed_recommendations.table.feed": "Feed",
+    "page.feed_recommendations.table.category": "Category",
+    "page.feed_recommendations.table.subscribers": "Subscribers",
+    "page.feed_recommendations.table.actions": "Actions",
     "page.add_feed.no_category": "No hay categoría. Debe tener al menos una categoría.",
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Encontrar una suscripción",
@@ -801,8 +1761,23 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "Cabecera de LastModified:",
     "page.edit_feed.etag_header": "Cabecera de ETag:",
     "page.edit_feed.no_header": "Sin cabecera",
+    "page.edit_feed.last_fetch_url": "URL final:",
+    "page.edit_feed.last_fetch_content_type": "Encabezado Content-Type:",
+    "page.edit_feed.last_fetch_size": "Tamaño:",
+    "page.edit_feed.bytes": [
+        "%d byte",
+        "%d bytes"
+    ],
     "page.edit_feed.last_parsing_error": "Último error de análisis",
+    "page.edit_feed.canonical_feed_url": "Esta fuente indica una URL diferente: %s",
     "page.entry.attachments": "Archivos adjuntos",
+    "page.entry.comments": "Comments",
+    "page.entry.comments.follow": "Follow the discussion",
+    "page.entry.comments.unfollow": "Stop following",
+    "page.entry.comments.following": [
+        "New comments will be fetched for %d more day.",
+        "New comments will be fetched for %d more days."
+    ],
     "page.keyboard_shortcuts.title": "Atajos de teclado",
     "page.keyboard_shortcuts.subtitle.sections": "Navegación de secciones",
     "page.keyboard_shortcuts.subtitle.items": "Navegación de artículos",
@@ -823,6 +1798,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.open_item": "Abrir el elemento seleccionado",
     "page.keyboard_shortcuts.open_original": "Abrir el enlace original",
     "page.keyboard_shortcuts.open_original_same_window": "Abrir enlace original en la pestaña actual",
+    "page.keyboard_shortcuts.open_original_background": "Abrir el enlace original en una pestaña en segundo plano",
     "page.keyboard_shortcuts.open_comments": "Abrir el enlace de comentarios",
     "page.keyboard_shortcuts.open_comments_same_window": "Abrir enlace de comentarios en la pestaña actual",
     "page.keyboard_shortcuts.toggle_read_status": "Marcar como leído o no leído",
@@ -835,6 +1811,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "Centrarse en el cuadro de búsqueda",
     "page.keyboard_shortcuts.close_modal": "Cerrar el cuadro de diálogo modal",
     "page.users.title": "Usuarios",
+    "page.reports.title": "Reports",
+    "page.reports.database_size": "Database size",
+    "page.reports.storage": "Storage",
+    "page.reports.feeds": "Feeds",
+    "page.reports.entries": "Articles",
+    "page.reports.last_activity": "Last activity",
     "page.users.username": "Nombre de usuario",
     "page.users.never_logged": "Nunca",
     "page.users.admin.yes": "Sí",
@@ -874,30 +1856,92 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Acciones",
     "page.api_keys.never_used": "Nunca usado",
     "page.new_api_key.title": "Nueva clave API",
+    "page.muted_keywords.title": "Palabras clave silenciadas",
+    "page.blocked_feeds.title": "Blocked Feeds",
+    "page.blocked_feeds.help": "Nobody can subscribe to these feeds on this instance. Blocking a domain also blocks all its subdomains. Existing subscriptions are disabled.",
+    "page.blocked_feeds.table.pattern": "Feed URL or domain",
+    "page.blocked_feeds.table.reason": "Reason",
+    "page.blocked_feeds.table.created_at": "Blocked",
+    "page.blocked_feeds.table.actions": "Actions",
+    "page.muted_keywords.help": "Los nuevos artículos de todas sus fuentes que coincidan con una de estas palabras clave o expresiones regulares se ignoran o se marcan como leídos.",
+    "page.muted_keywords.table.pattern": "Palabra clave o expresión regular",
+    "page.muted_keywords.table.action": "Acción",
+    "page.muted_keywords.table.expires_at": "Fecha de caducidad",
+    "page.muted_keywords.table.actions": "Acciones",
+    "page.muted_keywords.never_expires": "Nunca",
+    "page.muted_keywords.expired": "Caducado",
+    "page.new_muted_keyword.title": "Nueva palabra clave silenciada",
+    "page.entry_email.title": "Compartir por correo",
     "alert.no_shared_entry": "No hay entrada compartida.",
+    "alert.blocked_feed_created": [
+        "The feed has been blocked, %d existing subscription has been disabled.",
+        "The feed has been blocked, %d existing subscriptions have been disabled."
+    ],
+    "alert.no_received_entry": "There is no received entry.",
     "alert.no_bookmark": "No hay marcador en este momento.",
+    "alert.no_trending_topic": "There is no trending topic at the moment.",
     "alert.no_category": "No hay categoría.",
+    "alert.no_hidden_category": "There is no hidden category.",
+    "alert.no_category_to_move_feeds": "Create another category first to receive the feeds of this one.",
+    "alert.category_invitation_sent": "An invitation to follow this category has been sent to %s.",
+    "alert.no_feed_recommendation": "There is no recommendation for your categories at the moment.",
     "alert.no_category_entry": "No hay artículos en esta categoria.",
     "alert.no_feed_entry": "No hay artículos para esta fuente.",
     "alert.no_feed": "No tienes suscripciones.",
+    "alert.unused_feeds": [
+        "%d feed hasn't been read for a long time, review it.",
+        "%d feeds haven't been read for a long time, review them."
+    ],
+    "alert.dormant_feeds": [
+        "%d feed hasn't published anything for a long time, review it.",
+        "%d feeds haven't published anything for a long time, review them."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Feeds without new entries for %d day.",
+        "Feeds without new entries for %d days."
+    ],
+    "alert.no_unused_feed": "You read all your subscriptions, nothing to clean up.",
     "alert.no_feed_in_category": "No hay suscripción para esta categoría.",
     "alert.no_history": "No hay historial en este momento.",
     "alert.feed_error": "Hay un problema con esta fuente.",
+    "alert.feed_dead": "Esta fuente ya no está disponible y ya no se actualiza automáticamente",
     "alert.no_search_result": "No hay resultados para esta búsqueda.",
+    "alert.no_saved_search": "No hay ninguna búsqueda guardada.",
     "alert.no_unread_entry": "No hay artículos sin leer.",
+    "alert.no_today_entry": "No se ha publicado ningún artículo hoy.",
+    "alert.no_podcast_entry": "No hay episodios de pódcast sin leer.",
+    "alert.no_queued_entry": "La cola de reproducción está vacía, añada episodios desde la página de pódcasts.",
+    "alert.no_digest_entry": "Nothing new since your last visit.",
     "alert.no_user": "Eres el unico usuario.",
     "alert.account_unlinked": "¡Tu cuenta externa ya está desvinculada!",
     "alert.account_linked": "¡Tu cuenta externa ya está vinculada!",
     "alert.pocket_linked": "¡Tu cuenta de Pocket ya está vinculada!",
     "alert.prefs_saved": "¡Las preferencias se han guardado!",
+    "alert.notification_email_verification_sent": "A verification link has been sent to the notification email address.",
+    "alert.notification_email_verified": "The notification email address is verified.",
+    "alert.entry_sent_by_email": "El artículo ha sido enviado por correo.",
     "error.unlink_account_without_password": "Debe definir una contraseña, de lo contrario no podrá volver a iniciar sesión.",
     "error.duplicate_linked_account": "¡Ya hay alguien asociado a este servicio!",
     "error.duplicate_fever_username": "¡Ya hay alguien con el mismo nombre de usuario de Fever!",
+    "error.custom_bookmark_invalid": "Invalid custom bookmark service settings: %v.",
+    "error.markdown_invalid": "Invalid Markdown notes settings: %v.",
+    "error.markdown_webdav_url_required": "The WebDAV folder URL is required to save Markdown notes.",
+    "error.hypothesis_token_required": "The Hypothesis API token is required.",
+    "error.hypothesis_group_required": "The Hypothesis group ID is required.",
+    "error.zotero_invalid": "Invalid Zotero settings: %v.",
+    "error.instapaper_invalid": "Unable to connect to Instapaper with these credentials: %v.",
+    "error.ntfy_invalid_topic_url": "The ntfy topic URL is invalid.",
+    "error.linkace_invalid_lists": "Invalid LinkAce lists: %v.",
+    "error.archivebox_credentials_required": "The ArchiveBox server URL and API key are required.",
     "error.pocket_request_token": "Incapaz de obtener un token de solicitud de Pocket!",
     "error.pocket_access_token": "Incapaz de obtener un token de acceso de Pocket!",
     "error.category_already_exists": "Esta categoría ya existe.",
+    "error.category_member_not_found": "This user does not exist.",
+    "error.category_shared_with_you": "This category is shared by %s and cannot be shared again.",
     "error.unable_to_create_category": "Incapaz de crear esta categoría.",
     "error.unable_to_update_category": "Incapaz de actualizar esta categoría.",
+    "error.unable_to_remove_category": "Unable to remove this category.",
+    "error.category_remove_target": "Choose another category for the feeds.",
     "error.user_already_exists": "Este usuario ya existe.",
     "error.unable_to_create_user": "Incapaz de crear este usuario.",
     "error.unable_to_update_user": "Incapaz de actualizar este usuario.",
@@ -906,30 +1950,81 @@ var translations = map[string]string{
     "error.empty_file": "Este archivo está vacío.",
     "error.bad_credentials": "Usuario o contraseña no válido.",
     "error.fields_mandatory": "Todos los campos son obligatorios.",
+    "error.no_feed_selected": "No feed selected.",
     "error.title_required": "El título es obligatorio.",
     "error.different_passwords": "Las contraseñas no son las mismas.",
     "error.password_min_length": "La contraseña debería tener al menos 6 caracteres.",
     "error.settings_mandatory_fields": "Los campos de nombre de usuario, tema, idioma y zona horaria son obligatorios.",
     "error.entries_per_page_invalid": "El número de entradas por página no es válido.",
+    "error.invalid_youtube_embed_url": "La URL de la instancia de Invidious o Piped no es válida.",
+    "error.invalid_auto_star_keywords": "One of the auto-star keywords is not a valid regular expression.",
+    "error.invalid_email_recipients": "Uno de los destinatarios no es válido o hay demasiados destinatarios.",
+    "error.invalid_notification_email": "The email address for the notifications is invalid.",
+    "error.invalid_notification_email_token": "This verification link is invalid or has already been used.",
+    "error.invalid_home_page": "La página de inicio no es válida, se requiere una consulta para abrir una búsqueda.",
+    "error.invalid_entry_timezone": "The timezone of the publication dates is invalid.",
+    "error.invalid_timestamp_format": "The format of the dates is invalid.",
+    "error.unable_to_send_email": "No se puede enviar el correo.",
+    "error.too_many_sent_emails": "You have sent too many emails, please try again later.",
     "error.feed_mandatory_fields": "Los campos de URL y categoría son obligatorios.",
+    "error.invalid_encoding": "Esta codificación de caracteres no es compatible.",
+    "error.invalid_cron_expression": "Expresión cron no válida.",
+    "error.invalid_feed_request_limits": "The request timeout or the maximum size is out of the allowed range.",
+    "error.feed_disabled_after_errors": [
+        "Esta fuente se ha desactivado automáticamente tras fallar durante %d semana: %s",
+        "Esta fuente se ha desactivado automáticamente tras fallar durante %d semanas: %s"
+    ],
     "error.user_mandatory_fields": "El nombre de usuario es obligatorio.",
     "error.api_key_already_exists": "Esta clave API ya existe.",
     "error.unable_to_create_api_key": "No se puede crear esta clave API.",
+    "error.muted_keyword_already_exists": "Esta palabra clave ya está silenciada.",
+    "error.blocked_feed_already_exists": "This feed URL or domain is already blocked.",
+    "error.invalid_blocked_feed": "Enter a complete feed URL or a domain name.",
+    "error.unable_to_block_feed": "Unable to block this feed.",
+    "error.unable_to_create_muted_keyword": "No se puede silenciar esta palabra clave.",
+    "error.invalid_muted_keyword": "Palabra clave o expresión regular no válida.",
+    "error.invalid_expiration_date": "Fecha de caducidad no válida.",
+    "error.too_many_sent_entries": "You have sent too many entries, please try again later.",
     "form.feed.label.title": "Título",
+    "form.feed.help.original_title": "Título original: %s",
+    "form.feed.help.previous_feed_url": "La URL de la fuente se actualizó automáticamente. URL anterior: %s",
     "form.feed.label.site_url": "URL del sitio",
     "form.feed.label.feed_url": "URL de la fuente",
     "form.feed.label.category": "Categoría",
     "form.feed.label.crawler": "Obtener contento original",
     "form.feed.label.feed_username": "Nombre de usuario de fuente",
     "form.feed.label.feed_password": "Contraseña de fuente",
+    "form.feed.label.bearer_token": "Token de acceso (Bearer) de la fuente",
+    "form.feed.label.oauth2_token_url": "URL del token OAuth2",
+    "form.feed.label.oauth2_client_id": "ID de cliente OAuth2",
+    "form.feed.label.oauth2_client_secret": "Secreto de cliente OAuth2",
+    "form.feed.label.oauth2_scopes": "Ámbitos OAuth2 (separados por espacios)",
     "form.feed.label.user_agent": "Invalidar el agente de usuario predeterminado",
     "form.feed.label.scraper_rules": "Reglas de raspador",
     "form.feed.label.rewrite_rules": "Reglas de reescribir",
+    "form.feed.label.encoding": "Forzar la codificación de caracteres",
     "form.feed.label.ignore_http_cache": "Ignorar caché HTTP",
     "form.feed.label.fetch_via_proxy": "Buscar a través de proxy",
     "form.feed.label.disabled": "No actualice este feed",
+    "form.feed.label.open_external_link": "Abrir los artículos directamente en el sitio web original",
+    "form.feed.label.fetch_scores": "Obtener la puntuación y el número de comentarios de Reddit y Hacker News",
+    "form.feed.label.minimum_score": "Ignorar los artículos con una puntuación inferior a",
+    "form.feed.label.blocked_authors": "Ignorar los artículos escritos por estos autores (uno por línea)",
+    "form.feed.label.auto_star": "Star automatically new entries of this feed",
+    "form.feed.label.archive_pages": "Keep a copy of the full web page of new entries",
+    "form.feed.label.hide_globally": "Ocultar los artículos en la lista global de no leídos",
+    "form.feed.label.notify_errors": "Send a notification when this feed keeps failing",
+    "form.feed.label.cron_expression": "Programación de actualización (expresión cron)",
+    "form.feed.help.cron_expression": "Minuto, hora, día del mes, mes y día de la semana en su zona horaria. Déjelo vacío para usar la programación predeterminada.",
+    "form.feed.label.request_timeout": "Tiempo de espera de la solicitud (segundos)",
+    "form.feed.label.max_body_size": "Tamaño máximo (MB)",
+    "form.feed.help.http_client_limits": "Use 0 para mantener la configuración global.",
     "form.category.label.title": "Título",
+    "form.category.label.move_feeds_to": "Move the feeds to",
+    "form.category.label.member_username": "Share with user",
     "form.user.label.username": "Nombre de usuario",
+    "form.entry_send.label.username": "Recipient username",
+    "form.entry_send.label.message": "Message (optional)",
     "form.user.label.password": "Contraseña",
     "form.user.label.confirmation": "Confirmación de contraseña",
     "form.user.label.admin": "Administrador",
@@ -938,10 +2033,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "Tema",
     "form.prefs.label.entry_sorting": "Clasificación de entradas",
     "form.prefs.label.entries_per_page": "Entradas por página",
+    "form.prefs.label.home_page": "Página de inicio",
+    "form.prefs.label.home_page_search": "Búsqueda abierta en la página de inicio",
+    "form.prefs.label.entry_timezone": "Publication dates",
+    "form.prefs.select.entry_timezone_local": "In my timezone",
+    "form.prefs.select.entry_timezone_original": "In the timezone of the feed",
+    "form.prefs.select.entry_timezone_both": "In both timezones",
+    "form.prefs.label.timestamp_format": "Dates in the lists",
+    "form.prefs.select.timestamp_relative": "Relative (2 hours ago)",
+    "form.prefs.select.timestamp_absolute": "Absolute (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "Entradas más viejas primero",
     "form.prefs.select.recent_first": "Entradas recientes primero",
+    "form.prefs.select.home_page_category": "Categoría",
+    "form.prefs.select.home_page_search": "Búsqueda",
     "form.prefs.label.keyboard_shortcuts": "Habilitar atajos de teclado",
     "form.prefs.label.show_reading_time": "Mostrar el tiempo estimado de lectura de los artículos",
+    "form.prefs.label.mark_read_on_original_link": "Marcar los artículos como leídos al abrir el enlace original",
+    "form.prefs.label.keep_starred_entries": "Keep starred articles when their feed or category is removed",
+    "form.prefs.label.accept_received_entries": "Accept entries sent by other users",
+    "form.prefs.label.archive_read_days": "Days to keep read articles",
+    "form.prefs.help.archive_read_days": "Leave empty to use the default of %d days, the maximum is %d days.",
+    "form.prefs.label.youtube_embed_url": "Instancia de Invidious o Piped usada para reproducir vídeos de YouTube",
+    "form.prefs.label.blocked_authors": "Ignorar los artículos escritos por estos autores en todas las fuentes (uno por línea)",
+    "form.prefs.label.auto_star_keywords": "Star automatically new entries matching these keywords (one regular expression per line)",
+    "form.prefs.label.auto_star_authors": "Star automatically new entries from these authors (one per line)",
+    "form.prefs.label.email_recipients": "Destinatarios predeterminados al compartir por correo (separados por comas)",
+    "form.prefs.label.notification_email": "Email address for the notifications",
+    "form.prefs.help.notification_email_unverified": "This address is not verified yet, no notification is sent to it.",
     "form.prefs.label.custom_css": "CSS personalizado",
     "form.import.label.file": "Archivo OPML",
     "form.import.label.url": "URL",
@@ -952,12 +2070,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "Guardar artículos a Pinboard",
     "form.integration.pinboard_token": "Token de API de Pinboard",
     "form.integration.pinboard_tags": "Etiquetas de Pinboard",
+    "form.integration.pinboard_category_tag": "Añadir la categoría de la fuente como etiqueta",
+    "form.integration.pinboard_entry_tags": "Añadir las etiquetas del artículo",
+    "form.integration.pinboard_prompt_tags": "Pedir etiquetas adicionales al guardar un artículo",
     "form.integration.pinboard_bookmark": "Marcar marcador como no leído",
     "form.integration.instapaper_activate": "Guardar artículos a Instapaper",
     "form.integration.instapaper_username": "Nombre de usuario de Instapaper",
     "form.integration.instapaper_password": "Contraseña de Instapaper",
+    "form.integration.instapaper_folder": "Carpeta de Instapaper",
+    "form.integration.instapaper_folder_unread": "No leídos (predeterminado)",
+    "form.integration.instapaper_folder_id": "ID de la carpeta de Instapaper",
+    "form.integration.instapaper_folder_help": "The folders are listed once the integration is saved, save again to update the list. Leave empty to save articles in the Unread folder.",
     "form.integration.pocket_activate": "Guardar artículos a Pocket",
     "form.integration.pocket_consumer_key": "Clave del consumidor de Pocket",
+    "form.integration.pocket_category_tag": "Añadir la categoría de la fuente como etiqueta",
     "form.integration.pocket_access_token": "Token de acceso de Pocket",
     "form.integration.pocket_connect_link": "Conectar a la cuenta de Pocket",
     "form.integration.wallabag_activate": "Guardar artículos a Wallabag",
@@ -966,12 +2092,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Secreto cliente de Wallabag",
     "form.integration.wallabag_username": "Nombre de usuario de Wallabag",
     "form.integration.wallabag_password": "Contraseña de Wallabag",
+    "form.integration.wallabag_tags": "Etiquetas de Wallabag (separadas por comas)",
+    "form.integration.wallabag_archive": "Archivar los artículos guardados en Wallabag",
     "form.integration.nunux_keeper_activate": "Guardar artículos a Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Extremo de API de Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Clave de API de Nunux Keeper",
+    "form.integration.karakeep_activate": "Save articles to Karakeep",
+    "form.integration.karakeep_endpoint": "Karakeep server URL",
+    "form.integration.karakeep_api_key": "Karakeep API key",
+    "form.integration.karakeep_tags": "Karakeep tags (comma separated)",
+    "form.integration.archivebox_activate": "Archive saved and starred articles with ArchiveBox",
+    "form.integration.archivebox_endpoint": "ArchiveBox server URL",
+    "form.integration.archivebox_api_key": "ArchiveBox API key",
+    "form.integration.archivebox_tags": "ArchiveBox tags (comma separated)",
+    "form.integration.archivebox_help": "The link to the snapshot is shown on the article once ArchiveBox has finished archiving the page.",
+    "form.integration.ntfy_activate": "Send push notifications with ntfy",
+    "form.integration.ntfy_topic_url": "ntfy topic URL",
+    "form.integration.ntfy_token": "ntfy access token (optional)",
+    "form.integration.ntfy_help": "Feeds with error notifications enabled are reported to this topic when they keep failing.",
+    "form.integration.espial_activate": "Save articles to Espial",
+    "form.integration.espial_endpoint": "Espial server URL",
+    "form.integration.espial_api_key": "Espial API key",
+    "form.integration.espial_tags": "Espial tags (space separated)",
+    "form.integration.linkace_activate": "Save articles to LinkAce",
+    "form.integration.linkace_endpoint": "LinkAce server URL",
+    "form.integration.linkace_api_key": "LinkAce API token",
+    "form.integration.linkace_tags": "LinkAce tags (comma separated)",
+    "form.integration.linkace_lists": "LinkAce list IDs (comma separated)",
+    "form.integration.linkace_category_tag": "Add the category as tag",
+    "form.integration.linkace_is_private": "Save links as private",
+    "form.integration.custom_bookmark": "Servicio de marcadores personalizado",
+    "form.integration.custom_bookmark_activate": "Save articles to a custom bookmark service",
+    "form.integration.custom_bookmark_endpoint": "Acceso API",
+    "form.integration.custom_bookmark_method": "Método HTTP",
+    "form.integration.custom_bookmark_headers": "HTTP Headers (one per line)",
+    "form.integration.custom_bookmark_body": "JSON Body Template",
+    "form.integration.custom_bookmark_body_help": "Placeholders are replaced by JSON values, do not add quotes around them:",
+    "form.integration.markdown": "Markdown notes",
+    "form.integration.markdown_activate": "Save articles as Markdown files, for notes apps like Obsidian",
+    "form.integration.markdown_starred": "Also save starred articles",
+    "form.integration.markdown_webdav_url": "WebDAV folder URL",
+    "form.integration.markdown_webdav_username": "WebDAV Username",
+    "form.integration.markdown_webdav_password": "WebDAV Password",
+    "form.integration.markdown_local_help": "Leave the URL empty to write the files in the folder configured by the administrator.",
+    "form.integration.wayback_activate": "Archive saved and starred articles with the Wayback Machine",
+    "form.integration.wayback_help": "The web page is submitted to the Internet Archive and the link to the copy is shown on the article.",
+    "form.integration.zotero_activate": "Save articles to Zotero",
+    "form.integration.zotero_library_type": "Zotero library",
+    "form.integration.zotero_library_user": "Personal library",
+    "form.integration.zotero_library_group": "Group library",
+    "form.integration.zotero_library_id": "Zotero user or group ID",
+    "form.integration.zotero_api_key": "Zotero API key",
+    "form.integration.zotero_collection_key": "Zotero collection key (optional)",
+    "form.integration.zotero_help": "Articles linking to a DOI are saved as journal articles, the other ones as web pages.",
+    "form.integration.hypothesis_activate": "Publish the annotations of articles to Hypothesis",
+    "form.integration.hypothesis_token": "Hypothesis API token",
+    "form.integration.hypothesis_token_help": "The token can be generated on the developer page of your Hypothesis account.",
+    "form.integration.hypothesis_group": "Hypothesis group ID",
+    "form.integration.hypothesis_group_help": "The annotations are visible to the members of this group, the group __world__ makes them public.",
+    "form.integration.hypothesis_export_help": "All annotations can also be downloaded in the W3C Web Annotation format with the API:",
+    "form.integration.rssbridge_activate": "Usar RSS-Bridge cuando un sitio web no tiene ninguna fuente",
+    "form.integration.rssbridge_url": "URL del servidor RSS-Bridge",
+    "form.integration.routing": "Categorías",
+    "form.integration.routing_help": "Services limited to some categories only receive the articles of the selected categories, and nothing when no category is selected.",
+    "form.integration.routing_restrict": "Limit to the selected categories",
     "form.api_key.label.description": "Etiqueta de clave API",
+    "form.muted_keyword.label.pattern": "Palabra clave o expresión regular",
+    "form.blocked_feed.label.pattern": "Feed URL or domain",
+    "form.blocked_feed.help.pattern": "A complete URL blocks only this feed, a domain name blocks all the feeds of the domain and its subdomains.",
+    "form.blocked_feed.label.reason": "Reason shown to users (optional)",
+    "form.muted_keyword.label.action": "Artículos coincidentes",
+    "form.muted_keyword.label.expires_at": "Fecha de caducidad (opcional)",
+    "form.entry_email.label.recipients": "Destinatarios (separados por comas)",
+    "form.entry_email.label.note": "Nota personal (opcional)",
+    "form.muted_keyword.select.drop": "Ignorar",
+    "form.muted_keyword.select.read": "Marcar como leído",
     "form.submit.loading": "Cargando...",
     "form.submit.saving": "Guardando...",
+    "form.submit.sending": "Enviando...",
     "time_elapsed.not_yet": "todavía no",
     "time_elapsed.yesterday": "ayer",
     "time_elapsed.now": "ahora mismo",
@@ -1010,9 +2208,26 @@ var translations = map[string]string{
     "action.save": "Sauvegarder",
     "action.or": "ou",
     "action.cancel": "annuler",
+    "action.send": "Envoyer",
+    "action.close": "Fermer",
     "action.remove": "Supprimer",
+    "action.add_to_queue": "Ajouter à la file d'attente",
+    "action.play_queue": "Lire la file d'attente",
+    "action.move_up": "Monter",
+    "action.move_down": "Descendre",
+    "action.open": "Ouvrir",
+    "action.save_search": "Enregistrer cette recherche",
+    "action.unsubscribe_selected": "Se désabonner des flux sélectionnés",
+    "action.keep_selected": "Conserver les flux sélectionnés",
     "action.remove_feed": "Supprimer ce flux",
+    "action.use_canonical_feed_url": "Utiliser cette adresse",
     "action.update": "Mettre à jour",
+    "action.send_verification_link": "Envoyer un lien de vérification",
+    "action.block_feed": "Bloquer",
+    "action.share_category": "Partager",
+    "action.hide_category": "Masquer",
+    "action.unhide_category": "Réafficher",
+    "action.move_feeds_and_remove": "Déplacer et supprimer",
     "action.edit": "Modifier",
     "action.download": "Télécharger",
     "action.import": "Importer",
@@ -1022,36 +2237,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "Connecté en tant que %s",
     "menu.unread": "Non lus",
     "menu.starred": "Favoris",
+    "menu.trending": "Tendances",
+    "menu.podcasts": "Podcasts",
+    "menu.playback_queue": "File de lecture",
     "menu.history": "Historique",
     "menu.feeds": "Abonnements",
     "menu.categories": "Catégories",
+    "menu.today": "Aujourd'hui",
     "menu.settings": "Réglages",
     "menu.logout": "Se déconnecter",
+    "menu.skip_to_content": "Aller au contenu",
     "menu.preferences": "Préférences",
     "menu.integrations": "Intégrations",
     "menu.sessions": "Sessions",
     "menu.users": "Utilisateurs",
+    "menu.reports": "Rapports",
+    "menu.blocked_feeds": "Flux bloqués",
     "menu.about": "A propos",
     "menu.export": "Export",
     "menu.import": "Import",
     "menu.create_category": "Créer une catégorie",
+    "menu.hidden_categories": "Catégories masquées",
     "menu.mark_page_as_read": "Marquer cette page comme lu",
+    "menu.saved_searches": "Recherches enregistrées",
+    "menu.clear_search_history": "Effacer l'historique de recherche",
     "menu.mark_all_as_read": "Tout marquer comme lu",
     "menu.show_all_entries": "Afficher tous les articles",
+    "menu.show_all_feeds": "Afficher tous les abonnements",
     "menu.show_only_unread_entries": "Afficher uniquement les articles non lus",
     "menu.refresh_feed": "Actualiser",
     "menu.refresh_all_feeds": "Actualiser les abonnements en arrière-plan",
     "menu.edit_feed": "Modifier",
     "menu.edit_category": "Modifier",
     "menu.add_feed": "Ajouter un abonnement",
+    "menu.feed_recommendations": "Recommandations",
     "menu.add_user": "Ajouter un utilisateur",
     "menu.flush_history": "Supprimer l'historique",
     "menu.feed_entries": "Articles",
     "menu.api_keys": "Clés d'API",
     "menu.create_api_key": "Créer une nouvelle clé d'API",
+    "menu.muted_keywords": "Mots-clés masqués",
+    "menu.create_muted_keyword": "Masquer un nouveau mot-clé",
     "menu.shared_entries": "Articles partagés",
+    "menu.received_entries": "Articles reçus",
     "search.label": "Recherche",
     "search.placeholder": "Recherche...",
+    "search.placeholder_feed": "Rechercher dans cet abonnement...",
+    "search.placeholder_category": "Rechercher dans cette catégorie...",
     "pagination.next": "Suivant",
     "pagination.previous": "Précédent",
     "entry.status.unread": "Non lu",
@@ -1064,19 +2296,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "Ajouté aux favoris",
     "entry.bookmark.toast.off": "Enlevé des favoris",
     "entry.state.saving": "Sauvegarde en cours...",
+    "entry.state.queued": "Dans la file d'attente",
     "entry.state.loading": "Chargement...",
+    "entry.state.archiving": "Archivage...",
     "entry.save.label": "Sauvegarder",
     "entry.save.title": "Sauvegarder cet article",
     "entry.save.completed": "Terminé !",
     "entry.save.toast.completed": "Article sauvegardé",
+    "entry.save.prompt_tags": "Étiquettes supplémentaires (séparées par des espaces)",
+    "entry.select.label": "Sélectionner",
+    "entry.bulk.selected": "%d sélectionné(s)",
+    "entry.bulk.save": "Sauvegarder les articles sélectionnés",
+    "entry.bulk.save.completed": "Articles envoyés aux services tiers",
+    "entry.bulk.clear": "Annuler la sélection",
     "entry.scraper.label": "Original",
+    "entry.snapshot.title": "Ouvrir la copie de la page web conservée par Miniflux",
+    "entry.snapshot.label": "Copie hors-ligne",
+    "entry.snapshot.create.title": "Conserver une copie complète de la page web, consultable même si le site disparaît",
+    "entry.snapshot.create.label": "Archiver la page",
+    "entry.snapshot.create.toast": "Page web archivée",
     "entry.scraper.title": "Récupérer le contenu original",
     "entry.scraper.completed": "Terminé !",
     "entry.original.label": "Original",
     "entry.comments.label": "Commentaires",
+    "entry.location.title": "Afficher le lieu sur une carte",
+    "entry.location.label": "Carte",
+    "entry.archives.title": "Copies de la page web conservées par les services d'archivage",
+    "entry.archives.label": "Copie archivée :",
     "entry.comments.title": "Voir les commentaires",
+    "entry.print.label": "Imprimer",
+    "entry.print.title": "Imprimer ou enregistrer en PDF",
+    "entry.print.source": "Source",
+    "entry.print.url": "URL",
+    "entry.print.date": "Date",
+    "entry.email.label": "E-mail",
+    "entry.email.title": "Partager par e-mail",
+    "email.entry.signature": "Partagé par %s depuis %s avec Miniflux",
+    "email.feed_error.subject": "L'abonnement « %s » échoue de façon répétée",
+    "email.feed_error.body": "Miniflux n'a pas pu actualiser l'abonnement « %s » (%s) %d fois de suite.\n\nDernière erreur : %s\n\nVous pouvez vérifier l'abonnement ici : %s",
+    "email.notification_email_verification.subject": "Confirmez votre adresse email pour les notifications de Miniflux",
+    "email.notification_email_verification.body": "L'utilisateur Miniflux \"%s\" souhaite recevoir des notifications à cette adresse.\n\nOuvrez ce lien pour la confirmer : %s\n\nSi vous n'êtes pas à l'origine de cette demande, vous pouvez ignorer cet email.",
     "entry.share.label": "Partager",
     "entry.share.title": "Partager cet article",
+    "entry.send.label": "Envoyer",
+    "entry.send.title": "Envoyer cet article à un autre utilisateur",
     "entry.unshare.label": "Enlever le partage",
     "entry.shared_entry.title": "Ouvrir le lien public",
     "entry.shared_entry.label": "Partage",
@@ -1084,10 +2347,41 @@ var translations = map[string]string{
         "%d minute de lecture",
         "%d minutes de lecture"
     ],
+    "entry.video_duration": [
+        "Vidéo de %d minute",
+        "Vidéo de %d minutes"
+    ],
+    "entry.score": [
+        "%d point",
+        "%d points"
+    ],
+    "entry.comments_count": [
+        "%d commentaire",
+        "%d commentaires"
+    ],
+    "entry.duplicates": [
+        "%d article similaire",
+        "%d articles similaires"
+    ],
+    "entry.removed_trackers": [
+        "%d traqueur supprimé",
+        "%d traqueurs supprimés"
+    ],
     "page.shared_entries.title": "Articles partagés",
+    "page.received_entries.title": "Articles reçus",
+    "page.received_entries.sender": "Envoyé par %s",
+    "page.entry_send.title": "Envoyer à un autre utilisateur",
     "page.unread.title": "Non lus",
     "page.starred.title": "Favoris",
+    "page.orphaned_saves.title": "Favoris orphelins",
+    "page.trending.title": "Tendances",
+    "page.trending.feed_count": [
+        "(%d abonnement)",
+        "(%d abonnements)"
+    ],
     "page.categories.title": "Catégories",
+    "page.hidden_categories.title": "Catégories masquées",
+    "page.hidden_categories.help": "Les catégories masquées conservent leurs abonnements à jour, mais leurs articles n'apparaissent pas dans la liste des non lus ni dans ses compteurs.",
     "page.categories.no_feed": "Aucun abonnement.",
     "page.categories.entries": "Articles",
     "page.categories.feeds": "Abonnements",
@@ -1098,25 +2392,89 @@ var translations = map[string]string{
     "page.new_category.title": "Nouvelle catégorie",
     "page.new_user.title": "Nouvel Utilisateur",
     "page.edit_category.title": "Modification de la catégorie : %s",
+    "page.remove_category.title": "Supprimer la catégorie : %s",
+    "page.remove_category.help": [
+        "Cette catégorie contient %d abonnement. Il sera déplacé dans la catégorie ci-dessous avant la suppression.",
+        "Cette catégorie contient %d abonnements. Ils seront déplacés dans la catégorie ci-dessous avant la suppression."
+    ],
+    "page.edit_category.members": "Membres",
+    "page.edit_category.member_since": "Membre depuis",
+    "page.edit_category.members_help": "Les membres voient les abonnements de cette catégorie dans leur propre compte avec leur propre statut de lecture et leurs favoris, une fois l'invitation acceptée. Les flux ne sont récupérés qu'une seule fois pour tout le monde.",
+    "page.edit_category.shared_by": "Cette catégorie est partagée par %s. Les nouveaux articles sont récupérés par son propriétaire.",
+    "page.edit_category.invitation_pending": "Invitation en attente",
+    "page.categories.invitation": "%s vous invite à suivre la catégorie « %s ».",
+    "action.accept_invitation": "Accepter",
+    "action.decline_invitation": "Refuser",
+    "action.leave_shared_category": "Ne plus suivre cette catégorie",
     "page.edit_user.title": "Modification de l'utilisateur : %s",
     "page.feeds.title": "Abonnements",
     "page.feeds.last_check": "Dernière vérification :",
+    "page.feed_entries.volume": [
+        "%d article durant les %d derniers jours",
+        "%d articles durant les %d derniers jours"
+    ],
     "page.feeds.unread_counter": "Nombre d'entrées non lues",
     "page.feeds.read_counter": "Nombre d'entrées lues",
     "page.feeds.error_count": [
         "%d erreur",
         "%d erreurs"
     ],
+    "page.feeds.dead": "Supprimé par l'éditeur",
+    "page.feeds.dormant": "Inactif",
+    "page.feeds.dormant_title": "Cet abonnement n'a rien publié depuis longtemps",
+    "page.feeds.move.hint": "Glissez les abonnements vers une catégorie, ou sélectionnez-en plusieurs et cliquez sur une catégorie :",
+    "page.feeds.move.selected": "Déplacer les %d abonnements sélectionnés vers :",
+    "page.feeds.move.loading": "Déplacement...",
+    "page.unused_feeds.title": "Flux non lus",
+    "page.unused_feeds.description": [
+        "Vous n'avez rien lu ni ajouté aux favoris dans ces flux depuis %d mois.",
+        "Vous n'avez rien lu ni ajouté aux favoris dans ces flux depuis %d mois."
+    ],
+    "page.unused_feeds.last_read": "Dernière lecture :",
+    "page.unused_feeds.never_read": "Jamais lu",
     "page.history.title": "Historique",
+    "page.today.title": "Aujourd'hui",
+    "page.podcasts.title": "Podcasts",
+    "page.playback_queue.title": "File de lecture",
+    "page.podcasts.total_duration": "Durée d'écoute totale : %s",
+    "page.digest.title": "Depuis votre dernière visite",
+    "page.digest.since": "Articles non lus reçus depuis",
+    "page.digest.continue": "Continuer la lecture",
+    "page.digest.top_entries": "Articles à la une",
+    "page.digest.entry_count": [
+        "%d nouvel article",
+        "%d nouveaux articles"
+    ],
     "page.import.title": "Importation",
     "page.search.title": "Résultats de la recherche",
+    "page.search.syntax_help": "Affinez la recherche avec title:, author:, feed:, tag:, before:2006-01-02 et after:2006-01-02, utilisez des guillemets pour les expressions exactes et des barres obliques pour les expressions régulières, par exemple title:/^release/.",
+    "page.search.saved": "Recherche enregistrée",
+    "page.saved_searches.title": "Recherches enregistrées",
+    "page.saved_searches.recent": "Recherches récentes",
+    "page.saved_searches.table.query": "Recherche",
+    "page.saved_searches.table.actions": "Actions",
+    "page.search.scope_feed": "Abonnement : %s",
+    "page.search.scope_category": "Catégorie : %s",
+    "page.search.all_entries": "Rechercher dans tous les articles",
     "page.about.title": "A propos",
     "page.about.credits": "Crédits",
     "page.about.version": "Version :",
     "page.about.build_date": "Date de la compilation :",
+    "page.about.features": "Fonctionnalités",
+    "page.about.image_proxy": "Proxy d'images :",
+    "page.about.search_backend": "Moteur de recherche :",
+    "page.about.websub": "WebSub :",
+    "page.about.integrations": "Intégrations :",
+    "page.about.enabled": "Activé",
+    "page.about.disabled": "Désactivé",
     "page.about.author": "Auteur :",
     "page.about.license": "Licence :",
     "page.add_feed.title": "Nouvel Abonnement",
+    "page.feed_recommendations.title": "Abonnements recommandés",
+    "page.feed_recommendations.table.feed": "Abonnement",
+    "page.feed_recommendations.table.category": "Catégorie",
+    "page.feed_recommendations.table.subscribers": "Abonnés",
+    "page.feed_recommendations.table.actions": "Actions",
     "page.add_feed.no_category": "Il n'y a aucune catégorie. Vous devez avoir au moins une catégorie.",
     "page.add_feed.label.url": "Lien",
     "page.add_feed.submit": "Trouver un abonnement",
@@ -1127,8 +2485,23 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "En-tête LastModified :",
     "page.edit_feed.etag_header": "En-tête ETag :",
     "page.edit_feed.no_header": "Aucune",
+    "page.edit_feed.last_fetch_url": "Adresse finale :",
+    "page.edit_feed.last_fetch_content_type": "En-tête Content-Type :",
+    "page.edit_feed.last_fetch_size": "Taille :",
+    "page.edit_feed.bytes": [
+        "%d octet",
+        "%d octets"
+    ],
     "page.edit_feed.last_parsing_error": "Dernière erreur d'analyse",
+    "page.edit_feed.canonical_feed_url": "Ce flux indique une adresse différente : %s",
     "page.entry.attachments": "Pièces Jointes",
+    "page.entry.comments": "Commentaires",
+    "page.entry.comments.follow": "Suivre la discussion",
+    "page.entry.comments.unfollow": "Ne plus suivre",
+    "page.entry.comments.following": [
+        "Les nouveaux commentaires sont récupérés pendant encore %d jour.",
+        "Les nouveaux commentaires sont récupérés pendant encore %d jours."
+    ],
     "page.keyboard_shortcuts.title": "Raccourcis clavier",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguation entre les sections",
     "page.keyboard_shortcuts.subtitle.items": "Naviguation entre les éléments",
@@ -1149,6 +2522,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.open_item": "Ouvrir élément sélectionné",
     "page.keyboard_shortcuts.open_original": "Ouvrir le lien original",
     "page.keyboard_shortcuts.open_original_same_window": "Ouvrir le lien original dans l'onglet en cours",
+    "page.keyboard_shortcuts.open_original_background": "Ouvrir le lien original dans un onglet en arrière-plan",
     "page.keyboard_shortcuts.open_comments": "Ouvrir le lien des commentaires",
     "page.keyboard_shortcuts.open_comments_same_window": "Ouvrir le lien des commentaires dans l'onglet en cours",
     "page.keyboard_shortcuts.toggle_read_status": "Basculer entre lu/non lu",
@@ -1161,6 +2535,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "Mettre le focus sur le champ de recherche",
     "page.keyboard_shortcuts.close_modal": "Fermer la boite de dialogue",
     "page.users.title": "Utilisateurs",
+    "page.reports.title": "Rapports",
+    "page.reports.database_size": "Taille de la base de données",
+    "page.reports.storage": "Stockage",
+    "page.reports.feeds": "Abonnements",
+    "page.reports.entries": "Articles",
+    "page.reports.last_activity": "Dernière activité",
     "page.users.username": "Nom d'utilisateur",
     "page.users.never_logged": "Jamais",
     "page.users.admin.yes": "Oui",
@@ -1200,30 +2580,92 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Actions",
     "page.api_keys.never_used": "Jamais utilisé",
     "page.new_api_key.title": "Nouvelle clé d'API",
+    "page.muted_keywords.title": "Mots-clés masqués",
+    "page.blocked_feeds.title": "Flux bloqués",
+    "page.blocked_feeds.help": "Personne ne peut s'abonner à ces flux sur cette instance. Bloquer un domaine bloque aussi tous ses sous-domaines. Les abonnements existants sont désactivés.",
+    "page.blocked_feeds.table.pattern": "URL du flux ou domaine",
+    "page.blocked_feeds.table.reason": "Raison",
+    "page.blocked_feeds.table.created_at": "Bloqué",
+    "page.blocked_feeds.table.actions": "Actions",
+    "page.muted_keywords.help": "Les nouveaux articles de tous vos flux correspondant à l'un de ces mots-clés ou expressions régulières sont ignorés ou marqués comme lus.",
+    "page.muted_keywords.table.pattern": "Mot-clé ou expression régulière",
+    "page.muted_keywords.table.action": "Action",
+    "page.muted_keywords.table.expires_at": "Date d'expiration",
+    "page.muted_keywords.table.actions": "Actions",
+    "page.muted_keywords.never_expires": "Jamais",
+    "page.muted_keywords.expired": "Expiré",
+    "page.new_muted_keyword.title": "Nouveau mot-clé masqué",
+    "page.entry_email.title": "Partager par e-mail",
     "alert.no_shared_entry": "Il n'y a pas d'article partagé.",
+    "alert.blocked_feed_created": [
+        "Le flux a été bloqué, %d abonnement existant a été désactivé.",
+        "Le flux a été bloqué, %d abonnements existants ont été désactivés."
+    ],
+    "alert.no_received_entry": "Il n'y a aucun article reçu.",
     "alert.no_bookmark": "Il n'y a aucun favoris pour le moment.",
+    "alert.no_trending_topic": "Il n'y a aucune tendance pour le moment.",
     "alert.no_category": "Il n'y a aucune catégorie.",
+    "alert.no_hidden_category": "Il n'y a aucune catégorie masquée.",
+    "alert.no_category_to_move_feeds": "Créez d'abord une autre catégorie pour recevoir les abonnements de celle-ci.",
+    "alert.category_invitation_sent": "Une invitation à suivre cette catégorie a été envoyée à %s.",
+    "alert.no_feed_recommendation": "Il n'y a aucune recommandation pour vos catégories pour le moment.",
     "alert.no_category_entry": "Il n'y a aucun article dans cette catégorie.",
     "alert.no_feed_entry": "Il n'y a aucun article pour cet abonnement.",
     "alert.no_feed": "Vous n'avez aucun abonnement.",
+    "alert.unused_feeds": [
+        "%d flux n'a pas été lu depuis longtemps, vérifiez-le.",
+        "%d flux n'ont pas été lus depuis longtemps, vérifiez-les."
+    ],
+    "alert.dormant_feeds": [
+        "%d abonnement n'a rien publié depuis longtemps, vérifiez-le.",
+        "%d abonnements n'ont rien publié depuis longtemps, vérifiez-les."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Abonnements sans nouvel article depuis %d jour.",
+        "Abonnements sans nouvel article depuis %d jours."
+    ],
+    "alert.no_unused_feed": "Vous lisez tous vos abonnements, rien à nettoyer.",
     "alert.no_feed_in_category": "Il n'y a pas d'abonnement pour cette catégorie.",
     "alert.no_history": "Il n'y a aucun historique pour le moment.",
     "alert.feed_error": "Il y a un problème avec cet abonnement",
+    "alert.feed_dead": "Cet abonnement n'est plus disponible et n'est plus actualisé automatiquement",
     "alert.no_search_result": "Il n'y a aucun résultat pour cette recherche.",
+    "alert.no_saved_search": "Il n'y a aucune recherche enregistrée.",
     "alert.no_unread_entry": "Il n'y a rien de nouveau à lire.",
+    "alert.no_today_entry": "Aucun article n'a été publié aujourd'hui.",
+    "alert.no_podcast_entry": "Il n'y a aucun épisode de podcast non lu.",
+    "alert.no_queued_entry": "La file de lecture est vide, ajoutez des épisodes depuis la page Podcasts.",
+    "alert.no_digest_entry": "Rien de nouveau depuis votre dernière visite.",
     "alert.no_user": "Vous êtes le seul utilisateur.",
     "alert.account_unlinked": "Votre compte externe est maintenant dissocié !",
     "alert.account_linked": "Votre compte externe est maintenant associé !",
     "alert.pocket_linked": "Votre compte Pocket est maintenant connecté !",
     "alert.prefs_saved": "Préférences sauvegardées !",
+    "alert.notification_email_verification_sent": "Un lien de vérification a été envoyé à l'adresse email des notifications.",
+    "alert.notification_email_verified": "L'adresse email des notifications est vérifiée.",
+    "alert.entry_sent_by_email": "L'article a été envoyé par e-mail.",
     "error.unlink_account_without_password": "Vous devez définir un mot de passe sinon vous ne pourrez plus vous connecter par la suite.",
     "error.duplicate_linked_account": "Il y a déjà quelqu'un d'associé avec ce provider !",
     "error.duplicate_fever_username": "Il y a déjà quelqu'un d'autre avec le même nom d'utilisateur Fever !",
+    "error.custom_bookmark_invalid": "Paramètres du service de favoris personnalisé invalides : %v.",
+    "error.markdown_invalid": "Paramètres des notes Markdown invalides : %v.",
+    "error.markdown_webdav_url_required": "L'URL du dossier WebDAV est obligatoire pour sauvegarder les notes Markdown.",
+    "error.hypothesis_token_required": "Le jeton d'API Hypothesis est obligatoire.",
+    "error.hypothesis_group_required": "L'identifiant du groupe Hypothesis est obligatoire.",
+    "error.zotero_invalid": "Paramètres Zotero invalides : %v.",
+    "error.instapaper_invalid": "Impossible de se connecter à Instapaper avec ces identifiants : %v.",
+    "error.ntfy_invalid_topic_url": "L'URL du sujet ntfy est invalide.",
+    "error.linkace_invalid_lists": "Listes LinkAce invalides : %v.",
+    "error.archivebox_credentials_required": "L'URL du serveur ArchiveBox et la clé d'API sont obligatoires.",
     "error.pocket_request_token": "Impossible de récupérer le jeton d'accès depuis Pocket !",
     "error.pocket_access_token": "Impossible de récupérer le jeton d'accès depuis Pocket !",
     "error.category_already_exists": "Cette catégorie existe déjà.",
+    "error.category_member_not_found": "Cet utilisateur n'existe pas.",
+    "error.category_shared_with_you": "Cette catégorie est partagée par %s et ne peut pas être partagée à nouveau.",
     "error.unable_to_create_category": "Impossible de créer cette catégorie.",
     "error.unable_to_update_category": "Impossible de mettre à jour cette catégorie.",
+    "error.unable_to_remove_category": "Impossible de supprimer cette catégorie.",
+    "error.category_remove_target": "Choisissez une autre catégorie pour les abonnements.",
     "error.user_already_exists": "Cet utilisateur existe déjà.",
     "error.unable_to_create_user": "Impossible de créer cet utilisateur.",
     "error.unable_to_update_user": "Impossible de mettre à jour cet utilisateur.",
@@ -1232,30 +2674,81 @@ var translations = map[string]string{
     "error.empty_file": "Ce fichier est vide.",
     "error.bad_credentials": "Mauvais identifiant ou mot de passe.",
     "error.fields_mandatory": "Tous les champs sont obligatoire.",
+    "error.no_feed_selected": "Aucun flux sélectionné.",
     "error.title_required": "Le titre est obligatoire.",
     "error.different_passwords": "Les mots de passe ne sont pas les mêmes.",
     "error.password_min_length": "Vous devez utiliser au moins 6 caractères pour le mot de passe.",
     "error.settings_mandatory_fields": "Le nom d'utilisateur, le thème, la langue et le fuseau horaire sont obligatoire.",
     "error.entries_per_page_invalid": "Le nombre d'entrées par page n'est pas valide.",
+    "error.invalid_youtube_embed_url": "L'URL de l'instance Invidious ou Piped n'est pas valide.",
+    "error.invalid_auto_star_keywords": "Un des mots-clés pour les favoris automatiques n'est pas une expression régulière valide.",
+    "error.invalid_email_recipients": "L'un des destinataires est invalide ou il y a trop de destinataires.",
+    "error.invalid_notification_email": "L'adresse email pour les notifications est invalide.",
+    "error.invalid_notification_email_token": "Ce lien de vérification est invalide ou a déjà été utilisé.",
+    "error.invalid_home_page": "La page d'accueil est invalide, une recherche doit être saisie pour ouvrir une recherche.",
+    "error.invalid_entry_timezone": "Le fuseau horaire des dates de publication est invalide.",
+    "error.invalid_timestamp_format": "Le format des dates est invalide.",
+    "error.unable_to_send_email": "Impossible d'envoyer l'e-mail.",
+    "error.too_many_sent_emails": "Vous avez envoyé trop d'e-mails, veuillez réessayer plus tard.",
     "error.feed_mandatory_fields": "L'URL et la catégorie sont obligatoire.",
+    "error.invalid_encoding": "Cet encodage de caractères n'est pas supporté.",
+    "error.invalid_cron_expression": "Expression cron invalide.",
+    "error.invalid_feed_request_limits": "Le délai de la requête ou la taille maximale est en dehors des limites autorisées.",
+    "error.feed_disabled_after_errors": [
+        "Cet abonnement a été désactivé automatiquement après %d semaine d'erreurs : %s",
+        "Cet abonnement a été désactivé automatiquement après %d semaines d'erreurs : %s"
+    ],
     "error.user_mandatory_fields": "Le nom d'utilisateur est obligatoire.",
     "error.api_key_already_exists": "Cette clé d'API existe déjà.",
     "error.unable_to_create_api_key": "Impossible de créer cette clé d'API.",
+    "error.muted_keyword_already_exists": "Ce mot-clé est déjà masqué.",
+    "error.blocked_feed_already_exists": "Cette URL de flux ou ce domaine est déjà bloqué.",
+    "error.invalid_blocked_feed": "Saisissez une URL de flux complète ou un nom de domaine.",
+    "error.unable_to_block_feed": "Impossible de bloquer ce flux.",
+    "error.unable_to_create_muted_keyword": "Impossible de masquer ce mot-clé.",
+    "error.invalid_muted_keyword": "Mot-clé ou expression régulière invalide.",
+    "error.invalid_expiration_date": "Date d'expiration invalide.",
+    "error.too_many_sent_entries": "Vous avez envoyé trop d'articles, veuillez réessayer plus tard.",
     "form.feed.label.title": "Titre",
+    "form.feed.help.original_title": "Titre original : %s",
+    "form.feed.help.previous_feed_url": "L'adresse du flux a été mise à jour automatiquement. Adresse précédente : %s",
     "form.feed.label.site_url": "URL du site web",
     "form.feed.label.feed_url": "URL du flux",
     "form.feed.label.category": "Catégorie",
     "form.feed.label.crawler": "Récupérer le contenu original",
     "form.feed.label.feed_username": "Nom d'utilisateur du flux",
     "form.feed.label.feed_password": "Mot de passe du flux",
+    "form.feed.label.bearer_token": "Jeton d'accès (Bearer) du flux",
+    "form.feed.label.oauth2_token_url": "URL du jeton OAuth2",
+    "form.feed.label.oauth2_client_id": "Identifiant client OAuth2",
+    "form.feed.label.oauth2_client_secret": "Secret client OAuth2",
+    "form.feed.label.oauth2_scopes": "Portées OAuth2 (séparées par des espaces)",
     "form.feed.label.user_agent": "Remplacer l'agent utilisateur par défaut",
     "form.feed.label.scraper_rules": "Règles pour récupérer le contenu original",
     "form.feed.label.rewrite_rules": "Règles de réécriture",
+    "form.feed.label.encoding": "Forcer l'encodage des caractères",
     "form.feed.label.ignore_http_cache": "Ignore cache HTTP",
     "form.feed.label.fetch_via_proxy": "Récupérer via proxy",
     "form.feed.label.disabled": "Ne pas actualiser ce flux",
+    "form.feed.label.open_external_link": "Ouvrir les articles directement sur le site original",
+    "form.feed.label.fetch_scores": "Récupérer le score et le nombre de commentaires depuis Reddit et Hacker News",
+    "form.feed.label.minimum_score": "Ignorer les articles dont le score est inférieur à",
+    "form.feed.label.blocked_authors": "Ignorer les articles écrits par ces auteurs (un par ligne)",
+    "form.feed.label.auto_star": "Ajouter automatiquement aux favoris les nouveaux articles de ce flux",
+    "form.feed.label.archive_pages": "Conserver une copie complète de la page web des nouveaux articles",
+    "form.feed.label.hide_globally": "Masquer les articles dans la liste globale des non lus",
+    "form.feed.label.notify_errors": "Envoyer une notification quand cet abonnement échoue de façon répétée",
+    "form.feed.label.cron_expression": "Planification de l'actualisation (expression cron)",
+    "form.feed.help.cron_expression": "Minute, heure, jour du mois, mois et jour de la semaine dans votre fuseau horaire. Laissez vide pour utiliser la planification par défaut.",
+    "form.feed.label.request_timeout": "Délai d'attente de la requête (secondes)",
+    "form.feed.label.max_body_size": "Taille maximale (Mo)",
+    "form.feed.help.http_client_limits": "Utilisez 0 pour garder les paramètres globaux.",
     "form.category.label.title": "Titre",
+    "form.category.label.move_feeds_to": "Déplacer les abonnements vers",
+    "form.category.label.member_username": "Partager avec l'utilisateur",
     "form.user.label.username": "Nom d'utilisateur",
+    "form.entry_send.label.username": "Nom d'utilisateur du destinataire",
+    "form.entry_send.label.message": "Message (facultatif)",
     "form.user.label.password": "Mot de passe",
     "form.user.label.confirmation": "Confirmation du mot de passe",
     "form.user.label.admin": "Administrateur",
@@ -1264,10 +2757,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "Thème",
     "form.prefs.label.entry_sorting": "Ordre des éléments",
     "form.prefs.label.entries_per_page": "Entrées par page",
+    "form.prefs.label.home_page": "Page d'accueil",
+    "form.prefs.label.home_page_search": "Recherche ouverte sur la page d'accueil",
+    "form.prefs.label.entry_timezone": "Dates de publication",
+    "form.prefs.select.entry_timezone_local": "Dans mon fuseau horaire",
+    "form.prefs.select.entry_timezone_original": "Dans le fuseau horaire du flux",
+    "form.prefs.select.entry_timezone_both": "Dans les deux fuseaux horaires",
+    "form.prefs.label.timestamp_format": "Dates dans les listes",
+    "form.prefs.select.timestamp_relative": "Relatives (il y a 2 heures)",
+    "form.prefs.select.timestamp_absolute": "Absolues (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "Ancien éléments en premier",
     "form.prefs.select.recent_first": "Éléments récents en premier",
+    "form.prefs.select.home_page_category": "Catégorie",
+    "form.prefs.select.home_page_search": "Recherche",
     "form.prefs.label.keyboard_shortcuts": "Activer les raccourcis clavier",
     "form.prefs.label.show_reading_time": "Afficher le temps de lecture estimé des articles",
+    "form.prefs.label.mark_read_on_original_link": "Marquer les articles comme lus lors de l'ouverture du lien original",
+    "form.prefs.label.keep_starred_entries": "Conserver les articles favoris lorsque leur abonnement ou leur catégorie est supprimé",
+    "form.prefs.label.accept_received_entries": "Accepter les articles envoyés par les autres utilisateurs",
+    "form.prefs.label.archive_read_days": "Nombre de jours de conservation des articles lus",
+    "form.prefs.help.archive_read_days": "Laissez vide pour utiliser la valeur par défaut de %d jours, le maximum est de %d jours.",
+    "form.prefs.label.youtube_embed_url": "Instance Invidious ou Piped utilisée pour lire les vidéos YouTube",
+    "form.prefs.label.blocked_authors": "Ignorer les articles écrits par ces auteurs dans tous les flux (un par ligne)",
+    "form.prefs.label.auto_star_keywords": "Ajouter automatiquement aux favoris les nouveaux articles correspondant à ces mots-clés (une expression régulière par ligne)",
+    "form.prefs.label.auto_star_authors": "Ajouter automatiquement aux favoris les nouveaux articles de ces auteurs (un par ligne)",
+    "form.prefs.label.email_recipients": "Destinataires par défaut lors du partage par e-mail (séparés par des virgules)",
+    "form.prefs.label.notification_email": "Adresse email pour les notifications",
+    "form.prefs.help.notification_email_unverified": "Cette adresse n'est pas encore vérifiée, aucune notification ne lui est envoyée.",
     "form.prefs.label.custom_css": "CSS personnalisé",
     "form.import.label.file": "Fichier OPML",
     "form.import.label.url": "URL",
@@ -1278,12 +2794,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "Sauvegarder les articles vers Pinboard",
     "form.integration.pinboard_token": "Jeton de sécurité de l'API de Pinboard",
     "form.integration.pinboard_tags": "Libellés de Pinboard",
+    "form.integration.pinboard_category_tag": "Ajouter la catégorie du flux comme étiquette",
+    "form.integration.pinboard_entry_tags": "Ajouter les étiquettes de l'article",
+    "form.integration.pinboard_prompt_tags": "Demander des étiquettes supplémentaires lors de la sauvegarde d'un article",
     "form.integration.pinboard_bookmark": "Marquer le lien comme non lu",
     "form.integration.instapaper_activate": "Sauvegarder les articles vers Instapaper",
     "form.integration.instapaper_username": "Nom d'utilisateur Instapaper",
     "form.integration.instapaper_password": "Mot de passe Instapaper",
+    "form.integration.instapaper_folder": "Dossier Instapaper",
+    "form.integration.instapaper_folder_unread": "Non lus (par défaut)",
+    "form.integration.instapaper_folder_id": "Identifiant du dossier Instapaper",
+    "form.integration.instapaper_folder_help": "Les dossiers sont listés une fois l'intégration enregistrée, enregistrez à nouveau pour mettre à jour la liste. Laissez vide pour enregistrer les articles dans le dossier Non lus.",
     "form.integration.pocket_activate": "Sauvegarder les articles vers Pocket",
     "form.integration.pocket_consumer_key": "Clé de l'API de Pocket",
+    "form.integration.pocket_category_tag": "Ajouter la catégorie du flux comme étiquette",
     "form.integration.pocket_access_token": "Jeton d'accès de l'API de Pocket",
     "form.integration.pocket_connect_link": "Connectez votre compte Pocket",
     "form.integration.wallabag_activate": "Sauvegarder les articles vers Wallabag",
@@ -1292,12 +2816,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Clé secrète du client Wallabag",
     "form.integration.wallabag_username": "Nom d'utilisateur de Wallabag",
     "form.integration.wallabag_password": "Mot de passe de Wallabag",
+    "form.integration.wallabag_tags": "Étiquettes Wallabag (séparées par des virgules)",
+    "form.integration.wallabag_archive": "Archiver les articles sauvegardés dans Wallabag",
     "form.integration.nunux_keeper_activate": "Sauvegarder les articles vers Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "URL de l'API de Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Clé d'API de Nunux Keeper",
+    "form.integration.karakeep_activate": "Sauvegarder les articles vers Karakeep",
+    "form.integration.karakeep_endpoint": "URL du serveur Karakeep",
+    "form.integration.karakeep_api_key": "Clé d'API de Karakeep",
+    "form.integration.karakeep_tags": "Étiquettes Karakeep (séparées par des virgules)",
+    "form.integration.archivebox_activate": "Archiver les articles sauvegardés et favoris avec ArchiveBox",
+    "form.integration.archivebox_endpoint": "URL du serveur ArchiveBox",
+    "form.integration.archivebox_api_key": "Clé d'API d'ArchiveBox",
+    "form.integration.archivebox_tags": "Libellés ArchiveBox (séparés par des virgules)",
+    "form.integration.archivebox_help": "Le lien vers la copie est affiché sur l'article une fois que ArchiveBox a terminé l'archivage de la page.",
+    "form.integration.ntfy_activate": "Envoyer des notifications push avec ntfy",
+    "form.integration.ntfy_topic_url": "URL du sujet ntfy",
+    "form.integration.ntfy_token": "Jeton d'accès ntfy (optionnel)",
+    "form.integration.ntfy_help": "Les flux avec les notifications d'erreur activées sont signalés à ce sujet lorsqu'ils échouent de façon répétée.",
+    "form.integration.espial_activate": "Sauvegarder les articles vers Espial",
+    "form.integration.espial_endpoint": "URL du serveur Espial",
+    "form.integration.espial_api_key": "Clé d'API d'Espial",
+    "form.integration.espial_tags": "Étiquettes Espial (séparées par des espaces)",
+    "form.integration.linkace_activate": "Sauvegarder les articles vers LinkAce",
+    "form.integration.linkace_endpoint": "URL du serveur LinkAce",
+    "form.integration.linkace_api_key": "Jeton d'API de LinkAce",
+    "form.integration.linkace_tags": "Étiquettes LinkAce (séparées par des virgules)",
+    "form.integration.linkace_lists": "Identifiants des listes LinkAce (séparés par des virgules)",
+    "form.integration.linkace_category_tag": "Ajouter la catégorie comme étiquette",
+    "form.integration.linkace_is_private": "Sauvegarder les liens en privé",
+    "form.integration.custom_bookmark": "Service de favoris personnalisé",
+    "form.integration.custom_bookmark_activate": "Sauvegarder les articles vers un service de favoris personnalisé",
+    "form.integration.custom_bookmark_endpoint": "URL de l'API",
+    "form.integration.custom_bookmark_method": "Méthode HTTP",
+    "form.integration.custom_bookmark_headers": "En-têtes HTTP (un par ligne)",
+    "form.integration.custom_bookmark_body": "Modèle du corps JSON",
+    "form.integration.custom_bookmark_body_help": "Les variables sont remplacées par des valeurs JSON, n'ajoutez pas de guillemets autour :",
+    "form.integration.markdown": "Notes Markdown",
+    "form.integration.markdown_activate": "Sauvegarder les articles en fichiers Markdown, pour les applications de notes comme Obsidian",
+    "form.integration.markdown_starred": "Sauvegarder aussi les articles favoris",
+    "form.integration.markdown_webdav_url": "URL du dossier WebDAV",
+    "form.integration.markdown_webdav_username": "Nom d'utilisateur WebDAV",
+    "form.integration.markdown_webdav_password": "Mot de passe WebDAV",
+    "form.integration.markdown_local_help": "Laissez l'URL vide pour écrire les fichiers dans le dossier configuré par l'administrateur.",
+    "form.integration.wayback_activate": "Archiver les articles sauvegardés et favoris avec la Wayback Machine",
+    "form.integration.wayback_help": "La page web est envoyée à l'Internet Archive et le lien vers la copie est affiché sur l'article.",
+    "form.integration.zotero_activate": "Sauvegarder les articles dans Zotero",
+    "form.integration.zotero_library_type": "Bibliothèque Zotero",
+    "form.integration.zotero_library_user": "Bibliothèque personnelle",
+    "form.integration.zotero_library_group": "Bibliothèque de groupe",
+    "form.integration.zotero_library_id": "Identifiant de l'utilisateur ou du groupe Zotero",
+    "form.integration.zotero_api_key": "Clé d'API Zotero",
+    "form.integration.zotero_collection_key": "Clé de la collection Zotero (optionnel)",
+    "form.integration.zotero_help": "Les articles qui contiennent un DOI sont sauvegardés comme articles de revue, les autres comme pages web.",
+    "form.integration.hypothesis_activate": "Publier les annotations des articles sur Hypothesis",
+    "form.integration.hypothesis_token": "Jeton d'API Hypothesis",
+    "form.integration.hypothesis_token_help": "Le jeton peut être généré sur la page développeur de votre compte Hypothesis.",
+    "form.integration.hypothesis_group": "Identifiant du groupe Hypothesis",
+    "form.integration.hypothesis_group_help": "Les annotations sont visibles par les membres de ce groupe, le groupe __world__ les rend publiques.",
+    "form.integration.hypothesis_export_help": "Toutes les annotations peuvent aussi être téléchargées au format W3C Web Annotation avec l'API :",
+    "form.integration.rssbridge_activate": "Utiliser RSS-Bridge lorsqu'un site web n'a aucun flux",
+    "form.integration.rssbridge_url": "URL du serveur RSS-Bridge",
+    "form.integration.routing": "Catégories",
+    "form.integration.routing_help": "Les services limités à certaines catégories reçoivent seulement les articles des catégories sélectionnées, et aucun article si aucune catégorie n'est sélectionnée.",
+    "form.integration.routing_restrict": "Limiter aux catégories sélectionnées",
     "form.api_key.label.description": "Libellé de la clé d'API",
+    "form.muted_keyword.label.pattern": "Mot-clé ou expression régulière",
+    "form.blocked_feed.label.pattern": "URL du flux ou domaine",
+    "form.blocked_feed.help.pattern": "Une URL complète bloque uniquement ce flux, un nom de domaine bloque tous les flux du domaine et de ses sous-domaines.",
+    "form.blocked_feed.label.reason": "Raison affichée aux utilisateurs (facultatif)",
+    "form.muted_keyword.label.action": "Articles correspondants",
+    "form.muted_keyword.label.expires_at": "Date d'expiration (facultatif)",
+    "form.entry_email.label.recipients": "Destinataires (séparés par des virgules)",
+    "form.entry_email.label.note": "Note personnelle (facultatif)",
+    "form.muted_keyword.select.drop": "Ignorer",
+    "form.muted_keyword.select.read": "Marquer comme lu",
     "form.submit.loading": "Chargement...",
     "form.submit.saving": "Sauvegarde en cours...",
+    "form.submit.sending": "Envoi...",
     "time_elapsed.not_yet": "pas encore",
     "time_elapsed.yesterday": "hier",
     "time_elapsed.now": "à l'instant",
@@ -1326,6 +2922,7 @@ var translations = map[string]string{
         "il y a %d ans"
     ],
     "This feed already exists (%s)": "Cet abonnement existe déjà (%s)",
+    "This feed has been blocked by the administrator (%s)": "Ce flux a été bloqué par l'administrateur (%s)",
     "Unable to fetch feed (Status Code = %d)": "Impossible de récupérer cet abonnement (code=%d)",
     "Unable to open this link: %v": "Impossible d'ouvrir ce lien : %v",
     "Unable to analyze this page: %v": "Impossible d'analyzer cette page : %v",
@@ -1342,9 +2939,12 @@ var translations = map[string]string{
     "This website is temporarily unreachable (original error: %q)": "Ce site web est temporairement injoignable (erreur originale : %q)",
     "This website is permanently unreachable (original error: %q)": "Ce site web n'est pas joignable de façon permanente (erreur originale : %q)",
     "Website unreachable, the request timed out after %d seconds": "Site web injoignable, la requête à échouée après %d secondes",
+    "This URL scheme is not allowed (%s)": "Ce type d'URL n'est pas autorisé (%s)",
+    "This address is not allowed, it points to a denied network (%s)": "Cette adresse n'est pas autorisée, elle pointe vers un réseau interdit (%s)",
     "You are not authorized to access this resource (invalid username/password)": "Vous n'êtes pas autorisé à accéder à cette ressource (nom d'utilisateur / mot de passe incorrect)",
     "Unable to fetch this resource (Status Code = %d)": "Impossible de récupérer cette ressource (code=%d)",
-    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Page introuvable (404), cet abonnement n'existe plus, vérifiez l'adresse du flux"
+    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Page introuvable (404), cet abonnement n'existe plus, vérifiez l'adresse du flux",
+    "This feed has been permanently removed by its publisher (410 Gone)": "Cet abonnement a été supprimé définitivement par son éditeur (410 Gone)"
 }
 `,
 	"it_IT": `{
@@ -1356,9 +2956,26 @@ var translations = map[string]string{
     "action.save": "Salva",
     "action.or": "o",
     "action.cancel": "cancella",
+    "action.send": "Invia",
+    "action.close": "Chiudi",
     "action.remove": "Elimina",
+    "action.add_to_queue": "Aggiungi alla coda",
+    "action.play_queue": "Riproduci la coda",
+    "action.move_up": "Sposta su",
+    "action.move_down": "Sposta giù",
+    "action.open": "Apri",
+    "action.save_search": "Salva questa ricerca",
+    "action.unsubscribe_selected": "Unsubscribe from selected feeds",
+    "action.keep_selected": "Keep selected feeds",
     "action.remove_feed": "Elimina questo feed",
+    "action.use_canonical_feed_url": "Usa questo URL",
     "action.update": "Aggiorna",
+    "action.send_verification_link": "Send a verification link",
+    "action.block_feed": "Block",
+    "action.share_category": "Share",
+    "action.hide_category": "Hide",
+    "action.unhide_category": "Show again",
+    "action.move_feeds_and_remove": "Move and remove",
     "action.edit": "Modifica",
     "action.download": "Scarica",
     "action.import": "Importa",
@@ -1368,36 +2985,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "Autenticato come %s",
     "menu.unread": "Da leggere",
     "menu.starred": "Preferiti",
+    "menu.trending": "Trending",
+    "menu.podcasts": "Podcast",
+    "menu.playback_queue": "Coda di riproduzione",
     "menu.history": "Cronologia",
     "menu.feeds": "Feed",
     "menu.categories": "Categorie",
+    "menu.today": "Oggi",
     "menu.settings": "Impostazioni",
     "menu.logout": "Esci",
+    "menu.skip_to_content": "Vai al contenuto",
     "menu.preferences": "Preferenze",
     "menu.integrations": "Integrazioni",
     "menu.sessions": "Sessioni",
     "menu.users": "Utenti",
+    "menu.reports": "Reports",
+    "menu.blocked_feeds": "Blocked Feeds",
     "menu.about": "Informazioni",
     "menu.export": "Esporta",
     "menu.import": "Importa",
     "menu.create_category": "Aggiungi una categoria",
+    "menu.hidden_categories": "Hidden categories",
     "menu.mark_page_as_read": "Segna questa pagina come letta",
+    "menu.saved_searches": "Ricerche salvate",
+    "menu.clear_search_history": "Cancella la cronologia delle ricerche",
     "menu.mark_all_as_read": "Segna tutti gli articoli come letti",
     "menu.show_all_entries": "Mostra tutte le voci",
+    "menu.show_all_feeds": "Show all feeds",
     "menu.show_only_unread_entries": "Mostra solo voci non lette",
     "menu.refresh_feed": "Aggiorna",
     "menu.refresh_all_feeds": "Aggiorna tutti i feed in background",
     "menu.edit_feed": "Modifica",
     "menu.edit_category": "Modifica",
     "menu.add_feed": "Aggiungi feed",
+    "menu.feed_recommendations": "Recommendations",
     "menu.add_user": "Aggiungi utente",
     "menu.flush_history": "Svuota la cronologia",
     "menu.feed_entries": "Articoli",
     "menu.api_keys": "Chiavi API",
     "menu.create_api_key": "Crea una nuova chiave API",
+    "menu.muted_keywords": "Parole chiave silenziate",
+    "menu.create_muted_keyword": "Silenzia una nuova parola chiave",
     "menu.shared_entries": "Voci condivise",
+    "menu.received_entries": "Received entries",
     "search.label": "Cerca",
     "search.placeholder": "Cerca...",
+    "search.placeholder_feed": "Cerca in questo feed...",
+    "search.placeholder_category": "Cerca in questa categoria...",
     "pagination.next": "Successivo",
     "pagination.previous": "Precedente",
     "entry.status.unread": "Da leggere",
@@ -1410,19 +3044,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "Ha recitato",
     "entry.bookmark.toast.off": "Non speciali",
     "entry.state.saving": "Salvataggio in corso...",
+    "entry.state.queued": "In coda",
     "entry.state.loading": "Caricamento in corso...",
+    "entry.state.archiving": "Archiving...",
     "entry.save.label": "Salva",
     "entry.save.title": "Salva questo articolo",
     "entry.save.completed": "Fatto!",
     "entry.save.toast.completed": "Articolo salvato",
+    "entry.save.prompt_tags": "Tag aggiuntivi (separati da spazi)",
+    "entry.select.label": "Seleziona",
+    "entry.bulk.selected": "%d selezionati",
+    "entry.bulk.save": "Salva gli articoli selezionati",
+    "entry.bulk.save.completed": "Entries sent to third-party services",
+    "entry.bulk.clear": "Annulla la selezione",
     "entry.scraper.label": "Scarica il contenuto integrale",
+    "entry.snapshot.title": "Open the copy of the web page kept by Miniflux",
+    "entry.snapshot.label": "Offline copy",
+    "entry.snapshot.create.title": "Keep a copy of the full web page, viewable even if the website goes offline",
+    "entry.snapshot.create.label": "Archive page",
+    "entry.snapshot.create.toast": "Web page archived",
     "entry.scraper.title": "Scarica il contenuto integrale",
     "entry.scraper.completed": "Fatto!",
     "entry.original.label": "Originale",
     "entry.comments.label": "Commenti",
+    "entry.location.title": "Mostra il luogo su una mappa",
+    "entry.location.label": "Mappa",
+    "entry.archives.title": "Copies of the web page kept by archiving services",
+    "entry.archives.label": "Archived copy:",
     "entry.comments.title": "Mostra i commenti",
+    "entry.print.label": "Stampa",
+    "entry.print.title": "Stampa o salva come PDF",
+    "entry.print.source": "Fonte",
+    "entry.print.url": "URL",
+    "entry.print.date": "Data",
+    "entry.email.label": "Email",
+    "entry.email.title": "Condividi via email",
+    "email.entry.signature": "Condiviso da %s da %s con Miniflux",
+    "email.feed_error.subject": "The feed \"%s\" keeps failing",
+    "email.feed_error.body": "Miniflux was unable to refresh the feed \"%s\" (%s) %d times in a row.\n\nLast error: %s\n\nYou can review the feed here: %s",
+    "email.notification_email_verification.subject": "Confirm your email address for the Miniflux notifications",
+    "email.notification_email_verification.body": "The Miniflux user \"%s\" wants to receive notifications at this address.\n\nOpen this link to confirm it: %s\n\nIf you didn't ask for it, you can ignore this email.",
     "entry.share.label": "Condividi",
     "entry.share.title": "Condividi questo articolo",
+    "entry.send.label": "Send",
+    "entry.send.title": "Send this article to another user",
     "entry.unshare.label": "Unshare",
     "entry.shared_entry.title": "Apri il link pubblico",
     "entry.shared_entry.label": "Condivisione",
@@ -1430,10 +3095,41 @@ var translations = map[string]string{
         "%d minuto di lettura",
         "%d minuti di lettura"
     ],
+    "entry.video_duration": [
+        "Video di %d minuto",
+        "Video di %d minuti"
+    ],
+    "entry.score": [
+        "%d punto",
+        "%d punti"
+    ],
+    "entry.comments_count": [
+        "%d commento",
+        "%d commenti"
+    ],
+    "entry.duplicates": [
+        "%d similar entry",
+        "%d similar entries"
+    ],
+    "entry.removed_trackers": [
+        "%d tracker rimosso",
+        "%d tracker rimossi"
+    ],
     "page.shared_entries.title": "Voci condivise",
+    "page.received_entries.title": "Received Entries",
+    "page.received_entries.sender": "Sent by %s",
+    "page.entry_send.title": "Send to another user",
     "page.unread.title": "Da leggere",
     "page.starred.title": "Preferiti",
+    "page.orphaned_saves.title": "Orphaned saves",
+    "page.trending.title": "Trending",
+    "page.trending.feed_count": [
+        "(%d feed)",
+        "(%d feeds)"
+    ],
     "page.categories.title": "Categorie",
+    "page.hidden_categories.title": "Hidden Categories",
+    "page.hidden_categories.help": "Hidden categories keep their subscriptions up to date but their articles are left out of the unread list and its counters.",
     "page.categories.no_feed": "Nessun feed.",
     "page.categories.entries": "Articoli",
     "page.categories.feeds": "Abbonamenti",
@@ -1444,25 +3140,89 @@ var translations = map[string]string{
     "page.new_category.title": "Nuova categoria",
     "page.new_user.title": "Nuovo utente",
     "page.edit_category.title": "Modifica categoria: %s",
+    "page.remove_category.title": "Remove Category: %s",
+    "page.remove_category.help": [
+        "This category contains %d feed. It will be moved to the category below before the removal.",
+        "This category contains %d feeds. They will be moved to the category below before the removal."
+    ],
+    "page.edit_category.members": "Members",
+    "page.edit_category.member_since": "Member since",
+    "page.edit_category.members_help": "Members see the feeds of this category in their own account with their own read and starred status, once they accept the invitation. Feeds are fetched only once for everyone.",
+    "page.edit_category.shared_by": "This category is shared by %s. New entries are fetched by its owner.",
+    "page.edit_category.invitation_pending": "Invitation pending",
+    "page.categories.invitation": "%s invites you to follow the category “%s”.",
+    "action.accept_invitation": "Accept",
+    "action.decline_invitation": "Decline",
+    "action.leave_shared_category": "Stop following this category",
     "page.edit_user.title": "Modifica utente: %s",
     "page.feeds.title": "Feed",
     "page.feeds.last_check": "Ultimo controllo:",
+    "page.feed_entries.volume": [
+        "%d entry during the last %d days",
+        "%d entries during the last %d days"
+    ],
     "page.feeds.unread_counter": "Numero di voci non lette",
     "page.feeds.read_counter": "Numero di voci lette",
     "page.feeds.error_count": [
         "%d errore",
         "%d errori"
     ],
+    "page.feeds.dead": "Rimosso dall'editore",
+    "page.feeds.dormant": "Dormant",
+    "page.feeds.dormant_title": "This feed hasn't published anything for a long time",
+    "page.feeds.move.hint": "Drag feeds to a category, or select several feeds and click a category:",
+    "page.feeds.move.selected": "Move the %d selected feeds to:",
+    "page.feeds.move.loading": "Moving...",
+    "page.unused_feeds.title": "Unread Feeds",
+    "page.unused_feeds.description": [
+        "You haven't read or starred anything from these feeds for %d month.",
+        "You haven't read or starred anything from these feeds for %d months."
+    ],
+    "page.unused_feeds.last_read": "Last read:",
+    "page.unused_feeds.never_read": "Never read",
     "page.history.title": "Cronologia",
+    "page.today.title": "Oggi",
+    "page.podcasts.title": "Podcast",
+    "page.playback_queue.title": "Coda di riproduzione",
+    "page.podcasts.total_duration": "Tempo di ascolto totale: %s",
+    "page.digest.title": "Since your last visit",
+    "page.digest.since": "Unread articles received since",
+    "page.digest.continue": "Continue reading",
+    "page.digest.top_entries": "Top articles",
+    "page.digest.entry_count": [
+        "%d new article",
+        "%d new articles"
+    ],
     "page.import.title": "Importa",
     "page.search.title": "Risultati della ricerca",
+    "page.search.syntax_help": "Restringi la ricerca con title:, author:, feed:, tag:, before:2006-01-02 e after:2006-01-02, usa le virgolette per le frasi esatte e le barre per le espressioni regolari, ad esempio title:/^release/.",
+    "page.search.saved": "Ricerca salvata",
+    "page.saved_searches.title": "Ricerche salvate",
+    "page.saved_searches.recent": "Ricerche recenti",
+    "page.saved_searches.table.query": "Ricerca",
+    "page.saved_searches.table.actions": "Azioni",
+    "page.search.scope_feed": "Feed: %s",
+    "page.search.scope_category": "Categoria: %s",
+    "page.search.all_entries": "Cerca in tutti gli articoli",
     "page.about.title": "Informazioni",
     "page.about.credits": "Crediti",
     "page.about.version": "Versione:",
     "page.about.build_date": "Data della build:",
+    "page.about.features": "Features",
+    "page.about.image_proxy": "Image proxy:",
+    "page.about.search_backend": "Search engine:",
+    "page.about.websub": "WebSub:",
+    "page.about.integrations": "Integrations:",
+    "page.about.enabled": "Enabled",
+    "page.about.disabled": "Disabled",
     "page.about.author": "Autore:",
     "page.about.license": "Licenza:",
     "page.add_feed.title": "Nuovo feed",
+    "page.feed_recommendations.title": "Recommended Feeds",
+    "page.feed_recommendations.table.feed": "Feed",
+    "page.feed_recommendations.table.category": "Category",
+    "page.feed_recommendations.table.subscribers": "Subscribers",
+    "page.feed_recommendations.table.actions": "Actions",
     "page.add_feed.no_category": "Nessuna categoria selezionata. Devi scegliere almeno una categoria.",
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Abbonati al feed",
@@ -1473,8 +3233,23 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "Header LastModified:",
     "page.edit_feed.etag_header": "Header ETag:",
     "page.edit_feed.no_header": "Nessun header",
+    "page.edit_feed.last_fetch_url": "URL finale:",
+    "page.edit_feed.last_fetch_content_type": "Intestazione Content-Type:",
+    "page.edit_feed.last_fetch_size": "Dimensione:",
+    "page.edit_feed.bytes": [
+        "%d byte",
+        "%d byte"
+    ],
     "page.edit_feed.last_parsing_error": "Ultimo errore di parsing",
+    "page.edit_feed.canonical_feed_url": "Questo feed indica un URL diverso: %s",
     "page.entry.attachments": "Allegati",
+    "page.entry.comments": "Comments",
+    "page.entry.comments.follow": "Follow the discussion",
+    "page.entry.comments.unfollow": "Stop following",
+    "page.entry.comments.following": [
+        "New comments will be fetched for %d more day.",
+        "New comments will be fetched for %d more days."
+    ],
     "page.keyboard_shortcuts.title": "Scorciatoie da tastiera",
     "page.keyboard_shortcuts.subtitle.sections": "Navigazione sezioni",
     "page.keyboard_shortcuts.subtitle.items": "Navigazione articoli",
@@ -1495,6 +3270,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.open_item": "Apri l'articolo selezionato",
     "page.keyboard_shortcuts.open_original": "Apri la pagina web originale",
     "page.keyboard_shortcuts.open_original_same_window": "Apri il link originale nella scheda corrente",
+    "page.keyboard_shortcuts.open_original_background": "Apri il link originale in una scheda in background",
     "page.keyboard_shortcuts.open_comments": "Apri la pagina web dei commenti",
     "page.keyboard_shortcuts.open_comments_same_window": "Apri il link dei commenti nella scheda corrente",
     "page.keyboard_shortcuts.toggle_read_status": "Cambia lo stato di lettura (letto/da leggere)",
@@ -1507,6 +3283,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "Apri la casella di ricerca",
     "page.keyboard_shortcuts.close_modal": "Chiudi la finestra di dialogo",
     "page.users.title": "Utenti",
+    "page.reports.title": "Reports",
+    "page.reports.database_size": "Database size",
+    "page.reports.storage": "Storage",
+    "page.reports.feeds": "Feeds",
+    "page.reports.entries": "Articles",
+    "page.reports.last_activity": "Last activity",
     "page.users.username": "Nome utente",
     "page.users.never_logged": "Mai",
     "page.users.admin.yes": "Sì",
@@ -1546,30 +3328,92 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Azioni",
     "page.api_keys.never_used": "Mai usato",
     "page.new_api_key.title": "Nuova chiave API",
+    "page.muted_keywords.title": "Parole chiave silenziate",
+    "page.blocked_feeds.title": "Blocked Feeds",
+    "page.blocked_feeds.help": "Nobody can subscribe to these feeds on this instance. Blocking a domain also blocks all its subdomains. Existing subscriptions are disabled.",
+    "page.blocked_feeds.table.pattern": "Feed URL or domain",
+    "page.blocked_feeds.table.reason": "Reason",
+    "page.blocked_feeds.table.created_at": "Blocked",
+    "page.blocked_feeds.table.actions": "Actions",
+    "page.muted_keywords.help": "I nuovi articoli di tutti i tuoi feed che corrispondono a una di queste parole chiave o espressioni regolari vengono ignorati o segnati come letti.",
+    "page.muted_keywords.table.pattern": "Parola chiave o espressione regolare",
+    "page.muted_keywords.table.action": "Azione",
+    "page.muted_keywords.table.expires_at": "Data di scadenza",
+    "page.muted_keywords.table.actions": "Azioni",
+    "page.muted_keywords.never_expires": "Mai",
+    "page.muted_keywords.expired": "Scaduto",
+    "page.new_muted_keyword.title": "Nuova parola chiave silenziata",
+    "page.entry_email.title": "Condividi via email",
     "alert.no_shared_entry": "Non ci sono voci condivise.",
+    "alert.blocked_feed_created": [
+        "The feed has been blocked, %d existing subscription has been disabled.",
+        "The feed has been blocked, %d existing subscriptions have been disabled."
+    ],
+    "alert.no_received_entry": "There is no received entry.",
     "alert.no_bookmark": "Nessun preferito disponibile.",
+    "alert.no_trending_topic": "There is no trending topic at the moment.",
     "alert.no_category": "Nessuna categoria disponibile.",
+    "alert.no_hidden_category": "There is no hidden category.",
+    "alert.no_category_to_move_feeds": "Create another category first to receive the feeds of this one.",
+    "alert.category_invitation_sent": "An invitation to follow this category has been sent to %s.",
+    "alert.no_feed_recommendation": "There is no recommendation for your categories at the moment.",
     "alert.no_category_entry": "Questa categoria non contiene alcun articolo.",
     "alert.no_feed_entry": "Questo feed non contiene alcun articolo.",
     "alert.no_feed": "Nessun feed disponibile.",
+    "alert.unused_feeds": [
+        "%d feed hasn't been read for a long time, review it.",
+        "%d feeds haven't been read for a long time, review them."
+    ],
+    "alert.dormant_feeds": [
+        "%d feed hasn't published anything for a long time, review it.",
+        "%d feeds haven't published anything for a long time, review them."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Feeds without new entries for %d day.",
+        "Feeds without new entries for %d days."
+    ],
+    "alert.no_unused_feed": "You read all your subscriptions, nothing to clean up.",
     "alert.no_feed_in_category": "Non esiste un abbonamento per questa categoria.",
     "alert.no_history": "La tua cronologia al momento è vuota.",
     "alert.feed_error": "Sembra ci sia un problema con questo feed",
+    "alert.feed_dead": "Questo feed non è più disponibile e non viene più aggiornato automaticamente",
     "alert.no_search_result": "La ricerca non ha prodotto risultati.",
+    "alert.no_saved_search": "Non ci sono ricerche salvate.",
     "alert.no_unread_entry": "Nessun articolo da leggere.",
+    "alert.no_today_entry": "Nessun articolo è stato pubblicato oggi.",
+    "alert.no_podcast_entry": "Non ci sono episodi di podcast da leggere.",
+    "alert.no_queued_entry": "La coda di riproduzione è vuota, aggiungi episodi dalla pagina Podcast.",
+    "alert.no_digest_entry": "Nothing new since your last visit.",
     "alert.no_user": "Tu sei l'unico utente.",
     "alert.account_unlinked": "Il tuo account esterno ora è scollegato!",
     "alert.account_linked": "Il tuo account esterno ora è collegato!",
     "alert.pocket_linked": "Il tuo account Pocket ora è collegato!",
     "alert.prefs_saved": "Preferenze salvate!",
+    "alert.notification_email_verification_sent": "A verification link has been sent to the notification email address.",
+    "alert.notification_email_verified": "The notification email address is verified.",
+    "alert.entry_sent_by_email": "L'articolo è stato inviato via email.",
     "error.unlink_account_without_password": "Devi scegliere una password altrimenti la prossima volta non riuscirai ad accedere.",
     "error.duplicate_linked_account": "Esiste già un account configurato per questo servizio!",
     "error.duplicate_fever_username": "Esiste già un account Fever con lo stesso nome utente!",
+    "error.custom_bookmark_invalid": "Invalid custom bookmark service settings: %v.",
+    "error.markdown_invalid": "Invalid Markdown notes settings: %v.",
+    "error.markdown_webdav_url_required": "The WebDAV folder URL is required to save Markdown notes.",
+    "error.hypothesis_token_required": "The Hypothesis API token is required.",
+    "error.hypothesis_group_required": "The Hypothesis group ID is required.",
+    "error.zotero_invalid": "Invalid Zotero settings: %v.",
+    "error.instapaper_invalid": "Unable to connect to Instapaper with these credentials: %v.",
+    "error.ntfy_invalid_topic_url": "The ntfy topic URL is invalid.",
+    "error.linkace_invalid_lists": "Invalid LinkAce lists: %v.",
+    "error.archivebox_credentials_required": "The ArchiveBox server URL and API key are required.",
     "error.pocket_request_token": "Non sono riuscito ad ottenere il request token da Pocket!",
     "error.pocket_access_token": "Non sono riuscito ad ottenere l'access token da Pocket!",
     "error.category_already_exists": "Questa categoria esiste già.",
+    "error.category_member_not_found": "This user does not exist.",
+    "error.category_shared_with_you": "This category is shared by %s and cannot be shared again.",
     "error.unable_to_create_category": "Non sono riuscito ad aggiungere questa categoria.",
     "error.unable_to_update_category": "Non sono riuscito ad aggiornare questa categoria.",
+    "error.unable_to_remove_category": "Unable to remove this category.",
+    "error.category_remove_target": "Choose another category for the feeds.",
     "error.user_already_exists": "Questo utente esiste già.",
     "error.unable_to_create_user": "Non sono riuscito ad aggiungere questo user.",
     "error.unable_to_update_user": "Non sono riuscito ad aggiornare questo utente.",
@@ -1578,30 +3422,81 @@ var translations = map[string]string{
     "error.empty_file": "Questo file è vuoto.",
     "error.bad_credentials": "Nome utente o password non validi.",
     "error.fields_mandatory": "Tutti i campi sono obbligatori.",
+    "error.no_feed_selected": "No feed selected.",
     "error.title_required": "Il titolo è obbligatorio.",
     "error.different_passwords": "Le password non coincidono.",
     "error.password_min_length": "La password deve contenere almeno 6 caratteri.",
     "error.settings_mandatory_fields": "Il nome utente, il tema, la lingua ed il fuso orario sono campi obbligatori.",
     "error.entries_per_page_invalid": "Il numero di articoli per pagina non è valido.",
+    "error.invalid_youtube_embed_url": "L'URL dell'istanza Invidious o Piped non è valido.",
+    "error.invalid_auto_star_keywords": "One of the auto-star keywords is not a valid regular expression.",
+    "error.invalid_email_recipients": "Uno dei destinatari non è valido o ci sono troppi destinatari.",
+    "error.invalid_notification_email": "The email address for the notifications is invalid.",
+    "error.invalid_notification_email_token": "This verification link is invalid or has already been used.",
+    "error.invalid_home_page": "La pagina iniziale non è valida, è necessaria una ricerca per aprire una ricerca.",
+    "error.invalid_entry_timezone": "The timezone of the publication dates is invalid.",
+    "error.invalid_timestamp_format": "The format of the dates is invalid.",
+    "error.unable_to_send_email": "Impossibile inviare l'email.",
+    "error.too_many_sent_emails": "You have sent too many emails, please try again later.",
     "error.feed_mandatory_fields": "L'URL e la categoria sono obbligatori.",
+    "error.invalid_encoding": "Questa codifica dei caratteri non è supportata.",
+    "error.invalid_cron_expression": "Espressione cron non valida.",
+    "error.invalid_feed_request_limits": "The request timeout or the maximum size is out of the allowed range.",
+    "error.feed_disabled_after_errors": [
+        "Questo feed è stato disattivato automaticamente dopo %d settimana di errori: %s",
+        "Questo feed è stato disattivato automaticamente dopo %d settimane di errori: %s"
+    ],
     "error.user_mandatory_fields": "Il nome utente è obbligatorio.",
     "error.api_key_already_exists": "Questa chiave API esiste già.",
     "error.unable_to_create_api_key": "Impossibile creare questa chiave API.",
+    "error.muted_keyword_already_exists": "Questa parola chiave è già silenziata.",
+    "error.blocked_feed_already_exists": "This feed URL or domain is already blocked.",
+    "error.invalid_blocked_feed": "Enter a complete feed URL or a domain name.",
+    "error.unable_to_block_feed": "Unable to block this feed.",
+    "error.unable_to_create_muted_keyword": "Impossibile silenziare questa parola chiave.",
+    "error.invalid_muted_keyword": "Parola chiave o espressione regolare non valida.",
+    "error.invalid_expiration_date": "Data di scadenza non valida.",
+    "error.too_many_sent_entries": "You have sent too many entries, please try again later.",
     "form.feed.label.title": "Titolo",
+    "form.feed.help.original_title": "Titolo originale: %s",
+    "form.feed.help.previous_feed_url": "L'URL del feed è stato aggiornato automaticamente. URL precedente: %s",
     "form.feed.label.site_url": "URL del sito",
     "form.feed.label.feed_url": "URL del feed",
     "form.feed.label.category": "Categoria",
     "form.feed.label.crawler": "Scarica il contenuto integrale",
     "form.feed.label.feed_username": "Nome utente del feed",
     "form.feed.label.feed_password": "Password del feed",
+    "form.feed.label.bearer_token": "Token di accesso (Bearer) del feed",
+    "form.feed.label.oauth2_token_url": "URL del token OAuth2",
+    "form.feed.label.oauth2_client_id": "ID client OAuth2",
+    "form.feed.label.oauth2_client_secret": "Segreto client OAuth2",
+    "form.feed.label.oauth2_scopes": "Ambiti OAuth2 (separati da spazi)",
     "form.feed.label.user_agent": "Usa user agent personalizzato",
     "form.feed.label.scraper_rules": "Regole di estrazione del contenuto",
     "form.feed.label.rewrite_rules": "Regole di impaginazione del contenuto",
+    "form.feed.label.encoding": "Forza la codifica dei caratteri",
     "form.feed.label.ignore_http_cache": "Ignora cache HTTP",
     "form.feed.label.fetch_via_proxy": "Recuperare tramite proxy",
     "form.feed.label.disabled": "Non aggiornare questo feed",
+    "form.feed.label.open_external_link": "Apri gli articoli direttamente sul sito originale",
+    "form.feed.label.fetch_scores": "Recupera il punteggio e il numero di commenti da Reddit e Hacker News",
+    "form.feed.label.minimum_score": "Ignora gli articoli con un punteggio inferiore a",
+    "form.feed.label.blocked_authors": "Ignora gli articoli scritti da questi autori (uno per riga)",
+    "form.feed.label.auto_star": "Star automatically new entries of this feed",
+    "form.feed.label.archive_pages": "Keep a copy of the full web page of new entries",
+    "form.feed.label.hide_globally": "Nascondi gli articoli nella lista globale dei non letti",
+    "form.feed.label.notify_errors": "Send a notification when this feed keeps failing",
+    "form.feed.label.cron_expression": "Pianificazione dell'aggiornamento (espressione cron)",
+    "form.feed.help.cron_expression": "Minuto, ora, giorno del mese, mese e giorno della settimana nel tuo fuso orario. Lascia vuoto per usare la pianificazione predefinita.",
+    "form.feed.label.request_timeout": "Timeout della richiesta (secondi)",
+    "form.feed.label.max_body_size": "Dimensione massima (MB)",
+    "form.feed.help.http_client_limits": "Usa 0 per mantenere le impostazioni globali.",
     "form.category.label.title": "Titolo",
+    "form.category.label.move_feeds_to": "Move the feeds to",
+    "form.category.label.member_username": "Share with user",
     "form.user.label.username": "Nome utente",
+    "form.entry_send.label.username": "Recipient username",
+    "form.entry_send.label.message": "Message (optional)",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Conferma password",
     "form.user.label.admin": "Amministratore",
@@ -1610,10 +3505,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "Tema",
     "form.prefs.label.entry_sorting": "Ordinamento articoli",
     "form.prefs.label.entries_per_page": "Articoli per pagina",
+    "form.prefs.label.home_page": "Pagina iniziale",
+    "form.prefs.label.home_page_search": "Ricerca aperta nella pagina iniziale",
+    "form.prefs.label.entry_timezone": "Publication dates",
+    "form.prefs.select.entry_timezone_local": "In my timezone",
+    "form.prefs.select.entry_timezone_original": "In the timezone of the feed",
+    "form.prefs.select.entry_timezone_both": "In both timezones",
+    "form.prefs.label.timestamp_format": "Dates in the lists",
+    "form.prefs.select.timestamp_relative": "Relative (2 hours ago)",
+    "form.prefs.select.timestamp_absolute": "Absolute (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "Prima i più vecchi",
     "form.prefs.select.recent_first": "Prima i più recenti",
+    "form.prefs.select.home_page_category": "Categoria",
+    "form.prefs.select.home_page_search": "Ricerca",
     "form.prefs.label.keyboard_shortcuts": "Abilita le scorciatoie da tastiera",
     "form.prefs.label.show_reading_time": "Mostra il tempo di lettura stimato per gli articoli",
+    "form.prefs.label.mark_read_on_original_link": "Segna gli articoli come letti quando si apre il link originale",
+    "form.prefs.label.keep_starred_entries": "Keep starred articles when their feed or category is removed",
+    "form.prefs.label.accept_received_entries": "Accept entries sent by other users",
+    "form.prefs.label.archive_read_days": "Days to keep read articles",
+    "form.prefs.help.archive_read_days": "Leave empty to use the default of %d days, the maximum is %d days.",
+    "form.prefs.label.youtube_embed_url": "Istanza Invidious o Piped usata per riprodurre i video di YouTube",
+    "form.prefs.label.blocked_authors": "Ignora gli articoli scritti da questi autori in tutti i feed (uno per riga)",
+    "form.prefs.label.auto_star_keywords": "Star automatically new entries matching these keywords (one regular expression per line)",
+    "form.prefs.label.auto_star_authors": "Star automatically new entries from these authors (one per line)",
+    "form.prefs.label.email_recipients": "Destinatari predefiniti per la condivisione via email (separati da virgole)",
+    "form.prefs.label.notification_email": "Email address for the notifications",
+    "form.prefs.help.notification_email_unverified": "This address is not verified yet, no notification is sent to it.",
     "form.prefs.label.custom_css": "CSS personalizzati",
     "form.import.label.file": "File OPML",
     "form.import.label.url": "URL",
@@ -1624,12 +3542,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "Salva gli articoli su Pinboard",
     "form.integration.pinboard_token": "Token dell'API di Pinboard",
     "form.integration.pinboard_tags": "Tag di Pinboard",
+    "form.integration.pinboard_category_tag": "Aggiungi la categoria del feed come tag",
+    "form.integration.pinboard_entry_tags": "Aggiungi i tag dell'articolo",
+    "form.integration.pinboard_prompt_tags": "Chiedi tag aggiuntivi quando si salva un articolo",
     "form.integration.pinboard_bookmark": "Segna i preferiti come non letti",
     "form.integration.instapaper_activate": "Salva gli articoli su Instapaper",
     "form.integration.instapaper_username": "Nome utente dell'account Instapaper",
     "form.integration.instapaper_password": "Password dell'account Instapaper",
+    "form.integration.instapaper_folder": "Cartella di Instapaper",
+    "form.integration.instapaper_folder_unread": "Da leggere (predefinito)",
+    "form.integration.instapaper_folder_id": "ID della cartella di Instapaper",
+    "form.integration.instapaper_folder_help": "The folders are listed once the integration is saved, save again to update the list. Leave empty to save articles in the Unread folder.",
     "form.integration.pocket_activate": "Salva gli articoli su Pocket",
     "form.integration.pocket_consumer_key": "Consumer key dell'account Pocket",
+    "form.integration.pocket_category_tag": "Aggiungi la categoria del feed come tag",
     "form.integration.pocket_access_token": "Access token dell'account Pocket",
     "form.integration.pocket_connect_link": "Collega il tuo account Pocket",
     "form.integration.wallabag_activate": "Salva gli articoli su Wallabag",
@@ -1638,12 +3564,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Client secret dell'account Wallabag",
     "form.integration.wallabag_username": "Nome utente dell'account Wallabag",
     "form.integration.wallabag_password": "Password dell'account Wallabag",
+    "form.integration.wallabag_tags": "Tag di Wallabag (separati da virgole)",
+    "form.integration.wallabag_archive": "Archivia gli articoli salvati su Wallabag",
     "form.integration.nunux_keeper_activate": "Salva gli articoli su Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Endpoint dell'API di Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "API key dell'account Nunux Keeper",
+    "form.integration.karakeep_activate": "Save articles to Karakeep",
+    "form.integration.karakeep_endpoint": "Karakeep server URL",
+    "form.integration.karakeep_api_key": "Karakeep API key",
+    "form.integration.karakeep_tags": "Karakeep tags (comma separated)",
+    "form.integration.archivebox_activate": "Archive saved and starred articles with ArchiveBox",
+    "form.integration.archivebox_endpoint": "ArchiveBox server URL",
+    "form.integration.archivebox_api_key": "ArchiveBox API key",
+    "form.integration.archivebox_tags": "ArchiveBox tags (comma separated)",
+    "form.integration.archivebox_help": "The link to the snapshot is shown on the article once ArchiveBox has finished archiving the page.",
+    "form.integration.ntfy_activate": "Send push notifications with ntfy",
+    "form.integration.ntfy_topic_url": "ntfy topic URL",
+    "form.integration.ntfy_token": "ntfy access token (optional)",
+    "form.integration.ntfy_help": "Feeds with error notifications enabled are reported to this topic when they keep failing.",
+    "form.integration.espial_activate": "Save articles to Espial",
+    "form.integration.espial_endpoint": "Espial server URL",
+    "form.integration.espial_api_key": "Espial API key",
+    "form.integration.espial_tags": "Espial tags (space separated)",
+    "form.integration.linkace_activate": "Save articles to LinkAce",
+    "form.integration.linkace_endpoint": "LinkAce server URL",
+    "form.integration.linkace_api_key": "LinkAce API token",
+    "form.integration.linkace_tags": "LinkAce tags (comma separated)",
+    "form.integration.linkace_lists": "LinkAce list IDs (comma separated)",
+    "form.integration.linkace_category_tag": "Add the category as tag",
+    "form.integration.linkace_is_private": "Save links as private",
+    "form.integration.custom_bookmark": "Servizio di segnalibri personalizzato",
+    "form.integration.custom_bookmark_activate": "Save articles to a custom bookmark service",
+    "form.integration.custom_bookmark_endpoint": "Endpoint dell'API",
+    "form.integration.custom_bookmark_method": "Metodo HTTP",
+    "form.integration.custom_bookmark_headers": "HTTP Headers (one per line)",
+    "form.integration.custom_bookmark_body": "JSON Body Template",
+    "form.integration.custom_bookmark_body_help": "Placeholders are replaced by JSON values, do not add quotes around them:",
+    "form.integration.markdown": "Markdown notes",
+    "form.integration.markdown_activate": "Save articles as Markdown files, for notes apps like Obsidian",
+    "form.integration.markdown_starred": "Also save starred articles",
+    "form.integration.markdown_webdav_url": "WebDAV folder URL",
+    "form.integration.markdown_webdav_username": "WebDAV Username",
+    "form.integration.markdown_webdav_password": "WebDAV Password",
+    "form.integration.markdown_local_help": "Leave the URL empty to write the files in the folder configured by the administrator.",
+    "form.integration.wayback_activate": "Archive saved and starred articles with the Wayback Machine",
+    "form.integration.wayback_help": "The web page is submitted to the Internet Archive and the link to the copy is shown on the article.",
+    "form.integration.zotero_activate": "Save articles to Zotero",
+    "form.integration.zotero_library_type": "Zotero library",
+    "form.integration.zotero_library_user": "Personal library",
+    "form.integration.zotero_library_group": "Group library",
+    "form.integration.zotero_library_id": "Zotero user or group ID",
+    "form.integration.zotero_api_key": "Zotero API key",
+    "form.integration.zotero_collection_key": "Zotero collection key (optional)",
+    "form.integration.zotero_help": "Articles linking to a DOI are saved as journal articles, the other ones as web pages.",
+    "form.integration.hypothesis_activate": "Publish the annotations of articles to Hypothesis",
+    "form.integration.hypothesis_token": "Hypothesis API token",
+    "form.integration.hypothesis_token_help": "The token can be generated on the developer page of your Hypothesis account.",
+    "form.integration.hypothesis_group": "Hypothesis group ID",
+    "form.integration.hypothesis_group_help": "The annotations are visible to the members of this group, the group __world__ makes them public.",
+    "form.integration.hypothesis_export_help": "All annotations can also be downloaded in the W3C Web Annotation format with the API:",
+    "form.integration.rssbridge_activate": "Usa RSS-Bridge quando un sito web non ha alcun feed",
+    "form.integration.rssbridge_url": "URL del server RSS-Bridge",
+    "form.integration.routing": "Categorie",
+    "form.integration.routing_help": "Services limited to some categories only receive the articles of the selected categories, and nothing when no category is selected.",
+    "form.integration.routing_restrict": "Limit to the selected categories",
     "form.api_key.label.description": "Etichetta chiave API",
+    "form.muted_keyword.label.pattern": "Parola chiave o espressione regolare",
+    "form.blocked_feed.label.pattern": "Feed URL or domain",
+    "form.blocked_feed.help.pattern": "A complete URL blocks only this feed, a domain name blocks all the feeds of the domain and its subdomains.",
+    "form.blocked_feed.label.reason": "Reason shown to users (optional)",
+    "form.muted_keyword.label.action": "Articoli corrispondenti",
+    "form.muted_keyword.label.expires_at": "Data di scadenza (facoltativa)",
+    "form.entry_email.label.recipients": "Destinatari (separati da virgole)",
+    "form.entry_email.label.note": "Nota personale (facoltativa)",
+    "form.muted_keyword.select.drop": "Ignora",
+    "form.muted_keyword.select.read": "Segna come letto",
     "form.submit.loading": "Caricamento in corso...",
     "form.submit.saving": "Salvataggio in corso...",
+    "form.submit.sending": "Invio...",
     "time_elapsed.not_yet": "non ancora",
     "time_elapsed.yesterday": "ieri",
     "time_elapsed.now": "adesso",
@@ -1682,9 +3680,26 @@ var translations = map[string]string{
     "action.save": "保存",
     "action.or": "または",
     "action.cancel": "取り消し",
+    "action.send": "送信",
+    "action.close": "閉じる",
     "action.remove": "削除",
+    "action.add_to_queue": "キューに追加",
+    "action.play_queue": "キューを再生",
+    "action.move_up": "上へ",
+    "action.move_down": "下へ",
+    "action.open": "開く",
+    "action.save_search": "この検索を保存",
+    "action.unsubscribe_selected": "Unsubscribe from selected feeds",
+    "action.keep_selected": "Keep selected feeds",
     "action.remove_feed": "このフィードを削除",
+    "action.use_canonical_feed_url": "この URL を使用する",
     "action.update": "更新",
+    "action.send_verification_link": "Send a verification link",
+    "action.block_feed": "Block",
+    "action.share_category": "Share",
+    "action.hide_category": "Hide",
+    "action.unhide_category": "Show again",
+    "action.move_feeds_and_remove": "Move and remove",
     "action.edit": "編集",
     "action.download": "ダウンロード",
     "action.import": "インポート",
@@ -1694,36 +3709,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "%s としてログイン中",
     "menu.unread": "未読",
     "menu.starred": "星付き",
+    "menu.trending": "Trending",
+    "menu.podcasts": "ポッドキャスト",
+    "menu.playback_queue": "再生キュー",
     "menu.history": "履歴",
     "menu.feeds": "フィード一覧",
     "menu.categories": "カテゴリ",
+    "menu.today": "今日",
     "menu.settings": "設定",
     "menu.logout": "ログアウト",
+    "menu.skip_to_content": "本文へスキップ",
     "menu.preferences": "設定情報",
     "menu.integrations": "関連付け",
     "menu.sessions": "セッション",
     "menu.users": "ユーザー一覧",
+    "menu.reports": "Reports",
+    "menu.blocked_feeds": "Blocked Feeds",
     "menu.about": "ソフトウエア情報",
     "menu.export": "エクスポート",
     "menu.import": "インポート",
     "menu.create_category": "カテゴリを作成",
+    "menu.hidden_categories": "Hidden categories",
     "menu.mark_page_as_read": "このページを既読にする",
+    "menu.saved_searches": "保存済みの検索",
+    "menu.clear_search_history": "検索履歴を消去",
     "menu.mark_all_as_read": "全て既読にする",
     "menu.show_all_entries": "全ての記事を表示",
+    "menu.show_all_feeds": "Show all feeds",
     "menu.show_only_unread_entries": "未読の記事だけを表示",
     "menu.refresh_feed": "更新",
     "menu.refresh_all_feeds": "全てのフィードをバックグラウンドで更新",
     "menu.edit_feed": "編集",
     "menu.edit_category": "編集",
     "menu.add_feed": "フィードを購読する",
+    "menu.feed_recommendations": "Recommendations",
     "menu.add_user": "ユーザーを追加",
     "menu.flush_history": "履歴を更新",
     "menu.feed_entries": "記事一覧",
     "menu.api_keys": "APIキー",
     "menu.create_api_key": "新しいAPIキーを作成する",
+    "menu.muted_keywords": "ミュートしたキーワード",
+    "menu.create_muted_keyword": "新しいキーワードをミュート",
     "menu.shared_entries": "共有エントリ",
+    "menu.received_entries": "Received entries",
     "search.label": "検索",
     "search.placeholder": "…を検索",
+    "search.placeholder_feed": "このフィードを検索…",
+    "search.placeholder_category": "このカテゴリを検索…",
     "pagination.next": "次",
     "pagination.previous": "前",
     "entry.status.unread": "未読",
@@ -1736,19 +3768,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "星付き",
     "entry.bookmark.toast.off": "星無し",
     "entry.state.saving": "保存中…",
+    "entry.state.queued": "キューに追加済み",
     "entry.state.loading": "読み込み中…",
+    "entry.state.archiving": "Archiving...",
     "entry.save.label": "保存",
     "entry.save.title": "この記事を保存",
     "entry.save.completed": "完了!",
     "entry.save.toast.completed": "記事は保存されました",
+    "entry.save.prompt_tags": "追加のタグ（スペース区切り）",
+    "entry.select.label": "Select",
+    "entry.bulk.selected": "%d selected",
+    "entry.bulk.save": "Save selected entries",
+    "entry.bulk.save.completed": "Entries sent to third-party services",
+    "entry.bulk.clear": "Clear selection",
     "entry.scraper.label": "オリジナルの内容を取得",
+    "entry.snapshot.title": "Open the copy of the web page kept by Miniflux",
+    "entry.snapshot.label": "Offline copy",
+    "entry.snapshot.create.title": "Keep a copy of the full web page, viewable even if the website goes offline",
+    "entry.snapshot.create.label": "Archive page",
+    "entry.snapshot.create.toast": "Web page archived",
     "entry.scraper.title": "オリジナルの内容を取得",
     "entry.scraper.completed": "完了!",
     "entry.original.label": "オリジナル",
     "entry.comments.label": "コメント",
+    "entry.location.title": "Show the location on a map",
+    "entry.location.label": "Map",
+    "entry.archives.title": "Copies of the web page kept by archiving services",
+    "entry.archives.label": "Archived copy:",
     "entry.comments.title": "コメントを見る",
+    "entry.print.label": "印刷",
+    "entry.print.title": "印刷またはPDFとして保存",
+    "entry.print.source": "ソース",
+    "entry.print.url": "URL",
+    "entry.print.date": "日付",
+    "entry.email.label": "メール",
+    "entry.email.title": "メールで共有",
+    "email.entry.signature": "%s が %s から Miniflux で共有",
+    "email.feed_error.subject": "The feed \"%s\" keeps failing",
+    "email.feed_error.body": "Miniflux was unable to refresh the feed \"%s\" (%s) %d times in a row.\n\nLast error: %s\n\nYou can review the feed here: %s",
+    "email.notification_email_verification.subject": "Confirm your email address for the Miniflux notifications",
+    "email.notification_email_verification.body": "The Miniflux user \"%s\" wants to receive notifications at this address.\n\nOpen this link to confirm it: %s\n\nIf you didn't ask for it, you can ignore this email.",
     "entry.share.label": "共有",
     "entry.share.title": "この記事を共有する",
+    "entry.send.label": "Send",
+    "entry.send.title": "Send this article to another user",
     "entry.unshare.label": "共有解除",
     "entry.shared_entry.title": "公開リンクを開く",
     "entry.shared_entry.label": "共有する",
@@ -1756,10 +3819,41 @@ var translations = map[string]string{
         "%d分で読む",
         "%d分で読む"
     ],
+    "entry.video_duration": [
+        "%d分の動画",
+        "%d分の動画"
+    ],
+    "entry.score": [
+        "%d ポイント",
+        "%d ポイント"
+    ],
+    "entry.comments_count": [
+        "%d 件のコメント",
+        "%d 件のコメント"
+    ],
+    "entry.duplicates": [
+        "%d similar entry",
+        "%d similar entries"
+    ],
+    "entry.removed_trackers": [
+        "%d 個のトラッカーを削除しました",
+        "%d 個のトラッカーを削除しました"
+    ],
     "page.shared_entries.title": "共有エントリ",
+    "page.received_entries.title": "Received Entries",
+    "page.received_entries.sender": "Sent by %s",
+    "page.entry_send.title": "Send to another user",
     "page.unread.title": "未読",
     "page.starred.title": "星付き",
+    "page.orphaned_saves.title": "Orphaned saves",
+    "page.trending.title": "Trending",
+    "page.trending.feed_count": [
+        "(%d feed)",
+        "(%d feeds)"
+    ],
     "page.categories.title": "カテゴリ",
+    "page.hidden_categories.title": "Hidden Categories",
+    "page.hidden_categories.help": "Hidden categories keep their subscriptions up to date but their articles are left out of the unread list and its counters.",
     "page.categories.no_feed": "フィード無し",
     "page.categories.entries": "記事",
     "page.categories.feeds": "フィード購読を見る",
@@ -1770,25 +3864,89 @@ var translations = map[string]string{
     "page.new_category.title": "新規カテゴリ",
     "page.new_user.title": "新規ユーザー",
     "page.edit_category.title": "カテゴリーを編集: %s",
+    "page.remove_category.title": "Remove Category: %s",
+    "page.remove_category.help": [
+        "This category contains %d feed. It will be moved to the category below before the removal.",
+        "This category contains %d feeds. They will be moved to the category below before the removal."
+    ],
+    "page.edit_category.members": "Members",
+    "page.edit_category.member_since": "Member since",
+    "page.edit_category.members_help": "Members see the feeds of this category in their own account with their own read and starred status, once they accept the invitation. Feeds are fetched only once for everyone.",
+    "page.edit_category.shared_by": "This category is shared by %s. New entries are fetched by its owner.",
+    "page.edit_category.invitation_pending": "Invitation pending",
+    "page.categories.invitation": "%s invites you to follow the category “%s”.",
+    "action.accept_invitation": "Accept",
+    "action.decline_invitation": "Decline",
+    "action.leave_shared_category": "Stop following this category",
     "page.edit_user.title": "ユーザーを編集: %s",
     "page.feeds.title": "フィード一覧",
     "page.feeds.last_check": "最終チェック:",
+    "page.feed_entries.volume": [
+        "%d entry during the last %d days",
+        "%d entries during the last %d days"
+    ],
     "page.feeds.unread_counter": "未読記事の数",
     "page.feeds.read_counter": "既読記事の数",
     "page.feeds.error_count": [
         "%d 個のエラー",
         "%d 個のエラー"
     ],
+    "page.feeds.dead": "発行者により削除されました",
+    "page.feeds.dormant": "Dormant",
+    "page.feeds.dormant_title": "This feed hasn't published anything for a long time",
+    "page.feeds.move.hint": "Drag feeds to a category, or select several feeds and click a category:",
+    "page.feeds.move.selected": "Move the %d selected feeds to:",
+    "page.feeds.move.loading": "Moving...",
+    "page.unused_feeds.title": "Unread Feeds",
+    "page.unused_feeds.description": [
+        "You haven't read or starred anything from these feeds for %d month.",
+        "You haven't read or starred anything from these feeds for %d months."
+    ],
+    "page.unused_feeds.last_read": "Last read:",
+    "page.unused_feeds.never_read": "Never read",
     "page.history.title": "履歴",
+    "page.today.title": "今日",
+    "page.podcasts.title": "ポッドキャスト",
+    "page.playback_queue.title": "再生キュー",
+    "page.podcasts.total_duration": "合計再生時間: %s",
+    "page.digest.title": "Since your last visit",
+    "page.digest.since": "Unread articles received since",
+    "page.digest.continue": "Continue reading",
+    "page.digest.top_entries": "Top articles",
+    "page.digest.entry_count": [
+        "%d new article",
+        "%d new articles"
+    ],
     "page.import.title": "インポート",
     "page.search.title": "検索結果",
+    "page.search.syntax_help": "title:、author:、feed:、tag:、before:2006-01-02、after:2006-01-02 で検索を絞り込めます。完全一致のフレーズには引用符を、正規表現にはスラッシュを使います（例: title:/^release/）。",
+    "page.search.saved": "保存済みの検索",
+    "page.saved_searches.title": "保存済みの検索",
+    "page.saved_searches.recent": "最近の検索",
+    "page.saved_searches.table.query": "検索",
+    "page.saved_searches.table.actions": "操作",
+    "page.search.scope_feed": "フィード: %s",
+    "page.search.scope_category": "カテゴリ: %s",
+    "page.search.all_entries": "すべての記事を検索",
     "page.about.title": "ソフトウエア情報",
     "page.about.credits": "著作権表示",
     "page.about.version": "バージョン:",
     "page.about.build_date": "ビルド日時:",
+    "page.about.features": "Features",
+    "page.about.image_proxy": "Image proxy:",
+    "page.about.search_backend": "Search engine:",
+    "page.about.websub": "WebSub:",
+    "page.about.integrations": "Integrations:",
+    "page.about.enabled": "Enabled",
+    "page.about.disabled": "Disabled",
     "page.about.author": "作者:",
     "page.about.license": "ライセンス:",
     "page.add_feed.title": "新規購読",
+    "page.feed_recommendations.title": "Recommended Feeds",
+    "page.feed_recommendations.table.feed": "Feed",
+    "page.feed_recommendations.table.category": "Category",
+    "page.feed_recommendations.table.subscribers": "Subscribers",
+    "page.feed_recommendations.table.actions": "Actions",
     "page.add_feed.no_category": "カテゴリが存在しません。 少なくとも1つのカテゴリが必要です。",
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "購読フィードを探して追加",
@@ -1799,8 +3957,23 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "最後に更新されたヘッダー:",
     "page.edit_feed.etag_header": "ETag ヘッダー:",
     "page.edit_feed.no_header": " なし",
+    "page.edit_feed.last_fetch_url": "最終 URL:",
+    "page.edit_feed.last_fetch_content_type": "Content-Type ヘッダー:",
+    "page.edit_feed.last_fetch_size": "サイズ:",
+    "page.edit_feed.bytes": [
+        "%d バイト",
+        "%d バイト"
+    ],
     "page.edit_feed.last_parsing_error": "最新の解析エラー",
+    "page.edit_feed.canonical_feed_url": "このフィードは別の URL を示しています: %s",
     "page.entry.attachments": "添付物",
+    "page.entry.comments": "Comments",
+    "page.entry.comments.follow": "Follow the discussion",
+    "page.entry.comments.unfollow": "Stop following",
+    "page.entry.comments.following": [
+        "New comments will be fetched for %d more day.",
+        "New comments will be fetched for %d more days."
+    ],
     "page.keyboard_shortcuts.title": "キーボード・ショートカット",
     "page.keyboard_shortcuts.subtitle.sections": "セクション 移動",
     "page.keyboard_shortcuts.subtitle.items": "アイテム 移動",
@@ -1821,6 +3994,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.open_item": "選択されたアイテムを開く",
     "page.keyboard_shortcuts.open_original": "オリジナルのリンクを開く",
     "page.keyboard_shortcuts.open_original_same_window": "現在のタブでオリジナルのリンクを開く",
+    "page.keyboard_shortcuts.open_original_background": "Open original link in background tab",
     "page.keyboard_shortcuts.open_comments": "コメントリンクを開く",
     "page.keyboard_shortcuts.open_comments_same_window": "現在のタブでコメントリンクを開く",
     "page.keyboard_shortcuts.toggle_read_status": "既読/未読 切り替え",
@@ -1833,6 +4007,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "検索フォームにフォーカスを移す",
     "page.keyboard_shortcuts.close_modal": "モーダルダイアログを閉じる",
     "page.users.title": "ユーザー一覧",
+    "page.reports.title": "Reports",
+    "page.reports.database_size": "Database size",
+    "page.reports.storage": "Storage",
+    "page.reports.feeds": "Feeds",
+    "page.reports.entries": "Articles",
+    "page.reports.last_activity": "Last activity",
     "page.users.username": "ユーザー名",
     "page.users.never_logged": "未ログイン",
     "page.users.admin.yes": "管理者",
@@ -1872,30 +4052,92 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "アクション",
     "page.api_keys.never_used": "使われたことがない",
     "page.new_api_key.title": "新しいAPIキー",
+    "page.muted_keywords.title": "ミュートしたキーワード",
+    "page.blocked_feeds.title": "Blocked Feeds",
+    "page.blocked_feeds.help": "Nobody can subscribe to these feeds on this instance. Blocking a domain also blocks all its subdomains. Existing subscriptions are disabled.",
+    "page.blocked_feeds.table.pattern": "Feed URL or domain",
+    "page.blocked_feeds.table.reason": "Reason",
+    "page.blocked_feeds.table.created_at": "Blocked",
+    "page.blocked_feeds.table.actions": "Actions",
+    "page.muted_keywords.help": "New entries of all your feeds matching one of these keywords or regular expressions are ignored or marked as read.",
+    "page.muted_keywords.table.pattern": "Keyword or Regex",
+    "page.muted_keywords.table.action": "Action",
+    "page.muted_keywords.table.expires_at": "Expiration Date",
+    "page.muted_keywords.table.actions": "Actions",
+    "page.muted_keywords.never_expires": "Never",
+    "page.muted_keywords.expired": "Expired",
+    "page.new_muted_keyword.title": "New Muted Keyword",
+    "page.entry_email.title": "メールで共有",
     "alert.no_shared_entry": "共有エントリはありません。",
+    "alert.blocked_feed_created": [
+        "The feed has been blocked, %d existing subscription has been disabled.",
+        "The feed has been blocked, %d existing subscriptions have been disabled."
+    ],
+    "alert.no_received_entry": "There is no received entry.",
     "alert.no_bookmark": "現在星付きはありません。",
+    "alert.no_trending_topic": "There is no trending topic at the moment.",
     "alert.no_category": "カテゴリが存在しません。",
+    "alert.no_hidden_category": "There is no hidden category.",
+    "alert.no_category_to_move_feeds": "Create another category first to receive the feeds of this one.",
+    "alert.category_invitation_sent": "An invitation to follow this category has been sent to %s.",
+    "alert.no_feed_recommendation": "There is no recommendation for your categories at the moment.",
     "alert.no_category_entry": "このカテゴリには記事がありません。",
     "alert.no_feed_entry": "このフィードには記事がありません。",
     "alert.no_feed": "何も購読していません。",
+    "alert.unused_feeds": [
+        "%d feed hasn't been read for a long time, review it.",
+        "%d feeds haven't been read for a long time, review them."
+    ],
+    "alert.dormant_feeds": [
+        "%d feed hasn't published anything for a long time, review it.",
+        "%d feeds haven't published anything for a long time, review them."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Feeds without new entries for %d day.",
+        "Feeds without new entries for %d days."
+    ],
+    "alert.no_unused_feed": "You read all your subscriptions, nothing to clean up.",
     "alert.no_feed_in_category": "このカテゴリにはフィードの購読がありません。",
     "alert.no_history": "現時点では履歴がありません。",
     "alert.feed_error": "このフィードには問題があります。",
+    "alert.feed_dead": "このフィードは利用できなくなったため、自動更新されません",
     "alert.no_search_result": "検索で何も見つかりませんでした。",
+    "alert.no_saved_search": "保存済みの検索はありません。",
     "alert.no_unread_entry": "未読の記事はありません。",
+    "alert.no_today_entry": "No article has been published today.",
+    "alert.no_podcast_entry": "未読のポッドキャストのエピソードはありません。",
+    "alert.no_queued_entry": "再生キューは空です。ポッドキャストのページからエピソードを追加してください。",
+    "alert.no_digest_entry": "Nothing new since your last visit.",
     "alert.no_user": "あなたが唯一のユーザーです。",
     "alert.account_unlinked": "外部アカウントとのリンクが解除されました!",
     "alert.account_linked": "外部アカウントとリンクされました!",
     "alert.pocket_linked": "Pocket アカウントとリンクされました!",
     "alert.prefs_saved": "設定情報は保存されました!",
+    "alert.notification_email_verification_sent": "A verification link has been sent to the notification email address.",
+    "alert.notification_email_verified": "The notification email address is verified.",
+    "alert.entry_sent_by_email": "記事をメールで送信しました。",
     "error.unlink_account_without_password": "パスワードを設定しなければ再びログインすることはできません。",
     "error.duplicate_linked_account": "別なユーザーが既にこのサービスの同じユーザーとリンクしています。",
     "error.duplicate_fever_username": "既に同じ名前の Fever ユーザー名が使われています!",
+    "error.custom_bookmark_invalid": "Invalid custom bookmark service settings: %v.",
+    "error.markdown_invalid": "Invalid Markdown notes settings: %v.",
+    "error.markdown_webdav_url_required": "The WebDAV folder URL is required to save Markdown notes.",
+    "error.hypothesis_token_required": "The Hypothesis API token is required.",
+    "error.hypothesis_group_required": "The Hypothesis group ID is required.",
+    "error.zotero_invalid": "Invalid Zotero settings: %v.",
+    "error.instapaper_invalid": "Unable to connect to Instapaper with these credentials: %v.",
+    "error.ntfy_invalid_topic_url": "The ntfy topic URL is invalid.",
+    "error.linkace_invalid_lists": "Invalid LinkAce lists: %v.",
+    "error.archivebox_credentials_required": "The ArchiveBox server URL and API key are required.",
     "error.pocket_request_token": "Pocket の request token が取得できません!",
     "error.pocket_access_token": "Pocket の access token が取得できません!",
     "error.category_already_exists": "このカテゴリは既に存在しています。",
+    "error.category_member_not_found": "This user does not exist.",
+    "error.category_shared_with_you": "This category is shared by %s and cannot be shared again.",
     "error.unable_to_create_category": "カテゴリを作成できません。",
     "error.unable_to_update_category": "カテゴリを更新できません。",
+    "error.unable_to_remove_category": "Unable to remove this category.",
+    "error.category_remove_target": "Choose another category for the feeds.",
     "error.user_already_exists": "このユーザーは既に存在します。",
     "error.unable_to_create_user": "このユーザーを作ることはできません。",
     "error.unable_to_update_user": "このユーザーを更新することはできません。",
@@ -1904,30 +4146,81 @@ var translations = map[string]string{
     "error.empty_file": "このファイルは空です。",
     "error.bad_credentials": "ユーザー名かパスワードが間違っています。",
     "error.fields_mandatory": "全ての項目が必要です。",
+    "error.no_feed_selected": "No feed selected.",
     "error.title_required": "タイトルが必要です。",
     "error.different_passwords": "パスワードが一致しません。",
     "error.password_min_length": "パスワードは6文字以上である必要があります。",
     "error.settings_mandatory_fields": "ユーザー名、テーマ、言語、タイムゾーンの全てが必要です。",
     "error.entries_per_page_invalid": "ページあたりのエントリ数が無効です。",
+    "error.invalid_youtube_embed_url": "The Invidious or Piped instance URL is not valid.",
+    "error.invalid_auto_star_keywords": "One of the auto-star keywords is not a valid regular expression.",
+    "error.invalid_email_recipients": "宛先のいずれかが無効か、宛先が多すぎます。",
+    "error.invalid_notification_email": "The email address for the notifications is invalid.",
+    "error.invalid_notification_email_token": "This verification link is invalid or has already been used.",
+    "error.invalid_home_page": "The home page is invalid, a search query is required to open a search.",
+    "error.invalid_entry_timezone": "The timezone of the publication dates is invalid.",
+    "error.invalid_timestamp_format": "The format of the dates is invalid.",
+    "error.unable_to_send_email": "メールを送信できません。",
+    "error.too_many_sent_emails": "You have sent too many emails, please try again later.",
     "error.feed_mandatory_fields": "URL と カテゴリが必要です。",
+    "error.invalid_encoding": "This character encoding is not supported.",
+    "error.invalid_cron_expression": "cron 式が無効です。",
+    "error.invalid_feed_request_limits": "The request timeout or the maximum size is out of the allowed range.",
+    "error.feed_disabled_after_errors": [
+        "このフィードは %d 週間エラーが続いたため自動的に無効化されました: %s",
+        "このフィードは %d 週間エラーが続いたため自動的に無効化されました: %s"
+    ],
     "error.user_mandatory_fields": "ユーザー名が必要です。",
     "error.api_key_already_exists": "このAPIキーは既に存在します。",
     "error.unable_to_create_api_key": "このAPIキーを作成できません。",
+    "error.muted_keyword_already_exists": "This keyword is already muted.",
+    "error.blocked_feed_already_exists": "This feed URL or domain is already blocked.",
+    "error.invalid_blocked_feed": "Enter a complete feed URL or a domain name.",
+    "error.unable_to_block_feed": "Unable to block this feed.",
+    "error.unable_to_create_muted_keyword": "Unable to mute this keyword.",
+    "error.invalid_muted_keyword": "Invalid keyword or regular expression.",
+    "error.invalid_expiration_date": "Invalid expiration date.",
+    "error.too_many_sent_entries": "You have sent too many entries, please try again later.",
     "form.feed.label.title": "タイトル",
+    "form.feed.help.original_title": "元のタイトル: %s",
+    "form.feed.help.previous_feed_url": "フィードの URL が自動的に更新されました。以前の URL: %s",
     "form.feed.label.site_url": "サイト URL",
     "form.feed.label.feed_url": "フィード URL",
     "form.feed.label.category": "カテゴリ",
     "form.feed.label.crawler": "オリジナルの内容を取得",
     "form.feed.label.feed_username": "フィードのユーザー名",
     "form.feed.label.feed_password": "フィードのパスワード",
+    "form.feed.label.bearer_token": "Feed Bearer Token",
+    "form.feed.label.oauth2_token_url": "OAuth2 Token URL",
+    "form.feed.label.oauth2_client_id": "OAuth2 Client ID",
+    "form.feed.label.oauth2_client_secret": "OAuth2 Client Secret",
+    "form.feed.label.oauth2_scopes": "OAuth2 Scopes (separated by spaces)",
     "form.feed.label.user_agent": "ディフォルトの User Agent を上書きする",
     "form.feed.label.scraper_rules": "スクラップルール",
     "form.feed.label.rewrite_rules": "Rewrite ルール",
+    "form.feed.label.encoding": "Character Encoding Override",
     "form.feed.label.ignore_http_cache": "HTTPキャッシュを無視",
     "form.feed.label.fetch_via_proxy": "プロキシ経由でフェッチ",
     "form.feed.label.disabled": "このフィードを更新しない",
+    "form.feed.label.open_external_link": "Open entries directly on the original website",
+    "form.feed.label.fetch_scores": "Fetch score and number of comments from Reddit and Hacker News",
+    "form.feed.label.minimum_score": "Ignore entries with a score lower than",
+    "form.feed.label.blocked_authors": "Ignore entries written by these authors (one per line)",
+    "form.feed.label.auto_star": "Star automatically new entries of this feed",
+    "form.feed.label.archive_pages": "Keep a copy of the full web page of new entries",
+    "form.feed.label.hide_globally": "全体の未読一覧で記事を非表示にする",
+    "form.feed.label.notify_errors": "Send a notification when this feed keeps failing",
+    "form.feed.label.cron_expression": "更新スケジュール (cron 式)",
+    "form.feed.help.cron_expression": "タイムゾーンに基づく分、時、日、月、曜日。空欄の場合は既定のスケジュールを使用します。",
+    "form.feed.label.request_timeout": "リクエストのタイムアウト (秒)",
+    "form.feed.label.max_body_size": "最大サイズ (MB)",
+    "form.feed.help.http_client_limits": "0 を指定するとグローバル設定を使用します。",
     "form.category.label.title": "タイトル",
+    "form.category.label.move_feeds_to": "Move the feeds to",
+    "form.category.label.member_username": "Share with user",
     "form.user.label.username": "ユーザー名",
+    "form.entry_send.label.username": "Recipient username",
+    "form.entry_send.label.message": "Message (optional)",
     "form.user.label.password": "パスワード",
     "form.user.label.confirmation": "パスワード確認",
     "form.user.label.admin": "管理者",
@@ -1936,10 +4229,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "テーマ",
     "form.prefs.label.entry_sorting": "記事の並べ替え",
     "form.prefs.label.entries_per_page": "ページあたりのエントリ",
+    "form.prefs.label.home_page": "Home page",
+    "form.prefs.label.home_page_search": "Search query opened on the home page",
+    "form.prefs.label.entry_timezone": "Publication dates",
+    "form.prefs.select.entry_timezone_local": "In my timezone",
+    "form.prefs.select.entry_timezone_original": "In the timezone of the feed",
+    "form.prefs.select.entry_timezone_both": "In both timezones",
+    "form.prefs.label.timestamp_format": "Dates in the lists",
+    "form.prefs.select.timestamp_relative": "Relative (2 hours ago)",
+    "form.prefs.select.timestamp_absolute": "Absolute (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "古い記事を最初に",
     "form.prefs.select.recent_first": "新しい記事を最初に",
+    "form.prefs.select.home_page_category": "Category",
+    "form.prefs.select.home_page_search": "Search",
     "form.prefs.label.keyboard_shortcuts": "キーボード・ショートカットを有効にする",
     "form.prefs.label.show_reading_time": "記事の推定読書時間を表示する",
+    "form.prefs.label.mark_read_on_original_link": "Mark entries as read when opening the original link",
+    "form.prefs.label.keep_starred_entries": "Keep starred articles when their feed or category is removed",
+    "form.prefs.label.accept_received_entries": "Accept entries sent by other users",
+    "form.prefs.label.archive_read_days": "Days to keep read articles",
+    "form.prefs.help.archive_read_days": "Leave empty to use the default of %d days, the maximum is %d days.",
+    "form.prefs.label.youtube_embed_url": "Invidious or Piped instance used to play YouTube videos",
+    "form.prefs.label.blocked_authors": "Ignore entries written by these authors in all feeds (one per line)",
+    "form.prefs.label.auto_star_keywords": "Star automatically new entries matching these keywords (one regular expression per line)",
+    "form.prefs.label.auto_star_authors": "Star automatically new entries from these authors (one per line)",
+    "form.prefs.label.email_recipients": "メール共有時の既定の宛先（カンマ区切り）",
+    "form.prefs.label.notification_email": "Email address for the notifications",
+    "form.prefs.help.notification_email_unverified": "This address is not verified yet, no notification is sent to it.",
     "form.prefs.label.custom_css": "カスタムCSS",
     "form.import.label.file": "OPML ファイル",
     "form.import.label.url": "URL",
@@ -1950,12 +4266,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "Pinboard に記事を保存する",
     "form.integration.pinboard_token": "Pinboard の API Token",
     "form.integration.pinboard_tags": "Pinboard の Tag",
+    "form.integration.pinboard_category_tag": "フィードのカテゴリをタグとして追加",
+    "form.integration.pinboard_entry_tags": "記事のタグを追加",
+    "form.integration.pinboard_prompt_tags": "記事の保存時に追加のタグを入力する",
     "form.integration.pinboard_bookmark": "ブックマークを未読にする",
     "form.integration.instapaper_activate": "Instapaper に記事を保存する",
     "form.integration.instapaper_username": "Instapaper の ユーザー名",
     "form.integration.instapaper_password": "Instapaper の パスワード",
+    "form.integration.instapaper_folder": "Instapaper Folder",
+    "form.integration.instapaper_folder_unread": "Unread (default)",
+    "form.integration.instapaper_folder_id": "Instapaper Folder ID",
+    "form.integration.instapaper_folder_help": "The folders are listed once the integration is saved, save again to update the list. Leave empty to save articles in the Unread folder.",
     "form.integration.pocket_activate": "Pocket に記事を保存する",
     "form.integration.pocket_consumer_key": "Pocket の Consumer Key",
+    "form.integration.pocket_category_tag": "フィードのカテゴリをタグとして追加",
     "form.integration.pocket_access_token": "Pocket の Access Token",
     "form.integration.pocket_connect_link": "Pocket account に接続",
     "form.integration.wallabag_activate": "Wallabag に記事を保存する",
@@ -1964,12 +4288,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Wallabag の Client Secret",
     "form.integration.wallabag_username": "Wallabag の ユーザー名",
     "form.integration.wallabag_password": "Wallabag の パスワード",
+    "form.integration.wallabag_tags": "Wallabag のタグ（カンマ区切り）",
+    "form.integration.wallabag_archive": "Wallabag に保存した記事をアーカイブする",
     "form.integration.nunux_keeper_activate": "Nunux Keeper に記事を保存する",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper の API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper の API key",
+    "form.integration.karakeep_activate": "Save articles to Karakeep",
+    "form.integration.karakeep_endpoint": "Karakeep server URL",
+    "form.integration.karakeep_api_key": "Karakeep API key",
+    "form.integration.karakeep_tags": "Karakeep tags (comma separated)",
+    "form.integration.archivebox_activate": "Archive saved and starred articles with ArchiveBox",
+    "form.integration.archivebox_endpoint": "ArchiveBox server URL",
+    "form.integration.archivebox_api_key": "ArchiveBox API key",
+    "form.integration.archivebox_tags": "ArchiveBox tags (comma separated)",
+    "form.integration.archivebox_help": "The link to the snapshot is shown on the article once ArchiveBox has finished archiving the page.",
+    "form.integration.ntfy_activate": "Send push notifications with ntfy",
+    "form.integration.ntfy_topic_url": "ntfy topic URL",
+    "form.integration.ntfy_token": "ntfy access token (optional)",
+    "form.integration.ntfy_help": "Feeds with error notifications enabled are reported to this topic when they keep failing.",
+    "form.integration.espial_activate": "Save articles to Espial",
+    "form.integration.espial_endpoint": "Espial server URL",
+    "form.integration.espial_api_key": "Espial API key",
+    "form.integration.espial_tags": "Espial tags (space separated)",
+    "form.integration.linkace_activate": "Save articles to LinkAce",
+    "form.integration.linkace_endpoint": "LinkAce server URL",
+    "form.integration.linkace_api_key": "LinkAce API token",
+    "form.integration.linkace_tags": "LinkAce tags (comma separated)",
+    "form.integration.linkace_lists": "LinkAce list IDs (comma separated)",
+    "form.integration.linkace_category_tag": "Add the category as tag",
+    "form.integration.linkace_is_private": "Save links as private",
+    "form.integration.custom_bookmark": "Custom bookmark service",
+    "form.integration.custom_bookmark_activate": "Save articles to a custom bookmark service",
+    "form.integration.custom_bookmark_endpoint": "API Endpoint",
+    "form.integration.custom_bookmark_method": "HTTP Method",
+    "form.integration.custom_bookmark_headers": "HTTP Headers (one per line)",
+    "form.integration.custom_bookmark_body": "JSON Body Template",
+    "form.integration.custom_bookmark_body_help": "Placeholders are replaced by JSON values, do not add quotes around them:",
+    "form.integration.markdown": "Markdown notes",
+    "form.integration.markdown_activate": "Save articles as Markdown files, for notes apps like Obsidian",
+    "form.integration.markdown_starred": "Also save starred articles",
+    "form.integration.markdown_webdav_url": "WebDAV folder URL",
+    "form.integration.markdown_webdav_username": "WebDAV Username",
+    "form.integration.markdown_webdav_password": "WebDAV Password",
+    "form.integration.markdown_local_help": "Leave the URL empty to write the files in the folder configured by the administrator.",
+    "form.integration.wayback_activate": "Archive saved and starred articles with the Wayback Machine",
+    "form.integration.wayback_help": "The web page is submitted to the Internet Archive and the link to the copy is shown on the article.",
+    "form.integration.zotero_activate": "Save articles to Zotero",
+    "form.integration.zotero_library_type": "Zotero library",
+    "form.integration.zotero_library_user": "Personal library",
+    "form.integration.zotero_library_group": "Group library",
+    "form.integration.zotero_library_id": "Zotero user or group ID",
+    "form.integration.zotero_api_key": "Zotero API key",
+    "form.integration.zotero_collection_key": "Zotero collection key (optional)",
+    "form.integration.zotero_help": "Articles linking to a DOI are saved as journal articles, the other ones as web pages.",
+    "form.integration.hypothesis_activate": "Publish the annotations of articles to Hypothesis",
+    "form.integration.hypothesis_token": "Hypothesis API token",
+    "form.integration.hypothesis_token_help": "The token can be generated on the developer page of your Hypothesis account.",
+    "form.integration.hypothesis_group": "Hypothesis group ID",
+    "form.integration.hypothesis_group_help": "The annotations are visible to the members of this group, the group __world__ makes them public.",
+    "form.integration.hypothesis_export_help": "All annotations can also be downloaded in the W3C Web Annotation format with the API:",
+    "form.integration.rssbridge_activate": "Use RSS-Bridge when a website doesn't have any feed",
+    "form.integration.rssbridge_url": "RSS-Bridge server URL",
+    "form.integration.routing": "カテゴリ",
+    "form.integration.routing_help": "Services limited to some categories only receive the articles of the selected categories, and nothing when no category is selected.",
+    "form.integration.routing_restrict": "Limit to the selected categories",
     "form.api_key.label.description": "APIキーラベル",
+    "form.muted_keyword.label.pattern": "Keyword or regular expression",
+    "form.blocked_feed.label.pattern": "Feed URL or domain",
+    "form.blocked_feed.help.pattern": "A complete URL blocks only this feed, a domain name blocks all the feeds of the domain and its subdomains.",
+    "form.blocked_feed.label.reason": "Reason shown to users (optional)",
+    "form.muted_keyword.label.action": "Matching entries",
+    "form.muted_keyword.label.expires_at": "Expiration date (optional)",
+    "form.entry_email.label.recipients": "宛先（カンマ区切り）",
+    "form.entry_email.label.note": "メモ（任意）",
+    "form.muted_keyword.select.drop": "Ignore",
+    "form.muted_keyword.select.read": "Mark as read",
     "form.submit.loading": "読み込み中…",
     "form.submit.saving": "保存中…",
+    "form.submit.sending": "送信中...",
     "time_elapsed.not_yet": "未来",
     "time_elapsed.yesterday": "昨日",
     "time_elapsed.now": "今",
@@ -2008,9 +4404,26 @@ var translations = map[string]string{
     "action.save": "Opslaan",
     "action.or": "of",
     "action.cancel": "annuleren",
+    "action.send": "Verzenden",
+    "action.close": "Sluiten",
     "action.remove": "Verwijderen",
+    "action.add_to_queue": "Toevoegen aan wachtrij",
+    "action.play_queue": "Wachtrij afspelen",
+    "action.move_up": "Omhoog",
+    "action.move_down": "Omlaag",
+    "action.open": "Openen",
+    "action.save_search": "Deze zoekopdracht opslaan",
+    "action.unsubscribe_selected": "Unsubscribe from selected feeds",
+    "action.keep_selected": "Keep selected feeds",
     "action.remove_feed": "Verwijder deze feed",
+    "action.use_canonical_feed_url": "Deze URL gebruiken",
     "action.update": "Updaten",
+    "action.send_verification_link": "Send a verification link",
+    "action.block_feed": "Block",
+    "action.share_category": "Share",
+    "action.hide_category": "Hide",
+    "action.unhide_category": "Show again",
+    "action.move_feeds_and_remove": "Move and remove",
     "action.edit": "Bewerken",
     "action.download": "Download",
     "action.import": "Importeren",
@@ -2020,36 +4433,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "Ingelogd als %s",
     "menu.unread": "Ongelezen",
     "menu.starred": "Favorieten",
+    "menu.trending": "Trending",
+    "menu.podcasts": "Podcasts",
+    "menu.playback_queue": "Afspeelwachtrij",
     "menu.history": "Geschiedenis",
     "menu.feeds": "Feeds",
     "menu.categories": "Categorieën",
+    "menu.today": "Vandaag",
     "menu.settings": "Instellingen",
     "menu.logout": "Uitloggen",
+    "menu.skip_to_content": "Naar inhoud springen",
     "menu.preferences": "Voorkeuren",
     "menu.integrations": "Integraties",
     "menu.sessions": "Sessies",
     "menu.users": "Users",
+    "menu.reports": "Reports",
+    "menu.blocked_feeds": "Blocked Feeds",
     "menu.about": "Over",
     "menu.export": "Exporteren",
     "menu.import": "Importeren",
     "menu.create_category": "Categorie toevoegen",
+    "menu.hidden_categories": "Hidden categories",
     "menu.mark_page_as_read": "Markeer deze pagina als gelezen",
+    "menu.saved_searches": "Opgeslagen zoekopdrachten",
+    "menu.clear_search_history": "Zoekgeschiedenis wissen",
     "menu.mark_all_as_read": "Markeer alle items als gelezen",
     "menu.show_all_entries": "Toon alle artikelen",
+    "menu.show_all_feeds": "Show all feeds",
     "menu.show_only_unread_entries": "Toon alleen ongelezen artikelen",
     "menu.refresh_feed": "Vernieuwen",
     "menu.refresh_all_feeds": "Vernieuw alle feeds in de achtergrond",
     "menu.edit_feed": "Bewerken",
     "menu.edit_category": "Bewerken",
     "menu.add_feed": "Feed toevoegen",
+    "menu.feed_recommendations": "Recommendations",
     "menu.add_user": "Gebruiker toevoegen",
     "menu.flush_history": "Verwijder geschiedenis",
     "menu.feed_entries": "Lidwoord",
     "menu.api_keys": "API-sleutels",
     "menu.create_api_key": "Maak een nieuwe API-sleutel",
+    "menu.muted_keywords": "Gedempte trefwoorden",
+    "menu.create_muted_keyword": "Nieuw trefwoord dempen",
     "menu.shared_entries": "Gedeelde vermeldingen",
+    "menu.received_entries": "Received entries",
     "search.label": "Zoeken",
     "search.placeholder": "Zoeken...",
+    "search.placeholder_feed": "Zoeken in deze feed...",
+    "search.placeholder_category": "Zoeken in deze categorie...",
     "pagination.next": "Volgende",
     "pagination.previous": "Vorige",
     "entry.status.unread": "Ongelezen",
@@ -2062,19 +4492,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "Met ster",
     "entry.bookmark.toast.off": "Ster verwijderd",
     "entry.state.saving": "Opslaag...",
+    "entry.state.queued": "In wachtrij",
     "entry.state.loading": "Laden...",
+    "entry.state.archiving": "Archiving...",
     "entry.save.label": "Opslaan",
     "entry.save.title": "Artikel opslaan",
     "entry.save.completed": "Done!",
     "entry.save.toast.completed": "Artikel opgeslagen",
+    "entry.save.prompt_tags": "Extra tags (gescheiden door spaties)",
+    "entry.select.label": "Selecteren",
+    "entry.bulk.selected": "%d geselecteerd",
+    "entry.bulk.save": "Geselecteerde artikelen opslaan",
+    "entry.bulk.save.completed": "Entries sent to third-party services",
+    "entry.bulk.clear": "Selectie wissen",
     "entry.scraper.label": "Fetch original content",
+    "entry.snapshot.title": "Open the copy of the web page kept by Miniflux",
+    "entry.snapshot.label": "Offline copy",
+    "entry.snapshot.create.title": "Keep a copy of the full web page, viewable even if the website goes offline",
+    "entry.snapshot.create.label": "Archive page",
+    "entry.snapshot.create.toast": "Web page archived",
     "entry.scraper.title": "Fetch original content",
     "entry.scraper.completed": "Klaar!",
     "entry.original.label": "Origineel",
     "entry.comments.label": "Comments",
+    "entry.location.title": "Locatie op een kaart tonen",
+    "entry.location.label": "Kaart",
+    "entry.archives.title": "Copies of the web page kept by archiving services",
+    "entry.archives.label": "Archived copy:",
     "entry.comments.title": "Bekijk de reacties",
+    "entry.print.label": "Afdrukken",
+    "entry.print.title": "Afdrukken of opslaan als PDF",
+    "entry.print.source": "Bron",
+    "entry.print.url": "URL",
+    "entry.print.date": "Datum",
+    "entry.email.label": "E-mail",
+    "entry.email.title": "Delen via e-mail",
+    "email.entry.signature": "Gedeeld door %s uit %s met Miniflux",
+    "email.feed_error.subject": "The feed \"%s\" keeps failing",
+    "email.feed_error.body": "Miniflux was unable to refresh the feed \"%s\" (%s) %d times in a row.\n\nLast error: %s\n\nYou can review the feed here: %s",
+    "email.notification_email_verification.subject": "Confirm your email address for the Miniflux notifications",
+    "email.notification_email_verification.body": "The Miniflux user \"%s\" wants to receive notifications at this address.\n\nOpen this link to confirm it: %s\n\nIf you didn't ask for it, you can ignore this email.",
     "entry.share.label": "Deel",
     "entry.share.title": "Deel dit artikel",
+    "entry.send.label": "Send",
+    "entry.send.title": "Send this article to another user",
     "entry.unshare.label": "Delen ongedaan maken",
     "entry.shared_entry.title": "Open de openbare link",
     "entry.shared_entry.label": "Delen",
@@ -2082,10 +4543,41 @@ var translations = map[string]string{
         "%d minuut gelezen",
         "%d minuten gelezen"
     ],
+    "entry.video_duration": [
+        "Video van %d minuut",
+        "Video van %d minuten"
+    ],
+    "entry.score": [
+        "%d punt",
+        "%d punten"
+    ],
+    "entry.comments_count": [
+        "%d reactie",
+        "%d reacties"
+    ],
+    "entry.duplicates": [
+        "%d similar entry",
+        "%d similar entries"
+    ],
+    "entry.removed_trackers": [
+        "%d tracker verwijderd",
+        "%d trackers verwijderd"
+    ],
     "page.shared_entries.title": "Gedeelde vermeldingen",
+    "page.received_entries.title": "Received Entries",
+    "page.received_entries.sender": "Sent by %s",
+    "page.entry_send.title": "Send to another user",
     "page.unread.title": "Ongelezen",
     "page.starred.title": "Favorieten",
+    "page.orphaned_saves.title": "Orphaned saves",
+    "page.trending.title": "Trending",
+    "page.trending.feed_count": [
+        "(%d feed)",
+        "(%d feeds)"
+    ],
     "page.categories.title": "Categorieën",
+    "page.hidden_categories.title": "Hidden Categories",
+    "page.hidden_categories.help": "Hidden categories keep their subscriptions up to date but their articles are left out of the unread list and its counters.",
     "page.categories.no_feed": "Geen feeds.",
     "page.categories.entries": "Lidwoord",
     "page.categories.feeds": "Abonnementen",
@@ -2096,26 +4588,90 @@ var translations = map[string]string{
     "page.new_category.title": "Nieuwe categorie",
     "page.new_user.title": "Nieuwe gebruiker",
     "page.edit_category.title": "Bewerken van categorie: %s",
+    "page.remove_category.title": "Remove Category: %s",
+    "page.remove_category.help": [
+        "This category contains %d feed. It will be moved to the category below before the removal.",
+        "This category contains %d feeds. They will be moved to the category below before the removal."
+    ],
+    "page.edit_category.members": "Members",
+    "page.edit_category.member_since": "Member since",
+    "page.edit_category.members_help": "Members see the feeds of this category in their own account with their own read and starred status, once they accept the invitation. Feeds are fetched only once for everyone.",
+    "page.edit_category.shared_by": "This category is shared by %s. New entries are fetched by its owner.",
+    "page.edit_category.invitation_pending": "Invitation pending",
+    "page.categories.invitation": "%s invites you to follow the category “%s”.",
+    "action.accept_invitation": "Accept",
+    "action.decline_invitation": "Decline",
+    "action.leave_shared_category": "Stop following this category",
     "page.edit_user.title": "Bewerk gebruiker: %s",
     "page.feeds.title": "Feeds",
     "page.feeds.last_check": "Laatste update:",
+    "page.feed_entries.volume": [
+        "%d entry during the last %d days",
+        "%d entries during the last %d days"
+    ],
     "page.feeds.unread_counter": "Aantal ongelezen vermeldingen",
     "page.feeds.read_counter": "Aantal gelezen vermeldingen",
     "page.feeds.error_count": [
         "%d error",
         "%d errors"
     ],
+    "page.feeds.dead": "Verwijderd door de uitgever",
+    "page.feeds.dormant": "Dormant",
+    "page.feeds.dormant_title": "This feed hasn't published anything for a long time",
+    "page.feeds.move.hint": "Drag feeds to a category, or select several feeds and click a category:",
+    "page.feeds.move.selected": "Move the %d selected feeds to:",
+    "page.feeds.move.loading": "Moving...",
+    "page.unused_feeds.title": "Unread Feeds",
+    "page.unused_feeds.description": [
+        "You haven't read or starred anything from these feeds for %d month.",
+        "You haven't read or starred anything from these feeds for %d months."
+    ],
+    "page.unused_feeds.last_read": "Last read:",
+    "page.unused_feeds.never_read": "Never read",
     "page.history.title": "Geschiedenis",
+    "page.today.title": "Vandaag",
+    "page.podcasts.title": "Podcasts",
+    "page.playback_queue.title": "Afspeelwachtrij",
+    "page.podcasts.total_duration": "Totale luistertijd: %s",
+    "page.digest.title": "Since your last visit",
+    "page.digest.since": "Unread articles received since",
+    "page.digest.continue": "Continue reading",
+    "page.digest.top_entries": "Top articles",
+    "page.digest.entry_count": [
+        "%d new article",
+        "%d new articles"
+    ],
     "page.import.title": "Importeren",
     "page.login.title": "Inloggen",
     "page.search.title": "Zoekresultaten",
+    "page.search.syntax_help": "Verfijn de zoekopdracht met title:, author:, feed:, tag:, before:2006-01-02 en after:2006-01-02, gebruik aanhalingstekens voor exacte zinnen en schuine strepen voor reguliere expressies, bijvoorbeeld title:/^release/.",
+    "page.search.saved": "Opgeslagen zoekopdracht",
+    "page.saved_searches.title": "Opgeslagen zoekopdrachten",
+    "page.saved_searches.recent": "Recente zoekopdrachten",
+    "page.saved_searches.table.query": "Zoekopdracht",
+    "page.saved_searches.table.actions": "Acties",
+    "page.search.scope_feed": "Feed: %s",
+    "page.search.scope_category": "Categorie: %s",
+    "page.search.all_entries": "Alle artikelen doorzoeken",
     "page.about.title": "Over",
     "page.about.credits": "Copyrights",
     "page.about.version": "Versie:",
     "page.about.build_date": "Datum build:",
+    "page.about.features": "Features",
+    "page.about.image_proxy": "Image proxy:",
+    "page.about.search_backend": "Search engine:",
+    "page.about.websub": "WebSub:",
+    "page.about.integrations": "Integrations:",
+    "page.about.enabled": "Enabled",
+    "page.about.disabled": "Disabled",
     "page.about.author": "Auteur:",
     "page.about.license": "Licentie:",
     "page.add_feed.title": "Nieuwe feed",
+    "page.feed_recommendations.title": "Recommended Feeds",
+    "page.feed_recommendations.table.feed": "Feed",
+    "page.feed_recommendations.table.category": "Category",
+    "page.feed_recommendations.table.subscribers": "Subscribers",
+    "page.feed_recommendations.table.actions": "Actions",
     "page.add_feed.no_category": "Er zijn geen categorieën. Je moet op zijn minst één caterogie hebben.",
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Feed zoeken",
@@ -2126,8 +4682,23 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "LastModified-header:",
     "page.edit_feed.etag_header": "ETAG-header:",
     "page.edit_feed.no_header": "Geen",
+    "page.edit_feed.last_fetch_url": "Uiteindelijke URL:",
+    "page.edit_feed.last_fetch_content_type": "Content-Type-header:",
+    "page.edit_feed.last_fetch_size": "Grootte:",
+    "page.edit_feed.bytes": [
+        "%d byte",
+        "%d bytes"
+    ],
     "page.edit_feed.last_parsing_error": "Laatste parse error",
+    "page.edit_feed.canonical_feed_url": "Deze feed vermeldt een andere URL: %s",
     "page.entry.attachments": "Bijlagen",
+    "page.entry.comments": "Comments",
+    "page.entry.comments.follow": "Follow the discussion",
+    "page.entry.comments.unfollow": "Stop following",
+    "page.entry.comments.following": [
+        "New comments will be fetched for %d more day.",
+        "New comments will be fetched for %d more days."
+    ],
     "page.keyboard_shortcuts.title": "Sneltoetsen",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguatie tussen menu's",
     "page.keyboard_shortcuts.subtitle.items": "Navigatie tussen items",
@@ -2148,6 +4719,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.open_item": "Open geselecteerde link",
     "page.keyboard_shortcuts.open_original": "Open originele link",
     "page.keyboard_shortcuts.open_original_same_window": "Oorspronkelijke koppeling op huidig tabblad openen",
+    "page.keyboard_shortcuts.open_original_background": "Originele link openen in een achtergrondtabblad",
     "page.keyboard_shortcuts.open_comments": "Open opmerkingen link",
     "page.keyboard_shortcuts.open_comments_same_window": "Open de reactiekoppeling op het huidige tabblad",
     "page.keyboard_shortcuts.toggle_read_status": "Markeer gelezen/ongelezen",
@@ -2160,6 +4732,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "Focus instellen op zoekformulier",
     "page.keyboard_shortcuts.close_modal": "Sluit dialoogscherm",
     "page.users.title": "Gebruikers",
+    "page.reports.title": "Reports",
+    "page.reports.database_size": "Database size",
+    "page.reports.storage": "Storage",
+    "page.reports.feeds": "Feeds",
+    "page.reports.entries": "Articles",
+    "page.reports.last_activity": "Last activity",
     "page.users.username": "Gebruikersnaam",
     "page.users.never_logged": "Nooit",
     "page.users.admin.yes": "Ja",
@@ -2198,30 +4776,92 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Acties",
     "page.api_keys.never_used": "Nooit gebruikt",
     "page.new_api_key.title": "Nieuwe API-sleutel",
+    "page.muted_keywords.title": "Gedempte trefwoorden",
+    "page.blocked_feeds.title": "Blocked Feeds",
+    "page.blocked_feeds.help": "Nobody can subscribe to these feeds on this instance. Blocking a domain also blocks all its subdomains. Existing subscriptions are disabled.",
+    "page.blocked_feeds.table.pattern": "Feed URL or domain",
+    "page.blocked_feeds.table.reason": "Reason",
+    "page.blocked_feeds.table.created_at": "Blocked",
+    "page.blocked_feeds.table.actions": "Actions",
+    "page.muted_keywords.help": "Nieuwe artikelen van al je feeds die overeenkomen met een van deze trefwoorden of reguliere expressies worden genegeerd of als gelezen gemarkeerd.",
+    "page.muted_keywords.table.pattern": "Trefwoord of reguliere expressie",
+    "page.muted_keywords.table.action": "Actie",
+    "page.muted_keywords.table.expires_at": "Vervaldatum",
+    "page.muted_keywords.table.actions": "Acties",
+    "page.muted_keywords.never_expires": "Nooit",
+    "page.muted_keywords.expired": "Verlopen",
+    "page.new_muted_keyword.title": "Nieuw gedempt trefwoord",
+    "page.entry_email.title": "Delen via e-mail",
     "alert.no_shared_entry": "Er is geen gedeelde toegang.",
+    "alert.blocked_feed_created": [
+        "The feed has been blocked, %d existing subscription has been disabled.",
+        "The feed has been blocked, %d existing subscriptions have been disabled."
+    ],
+    "alert.no_received_entry": "There is no received entry.",
     "alert.no_bookmark": "Er zijn op dit moment geen favorieten.",
+    "alert.no_trending_topic": "There is no trending topic at the moment.",
     "alert.no_category": "Er zijn geen categorieën.",
+    "alert.no_hidden_category": "There is no hidden category.",
+    "alert.no_category_to_move_feeds": "Create another category first to receive the feeds of this one.",
+    "alert.category_invitation_sent": "An invitation to follow this category has been sent to %s.",
+    "alert.no_feed_recommendation": "There is no recommendation for your categories at the moment.",
     "alert.no_category_entry": "Deze categorie bevat geen feeds.",
     "alert.no_feed_entry": "Er zijn geen artikelen in deze feed.",
     "alert.no_feed": "Je hebt nog geen feeds geabboneerd staan.",
+    "alert.unused_feeds": [
+        "%d feed hasn't been read for a long time, review it.",
+        "%d feeds haven't been read for a long time, review them."
+    ],
+    "alert.dormant_feeds": [
+        "%d feed hasn't published anything for a long time, review it.",
+        "%d feeds haven't published anything for a long time, review them."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Feeds without new entries for %d day.",
+        "Feeds without new entries for %d days."
+    ],
+    "alert.no_unused_feed": "You read all your subscriptions, nothing to clean up.",
     "alert.no_feed_in_category": "Er is geen abonnement voor deze categorie.",
     "alert.no_history": "Geschiedenis is op dit moment leeg.",
     "alert.feed_error": "Er is een probleem met deze feed",
+    "alert.feed_dead": "Deze feed is niet meer beschikbaar en wordt niet meer automatisch vernieuwd",
     "alert.no_search_result": "Er is geen resultaat voor deze zoekopdracht.",
+    "alert.no_saved_search": "Er zijn geen opgeslagen zoekopdrachten.",
     "alert.no_unread_entry": "Er zijn geen ongelezen artikelen.",
+    "alert.no_today_entry": "Er is vandaag geen artikel gepubliceerd.",
+    "alert.no_podcast_entry": "Er zijn geen ongelezen podcastafleveringen.",
+    "alert.no_queued_entry": "De afspeelwachtrij is leeg, voeg afleveringen toe vanaf de podcastpagina.",
+    "alert.no_digest_entry": "Nothing new since your last visit.",
     "alert.no_user": "Je bent de enige gebruiker.",
     "alert.account_unlinked": "Uw externe account is nu gedissocieerd!",
     "alert.account_linked": "Uw externe account is nu gekoppeld!",
     "alert.pocket_linked": "Uw Pocket-account is nu gekoppeld!",
     "alert.prefs_saved": "Instellingen opgeslagen!",
+    "alert.notification_email_verification_sent": "A verification link has been sent to the notification email address.",
+    "alert.notification_email_verified": "The notification email address is verified.",
+    "alert.entry_sent_by_email": "Het artikel is per e-mail verzonden.",
     "error.unlink_account_without_password": "U moet een wachtwoord definiëren anders kunt u zich niet opnieuw aanmelden.",
     "error.duplicate_linked_account": "Er is al iemand geregistreerd met deze provider!",
     "error.duplicate_fever_username": "Er is al iemand met dezelfde Fever gebruikersnaam!",
+    "error.custom_bookmark_invalid": "Invalid custom bookmark service settings: %v.",
+    "error.markdown_invalid": "Invalid Markdown notes settings: %v.",
+    "error.markdown_webdav_url_required": "The WebDAV folder URL is required to save Markdown notes.",
+    "error.hypothesis_token_required": "The Hypothesis API token is required.",
+    "error.hypothesis_group_required": "The Hypothesis group ID is required.",
+    "error.zotero_invalid": "Invalid Zotero settings: %v.",
+    "error.instapaper_invalid": "Unable to connect to Instapaper with these credentials: %v.",
+    "error.ntfy_invalid_topic_url": "The ntfy topic URL is invalid.",
+    "error.linkace_invalid_lists": "Invalid LinkAce lists: %v.",
+    "error.archivebox_credentials_required": "The ArchiveBox server URL and API key are required.",
     "error.pocket_request_token": "Kon geen aanvraagtoken ophalen van Pocket!",
     "error.pocket_access_token": "Kon geen toegangstoken ophalen van Pocket!",
     "error.category_already_exists": "Deze categorie bestaat al.",
+    "error.category_member_not_found": "This user does not exist.",
+    "error.category_shared_with_you": "This category is shared by %s and cannot be shared again.",
     "error.unable_to_create_category": "Kan deze categorie niet maken.",
     "error.unable_to_update_category": "Kon categorie niet updaten.",
+    "error.unable_to_remove_category": "Unable to remove this category.",
+    "error.category_remove_target": "Choose another category for the feeds.",
     "error.user_already_exists": "Deze gebruiker bestaat al.",
     "error.unable_to_create_user": "Kan deze gebruiker niet maken.",
     "error.unable_to_update_user": "Kan deze gebruiker niet updaten.",
@@ -2230,30 +4870,81 @@ var translations = map[string]string{
     "error.empty_file": "Dit bestand is leeg.",
     "error.bad_credentials": "Onjuiste gebruikersnaam of wachtwoord.",
     "error.fields_mandatory": "Alle velden moeten ingevuld zijn.",
+    "error.no_feed_selected": "No feed selected.",
     "error.title_required": "Naam van categorie is verplicht.",
     "error.different_passwords": "Wachtwoorden zijn niet hetzelfde.",
     "error.password_min_length": "Je moet minstens 6 tekens gebruiken.",
     "error.settings_mandatory_fields": "Gebruikersnaam, skin, taal en tijdzone zijn verplicht.",
     "error.entries_per_page_invalid": "Het aantal inzendingen per pagina is niet geldig.",
+    "error.invalid_youtube_embed_url": "De URL van de Invidious- of Piped-instantie is ongeldig.",
+    "error.invalid_auto_star_keywords": "One of the auto-star keywords is not a valid regular expression.",
+    "error.invalid_email_recipients": "Een van de ontvangers is ongeldig of er zijn te veel ontvangers.",
+    "error.invalid_notification_email": "The email address for the notifications is invalid.",
+    "error.invalid_notification_email_token": "This verification link is invalid or has already been used.",
+    "error.invalid_home_page": "De startpagina is ongeldig, er is een zoekopdracht nodig om een zoekactie te openen.",
+    "error.invalid_entry_timezone": "The timezone of the publication dates is invalid.",
+    "error.invalid_timestamp_format": "The format of the dates is invalid.",
+    "error.unable_to_send_email": "Kan de e-mail niet verzenden.",
+    "error.too_many_sent_emails": "You have sent too many emails, please try again later.",
     "error.feed_mandatory_fields": "The URL en de categorie zijn verplicht.",
+    "error.invalid_encoding": "Deze tekencodering wordt niet ondersteund.",
+    "error.invalid_cron_expression": "Ongeldige cron-expressie.",
+    "error.invalid_feed_request_limits": "The request timeout or the maximum size is out of the allowed range.",
+    "error.feed_disabled_after_errors": [
+        "Deze feed is automatisch uitgeschakeld na %d week met fouten: %s",
+        "Deze feed is automatisch uitgeschakeld na %d weken met fouten: %s"
+    ],
     "error.user_mandatory_fields": "Gebruikersnaam is verplicht",
     "error.api_key_already_exists": "This API Key already exists.",
     "error.unable_to_create_api_key": "Kan deze API-sleutel niet maken.",
+    "error.muted_keyword_already_exists": "Dit trefwoord is al gedempt.",
+    "error.blocked_feed_already_exists": "This feed URL or domain is already blocked.",
+    "error.invalid_blocked_feed": "Enter a complete feed URL or a domain name.",
+    "error.unable_to_block_feed": "Unable to block this feed.",
+    "error.unable_to_create_muted_keyword": "Kan dit trefwoord niet dempen.",
+    "error.invalid_muted_keyword": "Ongeldig trefwoord of ongeldige reguliere expressie.",
+    "error.invalid_expiration_date": "Ongeldige vervaldatum.",
+    "error.too_many_sent_entries": "You have sent too many entries, please try again later.",
     "form.feed.label.title": "Naam",
+    "form.feed.help.original_title": "Oorspronkelijke titel: %s",
+    "form.feed.help.previous_feed_url": "De feed-URL is automatisch bijgewerkt. Vorige URL: %s",
     "form.feed.label.site_url": "Website URL",
     "form.feed.label.feed_url": "Feed URL",
     "form.feed.label.category": "Categorie",
     "form.feed.label.crawler": "Download originele content",
     "form.feed.label.feed_username": "Feed-gebruikersnaam",
     "form.feed.label.feed_password": "Feed wachtwoord",
+    "form.feed.label.bearer_token": "Bearer-token van de feed",
+    "form.feed.label.oauth2_token_url": "OAuth2-token-URL",
+    "form.feed.label.oauth2_client_id": "OAuth2-client-ID",
+    "form.feed.label.oauth2_client_secret": "OAuth2-clientgeheim",
+    "form.feed.label.oauth2_scopes": "OAuth2-scopes (gescheiden door spaties)",
     "form.feed.label.user_agent": "Standaard User Agent overschrijven",
     "form.feed.label.scraper_rules": "Scraper regels",
     "form.feed.label.rewrite_rules": "Rewrite regels",
+    "form.feed.label.encoding": "Tekencodering forceren",
     "form.feed.label.ignore_http_cache": "Negeer HTTP-cache",
     "form.feed.label.fetch_via_proxy": "Ophalen via proxy",
     "form.feed.label.disabled": "Vernieuw deze feed niet",
+    "form.feed.label.open_external_link": "Artikelen direct op de originele website openen",
+    "form.feed.label.fetch_scores": "Score en aantal reacties ophalen van Reddit en Hacker News",
+    "form.feed.label.minimum_score": "Artikelen negeren met een score lager dan",
+    "form.feed.label.blocked_authors": "Artikelen van deze auteurs negeren (één per regel)",
+    "form.feed.label.auto_star": "Star automatically new entries of this feed",
+    "form.feed.label.archive_pages": "Keep a copy of the full web page of new entries",
+    "form.feed.label.hide_globally": "Artikelen verbergen in de globale lijst met ongelezen",
+    "form.feed.label.notify_errors": "Send a notification when this feed keeps failing",
+    "form.feed.label.cron_expression": "Vernieuwingsschema (cron-expressie)",
+    "form.feed.help.cron_expression": "Minuut, uur, dag van de maand, maand en dag van de week in uw tijdzone. Laat leeg om de standaardplanning te gebruiken.",
+    "form.feed.label.request_timeout": "Time-out van het verzoek (seconden)",
+    "form.feed.label.max_body_size": "Maximale grootte (MB)",
+    "form.feed.help.http_client_limits": "Gebruik 0 om de algemene instellingen te behouden.",
     "form.category.label.title": "Naam",
+    "form.category.label.move_feeds_to": "Move the feeds to",
+    "form.category.label.member_username": "Share with user",
     "form.user.label.username": "Gebruikersnaam",
+    "form.entry_send.label.username": "Recipient username",
+    "form.entry_send.label.message": "Message (optional)",
     "form.user.label.password": "Wachtwoord",
     "form.user.label.confirmation": "Bevestig wachtwoord",
     "form.user.label.admin": "Administrator",
@@ -2262,10 +4953,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "Skin",
     "form.prefs.label.entry_sorting": "Volgorde van items",
     "form.prefs.label.entries_per_page": "Inzendingen per pagina",
+    "form.prefs.label.home_page": "Startpagina",
+    "form.prefs.label.home_page_search": "Zoekopdracht op de startpagina",
+    "form.prefs.label.entry_timezone": "Publication dates",
+    "form.prefs.select.entry_timezone_local": "In my timezone",
+    "form.prefs.select.entry_timezone_original": "In the timezone of the feed",
+    "form.prefs.select.entry_timezone_both": "In both timezones",
+    "form.prefs.label.timestamp_format": "Dates in the lists",
+    "form.prefs.select.timestamp_relative": "Relative (2 hours ago)",
+    "form.prefs.select.timestamp_absolute": "Absolute (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "Oudere items eerst",
     "form.prefs.select.recent_first": "Recente items eerst",
+    "form.prefs.select.home_page_category": "Categorie",
+    "form.prefs.select.home_page_search": "Zoeken",
     "form.prefs.label.keyboard_shortcuts": "Schakel sneltoetsen in",
     "form.prefs.label.show_reading_time": "Toon geschatte leestijd voor artikelen",
+    "form.prefs.label.mark_read_on_original_link": "Artikelen als gelezen markeren bij het openen van de originele link",
+    "form.prefs.label.keep_starred_entries": "Keep starred articles when their feed or category is removed",
+    "form.prefs.label.accept_received_entries": "Accept entries sent by other users",
+    "form.prefs.label.archive_read_days": "Days to keep read articles",
+    "form.prefs.help.archive_read_days": "Leave empty to use the default of %d days, the maximum is %d days.",
+    "form.prefs.label.youtube_embed_url": "Invidious- of Piped-instantie om YouTube-video's af te spelen",
+    "form.prefs.label.blocked_authors": "Artikelen van deze auteurs in alle feeds negeren (één per regel)",
+    "form.prefs.label.auto_star_keywords": "Star automatically new entries matching these keywords (one regular expression per line)",
+    "form.prefs.label.auto_star_authors": "Star automatically new entries from these authors (one per line)",
+    "form.prefs.label.email_recipients": "Standaardontvangers bij delen via e-mail (kommagescheiden)",
+    "form.prefs.label.notification_email": "Email address for the notifications",
+    "form.prefs.help.notification_email_unverified": "This address is not verified yet, no notification is sent to it.",
     "form.prefs.label.custom_css": "Aangepaste CSS",
     "form.import.label.file": "OPML-bestand",
     "form.import.label.url": "URL",
@@ -2276,12 +4990,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "Artikelen opslaan naar Pinboard",
     "form.integration.pinboard_token": "Pinboard API token",
     "form.integration.pinboard_tags": "Pinboard tags",
+    "form.integration.pinboard_category_tag": "De categorie van de feed als tag toevoegen",
+    "form.integration.pinboard_entry_tags": "De tags van het artikel toevoegen",
+    "form.integration.pinboard_prompt_tags": "Om extra tags vragen bij het opslaan van een artikel",
     "form.integration.pinboard_bookmark": "Markeer bookmark als gelezen",
     "form.integration.instapaper_activate": "Artikelen opstaan naar Instapaper",
     "form.integration.instapaper_username": "Instapaper gebruikersnaam",
     "form.integration.instapaper_password": "Instapaper wachtwoord",
+    "form.integration.instapaper_folder": "Instapaper-map",
+    "form.integration.instapaper_folder_unread": "Ongelezen (standaard)",
+    "form.integration.instapaper_folder_id": "Instapaper-map-ID",
+    "form.integration.instapaper_folder_help": "The folders are listed once the integration is saved, save again to update the list. Leave empty to save articles in the Unread folder.",
     "form.integration.pocket_activate": "Bewaar artikelen in Pocket",
     "form.integration.pocket_consumer_key": "Pocket Consumer Key",
+    "form.integration.pocket_category_tag": "De categorie van de feed als tag toevoegen",
     "form.integration.pocket_access_token": "Pocket Access Token",
     "form.integration.pocket_connect_link": "Verbind je Pocket-account",
     "form.integration.wallabag_activate": "Opslaan naar Wallabag",
@@ -2290,12 +5012,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Wallabag Client-Secret",
     "form.integration.wallabag_username": "Wallabag gebruikersnaam",
     "form.integration.wallabag_password": "Wallabag wachtwoord",
+    "form.integration.wallabag_tags": "Wallabag-tags (kommagescheiden)",
+    "form.integration.wallabag_archive": "Artikelen die in Wallabag zijn opgeslagen archiveren",
     "form.integration.nunux_keeper_activate": "Opslaan naar Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper URL",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API-sleutel",
+    "form.integration.karakeep_activate": "Save articles to Karakeep",
+    "form.integration.karakeep_endpoint": "Karakeep server URL",
+    "form.integration.karakeep_api_key": "Karakeep API key",
+    "form.integration.karakeep_tags": "Karakeep tags (comma separated)",
+    "form.integration.archivebox_activate": "Archive saved and starred articles with ArchiveBox",
+    "form.integration.archivebox_endpoint": "ArchiveBox server URL",
+    "form.integration.archivebox_api_key": "ArchiveBox API key",
+    "form.integration.archivebox_tags": "ArchiveBox tags (comma separated)",
+    "form.integration.archivebox_help": "The link to the snapshot is shown on the article once ArchiveBox has finished archiving the page.",
+    "form.integration.ntfy_activate": "Send push notifications with ntfy",
+    "form.integration.ntfy_topic_url": "ntfy topic URL",
+    "form.integration.ntfy_token": "ntfy access token (optional)",
+    "form.integration.ntfy_help": "Feeds with error notifications enabled are reported to this topic when they keep failing.",
+    "form.integration.espial_activate": "Save articles to Espial",
+    "form.integration.espial_endpoint": "Espial server URL",
+    "form.integration.espial_api_key": "Espial API key",
+    "form.integration.espial_tags": "Espial tags (space separated)",
+    "form.integration.linkace_activate": "Save articles to LinkAce",
+    "form.integration.linkace_endpoint": "LinkAce server URL",
+    "form.integration.linkace_api_key": "LinkAce API token",
+    "form.integration.linkace_tags": "LinkAce tags (comma separated)",
+    "form.integration.linkace_lists": "LinkAce list IDs (comma separated)",
+    "form.integration.linkace_category_tag": "Add the category as tag",
+    "form.integration.linkace_is_private": "Save links as private",
+    "form.integration.custom_bookmark": "Eigen bladwijzerdienst",
+    "form.integration.custom_bookmark_activate": "Save articles to a custom bookmark service",
+    "form.integration.custom_bookmark_endpoint": "API-URL",
+    "form.integration.custom_bookmark_method": "HTTP-methode",
+    "form.integration.custom_bookmark_headers": "HTTP Headers (one per line)",
+    "form.integration.custom_bookmark_body": "JSON Body Template",
+    "form.integration.custom_bookmark_body_help": "Placeholders are replaced by JSON values, do not add quotes around them:",
+    "form.integration.markdown": "Markdown notes",
+    "form.integration.markdown_activate": "Save articles as Markdown files, for notes apps like Obsidian",
+    "form.integration.markdown_starred": "Also save starred articles",
+    "form.integration.markdown_webdav_url": "WebDAV folder URL",
+    "form.integration.markdown_webdav_username": "WebDAV Username",
+    "form.integration.markdown_webdav_password": "WebDAV Password",
+    "form.integration.markdown_local_help": "Leave the URL empty to write the files in the folder configured by the administrator.",
+    "form.integration.wayback_activate": "Archive saved and starred articles with the Wayback Machine",
+    "form.integration.wayback_help": "The web page is submitted to the Internet Archive and the link to the copy is shown on the article.",
+    "form.integration.zotero_activate": "Save articles to Zotero",
+    "form.integration.zotero_library_type": "Zotero library",
+    "form.integration.zotero_library_user": "Personal library",
+    "form.integration.zotero_library_group": "Group library",
+    "form.integration.zotero_library_id": "Zotero user or group ID",
+    "form.integration.zotero_api_key": "Zotero API key",
+    "form.integration.zotero_collection_key": "Zotero collection key (optional)",
+    "form.integration.zotero_help": "Articles linking to a DOI are saved as journal articles, the other ones as web pages.",
+    "form.integration.hypothesis_activate": "Publish the annotations of articles to Hypothesis",
+    "form.integration.hypothesis_token": "Hypothesis API token",
+    "form.integration.hypothesis_token_help": "The token can be generated on the developer page of your Hypothesis account.",
+    "form.integration.hypothesis_group": "Hypothesis group ID",
+    "form.integration.hypothesis_group_help": "The annotations are visible to the members of this group, the group __world__ makes them public.",
+    "form.integration.hypothesis_export_help": "All annotations can also be downloaded in the W3C Web Annotation format with the API:",
+    "form.integration.rssbridge_activate": "RSS-Bridge gebruiken wanneer een website geen feed heeft",
+    "form.integration.rssbridge_url": "URL van de RSS-Bridge-server",
+    "form.integration.routing": "Categorieën",
+    "form.integration.routing_help": "Services limited to some categories only receive the articles of the selected categories, and nothing when no category is selected.",
+    "form.integration.routing_restrict": "Limit to the selected categories",
     "form.api_key.label.description": "API-sleutellabel",
+    "form.muted_keyword.label.pattern": "Trefwoord of reguliere expressie",
+    "form.blocked_feed.label.pattern": "Feed URL or domain",
+    "form.blocked_feed.help.pattern": "A complete URL blocks only this feed, a domain name blocks all the feeds of the domain and its subdomains.",
+    "form.blocked_feed.label.reason": "Reason shown to users (optional)",
+    "form.muted_keyword.label.action": "Overeenkomende artikelen",
+    "form.muted_keyword.label.expires_at": "Vervaldatum (optioneel)",
+    "form.entry_email.label.recipients": "Ontvangers (kommagescheiden)",
+    "form.entry_email.label.note": "Persoonlijke notitie (optioneel)",
+    "form.muted_keyword.select.drop": "Negeren",
+    "form.muted_keyword.select.read": "Markeren als gelezen",
     "form.submit.loading": "Laden...",
     "form.submit.saving": "Opslaag...",
+    "form.submit.sending": "Verzenden...",
     "time_elapsed.not_yet": "in de toekomst",
     "time_elapsed.yesterday": "gisteren",
     "time_elapsed.now": "minder dan een minuut geleden",
@@ -2352,9 +5146,26 @@ var translations = map[string]string{
     "action.save": "Zapisz",
     "action.or": "lub",
     "action.cancel": "anuluj",
+    "action.send": "Wyślij",
+    "action.close": "Zamknij",
     "action.remove": "Usuń",
+    "action.add_to_queue": "Dodaj do kolejki",
+    "action.play_queue": "Odtwórz kolejkę",
+    "action.move_up": "Przenieś w górę",
+    "action.move_down": "Przenieś w dół",
+    "action.open": "Otwórz",
+    "action.save_search": "Zapisz to wyszukiwanie",
+    "action.unsubscribe_selected": "Unsubscribe from selected feeds",
+    "action.keep_selected": "Keep selected feeds",
     "action.remove_feed": "Usuń ten kanał",
+    "action.use_canonical_feed_url": "Użyj tego adresu URL",
     "action.update": "Zaktualizuj",
+    "action.send_verification_link": "Send a verification link",
+    "action.block_feed": "Block",
+    "action.share_category": "Share",
+    "action.hide_category": "Hide",
+    "action.unhide_category": "Show again",
+    "action.move_feeds_and_remove": "Move and remove",
     "action.edit": "Edytuj",
     "action.download": "Pobierz",
     "action.import": "Importuj",
@@ -2364,36 +5175,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "Zalogowany jako %s",
     "menu.unread": "Nieprzeczytane",
     "menu.starred": "Ulubione",
+    "menu.trending": "Trending",
+    "menu.podcasts": "Podcasty",
+    "menu.playback_queue": "Kolejka odtwarzania",
     "menu.history": "Historia",
     "menu.feeds": "Kanały",
     "menu.categories": "Kategorie",
+    "menu.today": "Dzisiaj",
     "menu.settings": "Ustawienia",
     "menu.logout": "Wyloguj się",
+    "menu.skip_to_content": "Przejdź do treści",
     "menu.preferences": "Preferencje",
     "menu.integrations": "Usługi",
     "menu.sessions": "Sesje",
     "menu.users": "Użytkownicy",
+    "menu.reports": "Reports",
+    "menu.blocked_feeds": "Blocked Feeds",
     "menu.about": "O stronie",
     "menu.export": "Eksportuj",
     "menu.import": "Importuj",
     "menu.create_category": "Utwórz kategorię",
+    "menu.hidden_categories": "Hidden categories",
     "menu.mark_page_as_read": "Oznacz jako przeczytane",
+    "menu.saved_searches": "Zapisane wyszukiwania",
+    "menu.clear_search_history": "Wyczyść historię wyszukiwania",
     "menu.mark_all_as_read": "Oznacz wszystko jako przeczytane",
     "menu.show_all_entries": "Pokaż wszystkie artykuły",
+    "menu.show_all_feeds": "Show all feeds",
     "menu.show_only_unread_entries": "Pokaż tylko nieprzeczytane artykuły",
     "menu.refresh_feed": "Odśwież",
     "menu.refresh_all_feeds": "Odśwież wszystkie subskrypcje w tle",
     "menu.edit_feed": "Edytuj",
     "menu.edit_category": "Edytuj",
     "menu.add_feed": "Dodaj subskrypcję",
+    "menu.feed_recommendations": "Recommendations",
     "menu.add_user": "Dodaj użytkownika",
     "menu.flush_history": "Usuń historię",
     "menu.feed_entries": "Artykuły",
     "menu.api_keys": "Klucze API",
     "menu.create_api_key": "Utwórz nowy klucz API",
+    "menu.muted_keywords": "Wyciszone słowa kluczowe",
+    "menu.create_muted_keyword": "Wycisz nowe słowo kluczowe",
     "menu.shared_entries": "Udostępnione wpisy",
+    "menu.received_entries": "Received entries",
     "search.label": "Szukaj",
     "search.placeholder": "Szukaj...",
+    "search.placeholder_feed": "Szukaj w tym kanale...",
+    "search.placeholder_category": "Szukaj w tej kategorii...",
     "pagination.next": "Następny",
     "pagination.previous": "Poprzedni",
     "entry.status.unread": "Nieprzeczytane",
@@ -2406,19 +5234,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "Oznaczone gwiazdką",
     "entry.bookmark.toast.off": "Bez gwiazdek",
     "entry.state.saving": "Zapisywanie...",
+    "entry.state.queued": "W kolejce",
     "entry.state.loading": "Ładowanie...",
+    "entry.state.archiving": "Archiving...",
     "entry.save.label": "Zapisz",
     "entry.save.title": "Zapisz ten artykuł",
     "entry.save.completed": "Gotowe!",
     "entry.save.toast.completed": "Artykuł zapisany",
+    "entry.save.prompt_tags": "Dodatkowe tagi (oddzielone spacjami)",
+    "entry.select.label": "Select",
+    "entry.bulk.selected": "%d selected",
+    "entry.bulk.save": "Save selected entries",
+    "entry.bulk.save.completed": "Entries sent to third-party services",
+    "entry.bulk.clear": "Clear selection",
     "entry.scraper.label": "Pobierz treść",
+    "entry.snapshot.title": "Open the copy of the web page kept by Miniflux",
+    "entry.snapshot.label": "Offline copy",
+    "entry.snapshot.create.title": "Keep a copy of the full web page, viewable even if the website goes offline",
+    "entry.snapshot.create.label": "Archive page",
+    "entry.snapshot.create.toast": "Web page archived",
     "entry.scraper.title": "Pobierz oryginalną treść",
     "entry.scraper.completed": "Gotowe!",
     "entry.original.label": "Oryginalny",
     "entry.comments.label": "Komentarze",
+    "entry.location.title": "Show the location on a map",
+    "entry.location.label": "Map",
+    "entry.archives.title": "Copies of the web page kept by archiving services",
+    "entry.archives.label": "Archived copy:",
     "entry.comments.title": "Zobacz komentarze",
+    "entry.print.label": "Drukuj",
+    "entry.print.title": "Drukuj lub zapisz jako PDF",
+    "entry.print.source": "Źródło",
+    "entry.print.url": "URL",
+    "entry.print.date": "Data",
+    "entry.email.label": "E-mail",
+    "entry.email.title": "Udostępnij e-mailem",
+    "email.entry.signature": "Udostępnione przez %s z %s za pomocą Miniflux",
+    "email.feed_error.subject": "The feed \"%s\" keeps failing",
+    "email.feed_error.body": "Miniflux was unable to refresh the feed \"%s\" (%s) %d times in a row.\n\nLast error: %s\n\nYou can review the feed here: %s",
+    "email.notification_email_verification.subject": "Confirm your email address for the Miniflux notifications",
+    "email.notification_email_verification.body": "The Miniflux user \"%s\" wants to receive notifications at this address.\n\nOpen this link to confirm it: %s\n\nIf you didn't ask for it, you can ignore this email.",
     "entry.share.label": "Podzielić się",
     "entry.share.title": "Podzielić się ten artykuł",
+    "entry.send.label": "Send",
+    "entry.send.title": "Send this article to another user",
     "entry.unshare.label": "Unshare",
     "entry.shared_entry.title": "Otwórz publiczny link",
     "entry.shared_entry.label": "Udostępnianie",
@@ -2426,10 +5285,46 @@ var translations = map[string]string{
         "%d minuta czytania",
         "%d minut czytania"
     ],
+    "entry.video_duration": [
+        "Wideo %d minuta",
+        "Wideo %d minut"
+    ],
+    "entry.score": [
+        "%d punkt",
+        "%d punktów",
+        "%d punktów"
+    ],
+    "entry.comments_count": [
+        "%d komentarz",
+        "%d komentarzy",
+        "%d komentarzy"
+    ],
+    "entry.duplicates": [
+        "%d podobny artykuł",
+        "%d podobne artykuły",
+        "%d podobnych artykułów"
+    ],
+    "entry.removed_trackers": [
+        "%d tracker usunięty",
+        "%d trackery usunięte",
+        "%d trackerów usuniętych"
+    ],
     "page.shared_entries.title": "Udostępnione wpisy",
+    "page.received_entries.title": "Received Entries",
+    "page.received_entries.sender": "Sent by %s",
+    "page.entry_send.title": "Send to another user",
     "page.unread.title": "Nieprzeczytane",
     "page.starred.title": "Oznaczone gwiazdką",
+    "page.orphaned_saves.title": "Orphaned saves",
+    "page.trending.title": "Trending",
+    "page.trending.feed_count": [
+        "(%d feed)",
+        "(%d feeds)",
+        "(%d feeds)"
+    ],
     "page.categories.title": "Kategorie",
+    "page.hidden_categories.title": "Hidden Categories",
+    "page.hidden_categories.help": "Hidden categories keep their subscriptions up to date but their articles are left out of the unread list and its counters.",
     "page.categories.no_feed": "Brak kanałów.",
     "page.categories.entries": "Artykuły",
     "page.categories.feeds": "Subskrypcje",
@@ -2441,9 +5336,29 @@ var translations = map[string]string{
     "page.new_category.title": "Nowa kategoria",
     "page.new_user.title": "Nowy użytkownik",
     "page.edit_category.title": "Edycja Kategorii: %s",
+    "page.remove_category.title": "Remove Category: %s",
+    "page.remove_category.help": [
+        "This category contains %d feed. It will be moved to the category below before the removal.",
+        "This category contains %d feeds. They will be moved to the category below before the removal.",
+        "This category contains %d feeds. They will be moved to the category below before the removal."
+    ],
+    "page.edit_category.members": "Members",
+    "page.edit_category.member_since": "Member since",
+    "page.edit_category.members_help": "Members see the feeds of this category in their own account with their own read and starred status, once they accept the invitation. Feeds are fetched only once for everyone.",
+    "page.edit_category.shared_by": "This category is shared by %s. New entries are fetched by its owner.",
+    "page.edit_category.invitation_pending": "Invitation pending",
+    "page.categories.invitation": "%s invites you to follow the category “%s”.",
+    "action.accept_invitation": "Accept",
+    "action.decline_invitation": "Decline",
+    "action.leave_shared_category": "Stop following this category",
     "page.edit_user.title": "Edytuj użytkownika: %s",
     "page.feeds.title": "Kanały",
     "page.feeds.last_check": "Ostatnia aktualizacja:",
+    "page.feed_entries.volume": [
+        "%d entry during the last %d days",
+        "%d entries during the last %d days",
+        "%d entries during the last %d days"
+    ],
     "page.feeds.unread_counter": "Liczba nieprzeczytanych wpisów",
     "page.feeds.read_counter": "Liczba przeczytanych wpisów",
     "page.feeds.error_count": [
@@ -2451,16 +5366,64 @@ var translations = map[string]string{
         "%d błąd",
         "%d błędów"
     ],
+    "page.feeds.dead": "Usunięty przez wydawcę",
+    "page.feeds.dormant": "Dormant",
+    "page.feeds.dormant_title": "This feed hasn't published anything for a long time",
+    "page.feeds.move.hint": "Drag feeds to a category, or select several feeds and click a category:",
+    "page.feeds.move.selected": "Move the %d selected feeds to:",
+    "page.feeds.move.loading": "Moving...",
+    "page.unused_feeds.title": "Unread Feeds",
+    "page.unused_feeds.description": [
+        "You haven't read or starred anything from these feeds for %d month.",
+        "You haven't read or starred anything from these feeds for %d months.",
+        "You haven't read or starred anything from these feeds for %d months."
+    ],
+    "page.unused_feeds.last_read": "Last read:",
+    "page.unused_feeds.never_read": "Never read",
     "page.history.title": "Historia",
+    "page.today.title": "Dzisiaj",
+    "page.podcasts.title": "Podcasty",
+    "page.playback_queue.title": "Kolejka odtwarzania",
+    "page.podcasts.total_duration": "Łączny czas słuchania: %s",
+    "page.digest.title": "Since your last visit",
+    "page.digest.since": "Unread articles received since",
+    "page.digest.continue": "Continue reading",
+    "page.digest.top_entries": "Top articles",
+    "page.digest.entry_count": [
+        "%d new article",
+        "%d new articles",
+        "%d new articles"
+    ],
     "page.import.title": "Importuj",
     "page.search.title": "Wyniki wyszukiwania",
+    "page.search.syntax_help": "Zawęź wyszukiwanie za pomocą title:, author:, feed:, tag:, before:2006-01-02 i after:2006-01-02, użyj cudzysłowów dla dokładnych fraz i ukośników dla wyrażeń regularnych, na przykład title:/^release/.",
+    "page.search.saved": "Zapisane wyszukiwanie",
+    "page.saved_searches.title": "Zapisane wyszukiwania",
+    "page.saved_searches.recent": "Ostatnie wyszukiwania",
+    "page.saved_searches.table.query": "Wyszukiwanie",
+    "page.saved_searches.table.actions": "Działania",
+    "page.search.scope_feed": "Kanał: %s",
+    "page.search.scope_category": "Kategoria: %s",
+    "page.search.all_entries": "Szukaj we wszystkich artykułach",
     "page.about.title": "O",
     "page.about.credits": "Prawa autorskie",
     "page.about.version": "Wersja:",
     "page.about.build_date": "Data opracowania:",
+    "page.about.features": "Features",
+    "page.about.image_proxy": "Image proxy:",
+    "page.about.search_backend": "Search engine:",
+    "page.about.websub": "WebSub:",
+    "page.about.integrations": "Integrations:",
+    "page.about.enabled": "Enabled",
+    "page.about.disabled": "Disabled",
     "page.about.author": "Autor:",
     "page.about.license": "Licencja:",
     "page.add_feed.title": "Nowa subskrypcja",
+    "page.feed_recommendations.title": "Recommended Feeds",
+    "page.feed_recommendations.table.feed": "Feed",
+    "page.feed_recommendations.table.category": "Category",
+    "page.feed_recommendations.table.subscribers": "Subscribers",
+    "page.feed_recommendations.table.actions": "Actions",
     "page.add_feed.no_category": "Nie ma żadnej kategorii. Musisz mieć co najmniej jedną kategorię.",
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Znajdź subskrypcję",
@@ -2471,8 +5434,25 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "Ostatnio zmienione:",
     "page.edit_feed.etag_header": "Nagłówek ETag:",
     "page.edit_feed.no_header": "Brak",
+    "page.edit_feed.last_fetch_url": "Końcowy adres URL:",
+    "page.edit_feed.last_fetch_content_type": "Nagłówek Content-Type:",
+    "page.edit_feed.last_fetch_size": "Rozmiar:",
+    "page.edit_feed.bytes": [
+        "%d bajt",
+        "%d bajty",
+        "%d bajtów"
+    ],
     "page.edit_feed.last_parsing_error": "Ostatni błąd analizy",
+    "page.edit_feed.canonical_feed_url": "Ten kanał wskazuje inny adres URL: %s",
     "page.entry.attachments": "Załączniki",
+    "page.entry.comments": "Comments",
+    "page.entry.comments.follow": "Follow the discussion",
+    "page.entry.comments.unfollow": "Stop following",
+    "page.entry.comments.following": [
+        "New comments will be fetched for %d more day.",
+        "New comments will be fetched for %d more days.",
+        "New comments will be fetched for %d more days."
+    ],
     "page.keyboard_shortcuts.title": "Skróty klawiszowe",
     "page.keyboard_shortcuts.subtitle.sections": "Nawigacja między punktami menu",
     "page.keyboard_shortcuts.subtitle.items": "Nawigacja między artykułami",
@@ -2493,6 +5473,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.open_item": "Otwórz zaznaczony artykuł",
     "page.keyboard_shortcuts.open_original": "Otwórz oryginalny artykuł",
     "page.keyboard_shortcuts.open_original_same_window": "Otwórz oryginalny link w bieżącej karcie",
+    "page.keyboard_shortcuts.open_original_background": "Open original link in background tab",
     "page.keyboard_shortcuts.open_comments": "Otwórz link do komentarzy",
     "page.keyboard_shortcuts.open_comments_same_window": "Otwórz link do komentarzy w bieżącej karcie",
     "page.keyboard_shortcuts.toggle_read_status": "Oznacz jako przeczytane/nieprzeczytane",
@@ -2505,6 +5486,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "Ustaw fokus na formularzu wyszukiwania",
     "page.keyboard_shortcuts.close_modal": "Zamknij listę skrótów klawiszowych",
     "page.users.title": "Użytkownicy",
+    "page.reports.title": "Reports",
+    "page.reports.database_size": "Database size",
+    "page.reports.storage": "Storage",
+    "page.reports.feeds": "Feeds",
+    "page.reports.entries": "Articles",
+    "page.reports.last_activity": "Last activity",
     "page.users.username": "Nazwa użytkownika",
     "page.users.never_logged": "Nigdy",
     "page.users.admin.yes": "Tak",
@@ -2544,30 +5531,95 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Działania",
     "page.api_keys.never_used": "Nigdy nie używany",
     "page.new_api_key.title": "Nowy klucz API",
+    "page.muted_keywords.title": "Wyciszone słowa kluczowe",
+    "page.blocked_feeds.title": "Blocked Feeds",
+    "page.blocked_feeds.help": "Nobody can subscribe to these feeds on this instance. Blocking a domain also blocks all its subdomains. Existing subscriptions are disabled.",
+    "page.blocked_feeds.table.pattern": "Feed URL or domain",
+    "page.blocked_feeds.table.reason": "Reason",
+    "page.blocked_feeds.table.created_at": "Blocked",
+    "page.blocked_feeds.table.actions": "Actions",
+    "page.muted_keywords.help": "New entries of all your feeds matching one of these keywords or regular expressions are ignored or marked as read.",
+    "page.muted_keywords.table.pattern": "Keyword or Regex",
+    "page.muted_keywords.table.action": "Action",
+    "page.muted_keywords.table.expires_at": "Expiration Date",
+    "page.muted_keywords.table.actions": "Actions",
+    "page.muted_keywords.never_expires": "Never",
+    "page.muted_keywords.expired": "Expired",
+    "page.new_muted_keyword.title": "New Muted Keyword",
+    "page.entry_email.title": "Udostępnij e-mailem",
     "alert.no_shared_entry": "Brak wspólnego wpisu.",
+    "alert.blocked_feed_created": [
+        "The feed has been blocked, %d existing subscription has been disabled.",
+        "The feed has been blocked, %d existing subscriptions have been disabled."
+    ],
+    "alert.no_received_entry": "There is no received entry.",
     "alert.no_bookmark": "Obecnie nie ma żadnych zakładek.",
+    "alert.no_trending_topic": "There is no trending topic at the moment.",
     "alert.no_category": "Nie ma żadnej kategorii!",
+    "alert.no_hidden_category": "There is no hidden category.",
+    "alert.no_category_to_move_feeds": "Create another category first to receive the feeds of this one.",
+    "alert.category_invitation_sent": "An invitation to follow this category has been sent to %s.",
+    "alert.no_feed_recommendation": "There is no recommendation for your categories at the moment.",
     "alert.no_category_entry": "W tej kategorii nie ma żadnych artykułów",
     "alert.no_feed_entry": "Nie ma artykułu dla tego kanału.",
     "alert.no_feed": "Nie masz żadnej subskrypcji.",
+    "alert.unused_feeds": [
+        "%d feed hasn't been read for a long time, review it.",
+        "%d feeds haven't been read for a long time, review them.",
+        "%d feeds haven't been read for a long time, review them."
+    ],
+    "alert.dormant_feeds": [
+        "%d feed hasn't published anything for a long time, review it.",
+        "%d feeds haven't published anything for a long time, review them.",
+        "%d feeds haven't published anything for a long time, review them."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Feeds without new entries for %d day.",
+        "Feeds without new entries for %d days.",
+        "Feeds without new entries for %d days."
+    ],
+    "alert.no_unused_feed": "You read all your subscriptions, nothing to clean up.",
     "alert.no_feed_in_category": "Nie ma subskrypcji dla tej kategorii.",
     "alert.no_history": "Obecnie nie ma żadnej historii.",
     "alert.feed_error": "Z tym kanałem jest problem",
+    "alert.feed_dead": "Ten kanał nie jest już dostępny i nie jest automatycznie odświeżany",
     "alert.no_search_result": "Brak wyników dla tego wyszukiwania.",
+    "alert.no_saved_search": "Brak zapisanych wyszukiwań.",
     "alert.no_unread_entry": "Nie ma żadnych nieprzeczytanych artykułów.",
+    "alert.no_today_entry": "No article has been published today.",
+    "alert.no_podcast_entry": "Brak nieprzeczytanych odcinków podcastów.",
+    "alert.no_queued_entry": "Kolejka odtwarzania jest pusta, dodaj odcinki ze strony podcastów.",
+    "alert.no_digest_entry": "Nothing new since your last visit.",
     "alert.no_user": "Jesteś jedynym użytkownikiem.",
     "alert.account_unlinked": "Twoje konto zewnętrzne jest teraz zdysocjowane!",
     "alert.account_linked": "Twoje konto zewnętrzne jest teraz połączone!",
     "alert.pocket_linked": "Twoje konto Pocket jest teraz połączone!",
     "alert.prefs_saved": "Ustawienia zapisane!",
+    "alert.notification_email_verification_sent": "A verification link has been sent to the notification email address.",
+    "alert.notification_email_verified": "The notification email address is verified.",
+    "alert.entry_sent_by_email": "Artykuł został wysłany e-mailem.",
     "error.unlink_account_without_password": "Musisz zdefiniować hasło, inaczej nie będziesz mógł się ponownie zalogować.",
     "error.duplicate_linked_account": "Już ktoś jest powiązany z tym dostawcą!",
     "error.duplicate_fever_username": "Już ktoś inny używa tej nazwy użytkownika Fever!",
+    "error.custom_bookmark_invalid": "Invalid custom bookmark service settings: %v.",
+    "error.markdown_invalid": "Invalid Markdown notes settings: %v.",
+    "error.markdown_webdav_url_required": "The WebDAV folder URL is required to save Markdown notes.",
+    "error.hypothesis_token_required": "The Hypothesis API token is required.",
+    "error.hypothesis_group_required": "The Hypothesis group ID is required.",
+    "error.zotero_invalid": "Invalid Zotero settings: %v.",
+    "error.instapaper_invalid": "Unable to connect to Instapaper with these credentials: %v.",
+    "error.ntfy_invalid_topic_url": "The ntfy topic URL is invalid.",
+    "error.linkace_invalid_lists": "Invalid LinkAce lists: %v.",
+    "error.archivebox_credentials_required": "The ArchiveBox server URL and API key are required.",
     "error.pocket_request_token": "Nie można pobrać tokena żądania z Pocket!",
     "error.pocket_access_token": "Nie można pobrać tokena dostępu z Pocket!",
     "error.category_already_exists": "Ta kategoria już istnieje.",
+    "error.category_member_not_found": "This user does not exist.",
+    "error.category_shared_with_you": "This category is shared by %s and cannot be shared again.",
     "error.unable_to_create_category": "Ta kategoria nie mogła zostać utworzona.",
     "error.unable_to_update_category": "Ta kategoria nie mogła zostać zaktualizowana.",
+    "error.unable_to_remove_category": "Unable to remove this category.",
+    "error.category_remove_target": "Choose another category for the feeds.",
     "error.user_already_exists": "Ten użytkownik już istnieje.",
     "error.unable_to_create_user": "Nie można utworzyć tego użytkownika.",
     "error.unable_to_update_user": "Nie można zaktualizować tego użytkownika.",
@@ -2576,30 +5628,82 @@ var translations = map[string]string{
     "error.empty_file": "Ten plik jest pusty.",
     "error.bad_credentials": "Nieprawidłowa nazwa użytkownika lub hasło.",
     "error.fields_mandatory": "Wszystkie pola są obowiązkowe.",
+    "error.no_feed_selected": "No feed selected.",
     "error.title_required": "Tytuł jest obowiązkowy.",
     "error.different_passwords": "Hasła nie są identyczne.",
     "error.password_min_length": "Musisz użyć co najmniej 6 znaków.",
     "error.settings_mandatory_fields": "Pola nazwy użytkownika, tematu, języka i strefy czasowej są obowiązkowe.",
     "error.entries_per_page_invalid": "Liczba wpisów na stronę jest nieprawidłowa.",
+    "error.invalid_youtube_embed_url": "The Invidious or Piped instance URL is not valid.",
+    "error.invalid_auto_star_keywords": "One of the auto-star keywords is not a valid regular expression.",
+    "error.invalid_email_recipients": "Jeden z odbiorców jest nieprawidłowy lub jest ich zbyt wielu.",
+    "error.invalid_notification_email": "The email address for the notifications is invalid.",
+    "error.invalid_notification_email_token": "This verification link is invalid or has already been used.",
+    "error.invalid_home_page": "The home page is invalid, a search query is required to open a search.",
+    "error.invalid_entry_timezone": "The timezone of the publication dates is invalid.",
+    "error.invalid_timestamp_format": "The format of the dates is invalid.",
+    "error.unable_to_send_email": "Nie można wysłać wiadomości e-mail.",
+    "error.too_many_sent_emails": "You have sent too many emails, please try again later.",
     "error.feed_mandatory_fields": "URL i kategoria są obowiązkowe.",
+    "error.invalid_encoding": "This character encoding is not supported.",
+    "error.invalid_cron_expression": "Nieprawidłowe wyrażenie cron.",
+    "error.invalid_feed_request_limits": "The request timeout or the maximum size is out of the allowed range.",
+    "error.feed_disabled_after_errors": [
+        "Ten kanał został automatycznie wyłączony po %d tygodniu błędów: %s",
+        "Ten kanał został automatycznie wyłączony po %d tygodniach błędów: %s",
+        "Ten kanał został automatycznie wyłączony po %d tygodniach błędów: %s"
+    ],
     "error.user_mandatory_fields": "Nazwa użytkownika jest obowiązkowa.",
     "error.api_key_already_exists": "Deze API-sleutel bestaat al.",
     "error.unable_to_create_api_key": "Nie można utworzyć tego klucza API.",
+    "error.muted_keyword_already_exists": "This keyword is already muted.",
+    "error.blocked_feed_already_exists": "This feed URL or domain is already blocked.",
+    "error.invalid_blocked_feed": "Enter a complete feed URL or a domain name.",
+    "error.unable_to_block_feed": "Unable to block this feed.",
+    "error.unable_to_create_muted_keyword": "Unable to mute this keyword.",
+    "error.invalid_muted_keyword": "Invalid keyword or regular expression.",
+    "error.invalid_expiration_date": "Invalid expiration date.",
+    "error.too_many_sent_entries": "You have sent too many entries, please try again later.",
     "form.feed.label.title": "Tytuł",
+    "form.feed.help.original_title": "Oryginalny tytuł: %s",
+    "form.feed.help.previous_feed_url": "Adres URL kanału został automatycznie zaktualizowany. Poprzedni adres URL: %s",
     "form.feed.label.site_url": "URL strony",
     "form.feed.label.feed_url": "URL kanału",
     "form.feed.label.category": "Kategoria",
     "form.feed.label.crawler": "Pobierz oryginalną treść",
     "form.feed.label.feed_username": "Subskrypcję nazwa użytkownika",
     "form.feed.label.feed_password": "Subskrypcję Hasło",
+    "form.feed.label.bearer_token": "Feed Bearer Token",
+    "form.feed.label.oauth2_token_url": "OAuth2 Token URL",
+    "form.feed.label.oauth2_client_id": "OAuth2 Client ID",
+    "form.feed.label.oauth2_client_secret": "OAuth2 Client Secret",
+    "form.feed.label.oauth2_scopes": "OAuth2 Scopes (separated by spaces)",
     "form.feed.label.user_agent": "Zastąp domyślny agent użytkownika",
     "form.feed.label.scraper_rules": "Zasady ekstrakcji",
     "form.feed.label.rewrite_rules": "Reguły zapisu",
+    "form.feed.label.encoding": "Character Encoding Override",
     "form.feed.label.ignore_http_cache": "Zignoruj ​​pamięć podręczną HTTP",
     "form.feed.label.fetch_via_proxy": "Pobierz przez proxy",
     "form.feed.label.disabled": "Не обновлять этот канал",
+    "form.feed.label.open_external_link": "Open entries directly on the original website",
+    "form.feed.label.fetch_scores": "Fetch score and number of comments from Reddit and Hacker News",
+    "form.feed.label.minimum_score": "Ignore entries with a score lower than",
+    "form.feed.label.blocked_authors": "Ignore entries written by these authors (one per line)",
+    "form.feed.label.auto_star": "Star automatically new entries of this feed",
+    "form.feed.label.archive_pages": "Keep a copy of the full web page of new entries",
+    "form.feed.label.hide_globally": "Ukryj artykuły na globalnej liście nieprzeczytanych",
+    "form.feed.label.notify_errors": "Send a notification when this feed keeps failing",
+    "form.feed.label.cron_expression": "Harmonogram odświeżania (wyrażenie cron)",
+    "form.feed.help.cron_expression": "Minuta, godzina, dzień miesiąca, miesiąc i dzień tygodnia w Twojej strefie czasowej. Pozostaw puste, aby użyć domyślnego harmonogramu.",
+    "form.feed.label.request_timeout": "Limit czasu żądania (sekundy)",
+    "form.feed.label.max_body_size": "Maksymalny rozmiar (MB)",
+    "form.feed.help.http_client_limits": "Użyj 0, aby zachować ustawienia globalne.",
     "form.category.label.title": "Tytuł",
+    "form.category.label.move_feeds_to": "Move the feeds to",
+    "form.category.label.member_username": "Share with user",
     "form.user.label.username": "Nazwa użytkownika",
+    "form.entry_send.label.username": "Recipient username",
+    "form.entry_send.label.message": "Message (optional)",
     "form.user.label.password": "Hasło",
     "form.user.label.confirmation": "Potwierdzenie hasła",
     "form.user.label.admin": "Administrator",
@@ -2608,10 +5712,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "Wygląd",
     "form.prefs.label.entry_sorting": "Sortowanie artykułów",
     "form.prefs.label.entries_per_page": "Wpisy na stronie",
+    "form.prefs.label.home_page": "Home page",
+    "form.prefs.label.home_page_search": "Search query opened on the home page",
+    "form.prefs.label.entry_timezone": "Publication dates",
+    "form.prefs.select.entry_timezone_local": "In my timezone",
+    "form.prefs.select.entry_timezone_original": "In the timezone of the feed",
+    "form.prefs.select.entry_timezone_both": "In both timezones",
+    "form.prefs.label.timestamp_format": "Dates in the lists",
+    "form.prefs.select.timestamp_relative": "Relative (2 hours ago)",
+    "form.prefs.select.timestamp_absolute": "Absolute (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "Najstarsze wpisy jako pierwsze",
     "form.prefs.label.keyboard_shortcuts": "Włącz skróty klawiaturowe",
     "form.prefs.label.show_reading_time": "Pokaż szacowany czas czytania artykułów",
+    "form.prefs.label.mark_read_on_original_link": "Mark entries as read when opening the original link",
+    "form.prefs.label.keep_starred_entries": "Keep starred articles when their feed or category is removed",
+    "form.prefs.label.accept_received_entries": "Accept entries sent by other users",
+    "form.prefs.label.archive_read_days": "Days to keep read articles",
+    "form.prefs.help.archive_read_days": "Leave empty to use the default of %d days, the maximum is %d days.",
+    "form.prefs.label.youtube_embed_url": "Invidious or Piped instance used to play YouTube videos",
+    "form.prefs.label.blocked_authors": "Ignore entries written by these authors in all feeds (one per line)",
+    "form.prefs.label.auto_star_keywords": "Star automatically new entries matching these keywords (one regular expression per line)",
+    "form.prefs.label.auto_star_authors": "Star automatically new entries from these authors (one per line)",
+    "form.prefs.label.email_recipients": "Domyślni odbiorcy przy udostępnianiu e-mailem (oddzieleni przecinkami)",
+    "form.prefs.label.notification_email": "Email address for the notifications",
+    "form.prefs.help.notification_email_unverified": "This address is not verified yet, no notification is sent to it.",
     "form.prefs.select.recent_first": "Najnowsze wpisy jako pierwsze",
+    "form.prefs.select.home_page_category": "Category",
+    "form.prefs.select.home_page_search": "Search",
     "form.prefs.label.custom_css": "Niestandardowy CSS",
     "form.import.label.file": "Plik OPML",
     "form.import.label.url": "URL",
@@ -2622,12 +5749,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "Zapisz artykuł w Pinboard",
     "form.integration.pinboard_token": "Token Pinboard API",
     "form.integration.pinboard_tags": "Pinboard Tags",
+    "form.integration.pinboard_category_tag": "Dodaj kategorię kanału jako tag",
+    "form.integration.pinboard_entry_tags": "Dodaj tagi artykułu",
+    "form.integration.pinboard_prompt_tags": "Pytaj o dodatkowe tagi przy zapisywaniu artykułu",
     "form.integration.pinboard_bookmark": "Zaznacz zakładkę jako nieprzeczytaną",
     "form.integration.instapaper_activate": "Zapisz artykuł w Instapaper",
     "form.integration.instapaper_username": "Login do Instapaper",
     "form.integration.instapaper_password": "Hasło do Instapaper",
+    "form.integration.instapaper_folder": "Instapaper Folder",
+    "form.integration.instapaper_folder_unread": "Unread (default)",
+    "form.integration.instapaper_folder_id": "Instapaper Folder ID",
+    "form.integration.instapaper_folder_help": "The folders are listed once the integration is saved, save again to update the list. Leave empty to save articles in the Unread folder.",
     "form.integration.pocket_activate": "Zapisz artykuły w Pocket",
     "form.integration.pocket_consumer_key": "Pocket Consumer Key",
+    "form.integration.pocket_category_tag": "Dodaj kategorię kanału jako tag",
     "form.integration.pocket_access_token": "Token dostępu kieszeń",
     "form.integration.pocket_connect_link": "Połącz swoje konto Pocket",
     "form.integration.wallabag_activate": "Zapisz artykuły do Wallabag",
@@ -2636,12 +5771,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Wallabag Client Secret",
     "form.integration.wallabag_username": "Login do Wallabag",
     "form.integration.wallabag_password": "Hasło do Wallabag",
+    "form.integration.wallabag_tags": "Tagi Wallabag (oddzielone przecinkami)",
+    "form.integration.wallabag_archive": "Archiwizuj artykuły zapisane w Wallabag",
     "form.integration.nunux_keeper_activate": "Zapisz artykuly do Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper URL",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
+    "form.integration.karakeep_activate": "Save articles to Karakeep",
+    "form.integration.karakeep_endpoint": "Karakeep server URL",
+    "form.integration.karakeep_api_key": "Karakeep API key",
+    "form.integration.karakeep_tags": "Karakeep tags (comma separated)",
+    "form.integration.archivebox_activate": "Archive saved and starred articles with ArchiveBox",
+    "form.integration.archivebox_endpoint": "ArchiveBox server URL",
+    "form.integration.archivebox_api_key": "ArchiveBox API key",
+    "form.integration.archivebox_tags": "ArchiveBox tags (comma separated)",
+    "form.integration.archivebox_help": "The link to the snapshot is shown on the article once ArchiveBox has finished archiving the page.",
+    "form.integration.ntfy_activate": "Send push notifications with ntfy",
+    "form.integration.ntfy_topic_url": "ntfy topic URL",
+    "form.integration.ntfy_token": "ntfy access token (optional)",
+    "form.integration.ntfy_help": "Feeds with error notifications enabled are reported to this topic when they keep failing.",
+    "form.integration.espial_activate": "Save articles to Espial",
+    "form.integration.espial_endpoint": "Espial server URL",
+    "form.integration.espial_api_key": "Espial API key",
+    "form.integration.espial_tags": "Espial tags (space separated)",
+    "form.integration.linkace_activate": "Save articles to LinkAce",
+    "form.integration.linkace_endpoint": "LinkAce server URL",
+    "form.integration.linkace_api_key": "LinkAce API token",
+    "form.integration.linkace_tags": "LinkAce tags (comma separated)",
+    "form.integration.linkace_lists": "LinkAce list IDs (comma separated)",
+    "form.integration.linkace_category_tag": "Add the category as tag",
+    "form.integration.linkace_is_private": "Save links as private",
+    "form.integration.custom_bookmark": "Custom bookmark service",
+    "form.integration.custom_bookmark_activate": "Save articles to a custom bookmark service",
+    "form.integration.custom_bookmark_endpoint": "API Endpoint",
+    "form.integration.custom_bookmark_method": "HTTP Method",
+    "form.integration.custom_bookmark_headers": "HTTP Headers (one per line)",
+    "form.integration.custom_bookmark_body": "JSON Body Template",
+    "form.integration.custom_bookmark_body_help": "Placeholders are replaced by JSON values, do not add quotes around them:",
+    "form.integration.markdown": "Markdown notes",
+    "form.integration.markdown_activate": "Save articles as Markdown files, for notes apps like Obsidian",
+    "form.integration.markdown_starred": "Also save starred articles",
+    "form.integration.markdown_webdav_url": "WebDAV folder URL",
+    "form.integration.markdown_webdav_username": "WebDAV Username",
+    "form.integration.markdown_webdav_password": "WebDAV Password",
+    "form.integration.markdown_local_help": "Leave the URL empty to write the files in the folder configured by the administrator.",
+    "form.integration.wayback_activate": "Archive saved and starred articles with the Wayback Machine",
+    "form.integration.wayback_help": "The web page is submitted to the Internet Archive and the link to the copy is shown on the article.",
+    "form.integration.zotero_activate": "Save articles to Zotero",
+    "form.integration.zotero_library_type": "Zotero library",
+    "form.integration.zotero_library_user": "Personal library",
+    "form.integration.zotero_library_group": "Group library",
+    "form.integration.zotero_library_id": "Zotero user or group ID",
+    "form.integration.zotero_api_key": "Zotero API key",
+    "form.integration.zotero_collection_key": "Zotero collection key (optional)",
+    "form.integration.zotero_help": "Articles linking to a DOI are saved as journal articles, the other ones as web pages.",
+    "form.integration.hypothesis_activate": "Publish the annotations of articles to Hypothesis",
+    "form.integration.hypothesis_token": "Hypothesis API token",
+    "form.integration.hypothesis_token_help": "The token can be generated on the developer page of your Hypothesis account.",
+    "form.integration.hypothesis_group": "Hypothesis group ID",
+    "form.integration.hypothesis_group_help": "The annotations are visible to the members of this group, the group __world__ makes them public.",
+    "form.integration.hypothesis_export_help": "All annotations can also be downloaded in the W3C Web Annotation format with the API:",
+    "form.integration.rssbridge_activate": "Use RSS-Bridge when a website doesn't have any feed",
+    "form.integration.rssbridge_url": "RSS-Bridge server URL",
+    "form.integration.routing": "Kategorie",
+    "form.integration.routing_help": "Services limited to some categories only receive the articles of the selected categories, and nothing when no category is selected.",
+    "form.integration.routing_restrict": "Limit to the selected categories",
     "form.api_key.label.description": "Etykieta klucza API",
+    "form.muted_keyword.label.pattern": "Keyword or regular expression",
+    "form.blocked_feed.label.pattern": "Feed URL or domain",
+    "form.blocked_feed.help.pattern": "A complete URL blocks only this feed, a domain name blocks all the feeds of the domain and its subdomains.",
+    "form.blocked_feed.label.reason": "Reason shown to users (optional)",
+    "form.muted_keyword.label.action": "Matching entries",
+    "form.muted_keyword.label.expires_at": "Expiration date (optional)",
+    "form.entry_email.label.recipients": "Odbiorcy (oddzieleni przecinkami)",
+    "form.entry_email.label.note": "Notatka osobista (opcjonalnie)",
+    "form.muted_keyword.select.drop": "Ignore",
+    "form.muted_keyword.select.read": "Mark as read",
     "form.submit.loading": "Ładowanie...",
     "form.submit.saving": "Zapisywanie...",
+    "form.submit.sending": "Wysyłanie...",
     "time_elapsed.not_yet": "jeszcze nie",
     "time_elapsed.yesterday": "wczoraj",
     "time_elapsed.now": "przed chwilą",
@@ -2704,9 +5911,26 @@ var translations = map[string]string{
     "action.save": "Salvar",
     "action.or": "Ou",
     "action.cancel": "Cancelar",
+    "action.send": "Enviar",
+    "action.close": "Fechar",
     "action.remove": "Remover",
+    "action.add_to_queue": "Adicionar à fila",
+    "action.play_queue": "Reproduzir a fila",
+    "action.move_up": "Mover para cima",
+    "action.move_down": "Mover para baixo",
+    "action.open": "Abrir",
+    "action.save_search": "Salvar esta pesquisa",
+    "action.unsubscribe_selected": "Unsubscribe from selected feeds",
+    "action.keep_selected": "Keep selected feeds",
     "action.remove_feed": "Remover fonte",
+    "action.use_canonical_feed_url": "Usar esta URL",
     "action.update": "Atualizar",
+    "action.send_verification_link": "Send a verification link",
+    "action.block_feed": "Block",
+    "action.share_category": "Share",
+    "action.hide_category": "Hide",
+    "action.unhide_category": "Show again",
+    "action.move_feeds_and_remove": "Move and remove",
     "action.edit": "Editar",
     "action.download": "Baixar",
     "action.import": "Importar",
@@ -2716,36 +5940,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "Autenticado como %s",
     "menu.unread": "Não lido",
     "menu.starred": "Favoritos",
+    "menu.trending": "Trending",
+    "menu.podcasts": "Podcasts",
+    "menu.playback_queue": "Fila de reprodução",
     "menu.history": "Histórico",
     "menu.feeds": "Fontes",
     "menu.categories": "Categorias",
+    "menu.today": "Hoje",
     "menu.settings": "Configurações",
     "menu.logout": "Encerrar sessão",
+    "menu.skip_to_content": "Pular para o conteúdo",
     "menu.preferences": "Preferências",
     "menu.integrations": "Integrações",
     "menu.sessions": "Sessões",
     "menu.users": "Usuários",
+    "menu.reports": "Reports",
+    "menu.blocked_feeds": "Blocked Feeds",
     "menu.about": "Sobre",
     "menu.export": "Exportar",
     "menu.import": "Importar",
     "menu.create_category": "Criar uma categoria",
+    "menu.hidden_categories": "Hidden categories",
     "menu.mark_page_as_read": "Marcar essa página como lída",
+    "menu.saved_searches": "Pesquisas salvas",
+    "menu.clear_search_history": "Limpar o histórico de pesquisa",
     "menu.mark_all_as_read": "Marcar todos como lido",
     "menu.show_all_entries": "Mostrar todas os itens",
+    "menu.show_all_feeds": "Show all feeds",
     "menu.show_only_unread_entries": "Mostrar apenas itens não lidos",
     "menu.refresh_feed": "Atualizar",
     "menu.refresh_all_feeds": "Atualizar todas as fontes",
     "menu.edit_feed": "Editar",
     "menu.edit_category": "Editar",
     "menu.add_feed": "Adicionar inscrição",
+    "menu.feed_recommendations": "Recommendations",
     "menu.add_user": "Adicionar usuário",
     "menu.flush_history": "Limpar histórico",
     "menu.feed_entries": "Itens",
     "menu.api_keys": "Chaves de API",
     "menu.create_api_key": "Criar uma nova chave de API",
+    "menu.muted_keywords": "Palavras-chave silenciadas",
+    "menu.create_muted_keyword": "Silenciar uma nova palavra-chave",
     "menu.shared_entries": "Itens compartilhados",
+    "menu.received_entries": "Received entries",
     "search.label": "Buscar",
     "search.placeholder": "Buscar por...",
+    "search.placeholder_feed": "Pesquisar nesta fonte...",
+    "search.placeholder_category": "Pesquisar nesta categoria...",
     "pagination.next": "Próximo",
     "pagination.previous": "Anterior",
     "entry.status.unread": "Não lido",
@@ -2758,19 +5999,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "Favoritado",
     "entry.bookmark.toast.off": "Desfavoritado",
     "entry.state.saving": "Salvando...",
+    "entry.state.queued": "Na fila",
     "entry.state.loading": "Carregando...",
+    "entry.state.archiving": "Archiving...",
     "entry.save.label": "Salvar",
     "entry.save.title": "Salvar esse item",
     "entry.save.completed": "Feito!",
     "entry.save.toast.completed": "Item guardado",
+    "entry.save.prompt_tags": "Tags adicionais (separadas por espaços)",
+    "entry.select.label": "Selecionar",
+    "entry.bulk.selected": "%d selecionados",
+    "entry.bulk.save": "Salvar os itens selecionados",
+    "entry.bulk.save.completed": "Entries sent to third-party services",
+    "entry.bulk.clear": "Limpar seleção",
     "entry.scraper.label": "Conteúdo completo",
+    "entry.snapshot.title": "Open the copy of the web page kept by Miniflux",
+    "entry.snapshot.label": "Offline copy",
+    "entry.snapshot.create.title": "Keep a copy of the full web page, viewable even if the website goes offline",
+    "entry.snapshot.create.label": "Archive page",
+    "entry.snapshot.create.toast": "Web page archived",
     "entry.scraper.title": "Obter conteúdo completo",
     "entry.scraper.completed": "Feito!",
     "entry.original.label": "Original",
     "entry.comments.label": "Comentários",
+    "entry.location.title": "Mostrar o local em um mapa",
+    "entry.location.label": "Mapa",
+    "entry.archives.title": "Copies of the web page kept by archiving services",
+    "entry.archives.label": "Archived copy:",
     "entry.comments.title": "Ver comentários",
+    "entry.print.label": "Imprimir",
+    "entry.print.title": "Imprimir ou salvar como PDF",
+    "entry.print.source": "Fonte",
+    "entry.print.url": "URL",
+    "entry.print.date": "Data",
+    "entry.email.label": "E-mail",
+    "entry.email.title": "Compartilhar por e-mail",
+    "email.entry.signature": "Compartilhado por %s de %s com Miniflux",
+    "email.feed_error.subject": "The feed \"%s\" keeps failing",
+    "email.feed_error.body": "Miniflux was unable to refresh the feed \"%s\" (%s) %d times in a row.\n\nLast error: %s\n\nYou can review the feed here: %s",
+    "email.notification_email_verification.subject": "Confirm your email address for the Miniflux notifications",
+    "email.notification_email_verification.body": "The Miniflux user \"%s\" wants to receive notifications at this address.\n\nOpen this link to confirm it: %s\n\nIf you didn't ask for it, you can ignore this email.",
     "entry.share.label": "Compartilhar",
     "entry.share.title": "Compartilhar esse item",
+    "entry.send.label": "Send",
+    "entry.send.title": "Send this article to another user",
     "entry.unshare.label": "Descompartilhar",
     "entry.shared_entry.title": "Abrir link público",
     "entry.shared_entry.label": "Compartilhar",
@@ -2778,10 +6050,41 @@ var translations = map[string]string{
         "%d minuto lido",
         "%d minutos lidos"
     ],
+    "entry.video_duration": [
+        "Vídeo de %d minuto",
+        "Vídeo de %d minutos"
+    ],
+    "entry.score": [
+        "%d ponto",
+        "%d pontos"
+    ],
+    "entry.comments_count": [
+        "%d comentário",
+        "%d comentários"
+    ],
+    "entry.duplicates": [
+        "%d similar entry",
+        "%d similar entries"
+    ],
+    "entry.removed_trackers": [
+        "%d rastreador removido",
+        "%d rastreadores removidos"
+    ],
     "page.shared_entries.title": "Itens compartilhados",
+    "page.received_entries.title": "Received Entries",
+    "page.received_entries.sender": "Sent by %s",
+    "page.entry_send.title": "Send to another user",
     "page.unread.title": "Não lídos",
     "page.starred.title": "Favoritos",
+    "page.orphaned_saves.title": "Orphaned saves",
+    "page.trending.title": "Trending",
+    "page.trending.feed_count": [
+        "(%d feed)",
+        "(%d feeds)"
+    ],
     "page.categories.title": "Categorias",
+    "page.hidden_categories.title": "Hidden Categories",
+    "page.hidden_categories.help": "Hidden categories keep their subscriptions up to date but their articles are left out of the unread list and its counters.",
     "page.categories.no_feed": "Sem fonte.",
     "page.categories.entries": "Itens",
     "page.categories.feeds": "Inscrições",
@@ -2792,25 +6095,89 @@ var translations = map[string]string{
     "page.new_category.title": "Nova categoria",
     "page.new_user.title": "Novo usuário",
     "page.edit_category.title": "Editar categoria: %s",
+    "page.remove_category.title": "Remove Category: %s",
+    "page.remove_category.help": [
+        "This category contains %d feed. It will be moved to the category below before the removal.",
+        "This category contains %d feeds. They will be moved to the category below before the removal."
+    ],
+    "page.edit_category.members": "Members",
+    "page.edit_category.member_since": "Member since",
+    "page.edit_category.members_help": "Members see the feeds of this category in their own account with their own read and starred status, once they accept the invitation. Feeds are fetched only once for everyone.",
+    "page.edit_category.shared_by": "This category is shared by %s. New entries are fetched by its owner.",
+    "page.edit_category.invitation_pending": "Invitation pending",
+    "page.categories.invitation": "%s invites you to follow the category “%s”.",
+    "action.accept_invitation": "Accept",
+    "action.decline_invitation": "Decline",
+    "action.leave_shared_category": "Stop following this category",
     "page.edit_user.title": "Editar usuário: %s",
     "page.feeds.title": "Fontes",
     "page.feeds.last_check": "Última verificação:",
+    "page.feed_entries.volume": [
+        "%d entry during the last %d days",
+        "%d entries during the last %d days"
+    ],
     "page.feeds.unread_counter": "Numero de itens não lidos",
     "page.feeds.read_counter": "Número de itens lidos",
     "page.feeds.error_count": [
         "%d erro",
         "%d erros"
     ],
+    "page.feeds.dead": "Removido pelo editor",
+    "page.feeds.dormant": "Dormant",
+    "page.feeds.dormant_title": "This feed hasn't published anything for a long time",
+    "page.feeds.move.hint": "Drag feeds to a category, or select several feeds and click a category:",
+    "page.feeds.move.selected": "Move the %d selected feeds to:",
+    "page.feeds.move.loading": "Moving...",
+    "page.unused_feeds.title": "Unread Feeds",
+    "page.unused_feeds.description": [
+        "You haven't read or starred anything from these feeds for %d month.",
+        "You haven't read or starred anything from these feeds for %d months."
+    ],
+    "page.unused_feeds.last_read": "Last read:",
+    "page.unused_feeds.never_read": "Never read",
     "page.history.title": "Histórico",
+    "page.today.title": "Hoje",
+    "page.podcasts.title": "Podcasts",
+    "page.playback_queue.title": "Fila de reprodução",
+    "page.podcasts.total_duration": "Tempo total de escuta: %s",
+    "page.digest.title": "Since your last visit",
+    "page.digest.since": "Unread articles received since",
+    "page.digest.continue": "Continue reading",
+    "page.digest.top_entries": "Top articles",
+    "page.digest.entry_count": [
+        "%d new article",
+        "%d new articles"
+    ],
     "page.import.title": "Importar",
     "page.search.title": "Resultados da busca",
+    "page.search.syntax_help": "Refine a pesquisa com title:, author:, feed:, tag:, before:2006-01-02 e after:2006-01-02, use aspas para frases exatas e barras para expressões regulares, por exemplo title:/^release/.",
+    "page.search.saved": "Pesquisa salva",
+    "page.saved_searches.title": "Pesquisas salvas",
+    "page.saved_searches.recent": "Pesquisas recentes",
+    "page.saved_searches.table.query": "Pesquisa",
+    "page.saved_searches.table.actions": "Ações",
+    "page.search.scope_feed": "Fonte: %s",
+    "page.search.scope_category": "Categoria: %s",
+    "page.search.all_entries": "Pesquisar em todos os itens",
     "page.about.title": "Sobre",
     "page.about.credits": "Créditos",
     "page.about.version": "Versão:",
     "page.about.build_date": "Compilado em:",
+    "page.about.features": "Features",
+    "page.about.image_proxy": "Image proxy:",
+    "page.about.search_backend": "Search engine:",
+    "page.about.websub": "WebSub:",
+    "page.about.integrations": "Integrations:",
+    "page.about.enabled": "Enabled",
+    "page.about.disabled": "Disabled",
     "page.about.author": "Autor:",
     "page.about.license": "Licença:",
     "page.add_feed.title": "Nova inscrição",
+    "page.feed_recommendations.title": "Recommended Feeds",
+    "page.feed_recommendations.table.feed": "Feed",
+    "page.feed_recommendations.table.category": "Category",
+    "page.feed_recommendations.table.subscribers": "Subscribers",
+    "page.feed_recommendations.table.actions": "Actions",
     "page.add_feed.no_category": "Não existe uma categoria. Deve existir pelo menos uma categoria.",
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Buscar uma fonte",
@@ -2821,8 +6188,23 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "Cabeçalho 'LastModified':",
     "page.edit_feed.etag_header": "Cabeçalho 'ETag':",
     "page.edit_feed.no_header": "Sem cabeçalhos",
+    "page.edit_feed.last_fetch_url": "URL final:",
+    "page.edit_feed.last_fetch_content_type": "Cabeçalho Content-Type:",
+    "page.edit_feed.last_fetch_size": "Tamanho:",
+    "page.edit_feed.bytes": [
+        "%d byte",
+        "%d bytes"
+    ],
     "page.edit_feed.last_parsing_error": "Último erro durante processamento",
+    "page.edit_feed.canonical_feed_url": "Esta fonte indica uma URL diferente: %s",
     "page.entry.attachments": "Anexos",
+    "page.entry.comments": "Comments",
+    "page.entry.comments.follow": "Follow the discussion",
+    "page.entry.comments.unfollow": "Stop following",
+    "page.entry.comments.following": [
+        "New comments will be fetched for %d more day.",
+        "New comments will be fetched for %d more days."
+    ],
     "page.keyboard_shortcuts.title": "Atalhos de teclado",
     "page.keyboard_shortcuts.subtitle.sections": "Navegação de seções",
     "page.keyboard_shortcuts.subtitle.items": "Navegação de itens",
@@ -2843,6 +6225,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.open_item": "Abrir o item selecionado",
     "page.keyboard_shortcuts.open_original": "Abrir o conteúdo original",
     "page.keyboard_shortcuts.open_original_same_window": "Abrir o conteúdo original na janela atual",
+    "page.keyboard_shortcuts.open_original_background": "Abrir o conteúdo original em uma aba em segundo plano",
     "page.keyboard_shortcuts.open_comments": "Abrir os comentários",
     "page.keyboard_shortcuts.open_comments_same_window": "Abrir os comentários na janela atual",
     "page.keyboard_shortcuts.toggle_read_status": "Inverter estado de leitura do item",
@@ -2855,6 +6238,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "Ir para o campo de busca",
     "page.keyboard_shortcuts.close_modal": "Fechar janela",
     "page.users.title": "Usuários",
+    "page.reports.title": "Reports",
+    "page.reports.database_size": "Database size",
+    "page.reports.storage": "Storage",
+    "page.reports.feeds": "Feeds",
+    "page.reports.entries": "Articles",
+    "page.reports.last_activity": "Last activity",
     "page.users.username": "Nome de usuário",
     "page.users.never_logged": "Nunca",
     "page.users.admin.yes": "Sim",
@@ -2894,30 +6283,92 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Ações",
     "page.api_keys.never_used": "Nunca usado",
     "page.new_api_key.title": "Nova chave de API",
+    "page.muted_keywords.title": "Palavras-chave silenciadas",
+    "page.blocked_feeds.title": "Blocked Feeds",
+    "page.blocked_feeds.help": "Nobody can subscribe to these feeds on this instance. Blocking a domain also blocks all its subdomains. Existing subscriptions are disabled.",
+    "page.blocked_feeds.table.pattern": "Feed URL or domain",
+    "page.blocked_feeds.table.reason": "Reason",
+    "page.blocked_feeds.table.created_at": "Blocked",
+    "page.blocked_feeds.table.actions": "Actions",
+    "page.muted_keywords.help": "Novos itens de todas as suas fontes que correspondam a uma dessas palavras-chave ou expressões regulares são ignorados ou marcados como lidos.",
+    "page.muted_keywords.table.pattern": "Palavra-chave ou expressão regular",
+    "page.muted_keywords.table.action": "Ação",
+    "page.muted_keywords.table.expires_at": "Data de expiração",
+    "page.muted_keywords.table.actions": "Ações",
+    "page.muted_keywords.never_expires": "Nunca",
+    "page.muted_keywords.expired": "Expirado",
+    "page.new_muted_keyword.title": "Nova palavra-chave silenciada",
+    "page.entry_email.title": "Compartilhar por e-mail",
     "alert.no_shared_entry": "Não há itens compartilhados.",
+    "alert.blocked_feed_created": [
+        "The feed has been blocked, %d existing subscription has been disabled.",
+        "The feed has been blocked, %d existing subscriptions have been disabled."
+    ],
+    "alert.no_received_entry": "There is no received entry.",
     "alert.no_bookmark": "Não há favorito neste momento.",
+    "alert.no_trending_topic": "There is no trending topic at the moment.",
     "alert.no_category": "Não há categoria.",
+    "alert.no_hidden_category": "There is no hidden category.",
+    "alert.no_category_to_move_feeds": "Create another category first to receive the feeds of this one.",
+    "alert.category_invitation_sent": "An invitation to follow this category has been sent to %s.",
+    "alert.no_feed_recommendation": "There is no recommendation for your categories at the moment.",
     "alert.no_category_entry": "Não há itens nesta categoria.",
     "alert.no_feed_entry": "Não há itens nessa fonte.",
     "alert.no_feed": "Não há inscrições.",
+    "alert.unused_feeds": [
+        "%d feed hasn't been read for a long time, review it.",
+        "%d feeds haven't been read for a long time, review them."
+    ],
+    "alert.dormant_feeds": [
+        "%d feed hasn't published anything for a long time, review it.",
+        "%d feeds haven't published anything for a long time, review them."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Feeds without new entries for %d day.",
+        "Feeds without new entries for %d days."
+    ],
+    "alert.no_unused_feed": "You read all your subscriptions, nothing to clean up.",
     "alert.no_feed_in_category": "Não há inscrições nessa categoria.",
     "alert.no_history": "Não há histórico nesse momento.",
     "alert.feed_error": "Ocorreu um problema com esta fonte.",
+    "alert.feed_dead": "Esta fonte não está mais disponível e não é mais atualizada automaticamente",
     "alert.no_search_result": "Não há resultados para essa busca.",
+    "alert.no_saved_search": "Não há nenhuma pesquisa salva.",
     "alert.no_unread_entry": "Não há itens não lidos.",
+    "alert.no_today_entry": "Nenhum artigo foi publicado hoje.",
+    "alert.no_podcast_entry": "Não há episódios de podcast não lidos.",
+    "alert.no_queued_entry": "A fila de reprodução está vazia, adicione episódios pela página de podcasts.",
+    "alert.no_digest_entry": "Nothing new since your last visit.",
     "alert.no_user": "Você é o único usuário.",
     "alert.account_unlinked": "Sua conta externa está desvinculada!",
     "alert.account_linked": "Sua conta externa está vinculada!",
     "alert.pocket_linked": "Sua conta do Pocket está vinculada!",
     "alert.prefs_saved": "Suas preferências foram salvas!",
+    "alert.notification_email_verification_sent": "A verification link has been sent to the notification email address.",
+    "alert.notification_email_verified": "The notification email address is verified.",
+    "alert.entry_sent_by_email": "O artigo foi enviado por e-mail.",
     "error.unlink_account_without_password": "Você deve definir uma senha, senão não será possível efetuar a sessão novamente.",
     "error.duplicate_linked_account": "Alguém já está vinculado a esse serviço!",
     "error.duplicate_fever_username": "Alguém já está utilizando esse nome de usuário do Fever!",
+    "error.custom_bookmark_invalid": "Invalid custom bookmark service settings: %v.",
+    "error.markdown_invalid": "Invalid Markdown notes settings: %v.",
+    "error.markdown_webdav_url_required": "The WebDAV folder URL is required to save Markdown notes.",
+    "error.hypothesis_token_required": "The Hypothesis API token is required.",
+    "error.hypothesis_group_required": "The Hypothesis group ID is required.",
+    "error.zotero_invalid": "Invalid Zotero settings: %v.",
+    "error.instapaper_invalid": "Unable to connect to Instapaper with these credentials: %v.",
+    "error.ntfy_invalid_topic_url": "The ntfy topic URL is invalid.",
+    "error.linkace_invalid_lists": "Invalid LinkAce lists: %v.",
+    "error.archivebox_credentials_required": "The ArchiveBox server URL and API key are required.",
     "error.pocket_request_token": "Não foi possível obter um pedido de token no Pocket!",
     "error.pocket_access_token": "Não foi possível obter um token de acesso no Pocket!",
     "error.category_already_exists": "Esta categoria já existe.",
+    "error.category_member_not_found": "This user does not exist.",
+    "error.category_shared_with_you": "This category is shared by %s and cannot be shared again.",
     "error.unable_to_create_category": "Não foi possível criar essa categoria.",
     "error.unable_to_update_category": "Não foi possível atualizar essa categoria.",
+    "error.unable_to_remove_category": "Unable to remove this category.",
+    "error.category_remove_target": "Choose another category for the feeds.",
     "error.user_already_exists": "Esse usuário já existe.",
     "error.unable_to_create_user": "Não foi possível criar esse usuário.",
     "error.unable_to_update_user": "Não foi possível atualizar esse usuário.",
@@ -2926,30 +6377,81 @@ var translations = map[string]string{
     "error.empty_file": "Esse arquivo está vazio.",
     "error.bad_credentials": "Usuário ou senha são inválidos.",
     "error.fields_mandatory": "Todos os campos são obrigatórios.",
+    "error.no_feed_selected": "No feed selected.",
     "error.title_required": "O título é obrigatório.",
     "error.different_passwords": "As senhas não são iguais.",
     "error.password_min_length": "A senha deve ter no mínimo 6 caracteres.",
     "error.settings_mandatory_fields": "Os campos de nome de usuário, tema, idioma e fuso horário são obrigatórios.",
     "error.entries_per_page_invalid": "O número de itens por página é inválido.",
+    "error.invalid_youtube_embed_url": "A URL da instância Invidious ou Piped não é válida.",
+    "error.invalid_auto_star_keywords": "One of the auto-star keywords is not a valid regular expression.",
+    "error.invalid_email_recipients": "Um dos destinatários é inválido ou há destinatários demais.",
+    "error.invalid_notification_email": "The email address for the notifications is invalid.",
+    "error.invalid_notification_email_token": "This verification link is invalid or has already been used.",
+    "error.invalid_home_page": "A página inicial é inválida, uma consulta é necessária para abrir uma pesquisa.",
+    "error.invalid_entry_timezone": "The timezone of the publication dates is invalid.",
+    "error.invalid_timestamp_format": "The format of the dates is invalid.",
+    "error.unable_to_send_email": "Não foi possível enviar o e-mail.",
+    "error.too_many_sent_emails": "You have sent too many emails, please try again later.",
     "error.feed_mandatory_fields": "O campo de URL e categoria são obrigatórios.",
+    "error.invalid_encoding": "Esta codificação de caracteres não é suportada.",
+    "error.invalid_cron_expression": "Expressão cron inválida.",
+    "error.invalid_feed_request_limits": "The request timeout or the maximum size is out of the allowed range.",
+    "error.feed_disabled_after_errors": [
+        "Esta fonte foi desativada automaticamente após %d semana de falhas: %s",
+        "Esta fonte foi desativada automaticamente após %d semanas de falhas: %s"
+    ],
     "error.user_mandatory_fields": "O nome de usuário é obrigatório.",
     "error.api_key_already_exists": "Essa chave de API já existe.",
     "error.unable_to_create_api_key": "Não foi possível criar uma chave de API.",
+    "error.muted_keyword_already_exists": "Esta palavra-chave já está silenciada.",
+    "error.blocked_feed_already_exists": "This feed URL or domain is already blocked.",
+    "error.invalid_blocked_feed": "Enter a complete feed URL or a domain name.",
+    "error.unable_to_block_feed": "Unable to block this feed.",
+    "error.unable_to_create_muted_keyword": "Não foi possível silenciar esta palavra-chave.",
+    "error.invalid_muted_keyword": "Palavra-chave ou expressão regular inválida.",
+    "error.invalid_expiration_date": "Data de expiração inválida.",
+    "error.too_many_sent_entries": "You have sent too many entries, please try again later.",
     "form.feed.label.title": "Título",
+    "form.feed.help.original_title": "Título original: %s",
+    "form.feed.help.previous_feed_url": "A URL da fonte foi atualizada automaticamente. URL anterior: %s",
     "form.feed.label.site_url": "URL do site",
     "form.feed.label.feed_url": "URL da fonte",
     "form.feed.label.category": "Categoria",
     "form.feed.label.crawler": "Obter conteúdo original",
     "form.feed.label.feed_username": "Nome de usuário da fonte",
     "form.feed.label.feed_password": "Senha da fonte",
+    "form.feed.label.bearer_token": "Token de acesso (Bearer) da fonte",
+    "form.feed.label.oauth2_token_url": "URL do token OAuth2",
+    "form.feed.label.oauth2_client_id": "ID do cliente OAuth2",
+    "form.feed.label.oauth2_client_secret": "Segredo do cliente OAuth2",
+    "form.feed.label.oauth2_scopes": "Escopos OAuth2 (separados por espaços)",
     "form.feed.label.user_agent": "Sobrescrever o agente de usuário (user-agent) padrão",
     "form.feed.label.scraper_rules": "Regras do scraper",
     "form.feed.label.rewrite_rules": "Regras para o Rewrite",
+    "form.feed.label.encoding": "Forçar a codificação de caracteres",
     "form.feed.label.ignore_http_cache": "Ignorar cache HTTP",
     "form.feed.label.disabled": "Não atualizar esta fonte",
+    "form.feed.label.open_external_link": "Abrir itens diretamente no site original",
+    "form.feed.label.fetch_scores": "Buscar a pontuação e o número de comentários do Reddit e do Hacker News",
+    "form.feed.label.minimum_score": "Ignorar os itens com pontuação inferior a",
+    "form.feed.label.blocked_authors": "Ignorar os itens escritos por estes autores (um por linha)",
+    "form.feed.label.auto_star": "Star automatically new entries of this feed",
+    "form.feed.label.archive_pages": "Keep a copy of the full web page of new entries",
+    "form.feed.label.hide_globally": "Ocultar itens na lista global de não lidos",
+    "form.feed.label.notify_errors": "Send a notification when this feed keeps failing",
+    "form.feed.label.cron_expression": "Agendamento da atualização (expressão cron)",
+    "form.feed.help.cron_expression": "Minuto, hora, dia do mês, mês e dia da semana no seu fuso horário. Deixe vazio para usar o agendamento padrão.",
+    "form.feed.label.request_timeout": "Tempo limite da requisição (segundos)",
+    "form.feed.label.max_body_size": "Tamanho máximo (MB)",
+    "form.feed.help.http_client_limits": "Use 0 para manter as configurações globais.",
     "form.feed.label.fetch_via_proxy": "Buscar via proxy",
     "form.category.label.title": "Título",
+    "form.category.label.move_feeds_to": "Move the feeds to",
+    "form.category.label.member_username": "Share with user",
     "form.user.label.username": "Nome de usuário",
+    "form.entry_send.label.username": "Recipient username",
+    "form.entry_send.label.message": "Message (optional)",
     "form.user.label.password": "Senha",
     "form.user.label.confirmation": "Confirmação de senha",
     "form.user.label.admin": "Administrador",
@@ -2958,10 +6460,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "Tema",
     "form.prefs.label.entry_sorting": "Ordenação dos itens",
     "form.prefs.label.entries_per_page": "Itens por página",
+    "form.prefs.label.home_page": "Página inicial",
+    "form.prefs.label.home_page_search": "Pesquisa aberta na página inicial",
+    "form.prefs.label.entry_timezone": "Publication dates",
+    "form.prefs.select.entry_timezone_local": "In my timezone",
+    "form.prefs.select.entry_timezone_original": "In the timezone of the feed",
+    "form.prefs.select.entry_timezone_both": "In both timezones",
+    "form.prefs.label.timestamp_format": "Dates in the lists",
+    "form.prefs.select.timestamp_relative": "Relative (2 hours ago)",
+    "form.prefs.select.timestamp_absolute": "Absolute (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "Itens mais velhos primeiro",
     "form.prefs.select.recent_first": "Itens mais recentes",
+    "form.prefs.select.home_page_category": "Categoria",
+    "form.prefs.select.home_page_search": "Pesquisa",
     "form.prefs.label.keyboard_shortcuts": "Habilitar atalhos do teclado",
     "form.prefs.label.show_reading_time": "Mostrar tempo estimado de leitura de artigos",
+    "form.prefs.label.mark_read_on_original_link": "Marcar itens como lidos ao abrir o link original",
+    "form.prefs.label.keep_starred_entries": "Keep starred articles when their feed or category is removed",
+    "form.prefs.label.accept_received_entries": "Accept entries sent by other users",
+    "form.prefs.label.archive_read_days": "Days to keep read articles",
+    "form.prefs.help.archive_read_days": "Leave empty to use the default of %d days, the maximum is %d days.",
+    "form.prefs.label.youtube_embed_url": "Instância Invidious ou Piped usada para reproduzir vídeos do YouTube",
+    "form.prefs.label.blocked_authors": "Ignorar os itens escritos por estes autores em todas as fontes (um por linha)",
+    "form.prefs.label.auto_star_keywords": "Star automatically new entries matching these keywords (one regular expression per line)",
+    "form.prefs.label.auto_star_authors": "Star automatically new entries from these authors (one per line)",
+    "form.prefs.label.email_recipients": "Destinatários padrão ao compartilhar por e-mail (separados por vírgulas)",
+    "form.prefs.label.notification_email": "Email address for the notifications",
+    "form.prefs.help.notification_email_unverified": "This address is not verified yet, no notification is sent to it.",
     "form.prefs.label.custom_css": "CSS customizado",
     "form.import.label.file": "Arquivo OPML",
     "form.import.label.url": "URL",
@@ -2972,12 +6497,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "Salvar itens no Pinboard",
     "form.integration.pinboard_token": "Token de API do Pinboard",
     "form.integration.pinboard_tags": "Etiquetas (tags) do Pinboard",
+    "form.integration.pinboard_category_tag": "Adicionar a categoria da fonte como tag",
+    "form.integration.pinboard_entry_tags": "Adicionar as tags do artigo",
+    "form.integration.pinboard_prompt_tags": "Pedir tags adicionais ao salvar um artigo",
     "form.integration.pinboard_bookmark": "Salvar marcador como não lído",
     "form.integration.instapaper_activate": "Salvar itens no Instapaper",
     "form.integration.instapaper_username": "Nome do usuário do Instapaper",
     "form.integration.instapaper_password": "Senha do Instapaper",
+    "form.integration.instapaper_folder": "Pasta do Instapaper",
+    "form.integration.instapaper_folder_unread": "Não lidos (padrão)",
+    "form.integration.instapaper_folder_id": "ID da pasta do Instapaper",
+    "form.integration.instapaper_folder_help": "The folders are listed once the integration is saved, save again to update the list. Leave empty to save articles in the Unread folder.",
     "form.integration.pocket_activate": "Salvar itens no Pocket",
     "form.integration.pocket_consumer_key": "Chave de consumo (Consumer Key) do Pocket",
+    "form.integration.pocket_category_tag": "Adicionar a categoria da fonte como tag",
     "form.integration.pocket_access_token": "Token de acesso do Pocket",
     "form.integration.pocket_connect_link": "Conectar a conta do Pocket",
     "form.integration.wallabag_activate": "Salvar itens no Wallabag",
@@ -2986,12 +6519,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Segredo do cliente (Client Secret) do Wallabag",
     "form.integration.wallabag_username": "Nome de usuário do Wallabag",
     "form.integration.wallabag_password": "Senha do Wallabag",
+    "form.integration.wallabag_tags": "Tags do Wallabag (separadas por vírgulas)",
+    "form.integration.wallabag_archive": "Arquivar os artigos salvos no Wallabag",
     "form.integration.nunux_keeper_activate": "Salvar itens no Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Endpoint de API do Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Chave de API do Nunux Keeper",
+    "form.integration.karakeep_activate": "Save articles to Karakeep",
+    "form.integration.karakeep_endpoint": "Karakeep server URL",
+    "form.integration.karakeep_api_key": "Karakeep API key",
+    "form.integration.karakeep_tags": "Karakeep tags (comma separated)",
+    "form.integration.archivebox_activate": "Archive saved and starred articles with ArchiveBox",
+    "form.integration.archivebox_endpoint": "ArchiveBox server URL",
+    "form.integration.archivebox_api_key": "ArchiveBox API key",
+    "form.integration.archivebox_tags": "ArchiveBox tags (comma separated)",
+    "form.integration.archivebox_help": "The link to the snapshot is shown on the article once ArchiveBox has finished archiving the page.",
+    "form.integration.ntfy_activate": "Send push notifications with ntfy",
+    "form.integration.ntfy_topic_url": "ntfy topic URL",
+    "form.integration.ntfy_token": "ntfy access token (optional)",
+    "form.integration.ntfy_help": "Feeds with error notifications enabled are reported to this topic when they keep failing.",
+    "form.integration.espial_activate": "Save articles to Espial",
+    "form.integration.espial_endpoint": "Espial server URL",
+    "form.integration.espial_api_key": "Espial API key",
+    "form.integration.espial_tags": "Espial tags (space separated)",
+    "form.integration.linkace_activate": "Save articles to LinkAce",
+    "form.integration.linkace_endpoint": "LinkAce server URL",
+    "form.integration.linkace_api_key": "LinkAce API token",
+    "form.integration.linkace_tags": "LinkAce tags (comma separated)",
+    "form.integration.linkace_lists": "LinkAce list IDs (comma separated)",
+    "form.integration.linkace_category_tag": "Add the category as tag",
+    "form.integration.linkace_is_private": "Save links as private",
+    "form.integration.custom_bookmark": "Serviço de favoritos personalizado",
+    "form.integration.custom_bookmark_activate": "Save articles to a custom bookmark service",
+    "form.integration.custom_bookmark_endpoint": "Endpoint da API",
+    "form.integration.custom_bookmark_method": "Método HTTP",
+    "form.integration.custom_bookmark_headers": "HTTP Headers (one per line)",
+    "form.integration.custom_bookmark_body": "JSON Body Template",
+    "form.integration.custom_bookmark_body_help": "Placeholders are replaced by JSON values, do not add quotes around them:",
+    "form.integration.markdown": "Markdown notes",
+    "form.integration.markdown_activate": "Save articles as Markdown files, for notes apps like Obsidian",
+    "form.integration.markdown_starred": "Also save starred articles",
+    "form.integration.markdown_webdav_url": "WebDAV folder URL",
+    "form.integration.markdown_webdav_username": "WebDAV Username",
+    "form.integration.markdown_webdav_password": "WebDAV Password",
+    "form.integration.markdown_local_help": "Leave the URL empty to write the files in the folder configured by the administrator.",
+    "form.integration.wayback_activate": "Archive saved and starred articles with the Wayback Machine",
+    "form.integration.wayback_help": "The web page is submitted to the Internet Archive and the link to the copy is shown on the article.",
+    "form.integration.zotero_activate": "Save articles to Zotero",
+    "form.integration.zotero_library_type": "Zotero library",
+    "form.integration.zotero_library_user": "Personal library",
+    "form.integration.zotero_library_group": "Group library",
+    "form.integration.zotero_library_id": "Zotero user or group ID",
+    "form.integration.zotero_api_key": "Zotero API key",
+    "form.integration.zotero_collection_key": "Zotero collection key (optional)",
+    "form.integration.zotero_help": "Articles linking to a DOI are saved as journal articles, the other ones as web pages.",
+    "form.integration.hypothesis_activate": "Publish the annotations of articles to Hypothesis",
+    "form.integration.hypothesis_token": "Hypothesis API token",
+    "form.integration.hypothesis_token_help": "The token can be generated on the developer page of your Hypothesis account.",
+    "form.integration.hypothesis_group": "Hypothesis group ID",
+    "form.integration.hypothesis_group_help": "The annotations are visible to the members of this group, the group __world__ makes them public.",
+    "form.integration.hypothesis_export_help": "All annotations can also be downloaded in the W3C Web Annotation format with the API:",
+    "form.integration.rssbridge_activate": "Usar RSS-Bridge quando um site não tiver nenhuma fonte",
+    "form.integration.rssbridge_url": "URL do servidor RSS-Bridge",
+    "form.integration.routing": "Categorias",
+    "form.integration.routing_help": "Services limited to some categories only receive the articles of the selected categories, and nothing when no category is selected.",
+    "form.integration.routing_restrict": "Limit to the selected categories",
     "form.api_key.label.description": "Etiqueta da chave de API",
+    "form.muted_keyword.label.pattern": "Palavra-chave ou expressão regular",
+    "form.blocked_feed.label.pattern": "Feed URL or domain",
+    "form.blocked_feed.help.pattern": "A complete URL blocks only this feed, a domain name blocks all the feeds of the domain and its subdomains.",
+    "form.blocked_feed.label.reason": "Reason shown to users (optional)",
+    "form.muted_keyword.label.action": "Itens correspondentes",
+    "form.muted_keyword.label.expires_at": "Data de expiração (opcional)",
+    "form.entry_email.label.recipients": "Destinatários (separados por vírgulas)",
+    "form.entry_email.label.note": "Nota pessoal (opcional)",
+    "form.muted_keyword.select.drop": "Ignorar",
+    "form.muted_keyword.select.read": "Marcar como lido",
     "form.submit.loading": "Carregando...",
     "form.submit.saving": "Salvando...",
+    "form.submit.sending": "Enviando...",
     "time_elapsed.not_yet": "ainda não",
     "time_elapsed.yesterday": "ontem",
     "time_elapsed.now": "agora mesmo",
@@ -3030,9 +6635,26 @@ var translations = map[string]string{
     "action.save": "Сохранить",
     "action.or": "или",
     "action.cancel": "закрыть",
+    "action.send": "Отправить",
+    "action.close": "Закрыть",
     "action.remove": "Удалить",
+    "action.add_to_queue": "Добавить в очередь",
+    "action.play_queue": "Воспроизвести очередь",
+    "action.move_up": "Вверх",
+    "action.move_down": "Вниз",
+    "action.open": "Открыть",
+    "action.save_search": "Сохранить этот поиск",
+    "action.unsubscribe_selected": "Unsubscribe from selected feeds",
+    "action.keep_selected": "Keep selected feeds",
     "action.remove_feed": "Удалить эту подписку",
+    "action.use_canonical_feed_url": "Использовать этот адрес",
     "action.update": "Обновить",
+    "action.send_verification_link": "Send a verification link",
+    "action.block_feed": "Block",
+    "action.share_category": "Share",
+    "action.hide_category": "Hide",
+    "action.unhide_category": "Show again",
+    "action.move_feeds_and_remove": "Move and remove",
     "action.edit": "Изменить",
     "action.download": "Загрузить",
     "action.import": "Импорт",
@@ -3042,36 +6664,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "Авторизован как %s",
     "menu.unread": "Непрочитанное",
     "menu.starred": "Избранное",
+    "menu.trending": "Trending",
+    "menu.podcasts": "Подкасты",
+    "menu.playback_queue": "Очередь воспроизведения",
     "menu.history": "История",
     "menu.feeds": "Подписки",
     "menu.categories": "Категории",
+    "menu.today": "Сегодня",
     "menu.settings": "Настройки",
     "menu.logout": "Выйти",
+    "menu.skip_to_content": "Перейти к содержимому",
     "menu.preferences": "Предпочтения",
     "menu.integrations": "Интеграции",
     "menu.sessions": "Сессии",
     "menu.users": "Пользователи",
+    "menu.reports": "Reports",
+    "menu.blocked_feeds": "Blocked Feeds",
     "menu.about": "О приложении",
     "menu.export": "Экспорт",
     "menu.import": "Импорт",
     "menu.create_category": "Создать категорию",
+    "menu.hidden_categories": "Hidden categories",
     "menu.mark_page_as_read": "Отметить эту страницу прочитанной",
+    "menu.saved_searches": "Сохранённые поиски",
+    "menu.clear_search_history": "Очистить историю поиска",
     "menu.mark_all_as_read": "Отметить всё как прочитанное",
     "menu.show_all_entries": "Показать все статьи",
+    "menu.show_all_feeds": "Show all feeds",
     "menu.show_only_unread_entries": "Показывать только непрочитанные статьи",
     "menu.refresh_feed": "Обновить",
     "menu.refresh_all_feeds": "Обновить все подписки в фоне",
     "menu.edit_feed": "Изменить",
     "menu.edit_category": "Изменить",
     "menu.add_feed": "Добавить подписку",
+    "menu.feed_recommendations": "Recommendations",
     "menu.add_user": "Добавить пользователя",
     "menu.flush_history": "Отчистить историю",
     "menu.feed_entries": "Статьи",
     "menu.api_keys": "API-ключи",
     "menu.create_api_key": "Создать новый API-ключ",
+    "menu.muted_keywords": "Скрытые ключевые слова",
+    "menu.create_muted_keyword": "Скрыть новое ключевое слово",
     "menu.shared_entries": "Общие записи",
+    "menu.received_entries": "Received entries",
     "search.label": "Поиск",
     "search.placeholder": "Поиск…",
+    "search.placeholder_feed": "Искать в этой подписке…",
+    "search.placeholder_category": "Искать в этой категории…",
     "pagination.next": "Следующая",
     "pagination.previous": "Предыдущая",
     "entry.status.unread": "Непрочитано",
@@ -3084,19 +6723,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "Помеченные",
     "entry.bookmark.toast.off": "Без пометок",
     "entry.state.saving": "Сохранение…",
+    "entry.state.queued": "В очереди",
     "entry.state.loading": "Загрузка…",
+    "entry.state.archiving": "Archiving...",
     "entry.save.label": "Сохранить",
     "entry.save.title": "Сохранить эту статью",
     "entry.save.completed": "Готово!",
     "entry.save.toast.completed": "Статья сохранена",
+    "entry.save.prompt_tags": "Дополнительные теги (через пробел)",
+    "entry.select.label": "Select",
+    "entry.bulk.selected": "%d selected",
+    "entry.bulk.save": "Save selected entries",
+    "entry.bulk.save.completed": "Entries sent to third-party services",
+    "entry.bulk.clear": "Clear selection",
     "entry.scraper.label": "Извлечь оригинальное содержимое",
+    "entry.snapshot.title": "Open the copy of the web page kept by Miniflux",
+    "entry.snapshot.label": "Offline copy",
+    "entry.snapshot.create.title": "Keep a copy of the full web page, viewable even if the website goes offline",
+    "entry.snapshot.create.label": "Archive page",
+    "entry.snapshot.create.toast": "Web page archived",
     "entry.scraper.title": "Извлечь оригинальное содержимое",
     "entry.scraper.completed": "Готово!",
     "entry.original.label": "Оригинал",
     "entry.comments.label": "Комментарии",
+    "entry.location.title": "Show the location on a map",
+    "entry.location.label": "Map",
+    "entry.archives.title": "Copies of the web page kept by archiving services",
+    "entry.archives.label": "Archived copy:",
     "entry.comments.title": "Показать комментарии",
+    "entry.print.label": "Печать",
+    "entry.print.title": "Печать или сохранение в PDF",
+    "entry.print.source": "Источник",
+    "entry.print.url": "URL",
+    "entry.print.date": "Дата",
+    "entry.email.label": "Почта",
+    "entry.email.title": "Поделиться по почте",
+    "email.entry.signature": "%s поделился(ась) из %s через Miniflux",
+    "email.feed_error.subject": "The feed \"%s\" keeps failing",
+    "email.feed_error.body": "Miniflux was unable to refresh the feed \"%s\" (%s) %d times in a row.\n\nLast error: %s\n\nYou can review the feed here: %s",
+    "email.notification_email_verification.subject": "Confirm your email address for the Miniflux notifications",
+    "email.notification_email_verification.body": "The Miniflux user \"%s\" wants to receive notifications at this address.\n\nOpen this link to confirm it: %s\n\nIf you didn't ask for it, you can ignore this email.",
     "entry.share.label": "Поделиться",
     "entry.share.title": "Поделиться этой статьёй",
+    "entry.send.label": "Send",
+    "entry.send.title": "Send this article to another user",
     "entry.unshare.label": "Удалить из общедоступных",
     "entry.shared_entry.title": "Открыть публичную ссылку",
     "entry.shared_entry.label": "Поделиться",
@@ -3104,10 +6774,46 @@ var translations = map[string]string{
         "%d минута чтения",
         "%d минут чтения"
     ],
+    "entry.video_duration": [
+        "Видео %d минута",
+        "Видео %d минут"
+    ],
+    "entry.score": [
+        "%d очко",
+        "%d очков",
+        "%d очков"
+    ],
+    "entry.comments_count": [
+        "%d комментарий",
+        "%d комментариев",
+        "%d комментариев"
+    ],
+    "entry.duplicates": [
+        "%d похожая статья",
+        "%d похожие статьи",
+        "%d похожих статей"
+    ],
+    "entry.removed_trackers": [
+        "%d трекер удалён",
+        "%d трекера удалено",
+        "%d трекеров удалено"
+    ],
     "page.shared_entries.title": "Общедоступные записи",
+    "page.received_entries.title": "Received Entries",
+    "page.received_entries.sender": "Sent by %s",
+    "page.entry_send.title": "Send to another user",
     "page.unread.title": "Непрочитанное",
     "page.starred.title": "Избранное",
+    "page.orphaned_saves.title": "Orphaned saves",
+    "page.trending.title": "Trending",
+    "page.trending.feed_count": [
+        "(%d feed)",
+        "(%d feeds)",
+        "(%d feeds)"
+    ],
     "page.categories.title": "Категории",
+    "page.hidden_categories.title": "Hidden Categories",
+    "page.hidden_categories.help": "Hidden categories keep their subscriptions up to date but their articles are left out of the unread list and its counters.",
     "page.categories.no_feed": "Нет подписок.",
     "page.categories.entries": "Cтатьи",
     "page.categories.feeds": "Подписки",
@@ -3119,9 +6825,29 @@ var translations = map[string]string{
     "page.new_category.title": "Новая категория",
     "page.new_user.title": "Новый пользователь",
     "page.edit_category.title": "Изменить категорию: %s",
+    "page.remove_category.title": "Remove Category: %s",
+    "page.remove_category.help": [
+        "This category contains %d feed. It will be moved to the category below before the removal.",
+        "This category contains %d feeds. They will be moved to the category below before the removal.",
+        "This category contains %d feeds. They will be moved to the category below before the removal."
+    ],
+    "page.edit_category.members": "Members",
+    "page.edit_category.member_since": "Member since",
+    "page.edit_category.members_help": "Members see the feeds of this category in their own account with their own read and starred status, once they accept the invitation. Feeds are fetched only once for everyone.",
+    "page.edit_category.shared_by": "This category is shared by %s. New entries are fetched by its owner.",
+    "page.edit_category.invitation_pending": "Invitation pending",
+    "page.categories.invitation": "%s invites you to follow the category “%s”.",
+    "action.accept_invitation": "Accept",
+    "action.decline_invitation": "Decline",
+    "action.leave_shared_category": "Stop following this category",
     "page.edit_user.title": "Изменить пользователя: %s",
     "page.feeds.title": "Подписки",
     "page.feeds.last_check": "Последняя проверка:",
+    "page.feed_entries.volume": [
+        "%d entry during the last %d days",
+        "%d entries during the last %d days",
+        "%d entries during the last %d days"
+    ],
     "page.feeds.unread_counter": "Количество непрочитанных записей",
     "page.feeds.read_counter": "Количество прочитанных записей",
     "page.feeds.error_count": [
@@ -3129,16 +6855,64 @@ var translations = map[string]string{
         "%d ошибки",
         "%d ошибок"
     ],
+    "page.feeds.dead": "Удалено издателем",
+    "page.feeds.dormant": "Dormant",
+    "page.feeds.dormant_title": "This feed hasn't published anything for a long time",
+    "page.feeds.move.hint": "Drag feeds to a category, or select several feeds and click a category:",
+    "page.feeds.move.selected": "Move the %d selected feeds to:",
+    "page.feeds.move.loading": "Moving...",
+    "page.unused_feeds.title": "Unread Feeds",
+    "page.unused_feeds.description": [
+        "You haven't read or starred anything from these feeds for %d month.",
+        "You haven't read or starred anything from these feeds for %d months.",
+        "You haven't read or starred anything from these feeds for %d months."
+    ],
+    "page.unused_feeds.last_read": "Last read:",
+    "page.unused_feeds.never_read": "Never read",
     "page.history.title": "История",
+    "page.today.title": "Сегодня",
+    "page.podcasts.title": "Подкасты",
+    "page.playback_queue.title": "Очередь воспроизведения",
+    "page.podcasts.total_duration": "Общее время прослушивания: %s",
+    "page.digest.title": "Since your last visit",
+    "page.digest.since": "Unread articles received since",
+    "page.digest.continue": "Continue reading",
+    "page.digest.top_entries": "Top articles",
+    "page.digest.entry_count": [
+        "%d new article",
+        "%d new articles",
+        "%d new articles"
+    ],
     "page.import.title": "Импорт",
     "page.search.title": "Результаты поиска",
+    "page.search.syntax_help": "Уточните поиск с помощью title:, author:, feed:, tag:, before:2006-01-02 и after:2006-01-02, используйте кавычки для точных фраз и косые черты для регулярных выражений, например title:/^release/.",
+    "page.search.saved": "Сохранённый поиск",
+    "page.saved_searches.title": "Сохранённые поиски",
+    "page.saved_searches.recent": "Недавние поиски",
+    "page.saved_searches.table.query": "Поиск",
+    "page.saved_searches.table.actions": "Действия",
+    "page.search.scope_feed": "Подписка: %s",
+    "page.search.scope_category": "Категория: %s",
+    "page.search.all_entries": "Искать во всех статьях",
     "page.about.title": "О приложении",
     "page.about.credits": "Авторы",
     "page.about.version": "Версия:",
     "page.about.build_date": "Дата сборки:",
+    "page.about.features": "Features",
+    "page.about.image_proxy": "Image proxy:",
+    "page.about.search_backend": "Search engine:",
+    "page.about.websub": "WebSub:",
+    "page.about.integrations": "Integrations:",
+    "page.about.enabled": "Enabled",
+    "page.about.disabled": "Disabled",
     "page.about.author": "Автор:",
     "page.about.license": "Лицензия:",
     "page.add_feed.title": "Новая подписка",
+    "page.feed_recommendations.title": "Recommended Feeds",
+    "page.feed_recommendations.table.feed": "Feed",
+    "page.feed_recommendations.table.category": "Category",
+    "page.feed_recommendations.table.subscribers": "Subscribers",
+    "page.feed_recommendations.table.actions": "Actions",
     "page.add_feed.no_category": "Категории отсутствуют. У вас должна быть хотя бы одна категория.",
     "page.add_feed.label.url": "URL",
     "page.add_feed.submit": "Найти подписку",
@@ -3149,8 +6923,25 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "Заголовок LastModified:",
     "page.edit_feed.etag_header": "Заголовок ETag:",
     "page.edit_feed.no_header": "Отсутствует",
+    "page.edit_feed.last_fetch_url": "Итоговый адрес:",
+    "page.edit_feed.last_fetch_content_type": "Заголовок Content-Type:",
+    "page.edit_feed.last_fetch_size": "Размер:",
+    "page.edit_feed.bytes": [
+        "%d байт",
+        "%d байта",
+        "%d байт"
+    ],
     "page.edit_feed.last_parsing_error": "Последняя ошибка парсинга",
+    "page.edit_feed.canonical_feed_url": "Эта подписка указывает другой адрес: %s",
     "page.entry.attachments": "Вложения",
+    "page.entry.comments": "Comments",
+    "page.entry.comments.follow": "Follow the discussion",
+    "page.entry.comments.unfollow": "Stop following",
+    "page.entry.comments.following": [
+        "New comments will be fetched for %d more day.",
+        "New comments will be fetched for %d more days.",
+        "New comments will be fetched for %d more days."
+    ],
     "page.keyboard_shortcuts.title": "Сочетания клавиш",
     "page.keyboard_shortcuts.subtitle.sections": "Навигация по секциям",
     "page.keyboard_shortcuts.subtitle.items": "Навигация по элементам",
@@ -3170,6 +6961,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_next_page": "Перейти к следующей странице",
     "page.keyboard_shortcuts.open_item": "Открыть выбранный элемент",
     "page.keyboard_shortcuts.open_original_same_window": "Открыть оригинальную ссылку в текущей вкладке",
+    "page.keyboard_shortcuts.open_original_background": "Open original link in background tab",
     "page.keyboard_shortcuts.open_original": "Открыть оригинальную ссылку",
     "page.keyboard_shortcuts.open_comments_same_window": "Открыть ссылку на комментарии в текущей вкладке",
     "page.keyboard_shortcuts.open_comments": "Открыть ссылку для комментариев",
@@ -3183,6 +6975,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "Установить фокус в поисковой форме",
     "page.keyboard_shortcuts.close_modal": "Закрыть модальный диалог",
     "page.users.title": "Пользователи",
+    "page.reports.title": "Reports",
+    "page.reports.database_size": "Database size",
+    "page.reports.storage": "Storage",
+    "page.reports.feeds": "Feeds",
+    "page.reports.entries": "Articles",
+    "page.reports.last_activity": "Last activity",
     "page.users.username": "Имя пользователя",
     "page.users.never_logged": "Никогда",
     "page.users.admin.yes": "Да",
@@ -3222,30 +7020,95 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "Действия",
     "page.api_keys.never_used": "Никогда не использовался",
     "page.new_api_key.title": "Новый API-ключ",
+    "page.muted_keywords.title": "Скрытые ключевые слова",
+    "page.blocked_feeds.title": "Blocked Feeds",
+    "page.blocked_feeds.help": "Nobody can subscribe to these feeds on this instance. Blocking a domain also blocks all its subdomains. Existing subscriptions are disabled.",
+    "page.blocked_feeds.table.pattern": "Feed URL or domain",
+    "page.blocked_feeds.table.reason": "Reason",
+    "page.blocked_feeds.table.created_at": "Blocked",
+    "page.blocked_feeds.table.actions": "Actions",
+    "page.muted_keywords.help": "New entries of all your feeds matching one of these keywords or regular expressions are ignored or marked as read.",
+    "page.muted_keywords.table.pattern": "Keyword or Regex",
+    "page.muted_keywords.table.action": "Action",
+    "page.muted_keywords.table.expires_at": "Expiration Date",
+    "page.muted_keywords.table.actions": "Actions",
+    "page.muted_keywords.never_expires": "Never",
+    "page.muted_keywords.expired": "Expired",
+    "page.new_muted_keyword.title": "New Muted Keyword",
+    "page.entry_email.title": "Поделиться по почте",
     "alert.no_shared_entry": "Общедоступные записи отсутствуют.",
+    "alert.blocked_feed_created": [
+        "The feed has been blocked, %d existing subscription has been disabled.",
+        "The feed has been blocked, %d existing subscriptions have been disabled."
+    ],
+    "alert.no_received_entry": "There is no received entry.",
     "alert.no_bookmark": "Избранное отсутствует.",
+    "alert.no_trending_topic": "There is no trending topic at the moment.",
     "alert.no_category": "Категории отсутствуют.",
+    "alert.no_hidden_category": "There is no hidden category.",
+    "alert.no_category_to_move_feeds": "Create another category first to receive the feeds of this one.",
+    "alert.category_invitation_sent": "An invitation to follow this category has been sent to %s.",
+    "alert.no_feed_recommendation": "There is no recommendation for your categories at the moment.",
     "alert.no_category_entry": "В этой категории нет статей.",
     "alert.no_feed_entry": "В этой подписке отсутствуют статьи.",
     "alert.no_feed": "У вас нет ни одной подписки.",
+    "alert.unused_feeds": [
+        "%d feed hasn't been read for a long time, review it.",
+        "%d feeds haven't been read for a long time, review them.",
+        "%d feeds haven't been read for a long time, review them."
+    ],
+    "alert.dormant_feeds": [
+        "%d feed hasn't published anything for a long time, review it.",
+        "%d feeds haven't published anything for a long time, review them.",
+        "%d feeds haven't published anything for a long time, review them."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Feeds without new entries for %d day.",
+        "Feeds without new entries for %d days.",
+        "Feeds without new entries for %d days."
+    ],
+    "alert.no_unused_feed": "You read all your subscriptions, nothing to clean up.",
     "alert.no_feed_in_category": "Для этой категории нет подписки.",
     "alert.no_history": "Истории пока нет.",
     "alert.feed_error": "С этой подпиской есть проблема",
+    "alert.feed_dead": "Эта подписка больше недоступна и не обновляется автоматически",
     "alert.no_search_result": "Нет результатов для данного поискового запроса.",
+    "alert.no_saved_search": "Нет сохранённых поисков.",
     "alert.no_unread_entry": "Нет непрочитанных статей.",
+    "alert.no_today_entry": "No article has been published today.",
+    "alert.no_podcast_entry": "Нет непрочитанных выпусков подкастов.",
+    "alert.no_queued_entry": "Очередь воспроизведения пуста, добавьте выпуски на странице подкастов.",
+    "alert.no_digest_entry": "Nothing new since your last visit.",
     "alert.no_user": "Вы единственный пользователь.",
     "alert.account_unlinked": "Ваш внешний аккаунт теперь отвязан!",
     "alert.account_linked": "Ваш внешний аккаунт теперь привязан!",
     "alert.pocket_linked": "Ваш Pocket аккаунт теперь привязан!",
     "alert.prefs_saved": "Предпочтения сохранены!",
+    "alert.notification_email_verification_sent": "A verification link has been sent to the notification email address.",
+    "alert.notification_email_verified": "The notification email address is verified.",
+    "alert.entry_sent_by_email": "Статья отправлена по почте.",
     "error.unlink_account_without_password": "Вы должны установить пароль, иначе вы не сможете войти снова.",
     "error.duplicate_linked_account": "Уже есть кто-то, кто ассоциирован с этим аккаунтом!",
     "error.duplicate_fever_username": "Уже есть кто-то с таким же именем пользователя Fever!",
+    "error.custom_bookmark_invalid": "Invalid custom bookmark service settings: %v.",
+    "error.markdown_invalid": "Invalid Markdown notes settings: %v.",
+    "error.markdown_webdav_url_required": "The WebDAV folder URL is required to save Markdown notes.",
+    "error.hypothesis_token_required": "The Hypothesis API token is required.",
+    "error.hypothesis_group_required": "The Hypothesis group ID is required.",
+    "error.zotero_invalid": "Invalid Zotero settings: %v.",
+    "error.instapaper_invalid": "Unable to connect to Instapaper with these credentials: %v.",
+    "error.ntfy_invalid_topic_url": "The ntfy topic URL is invalid.",
+    "error.linkace_invalid_lists": "Invalid LinkAce lists: %v.",
+    "error.archivebox_credentials_required": "The ArchiveBox server URL and API key are required.",
     "error.pocket_request_token": "Не удается извлечь request token из Pocket!",
     "error.pocket_access_token": "Не удается извлечь access token из Pocket!",
     "error.category_already_exists": "Эта категория уже существует.",
+    "error.category_member_not_found": "This user does not exist.",
+    "error.category_shared_with_you": "This category is shared by %s and cannot be shared again.",
     "error.unable_to_create_category": "Не удается создать эту категорию.",
     "error.unable_to_update_category": "Не удается обновить эту категорию.",
+    "error.unable_to_remove_category": "Unable to remove this category.",
+    "error.category_remove_target": "Choose another category for the feeds.",
     "error.user_already_exists": "Этот пользователь уже существует.",
     "error.unable_to_create_user": "Не удается создать этого пользователя.",
     "error.unable_to_update_user": "Не удается обновить этого пользователя.",
@@ -3254,30 +7117,82 @@ var translations = map[string]string{
     "error.empty_file": "Этот файл пуст.",
     "error.bad_credentials": "Неверное имя пользователя или пароль.",
     "error.fields_mandatory": "Все поля обязательны.",
+    "error.no_feed_selected": "No feed selected.",
     "error.title_required": "Название обязательно.",
     "error.different_passwords": "Пароли не совпадают.",
     "error.password_min_length": "Вы должны использовать минимум 6 символов.",
     "error.settings_mandatory_fields": "Имя пользователя, тема, язык и часовой пояс обязательны.",
     "error.entries_per_page_invalid": "Количество записей на странице недействительно.",
+    "error.invalid_youtube_embed_url": "The Invidious or Piped instance URL is not valid.",
+    "error.invalid_auto_star_keywords": "One of the auto-star keywords is not a valid regular expression.",
+    "error.invalid_email_recipients": "Один из получателей недействителен или получателей слишком много.",
+    "error.invalid_notification_email": "The email address for the notifications is invalid.",
+    "error.invalid_notification_email_token": "This verification link is invalid or has already been used.",
+    "error.invalid_home_page": "The home page is invalid, a search query is required to open a search.",
+    "error.invalid_entry_timezone": "The timezone of the publication dates is invalid.",
+    "error.invalid_timestamp_format": "The format of the dates is invalid.",
+    "error.unable_to_send_email": "Не удалось отправить письмо.",
+    "error.too_many_sent_emails": "You have sent too many emails, please try again later.",
     "error.feed_mandatory_fields": "URL и категория обязательны.",
+    "error.invalid_encoding": "This character encoding is not supported.",
+    "error.invalid_cron_expression": "Неверное выражение cron.",
+    "error.invalid_feed_request_limits": "The request timeout or the maximum size is out of the allowed range.",
+    "error.feed_disabled_after_errors": [
+        "Подписка автоматически отключена после %d недели ошибок: %s",
+        "Подписка автоматически отключена после %d недель ошибок: %s",
+        "Подписка автоматически отключена после %d недель ошибок: %s"
+    ],
     "error.user_mandatory_fields": "Имя пользователя обязательно.",
     "error.api_key_already_exists": "Этот ключ API уже существует.",
     "error.unable_to_create_api_key": "Невозможно создать этот ключ API.",
+    "error.muted_keyword_already_exists": "This keyword is already muted.",
+    "error.blocked_feed_already_exists": "This feed URL or domain is already blocked.",
+    "error.invalid_blocked_feed": "Enter a complete feed URL or a domain name.",
+    "error.unable_to_block_feed": "Unable to block this feed.",
+    "error.unable_to_create_muted_keyword": "Unable to mute this keyword.",
+    "error.invalid_muted_keyword": "Invalid keyword or regular expression.",
+    "error.invalid_expiration_date": "Invalid expiration date.",
+    "error.too_many_sent_entries": "You have sent too many entries, please try again later.",
     "form.feed.label.title": "Название",
+    "form.feed.help.original_title": "Исходное название: %s",
+    "form.feed.help.previous_feed_url": "Адрес подписки был автоматически обновлён. Предыдущий адрес: %s",
     "form.feed.label.site_url": "URL сайта",
     "form.feed.label.feed_url": "URL подписки",
     "form.feed.label.category": "Категория",
     "form.feed.label.crawler": "Извлечь оригинальное содержимое",
     "form.feed.label.feed_username": "Имя пользователя подписки",
     "form.feed.label.feed_password": "Пароль подписки",
+    "form.feed.label.bearer_token": "Feed Bearer Token",
+    "form.feed.label.oauth2_token_url": "OAuth2 Token URL",
+    "form.feed.label.oauth2_client_id": "OAuth2 Client ID",
+    "form.feed.label.oauth2_client_secret": "OAuth2 Client Secret",
+    "form.feed.label.oauth2_scopes": "OAuth2 Scopes (separated by spaces)",
     "form.feed.label.user_agent": "Переопределить User Agent по умолчанию",
     "form.feed.label.scraper_rules": "Правила Scraper",
     "form.feed.label.rewrite_rules": "Правила Rewrite",
+    "form.feed.label.encoding": "Character Encoding Override",
     "form.feed.label.ignore_http_cache": "Игнорировать HTTP-кеш",
     "form.feed.label.fetch_via_proxy": "Получить через прокси",
     "form.feed.label.disabled": "Не обновлять этот канал",
+    "form.feed.label.open_external_link": "Open entries directly on the original website",
+    "form.feed.label.fetch_scores": "Fetch score and number of comments from Reddit and Hacker News",
+    "form.feed.label.minimum_score": "Ignore entries with a score lower than",
+    "form.feed.label.blocked_authors": "Ignore entries written by these authors (one per line)",
+    "form.feed.label.auto_star": "Star automatically new entries of this feed",
+    "form.feed.label.archive_pages": "Keep a copy of the full web page of new entries",
+    "form.feed.label.hide_globally": "Скрывать статьи в общем списке непрочитанного",
+    "form.feed.label.notify_errors": "Send a notification when this feed keeps failing",
+    "form.feed.label.cron_expression": "Расписание обновления (выражение cron)",
+    "form.feed.help.cron_expression": "Минута, час, день месяца, месяц и день недели в вашем часовом поясе. Оставьте пустым, чтобы использовать расписание по умолчанию.",
+    "form.feed.label.request_timeout": "Тайм-аут запроса (секунды)",
+    "form.feed.label.max_body_size": "Максимальный размер (МБ)",
+    "form.feed.help.http_client_limits": "Укажите 0, чтобы использовать глобальные настройки.",
     "form.category.label.title": "Название",
+    "form.category.label.move_feeds_to": "Move the feeds to",
+    "form.category.label.member_username": "Share with user",
     "form.user.label.username": "Имя пользователя",
+    "form.entry_send.label.username": "Recipient username",
+    "form.entry_send.label.message": "Message (optional)",
     "form.user.label.password": "Пароль",
     "form.user.label.confirmation": "Подтверждение пароля",
     "form.user.label.admin": "Администратор",
@@ -3286,10 +7201,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "Тема",
     "form.prefs.label.entry_sorting": "Сортировка записей",
     "form.prefs.label.entries_per_page": "Записи на странице",
+    "form.prefs.label.home_page": "Home page",
+    "form.prefs.label.home_page_search": "Search query opened on the home page",
+    "form.prefs.label.entry_timezone": "Publication dates",
+    "form.prefs.select.entry_timezone_local": "In my timezone",
+    "form.prefs.select.entry_timezone_original": "In the timezone of the feed",
+    "form.prefs.select.entry_timezone_both": "In both timezones",
+    "form.prefs.label.timestamp_format": "Dates in the lists",
+    "form.prefs.select.timestamp_relative": "Relative (2 hours ago)",
+    "form.prefs.select.timestamp_absolute": "Absolute (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "Сначала старые записи",
     "form.prefs.select.recent_first": "Сначала последние записи",
+    "form.prefs.select.home_page_category": "Category",
+    "form.prefs.select.home_page_search": "Search",
     "form.prefs.label.keyboard_shortcuts": "Включить сочетания клавиш",
     "form.prefs.label.show_reading_time": "Показать примерное время чтения статей",
+    "form.prefs.label.mark_read_on_original_link": "Mark entries as read when opening the original link",
+    "form.prefs.label.keep_starred_entries": "Keep starred articles when their feed or category is removed",
+    "form.prefs.label.accept_received_entries": "Accept entries sent by other users",
+    "form.prefs.label.archive_read_days": "Days to keep read articles",
+    "form.prefs.help.archive_read_days": "Leave empty to use the default of %d days, the maximum is %d days.",
+    "form.prefs.label.youtube_embed_url": "Invidious or Piped instance used to play YouTube videos",
+    "form.prefs.label.blocked_authors": "Ignore entries written by these authors in all feeds (one per line)",
+    "form.prefs.label.auto_star_keywords": "Star automatically new entries matching these keywords (one regular expression per line)",
+    "form.prefs.label.auto_star_authors": "Star automatically new entries from these authors (one per line)",
+    "form.prefs.label.email_recipients": "Получатели по умолчанию при отправке по почте (через запятую)",
+    "form.prefs.label.notification_email": "Email address for the notifications",
+    "form.prefs.help.notification_email_unverified": "This address is not verified yet, no notification is sent to it.",
     "form.prefs.label.custom_css": "Пользовательские CSS",
     "form.import.label.file": "OPML файл",
     "form.import.label.url": "URL",
@@ -3300,12 +7238,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "Сохранять статьи в Pinboard",
     "form.integration.pinboard_token": "Pinboard API Token",
     "form.integration.pinboard_tags": "Теги Pinboard",
+    "form.integration.pinboard_category_tag": "Добавлять категорию подписки как тег",
+    "form.integration.pinboard_entry_tags": "Добавлять теги статьи",
+    "form.integration.pinboard_prompt_tags": "Запрашивать дополнительные теги при сохранении статьи",
     "form.integration.pinboard_bookmark": "Помечать закладки как непрочитанное",
     "form.integration.instapaper_activate": "Сохранять статьи в Instapaper",
     "form.integration.instapaper_username": "Имя пользователя Instapaper",
     "form.integration.instapaper_password": "Пароль Instapaper",
+    "form.integration.instapaper_folder": "Instapaper Folder",
+    "form.integration.instapaper_folder_unread": "Unread (default)",
+    "form.integration.instapaper_folder_id": "Instapaper Folder ID",
+    "form.integration.instapaper_folder_help": "The folders are listed once the integration is saved, save again to update the list. Leave empty to save articles in the Unread folder.",
     "form.integration.pocket_activate": "Сохранять статьи в Pocket",
     "form.integration.pocket_consumer_key": "Pocket Consumer Key",
+    "form.integration.pocket_category_tag": "Добавлять категорию подписки как тег",
     "form.integration.pocket_access_token": "Pocket Access Token",
     "form.integration.pocket_connect_link": "Подключить аккаунт Pocket",
     "form.integration.wallabag_activate": "Сохранять статьи в Wallabag",
@@ -3314,12 +7260,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Wallabag Client Secret",
     "form.integration.wallabag_username": "Имя пользователя Wallabag",
     "form.integration.wallabag_password": "Пароль Wallabag",
+    "form.integration.wallabag_tags": "Теги Wallabag (через запятую)",
+    "form.integration.wallabag_archive": "Архивировать статьи, сохранённые в Wallabag",
     "form.integration.nunux_keeper_activate": "Сохранять статьи в Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Конечная точка Nunux Keeper API",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API Key",
+    "form.integration.karakeep_activate": "Save articles to Karakeep",
+    "form.integration.karakeep_endpoint": "Karakeep server URL",
+    "form.integration.karakeep_api_key": "Karakeep API key",
+    "form.integration.karakeep_tags": "Karakeep tags (comma separated)",
+    "form.integration.archivebox_activate": "Archive saved and starred articles with ArchiveBox",
+    "form.integration.archivebox_endpoint": "ArchiveBox server URL",
+    "form.integration.archivebox_api_key": "ArchiveBox API key",
+    "form.integration.archivebox_tags": "ArchiveBox tags (comma separated)",
+    "form.integration.archivebox_help": "The link to the snapshot is shown on the article once ArchiveBox has finished archiving the page.",
+    "form.integration.ntfy_activate": "Send push notifications with ntfy",
+    "form.integration.ntfy_topic_url": "ntfy topic URL",
+    "form.integration.ntfy_token": "ntfy access token (optional)",
+    "form.integration.ntfy_help": "Feeds with error notifications enabled are reported to this topic when they keep failing.",
+    "form.integration.espial_activate": "Save articles to Espial",
+    "form.integration.espial_endpoint": "Espial server URL",
+    "form.integration.espial_api_key": "Espial API key",
+    "form.integration.espial_tags": "Espial tags (space separated)",
+    "form.integration.linkace_activate": "Save articles to LinkAce",
+    "form.integration.linkace_endpoint": "LinkAce server URL",
+    "form.integration.linkace_api_key": "LinkAce API token",
+    "form.integration.linkace_tags": "LinkAce tags (comma separated)",
+    "form.integration.linkace_lists": "LinkAce list IDs (comma separated)",
+    "form.integration.linkace_category_tag": "Add the category as tag",
+    "form.integration.linkace_is_private": "Save links as private",
+    "form.integration.custom_bookmark": "Custom bookmark service",
+    "form.integration.custom_bookmark_activate": "Save articles to a custom bookmark service",
+    "form.integration.custom_bookmark_endpoint": "API Endpoint",
+    "form.integration.custom_bookmark_method": "HTTP Method",
+    "form.integration.custom_bookmark_headers": "HTTP Headers (one per line)",
+    "form.integration.custom_bookmark_body": "JSON Body Template",
+    "form.integration.custom_bookmark_body_help": "Placeholders are replaced by JSON values, do not add quotes around them:",
+    "form.integration.markdown": "Markdown notes",
+    "form.integration.markdown_activate": "Save articles as Markdown files, for notes apps like Obsidian",
+    "form.integration.markdown_starred": "Also save starred articles",
+    "form.integration.markdown_webdav_url": "WebDAV folder URL",
+    "form.integration.markdown_webdav_username": "WebDAV Username",
+    "form.integration.markdown_webdav_password": "WebDAV Password",
+    "form.integration.markdown_local_help": "Leave the URL empty to write the files in the folder configured by the administrator.",
+    "form.integration.wayback_activate": "Archive saved and starred articles with the Wayback Machine",
+    "form.integration.wayback_help": "The web page is submitted to the Internet Archive and the link to the copy is shown on the article.",
+    "form.integration.zotero_activate": "Save articles to Zotero",
+    "form.integration.zotero_library_type": "Zotero library",
+    "form.integration.zotero_library_user": "Personal library",
+    "form.integration.zotero_library_group": "Group library",
+    "form.integration.zotero_library_id": "Zotero user or group ID",
+    "form.integration.zotero_api_key": "Zotero API key",
+    "form.integration.zotero_collection_key": "Zotero collection key (optional)",
+    "form.integration.zotero_help": "Articles linking to a DOI are saved as journal articles, the other ones as web pages.",
+    "form.integration.hypothesis_activate": "Publish the annotations of articles to Hypothesis",
+    "form.integration.hypothesis_token": "Hypothesis API token",
+    "form.integration.hypothesis_token_help": "The token can be generated on the developer page of your Hypothesis account.",
+    "form.integration.hypothesis_group": "Hypothesis group ID",
+    "form.integration.hypothesis_group_help": "The annotations are visible to the members of this group, the group __world__ makes them public.",
+    "form.integration.hypothesis_export_help": "All annotations can also be downloaded in the W3C Web Annotation format with the API:",
+    "form.integration.rssbridge_activate": "Use RSS-Bridge when a website doesn't have any feed",
+    "form.integration.rssbridge_url": "RSS-Bridge server URL",
+    "form.integration.routing": "Категории",
+    "form.integration.routing_help": "Services limited to some categories only receive the articles of the selected categories, and nothing when no category is selected.",
+    "form.integration.routing_restrict": "Limit to the selected categories",
     "form.api_key.label.description": "Описание API-ключа",
+    "form.muted_keyword.label.pattern": "Keyword or regular expression",
+    "form.blocked_feed.label.pattern": "Feed URL or domain",
+    "form.blocked_feed.help.pattern": "A complete URL blocks only this feed, a domain name blocks all the feeds of the domain and its subdomains.",
+    "form.blocked_feed.label.reason": "Reason shown to users (optional)",
+    "form.muted_keyword.label.action": "Matching entries",
+    "form.muted_keyword.label.expires_at": "Expiration date (optional)",
+    "form.entry_email.label.recipients": "Получатели (через запятую)",
+    "form.entry_email.label.note": "Личная заметка (необязательно)",
+    "form.muted_keyword.select.drop": "Ignore",
+    "form.muted_keyword.select.read": "Mark as read",
     "form.submit.loading": "Загрузка…",
     "form.submit.saving": "Сохранение…",
+    "form.submit.sending": "Отправка...",
     "time_elapsed.not_yet": "ещё нет",
     "time_elapsed.yesterday": "вчера",
     "time_elapsed.now": "только что",
@@ -3364,9 +7382,26 @@ var translations = map[string]string{
     "action.save": "保存",
     "action.or": "或",
     "action.cancel": "取消",
+    "action.send": "发送",
+    "action.close": "关闭",
     "action.remove": "删除",
+    "action.add_to_queue": "加入队列",
+    "action.play_queue": "播放队列",
+    "action.move_up": "上移",
+    "action.move_down": "下移",
+    "action.open": "打开",
+    "action.save_search": "保存此搜索",
+    "action.unsubscribe_selected": "Unsubscribe from selected feeds",
+    "action.keep_selected": "Keep selected feeds",
     "action.remove_feed": "删除此源",
+    "action.use_canonical_feed_url": "使用此 URL",
     "action.update": "更新",
+    "action.send_verification_link": "Send a verification link",
+    "action.block_feed": "Block",
+    "action.share_category": "Share",
+    "action.hide_category": "Hide",
+    "action.unhide_category": "Show again",
+    "action.move_feeds_and_remove": "Move and remove",
     "action.edit": "编辑",
     "action.download": "下载",
     "action.import": "导入",
@@ -3376,36 +7411,53 @@ var translations = map[string]string{
     "tooltip.logged_user": "当前登录 %s",
     "menu.unread": "未读",
     "menu.starred": "星标",
+    "menu.trending": "Trending",
+    "menu.podcasts": "播客",
+    "menu.playback_queue": "播放队列",
     "menu.history": "历史",
     "menu.feeds": "源",
     "menu.categories": "分类",
+    "menu.today": "今天",
     "menu.settings": "设置",
     "menu.logout": "登出",
+    "menu.skip_to_content": "跳到内容",
     "menu.preferences": "设置",
     "menu.integrations": "集成",
     "menu.sessions": "会话",
     "menu.users": "用户",
+    "menu.reports": "Reports",
+    "menu.blocked_feeds": "Blocked Feeds",
     "menu.about": "关于",
     "menu.export": "导出",
     "menu.import": "导入",
     "menu.create_category": "新建分类",
+    "menu.hidden_categories": "Hidden categories",
     "menu.mark_page_as_read": "标记为已读",
+    "menu.saved_searches": "已保存的搜索",
+    "menu.clear_search_history": "清除搜索历史",
     "menu.mark_all_as_read": "全部标为已读",
     "menu.show_all_entries": "显示所有条目",
+    "menu.show_all_feeds": "Show all feeds",
     "menu.show_only_unread_entries": "仅显示未读文章",
     "menu.refresh_feed": "更新",
     "menu.refresh_all_feeds": "在后台更新全部源",
     "menu.edit_feed": "编辑",
     "menu.edit_category": "编辑",
     "menu.add_feed": "新增订阅",
+    "menu.feed_recommendations": "Recommendations",
     "menu.add_user": "新建用户",
     "menu.flush_history": "清理历史",
     "menu.feed_entries": "文章",
     "menu.api_keys": "API密钥",
     "menu.create_api_key": "创建一个新的API密钥",
+    "menu.muted_keywords": "屏蔽的关键词",
+    "menu.create_muted_keyword": "屏蔽新的关键词",
     "menu.shared_entries": "共享条目",
+    "menu.received_entries": "Received entries",
     "search.label": "搜索",
     "search.placeholder": "搜索…",
+    "search.placeholder_feed": "在此源中搜索…",
+    "search.placeholder_category": "在此分类中搜索…",
     "pagination.next": "下一页",
     "pagination.previous": "上一页",
     "entry.status.unread": "未读",
@@ -3418,19 +7470,50 @@ var translations = map[string]string{
     "entry.bookmark.toast.on": "已标记星标",
     "entry.bookmark.toast.off": "已去掉星标",
     "entry.state.saving": "保存中…",
+    "entry.state.queued": "已加入队列",
     "entry.state.loading": "载入中…",
+    "entry.state.archiving": "Archiving...",
     "entry.save.label": "保存",
     "entry.save.title": "保存这篇文章",
     "entry.save.completed": "完成",
     "entry.save.toast.completed": "已保存文章",
+    "entry.save.prompt_tags": "额外的标签（以空格分隔）",
+    "entry.select.label": "Select",
+    "entry.bulk.selected": "%d selected",
+    "entry.bulk.save": "Save selected entries",
+    "entry.bulk.save.completed": "Entries sent to third-party services",
+    "entry.bulk.clear": "Clear selection",
     "entry.scraper.label": "抓取原内容",
+    "entry.snapshot.title": "Open the copy of the web page kept by Miniflux",
+    "entry.snapshot.label": "Offline copy",
+    "entry.snapshot.create.title": "Keep a copy of the full web page, viewable even if the website goes offline",
+    "entry.snapshot.create.label": "Archive page",
+    "entry.snapshot.create.toast": "Web page archived",
     "entry.scraper.title": "抓取原内容",
     "entry.scraper.completed": "完成",
     "entry.original.label": "原始内容",
     "entry.comments.label": "评论",
+    "entry.location.title": "Show the location on a map",
+    "entry.location.label": "Map",
+    "entry.archives.title": "Copies of the web page kept by archiving services",
+    "entry.archives.label": "Archived copy:",
     "entry.comments.title": "查看评论",
+    "entry.print.label": "打印",
+    "entry.print.title": "打印或保存为 PDF",
+    "entry.print.source": "来源",
+    "entry.print.url": "URL",
+    "entry.print.date": "日期",
+    "entry.email.label": "邮件",
+    "entry.email.title": "通过邮件分享",
+    "email.entry.signature": "%s 通过 Miniflux 分享自 %s",
+    "email.feed_error.subject": "The feed \"%s\" keeps failing",
+    "email.feed_error.body": "Miniflux was unable to refresh the feed \"%s\" (%s) %d times in a row.\n\nLast error: %s\n\nYou can review the feed here: %s",
+    "email.notification_email_verification.subject": "Confirm your email address for the Miniflux notifications",
+    "email.notification_email_verification.body": "The Miniflux user \"%s\" wants to receive notifications at this address.\n\nOpen this link to confirm it: %s\n\nIf you didn't ask for it, you can ignore this email.",
     "entry.share.label": "分享",
     "entry.share.title": "分享这篇文章",
+    "entry.send.label": "Send",
+    "entry.send.title": "Send this article to another user",
     "entry.unshare.label": "取消分享",
     "entry.shared_entry.title": "打开公共链接",
     "entry.shared_entry.label": "分享分享",
@@ -3438,10 +7521,40 @@ var translations = map[string]string{
         "%d分钟阅读",
         "%d分钟阅读"
     ],
+    "entry.video_duration": [
+        "%d 分钟视频",
+        "%d 分钟视频"
+    ],
+    "entry.score": [
+        "%d 分",
+        "%d 分"
+    ],
+    "entry.comments_count": [
+        "%d 条评论",
+        "%d 条评论"
+    ],
+    "entry.duplicates": [
+        "%d similar entry",
+        "%d similar entries"
+    ],
+    "entry.removed_trackers": [
+        "已移除 %d 个跟踪器"
+    ],
     "page.shared_entries.title": "共享条目",
+    "page.received_entries.title": "Received Entries",
+    "page.received_entries.sender": "Sent by %s",
+    "page.entry_send.title": "Send to another user",
     "page.unread.title": "未读",
     "page.starred.title": "星标",
+    "page.orphaned_saves.title": "Orphaned saves",
+    "page.trending.title": "Trending",
+    "page.trending.feed_count": [
+        "(%d feed)",
+        "(%d feeds)"
+    ],
     "page.categories.title": "分类",
+    "page.hidden_categories.title": "Hidden Categories",
+    "page.hidden_categories.help": "Hidden categories keep their subscriptions up to date but their articles are left out of the unread list and its counters.",
     "page.categories.no_feed": "没有源",
     "page.categories.entries": "文章",
     "page.categories.feeds": "查看订阅",
@@ -3451,24 +7564,86 @@ var translations = map[string]string{
     "page.new_category.title": "新分类",
     "page.new_user.title": "新用户",
     "page.edit_category.title": "编辑分类 : %s",
+    "page.remove_category.title": "Remove Category: %s",
+    "page.remove_category.help": [
+        "This category contains %d feeds. They will be moved to the category below before the removal."
+    ],
+    "page.edit_category.members": "Members",
+    "page.edit_category.member_since": "Member since",
+    "page.edit_category.members_help": "Members see the feeds of this category in their own account with their own read and starred status, once they accept the invitation. Feeds are fetched only once for everyone.",
+    "page.edit_category.shared_by": "This category is shared by %s. New entries are fetched by its owner.",
+    "page.edit_category.invitation_pending": "Invitation pending",
+    "page.categories.invitation": "%s invites you to follow the category “%s”.",
+    "action.accept_invitation": "Accept",
+    "action.decline_invitation": "Decline",
+    "action.leave_shared_category": "Stop following this category",
     "page.edit_user.title": "编辑用户 : %s",
     "page.feeds.title": "源",
     "page.feeds.last_check": "最后检查时间：",
+    "page.feed_entries.volume": [
+        "%d entries during the last %d days"
+    ],
     "page.feeds.unread_counter": "未读条目数",
     "page.feeds.read_counter": "读取条目数",
     "page.feeds.error_count": [
         "%d 错误"
     ],
+    "page.feeds.dead": "已被发布者删除",
+    "page.feeds.dormant": "Dormant",
+    "page.feeds.dormant_title": "This feed hasn't published anything for a long time",
+    "page.feeds.move.hint": "Drag feeds to a category, or select several feeds and click a category:",
+    "page.feeds.move.selected": "Move the %d selected feeds to:",
+    "page.feeds.move.loading": "Moving...",
+    "page.unused_feeds.title": "Unread Feeds",
+    "page.unused_feeds.description": [
+        "You haven't read or starred anything from these feeds for %d month.",
+        "You haven't read or starred anything from these feeds for %d months."
+    ],
+    "page.unused_feeds.last_read": "Last read:",
+    "page.unused_feeds.never_read": "Never read",
     "page.history.title": "历史",
+    "page.today.title": "今天",
+    "page.podcasts.title": "播客",
+    "page.playback_queue.title": "播放队列",
+    "page.podcasts.total_duration": "总收听时长：%s",
+    "page.digest.title": "Since your last visit",
+    "page.digest.since": "Unread articles received since",
+    "page.digest.continue": "Continue reading",
+    "page.digest.top_entries": "Top articles",
+    "page.digest.entry_count": [
+        "%d new article",
+        "%d new articles"
+    ],
     "page.import.title": "导入",
     "page.search.title": "搜索结果",
+    "page.search.syntax_help": "使用 title:、author:、feed:、tag:、before:2006-01-02 和 after:2006-01-02 缩小搜索范围，用引号匹配精确短语，用斜杠表示正则表达式，例如 title:/^release/。",
+    "page.search.saved": "已保存的搜索",
+    "page.saved_searches.title": "已保存的搜索",
+    "page.saved_searches.recent": "最近的搜索",
+    "page.saved_searches.table.query": "搜索",
+    "page.saved_searches.table.actions": "操作",
+    "page.search.scope_feed": "源：%s",
+    "page.search.scope_category": "分类：%s",
+    "page.search.all_entries": "搜索所有文章",
     "page.about.title": "关于",
     "page.about.credits": "版权",
     "page.about.version": "版本号：",
     "page.about.build_date": "构建日期：",
+    "page.about.features": "Features",
+    "page.about.image_proxy": "Image proxy:",
+    "page.about.search_backend": "Search engine:",
+    "page.about.websub": "WebSub:",
+    "page.about.integrations": "Integrations:",
+    "page.about.enabled": "Enabled",
+    "page.about.disabled": "Disabled",
     "page.about.author": "作者：",
     "page.about.license": "协议：",
     "page.add_feed.title": "新增订阅",
+    "page.feed_recommendations.title": "Recommended Feeds",
+    "page.feed_recommendations.table.feed": "Feed",
+    "page.feed_recommendations.table.category": "Category",
+    "page.feed_recommendations.table.subscribers": "Subscribers",
+    "page.feed_recommendations.table.actions": "Actions",
     "page.add_feed.no_category": "没有类别，您必须至少有一个类别",
     "page.add_feed.label.url": "网址",
     "page.add_feed.submit": "查找订阅",
@@ -3479,8 +7654,22 @@ var translations = map[string]string{
     "page.edit_feed.last_modified_header": "最后修改的 Header：",
     "page.edit_feed.etag_header": "ETag 标题：",
     "page.edit_feed.no_header": "无",
+    "page.edit_feed.last_fetch_url": "最终 URL：",
+    "page.edit_feed.last_fetch_content_type": "Content-Type 头：",
+    "page.edit_feed.last_fetch_size": "大小：",
+    "page.edit_feed.bytes": [
+        "%d 字节"
+    ],
     "page.edit_feed.last_parsing_error": "最后一次解析错误",
+    "page.edit_feed.canonical_feed_url": "此源声明了不同的 URL：%s",
     "page.entry.attachments": "附件",
+    "page.entry.comments": "Comments",
+    "page.entry.comments.follow": "Follow the discussion",
+    "page.entry.comments.unfollow": "Stop following",
+    "page.entry.comments.following": [
+        "New comments will be fetched for %d more day.",
+        "New comments will be fetched for %d more days."
+    ],
     "page.keyboard_shortcuts.title": "快捷键",
     "page.keyboard_shortcuts.subtitle.sections": "分区导航",
     "page.keyboard_shortcuts.subtitle.items": "条目导航",
@@ -3501,6 +7690,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.open_item": "打开选定的条目",
     "page.keyboard_shortcuts.open_original": "打开原始链接",
     "page.keyboard_shortcuts.open_original_same_window": "在当前标签页中打开原始链接",
+    "page.keyboard_shortcuts.open_original_background": "Open original link in background tab",
     "page.keyboard_shortcuts.open_comments": "打开评论链接",
     "page.keyboard_shortcuts.open_comments_same_window": "在当前标签页中打开评论链接",
     "page.keyboard_shortcuts.toggle_read_status": "切换已读/未读状态",
@@ -3513,6 +7703,12 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.go_to_search": "将重点放在搜索表单上",
     "page.keyboard_shortcuts.close_modal": "关闭模态对话窗口",
     "page.users.title": "用户",
+    "page.reports.title": "Reports",
+    "page.reports.database_size": "Database size",
+    "page.reports.storage": "Storage",
+    "page.reports.feeds": "Feeds",
+    "page.reports.entries": "Articles",
+    "page.reports.last_activity": "Last activity",
     "page.users.username": "用户名",
     "page.users.never_logged": "从未登陆",
     "page.users.admin.yes": "是",
@@ -3552,30 +7748,90 @@ var translations = map[string]string{
     "page.api_keys.table.actions": "操作",
     "page.api_keys.never_used": "没用过",
     "page.new_api_key.title": "新的API密钥",
+    "page.muted_keywords.title": "屏蔽的关键词",
+    "page.blocked_feeds.title": "Blocked Feeds",
+    "page.blocked_feeds.help": "Nobody can subscribe to these feeds on this instance. Blocking a domain also blocks all its subdomains. Existing subscriptions are disabled.",
+    "page.blocked_feeds.table.pattern": "Feed URL or domain",
+    "page.blocked_feeds.table.reason": "Reason",
+    "page.blocked_feeds.table.created_at": "Blocked",
+    "page.blocked_feeds.table.actions": "Actions",
+    "page.muted_keywords.help": "New entries of all your feeds matching one of these keywords or regular expressions are ignored or marked as read.",
+    "page.muted_keywords.table.pattern": "Keyword or Regex",
+    "page.muted_keywords.table.action": "Action",
+    "page.muted_keywords.table.expires_at": "Expiration Date",
+    "page.muted_keywords.table.actions": "Actions",
+    "page.muted_keywords.never_expires": "Never",
+    "page.muted_keywords.expired": "Expired",
+    "page.new_muted_keyword.title": "New Muted Keyword",
+    "page.entry_email.title": "通过邮件分享",
     "alert.no_shared_entry": "没有共享条目。",
+    "alert.blocked_feed_created": [
+        "The feed has been blocked, %d existing subscription has been disabled.",
+        "The feed has been blocked, %d existing subscriptions have been disabled."
+    ],
+    "alert.no_received_entry": "There is no received entry.",
     "alert.no_bookmark": "目前没有书签",
+    "alert.no_trending_topic": "There is no trending topic at the moment.",
     "alert.no_category": "目前没有分类",
+    "alert.no_hidden_category": "There is no hidden category.",
+    "alert.no_category_to_move_feeds": "Create another category first to receive the feeds of this one.",
+    "alert.category_invitation_sent": "An invitation to follow this category has been sent to %s.",
+    "alert.no_feed_recommendation": "There is no recommendation for your categories at the moment.",
     "alert.no_category_entry": "该分类下没有文章",
     "alert.no_feed_entry": "该源中没有文章",
     "alert.no_feed": "目前没有订阅",
+    "alert.unused_feeds": [
+        "%d feed hasn't been read for a long time, review it.",
+        "%d feeds haven't been read for a long time, review them."
+    ],
+    "alert.dormant_feeds": [
+        "%d feeds haven't published anything for a long time, review them."
+    ],
+    "alert.dormant_feeds_filter": [
+        "Feeds without new entries for %d days."
+    ],
+    "alert.no_unused_feed": "You read all your subscriptions, nothing to clean up.",
     "alert.no_history": "目前没有历史",
     "alert.feed_error": "该源存在问题",
+    "alert.feed_dead": "此源已不可用，不再自动刷新",
     "alert.no_search_result": "该搜索没有结果",
+    "alert.no_saved_search": "没有已保存的搜索。",
     "alert.no_feed_in_category": "没有该类别的订阅。",
     "alert.no_unread_entry": "目前没有未读文章",
+    "alert.no_today_entry": "No article has been published today.",
+    "alert.no_podcast_entry": "没有未读的播客节目。",
+    "alert.no_queued_entry": "播放队列为空，请从播客页面添加节目。",
+    "alert.no_digest_entry": "Nothing new since your last visit.",
     "alert.no_user": "您是目前仅有的用户",
     "alert.account_unlinked": "您的外部帐户现已解除关联！",
     "alert.account_linked": "您的外部账号已关联！",
     "alert.pocket_linked": "您的Pocket帐户现已关联",
     "alert.prefs_saved": "设置已存储！",
+    "alert.notification_email_verification_sent": "A verification link has been sent to the notification email address.",
+    "alert.notification_email_verified": "The notification email address is verified.",
+    "alert.entry_sent_by_email": "文章已通过邮件发送。",
     "error.unlink_account_without_password": "您必须定义密码，否则您将无法再次登录。",
     "error.duplicate_linked_account": "该 Provider 已被关联！",
     "error.duplicate_fever_username": "Fever 用户名已被占用！",
+    "error.custom_bookmark_invalid": "Invalid custom bookmark service settings: %v.",
+    "error.markdown_invalid": "Invalid Markdown notes settings: %v.",
+    "error.markdown_webdav_url_required": "The WebDAV folder URL is required to save Markdown notes.",
+    "error.hypothesis_token_required": "The Hypothesis API token is required.",
+    "error.hypothesis_group_required": "The Hypothesis group ID is required.",
+    "error.zotero_invalid": "Invalid Zotero settings: %v.",
+    "error.instapaper_invalid": "Unable to connect to Instapaper with these credentials: %v.",
+    "error.ntfy_invalid_topic_url": "The ntfy topic URL is invalid.",
+    "error.linkace_invalid_lists": "Invalid LinkAce lists: %v.",
+    "error.archivebox_credentials_required": "The ArchiveBox server URL and API key are required.",
     "error.pocket_request_token": "无法从 Pocket 获取请求令牌！",
     "error.pocket_access_token": "无法从 Pocket 获取访问令牌！",
     "error.category_already_exists": "分类已存在",
+    "error.category_member_not_found": "This user does not exist.",
+    "error.category_shared_with_you": "This category is shared by %s and cannot be shared again.",
     "error.unable_to_create_category": "无法建立这个分类",
     "error.unable_to_update_category": "无法更新该分类",
+    "error.unable_to_remove_category": "Unable to remove this category.",
+    "error.category_remove_target": "Choose another category for the feeds.",
     "error.user_already_exists": "用户已存在",
     "error.unable_to_create_user": "无法创建此用户",
     "error.unable_to_update_user": "无法更新此用户",
@@ -3584,30 +7840,80 @@ var translations = map[string]string{
     "error.empty_file": "该文件为空",
     "error.bad_credentials": "用户名或密码无效",
     "error.fields_mandatory": "必须填写全部信息",
+    "error.no_feed_selected": "No feed selected.",
     "error.title_required": "必须填写标题",
     "error.different_passwords": "两次输入的密码不同",
     "error.password_min_length": "请至少使用6个字符",
     "error.settings_mandatory_fields": "必须填写用户名、主题、语言以及时区",
     "error.entries_per_page_invalid": "每页的条目数无效。",
+    "error.invalid_youtube_embed_url": "The Invidious or Piped instance URL is not valid.",
+    "error.invalid_auto_star_keywords": "One of the auto-star keywords is not a valid regular expression.",
+    "error.invalid_email_recipients": "某个收件人无效或收件人过多。",
+    "error.invalid_notification_email": "The email address for the notifications is invalid.",
+    "error.invalid_notification_email_token": "This verification link is invalid or has already been used.",
+    "error.invalid_home_page": "The home page is invalid, a search query is required to open a search.",
+    "error.invalid_entry_timezone": "The timezone of the publication dates is invalid.",
+    "error.invalid_timestamp_format": "The format of the dates is invalid.",
+    "error.unable_to_send_email": "无法发送邮件。",
+    "error.too_many_sent_emails": "You have sent too many emails, please try again later.",
     "error.feed_mandatory_fields": "必须填写 URL 和分类",
+    "error.invalid_encoding": "This character encoding is not supported.",
+    "error.invalid_cron_expression": "无效的 cron 表达式。",
+    "error.invalid_feed_request_limits": "The request timeout or the maximum size is out of the allowed range.",
+    "error.feed_disabled_after_errors": [
+        "此订阅源连续 %d 周出错，已被自动禁用：%s"
+    ],
     "error.user_mandatory_fields": "必须填写用户名",
     "error.api_key_already_exists": "此API密钥已存在。",
     "error.unable_to_create_api_key": "无法创建此API密钥。",
+    "error.muted_keyword_already_exists": "This keyword is already muted.",
+    "error.blocked_feed_already_exists": "This feed URL or domain is already blocked.",
+    "error.invalid_blocked_feed": "Enter a complete feed URL or a domain name.",
+    "error.unable_to_block_feed": "Unable to block this feed.",
+    "error.unable_to_create_muted_keyword": "Unable to mute this keyword.",
+    "error.invalid_muted_keyword": "Invalid keyword or regular expression.",
+    "error.invalid_expiration_date": "Invalid expiration date.",
+    "error.too_many_sent_entries": "You have sent too many entries, please try again later.",
     "form.feed.label.title": "标题",
+    "form.feed.help.original_title": "原始标题：%s",
+    "form.feed.help.previous_feed_url": "源 URL 已自动更新。之前的 URL：%s",
     "form.feed.label.site_url": "站点 URL",
     "form.feed.label.feed_url": "源 URL",
     "form.feed.label.category": "类别",
     "form.feed.label.crawler": "获取原始内容",
     "form.feed.label.feed_username": "源用户名",
     "form.feed.label.feed_password": "源密码",
+    "form.feed.label.bearer_token": "Feed Bearer Token",
+    "form.feed.label.oauth2_token_url": "OAuth2 Token URL",
+    "form.feed.label.oauth2_client_id": "OAuth2 Client ID",
+    "form.feed.label.oauth2_client_secret": "OAuth2 Client Secret",
+    "form.feed.label.oauth2_scopes": "OAuth2 Scopes (separated by spaces)",
     "form.feed.label.user_agent": "覆盖默认 User-Agent",
     "form.feed.label.scraper_rules": "Scraper 规则",
     "form.feed.label.rewrite_rules": "重写规则",
+    "form.feed.label.encoding": "Character Encoding Override",
     "form.feed.label.ignore_http_cache": "忽略HTTP缓存",
     "form.feed.label.fetch_via_proxy": "通过代理获取",
     "form.feed.label.disabled": "请勿刷新此Feed",
+    "form.feed.label.open_external_link": "Open entries directly on the original website",
+    "form.feed.label.fetch_scores": "Fetch score and number of comments from Reddit and Hacker News",
+    "form.feed.label.minimum_score": "Ignore entries with a score lower than",
+    "form.feed.label.blocked_authors": "Ignore entries written by these authors (one per line)",
+    "form.feed.label.auto_star": "Star automatically new entries of this feed",
+    "form.feed.label.archive_pages": "Keep a copy of the full web page of new entries",
+    "form.feed.label.hide_globally": "在全局未读列表中隐藏文章",
+    "form.feed.label.notify_errors": "Send a notification when this feed keeps failing",
+    "form.feed.label.cron_expression": "刷新计划（cron 表达式）",
+    "form.feed.help.cron_expression": "按您的时区填写分钟、小时、日期、月份和星期。留空则使用默认计划。",
+    "form.feed.label.request_timeout": "请求超时（秒）",
+    "form.feed.label.max_body_size": "最大大小（MB）",
+    "form.feed.help.http_client_limits": "使用 0 保留全局设置。",
     "form.category.label.title": "标题",
+    "form.category.label.move_feeds_to": "Move the feeds to",
+    "form.category.label.member_username": "Share with user",
     "form.user.label.username": "用户名",
+    "form.entry_send.label.username": "Recipient username",
+    "form.entry_send.label.message": "Message (optional)",
     "form.user.label.password": "密码",
     "form.user.label.confirmation": "确认",
     "form.user.label.admin": "管理员",
@@ -3616,10 +7922,33 @@ var translations = map[string]string{
     "form.prefs.label.theme": "主题",
     "form.prefs.label.entry_sorting": "内容排序",
     "form.prefs.label.entries_per_page": "每页条目",
+    "form.prefs.label.home_page": "Home page",
+    "form.prefs.label.home_page_search": "Search query opened on the home page",
+    "form.prefs.label.entry_timezone": "Publication dates",
+    "form.prefs.select.entry_timezone_local": "In my timezone",
+    "form.prefs.select.entry_timezone_original": "In the timezone of the feed",
+    "form.prefs.select.entry_timezone_both": "In both timezones",
+    "form.prefs.label.timestamp_format": "Dates in the lists",
+    "form.prefs.select.timestamp_relative": "Relative (2 hours ago)",
+    "form.prefs.select.timestamp_absolute": "Absolute (2020-05-01 10:30:00)",
     "form.prefs.select.older_first": "旧->新",
     "form.prefs.select.recent_first": "新->旧",
+    "form.prefs.select.home_page_category": "Category",
+    "form.prefs.select.home_page_search": "Search",
     "form.prefs.label.keyboard_shortcuts": "启用键盘快捷键",
     "form.prefs.label.show_reading_time": "显示文章的预计阅读时间",
+    "form.prefs.label.mark_read_on_original_link": "Mark entries as read when opening the original link",
+    "form.prefs.label.keep_starred_entries": "Keep starred articles when their feed or category is removed",
+    "form.prefs.label.accept_received_entries": "Accept entries sent by other users",
+    "form.prefs.label.archive_read_days": "Days to keep read articles",
+    "form.prefs.help.archive_read_days": "Leave empty to use the default of %d days, the maximum is %d days.",
+    "form.prefs.label.youtube_embed_url": "Invidious or Piped instance used to play YouTube videos",
+    "form.prefs.label.blocked_authors": "Ignore entries written by these authors in all feeds (one per line)",
+    "form.prefs.label.auto_star_keywords": "Star automatically new entries matching these keywords (one regular expression per line)",
+    "form.prefs.label.auto_star_authors": "Star automatically new entries from these authors (one per line)",
+    "form.prefs.label.email_recipients": "通过邮件分享时的默认收件人（逗号分隔）",
+    "form.prefs.label.notification_email": "Email address for the notifications",
+    "form.prefs.help.notification_email_unverified": "This address is not verified yet, no notification is sent to it.",
     "form.prefs.label.custom_css": "自定义CSS",
     "form.import.label.file": "OPML 文件",
     "form.import.label.url": "URL",
@@ -3630,12 +7959,20 @@ var translations = map[string]string{
     "form.integration.pinboard_activate": "保存文章到 Pinboard",
     "form.integration.pinboard_token": "Pinboard API Token",
     "form.integration.pinboard_tags": "Pinboard 标签",
+    "form.integration.pinboard_category_tag": "将源的分类添加为标签",
+    "form.integration.pinboard_entry_tags": "添加文章的标签",
+    "form.integration.pinboard_prompt_tags": "保存文章时询问额外的标签",
     "form.integration.pinboard_bookmark": "标记为未读",
     "form.integration.instapaper_activate": "保存文章到Instapaper",
     "form.integration.instapaper_username": "Instapaper 用户名",
     "form.integration.instapaper_password": "Instapaper 密码",
+    "form.integration.instapaper_folder": "Instapaper Folder",
+    "form.integration.instapaper_folder_unread": "Unread (default)",
+    "form.integration.instapaper_folder_id": "Instapaper Folder ID",
+    "form.integration.instapaper_folder_help": "The folders are listed once the integration is saved, save again to update the list. Leave empty to save articles in the Unread folder.",
     "form.integration.pocket_activate": "将文章保存到Pocket",
     "form.integration.pocket_consumer_key": "Pocket 用户密钥",
+    "form.integration.pocket_category_tag": "将源的分类添加为标签",
     "form.integration.pocket_access_token": "Pocket Access Token",
     "form.integration.pocket_connect_link": "连接您的 Pocket 帐户",
     "form.integration.wallabag_activate": "保存文章到 Wallabag",
@@ -3644,12 +7981,84 @@ var translations = map[string]string{
     "form.integration.wallabag_client_secret": "Wallabag 客户端 Secret",
     "form.integration.wallabag_username": "Wallabag 用户名",
     "form.integration.wallabag_password": "Wallabag 密码",
+    "form.integration.wallabag_tags": "Wallabag 标签（逗号分隔）",
+    "form.integration.wallabag_archive": "归档保存到 Wallabag 的文章",
     "form.integration.nunux_keeper_activate": "保存文章到 Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API 密钥",
+    "form.integration.karakeep_activate": "Save articles to Karakeep",
+    "form.integration.karakeep_endpoint": "Karakeep server URL",
+    "form.integration.karakeep_api_key": "Karakeep API key",
+    "form.integration.karakeep_tags": "Karakeep tags (comma separated)",
+    "form.integration.archivebox_activate": "Archive saved and starred articles with ArchiveBox",
+    "form.integration.archivebox_endpoint": "ArchiveBox server URL",
+    "form.integration.archivebox_api_key": "ArchiveBox API key",
+    "form.integration.archivebox_tags": "ArchiveBox tags (comma separated)",
+    "form.integration.archivebox_help": "The link to the snapshot is shown on the article once ArchiveBox has finished archiving the page.",
+    "form.integration.ntfy_activate": "Send push notifications with ntfy",
+    "form.integration.ntfy_topic_url": "ntfy topic URL",
+    "form.integration.ntfy_token": "ntfy access token (optional)",
+    "form.integration.ntfy_help": "Feeds with error notifications enabled are reported to this topic when they keep failing.",
+    "form.integration.espial_activate": "Save articles to Espial",
+    "form.integration.espial_endpoint": "Espial server URL",
+    "form.integration.espial_api_key": "Espial API key",
+    "form.integration.espial_tags": "Espial tags (space separated)",
+    "form.integration.linkace_activate": "Save articles to LinkAce",
+    "form.integration.linkace_endpoint": "LinkAce server URL",
+    "form.integration.linkace_api_key": "LinkAce API token",
+    "form.integration.linkace_tags": "LinkAce tags (comma separated)",
+    "form.integration.linkace_lists": "LinkAce list IDs (comma separated)",
+    "form.integration.linkace_category_tag": "Add the category as tag",
+    "form.integration.linkace_is_private": "Save links as private",
+    "form.integration.custom_bookmark": "Custom bookmark service",
+    "form.integration.custom_bookmark_activate": "Save articles to a custom bookmark service",
+    "form.integration.custom_bookmark_endpoint": "API Endpoint",
+    "form.integration.custom_bookmark_method": "HTTP Method",
+    "form.integration.custom_bookmark_headers": "HTTP Headers (one per line)",
+    "form.integration.custom_bookmark_body": "JSON Body Template",
+    "form.integration.custom_bookmark_body_help": "Placeholders are replaced by JSON values, do not add quotes around them:",
+    "form.integration.markdown": "Markdown notes",
+    "form.integration.markdown_activate": "Save articles as Markdown files, for notes apps like Obsidian",
+    "form.integration.markdown_starred": "Also save starred articles",
+    "form.integration.markdown_webdav_url": "WebDAV folder URL",
+    "form.integration.markdown_webdav_username": "WebDAV Username",
+    "form.integration.markdown_webdav_password": "WebDAV Password",
+    "form.integration.markdown_local_help": "Leave the URL empty to write the files in the folder configured by the administrator.",
+    "form.integration.wayback_activate": "Archive saved and starred articles with the Wayback Machine",
+    "form.integration.wayback_help": "The web page is submitted to the Internet Archive and the link to the copy is shown on the article.",
+    "form.integration.zotero_activate": "Save articles to Zotero",
+    "form.integration.zotero_library_type": "Zotero library",
+    "form.integration.zotero_library_user": "Personal library",
+    "form.integration.zotero_library_group": "Group library",
+    "form.integration.zotero_library_id": "Zotero user or group ID",
+    "form.integration.zotero_api_key": "Zotero API key",
+    "form.integration.zotero_collection_key": "Zotero collection key (optional)",
+    "form.integration.zotero_help": "Articles linking to a DOI are saved as journal articles, the other ones as web pages.",
+    "form.integration.hypothesis_activate": "Publish the annotations of articles to Hypothesis",
+    "form.integration.hypothesis_token": "Hypothesis API token",
+    "form.integration.hypothesis_token_help": "The token can be generated on the developer page of your Hypothesis account.",
+    "form.integration.hypothesis_group": "Hypothesis group ID",
+    "form.integration.hypothesis_group_help": "The annotations are visible to the members of this group, the group __world__ makes them public.",
+    "form.integration.hypothesis_export_help": "All annotations can also be downloaded in the W3C Web Annotation format with the API:",
+    "form.integration.rssbridge_activate": "Use RSS-Bridge when a website doesn't have any feed",
+    "form.integration.rssbridge_url": "RSS-Bridge server URL",
+    "form.integration.routing": "分类",
+    "form.integration.routing_help": "Services limited to some categories only receive the articles of the selected categories, and nothing when no category is selected.",
+    "form.integration.routing_restrict": "Limit to the selected categories",
     "form.api_key.label.description": "API密钥标签",
+    "form.muted_keyword.label.pattern": "Keyword or regular expression",
+    "form.blocked_feed.label.pattern": "Feed URL or domain",
+    "form.blocked_feed.help.pattern": "A complete URL blocks only this feed, a domain name blocks all the feeds of the domain and its subdomains.",
+    "form.blocked_feed.label.reason": "Reason shown to users (optional)",
+    "form.muted_keyword.label.action": "Matching entries",
+    "form.muted_keyword.label.expires_at": "Expiration date (optional)",
+    "form.entry_email.label.recipients": "收件人（逗号分隔）",
+    "form.entry_email.label.note": "个人备注（可选）",
+    "form.muted_keyword.select.drop": "Ignore",
+    "form.muted_keyword.select.read": "Mark as read",
     "form.submit.loading": "载入中…",
     "form.submit.saving": "保存中…",
+    "form.submit.sending": "发送中...",
     "time_elapsed.not_yet": "尚未",
     "time_elapsed.yesterday": "昨天",
     "time_elapsed.now": "刚刚",
@@ -3695,15 +8104,15 @@ var translations = map[string]string{
 }
 
 var translationsChecksums = map[string]string{
-	"de_DE": "8f96cb46f5a7e8f64ee8f10176dc3a2f3d53953d250317da83a79d0700b47c82",
-	"en_US": "d33324caed406ecf6ce03920b15e235d46b258457a8bd48cd1ade685b9a3ad6b",
-	"es_ES": "2ff9333218dba2b86cb84f377dad66b9dc73848aee6bb09889cbdc10e58ca077",
-	"fr_FR": "07dc2cfdbc14cdf16312423158656f5526d3c3c7be490abf5503109a408e5056",
-	"it_IT": "d4f68a507e1deb9fab3aa38fb78d9e9e4040386d6f36611ec5f105adfb4b0d03",
-	"ja_JP": "5c4c063ebaee14bed941b020e0d19de5ef5e8d3bf11c1967b1f321d57d5af6a9",
-	"nl_NL": "f862027e192be7a09730470acc2639971c4abf01b256d5bb81246960cc54adcf",
-	"pl_PL": "35147e55f1800964d268dc04b9cc25a9c8fa98077f759c5d3a5bd339f0eee53e",
-	"pt_BR": "2461105ebc2a2d57b3a63a29ee21f74e3d1eba54c049abcfd077dd30acc8d0a2",
-	"ru_RU": "402f15d3c68e008a1ffa3dddb4002126a29c1cf93359a64bca944a8da541da72",
-	"zh_CN": "21ca934599220fa3d3ba06b390b619ce9d1565be329b8b5e35d43f7e3ad3a269",
+	"de_DE": "63a36c2ac3833f86b8b380e43f401ec41ddc97b1ce86d45a6e678dbd4eab1eb5",
+	"en_US": "5d58ca405e616feec3fdb1dc26c5a850a6ae11f51ed20885c7b8d6c3063679e1",
+	"es_ES": "5d5a1f8d417fc5ae3f0cc3c84156dcf9958f8066ee3703b9f8b6f494416109aa",
+	"fr_FR": "5077f2992bf76ff04e35ca3713152b674a9347c79e1f6ca3dc0a6ac6f5e956e0",
+	"it_IT": "d85686602aae15bdd423423c0978c38cad89286cbf01c962716705de61f9fa4e",
+	"ja_JP": "cffa1216be3c0463e2912498b9c95a384e83a9e482d35682f19eb719bd5633b0",
+	"nl_NL": "a08166ccccae393273f4374a538a859be2d3aeadb56ac8ce3fffdc120bb2fcc2",
+	"pl_PL": "06b89a8856c4909cca6b862fbb6b45a50c35bdcb94dbfcdd55d9096ce518956d",
+	"pt_BR": "9062a5ca27a78ebcc39bfe7adf2c0478cae79bbc514944fb0729ebdf07ed7431",
+	"ru_RU": "7253dfc790bb7534a7cbcdb6e63017619f2bec0d8a92be1e03e064e59a3e604b",
+	"zh_CN": "fc91dd43f9cb1a32166587659d16a58ef75dc72308a70366b4c0ea086817a1ee",
 }
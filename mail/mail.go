@@ -0,0 +1,205 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mail // import "miniflux.app/mail"
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+)
+
+const (
+	implicitTLSPort = 465
+	dialTimeout     = 10 * time.Second
+
+	// MaxRecipients is the maximum number of recipients of a single email.
+	MaxRecipients = 10
+)
+
+// Message represents a plain text email.
+type Message struct {
+	ID      string
+	From    string
+	To      []string
+	Subject string
+	Body    string
+	Date    time.Time
+}
+
+// Bytes returns the message encoded for the SMTP DATA command.
+func (m *Message) Bytes() []byte {
+	var buffer bytes.Buffer
+	buffer.WriteString("From: " + m.From + "\r\n")
+	buffer.WriteString("To: " + strings.Join(m.To, ", ") + "\r\n")
+	buffer.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", m.Subject) + "\r\n")
+	buffer.WriteString("Date: " + m.Date.Format(time.RFC1123Z) + "\r\n")
+	buffer.WriteString("Message-ID: <" + m.ID + ">\r\n")
+	buffer.WriteString("MIME-Version: 1.0\r\n")
+	buffer.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buffer.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buffer.WriteString("\r\n")
+
+	writer := quotedprintable.NewWriter(&buffer)
+	writer.Write([]byte(m.Body))
+	writer.Close()
+
+	return buffer.Bytes()
+}
+
+// ParseRecipients parses a list of addresses separated by commas or new lines.
+func ParseRecipients(value string) ([]string, error) {
+	var recipients []string
+
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ';' || r == '\n' || r == '\r'
+	})
+
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		address, err := netmail.ParseAddress(field)
+		if err != nil {
+			return nil, fmt.Errorf("mail: invalid address %q: %v", field, err)
+		}
+
+		recipients = append(recipients, address.Address)
+	}
+
+	if len(recipients) > MaxRecipients {
+		return nil, fmt.Errorf("mail: too many recipients, the maximum is %d", MaxRecipients)
+	}
+
+	return recipients, nil
+}
+
+// Client sends emails through an SMTP server.
+type Client struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+}
+
+// NewClient returns a new SMTP client.
+func NewClient(host string, port int, username, password, from string) *Client {
+	return &Client{host: host, port: port, username: username, password: password, from: from}
+}
+
+// Send delivers a message to the given recipients.
+func (c *Client) Send(to []string, subject, body string) error {
+	if len(to) == 0 {
+		return fmt.Errorf("mail: no recipients")
+	}
+
+	sender, err := netmail.ParseAddress(c.from)
+	if err != nil {
+		return fmt.Errorf("mail: invalid sender address %q: %v", c.from, err)
+	}
+
+	message := &Message{
+		ID:      newMessageID(sender.Address),
+		From:    sender.String(),
+		To:      to,
+		Subject: subject,
+		Body:    body,
+		Date:    time.Now(),
+	}
+
+	client, err := c.dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if c.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.username, c.password, c.host)); err != nil {
+			return fmt.Errorf("mail: unable to authenticate: %v", err)
+		}
+	}
+
+	if err := client.Mail(sender.Address); err != nil {
+		return fmt.Errorf("mail: sender rejected: %v", err)
+	}
+
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("mail: recipient %q rejected: %v", recipient, err)
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("mail: unable to send message: %v", err)
+	}
+
+	if _, err := writer.Write(message.Bytes()); err != nil {
+		return fmt.Errorf("mail: unable to send message: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("mail: unable to send message: %v", err)
+	}
+
+	return client.Quit()
+}
+
+// newMessageID returns a unique message identifier in the domain of the sender address.
+func newMessageID(sender string) string {
+	domain := "localhost"
+	if index := strings.LastIndex(sender, "@"); index != -1 {
+		domain = sender[index+1:]
+	}
+
+	return crypto.GenerateRandomStringHex(16) + "@" + domain
+}
+
+// dial connects to the server with implicit TLS on port 465, and upgrades the connection with STARTTLS otherwise.
+func (c *Client) dial() (*smtp.Client, error) {
+	address := net.JoinHostPort(c.host, strconv.Itoa(c.port))
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	tlsConfig := &tls.Config{ServerName: c.host}
+
+	var conn net.Conn
+	var err error
+	if c.port == implicitTLSPort {
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("mail: unable to connect to %s: %v", address, err)
+	}
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("mail: unable to connect to %s: %v", address, err)
+	}
+
+	if c.port != implicitTLSPort {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConfig); err != nil {
+				client.Close()
+				return nil, fmt.Errorf("mail: unable to start TLS: %v", err)
+			}
+		}
+	}
+
+	return client, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mail // import "miniflux.app/mail"
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRecipients(t *testing.T) {
+	recipients, err := ParseRecipients("alice@example.org, Bob <bob@example.org>\ncarol@example.org;")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"alice@example.org", "bob@example.org", "carol@example.org"}
+	if strings.Join(recipients, ",") != strings.Join(expected, ",") {
+		t.Errorf(`Unexpected recipients, got %v instead of %v`, recipients, expected)
+	}
+}
+
+func TestParseEmptyRecipients(t *testing.T) {
+	recipients, err := ParseRecipients(" , ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(recipients) != 0 {
+		t.Errorf(`No recipients should be returned, got %v`, recipients)
+	}
+}
+
+func TestParseInvalidRecipients(t *testing.T) {
+	if _, err := ParseRecipients("alice@example.org, not an address"); err == nil {
+		t.Error(`An invalid address should return an error`)
+	}
+
+	if _, err := ParseRecipients(strings.Repeat("alice@example.org,", MaxRecipients+1)); err == nil {
+		t.Error(`Too many recipients should return an error`)
+	}
+}
+
+func TestMessageBytes(t *testing.T) {
+	message := &Message{
+		ID:      "1234@example.org",
+		From:    "Miniflux <miniflux@example.org>",
+		To:      []string{"alice@example.org", "bob@example.org"},
+		Subject: "Café\r\nBcc: eve@example.org",
+		Body:    "Hello\nWorld",
+		Date:    time.Date(2020, time.June, 5, 10, 30, 0, 0, time.UTC),
+	}
+
+	result := string(message.Bytes())
+
+	for _, header := range []string{
+		"From: Miniflux <miniflux@example.org>\r\n",
+		"To: alice@example.org, bob@example.org\r\n",
+		"Date: Fri, 05 Jun 2020 10:30:00 +0000\r\n",
+		"Message-ID: <1234@example.org>\r\n",
+		"Content-Type: text/plain; charset=utf-8\r\n",
+	} {
+		if !strings.Contains(result, header) {
+			t.Errorf(`The header %q is missing in %q`, header, result)
+		}
+	}
+
+	if strings.Contains(result, "\r\nBcc:") {
+		t.Errorf(`The subject should be encoded to avoid header injection: %q`, result)
+	}
+
+	if !strings.HasSuffix(result, "\r\n\r\nHello\r\nWorld") {
+		t.Errorf(`Unexpected body in %q`, result)
+	}
+}
+
+func TestNewMessageID(t *testing.T) {
+	first := newMessageID("miniflux@example.org")
+	if !strings.HasSuffix(first, "@example.org") {
+		t.Errorf(`The message ID should use the domain of the sender, got %q`, first)
+	}
+
+	if first == newMessageID("miniflux@example.org") {
+		t.Error(`The message IDs should be unique`)
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build go1.15
+
+package metric // import "miniflux.app/metric"
+
+import "database/sql"
+
+func maxIdleTimeClosed(stats sql.DBStats) int64 {
+	return stats.MaxIdleTimeClosed
+}
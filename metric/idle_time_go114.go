@@ -0,0 +1,14 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build !go1.15
+
+package metric // import "miniflux.app/metric"
+
+import "database/sql"
+
+// Go 1.14 does not close idle connections after a delay.
+func maxIdleTimeClosed(stats sql.DBStats) int64 {
+	return 0
+}
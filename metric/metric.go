@@ -78,6 +78,40 @@ var (
 		},
 		[]string{"status"},
 	)
+
+	dbConnectionsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "miniflux",
+			Name:      "db_connections",
+			Help:      "Number of database connections by state",
+		},
+		[]string{"state"},
+	)
+
+	dbWaitCountGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "miniflux",
+			Name:      "db_wait_count",
+			Help:      "Total number of connections waited for",
+		},
+	)
+
+	dbWaitDurationGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "miniflux",
+			Name:      "db_wait_duration_seconds",
+			Help:      "Total time blocked waiting for a new connection",
+		},
+	)
+
+	dbClosedConnectionsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "miniflux",
+			Name:      "db_closed_connections",
+			Help:      "Total number of connections closed by reason",
+		},
+		[]string{"reason"},
+	)
 )
 
 // Collector represents a metric collector.
@@ -95,6 +129,10 @@ func NewCollector(store *storage.Storage, refreshInterval int) *Collector {
 	prometheus.MustRegister(feedsGauge)
 	prometheus.MustRegister(brokenFeedsGauge)
 	prometheus.MustRegister(entriesGauge)
+	prometheus.MustRegister(dbConnectionsGauge)
+	prometheus.MustRegister(dbWaitCountGauge)
+	prometheus.MustRegister(dbWaitDurationGauge)
+	prometheus.MustRegister(dbClosedConnectionsGauge)
 
 	return &Collector{store, refreshInterval}
 }
@@ -116,5 +154,16 @@ func (c *Collector) GatherStorageMetrics() {
 		for status, count := range entriesCount {
 			entriesGauge.WithLabelValues(status).Set(float64(count))
 		}
+
+		dbStats := c.store.DatabaseStats()
+		dbConnectionsGauge.WithLabelValues("max_open").Set(float64(dbStats.MaxOpenConnections))
+		dbConnectionsGauge.WithLabelValues("open").Set(float64(dbStats.OpenConnections))
+		dbConnectionsGauge.WithLabelValues("in_use").Set(float64(dbStats.InUse))
+		dbConnectionsGauge.WithLabelValues("idle").Set(float64(dbStats.Idle))
+		dbWaitCountGauge.Set(float64(dbStats.WaitCount))
+		dbWaitDurationGauge.Set(dbStats.WaitDuration.Seconds())
+		dbClosedConnectionsGauge.WithLabelValues("max_idle").Set(float64(dbStats.MaxIdleClosed))
+		dbClosedConnectionsGauge.WithLabelValues("max_idle_time").Set(float64(maxIdleTimeClosed(dbStats)))
+		dbClosedConnectionsGauge.WithLabelValues("max_lifetime").Set(float64(dbStats.MaxLifetimeClosed))
 	}
 }
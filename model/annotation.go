@@ -0,0 +1,143 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WebAnnotationContext is the JSON-LD context of the W3C Web Annotation Data Model.
+const WebAnnotationContext = "http://www.w3.org/ns/anno.jsonld"
+
+// Annotation represents a highlight and/or a note written by the user on an entry.
+type Annotation struct {
+	ID           int64     `json:"id"`
+	UserID       int64     `json:"user_id"`
+	EntryID      int64     `json:"entry_id"`
+	Quote        string    `json:"quote"`
+	Note         string    `json:"note"`
+	HypothesisID string    `json:"hypothesis_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	EntryURL     string    `json:"entry_url"`
+	EntryTitle   string    `json:"entry_title"`
+}
+
+func (a *Annotation) String() string {
+	return fmt.Sprintf("ID=%d, EntryID=%d", a.ID, a.EntryID)
+}
+
+// Validate returns an error if the annotation has neither a highlighted text nor a note.
+func (a *Annotation) Validate() error {
+	a.Quote = strings.TrimSpace(a.Quote)
+	a.Note = strings.TrimSpace(a.Note)
+
+	if a.Quote == "" && a.Note == "" {
+		return errors.New("The quote or the note is mandatory")
+	}
+
+	return nil
+}
+
+// WebAnnotation converts the annotation to the W3C Web Annotation Data Model.
+// The API cannot return a single annotation, so the identifier is a URN instead of a URL.
+func (a *Annotation) WebAnnotation() *WebAnnotation {
+	annotation := &WebAnnotation{
+		Context:    WebAnnotationContext,
+		ID:         fmt.Sprintf("urn:miniflux:annotation:%d", a.ID),
+		Type:       "Annotation",
+		Motivation: "highlighting",
+		Created:    a.CreatedAt.UTC().Format(time.RFC3339),
+		Target:     &WebAnnotationTarget{Source: a.EntryURL},
+	}
+
+	if a.Note != "" {
+		annotation.Motivation = "commenting"
+		annotation.Body = &WebAnnotationBody{
+			Type:   "TextualBody",
+			Value:  a.Note,
+			Format: "text/plain",
+		}
+	}
+
+	if a.Quote != "" {
+		annotation.Target.Selector = &WebAnnotationSelector{Type: "TextQuoteSelector", Exact: a.Quote}
+	}
+
+	return annotation
+}
+
+// Annotations represents a list of annotations.
+type Annotations []*Annotation
+
+// WebAnnotationCollection converts the annotations to a W3C Web Annotation collection.
+func (a Annotations) WebAnnotationCollection() *WebAnnotationCollection {
+	items := make([]*WebAnnotation, 0, len(a))
+	for _, annotation := range a {
+		item := annotation.WebAnnotation()
+		item.Context = ""
+		items = append(items, item)
+	}
+
+	return &WebAnnotationCollection{
+		Context: WebAnnotationContext,
+		Type:    "AnnotationCollection",
+		Label:   "Miniflux annotations",
+		Total:   len(items),
+		First: &WebAnnotationPage{
+			Type:       "AnnotationPage",
+			StartIndex: 0,
+			Items:      items,
+		},
+	}
+}
+
+// WebAnnotation represents an annotation in the W3C Web Annotation Data Model.
+type WebAnnotation struct {
+	Context    string               `json:"@context,omitempty"`
+	ID         string               `json:"id"`
+	Type       string               `json:"type"`
+	Motivation string               `json:"motivation"`
+	Created    string               `json:"created"`
+	Body       *WebAnnotationBody   `json:"body,omitempty"`
+	Target     *WebAnnotationTarget `json:"target"`
+}
+
+// WebAnnotationBody represents the note attached to a Web Annotation.
+type WebAnnotationBody struct {
+	Type   string `json:"type"`
+	Value  string `json:"value"`
+	Format string `json:"format"`
+}
+
+// WebAnnotationTarget represents the annotated article and the highlighted text.
+type WebAnnotationTarget struct {
+	Source   string                 `json:"source"`
+	Selector *WebAnnotationSelector `json:"selector,omitempty"`
+}
+
+// WebAnnotationSelector identifies the highlighted text within the article.
+type WebAnnotationSelector struct {
+	Type  string `json:"type"`
+	Exact string `json:"exact"`
+}
+
+// WebAnnotationCollection represents a W3C Web Annotation collection with a single page.
+type WebAnnotationCollection struct {
+	Context string             `json:"@context"`
+	Type    string             `json:"type"`
+	Label   string             `json:"label"`
+	Total   int                `json:"total"`
+	First   *WebAnnotationPage `json:"first"`
+}
+
+// WebAnnotationPage represents a page of a Web Annotation collection.
+type WebAnnotationPage struct {
+	Type       string           `json:"type"`
+	StartIndex int              `json:"startIndex"`
+	Items      []*WebAnnotation `json:"items"`
+}
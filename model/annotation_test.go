@@ -0,0 +1,94 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestValidateAnnotation(t *testing.T) {
+	annotation := &Annotation{Quote: "  ", Note: "\n"}
+	if err := annotation.Validate(); err == nil {
+		t.Error(`An empty annotation should be invalid`)
+	}
+
+	annotation = &Annotation{Quote: " Highlighted text "}
+	if err := annotation.Validate(); err != nil {
+		t.Errorf(`A highlight without note should be valid: %v`, err)
+	}
+
+	if annotation.Quote != "Highlighted text" {
+		t.Errorf(`The quote should be trimmed, got %q`, annotation.Quote)
+	}
+}
+
+func TestWebAnnotation(t *testing.T) {
+	annotation := &Annotation{
+		ID:        42,
+		Quote:     "Highlighted text",
+		Note:      "My note",
+		CreatedAt: time.Date(2020, time.March, 1, 10, 0, 0, 0, time.UTC),
+		EntryURL:  "https://example.org/article",
+	}
+
+	result := annotation.WebAnnotation()
+	if result.ID != "urn:miniflux:annotation:42" {
+		t.Errorf(`Unexpected identifier: %q`, result.ID)
+	}
+
+	if result.Motivation != "commenting" || result.Body == nil || result.Body.Value != "My note" {
+		t.Errorf(`The note should be the body of the annotation: %+v`, result)
+	}
+
+	if result.Target.Source != "https://example.org/article" || result.Target.Selector.Exact != "Highlighted text" {
+		t.Errorf(`Unexpected target: %+v`, result.Target)
+	}
+
+	if result.Created != "2020-03-01T10:00:00Z" {
+		t.Errorf(`Unexpected creation date: %q`, result.Created)
+	}
+}
+
+func TestWebAnnotationWithoutNote(t *testing.T) {
+	annotation := &Annotation{ID: 1, Quote: "Highlighted text", EntryURL: "https://example.org/"}
+
+	data, err := json.Marshal(annotation.WebAnnotation())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result map[string]interface{}
+	json.Unmarshal(data, &result)
+
+	if _, found := result["body"]; found {
+		t.Error(`A highlight should not have a body`)
+	}
+
+	if result["motivation"] != "highlighting" || result["@context"] != WebAnnotationContext {
+		t.Errorf(`Unexpected annotation: %s`, data)
+	}
+}
+
+func TestWebAnnotationCollection(t *testing.T) {
+	annotations := Annotations{
+		{ID: 1, Quote: "First", EntryURL: "https://example.org/1"},
+		{ID: 2, Note: "Second", EntryURL: "https://example.org/2"},
+	}
+
+	collection := annotations.WebAnnotationCollection()
+	if collection.Total != 2 || len(collection.First.Items) != 2 {
+		t.Fatalf(`Unexpected collection: %+v`, collection)
+	}
+
+	if collection.First.Items[0].Context != "" {
+		t.Error(`The context should only be declared on the collection`)
+	}
+
+	if collection.First.Items[1].Target.Selector != nil {
+		t.Error(`A note without quote should target the whole article`)
+	}
+}
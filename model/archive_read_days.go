@@ -0,0 +1,31 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "miniflux.app/errors"
+
+// ValidateArchiveReadDays makes sure the number of days the read entries are kept is allowed by the administrator.
+// Zero means the default of the instance is used.
+func ValidateArchiveReadDays(days, maxDays int) error {
+	if days == 0 {
+		return nil
+	}
+
+	if days < 0 || days > maxDays {
+		return errors.NewLocalizedError("error.invalid_archive_read_days")
+	}
+
+	return nil
+}
+
+// ClampArchiveReadDays limits the number of days to the maximum allowed by the administrator,
+// the value saved by the user could be above a maximum lowered afterward.
+func ClampArchiveReadDays(days, maxDays int) int {
+	if days > maxDays {
+		return maxDays
+	}
+
+	return days
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateArchiveReadDays(t *testing.T) {
+	scenarios := []struct {
+		days    int
+		maxDays int
+		valid   bool
+	}{
+		{0, 365, true},
+		{0, 0, true},
+		{1, 365, true},
+		{365, 365, true},
+		{366, 365, false},
+		{-1, 365, false},
+		{30, 0, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := ValidateArchiveReadDays(scenario.days, scenario.maxDays)
+		if scenario.valid && err != nil {
+			t.Errorf(`%d days should be valid with a maximum of %d: %v`, scenario.days, scenario.maxDays, err)
+		}
+
+		if !scenario.valid && err == nil {
+			t.Errorf(`%d days should be invalid with a maximum of %d`, scenario.days, scenario.maxDays)
+		}
+	}
+}
+
+func TestClampArchiveReadDays(t *testing.T) {
+	scenarios := []struct {
+		days     int
+		maxDays  int
+		expected int
+	}{
+		{0, 365, 0},
+		{30, 365, 30},
+		{400, 365, 365},
+		{30, 0, 0},
+	}
+
+	for _, scenario := range scenarios {
+		if result := ClampArchiveReadDays(scenario.days, scenario.maxDays); result != scenario.expected {
+			t.Errorf(`Unexpected number of days for %d with a maximum of %d, got %d instead of %d`, scenario.days, scenario.maxDays, result, scenario.expected)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"regexp"
+	"strings"
+)
+
+var authorSeparatorRegex = regexp.MustCompile(`\s*(?:,|;|&|\band\b)\s*`)
+
+// MatchAuthor returns true if one of the entry authors belongs to the given lists.
+// Each list contains one author per line, names are compared case insensitively.
+func MatchAuthor(author string, authorLists ...string) bool {
+	if author == "" {
+		return false
+	}
+
+	authors := make(map[string]bool)
+	for _, list := range authorLists {
+		for _, line := range strings.Split(list, "\n") {
+			if name := normalizeAuthor(line); name != "" {
+				authors[name] = true
+			}
+		}
+	}
+
+	if len(authors) == 0 {
+		return false
+	}
+
+	if authors[normalizeAuthor(author)] {
+		return true
+	}
+
+	for _, name := range authorSeparatorRegex.Split(author, -1) {
+		if authors[normalizeAuthor(name)] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeAuthor removes the case, the extra spaces and the "by" prefix of an author name.
+func normalizeAuthor(name string) string {
+	name = strings.ToLower(strings.Join(strings.Fields(name), " "))
+	return strings.TrimPrefix(name, "by ")
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestMatchAuthor(t *testing.T) {
+	feedBlockedAuthors := "John Doe\n  jane   SMITH \n"
+	userBlockedAuthors := "Someone Else"
+
+	scenarios := map[string]bool{
+		"John Doe":                true,
+		"by john doe":             true,
+		"Jane Smith":              true,
+		"Alice, Jane Smith":       true,
+		"Alice and Someone Else":  true,
+		"Bob & Alice":             false,
+		"Johnny Doe":              false,
+		"":                        false,
+		"Alexander Fleming, John": false,
+	}
+
+	for author, expected := range scenarios {
+		if result := MatchAuthor(author, feedBlockedAuthors, userBlockedAuthors); result != expected {
+			t.Errorf(`Unexpected result for %q: got %v instead of %v`, author, result, expected)
+		}
+	}
+
+	if MatchAuthor("John Doe", "", "") {
+		t.Error(`No author should be blocked with empty lists`)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/timezone"
+)
+
+// BlockedFeed represents a feed URL or a domain that nobody can subscribe to on this instance.
+type BlockedFeed struct {
+	ID        int64     `json:"id"`
+	Pattern   string    `json:"pattern"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IsDomain returns true if the whole domain and its subdomains are blocked.
+func (b *BlockedFeed) IsDomain() bool {
+	return !strings.Contains(b.Pattern, "://")
+}
+
+// Match returns true if the feed URL is blocked.
+func (b *BlockedFeed) Match(feedURL string) bool {
+	if !b.IsDomain() {
+		return strings.TrimSuffix(feedURL, "/") == strings.TrimSuffix(b.Pattern, "/")
+	}
+
+	u, err := url.Parse(feedURL)
+	if err != nil {
+		return false
+	}
+
+	hostname := strings.ToLower(u.Hostname())
+	return hostname == b.Pattern || strings.HasSuffix(hostname, "."+b.Pattern)
+}
+
+// NormalizeBlockedFeedPattern returns the pattern as stored, domains are compared in lower case.
+func NormalizeBlockedFeedPattern(pattern string) string {
+	pattern = strings.TrimSpace(pattern)
+	if !strings.Contains(pattern, "://") {
+		pattern = strings.Trim(strings.ToLower(pattern), ".")
+	}
+
+	return pattern
+}
+
+// ValidateBlockedFeed validates a feed URL or a domain name.
+func ValidateBlockedFeed(pattern string) error {
+	if pattern == "" {
+		return fmt.Errorf(`The URL or the domain is mandatory`)
+	}
+
+	if strings.Contains(pattern, "://") {
+		if u, err := url.Parse(pattern); err != nil || u.Host == "" {
+			return fmt.Errorf(`Invalid URL: %q`, pattern)
+		}
+	} else if strings.ContainsAny(pattern, "/:@ ") {
+		return fmt.Errorf(`Invalid domain: %q`, pattern)
+	}
+
+	return nil
+}
+
+// BlockedFeeds represents a list of blocked feeds.
+type BlockedFeeds []*BlockedFeed
+
+// Match returns the first entry of the list blocking the feed URL.
+func (b BlockedFeeds) Match(feedURL string) *BlockedFeed {
+	for _, blockedFeed := range b {
+		if blockedFeed.Match(feedURL) {
+			return blockedFeed
+		}
+	}
+
+	return nil
+}
+
+// UseTimezone converts the creation date of all blocked feeds to the given timezone.
+func (b BlockedFeeds) UseTimezone(tz string) {
+	for _, blockedFeed := range b {
+		blockedFeed.CreatedAt = timezone.Convert(tz, blockedFeed.CreatedAt)
+	}
+}
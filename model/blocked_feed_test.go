@@ -0,0 +1,69 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestBlockedFeedMatchDomain(t *testing.T) {
+	blockedFeed := &BlockedFeed{Pattern: NormalizeBlockedFeedPattern(" Example.org. ")}
+
+	scenarios := map[string]bool{
+		"https://example.org/feed.xml":        true,
+		"http://EXAMPLE.org:8080/rss":         true,
+		"https://news.example.org/atom.xml":   true,
+		"https://notexample.org/feed.xml":     false,
+		"https://example.org.attacker.net/":   false,
+		"https://other.net/?u=example.org":    false,
+		"https://user@example.org/feed":       true,
+		"not a valid url with example.org %%": false,
+	}
+
+	for feedURL, expected := range scenarios {
+		if result := blockedFeed.Match(feedURL); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, feedURL, result, expected)
+		}
+	}
+}
+
+func TestBlockedFeedMatchURL(t *testing.T) {
+	blockedFeed := &BlockedFeed{Pattern: NormalizeBlockedFeedPattern("https://example.org/feed/")}
+
+	if !blockedFeed.Match("https://example.org/feed") {
+		t.Error(`The feed URL should be blocked`)
+	}
+
+	if blockedFeed.Match("https://example.org/other-feed") {
+		t.Error(`Only the given feed URL should be blocked`)
+	}
+}
+
+func TestBlockedFeedsMatch(t *testing.T) {
+	blockedFeeds := BlockedFeeds{
+		{ID: 1, Pattern: "https://example.org/feed"},
+		{ID: 2, Pattern: "example.com"},
+	}
+
+	if blockedFeed := blockedFeeds.Match("https://www.example.com/feed"); blockedFeed == nil || blockedFeed.ID != 2 {
+		t.Errorf(`Unexpected match: %v`, blockedFeed)
+	}
+
+	if blockedFeed := blockedFeeds.Match("https://example.net/feed"); blockedFeed != nil {
+		t.Errorf(`Unexpected match: %v`, blockedFeed)
+	}
+}
+
+func TestValidateBlockedFeed(t *testing.T) {
+	for _, pattern := range []string{"example.org", "https://example.org/feed.xml"} {
+		if err := ValidateBlockedFeed(pattern); err != nil {
+			t.Errorf(`%q should be valid: %v`, pattern, err)
+		}
+	}
+
+	for _, pattern := range []string{"", "example.org/feed", "https://", "user@example.org"} {
+		if err := ValidateBlockedFeed(pattern); err == nil {
+			t.Errorf(`%q should be invalid`, pattern)
+		}
+	}
+}
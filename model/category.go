@@ -14,6 +14,7 @@ type Category struct {
 	ID        int64  `json:"id,omitempty"`
 	Title     string `json:"title,omitempty"`
 	UserID    int64  `json:"user_id,omitempty"`
+	Hidden    bool   `json:"hidden"`
 	FeedCount int    `json:"nb_feeds,omitempty"`
 }
 
@@ -53,3 +54,25 @@ func (c Category) ValidateCategoryModification() error {
 
 // Categories represents a list of categories.
 type Categories []*Category
+
+// Visible returns the categories shown in the navigation.
+func (c Categories) Visible() Categories {
+	visible := make(Categories, 0, len(c))
+	for _, category := range c {
+		if !category.Hidden {
+			visible = append(visible, category)
+		}
+	}
+	return visible
+}
+
+// Hidden returns the categories hidden from the navigation.
+func (c Categories) Hidden() Categories {
+	hidden := make(Categories, 0)
+	for _, category := range c {
+		if category.Hidden {
+			hidden = append(hidden, category)
+		}
+	}
+	return hidden
+}
@@ -0,0 +1,38 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// CategoryMember represents a user who follows the feeds of a category shared by its owner.
+// Each member gets a copy of the feeds in its own category, the entries are fetched only once.
+// The member must accept the invitation of the owner before any feed is copied.
+type CategoryMember struct {
+	CategoryID       int64      `json:"category_id"`
+	UserID           int64      `json:"user_id"`
+	Username         string     `json:"username"`
+	MemberCategoryID int64      `json:"member_category_id"`
+	CreatedAt        time.Time  `json:"created_at"`
+	AcceptedAt       *time.Time `json:"accepted_at"`
+}
+
+// IsPending returns true if the member has not accepted the invitation yet.
+func (c *CategoryMember) IsPending() bool {
+	return c.AcceptedAt == nil
+}
+
+// CategoryMembers represents a list of category members.
+type CategoryMembers []*CategoryMember
+
+// CategoryInvitation represents an invitation to follow a category shared by another user.
+type CategoryInvitation struct {
+	CategoryID    int64     `json:"category_id"`
+	Title         string    `json:"title"`
+	OwnerUsername string    `json:"owner_username"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// CategoryInvitations represents a list of category invitations.
+type CategoryInvitations []*CategoryInvitation
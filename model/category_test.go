@@ -59,3 +59,21 @@ func TestValidateCategoryModification(t *testing.T) {
 		t.Error(`All required fields are filled, it should not generate any error`)
 	}
 }
+
+func TestCategoriesVisibility(t *testing.T) {
+	categories := Categories{
+		{ID: 1, Title: "News"},
+		{ID: 2, Title: "Olympics", Hidden: true},
+		{ID: 3, Title: "Tech"},
+	}
+
+	visible := categories.Visible()
+	if len(visible) != 2 || visible[0].ID != 1 || visible[1].ID != 3 {
+		t.Errorf(`Unexpected visible categories: %v`, visible)
+	}
+
+	hidden := categories.Hidden()
+	if len(hidden) != 1 || hidden[0].ID != 2 {
+		t.Errorf(`Unexpected hidden categories: %v`, hidden)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// ClusterMinTitleWords is the minimum number of words a title needs to be compared with other titles.
+const ClusterMinTitleWords = 4
+
+// ClusterEntries groups entries linking to the same URL or having the same title.
+// Clusters are returned in the order of their first entry and keep the original order of entries.
+func ClusterEntries(entries Entries) []Entries {
+	parents := make([]int, len(entries))
+	for i := range parents {
+		parents[i] = i
+	}
+
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+
+	keys := make(map[string]int)
+	for i, entry := range entries {
+		for _, key := range clusterKeys(entry) {
+			if j, found := keys[key]; found {
+				parents[find(i)] = find(j)
+			} else {
+				keys[key] = i
+			}
+		}
+	}
+
+	positions := make(map[int]int)
+	var clusters []Entries
+	for i, entry := range entries {
+		root := find(i)
+		position, found := positions[root]
+		if !found {
+			position = len(clusters)
+			positions[root] = position
+			clusters = append(clusters, nil)
+		}
+		clusters[position] = append(clusters[position], entry)
+	}
+
+	return clusters
+}
+
+func clusterKeys(entry *Entry) []string {
+	var keys []string
+	if link := normalizeClusterURL(entry.URL); link != "" {
+		keys = append(keys, "url:"+link)
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(entry.Title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(words) >= ClusterMinTitleWords {
+		keys = append(keys, "title:"+strings.Join(words, " "))
+	}
+
+	return keys
+}
+
+func normalizeClusterURL(link string) string {
+	u, err := url.Parse(link)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+
+	values := u.Query()
+	for name := range values {
+		if strings.HasPrefix(name, "utm_") {
+			values.Del(name)
+		}
+	}
+
+	normalized := strings.TrimPrefix(strings.ToLower(u.Host), "www.") + strings.TrimSuffix(u.Path, "/")
+	if query := values.Encode(); query != "" {
+		normalized += "?" + query
+	}
+
+	return normalized
+}
@@ -0,0 +1,36 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestClusterEntries(t *testing.T) {
+	entries := Entries{
+		&Entry{ID: 1, Title: "First", URL: "https://example.org/a"},
+		&Entry{ID: 2, Title: "Go 1.16 is released", URL: "https://blog.golang.org/go1.16"},
+		&Entry{ID: 3, Title: "Second", URL: "https://www.example.org/a/?utm_medium=feed"},
+		&Entry{ID: 4, Title: "Go 1.16 is Released!", URL: "https://example.com/go"},
+		&Entry{ID: 5, Title: "Short", URL: "https://example.org/b?id=1"},
+		&Entry{ID: 6, Title: "Short", URL: "https://example.org/b?id=2"},
+	}
+
+	clusters := ClusterEntries(entries)
+	expected := [][]int64{{1, 3}, {2, 4}, {5}, {6}}
+	if len(clusters) != len(expected) {
+		t.Fatalf(`Unexpected number of clusters: %d`, len(clusters))
+	}
+
+	for i, cluster := range clusters {
+		if len(cluster) != len(expected[i]) {
+			t.Fatalf(`Unexpected size for cluster #%d: %d`, i, len(cluster))
+		}
+
+		for j, entry := range cluster {
+			if entry.ID != expected[i][j] {
+				t.Errorf(`Unexpected entry in cluster #%d: got #%d instead of #%d`, i, entry.ID, expected[i][j])
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseInvalidCronExpressions(t *testing.T) {
+	expressions := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"@yearly",
+		"a b c d e",
+	}
+
+	for _, expression := range expressions {
+		if _, err := ParseCronExpression(expression); err == nil {
+			t.Errorf(`The expression %q should be invalid`, expression)
+		}
+	}
+}
+
+func TestValidateCronExpression(t *testing.T) {
+	if err := ValidateCronExpression(""); err != nil {
+		t.Errorf(`An empty expression should be valid: %v`, err)
+	}
+
+	if err := ValidateCronExpression("invalid"); err == nil {
+		t.Error(`An invalid expression should return an error`)
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	// 2020-06-05 is a Friday.
+	now := time.Date(2020, time.June, 5, 10, 30, 45, 0, time.UTC)
+
+	scenarios := map[string]time.Time{
+		"* * * * *":         time.Date(2020, time.June, 5, 10, 31, 0, 0, time.UTC),
+		"*/15 * * * *":      time.Date(2020, time.June, 5, 10, 45, 0, 0, time.UTC),
+		"@hourly":           time.Date(2020, time.June, 5, 11, 0, 0, 0, time.UTC),
+		"@daily":            time.Date(2020, time.June, 6, 0, 0, 0, 0, time.UTC),
+		"30 7 * * mon-fri":  time.Date(2020, time.June, 8, 7, 30, 0, 0, time.UTC),
+		"0 9,17 * * *":      time.Date(2020, time.June, 5, 17, 0, 0, 0, time.UTC),
+		"0 0 1 jan *":       time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"0 12 * * 7":        time.Date(2020, time.June, 7, 12, 0, 0, 0, time.UTC),
+		"0 12 15 * sat":     time.Date(2020, time.June, 6, 12, 0, 0, 0, time.UTC),
+		"0 0 29 feb *":      time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		"10-20/5 10 5 6 *":  time.Date(2021, time.June, 5, 10, 10, 0, 0, time.UTC),
+		"45 10 * * fri":     time.Date(2020, time.June, 5, 10, 45, 0, 0, time.UTC),
+		"  0 8 * * MON-FRI": time.Date(2020, time.June, 8, 8, 0, 0, 0, time.UTC),
+	}
+
+	for expression, expected := range scenarios {
+		schedule, err := ParseCronExpression(expression)
+		if err != nil {
+			t.Fatalf(`Unable to parse %q: %v`, expression, err)
+		}
+
+		if result := schedule.Next(now); !result.Equal(expected) {
+			t.Errorf(`Unexpected next time for %q, got %v instead of %v`, expression, result, expected)
+		}
+	}
+}
+
+func TestCronScheduleNextWithoutMatch(t *testing.T) {
+	schedule, err := ParseCronExpression("0 0 30 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !schedule.Next(time.Now()).IsZero() {
+		t.Error(`February 30th should never match`)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// VisitTimeout is the break after which a new activity of the user is considered as a new visit.
+const VisitTimeout = time.Hour
+
+// DigestEntriesLimit is the number of entries highlighted in the digest.
+const DigestEntriesLimit = 10
+
+// Digest summarizes the unread entries received since a given date.
+type Digest struct {
+	Since      time.Time         `json:"since"`
+	Total      int               `json:"total"`
+	Categories []*DigestCategory `json:"categories"`
+	Entries    Entries           `json:"entries"`
+}
+
+// DigestCategory represents the number of new entries in a category.
+type DigestCategory struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+	Count int    `json:"count"`
+}
+
+// DigestSince returns the beginning of the period summarized for the user,
+// the previous visit or the last 24 hours for new users.
+func (u *User) DigestSince(now time.Time) time.Time {
+	if u.PreviousVisitAt != nil && u.PreviousVisitAt.Before(now) {
+		return *u.PreviousVisitAt
+	}
+
+	return now.Add(-24 * time.Hour)
+}
+
+// IsReturningVisit returns true if the last activity of the user is older than the visit timeout.
+func (u *User) IsReturningVisit(now time.Time) bool {
+	return u.LastSeenAt != nil && now.Sub(*u.LastSeenAt) >= VisitTimeout
+}
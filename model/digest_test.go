@@ -0,0 +1,45 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDigestSince(t *testing.T) {
+	now := time.Now()
+	user := NewUser()
+
+	if since := user.DigestSince(now); !since.Equal(now.Add(-24 * time.Hour)) {
+		t.Errorf(`New users should get the last 24 hours, got %v`, since)
+	}
+
+	previousVisit := now.Add(-3 * time.Hour)
+	user.PreviousVisitAt = &previousVisit
+	if since := user.DigestSince(now); !since.Equal(previousVisit) {
+		t.Errorf(`The previous visit should be used, got %v`, since)
+	}
+}
+
+func TestIsReturningVisit(t *testing.T) {
+	now := time.Now()
+	user := NewUser()
+
+	if user.IsReturningVisit(now) {
+		t.Error(`A user who was never seen is not returning`)
+	}
+
+	lastSeen := now.Add(-10 * time.Minute)
+	user.LastSeenAt = &lastSeen
+	if user.IsReturningVisit(now) {
+		t.Error(`A recent activity belongs to the same visit`)
+	}
+
+	lastSeen = now.Add(-2 * VisitTimeout)
+	if !user.IsReturningVisit(now) {
+		t.Error(`An old activity should start a new visit`)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"miniflux.app/url"
+)
+
+// DomainRule represents instance-wide rewrite and scraper rules for a website.
+type DomainRule struct {
+	ID           int64     `json:"id"`
+	Domain       string    `json:"domain"`
+	RewriteRules string    `json:"rewrite_rules"`
+	ScraperRules string    `json:"scraper_rules"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (d *DomainRule) String() string {
+	return fmt.Sprintf("ID=%d, Domain=%s", d.ID, d.Domain)
+}
+
+// ValidateDomainRule validates a domain rule before saving it.
+func (d DomainRule) ValidateDomainRule() error {
+	if d.Domain == "" {
+		return errors.New("The domain is mandatory")
+	}
+
+	if strings.ContainsAny(d.Domain, "/ ") {
+		return errors.New("The domain must not contain any path or space")
+	}
+
+	if d.RewriteRules == "" && d.ScraperRules == "" {
+		return errors.New("At least one rewrite or scraper rule is mandatory")
+	}
+
+	return nil
+}
+
+// DomainRules represents a list of domain rules.
+type DomainRules []*DomainRule
+
+// Match returns the most specific rule that applies to the given URL, the rules apply to the domain and its subdomains.
+func (d DomainRules) Match(websiteURL string) *DomainRule {
+	var match *DomainRule
+	urlDomain := strings.ToLower(url.Domain(websiteURL))
+
+	for _, rule := range d {
+		domain := strings.ToLower(rule.Domain)
+		if urlDomain == domain || strings.HasSuffix(urlDomain, "."+domain) {
+			if match == nil || len(rule.Domain) > len(match.Domain) {
+				match = rule
+			}
+		}
+	}
+
+	return match
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateDomainRule(t *testing.T) {
+	scenarios := map[*DomainRule]bool{
+		{Domain: "example.org", RewriteRules: "nl2br"}:      true,
+		{Domain: "example.org", ScraperRules: "article"}:    true,
+		{Domain: "", RewriteRules: "nl2br"}:                 false,
+		{Domain: "example.org"}:                             false,
+		{Domain: "example.org/path", RewriteRules: "nl2br"}: false,
+	}
+
+	for rule, valid := range scenarios {
+		err := rule.ValidateDomainRule()
+		if valid && err != nil {
+			t.Errorf(`Rule %q should be valid: %v`, rule, err)
+		}
+
+		if !valid && err == nil {
+			t.Errorf(`Rule %q should not be valid`, rule)
+		}
+	}
+}
+
+func TestDomainRulesMatch(t *testing.T) {
+	rules := DomainRules{
+		&DomainRule{ID: 1, Domain: "example.org"},
+		&DomainRule{ID: 2, Domain: "blog.example.org"},
+	}
+
+	if rule := rules.Match("https://www.example.org/article"); rule == nil || rule.ID != 1 {
+		t.Errorf(`Unexpected rule matched: %v`, rule)
+	}
+
+	if rule := rules.Match("https://blog.example.org/article"); rule == nil || rule.ID != 2 {
+		t.Errorf(`The most specific rule should be matched, got %v`, rule)
+	}
+
+	if rule := rules.Match("https://example.com/"); rule != nil {
+		t.Errorf(`No rule should match, got %v`, rule)
+	}
+}
+
+func TestDomainRulesMatchLookalikeDomains(t *testing.T) {
+	rules := DomainRules{&DomainRule{ID: 1, Domain: "example.org"}}
+
+	if rule := rules.Match("https://Example.org/article"); rule == nil {
+		t.Error(`The rule should match the domain itself`)
+	}
+
+	for _, input := range []string{"https://notexample.org/", "https://example.org.evil.com/"} {
+		if rule := rules.Match(input); rule != nil {
+			t.Errorf(`No rule should match %q, got %v`, input, rule)
+		}
+	}
+}
@@ -4,15 +4,258 @@
 
 package model // import "miniflux.app/model"
 
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Media types of common attachment file extensions, used when feeds declare a wrong type.
+var enclosureMimeTypes = map[string]string{
+	".aac":  "audio/aac",
+	".flac": "audio/flac",
+	".m4a":  "audio/mp4",
+	".m4b":  "audio/mp4",
+	".mp3":  "audio/mpeg",
+	".oga":  "audio/ogg",
+	".ogg":  "audio/ogg",
+	".opus": "audio/opus",
+	".wav":  "audio/wav",
+	".m4v":  "video/x-m4v",
+	".mkv":  "video/x-matroska",
+	".mov":  "video/quicktime",
+	".mp4":  "video/mp4",
+	".webm": "video/webm",
+	".gif":  "image/gif",
+	".jpeg": "image/jpeg",
+	".jpg":  "image/jpeg",
+	".png":  "image/png",
+	".webp": "image/webp",
+	".epub": "application/epub+zip",
+	".pdf":  "application/pdf",
+}
+
+// Attachment types used to filter entries.
+const (
+	EnclosureTypeAny   = "any"
+	EnclosureTypeAudio = "audio"
+	EnclosureTypeVideo = "video"
+	EnclosureTypeImage = "image"
+)
+
+// ValidateEnclosureType makes sure the attachment type used to filter entries is valid.
+func ValidateEnclosureType(enclosureType string) error {
+	switch enclosureType {
+	case EnclosureTypeAny, EnclosureTypeAudio, EnclosureTypeVideo, EnclosureTypeImage:
+		return nil
+	}
+
+	return fmt.Errorf(`Invalid enclosure type, valid values are: "%s", "%s", "%s" and "%s"`, EnclosureTypeAny, EnclosureTypeAudio, EnclosureTypeVideo, EnclosureTypeImage)
+}
+
 // Enclosure represents an attachment.
 type Enclosure struct {
-	ID       int64  `json:"id"`
-	UserID   int64  `json:"user_id"`
-	EntryID  int64  `json:"entry_id"`
-	URL      string `json:"url"`
-	MimeType string `json:"mime_type"`
-	Size     int64  `json:"size"`
+	ID        int64  `json:"id"`
+	UserID    int64  `json:"user_id"`
+	EntryID   int64  `json:"entry_id"`
+	URL       string `json:"url"`
+	MimeType  string `json:"mime_type"`
+	Size      int64  `json:"size"`
+	Duration  int    `json:"duration"`
+	PosterURL string `json:"poster_url"`
+}
+
+// NormalizeMimeType replaces missing, generic or inconsistent media types by the one matching the file extension.
+func (e *Enclosure) NormalizeMimeType() {
+	declared := strings.ToLower(strings.TrimSpace(strings.SplitN(e.MimeType, ";", 2)[0]))
+	sniffed := mimeTypeFromURL(e.URL)
+
+	switch {
+	case sniffed == "":
+		if declared == "" {
+			declared = "application/octet-stream"
+		}
+		e.MimeType = declared
+	case isGenericMimeType(declared) || !isCompatibleMimeType(declared, sniffed):
+		e.MimeType = sniffed
+	default:
+		e.MimeType = declared
+	}
 }
 
 // EnclosureList represents a list of attachments.
 type EnclosureList []*Enclosure
+
+// NormalizeMimeTypes fixes the media type of each attachment.
+func (el EnclosureList) NormalizeMimeTypes() {
+	for _, enclosure := range el {
+		enclosure.NormalizeMimeType()
+	}
+}
+
+// Deduplicate merges the attachments pointing to the same file and sorts them by media type,
+// audio files come first, then videos, images and other documents.
+func (el EnclosureList) Deduplicate() EnclosureList {
+	enclosures := make(EnclosureList, 0, len(el))
+	seen := make(map[string]*Enclosure, len(el))
+
+	for _, enclosure := range el {
+		enclosure.URL = strings.TrimSpace(enclosure.URL)
+		if enclosure.URL == "" {
+			continue
+		}
+
+		if existing, found := seen[enclosure.URL]; found {
+			existing.merge(enclosure)
+			continue
+		}
+
+		seen[enclosure.URL] = enclosure
+		enclosures = append(enclosures, enclosure)
+	}
+
+	sort.SliceStable(enclosures, func(i, j int) bool {
+		return enclosures[i].rank() < enclosures[j].rank()
+	})
+
+	return enclosures
+}
+
+// AssignPosters uses the first image of the list as poster frame for the videos without one.
+func (el EnclosureList) AssignPosters() {
+	poster := ""
+	for _, enclosure := range el {
+		if strings.HasPrefix(enclosure.MimeType, "image/") {
+			poster = enclosure.URL
+			break
+		}
+	}
+
+	if poster == "" {
+		return
+	}
+
+	for _, enclosure := range el {
+		if strings.HasPrefix(enclosure.MimeType, "video/") && enclosure.PosterURL == "" {
+			enclosure.PosterURL = poster
+		}
+	}
+}
+
+// Primary returns the attachment that best represents the entry, or nil if there is none.
+func (el EnclosureList) Primary() *Enclosure {
+	var primary *Enclosure
+
+	for _, enclosure := range el {
+		if primary == nil || enclosure.rank() < primary.rank() {
+			primary = enclosure
+		}
+	}
+
+	return primary
+}
+
+// merge completes the attachment with the metadata of a duplicate.
+func (e *Enclosure) merge(duplicate *Enclosure) {
+	if isGenericMimeType(strings.ToLower(e.MimeType)) && !isGenericMimeType(strings.ToLower(duplicate.MimeType)) {
+		e.MimeType = duplicate.MimeType
+	} else if e.rank() > duplicate.rank() {
+		e.MimeType = duplicate.MimeType
+	}
+
+	if e.Size == 0 {
+		e.Size = duplicate.Size
+	}
+
+	if e.Duration == 0 {
+		e.Duration = duplicate.Duration
+	}
+
+	if e.PosterURL == "" {
+		e.PosterURL = duplicate.PosterURL
+	}
+}
+
+func (e *Enclosure) rank() int {
+	switch {
+	case strings.HasPrefix(e.MimeType, "audio/"):
+		return 0
+	case strings.HasPrefix(e.MimeType, "video/"):
+		return 1
+	case strings.HasPrefix(e.MimeType, "image/"):
+		return 2
+	default:
+		return 3
+	}
+}
+
+// ParseEnclosureDuration converts durations like "1:02:03", "62:03" or "3723" to seconds.
+// Durations are clamped to the largest value of the database column.
+func ParseEnclosureDuration(value string) int {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 {
+		return 0
+	}
+
+	duration := 0.0
+	for _, part := range parts {
+		number, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || math.IsNaN(number) || number < 0 {
+			return 0
+		}
+		duration = duration*60 + math.Floor(number)
+	}
+
+	if duration > math.MaxInt32 {
+		return math.MaxInt32
+	}
+
+	return int(duration)
+}
+
+func mimeTypeFromURL(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	return enclosureMimeTypes[strings.ToLower(path.Ext(u.Path))]
+}
+
+// isCompatibleMimeType returns true when both types belong to the same group.
+// MP4 and Ogg containers hold audio or video, so audio and video types are interchangeable.
+func isCompatibleMimeType(declared, sniffed string) bool {
+	declaredGroup := strings.SplitN(declared, "/", 2)[0]
+	sniffedGroup := strings.SplitN(sniffed, "/", 2)[0]
+
+	switch declaredGroup {
+	case sniffedGroup:
+		return true
+	case "audio", "video":
+		return sniffedGroup == "audio" || sniffedGroup == "video"
+	}
+
+	return false
+}
+
+func isGenericMimeType(mimeType string) bool {
+	switch {
+	case mimeType == "", !strings.Contains(mimeType, "/"), strings.HasSuffix(mimeType, "/*"):
+		return true
+	case mimeType == "application/octet-stream", mimeType == "binary/octet-stream", mimeType == "application/x-download":
+		return true
+	case strings.HasPrefix(mimeType, "text/"):
+		return true
+	}
+
+	return false
+}
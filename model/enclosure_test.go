@@ -0,0 +1,132 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestEnclosureNormalizeMimeType(t *testing.T) {
+	scenarios := []struct {
+		url      string
+		mimeType string
+		expected string
+	}{
+		{"https://example.org/episode.mp3", "audio/mpeg", "audio/mpeg"},
+		{"https://example.org/episode.mp3?source=rss", "", "audio/mpeg"},
+		{"https://example.org/episode.MP3", "application/octet-stream", "audio/mpeg"},
+		{"https://example.org/episode.mp3", "text/html; charset=utf-8", "audio/mpeg"},
+		{"https://example.org/episode.mp4", "audio/mp4", "audio/mp4"},
+		{"https://example.org/photo.jpg", "image/*", "image/jpeg"},
+		{"https://example.org/photo.jpg", "video/mp4", "image/jpeg"},
+		{"https://example.org/download", "Audio/MPEG", "audio/mpeg"},
+		{"https://example.org/download", "", "application/octet-stream"},
+	}
+
+	for _, scenario := range scenarios {
+		enclosure := &Enclosure{URL: scenario.url, MimeType: scenario.mimeType}
+		enclosure.NormalizeMimeType()
+		if enclosure.MimeType != scenario.expected {
+			t.Errorf(`Unexpected type for %q (%q), got %q instead of %q`, scenario.url, scenario.mimeType, enclosure.MimeType, scenario.expected)
+		}
+	}
+}
+
+func TestParseEnclosureDuration(t *testing.T) {
+	scenarios := map[string]int{
+		"":                0,
+		"3723":            3723,
+		"62:03":           3723,
+		"1:02:03":         3723,
+		"01:02:03":        3723,
+		"12.5":            12,
+		"invalid":         0,
+		"1:2:3:4":         0,
+		"-10":             0,
+		"NaN":             0,
+		"Inf":             2147483647,
+		"1e30":            2147483647,
+		"999999999:00:00": 2147483647,
+	}
+
+	for input, expected := range scenarios {
+		if result := ParseEnclosureDuration(input); result != expected {
+			t.Errorf(`Unexpected duration for %q, got %d instead of %d`, input, result, expected)
+		}
+	}
+}
+
+func TestEnclosureListDeduplicate(t *testing.T) {
+	enclosures := EnclosureList{
+		{URL: "https://example.org/cover.jpg", MimeType: "image/jpeg"},
+		{URL: "https://example.org/episode.mp4", MimeType: "video/mp4"},
+		{URL: "https://example.org/episode.mp3", MimeType: "application/octet-stream", Size: 1024},
+		{URL: " https://example.org/episode.mp3 ", MimeType: "audio/mpeg", Duration: 60},
+		{URL: ""},
+	}
+
+	results := enclosures.Deduplicate()
+	if len(results) != 3 {
+		t.Fatalf(`Unexpected number of enclosures: %d`, len(results))
+	}
+
+	expected := []string{"https://example.org/episode.mp3", "https://example.org/episode.mp4", "https://example.org/cover.jpg"}
+	for i, link := range expected {
+		if results[i].URL != link {
+			t.Errorf(`Unexpected enclosure at position %d: %q`, i, results[i].URL)
+		}
+	}
+
+	if results[0].MimeType != "audio/mpeg" || results[0].Size != 1024 || results[0].Duration != 60 {
+		t.Errorf(`The duplicates have not been merged: %+v`, results[0])
+	}
+}
+
+func TestEnclosureListPrimary(t *testing.T) {
+	if primary := (EnclosureList{}).Primary(); primary != nil {
+		t.Errorf(`An empty list should not have a primary enclosure`)
+	}
+
+	enclosures := EnclosureList{
+		{URL: "https://example.org/cover.jpg", MimeType: "image/jpeg"},
+		{URL: "https://example.org/episode.mp4", MimeType: "video/mp4"},
+	}
+
+	if primary := enclosures.Primary(); primary == nil || primary.URL != "https://example.org/episode.mp4" {
+		t.Errorf(`Unexpected primary enclosure: %+v`, primary)
+	}
+}
+
+func TestEnclosureListAssignPosters(t *testing.T) {
+	enclosures := EnclosureList{
+		{URL: "https://example.org/clip.mp4", MimeType: "video/mp4"},
+		{URL: "https://example.org/talk.webm", MimeType: "video/webm", PosterURL: "https://example.org/talk.png"},
+		{URL: "https://example.org/cover.jpg", MimeType: "image/jpeg"},
+	}
+
+	enclosures.AssignPosters()
+
+	if enclosures[0].PosterURL != "https://example.org/cover.jpg" {
+		t.Errorf(`Unexpected poster: %q`, enclosures[0].PosterURL)
+	}
+
+	if enclosures[1].PosterURL != "https://example.org/talk.png" {
+		t.Errorf(`The existing poster should be kept: %q`, enclosures[1].PosterURL)
+	}
+
+	if enclosures[2].PosterURL != "" {
+		t.Errorf(`Images should not have a poster: %q`, enclosures[2].PosterURL)
+	}
+}
+
+func TestValidateEnclosureType(t *testing.T) {
+	for _, enclosureType := range []string{EnclosureTypeAny, EnclosureTypeAudio, EnclosureTypeVideo, EnclosureTypeImage} {
+		if err := ValidateEnclosureType(enclosureType); err != nil {
+			t.Errorf(`The type %q should be valid`, enclosureType)
+		}
+	}
+
+	if err := ValidateEnclosureType("application"); err == nil {
+		t.Error(`An invalid type should generate an error`)
+	}
+}
@@ -7,6 +7,8 @@ package model // import "miniflux.app/model"
 import (
 	"fmt"
 	"time"
+
+	"miniflux.app/timezone"
 )
 
 // Entry statuses
@@ -20,21 +22,68 @@ const (
 
 // Entry represents a feed item in the system.
 type Entry struct {
-	ID          int64         `json:"id"`
-	UserID      int64         `json:"user_id"`
-	FeedID      int64         `json:"feed_id"`
-	Status      string        `json:"status"`
-	Hash        string        `json:"hash"`
-	Title       string        `json:"title"`
-	URL         string        `json:"url"`
-	CommentsURL string        `json:"comments_url"`
-	Date        time.Time     `json:"published_at"`
-	Content     string        `json:"content"`
-	Author      string        `json:"author"`
-	ShareCode   string        `json:"share_code"`
-	Starred     bool          `json:"starred"`
-	Enclosures  EnclosureList `json:"enclosures,omitempty"`
-	Feed        *Feed         `json:"feed,omitempty"`
+	ID                  int64                     `json:"id"`
+	UserID              int64                     `json:"user_id"`
+	FeedID              int64                     `json:"feed_id"`
+	Status              string                    `json:"status"`
+	Hash                string                    `json:"hash"`
+	Title               string                    `json:"title"`
+	URL                 string                    `json:"url"`
+	CommentsURL         string                    `json:"comments_url"`
+	CommentsFeedURL     string                    `json:"comments_feed_url"`
+	Date                time.Time                 `json:"published_at"`
+	DateOffset          *int                      `json:"-"`
+	ChangedAt           time.Time                 `json:"changed_at"`
+	Content             string                    `json:"content"`
+	Author              string                    `json:"author"`
+	ShareCode           string                    `json:"share_code"`
+	Starred             bool                      `json:"starred"`
+	RemovedTrackers     int                       `json:"removed_trackers"`
+	ReadingTime         int                       `json:"reading_time"`
+	Score               int                       `json:"score"`
+	CommentsCount       int                       `json:"comments_count"`
+	ReadingPosition     float64                   `json:"reading_position"`
+	Tags                []string                  `json:"tags"`
+	Latitude            *float64                  `json:"latitude,omitempty"`
+	Longitude           *float64                  `json:"longitude,omitempty"`
+	Enclosures          EnclosureList             `json:"enclosures,omitempty"`
+	PrimaryEnclosure    *Enclosure                `json:"primary_enclosure,omitempty"`
+	Archives            EntryArchives             `json:"archives,omitempty"`
+	HasSnapshot         bool                      `json:"-"`
+	Comments            EntryComments             `json:"comments,omitempty"`
+	CommentSubscription *EntryCommentSubscription `json:"-"`
+	Feed                *Feed                     `json:"feed,omitempty"`
+}
+
+// SetLocation defines the coordinates of the place the entry is about.
+func (e *Entry) SetLocation(latitude, longitude float64) {
+	e.Latitude = &latitude
+	e.Longitude = &longitude
+}
+
+// OriginalDate returns the publication date in the timezone used by the feed,
+// false is returned when the feed didn't give any timezone.
+func (e *Entry) OriginalDate() (time.Time, bool) {
+	if e.DateOffset == nil {
+		return time.Time{}, false
+	}
+
+	return e.Date.In(time.FixedZone("", *e.DateOffset)), true
+}
+
+// UseTimezone converts the timestamps of the entry and its feed to the given timezone.
+func (e *Entry) UseTimezone(tz string) {
+	e.Date = timezone.Convert(tz, e.Date)
+	e.ChangedAt = timezone.Convert(tz, e.ChangedAt)
+
+	if e.Feed != nil {
+		e.Feed.UseTimezone(tz)
+	}
+}
+
+// HasLocation returns true if the entry is geotagged.
+func (e *Entry) HasLocation() bool {
+	return e.Latitude != nil && e.Longitude != nil
 }
 
 // Entries represents a list of entries.
@@ -70,6 +119,15 @@ func ValidateDirection(direction string) error {
 	return fmt.Errorf(`Invalid direction, valid direction values are: "asc" or "desc"`)
 }
 
+// ValidateReadingPosition makes sure the reading position is a fraction of the content.
+func ValidateReadingPosition(position float64) error {
+	if position < 0 || position > 1 {
+		return fmt.Errorf(`Reading position should be between 0 and 1`)
+	}
+
+	return nil
+}
+
 // ValidateRange makes sure the offset/limit values are valid.
 func ValidateRange(offset, limit int) error {
 	if offset < 0 {
@@ -0,0 +1,40 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// Services keeping a copy of the web pages.
+const (
+	ArchiveServiceWayback    = "wayback"
+	ArchiveServiceArchiveBox = "archivebox"
+)
+
+var archiveServiceTitles = map[string]string{
+	ArchiveServiceWayback:    "Wayback Machine",
+	ArchiveServiceArchiveBox: "ArchiveBox",
+}
+
+// EntryArchive represents a snapshot of the entry web page kept by an archiving service.
+type EntryArchive struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	EntryID   int64     `json:"entry_id"`
+	Service   string    `json:"service"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ServiceTitle returns the name of the archiving service displayed to users.
+func (a *EntryArchive) ServiceTitle() string {
+	if title, found := archiveServiceTitles[a.Service]; found {
+		return title
+	}
+
+	return a.Service
+}
+
+// EntryArchives represents a list of snapshots.
+type EntryArchives []*EntryArchive
@@ -0,0 +1,43 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"math"
+	"time"
+)
+
+// EntryComment represents a comment published in the comments feed of an entry.
+type EntryComment struct {
+	ID      int64     `json:"id"`
+	EntryID int64     `json:"entry_id"`
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author"`
+	URL     string    `json:"url"`
+	Content string    `json:"content"`
+	Date    time.Time `json:"published_at"`
+}
+
+// EntryComments represents a list of comments.
+type EntryComments []*EntryComment
+
+// EntryCommentSubscription represents a temporary subscription to the comments feed of an entry.
+type EntryCommentSubscription struct {
+	EntryID         int64
+	UserID          int64
+	CommentsFeedURL string
+	ExpiresAt       time.Time
+	CheckedAt       *time.Time
+}
+
+// RemainingDays returns the number of days, rounded up, during which new comments are still fetched.
+func (s *EntryCommentSubscription) RemainingDays() int {
+	remaining := time.Until(s.ExpiresAt)
+	if remaining <= 0 {
+		return 0
+	}
+
+	return int(math.Ceil(remaining.Hours() / 24))
+}
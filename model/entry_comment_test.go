@@ -0,0 +1,27 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEntryCommentSubscriptionRemainingDays(t *testing.T) {
+	scenarios := map[time.Duration]int{
+		-time.Hour:                   0,
+		time.Hour:                    1,
+		7 * 24 * time.Hour:           7,
+		6*24*time.Hour + time.Minute: 7,
+		2*24*time.Hour - time.Minute: 2,
+	}
+
+	for duration, expected := range scenarios {
+		subscription := &EntryCommentSubscription{ExpiresAt: time.Now().Add(duration)}
+		if result := subscription.RemainingDays(); result != expected {
+			t.Errorf(`Unexpected remaining days for %v, got %d instead of %d`, duration, result, expected)
+		}
+	}
+}
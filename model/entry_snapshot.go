@@ -0,0 +1,15 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// EntrySnapshot represents a self-contained copy of the entry web page, images and stylesheets included.
+type EntrySnapshot struct {
+	EntryID   int64
+	UserID    int64
+	Content   []byte
+	CreatedAt time.Time
+}
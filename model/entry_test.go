@@ -69,3 +69,17 @@ func TestGetOppositeDirection(t *testing.T) {
 		t.Errorf(`An invalid direction should return "asc"`)
 	}
 }
+
+func TestValidateReadingPosition(t *testing.T) {
+	for _, position := range []float64{0, 0.5, 1} {
+		if err := ValidateReadingPosition(position); err != nil {
+			t.Errorf(`The position %v should be valid: %v`, position, err)
+		}
+	}
+
+	for _, position := range []float64{-0.1, 1.5} {
+		if err := ValidateReadingPosition(position); err == nil {
+			t.Errorf(`The position %v should be invalid`, position)
+		}
+	}
+}
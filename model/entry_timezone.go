@@ -0,0 +1,50 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"time"
+
+	"miniflux.app/errors"
+)
+
+// Timezones used to display the publication date of entries.
+const (
+	EntryTimezoneLocal    = "local"
+	EntryTimezoneOriginal = "original"
+	EntryTimezoneBoth     = "both"
+)
+
+// EntryTimezones returns the choices for the publication date of entries.
+func EntryTimezones() []string {
+	return []string{EntryTimezoneLocal, EntryTimezoneOriginal, EntryTimezoneBoth}
+}
+
+// ValidateEntryTimezone makes sure the entry timezone setting is valid.
+func ValidateEntryTimezone(value string) error {
+	for _, entryTimezone := range EntryTimezones() {
+		if value == entryTimezone {
+			return nil
+		}
+	}
+
+	return errors.NewLocalizedError("Invalid entry timezone")
+}
+
+// DateOffset returns the UTC offset of a publication date parsed from a feed, nil is returned for empty dates.
+// The date parser returns the dates without timezone in UTC, Unix timestamps are in the local timezone
+// of the server and are also considered as UTC dates.
+func DateOffset(date time.Time) *int {
+	if date.IsZero() {
+		return nil
+	}
+
+	offset := 0
+	if date.Location() != time.Local {
+		_, offset = date.Zone()
+	}
+
+	return &offset
+}
@@ -0,0 +1,83 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateEntryTimezone(t *testing.T) {
+	for _, value := range []string{"local", "original", "both"} {
+		if err := ValidateEntryTimezone(value); err != nil {
+			t.Errorf(`The entry timezone %q should be valid: %v`, value, err)
+		}
+	}
+
+	for _, value := range []string{"", "UTC", "Local"} {
+		if err := ValidateEntryTimezone(value); err == nil {
+			t.Errorf(`The entry timezone %q should be invalid`, value)
+		}
+	}
+}
+
+func TestDateOffset(t *testing.T) {
+	date := time.Date(2020, 5, 1, 10, 0, 0, 0, time.FixedZone("", -4*3600))
+	if offset := DateOffset(date); offset == nil || *offset != -4*3600 {
+		t.Errorf(`Unexpected offset: %v`, offset)
+	}
+
+	if offset := DateOffset(time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)); offset == nil || *offset != 0 {
+		t.Errorf(`Dates without timezone should be in UTC, got %v`, offset)
+	}
+
+	if offset := DateOffset(time.Unix(1588327200, 0)); offset == nil || *offset != 0 {
+		t.Errorf(`Unix timestamps should be in UTC, got %v`, offset)
+	}
+
+	if offset := DateOffset(time.Time{}); offset != nil {
+		t.Errorf(`Empty dates should not have an offset, got %d`, *offset)
+	}
+}
+
+func TestEntryOriginalDate(t *testing.T) {
+	offset := 9 * 3600
+	entry := &Entry{Date: time.Date(2020, 5, 1, 1, 30, 0, 0, time.UTC), DateOffset: &offset}
+
+	date, found := entry.OriginalDate()
+	if !found {
+		t.Fatal(`The original date should be found`)
+	}
+
+	if result := date.Format("2006-01-02 15:04 -07:00"); result != "2020-05-01 10:30 +09:00" {
+		t.Errorf(`Unexpected original date, got %q`, result)
+	}
+
+	entry.DateOffset = nil
+	if _, found := entry.OriginalDate(); found {
+		t.Error(`The original date should not be found without offset`)
+	}
+}
+
+func TestEntryUseTimezone(t *testing.T) {
+	lastReadAt := time.Date(2020, 5, 1, 8, 0, 0, 0, time.UTC)
+	entry := &Entry{
+		Date:      time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC),
+		ChangedAt: time.Date(2020, 5, 1, 11, 0, 0, 0, time.UTC),
+		Feed:      &Feed{CheckedAt: time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC), LastReadAt: &lastReadAt},
+	}
+
+	entry.UseTimezone("Asia/Tokyo")
+
+	for _, date := range []time.Time{entry.Date, entry.ChangedAt, entry.Feed.CheckedAt, *entry.Feed.LastReadAt} {
+		if date.Location().String() != "Asia/Tokyo" {
+			t.Errorf(`Unexpected timezone for %v`, date)
+		}
+	}
+
+	if entry.ChangedAt.Hour() != 20 {
+		t.Errorf(`Unexpected converted date, got %v`, entry.ChangedAt)
+	}
+}
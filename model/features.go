@@ -0,0 +1,31 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// SearchBackendPostgres is the full-text search backend provided by PostgreSQL.
+const SearchBackendPostgres = "postgresql"
+
+// Features represents the optional subsystems available to a user.
+type Features struct {
+	ImageProxy    bool     `json:"image_proxy"`
+	SearchBackend string   `json:"search_backend"`
+	WebSub        bool     `json:"websub"`
+	Integrations  []string `json:"integrations"`
+}
+
+// NewFeatures returns the features available with the given image proxy mode and user integrations.
+func NewFeatures(proxyImages string, integration *Integration) *Features {
+	features := &Features{
+		ImageProxy:    proxyImages != "none",
+		SearchBackend: SearchBackendPostgres,
+		Integrations:  make([]string, 0),
+	}
+
+	if integration != nil {
+		features.Integrations = integration.EnabledServices()
+	}
+
+	return features
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewFeatures(t *testing.T) {
+	integration := &Integration{PocketEnabled: true, FeverEnabled: true, WallabagEnabled: false}
+	features := NewFeatures("http-only", integration)
+
+	if !features.ImageProxy {
+		t.Error(`The image proxy should be enabled`)
+	}
+
+	if features.SearchBackend != SearchBackendPostgres {
+		t.Errorf(`Unexpected search backend: %q`, features.SearchBackend)
+	}
+
+	expected := []string{"fever", "pocket"}
+	if !reflect.DeepEqual(features.Integrations, expected) {
+		t.Errorf(`Unexpected integrations: %v`, features.Integrations)
+	}
+}
+
+func TestNewFeaturesWithoutIntegration(t *testing.T) {
+	features := NewFeatures("none", nil)
+
+	if features.ImageProxy {
+		t.Error(`The image proxy should be disabled`)
+	}
+
+	if features.Integrations == nil || len(features.Integrations) != 0 {
+		t.Errorf(`Integrations should be an empty list: %v`, features.Integrations)
+	}
+}
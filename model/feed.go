@@ -7,39 +7,110 @@ package model // import "miniflux.app/model"
 import (
 	"fmt"
 	"math"
+	"math/rand"
+	"net/url"
+	"strings"
 	"time"
 
 	"miniflux.app/config"
 	"miniflux.app/http/client"
+	"miniflux.app/timezone"
 )
 
+// PermanentRedirectThreshold is the number of consecutive permanent redirects
+// required before updating the feed URL automatically.
+const PermanentRedirectThreshold = 3
+
+// OrphanedSavesFeedURL identifies the virtual feed keeping the starred entries of removed feeds, it is never refreshed.
+const OrphanedSavesFeedURL = "miniflux:orphaned-saves"
+
+// ValidateRequestLimits makes sure the request timeout and the maximum size chosen for a feed are allowed, zero means the default of the instance.
+func ValidateRequestLimits(requestTimeout, maxBodySize int) error {
+	if requestTimeout != 0 && (requestTimeout < config.MinHTTPClientTimeout || requestTimeout > config.MaxHTTPClientTimeout) {
+		return fmt.Errorf("the request timeout must be between %d and %d seconds", config.MinHTTPClientTimeout, config.MaxHTTPClientTimeout)
+	}
+
+	if maxBodySize != 0 && (maxBodySize < config.MinHTTPClientMaxBodySize || maxBodySize > config.MaxHTTPClientMaxBodySize) {
+		return fmt.Errorf("the maximum size must be between %d and %d megabytes", config.MinHTTPClientMaxBodySize, config.MaxHTTPClientMaxBodySize)
+	}
+
+	return nil
+}
+
 // Feed represents a feed in the application.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at"`
-	NextCheckAt        time.Time `json:"next_check_at"`
-	EtagHeader         string    `json:"etag_header"`
-	LastModifiedHeader string    `json:"last_modified_header"`
-	ParsingErrorMsg    string    `json:"parsing_error_message"`
-	ParsingErrorCount  int       `json:"parsing_error_count"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	Crawler            bool      `json:"crawler"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Disabled           bool      `json:"disabled"`
-	IgnoreHTTPCache    bool      `json:"ignore_http_cache"`
-	FetchViaProxy      bool      `json:"fetch_via_proxy"`
-	Category           *Category `json:"category,omitempty"`
-	Entries            Entries   `json:"entries,omitempty"`
-	Icon               *FeedIcon `json:"icon"`
-	UnreadCount        int       `json:"-"`
-	ReadCount          int       `json:"-"`
+	ID                     int64      `json:"id"`
+	UserID                 int64      `json:"user_id"`
+	FeedURL                string     `json:"feed_url"`
+	SiteURL                string     `json:"site_url"`
+	Title                  string     `json:"title"`
+	CustomTitle            string     `json:"custom_title"`
+	CheckedAt              time.Time  `json:"checked_at"`
+	NextCheckAt            time.Time  `json:"next_check_at"`
+	EtagHeader             string     `json:"etag_header"`
+	LastModifiedHeader     string     `json:"last_modified_header"`
+	ParsingErrorMsg        string     `json:"parsing_error_message"`
+	ParsingErrorCount      int        `json:"parsing_error_count"`
+	ScraperRules           string     `json:"scraper_rules"`
+	RewriteRules           string     `json:"rewrite_rules"`
+	Crawler                bool       `json:"crawler"`
+	UserAgent              string     `json:"user_agent"`
+	Username               string     `json:"username"`
+	Password               string     `json:"password"`
+	Disabled               bool       `json:"disabled"`
+	IgnoreHTTPCache        bool       `json:"ignore_http_cache"`
+	FetchViaProxy          bool       `json:"fetch_via_proxy"`
+	OpenExternalLink       bool       `json:"open_external_link"`
+	Encoding               string     `json:"encoding"`
+	BearerToken            string     `json:"bearer_token"`
+	OAuth2TokenURL         string     `json:"oauth2_token_url"`
+	OAuth2ClientID         string     `json:"oauth2_client_id"`
+	OAuth2ClientSecret     string     `json:"oauth2_client_secret"`
+	OAuth2Scopes           string     `json:"oauth2_scopes"`
+	FetchScores            bool       `json:"fetch_scores"`
+	MinimumScore           int        `json:"minimum_score"`
+	BlockedAuthors         string     `json:"blocked_authors"`
+	AutoStar               bool       `json:"auto_star"`
+	ArchivePages           bool       `json:"archive_pages"`
+	SharedFeedID           int64      `json:"shared_feed_id,omitempty"`
+	HideGlobally           bool       `json:"hide_globally"`
+	NotifyErrors           bool       `json:"notify_errors"`
+	OrphanedSaves          bool       `json:"orphaned_saves"`
+	CronExpression         string     `json:"cron_expression"`
+	RequestTimeout         int        `json:"request_timeout"`
+	MaxBodySize            int        `json:"max_body_size"`
+	FailingSince           *time.Time `json:"failing_since"`
+	Dead                   bool       `json:"dead"`
+	PermanentRedirectCount int        `json:"-"`
+	PreviousFeedURL        string     `json:"previous_feed_url"`
+	FeedURLUpdatedAt       *time.Time `json:"feed_url_updated_at"`
+	CanonicalFeedURL       string     `json:"canonical_feed_url"`
+	LastFetchURL           string     `json:"last_fetch_url"`
+	LastFetchContentType   string     `json:"last_fetch_content_type"`
+	LastFetchSize          int        `json:"last_fetch_size"`
+	LastReadAt             *time.Time `json:"last_read_at"`
+	Unused                 bool       `json:"unused"`
+	LastEntryAt            *time.Time `json:"last_entry_at"`
+	Dormant                bool       `json:"dormant"`
+	Category               *Category  `json:"category,omitempty"`
+	Entries                Entries    `json:"entries,omitempty"`
+	Icon                   *FeedIcon  `json:"icon"`
+	UnreadCount            int        `json:"-"`
+	ReadCount              int        `json:"-"`
+}
+
+// FeedAuthentication represents token-based authentication parameters used to fetch a feed.
+type FeedAuthentication struct {
+	BearerToken        string `json:"bearer_token"`
+	OAuth2TokenURL     string `json:"oauth2_token_url"`
+	OAuth2ClientID     string `json:"oauth2_client_id"`
+	OAuth2ClientSecret string `json:"oauth2_client_secret"`
+	OAuth2Scopes       string `json:"oauth2_scopes"`
+}
+
+// HasOAuth2ClientCredentials returns true if the OAuth2 client credentials flow is configured.
+func (a *FeedAuthentication) HasOAuth2ClientCredentials() bool {
+	return a.OAuth2TokenURL != "" && a.OAuth2ClientID != ""
 }
 
 // List of supported schedulers.
@@ -59,6 +130,34 @@ func (f *Feed) String() string {
 	)
 }
 
+// DisplayTitle returns the title chosen by the user or the one published by the feed.
+func (f *Feed) DisplayTitle() string {
+	if f.CustomTitle != "" {
+		return f.CustomTitle
+	}
+	return f.Title
+}
+
+// UseTimezone converts the timestamps of the feed to the given timezone.
+func (f *Feed) UseTimezone(tz string) {
+	f.CheckedAt = timezone.Convert(tz, f.CheckedAt)
+
+	for _, date := range []*time.Time{f.FailingSince, f.FeedURLUpdatedAt, f.LastReadAt, f.LastEntryAt} {
+		if date != nil {
+			*date = timezone.Convert(tz, *date)
+		}
+	}
+}
+
+// WithCustomTitle renames the feed, the custom title is removed when it's empty or identical to the original one.
+func (f *Feed) WithCustomTitle(title string) {
+	title = strings.TrimSpace(title)
+	if title == f.Title {
+		title = ""
+	}
+	f.CustomTitle = title
+}
+
 // WithClientResponse updates feed attributes from an HTTP request.
 func (f *Feed) WithClientResponse(response *client.Response) {
 	f.EtagHeader = response.ETag
@@ -66,6 +165,13 @@ func (f *Feed) WithClientResponse(response *client.Response) {
 	f.FeedURL = response.EffectiveURL
 }
 
+// WithResponseMetadata keeps some details about the last fetch to help diagnosing broken feeds.
+func (f *Feed) WithResponseMetadata(response *client.Response) {
+	f.LastFetchURL = response.EffectiveURL
+	f.LastFetchContentType = response.ContentType
+	f.LastFetchSize = response.BodySize
+}
+
 // WithCategoryID initializes the category attribute of the feed.
 func (f *Feed) WithCategoryID(categoryID int64) {
 	f.Category = &Category{ID: categoryID}
@@ -82,16 +188,146 @@ func (f *Feed) WithBrowsingParameters(crawler bool, userAgent, username, passwor
 	f.FetchViaProxy = fetchViaProxy
 }
 
+// WithAuthentication defines token-based authentication parameters.
+func (f *Feed) WithAuthentication(auth *FeedAuthentication) {
+	if auth != nil {
+		f.BearerToken = auth.BearerToken
+		f.OAuth2TokenURL = auth.OAuth2TokenURL
+		f.OAuth2ClientID = auth.OAuth2ClientID
+		f.OAuth2ClientSecret = auth.OAuth2ClientSecret
+		f.OAuth2Scopes = auth.OAuth2Scopes
+	}
+}
+
+// Authentication returns token-based authentication parameters of the feed.
+func (f *Feed) Authentication() *FeedAuthentication {
+	return &FeedAuthentication{
+		BearerToken:        f.BearerToken,
+		OAuth2TokenURL:     f.OAuth2TokenURL,
+		OAuth2ClientID:     f.OAuth2ClientID,
+		OAuth2ClientSecret: f.OAuth2ClientSecret,
+		OAuth2Scopes:       f.OAuth2Scopes,
+	}
+}
+
+// CanShareContent returns true when the feed is downloaded without any setting specific to the user,
+// the same document can be used for all the subscribers of the URL.
+// The request limits and the proxy change what can be downloaded, these feeds are fetched on their own.
+func (f *Feed) CanShareContent() bool {
+	return f.Username == "" &&
+		f.Password == "" &&
+		f.UserAgent == "" &&
+		f.Encoding == "" &&
+		f.BearerToken == "" &&
+		f.OAuth2TokenURL == "" &&
+		f.RequestTimeout == 0 &&
+		f.MaxBodySize == 0 &&
+		!f.FetchViaProxy
+}
+
 // WithError adds a new error message and increment the error counter.
 func (f *Feed) WithError(message string) {
 	f.ParsingErrorCount++
 	f.ParsingErrorMsg = message
+
+	if f.FailingSince == nil {
+		now := time.Now()
+		f.FailingSince = &now
+	}
+}
+
+// ApplyErrorBackoff delays the next check according to the number of consecutive errors
+// and disables the feed when it has been failing for too long.
+func (f *Feed) ApplyErrorBackoff() {
+	if f.ParsingErrorCount == 0 || f.FailingSince == nil {
+		return
+	}
+
+	now := time.Now()
+	if multiplier := config.Opts.PollingErrorBackoffMultiplier(); multiplier > 0 {
+		intervalMinutes := float64(config.Opts.PollingFrequency()) * math.Pow(float64(multiplier), float64(f.ParsingErrorCount-1))
+		intervalMinutes = math.Min(intervalMinutes, float64(config.Opts.PollingErrorBackoffMaxInterval()))
+		f.NextCheckAt = now.Add(time.Minute * time.Duration(intervalMinutes))
+	}
+
+	if weeks := config.Opts.PollingErrorDisableAfterWeeks(); weeks > 0 && now.Sub(*f.FailingSince) >= time.Duration(weeks)*7*24*time.Hour {
+		f.Disabled = true
+	}
 }
 
 // ResetErrorCounter removes all previous errors.
 func (f *Feed) ResetErrorCounter() {
 	f.ParsingErrorCount = 0
 	f.ParsingErrorMsg = ""
+	f.FailingSince = nil
+	f.Dead = false
+}
+
+// MarkAsDead flags a feed that has been permanently removed by its publisher (HTTP 410).
+// Dead feeds are not refreshed by the scheduler anymore.
+func (f *Feed) MarkAsDead(message string) {
+	f.Dead = true
+	f.ParsingErrorMsg = message
+}
+
+// MarkAsBlocked disables a feed blocked by an administrator of the instance.
+func (f *Feed) MarkAsBlocked(message string) {
+	f.Disabled = true
+	f.ParsingErrorMsg = message
+}
+
+// WithPermanentRedirect records a permanent redirection to a new location.
+// The feed URL is updated once the same redirection has been seen enough times in a row,
+// the previous URL is kept for reference. It returns true when the feed URL has been changed.
+func (f *Feed) WithPermanentRedirect(location string) bool {
+	if location == "" || location == f.FeedURL {
+		f.PermanentRedirectCount = 0
+		return false
+	}
+
+	f.PermanentRedirectCount++
+	if f.PermanentRedirectCount < PermanentRedirectThreshold {
+		return false
+	}
+
+	now := time.Now()
+	f.PreviousFeedURL = f.FeedURL
+	f.FeedURL = location
+	f.FeedURLUpdatedAt = &now
+	f.PermanentRedirectCount = 0
+	return true
+}
+
+// WithSelfURL remembers the canonical URL advertised by the feed document (rel=self)
+// when it is a valid absolute URL different from the current feed URL.
+func (f *Feed) WithSelfURL(selfURL string) {
+	f.CanonicalFeedURL = ""
+
+	if selfURL == "" || selfURL == f.FeedURL {
+		return
+	}
+
+	u, err := url.Parse(selfURL)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return
+	}
+
+	f.CanonicalFeedURL = selfURL
+}
+
+// ApplyCanonicalURL replaces the feed URL by the canonical URL advertised by the feed.
+// Caching headers are kept since the document is the same. It returns false when there is nothing to apply.
+func (f *Feed) ApplyCanonicalURL() bool {
+	if f.CanonicalFeedURL == "" {
+		return false
+	}
+
+	now := time.Now()
+	f.PreviousFeedURL = f.FeedURL
+	f.FeedURL = f.CanonicalFeedURL
+	f.FeedURLUpdatedAt = &now
+	f.CanonicalFeedURL = ""
+	return true
 }
 
 // CheckedNow set attribute values when the feed is refreshed.
@@ -119,7 +355,51 @@ func (f *Feed) ScheduleNextCheck(weeklyCount int) {
 	default:
 		f.NextCheckAt = time.Now()
 	}
+
+	// A random delay avoids refreshing at the same time all the feeds created or checked together.
+	if jitter := config.Opts.PollingJitterMinutes(); jitter > 0 {
+		f.NextCheckAt = f.NextCheckAt.Add(time.Duration(rand.Int63n(int64(jitter) * int64(time.Minute))))
+	}
+}
+
+// ScheduleNextCronCheck set "next_check_at" according to the cron expression of the feed,
+// evaluated in the given timezone.
+func (f *Feed) ScheduleNextCronCheck(timezone string) error {
+	schedule, err := ParseCronExpression(f.CronExpression)
+	if err != nil {
+		return err
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	if next := schedule.Next(time.Now().In(location)); !next.IsZero() {
+		f.NextCheckAt = next
+	}
+
+	return nil
 }
 
 // Feeds is a list of feed
 type Feeds []*Feed
+
+// Dormant returns the feeds without new entries for a while.
+func (f Feeds) Dormant() Feeds {
+	dormantFeeds := make(Feeds, 0)
+	for _, feed := range f {
+		if feed.Dormant {
+			dormantFeeds = append(dormantFeeds, feed)
+		}
+	}
+	return dormantFeeds
+}
+
+// FeedCounters represents the number of read and unread entries of each feed and category.
+type FeedCounters struct {
+	ReadCounters           map[int64]int `json:"reads"`
+	UnreadCounters         map[int64]int `json:"unreads"`
+	CategoryReadCounters   map[int64]int `json:"category_reads"`
+	CategoryUnreadCounters map[int64]int `json:"category_unreads"`
+}
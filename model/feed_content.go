@@ -0,0 +1,52 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"bytes"
+	"time"
+
+	"miniflux.app/http/client"
+)
+
+// FeedContent represents a feed document recently downloaded, shared by all the subscribers of the same URL.
+type FeedContent struct {
+	FeedURL            string
+	EffectiveURL       string
+	PermanentRedirect  bool
+	ContentType        string
+	EtagHeader         string
+	LastModifiedHeader string
+	Content            []byte
+	FetchedAt          time.Time
+}
+
+// NewFeedContent returns the document downloaded from the feed URL.
+func NewFeedContent(feedURL string, response *client.Response, content string) *FeedContent {
+	return &FeedContent{
+		FeedURL:            feedURL,
+		EffectiveURL:       response.EffectiveURL,
+		PermanentRedirect:  response.PermanentRedirect,
+		ContentType:        response.ContentType,
+		EtagHeader:         response.ETag,
+		LastModifiedHeader: response.LastModified,
+		Content:            []byte(content),
+	}
+}
+
+// Response returns the document as if it has just been downloaded.
+func (c *FeedContent) Response() *client.Response {
+	return &client.Response{
+		Body:              bytes.NewReader(c.Content),
+		StatusCode:        200,
+		EffectiveURL:      c.EffectiveURL,
+		PermanentRedirect: c.PermanentRedirect,
+		ContentType:       c.ContentType,
+		ETag:              c.EtagHeader,
+		LastModified:      c.LastModifiedHeader,
+		ContentLength:     int64(len(c.Content)),
+		BodySize:          len(c.Content),
+	}
+}
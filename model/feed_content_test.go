@@ -0,0 +1,40 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+
+	"miniflux.app/http/client"
+)
+
+func TestFeedContentResponse(t *testing.T) {
+	content := NewFeedContent("http://example.org/feed", &client.Response{
+		StatusCode:        200,
+		EffectiveURL:      "https://example.org/feed.xml",
+		PermanentRedirect: true,
+		ContentType:       "application/rss+xml",
+		ETag:              "etag",
+		LastModified:      "date",
+	}, "<rss></rss>")
+
+	response := content.Response()
+
+	if response.EffectiveURL != "https://example.org/feed.xml" || !response.PermanentRedirect {
+		t.Errorf(`The redirection should be kept: %v`, response)
+	}
+
+	if response.ContentType != "application/rss+xml" || response.ContentLength != 11 {
+		t.Errorf(`Unexpected response: %v`, response)
+	}
+
+	if response.IsModified("etag", "") {
+		t.Error(`The response should not be modified for the same ETag`)
+	}
+
+	if body := response.BodyAsString(); body != "<rss></rss>" {
+		t.Errorf(`Unexpected body: %q`, body)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// FeedEntryCountLayout is the format of the days in the feed statistics.
+const FeedEntryCountLayout = "2006-01-02"
+
+// FeedEntryCount represents the number of entries published by a feed during one day.
+type FeedEntryCount struct {
+	Day   string `json:"day"`
+	Count int    `json:"count"`
+}
+
+// FeedEntryCounts represents the daily volume of a feed, from the oldest to the most recent day.
+type FeedEntryCounts []*FeedEntryCount
+
+// NewFeedEntryCounts returns the counts of the last days until today, the days without entries are set to zero.
+func NewFeedEntryCounts(counts map[string]int, days int, today time.Time) FeedEntryCounts {
+	entryCounts := make(FeedEntryCounts, 0, days)
+	for i := days - 1; i >= 0; i-- {
+		day := today.AddDate(0, 0, -i).Format(FeedEntryCountLayout)
+		entryCounts = append(entryCounts, &FeedEntryCount{Day: day, Count: counts[day]})
+	}
+	return entryCounts
+}
+
+// Total returns the number of entries published during the period.
+func (f FeedEntryCounts) Total() int {
+	total := 0
+	for _, entryCount := range f {
+		total += entryCount.Count
+	}
+	return total
+}
+
+// Max returns the highest daily count.
+func (f FeedEntryCounts) Max() int {
+	max := 0
+	for _, entryCount := range f {
+		if entryCount.Count > max {
+			max = entryCount.Count
+		}
+	}
+	return max
+}
+
+// WeeklyAverage returns the average number of entries published per week during the period.
+func (f FeedEntryCounts) WeeklyAverage() int {
+	if len(f) == 0 {
+		return 0
+	}
+
+	return int(math.Round(float64(f.Total()) * 7 / float64(len(f))))
+}
+
+// SparklinePoints returns the coordinates of a SVG polyline drawing the daily counts.
+func (f FeedEntryCounts) SparklinePoints(width, height int) string {
+	if len(f) < 2 {
+		return ""
+	}
+
+	max := f.Max()
+	step := float64(width) / float64(len(f)-1)
+	points := make([]string, 0, len(f))
+
+	for i, entryCount := range f {
+		y := float64(height)
+		if max > 0 {
+			y -= float64(entryCount.Count) * float64(height) / float64(max)
+		}
+		points = append(points, fmt.Sprintf("%.1f,%.1f", float64(i)*step, y))
+	}
+
+	return strings.Join(points, " ")
+}
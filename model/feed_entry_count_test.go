@@ -0,0 +1,61 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewFeedEntryCounts(t *testing.T) {
+	today := time.Date(2020, 3, 2, 15, 0, 0, 0, time.UTC)
+	entryCounts := NewFeedEntryCounts(map[string]int{"2020-03-02": 4, "2020-02-29": 2, "2020-01-01": 10}, 3, today)
+
+	if len(entryCounts) != 3 {
+		t.Fatalf(`Unexpected number of days, got %d`, len(entryCounts))
+	}
+
+	expected := []FeedEntryCount{{"2020-02-29", 2}, {"2020-03-01", 0}, {"2020-03-02", 4}}
+	for i, entryCount := range entryCounts {
+		if *entryCount != expected[i] {
+			t.Errorf(`Unexpected count, got %v instead of %v`, *entryCount, expected[i])
+		}
+	}
+
+	if entryCounts.Total() != 6 {
+		t.Errorf(`Unexpected total, got %d`, entryCounts.Total())
+	}
+
+	if entryCounts.Max() != 4 {
+		t.Errorf(`Unexpected max, got %d`, entryCounts.Max())
+	}
+}
+
+func TestFeedEntryCountsSparklinePoints(t *testing.T) {
+	entryCounts := FeedEntryCounts{{"2020-03-01", 0}, {"2020-03-02", 2}, {"2020-03-03", 4}}
+	if points := entryCounts.SparklinePoints(100, 20); points != "0.0,20.0 50.0,10.0 100.0,0.0" {
+		t.Errorf(`Unexpected points, got %q`, points)
+	}
+
+	empty := FeedEntryCounts{{"2020-03-01", 0}, {"2020-03-02", 0}}
+	if points := empty.SparklinePoints(100, 20); points != "0.0,20.0 100.0,20.0" {
+		t.Errorf(`Unexpected points without entries, got %q`, points)
+	}
+
+	if points := (FeedEntryCounts{}).SparklinePoints(100, 20); points != "" {
+		t.Errorf(`There should be no points without days, got %q`, points)
+	}
+}
+
+func TestFeedEntryCountsWeeklyAverage(t *testing.T) {
+	entryCounts := NewFeedEntryCounts(map[string]int{"2020-03-02": 20, "2020-02-20": 8}, 14, time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC))
+	if average := entryCounts.WeeklyAverage(); average != 14 {
+		t.Errorf(`Unexpected weekly average, got %d instead of 14`, average)
+	}
+
+	if average := (FeedEntryCounts{}).WeeklyAverage(); average != 0 {
+		t.Errorf(`The weekly average should be zero without days, got %d`, average)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "fmt"
+
+// MaxMovedFeeds is the maximum number of feeds moved to another category at once.
+const MaxMovedFeeds = 100
+
+// ValidateFeedsMove makes sure a batch of feeds can be moved to the category.
+func ValidateFeedsMove(feedIDs []int64, categoryID int64) error {
+	if len(feedIDs) == 0 {
+		return fmt.Errorf("no feed selected")
+	}
+
+	if len(feedIDs) > MaxMovedFeeds {
+		return fmt.Errorf("too many feeds selected, the limit is %d", MaxMovedFeeds)
+	}
+
+	if categoryID <= 0 {
+		return fmt.Errorf("no category selected")
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateFeedsMove(t *testing.T) {
+	if err := ValidateFeedsMove([]int64{1, 2}, 3); err != nil {
+		t.Errorf(`A valid move should not generate any error: %v`, err)
+	}
+
+	if err := ValidateFeedsMove(nil, 3); err == nil {
+		t.Error(`Moving no feed should generate an error`)
+	}
+
+	if err := ValidateFeedsMove([]int64{1}, 0); err == nil {
+		t.Error(`Moving feeds without category should generate an error`)
+	}
+
+	if err := ValidateFeedsMove(make([]int64, MaxMovedFeeds+1), 3); err == nil {
+		t.Error(`Moving too many feeds should generate an error`)
+	}
+}
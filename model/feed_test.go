@@ -33,6 +33,29 @@ func TestFeedWithResponse(t *testing.T) {
 	}
 }
 
+func TestFeedCustomTitle(t *testing.T) {
+	feed := &Feed{Title: "Original"}
+	if feed.DisplayTitle() != "Original" {
+		t.Errorf(`The original title should be displayed by default`)
+	}
+
+	feed.WithCustomTitle(" Renamed ")
+	if feed.CustomTitle != "Renamed" || feed.DisplayTitle() != "Renamed" {
+		t.Errorf(`The custom title should be displayed, got %q`, feed.DisplayTitle())
+	}
+
+	feed.WithCustomTitle("Original")
+	if feed.CustomTitle != "" {
+		t.Errorf(`The custom title should be removed when identical to the original title`)
+	}
+
+	feed.WithCustomTitle("Renamed")
+	feed.WithCustomTitle("")
+	if feed.CustomTitle != "" || feed.DisplayTitle() != "Original" {
+		t.Errorf(`An empty custom title should restore the original title`)
+	}
+}
+
 func TestFeedCategorySetter(t *testing.T) {
 	feed := &Feed{}
 	feed.WithCategoryID(int64(123))
@@ -75,6 +98,28 @@ func TestFeedBrowsingParams(t *testing.T) {
 	}
 }
 
+func TestFeedCanShareContent(t *testing.T) {
+	feed := &Feed{FeedURL: "https://example.org/feed.xml"}
+	if !feed.CanShareContent() {
+		t.Error(`A feed without any user specific setting should be shared`)
+	}
+
+	for _, feed := range []*Feed{
+		{Username: "user", Password: "secret"},
+		{UserAgent: "Custom User Agent"},
+		{Encoding: "iso-8859-1"},
+		{BearerToken: "token"},
+		{OAuth2TokenURL: "https://example.org/token", OAuth2ClientID: "client"},
+		{RequestTimeout: 5},
+		{MaxBodySize: 1},
+		{FetchViaProxy: true},
+	} {
+		if feed.CanShareContent() {
+			t.Errorf(`The feed should not be shared: %+v`, feed)
+		}
+	}
+}
+
 func TestFeedErrorCounter(t *testing.T) {
 	feed := &Feed{}
 	feed.WithError("Some Error")
@@ -182,3 +227,306 @@ func TestFeedScheduleNextCheckEntryCountBasedMinInterval(t *testing.T) {
 		t.Error(`The next_check_at should not be before the now + min interval`)
 	}
 }
+
+func TestFeedScheduleNextCheckWithJitter(t *testing.T) {
+	jitter := 30
+	os.Clearenv()
+	os.Setenv("POLLING_JITTER_MINUTES", fmt.Sprintf("%d", jitter))
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	before := time.Now()
+	feed := &Feed{}
+	feed.ScheduleNextCheck(0)
+
+	if feed.NextCheckAt.Before(before) {
+		t.Error(`The next_check_at should not be in the past`)
+	}
+
+	if feed.NextCheckAt.After(time.Now().Add(time.Minute * time.Duration(jitter))) {
+		t.Error(`The next_check_at should not be after now + jitter`)
+	}
+}
+
+func TestFeedScheduleNextCronCheck(t *testing.T) {
+	feed := &Feed{CronExpression: "0 8 * * *"}
+	if err := feed.ScheduleNextCronCheck("Europe/Paris"); err != nil {
+		t.Fatal(err)
+	}
+
+	location, _ := time.LoadLocation("Europe/Paris")
+	next := feed.NextCheckAt.In(location)
+	if next.Hour() != 8 || next.Minute() != 0 {
+		t.Errorf(`The next check should be at 8:00 in the user timezone, got %v`, next)
+	}
+
+	if !feed.NextCheckAt.After(time.Now()) || feed.NextCheckAt.After(time.Now().Add(24*time.Hour)) {
+		t.Errorf(`The next check should be within the next day, got %v`, feed.NextCheckAt)
+	}
+
+	feed.CronExpression = "invalid"
+	if err := feed.ScheduleNextCronCheck("UTC"); err == nil {
+		t.Error(`An invalid cron expression should return an error`)
+	}
+}
+
+func TestFeedApplyErrorBackoff(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_FREQUENCY", "60")
+	os.Setenv("POLLING_ERROR_BACKOFF_MULTIPLIER", "3")
+	os.Setenv("POLLING_ERROR_BACKOFF_MAX_INTERVAL", "300")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &Feed{}
+	feed.WithError("first error")
+	feed.ApplyErrorBackoff()
+
+	if feed.FailingSince == nil {
+		t.Fatal(`The failure date must be set`)
+	}
+
+	if delay := time.Until(feed.NextCheckAt); delay < 59*time.Minute || delay > 60*time.Minute {
+		t.Errorf(`The first retry should happen after the polling frequency, got %v`, delay)
+	}
+
+	feed.WithError("second error")
+	feed.ApplyErrorBackoff()
+
+	if delay := time.Until(feed.NextCheckAt); delay < 179*time.Minute || delay > 180*time.Minute {
+		t.Errorf(`The second retry should be delayed by the multiplier, got %v`, delay)
+	}
+
+	feed.WithError("third error")
+	feed.ApplyErrorBackoff()
+
+	if delay := time.Until(feed.NextCheckAt); delay < 299*time.Minute || delay > 300*time.Minute {
+		t.Errorf(`The retry delay should not exceed the maximum interval, got %v`, delay)
+	}
+
+	if feed.Disabled {
+		t.Error(`The feed should not be disabled by default`)
+	}
+
+	feed.ResetErrorCounter()
+	if feed.FailingSince != nil {
+		t.Error(`The failure date must be removed`)
+	}
+}
+
+func TestFeedApplyErrorBackoffDisablesFailingFeeds(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_ERROR_DISABLE_AFTER_WEEKS", "2")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	failingSince := time.Now().Add(-13 * 24 * time.Hour)
+	feed := &Feed{FailingSince: &failingSince}
+	feed.WithError("error")
+	feed.ApplyErrorBackoff()
+
+	if feed.Disabled {
+		t.Error(`The feed should not be disabled before two weeks`)
+	}
+
+	failingSince = time.Now().Add(-15 * 24 * time.Hour)
+	feed.ApplyErrorBackoff()
+
+	if !feed.Disabled {
+		t.Error(`The feed should be disabled after two weeks of errors`)
+	}
+}
+
+func TestFeedWithPermanentRedirect(t *testing.T) {
+	feed := &Feed{FeedURL: "http://example.org/feed.xml"}
+
+	for i := 1; i < PermanentRedirectThreshold; i++ {
+		if feed.WithPermanentRedirect("https://example.org/feed.xml") {
+			t.Fatalf(`The feed URL should not be updated after %d redirects`, i)
+		}
+	}
+
+	if !feed.WithPermanentRedirect("https://example.org/feed.xml") {
+		t.Fatal(`The feed URL should be updated`)
+	}
+
+	if feed.FeedURL != "https://example.org/feed.xml" {
+		t.Errorf(`Unexpected feed URL, got %q`, feed.FeedURL)
+	}
+
+	if feed.PreviousFeedURL != "http://example.org/feed.xml" {
+		t.Errorf(`Unexpected previous feed URL, got %q`, feed.PreviousFeedURL)
+	}
+
+	if feed.FeedURLUpdatedAt == nil {
+		t.Error(`The update date must be set`)
+	}
+
+	if feed.PermanentRedirectCount != 0 {
+		t.Errorf(`The redirect counter should be reset, got %d`, feed.PermanentRedirectCount)
+	}
+}
+
+func TestFeedWithInterruptedPermanentRedirects(t *testing.T) {
+	feed := &Feed{FeedURL: "http://example.org/feed.xml"}
+	feed.WithPermanentRedirect("https://example.org/feed.xml")
+	feed.WithPermanentRedirect("")
+
+	if feed.PermanentRedirectCount != 0 {
+		t.Fatalf(`The redirect counter should be reset, got %d`, feed.PermanentRedirectCount)
+	}
+
+	for i := 1; i < PermanentRedirectThreshold; i++ {
+		feed.WithPermanentRedirect("https://example.org/feed.xml")
+	}
+
+	if feed.FeedURL != "http://example.org/feed.xml" {
+		t.Errorf(`The feed URL should not be updated, got %q`, feed.FeedURL)
+	}
+}
+
+func TestFeedMarkAsDead(t *testing.T) {
+	feed := &Feed{}
+	feed.MarkAsDead("gone")
+
+	if !feed.Dead || feed.ParsingErrorMsg != "gone" {
+		t.Fatal(`The feed should be marked as dead`)
+	}
+
+	if feed.ParsingErrorCount != 0 {
+		t.Error(`A dead feed should not increment the error counter`)
+	}
+
+	feed.ResetErrorCounter()
+	if feed.Dead {
+		t.Error(`The feed should be alive again`)
+	}
+}
+
+func TestFeedMarkAsBlocked(t *testing.T) {
+	feed := &Feed{}
+	feed.MarkAsBlocked("blocked")
+
+	if !feed.Disabled || feed.ParsingErrorMsg != "blocked" {
+		t.Fatal(`The feed should be disabled`)
+	}
+
+	if feed.Dead || feed.ParsingErrorCount != 0 {
+		t.Error(`A blocked feed should not be considered as failing`)
+	}
+}
+
+func TestFeedWithSelfURL(t *testing.T) {
+	scenarios := map[string]string{
+		"":                             "",
+		"http://example.org/feed.xml":  "",
+		"/feed.xml":                    "",
+		"ftp://example.org/feed.xml":   "",
+		"https://example.org/feed.xml": "https://example.org/feed.xml",
+	}
+
+	for input, expected := range scenarios {
+		feed := &Feed{FeedURL: "http://example.org/feed.xml", CanonicalFeedURL: "https://example.org/old.xml"}
+		feed.WithSelfURL(input)
+
+		if feed.CanonicalFeedURL != expected {
+			t.Errorf(`Unexpected canonical URL for %q, got %q instead of %q`, input, feed.CanonicalFeedURL, expected)
+		}
+	}
+}
+
+func TestFeedApplyCanonicalURL(t *testing.T) {
+	feed := &Feed{FeedURL: "http://example.org/feed.xml", EtagHeader: "1234"}
+	if feed.ApplyCanonicalURL() {
+		t.Fatal(`There is no canonical URL to apply`)
+	}
+
+	feed.WithSelfURL("https://example.org/feed.xml")
+	if !feed.ApplyCanonicalURL() {
+		t.Fatal(`The canonical URL should be applied`)
+	}
+
+	if feed.FeedURL != "https://example.org/feed.xml" || feed.PreviousFeedURL != "http://example.org/feed.xml" {
+		t.Errorf(`Unexpected feed URLs, got %q and %q`, feed.FeedURL, feed.PreviousFeedURL)
+	}
+
+	if feed.CanonicalFeedURL != "" {
+		t.Error(`The canonical URL should be cleared`)
+	}
+
+	if feed.EtagHeader != "1234" {
+		t.Error(`The caching headers should be kept`)
+	}
+}
+
+func TestFeedWithResponseMetadata(t *testing.T) {
+	feed := &Feed{}
+	feed.WithResponseMetadata(&client.Response{
+		EffectiveURL:  "https://example.org/feed.xml",
+		ContentType:   "text/html",
+		ContentLength: -1,
+		BodySize:      42,
+	})
+
+	if feed.LastFetchURL != "https://example.org/feed.xml" {
+		t.Errorf(`Unexpected final URL, got %q`, feed.LastFetchURL)
+	}
+
+	if feed.LastFetchContentType != "text/html" {
+		t.Errorf(`Unexpected content type, got %q`, feed.LastFetchContentType)
+	}
+
+	if feed.LastFetchSize != 42 {
+		t.Errorf(`Unexpected body size, got %d`, feed.LastFetchSize)
+	}
+}
+
+func TestFeedsDormant(t *testing.T) {
+	feeds := Feeds{{ID: 1}, {ID: 2, Dormant: true}, {ID: 3}}
+
+	dormantFeeds := feeds.Dormant()
+	if len(dormantFeeds) != 1 || dormantFeeds[0].ID != 2 {
+		t.Errorf(`Unexpected dormant feeds: %v`, dormantFeeds)
+	}
+}
+
+func TestValidateRequestLimits(t *testing.T) {
+	scenarios := []struct {
+		requestTimeout int
+		maxBodySize    int
+		valid          bool
+	}{
+		{0, 0, true},
+		{config.MaxHTTPClientTimeout, config.MaxHTTPClientMaxBodySize, true},
+		{config.MaxHTTPClientTimeout + 1, 0, false},
+		{0, config.MaxHTTPClientMaxBodySize + 1, false},
+		{-1, 0, false},
+		{0, -1, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := ValidateRequestLimits(scenario.requestTimeout, scenario.maxBodySize)
+		if scenario.valid && err != nil {
+			t.Errorf(`The limits %d/%d should be valid: %v`, scenario.requestTimeout, scenario.maxBodySize, err)
+		}
+
+		if !scenario.valid && err == nil {
+			t.Errorf(`The limits %d/%d should be invalid`, scenario.requestTimeout, scenario.maxBodySize)
+		}
+	}
+}
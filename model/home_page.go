@@ -0,0 +1,101 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"strconv"
+	"strings"
+
+	"miniflux.app/errors"
+)
+
+// Pages that can be opened after login, a category, a search query or a saved search are given after a colon,
+// for example "category:42", "search:golang" or "saved_search:7".
+const (
+	HomePageUnread      = "unread"
+	HomePageToday       = "today"
+	HomePageStarred     = "starred"
+	HomePageHistory     = "history"
+	HomePageTrending    = "trending"
+	HomePageFeeds       = "feeds"
+	HomePageCategories  = "categories"
+	HomePageCategory    = "category"
+	HomePageSearch      = "search"
+	HomePageSavedSearch = "saved_search"
+)
+
+// DefaultHomePage is used when the user did not choose another page.
+const DefaultHomePage = HomePageUnread
+
+// HomePages returns the pages that do not need any argument.
+func HomePages() []string {
+	return []string{
+		HomePageUnread,
+		HomePageToday,
+		HomePageStarred,
+		HomePageHistory,
+		HomePageTrending,
+		HomePageFeeds,
+		HomePageCategories,
+	}
+}
+
+// ParseHomePage splits the home page setting into the page name and its argument.
+func ParseHomePage(homePage string) (page, argument string) {
+	parts := strings.SplitN(homePage, ":", 2)
+	if len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+
+	return parts[0], ""
+}
+
+// HomePageCategoryID returns the category of a "category:ID" home page.
+func HomePageCategoryID(homePage string) int64 {
+	return homePageID(homePage, HomePageCategory)
+}
+
+// HomePageSavedSearchID returns the saved search of a "saved_search:ID" home page.
+func HomePageSavedSearchID(homePage string) int64 {
+	return homePageID(homePage, HomePageSavedSearch)
+}
+
+func homePageID(homePage, expectedPage string) int64 {
+	page, argument := ParseHomePage(homePage)
+	if page != expectedPage {
+		return 0
+	}
+
+	id, _ := strconv.ParseInt(argument, 10, 64)
+	return id
+}
+
+// ValidateHomePage makes sure the home page setting is valid.
+func ValidateHomePage(homePage string) error {
+	page, argument := ParseHomePage(homePage)
+
+	switch page {
+	case HomePageCategory:
+		if HomePageCategoryID(homePage) > 0 {
+			return nil
+		}
+	case HomePageSavedSearch:
+		if HomePageSavedSearchID(homePage) > 0 {
+			return nil
+		}
+	case HomePageSearch:
+		if strings.TrimSpace(argument) != "" {
+			return nil
+		}
+	default:
+		for _, value := range HomePages() {
+			if value == homePage {
+				return nil
+			}
+		}
+	}
+
+	return errors.NewLocalizedError("Invalid home page")
+}
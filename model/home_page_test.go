@@ -0,0 +1,44 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateHomePage(t *testing.T) {
+	for _, homePage := range []string{"unread", "today", "starred", "categories", "category:42", "search:golang news", "saved_search:7"} {
+		if err := ValidateHomePage(homePage); err != nil {
+			t.Errorf(`The home page %q should be valid: %v`, homePage, err)
+		}
+	}
+
+	for _, homePage := range []string{"", "settings", "category:", "category:abc", "category:-1", "search:", "search:  ", "saved_search:", "saved_search:abc"} {
+		if err := ValidateHomePage(homePage); err == nil {
+			t.Errorf(`The home page %q should be invalid`, homePage)
+		}
+	}
+}
+
+func TestParseHomePage(t *testing.T) {
+	page, argument := ParseHomePage("search:tag:go")
+	if page != "search" || argument != "tag:go" {
+		t.Errorf(`Unexpected result: %q %q`, page, argument)
+	}
+
+	if categoryID := HomePageCategoryID("category:42"); categoryID != 42 {
+		t.Errorf(`Unexpected category ID: %d`, categoryID)
+	}
+
+	if categoryID := HomePageCategoryID("starred"); categoryID != 0 {
+		t.Errorf(`Unexpected category ID: %d`, categoryID)
+	}
+
+	if savedSearchID := HomePageSavedSearchID("saved_search:7"); savedSearchID != 7 {
+		t.Errorf(`Unexpected saved search ID: %d`, savedSearchID)
+	}
+
+	if savedSearchID := HomePageSavedSearchID("category:7"); savedSearchID != 0 {
+		t.Errorf(`Unexpected saved search ID: %d`, savedSearchID)
+	}
+}
@@ -4,30 +4,204 @@
 
 package model // import "miniflux.app/model"
 
+import (
+	"crypto/md5"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
 // Integration represents user integration settings.
 type Integration struct {
-	UserID               int64
-	PinboardEnabled      bool
-	PinboardToken        string
-	PinboardTags         string
-	PinboardMarkAsUnread bool
-	InstapaperEnabled    bool
-	InstapaperUsername   string
-	InstapaperPassword   string
-	FeverEnabled         bool
-	FeverUsername        string
-	FeverPassword        string
-	FeverToken           string
-	WallabagEnabled      bool
-	WallabagURL          string
-	WallabagClientID     string
-	WallabagClientSecret string
-	WallabagUsername     string
-	WallabagPassword     string
-	NunuxKeeperEnabled   bool
-	NunuxKeeperURL       string
-	NunuxKeeperAPIKey    string
-	PocketEnabled        bool
-	PocketAccessToken    string
-	PocketConsumerKey    string
+	UserID                 int64  `json:"user_id"`
+	PinboardEnabled        bool   `json:"pinboard_enabled"`
+	PinboardToken          string `json:"pinboard_token"`
+	PinboardTags           string `json:"pinboard_tags"`
+	PinboardMarkAsUnread   bool   `json:"pinboard_mark_as_unread"`
+	InstapaperEnabled      bool   `json:"instapaper_enabled"`
+	InstapaperUsername     string `json:"instapaper_username"`
+	InstapaperPassword     string `json:"instapaper_password"`
+	FeverEnabled           bool   `json:"fever_enabled"`
+	FeverUsername          string `json:"fever_username"`
+	FeverPassword          string `json:"fever_password"`
+	FeverToken             string `json:"-"`
+	WallabagEnabled        bool   `json:"wallabag_enabled"`
+	WallabagURL            string `json:"wallabag_url"`
+	WallabagClientID       string `json:"wallabag_client_id"`
+	WallabagClientSecret   string `json:"wallabag_client_secret"`
+	WallabagUsername       string `json:"wallabag_username"`
+	WallabagPassword       string `json:"wallabag_password"`
+	NunuxKeeperEnabled     bool   `json:"nunux_keeper_enabled"`
+	NunuxKeeperURL         string `json:"nunux_keeper_url"`
+	NunuxKeeperAPIKey      string `json:"nunux_keeper_api_key"`
+	PocketEnabled          bool   `json:"pocket_enabled"`
+	PocketAccessToken      string `json:"pocket_access_token"`
+	PocketConsumerKey      string `json:"pocket_consumer_key"`
+	RSSBridgeEnabled       bool   `json:"rssbridge_enabled"`
+	RSSBridgeURL           string `json:"rssbridge_url"`
+	PinboardCategoryTag    bool   `json:"pinboard_category_tag"`
+	PinboardEntryTags      bool   `json:"pinboard_entry_tags"`
+	PinboardPromptTags     bool   `json:"pinboard_prompt_tags"`
+	WallabagTags           string `json:"wallabag_tags"`
+	WallabagArchive        bool   `json:"wallabag_archive"`
+	InstapaperFolderID     string `json:"instapaper_folder_id"`
+	PocketCategoryTag      bool   `json:"pocket_category_tag"`
+	CustomBookmarkEnabled  bool   `json:"custom_bookmark_enabled"`
+	CustomBookmarkURL      string `json:"custom_bookmark_url"`
+	CustomBookmarkMethod   string `json:"custom_bookmark_method"`
+	CustomBookmarkHeaders  string `json:"custom_bookmark_headers"`
+	CustomBookmarkBody     string `json:"custom_bookmark_body"`
+	MarkdownEnabled        bool   `json:"markdown_enabled"`
+	MarkdownWebDAVURL      string `json:"markdown_webdav_url"`
+	MarkdownWebDAVUsername string `json:"markdown_webdav_username"`
+	MarkdownWebDAVPassword string `json:"markdown_webdav_password"`
+	MarkdownStarred        bool   `json:"markdown_starred"`
+	HypothesisEnabled      bool   `json:"hypothesis_enabled"`
+	HypothesisToken        string `json:"hypothesis_token"`
+	HypothesisGroup        string `json:"hypothesis_group"`
+	ZoteroEnabled          bool   `json:"zotero_enabled"`
+	ZoteroLibraryType      string `json:"zotero_library_type"`
+	ZoteroLibraryID        string `json:"zotero_library_id"`
+	ZoteroAPIKey           string `json:"zotero_api_key"`
+	ZoteroCollectionKey    string `json:"zotero_collection_key"`
+	KarakeepEnabled        bool   `json:"karakeep_enabled"`
+	KarakeepURL            string `json:"karakeep_url"`
+	KarakeepAPIKey         string `json:"karakeep_api_key"`
+	KarakeepTags           string `json:"karakeep_tags"`
+	EspialEnabled          bool   `json:"espial_enabled"`
+	EspialURL              string `json:"espial_url"`
+	EspialAPIKey           string `json:"espial_api_key"`
+	EspialTags             string `json:"espial_tags"`
+	LinkAceEnabled         bool   `json:"linkace_enabled"`
+	LinkAceURL             string `json:"linkace_url"`
+	LinkAceAPIKey          string `json:"linkace_api_key"`
+	LinkAceTags            string `json:"linkace_tags"`
+	LinkAceLists           string `json:"linkace_lists"`
+	LinkAceCategoryTag     bool   `json:"linkace_category_tag"`
+	LinkAceIsPrivate       bool   `json:"linkace_is_private"`
+	WaybackEnabled         bool   `json:"wayback_enabled"`
+	ArchiveBoxEnabled      bool   `json:"archivebox_enabled"`
+	ArchiveBoxURL          string `json:"archivebox_url"`
+	ArchiveBoxAPIKey       string `json:"archivebox_api_key"`
+	ArchiveBoxTags         string `json:"archivebox_tags"`
+	NtfyEnabled            bool   `json:"ntfy_enabled"`
+	NtfyTopicURL           string `json:"ntfy_topic_url"`
+	NtfyToken              string `json:"ntfy_token"`
+
+	// InstapaperFolders are fetched when the integration is saved, to avoid calling Instapaper for each page view.
+	InstapaperFolders InstapaperFolders `json:"-"`
+
+	// CategoryRoutes restricts services to some categories, services which are not in the map receive every entry.
+	// A restricted service without category receives nothing.
+	CategoryRoutes map[string][]int64 `json:"category_routes"`
+}
+
+// InstapaperFolder represents a folder of the Instapaper account.
+type InstapaperFolder struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+// InstapaperFolders represents a list of Instapaper folders.
+type InstapaperFolders []*InstapaperFolder
+
+// Value converts the folders to JSON.
+func (f InstapaperFolders) Value() (driver.Value, error) {
+	if f == nil {
+		f = InstapaperFolders{}
+	}
+	return json.Marshal(f)
+}
+
+// Scan converts raw JSON data.
+func (f *InstapaperFolders) Scan(src interface{}) error {
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("integration: unable to assert type of src")
+	}
+
+	if err := json.Unmarshal(source, f); err != nil {
+		return fmt.Errorf("integration: %v", err)
+	}
+
+	return nil
+}
+
+// RoutableService is a service that can be restricted to some categories, the name is a brand or a translation key.
+type RoutableService struct {
+	Key  string
+	Name string
+}
+
+// RoutableServices are the services that can be restricted to some categories.
+var RoutableServices = []*RoutableService{
+	{"pinboard", "Pinboard"},
+	{"instapaper", "Instapaper"},
+	{"pocket", "Pocket"},
+	{"wallabag", "Wallabag"},
+	{"nunux_keeper", "Nunux Keeper"},
+	{"karakeep", "Karakeep"},
+	{"espial", "Espial"},
+	{"linkace", "LinkAce"},
+	{"custom_bookmark", "form.integration.custom_bookmark"},
+	{"markdown", "form.integration.markdown"},
+	{"zotero", "Zotero"},
+}
+
+// UpdateFeverToken computes the token used by Fever clients from the credentials.
+func (i *Integration) UpdateFeverToken() {
+	if i.FeverEnabled {
+		i.FeverToken = fmt.Sprintf("%x", md5.Sum([]byte(i.FeverUsername+":"+i.FeverPassword)))
+	} else {
+		i.FeverToken = ""
+	}
+}
+
+// AcceptsCategory returns true if the entries of the category can be sent to the service.
+func (i *Integration) AcceptsCategory(service string, categoryID int64) bool {
+	categoryIDs, restricted := i.CategoryRoutes[service]
+	if !restricted {
+		return true
+	}
+
+	for _, id := range categoryIDs {
+		if id == categoryID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EnabledServices returns the name of the third-party services enabled by the user.
+func (i *Integration) EnabledServices() []string {
+	services := make([]string, 0)
+	for name, enabled := range map[string]bool{
+		"custom_bookmark": i.CustomBookmarkEnabled,
+		"espial":          i.EspialEnabled,
+		"archivebox":      i.ArchiveBoxEnabled,
+		"fever":           i.FeverEnabled,
+		"hypothesis":      i.HypothesisEnabled,
+		"instapaper":      i.InstapaperEnabled,
+		"karakeep":        i.KarakeepEnabled,
+		"linkace":         i.LinkAceEnabled,
+		"markdown":        i.MarkdownEnabled,
+		"ntfy":            i.NtfyEnabled,
+		"nunux_keeper":    i.NunuxKeeperEnabled,
+		"pinboard":        i.PinboardEnabled,
+		"pocket":          i.PocketEnabled,
+		"rssbridge":       i.RSSBridgeEnabled,
+		"wallabag":        i.WallabagEnabled,
+		"wayback":         i.WaybackEnabled,
+		"zotero":          i.ZoteroEnabled,
+	} {
+		if enabled {
+			services = append(services, name)
+		}
+	}
+
+	sort.Strings(services)
+	return services
 }
@@ -0,0 +1,52 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestIntegrationAcceptsCategory(t *testing.T) {
+	integration := &Integration{
+		CategoryRoutes: map[string][]int64{
+			"pinboard": {1, 2},
+			"pocket":   {},
+		},
+	}
+
+	if !integration.AcceptsCategory("pinboard", 2) {
+		t.Error(`Pinboard should receive the entries of the category #2`)
+	}
+
+	if integration.AcceptsCategory("pinboard", 3) {
+		t.Error(`Pinboard should not receive the entries of the category #3`)
+	}
+
+	if integration.AcceptsCategory("pocket", 3) {
+		t.Error(`A restricted service without category should not receive any entry`)
+	}
+
+	if !integration.AcceptsCategory("wallabag", 3) {
+		t.Error(`Services without routes should receive every entry`)
+	}
+}
+
+func TestInstapaperFoldersValueAndScan(t *testing.T) {
+	value, err := InstapaperFolders(nil).Value()
+	if err != nil || string(value.([]byte)) != "[]" {
+		t.Fatalf(`Unexpected value for an empty list: %v %v`, value, err)
+	}
+
+	var folders InstapaperFolders
+	if err := folders.Scan([]byte(`[{"id": 42, "title": "Later"}]`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(folders) != 1 || folders[0].ID != 42 || folders[0].Title != "Later" {
+		t.Errorf(`Unexpected folders: %+v`, folders)
+	}
+
+	if err := folders.Scan("invalid"); err == nil {
+		t.Error(`An invalid source should generate an error`)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Keywords is a list of compiled keywords, they are parsed once and matched against many entries.
+type Keywords []*regexp.Regexp
+
+// ParseKeywords compiles the list of keywords, it contains one regular expression per line, matched case insensitively.
+// Invalid regular expressions are ignored.
+func ParseKeywords(keywords string) Keywords {
+	var regexes Keywords
+	for _, pattern := range splitKeywords(keywords) {
+		if regex, err := regexp.Compile("(?i)" + pattern); err == nil {
+			regexes = append(regexes, regex)
+		}
+	}
+
+	return regexes
+}
+
+// Match returns true if the entry title or content matches one of the keywords.
+func (k Keywords) Match(entry *Entry) bool {
+	for _, regex := range k {
+		if regex.MatchString(entry.Title) || regex.MatchString(entry.Content) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateKeywords makes sure each line of the list is a valid regular expression.
+func ValidateKeywords(keywords string) error {
+	for _, pattern := range splitKeywords(keywords) {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf(`Invalid regular expression %q: %v`, pattern, err)
+		}
+	}
+
+	return nil
+}
+
+func splitKeywords(keywords string) []string {
+	var patterns []string
+	for _, line := range strings.Split(keywords, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			patterns = append(patterns, line)
+		}
+	}
+
+	return patterns
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestParseAndMatchKeywords(t *testing.T) {
+	keywords := ParseKeywords("golang\n\n  postgres(ql)?  \n(invalid")
+	if len(keywords) != 2 {
+		t.Fatalf(`Invalid and empty lines should be ignored, got %d keywords`, len(keywords))
+	}
+
+	if !keywords.Match(&Entry{Title: "What's new in GoLang"}) {
+		t.Error(`The title should match case insensitively`)
+	}
+
+	if !keywords.Match(&Entry{Title: "Databases", Content: "<p>PostgreSQL 13 is out</p>"}) {
+		t.Error(`The content should match`)
+	}
+
+	if keywords.Match(&Entry{Title: "Rust", Content: "Nothing to see"}) {
+		t.Error(`The entry should not match`)
+	}
+
+	if ParseKeywords("").Match(&Entry{Title: "Anything"}) {
+		t.Error(`An empty list should not match`)
+	}
+}
+
+func TestValidateKeywords(t *testing.T) {
+	if err := ValidateKeywords("golang\npostgres(ql)?"); err != nil {
+		t.Errorf(`Valid keywords should not generate any errors: %v`, err)
+	}
+
+	if err := ValidateKeywords("golang\n(invalid"); err == nil {
+		t.Error(`An invalid regex should generate an error`)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"miniflux.app/timezone"
+)
+
+// Actions applied to entries matching a muted keyword.
+const (
+	MutedKeywordActionDrop = "drop"
+	MutedKeywordActionRead = "read"
+)
+
+// MutedKeyword represents a keyword or a regex used to hide entries of all feeds.
+type MutedKeyword struct {
+	ID        int64      `json:"id"`
+	UserID    int64      `json:"user_id"`
+	Pattern   string     `json:"pattern"`
+	Action    string     `json:"action"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+
+	regex *regexp.Regexp
+}
+
+// IsExpired returns true if the mute is not applied anymore.
+func (m *MutedKeyword) IsExpired() bool {
+	return m.ExpiresAt != nil && m.ExpiresAt.Before(time.Now())
+}
+
+// Match returns true if the entry title or content matches the pattern, case insensitively.
+func (m *MutedKeyword) Match(entry *Entry) bool {
+	if m.regex == nil {
+		regex, err := regexp.Compile("(?i)" + m.Pattern)
+		if err != nil {
+			return false
+		}
+		m.regex = regex
+	}
+
+	return m.regex.MatchString(entry.Title) || m.regex.MatchString(entry.Content)
+}
+
+// UseTimezone converts the expiration date to the given timezone.
+func (m *MutedKeyword) UseTimezone(tz string) {
+	if m.ExpiresAt != nil {
+		*m.ExpiresAt = timezone.Convert(tz, *m.ExpiresAt)
+	}
+}
+
+// ValidateMutedKeyword validates the pattern and the action of a muted keyword.
+func ValidateMutedKeyword(pattern, action string) error {
+	if pattern == "" {
+		return fmt.Errorf(`The pattern is mandatory`)
+	}
+
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf(`Invalid regular expression: %v`, err)
+	}
+
+	switch action {
+	case MutedKeywordActionDrop, MutedKeywordActionRead:
+		return nil
+	default:
+		return fmt.Errorf(`Invalid action: %q`, action)
+	}
+}
+
+// MutedKeywords represents a list of muted keywords.
+type MutedKeywords []*MutedKeyword
+
+// UseTimezone converts the expiration date of all muted keywords to the given timezone.
+func (m MutedKeywords) UseTimezone(tz string) {
+	for _, mutedKeyword := range m {
+		mutedKeyword.UseTimezone(tz)
+	}
+}
+
+// Match returns the first active muted keyword matching the entry.
+func (m MutedKeywords) Match(entry *Entry) *MutedKeyword {
+	for _, mutedKeyword := range m {
+		if !mutedKeyword.IsExpired() && mutedKeyword.Match(entry) {
+			return mutedKeyword
+		}
+	}
+
+	return nil
+}
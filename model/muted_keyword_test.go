@@ -0,0 +1,58 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMutedKeywordMatch(t *testing.T) {
+	mutedKeyword := &MutedKeyword{Pattern: `game of thrones|GoT\b`}
+
+	if !mutedKeyword.Match(&Entry{Title: "Game of Thrones: the finale"}) {
+		t.Error(`The title should match case insensitively`)
+	}
+
+	if !mutedKeyword.Match(&Entry{Title: "Review", Content: "<p>Spoilers about GoT</p>"}) {
+		t.Error(`The content should match`)
+	}
+
+	if mutedKeyword.Match(&Entry{Title: "Gotham", Content: "Nothing to see"}) {
+		t.Error(`The entry should not match`)
+	}
+}
+
+func TestMutedKeywordsMatchIgnoresExpiredKeywords(t *testing.T) {
+	yesterday := time.Now().Add(-24 * time.Hour)
+	tomorrow := time.Now().Add(24 * time.Hour)
+
+	mutedKeywords := MutedKeywords{
+		&MutedKeyword{Pattern: "election", Action: MutedKeywordActionDrop, ExpiresAt: &yesterday},
+		&MutedKeyword{Pattern: "world cup", Action: MutedKeywordActionRead, ExpiresAt: &tomorrow},
+	}
+
+	if mutedKeywords.Match(&Entry{Title: "Election results"}) != nil {
+		t.Error(`An expired keyword should not match`)
+	}
+
+	if mutedKeyword := mutedKeywords.Match(&Entry{Title: "World Cup final"}); mutedKeyword == nil || mutedKeyword.Action != MutedKeywordActionRead {
+		t.Errorf(`Unexpected muted keyword: %v`, mutedKeyword)
+	}
+}
+
+func TestValidateMutedKeyword(t *testing.T) {
+	if err := ValidateMutedKeyword("spoiler", MutedKeywordActionDrop); err != nil {
+		t.Errorf(`A valid muted keyword should not generate any errors: %v`, err)
+	}
+
+	if err := ValidateMutedKeyword("(invalid", MutedKeywordActionDrop); err == nil {
+		t.Error(`An invalid regex should generate an error`)
+	}
+
+	if err := ValidateMutedKeyword("spoiler", "delete"); err == nil {
+		t.Error(`An invalid action should generate an error`)
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// QueueItem represents an audio entry waiting in the playback queue of a user.
+type QueueItem struct {
+	EntryID   int64  `json:"entry_id"`
+	Position  int    `json:"position"`
+	Title     string `json:"title"`
+	FeedID    int64  `json:"feed_id"`
+	FeedTitle string `json:"feed_title"`
+	AudioURL  string `json:"audio_url"`
+	MimeType  string `json:"mime_type"`
+	Duration  int    `json:"duration"`
+}
+
+// PlaybackQueue represents the audio entries queued by a user, in playback order.
+type PlaybackQueue []*QueueItem
+
+// Duration returns the total duration of the queue in seconds.
+func (p PlaybackQueue) Duration() int {
+	duration := 0
+	for _, item := range p {
+		duration += item.Duration
+	}
+	return duration
+}
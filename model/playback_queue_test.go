@@ -0,0 +1,23 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestPlaybackQueueDuration(t *testing.T) {
+	queue := PlaybackQueue{
+		&QueueItem{EntryID: 1, Duration: 1800},
+		&QueueItem{EntryID: 2},
+		&QueueItem{EntryID: 3, Duration: 2700},
+	}
+
+	if duration := queue.Duration(); duration != 4500 {
+		t.Errorf(`Unexpected total duration: %d`, duration)
+	}
+
+	if duration := (PlaybackQueue{}).Duration(); duration != 0 {
+		t.Errorf(`An empty queue should have no duration, got %d`, duration)
+	}
+}
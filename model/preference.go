@@ -0,0 +1,54 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// Limits of the preferences stored by third-party clients.
+const (
+	MaxPreferencesPerUser  = 500
+	MaxPreferenceValueSize = 16 * 1024
+)
+
+var preferenceNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,63}$`)
+
+// Preferences holds the settings saved by a client in its namespace, values are JSON documents.
+type Preferences map[string]json.RawMessage
+
+// AllPreferences holds the preferences of a user grouped by namespace.
+type AllPreferences map[string]Preferences
+
+// ValidatePreferenceName checks that a namespace or a key is made of letters, digits, dots, dashes and underscores.
+func ValidatePreferenceName(name string) error {
+	if !preferenceNameRegex.MatchString(name) {
+		return fmt.Errorf(`Invalid preference name %q, only letters, digits, ".", "-" and "_" are allowed (64 characters maximum)`, name)
+	}
+
+	return nil
+}
+
+// Validate checks the keys and the size of the values, a null value removes the key.
+func (p Preferences) Validate() error {
+	for key, value := range p {
+		if err := ValidatePreferenceName(key); err != nil {
+			return err
+		}
+
+		if len(value) > MaxPreferenceValueSize {
+			return fmt.Errorf(`The value of the preference %q is larger than %d bytes`, key, MaxPreferenceValueSize)
+		}
+	}
+
+	return nil
+}
+
+// IsPreferenceRemoval returns true if the value asks to remove the key.
+func IsPreferenceRemoval(value json.RawMessage) bool {
+	return len(value) == 0 || string(value) == "null"
+}
@@ -0,0 +1,52 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestValidatePreferenceName(t *testing.T) {
+	for _, name := range []string{"reeder", "com.example.app", "font_size", "theme-2"} {
+		if err := ValidatePreferenceName(name); err != nil {
+			t.Errorf(`The name %q should be valid: %v`, name, err)
+		}
+	}
+
+	for _, name := range []string{"", ".hidden", "with space", "slash/name", strings.Repeat("a", 65)} {
+		if err := ValidatePreferenceName(name); err == nil {
+			t.Errorf(`The name %q should be invalid`, name)
+		}
+	}
+}
+
+func TestValidatePreferences(t *testing.T) {
+	preferences := Preferences{"font_size": json.RawMessage(`16`), "theme": json.RawMessage(`"dark"`), "removed": json.RawMessage(`null`)}
+	if err := preferences.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	preferences = Preferences{"large": json.RawMessage(`"` + strings.Repeat("a", MaxPreferenceValueSize) + `"`)}
+	if err := preferences.Validate(); err == nil {
+		t.Error(`Values larger than the limit should be rejected`)
+	}
+
+	preferences = Preferences{"invalid key": json.RawMessage(`true`)}
+	if err := preferences.Validate(); err == nil {
+		t.Error(`Invalid keys should be rejected`)
+	}
+}
+
+func TestIsPreferenceRemoval(t *testing.T) {
+	if !IsPreferenceRemoval(json.RawMessage(`null`)) {
+		t.Error(`A null value should remove the preference`)
+	}
+
+	if IsPreferenceRemoval(json.RawMessage(`false`)) {
+		t.Error(`A false value should be stored`)
+	}
+}
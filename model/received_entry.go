@@ -0,0 +1,49 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// MaxSentEntriesPerHour is the number of entries a user can send to other users within an hour.
+const MaxSentEntriesPerHour = 20
+
+// ReceivedEntry represents a copy of an entry sent by another user of the instance.
+type ReceivedEntry struct {
+	ID             int64     `json:"id"`
+	SenderID       int64     `json:"sender_id"`
+	SenderUsername string    `json:"sender_username"`
+	RecipientID    int64     `json:"recipient_id"`
+	Title          string    `json:"title"`
+	URL            string    `json:"url"`
+	Author         string    `json:"author"`
+	Content        string    `json:"content"`
+	FeedTitle      string    `json:"feed_title"`
+	Date           time.Time `json:"published_at"`
+	Message        string    `json:"message"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// NewReceivedEntry copies the entry so the recipient keeps it even if the sender removes the feed.
+func NewReceivedEntry(entry *Entry, recipientID int64, message string) *ReceivedEntry {
+	receivedEntry := &ReceivedEntry{
+		SenderID:    entry.UserID,
+		RecipientID: recipientID,
+		Title:       entry.Title,
+		URL:         entry.URL,
+		Author:      entry.Author,
+		Content:     entry.Content,
+		Date:        entry.Date,
+		Message:     message,
+	}
+
+	if entry.Feed != nil {
+		receivedEntry.FeedTitle = entry.Feed.DisplayTitle()
+	}
+
+	return receivedEntry
+}
+
+// ReceivedEntries represents a list of received entries.
+type ReceivedEntries []*ReceivedEntry
@@ -0,0 +1,35 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestNewReceivedEntry(t *testing.T) {
+	entry := &Entry{
+		UserID:  1,
+		Title:   "Title",
+		URL:     "https://example.org/article",
+		Content: "<p>Content</p>",
+		Feed:    &Feed{Title: "Feed", CustomTitle: "My Feed"},
+	}
+
+	receivedEntry := NewReceivedEntry(entry, 2, "Have a look")
+
+	if receivedEntry.SenderID != 1 || receivedEntry.RecipientID != 2 {
+		t.Errorf(`Unexpected users: %d -> %d`, receivedEntry.SenderID, receivedEntry.RecipientID)
+	}
+
+	if receivedEntry.Title != entry.Title || receivedEntry.URL != entry.URL || receivedEntry.Content != entry.Content {
+		t.Errorf(`The entry has not been copied: %+v`, receivedEntry)
+	}
+
+	if receivedEntry.FeedTitle != "My Feed" {
+		t.Errorf(`Unexpected feed title: %q`, receivedEntry.FeedTitle)
+	}
+
+	if receivedEntry.Message != "Have a look" {
+		t.Errorf(`Unexpected message: %q`, receivedEntry.Message)
+	}
+}
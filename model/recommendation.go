@@ -0,0 +1,22 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// FeedRecommendationMinSubscribers is the minimum number of users subscribed to a feed before recommending it.
+// It prevents revealing the subscriptions of a single user.
+const FeedRecommendationMinSubscribers = 3
+
+// FeedRecommendation represents a feed popular among other users, matched with one of the user categories.
+type FeedRecommendation struct {
+	FeedURL       string `json:"feed_url"`
+	SiteURL       string `json:"site_url"`
+	Title         string `json:"title"`
+	CategoryID    int64  `json:"category_id"`
+	CategoryTitle string `json:"category_title"`
+	Subscribers   int    `json:"subscribers"`
+}
+
+// FeedRecommendations represents a list of feed recommendations.
+type FeedRecommendations []*FeedRecommendation
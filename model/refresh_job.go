@@ -0,0 +1,28 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// Refresh job statuses
+const (
+	RefreshJobStatusPending = "pending"
+	RefreshJobStatusRunning = "running"
+	RefreshJobStatusDone    = "done"
+	RefreshJobStatusFailed  = "failed"
+)
+
+// RefreshJob represents a feed refresh running in the background.
+type RefreshJob struct {
+	ID         string     `json:"id"`
+	UserID     int64      `json:"user_id"`
+	FeedIDs    []int64    `json:"feed_ids"`
+	Status     string     `json:"status"`
+	Completed  int        `json:"completed"`
+	EntryCount int        `json:"entry_count"`
+	Errors     []string   `json:"errors"`
+	CreatedAt  time.Time  `json:"created_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"time"
+
+	"miniflux.app/timezone"
+)
+
+// DatabaseReport gives an overview of the database usage.
+type DatabaseReport struct {
+	DatabaseSize int64         `json:"database_size"`
+	StorageBytes int64         `json:"storage_bytes"`
+	FeedCount    int           `json:"feed_count"`
+	EntryCount   int           `json:"entry_count"`
+	Users        []*UserReport `json:"users"`
+}
+
+// UserReport represents the resources used by a user.
+// The storage size is the sum of the rows size of the user's entries and attachments.
+type UserReport struct {
+	UserID       int64      `json:"user_id"`
+	Username     string     `json:"username"`
+	IsAdmin      bool       `json:"is_admin"`
+	FeedCount    int        `json:"feed_count"`
+	EntryCount   int        `json:"entry_count"`
+	StorageBytes int64      `json:"storage_bytes"`
+	LastLoginAt  *time.Time `json:"last_login_at"`
+	LastSeenAt   *time.Time `json:"last_seen_at"`
+}
+
+// LastActivityAt returns the most recent date the user was active, or nil if the user never logged in.
+func (u *UserReport) LastActivityAt() *time.Time {
+	if u.LastSeenAt != nil && (u.LastLoginAt == nil || u.LastSeenAt.After(*u.LastLoginAt)) {
+		return u.LastSeenAt
+	}
+
+	return u.LastLoginAt
+}
+
+// Share returns the part of the total storage used by the user, in percent.
+func (r *DatabaseReport) Share(user *UserReport) int {
+	if r.StorageBytes == 0 {
+		return 0
+	}
+
+	return int(user.StorageBytes * 100 / r.StorageBytes)
+}
+
+// UseTimezone converts the activity dates to the given timezone.
+func (r *DatabaseReport) UseTimezone(tz string) {
+	for _, user := range r.Users {
+		for _, date := range []*time.Time{user.LastLoginAt, user.LastSeenAt} {
+			if date != nil {
+				*date = timezone.Convert(tz, *date)
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUserReportLastActivity(t *testing.T) {
+	report := &UserReport{}
+	if report.LastActivityAt() != nil {
+		t.Error(`A user who never logged in has no activity`)
+	}
+
+	lastLogin := time.Now().Add(-time.Hour)
+	report.LastLoginAt = &lastLogin
+	if report.LastActivityAt() != &lastLogin {
+		t.Error(`The last login should be used when the user was not seen since`)
+	}
+
+	lastSeen := time.Now()
+	report.LastSeenAt = &lastSeen
+	if report.LastActivityAt() != &lastSeen {
+		t.Error(`The most recent activity should be used`)
+	}
+}
+
+func TestDatabaseReportShare(t *testing.T) {
+	user := &UserReport{StorageBytes: 250}
+	report := &DatabaseReport{Users: []*UserReport{user}}
+
+	if share := report.Share(user); share != 0 {
+		t.Errorf(`An empty database should give a share of 0, got %d`, share)
+	}
+
+	report.StorageBytes = 1000
+	if share := report.Share(user); share != 25 {
+		t.Errorf(`Unexpected share: got %d instead of 25`, share)
+	}
+}
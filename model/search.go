@@ -0,0 +1,46 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// SearchHistoryMaxSize is the number of recent searches remembered for each user.
+const SearchHistoryMaxSize = 20
+
+// SearchQueryMaxLength is the maximum number of characters of a saved or remembered search query.
+const SearchQueryMaxLength = 255
+
+// SavedSearch represents a search query kept by the user, optionally limited to a feed or a category.
+type SavedSearch struct {
+	ID            int64     `json:"id"`
+	UserID        int64     `json:"user_id"`
+	Query         string    `json:"query"`
+	FeedID        int64     `json:"feed_id"`
+	CategoryID    int64     `json:"category_id"`
+	FeedTitle     string    `json:"feed_title,omitempty"`
+	CategoryTitle string    `json:"category_title,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// SavedSearches represents a list of saved searches.
+type SavedSearches []*SavedSearch
+
+// ValidateSearchQuery makes sure the search query can be saved.
+func ValidateSearchQuery(query string) error {
+	if query == "" {
+		return errors.New("the search query is empty")
+	}
+
+	if utf8.RuneCountInString(query) > SearchQueryMaxLength {
+		return fmt.Errorf("the search query is longer than %d characters", SearchQueryMaxLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"regexp/syntax"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Maximum repetition count of the PostgreSQL regular expressions.
+const maxSearchRegexRepeat = 255
+
+// SearchQuery represents a search once the qualifiers, the quoted phrases and the regular expressions are extracted.
+//
+// Supported syntax:
+//
+//	title:word, author:word, feed:word, tag:word  match a single field, quotes can be used for the value
+//	title:/regex/, author:/regex/                 match a field with a case-insensitive regular expression
+//	before:2006-01-02, after:2006-01-02           match the publication date
+//	"some phrase"                                 match words next to each other
+//	/regex/                                       match the title with a regular expression
+type SearchQuery struct {
+	Terms         []string
+	Phrases       []string
+	Regexes       []string
+	Titles        []string
+	TitleRegexes  []string
+	Authors       []string
+	AuthorRegexes []string
+	Feeds         []string
+	Tags          []string
+	Before        *time.Time
+	After         *time.Time
+}
+
+// IsEmpty returns true if the query has no criteria.
+func (s *SearchQuery) IsEmpty() bool {
+	return len(s.Terms) == 0 && len(s.Phrases) == 0 && len(s.Regexes) == 0 &&
+		len(s.Titles) == 0 && len(s.TitleRegexes) == 0 && len(s.Authors) == 0 && len(s.AuthorRegexes) == 0 &&
+		len(s.Feeds) == 0 && len(s.Tags) == 0 && s.Before == nil && s.After == nil
+}
+
+// FullText returns the words and phrases handled by the full-text index.
+func (s *SearchQuery) FullText() string {
+	return strings.Join(append(append([]string{}, s.Terms...), s.Phrases...), " ")
+}
+
+// ParseSearchQuery extracts the qualifiers of a search query, the remaining words are full-text terms.
+func ParseSearchQuery(query string) *SearchQuery {
+	searchQuery := &SearchQuery{}
+	for _, token := range tokenizeSearchQuery(query) {
+		if token.quoted {
+			searchQuery.Phrases = append(searchQuery.Phrases, token.value)
+			continue
+		}
+
+		if token.regex && isValidSearchRegex(token.value) {
+			searchQuery.Regexes = append(searchQuery.Regexes, token.value)
+			continue
+		}
+
+		if !searchQuery.addQualifier(token) {
+			searchQuery.Terms = append(searchQuery.Terms, token.raw)
+		}
+	}
+
+	return searchQuery
+}
+
+func (s *SearchQuery) addQualifier(token searchToken) bool {
+	if token.key == "" || token.value == "" {
+		return false
+	}
+
+	if token.valueRegex && !isValidSearchRegex(token.value) {
+		return false
+	}
+
+	switch token.key {
+	case "title":
+		if token.valueRegex {
+			s.TitleRegexes = append(s.TitleRegexes, token.value)
+		} else {
+			s.Titles = append(s.Titles, token.value)
+		}
+	case "author":
+		if token.valueRegex {
+			s.AuthorRegexes = append(s.AuthorRegexes, token.value)
+		} else {
+			s.Authors = append(s.Authors, token.value)
+		}
+	case "feed":
+		s.Feeds = append(s.Feeds, token.value)
+	case "tag":
+		s.Tags = append(s.Tags, token.value)
+	case "before", "after":
+		date, err := time.Parse("2006-01-02", token.value)
+		if err != nil {
+			return false
+		}
+
+		if token.key == "before" {
+			s.Before = &date
+		} else {
+			s.After = &date
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+// isValidSearchRegex accepts the patterns having the same meaning in Go and in PostgreSQL advanced regular expressions,
+// other patterns could make the database query fail: Perl classes like \pL, named groups, flags or large repetitions.
+func isValidSearchRegex(pattern string) bool {
+	tree, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil || !hasPortableRepeats(tree) {
+		return false
+	}
+
+	runes := []rune(pattern)
+	inBrackets := false
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\':
+			if i+1 == len(runes) || !isPortableEscape(runes[i+1], inBrackets) {
+				return false
+			}
+			i++
+		case inBrackets:
+			if runes[i] == ']' {
+				inBrackets = false
+			} else if runes[i] == '[' && i+2 < len(runes) && runes[i+1] == ':' && runes[i+2] == '^' {
+				return false
+			}
+		case runes[i] == '[':
+			inBrackets = true
+			// A closing bracket at the beginning of the list is a literal.
+			if i+1 < len(runes) && runes[i+1] == '^' {
+				i++
+			}
+			if i+1 < len(runes) && runes[i+1] == ']' {
+				i++
+			}
+		case runes[i] == '(' && i+1 < len(runes) && runes[i+1] == '?':
+			if i+2 == len(runes) || runes[i+2] != ':' {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func isPortableEscape(r rune, inBrackets bool) bool {
+	switch r {
+	case 'd', 's', 'w':
+		return true
+	case 'D', 'S', 'W':
+		return !inBrackets
+	}
+
+	return r < unicode.MaxASCII && (unicode.IsPunct(r) || unicode.IsSymbol(r))
+}
+
+func hasPortableRepeats(tree *syntax.Regexp) bool {
+	if tree.Op == syntax.OpRepeat && (tree.Min > maxSearchRegexRepeat || tree.Max > maxSearchRegexRepeat) {
+		return false
+	}
+
+	for _, sub := range tree.Sub {
+		if !hasPortableRepeats(sub) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type searchToken struct {
+	raw        string
+	key        string
+	value      string
+	quoted     bool
+	regex      bool
+	valueRegex bool
+}
+
+// tokenizeSearchQuery splits a query on spaces, except within quotes and slashes.
+func tokenizeSearchQuery(query string) []searchToken {
+	var tokens []searchToken
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+		if runes[i] == ' ' || runes[i] == '\t' {
+			i++
+			continue
+		}
+
+		if runes[i] == '"' || runes[i] == '/' {
+			if end := closingDelimiter(runes, i); end > i+1 {
+				tokens = append(tokens, searchToken{
+					raw:    string(runes[i : end+1]),
+					value:  string(runes[i+1 : end]),
+					quoted: runes[i] == '"',
+					regex:  runes[i] == '/',
+				})
+				i = end + 1
+				continue
+			}
+		}
+
+		start := i
+		token := searchToken{}
+		for i < len(runes) && runes[i] != ' ' && runes[i] != '\t' {
+			if runes[i] == ':' && token.key == "" && i > start {
+				token.key = strings.ToLower(string(runes[start:i]))
+				if i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '/') {
+					if end := closingDelimiter(runes, i+1); end > i+2 {
+						token.value = string(runes[i+2 : end])
+						token.valueRegex = runes[i+1] == '/'
+						i = end + 1
+						break
+					}
+				}
+
+				valueStart := i + 1
+				for i+1 < len(runes) && runes[i+1] != ' ' && runes[i+1] != '\t' {
+					i++
+				}
+				token.value = string(runes[valueStart : i+1])
+			}
+			i++
+		}
+
+		token.raw = string(runes[start:i])
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+// closingDelimiter returns the position of the delimiter closing the one at the given position, or -1.
+func closingDelimiter(runes []rune, start int) int {
+	for i := start + 1; i < len(runes); i++ {
+		if runes[i] == runes[start] && runes[i-1] != '\\' {
+			return i
+		}
+	}
+
+	return -1
+}
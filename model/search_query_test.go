@@ -0,0 +1,130 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSearchQueryWithPlainWords(t *testing.T) {
+	searchQuery := ParseSearchQuery("golang  release notes")
+
+	if !reflect.DeepEqual(searchQuery.Terms, []string{"golang", "release", "notes"}) {
+		t.Errorf(`Unexpected terms: %v`, searchQuery.Terms)
+	}
+
+	if searchQuery.FullText() != "golang release notes" {
+		t.Errorf(`Unexpected full-text query: %q`, searchQuery.FullText())
+	}
+}
+
+func TestParseSearchQueryWithQualifiers(t *testing.T) {
+	searchQuery := ParseSearchQuery(`title:"Go 1.16" author:Rob feed:blog tag:release database`)
+
+	if !reflect.DeepEqual(searchQuery.Titles, []string{"Go 1.16"}) {
+		t.Errorf(`Unexpected titles: %v`, searchQuery.Titles)
+	}
+
+	if !reflect.DeepEqual(searchQuery.Authors, []string{"Rob"}) {
+		t.Errorf(`Unexpected authors: %v`, searchQuery.Authors)
+	}
+
+	if !reflect.DeepEqual(searchQuery.Feeds, []string{"blog"}) {
+		t.Errorf(`Unexpected feeds: %v`, searchQuery.Feeds)
+	}
+
+	if !reflect.DeepEqual(searchQuery.Tags, []string{"release"}) {
+		t.Errorf(`Unexpected tags: %v`, searchQuery.Tags)
+	}
+
+	if !reflect.DeepEqual(searchQuery.Terms, []string{"database"}) {
+		t.Errorf(`Unexpected terms: %v`, searchQuery.Terms)
+	}
+}
+
+func TestParseSearchQueryWithPhrasesAndRegexes(t *testing.T) {
+	searchQuery := ParseSearchQuery(`"open source" /v[0-9]+\.[0-9]+/ title:/^release/ author:/smith$/`)
+
+	if !reflect.DeepEqual(searchQuery.Phrases, []string{"open source"}) {
+		t.Errorf(`Unexpected phrases: %v`, searchQuery.Phrases)
+	}
+
+	if !reflect.DeepEqual(searchQuery.Regexes, []string{`v[0-9]+\.[0-9]+`}) {
+		t.Errorf(`Unexpected regexes: %v`, searchQuery.Regexes)
+	}
+
+	if !reflect.DeepEqual(searchQuery.TitleRegexes, []string{"^release"}) {
+		t.Errorf(`Unexpected title regexes: %v`, searchQuery.TitleRegexes)
+	}
+
+	if !reflect.DeepEqual(searchQuery.AuthorRegexes, []string{"smith$"}) {
+		t.Errorf(`Unexpected author regexes: %v`, searchQuery.AuthorRegexes)
+	}
+
+	if len(searchQuery.Terms) != 0 {
+		t.Errorf(`There should be no terms: %v`, searchQuery.Terms)
+	}
+}
+
+func TestParseSearchQueryWithDates(t *testing.T) {
+	searchQuery := ParseSearchQuery("before:2021-03-01 after:2021-02-01")
+
+	if searchQuery.Before == nil || searchQuery.Before.Format("2006-01-02") != "2021-03-01" {
+		t.Errorf(`Unexpected before date: %v`, searchQuery.Before)
+	}
+
+	if searchQuery.After == nil || searchQuery.After.Format("2006-01-02") != "2021-02-01" {
+		t.Errorf(`Unexpected after date: %v`, searchQuery.After)
+	}
+}
+
+func TestParseSearchQueryKeepsInvalidQualifiersAsTerms(t *testing.T) {
+	searchQuery := ParseSearchQuery("before:yesterday https://example.org/ title: /[a-/")
+
+	expected := []string{"before:yesterday", "https://example.org/", "title:", "/[a-/"}
+	if !reflect.DeepEqual(searchQuery.Terms, expected) {
+		t.Errorf(`Unexpected terms: %v`, searchQuery.Terms)
+	}
+
+	if searchQuery.Before != nil || len(searchQuery.Regexes) != 0 {
+		t.Error(`Invalid qualifiers should be ignored`)
+	}
+}
+
+func TestSearchRegexMustWorkWithPostgres(t *testing.T) {
+	scenarios := map[string]bool{
+		`v[0-9]+\.[0-9]+`: true,
+		`^(?:go|rust)$`:   true,
+		`[[:alpha:]]+\d`:  true,
+		`[\w.-]+@`:        true,
+		`a{2,255}`:        true,
+		`\pL+`:            false,
+		`(?P<name>x)`:     false,
+		`(?i)release`:     false,
+		`\bword\b`:        false,
+		`[\D]`:            false,
+		`[[:^alpha:]]`:    false,
+		`a{256}`:          false,
+		`\Qa.b\E`:         false,
+		`x\z`:             false,
+	}
+
+	for pattern, expected := range scenarios {
+		if result := isValidSearchRegex(pattern); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, pattern, result, expected)
+		}
+	}
+}
+
+func TestParseSearchQueryEmpty(t *testing.T) {
+	if !ParseSearchQuery("   ").IsEmpty() {
+		t.Error(`A blank query should be empty`)
+	}
+
+	if ParseSearchQuery("tag:go").IsEmpty() {
+		t.Error(`A query with a qualifier should not be empty`)
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateSearchQuery(t *testing.T) {
+	for _, query := range []string{"golang", strings.Repeat("é", SearchQueryMaxLength)} {
+		if err := ValidateSearchQuery(query); err != nil {
+			t.Errorf(`The query %q should be valid: %v`, query, err)
+		}
+	}
+
+	for _, query := range []string{"", strings.Repeat("a", SearchQueryMaxLength+1)} {
+		if err := ValidateSearchQuery(query); err == nil {
+			t.Errorf(`The query %q should be invalid`, query)
+		}
+	}
+}
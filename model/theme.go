@@ -15,6 +15,7 @@ func Themes() map[string]string {
 		"dark_sans_serif":   "Dark - Sans Serif",
 		"system_serif":      "System - Serif",
 		"system_sans_serif": "System - Sans Serif",
+		"high_contrast":     "High Contrast",
 	}
 }
 
@@ -25,6 +26,8 @@ func ThemeColor(theme string) string {
 	switch theme {
 	case "dark_serif", "dark_sans_serif":
 		return "#222"
+	case "high_contrast":
+		return "#000"
 	default:
 		return "#fff"
 	}
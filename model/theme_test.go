@@ -7,7 +7,7 @@ package model // import "miniflux.app/model"
 import "testing"
 
 func TestValidateTheme(t *testing.T) {
-	for _, status := range []string{"light_serif", "dark_sans_serif", "system_serif"} {
+	for _, status := range []string{"light_serif", "dark_sans_serif", "system_serif", "high_contrast"} {
 		if err := ValidateTheme(status); err != nil {
 			t.Error(`A valid theme should not generate any error`)
 		}
@@ -17,3 +17,17 @@ func TestValidateTheme(t *testing.T) {
 		t.Error(`An invalid theme should generate a error`)
 	}
 }
+
+func TestThemeColor(t *testing.T) {
+	scenarios := map[string]string{
+		"light_serif":     "#fff",
+		"dark_sans_serif": "#222",
+		"high_contrast":   "#000",
+	}
+
+	for theme, expected := range scenarios {
+		if color := ThemeColor(theme); color != expected {
+			t.Errorf(`Unexpected color for %q, got %q instead of %q`, theme, color, expected)
+		}
+	}
+}
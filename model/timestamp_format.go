@@ -0,0 +1,29 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "miniflux.app/errors"
+
+// Formats of the timestamps displayed in the lists, the other format is shown on hover.
+const (
+	TimestampFormatRelative = "relative"
+	TimestampFormatAbsolute = "absolute"
+)
+
+// TimestampFormats returns the list of timestamp formats.
+func TimestampFormats() []string {
+	return []string{TimestampFormatRelative, TimestampFormatAbsolute}
+}
+
+// ValidateTimestampFormat makes sure the timestamp format is valid.
+func ValidateTimestampFormat(value string) error {
+	for _, format := range TimestampFormats() {
+		if value == format {
+			return nil
+		}
+	}
+
+	return errors.NewLocalizedError("Invalid timestamp format")
+}
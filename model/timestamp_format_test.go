@@ -0,0 +1,21 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateTimestampFormat(t *testing.T) {
+	for _, value := range []string{"relative", "absolute"} {
+		if err := ValidateTimestampFormat(value); err != nil {
+			t.Errorf(`The timestamp format %q should be valid: %v`, value, err)
+		}
+	}
+
+	for _, value := range []string{"", "iso", "Relative"} {
+		if err := ValidateTimestampFormat(value); err == nil {
+			t.Errorf(`The timestamp format %q should be invalid`, value)
+		}
+	}
+}
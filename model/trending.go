@@ -0,0 +1,40 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "sort"
+
+// TrendingTopic represents a cluster of related entries published by several feeds.
+type TrendingTopic struct {
+	Title   string  `json:"title"`
+	Score   int     `json:"score"`
+	Entries Entries `json:"entries"`
+}
+
+// TrendingTopics represents a list of trending topics.
+type TrendingTopics []*TrendingTopic
+
+// FindTrendingTopics groups entries sharing the same link or the same title.
+// Only clusters of entries coming from at least two different feeds are returned,
+// the score being the number of distinct feeds.
+func FindTrendingTopics(entries Entries) TrendingTopics {
+	topics := make(TrendingTopics, 0)
+	for _, cluster := range ClusterEntries(entries) {
+		feeds := make(map[int64]bool)
+		for _, entry := range cluster {
+			feeds[entry.FeedID] = true
+		}
+
+		if len(feeds) < 2 {
+			continue
+		}
+
+		sort.SliceStable(cluster, func(i, j int) bool { return cluster[i].Date.Before(cluster[j].Date) })
+		topics = append(topics, &TrendingTopic{Title: cluster[0].Title, Score: len(feeds), Entries: cluster})
+	}
+
+	sort.SliceStable(topics, func(i, j int) bool { return topics[i].Score > topics[j].Score })
+	return topics
+}
@@ -0,0 +1,47 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFindTrendingTopics(t *testing.T) {
+	now := time.Now()
+	entries := Entries{
+		&Entry{ID: 1, FeedID: 1, Title: "Go 1.16 is released", URL: "https://blog.golang.org/go1.16?utm_source=rss", Date: now},
+		&Entry{ID: 2, FeedID: 2, Title: "Go 1.16", URL: "http://www.blog.golang.org/go1.16/", Date: now.Add(-time.Hour)},
+		&Entry{ID: 3, FeedID: 3, Title: "Go 1.16 is released!", URL: "https://example.org/news/1", Date: now},
+		&Entry{ID: 4, FeedID: 1, Title: "Unrelated entry", URL: "https://example.org/unrelated", Date: now},
+		&Entry{ID: 5, FeedID: 1, Title: "Same feed", URL: "https://example.org/same", Date: now},
+		&Entry{ID: 6, FeedID: 1, Title: "Same feed again", URL: "https://example.org/same", Date: now},
+		&Entry{ID: 7, FeedID: 4, Title: "Another topic", URL: "https://example.org/topic", Date: now},
+		&Entry{ID: 8, FeedID: 5, Title: "Another topic", URL: "https://example.org/topic", Date: now},
+	}
+
+	topics := FindTrendingTopics(entries)
+	if len(topics) != 2 {
+		t.Fatalf(`Unexpected number of topics: %d`, len(topics))
+	}
+
+	if topics[0].Score != 3 || len(topics[0].Entries) != 3 {
+		t.Errorf(`Unexpected first topic: score=%d, entries=%d`, topics[0].Score, len(topics[0].Entries))
+	}
+
+	if topics[0].Title != "Go 1.16" {
+		t.Errorf(`The topic title should be the one of the oldest entry, got %q`, topics[0].Title)
+	}
+
+	if topics[1].Score != 2 || topics[1].Title != "Another topic" {
+		t.Errorf(`Unexpected second topic: score=%d, title=%q`, topics[1].Score, topics[1].Title)
+	}
+}
+
+func TestFindTrendingTopicsWithoutEntries(t *testing.T) {
+	if topics := FindTrendingTopics(nil); len(topics) != 0 {
+		t.Errorf(`No topics should be returned, got %d`, len(topics))
+	}
+}
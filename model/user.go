@@ -6,26 +6,46 @@ package model // import "miniflux.app/model"
 
 import (
 	"errors"
+	"net/url"
+	"strings"
 	"time"
 
+	"miniflux.app/config"
+	"miniflux.app/mail"
 	"miniflux.app/timezone"
 )
 
 // User represents a user in the system.
 type User struct {
-	ID                int64             `json:"id"`
-	Username          string            `json:"username"`
-	Password          string            `json:"password,omitempty"`
-	IsAdmin           bool              `json:"is_admin"`
-	Theme             string            `json:"theme"`
-	Language          string            `json:"language"`
-	Timezone          string            `json:"timezone"`
-	EntryDirection    string            `json:"entry_sorting_direction"`
-	EntriesPerPage    int               `json:"entries_per_page"`
-	KeyboardShortcuts bool              `json:"keyboard_shortcuts"`
-	ShowReadingTime	  bool              `json:"show_reading_time"`
-	LastLoginAt       *time.Time        `json:"last_login_at,omitempty"`
-	Extra             map[string]string `json:"extra"`
+	ID                        int64             `json:"id"`
+	Username                  string            `json:"username"`
+	Password                  string            `json:"password,omitempty"`
+	IsAdmin                   bool              `json:"is_admin"`
+	Theme                     string            `json:"theme"`
+	Language                  string            `json:"language"`
+	Timezone                  string            `json:"timezone"`
+	EntryDirection            string            `json:"entry_sorting_direction"`
+	EntriesPerPage            int               `json:"entries_per_page"`
+	KeyboardShortcuts         bool              `json:"keyboard_shortcuts"`
+	ShowReadingTime           bool              `json:"show_reading_time"`
+	MarkReadOnOriginalLink    bool              `json:"mark_read_on_original_link"`
+	YouTubeEmbedURL           string            `json:"youtube_embed_url"`
+	BlockedAuthors            string            `json:"blocked_authors"`
+	AutoStarKeywords          string            `json:"auto_star_keywords"`
+	AutoStarAuthors           string            `json:"auto_star_authors"`
+	EmailRecipients           string            `json:"email_recipients"`
+	HomePage                  string            `json:"home_page"`
+	EntryTimezone             string            `json:"entry_timezone"`
+	TimestampFormat           string            `json:"timestamp_format"`
+	NotificationEmail         string            `json:"notification_email"`
+	NotificationEmailVerified bool              `json:"notification_email_verified"`
+	KeepStarredEntries        bool              `json:"keep_starred_entries"`
+	ArchiveReadDays           int               `json:"archive_read_days"`
+	AcceptReceivedEntries     bool              `json:"accept_received_entries"`
+	LastLoginAt               *time.Time        `json:"last_login_at,omitempty"`
+	LastSeenAt                *time.Time        `json:"last_seen_at,omitempty"`
+	PreviousVisitAt           *time.Time        `json:"previous_visit_at,omitempty"`
+	Extra                     map[string]string `json:"extra"`
 }
 
 // NewUser returns a new User.
@@ -44,6 +64,48 @@ func (u User) ValidateUserCreation() error {
 
 // ValidateUserModification validates user modification payload.
 func (u User) ValidateUserModification() error {
+	if u.YouTubeEmbedURL != "" && !IsValidYouTubeEmbedURL(u.YouTubeEmbedURL) {
+		return errors.New("The YouTube embed URL is invalid")
+	}
+
+	if err := ValidateKeywords(u.AutoStarKeywords); err != nil {
+		return err
+	}
+
+	if _, err := mail.ParseRecipients(u.EmailRecipients); err != nil {
+		return errors.New("The email recipients are invalid")
+	}
+
+	if u.HomePage != "" {
+		if err := ValidateHomePage(u.HomePage); err != nil {
+			return err
+		}
+	}
+
+	if u.EntryTimezone != "" {
+		if err := ValidateEntryTimezone(u.EntryTimezone); err != nil {
+			return err
+		}
+	}
+
+	if u.TimestampFormat != "" {
+		if err := ValidateTimestampFormat(u.TimestampFormat); err != nil {
+			return err
+		}
+	}
+
+	if u.NotificationEmail != "" {
+		if recipients, err := mail.ParseRecipients(u.NotificationEmail); err != nil || len(recipients) != 1 {
+			return errors.New("The notification email is invalid")
+		}
+	}
+
+	if u.ArchiveReadDays != 0 {
+		if err := ValidateArchiveReadDays(u.ArchiveReadDays, config.Opts.CleanupArchiveReadDaysMax()); err != nil {
+			return errors.New("The number of days to keep read entries is invalid")
+		}
+	}
+
 	if u.Theme != "" {
 		return ValidateTheme(u.Theme)
 	}
@@ -77,10 +139,34 @@ func (u User) ValidatePassword() error {
 	return nil
 }
 
-// UseTimezone converts last login date to the given timezone.
+// IsValidYouTubeEmbedURL checks if the URL of an Invidious or Piped instance is usable to embed videos.
+func IsValidYouTubeEmbedURL(embedURL string) bool {
+	return NormalizeYouTubeEmbedURL(embedURL) != ""
+}
+
+// NormalizeYouTubeEmbedURL returns the URL of an Invidious or Piped instance as it is stored and embedded in entries.
+// An empty string is returned when the URL is not a plain HTTPS URL.
+func NormalizeYouTubeEmbedURL(embedURL string) string {
+	if strings.ContainsAny(embedURL, "\"'<>` \t\n\\") {
+		return ""
+	}
+
+	u, err := url.Parse(embedURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" || u.User != nil || u.Opaque != "" || u.RawQuery != "" || u.Fragment != "" {
+		return ""
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	return u.String()
+}
+
+// UseTimezone converts last login and visit dates to the given timezone.
 func (u *User) UseTimezone(tz string) {
-	if u.LastLoginAt != nil {
-		*u.LastLoginAt = timezone.Convert(tz, *u.LastLoginAt)
+	for _, date := range []*time.Time{u.LastLoginAt, u.LastSeenAt, u.PreviousVisitAt} {
+		if date != nil {
+			*date = timezone.Convert(tz, *date)
+		}
 	}
 }
 
@@ -58,4 +58,52 @@ func TestValidateUserModification(t *testing.T) {
 	if err := user.ValidateUserModification(); err == nil {
 		t.Error(`An invalid password should generate an error`)
 	}
+
+	user = &User{YouTubeEmbedURL: "https://yewtu.be"}
+	if err := user.ValidateUserModification(); err != nil {
+		t.Error(`A valid YouTube embed URL should not generate any errors`)
+	}
+
+	user = &User{YouTubeEmbedURL: "yewtu.be"}
+	if err := user.ValidateUserModification(); err == nil {
+		t.Error(`An invalid YouTube embed URL should generate an error`)
+	}
+
+	for _, embedURL := range []string{"http://yewtu.be", `https://yewtu.be/" onload="alert(1)`, "javascript://yewtu.be", "https://yewtu.be/?q=1"} {
+		user = &User{YouTubeEmbedURL: embedURL}
+		if err := user.ValidateUserModification(); err == nil {
+			t.Errorf(`The YouTube embed URL %q should generate an error`, embedURL)
+		}
+	}
+
+	user = &User{AutoStarKeywords: "golang\n(invalid"}
+	if err := user.ValidateUserModification(); err == nil {
+		t.Error(`An invalid auto-star keyword should generate an error`)
+	}
+
+	user = &User{NotificationEmail: "alice@example.org"}
+	if err := user.ValidateUserModification(); err != nil {
+		t.Error(`A valid notification email should not generate any errors`)
+	}
+
+	for _, email := range []string{"alice", "alice@example.org, bob@example.org"} {
+		user = &User{NotificationEmail: email}
+		if err := user.ValidateUserModification(); err == nil {
+			t.Errorf(`The notification email %q should generate an error`, email)
+		}
+	}
+}
+
+func TestNormalizeYouTubeEmbedURL(t *testing.T) {
+	if embedURL := NormalizeYouTubeEmbedURL("https://yewtu.be/"); embedURL != "https://yewtu.be" {
+		t.Errorf(`Unexpected normalized URL: %q`, embedURL)
+	}
+
+	if embedURL := NormalizeYouTubeEmbedURL("https://example.org/piped/"); embedURL != "https://example.org/piped" {
+		t.Errorf(`Unexpected normalized URL: %q`, embedURL)
+	}
+
+	if embedURL := NormalizeYouTubeEmbedURL(`https://yewtu.be/'onload='alert(1)`); embedURL != "" {
+		t.Errorf(`URLs with quotes should be rejected: %q`, embedURL)
+	}
 }
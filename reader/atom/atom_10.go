@@ -15,6 +15,7 @@ import (
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/date"
+	"miniflux.app/reader/georss"
 	"miniflux.app/reader/media"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/url"
@@ -64,15 +65,22 @@ func (a *atom10Feed) Transform() *model.Feed {
 }
 
 type atom10Entry struct {
-	ID        string     `xml:"id"`
-	Title     atom10Text `xml:"title"`
-	Published string     `xml:"published"`
-	Updated   string     `xml:"updated"`
-	Links     atomLinks  `xml:"link"`
-	Summary   atom10Text `xml:"summary"`
-	Content   atom10Text `xml:"http://www.w3.org/2005/Atom content"`
-	Author    atomPerson `xml:"author"`
+	ID         string           `xml:"id"`
+	Title      atom10Text       `xml:"title"`
+	Published  string           `xml:"published"`
+	Updated    string           `xml:"updated"`
+	Links      atomLinks        `xml:"link"`
+	Summary    atom10Text       `xml:"summary"`
+	Content    atom10Text       `xml:"http://www.w3.org/2005/Atom content"`
+	Author     atomPerson       `xml:"author"`
+	Categories []atom10Category `xml:"category"`
 	media.Element
+	georss.Location
+}
+
+type atom10Category struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
 }
 
 func (a *atom10Entry) Transform() *model.Entry {
@@ -85,9 +93,32 @@ func (a *atom10Entry) Transform() *model.Entry {
 	entry.Title = a.entryTitle()
 	entry.Enclosures = a.entryEnclosures()
 	entry.CommentsURL = a.entryCommentsURL()
+	entry.CommentsFeedURL = a.entryCommentsFeedURL()
+	entry.Tags = a.entryTags()
+
+	if latitude, longitude, found := a.Coordinates(); found {
+		entry.SetLocation(latitude, longitude)
+	}
+
 	return entry
 }
 
+func (a *atom10Entry) entryTags() []string {
+	var tags []string
+	for _, category := range a.Categories {
+		tag := strings.TrimSpace(category.Label)
+		if tag == "" {
+			tag = strings.TrimSpace(category.Term)
+		}
+
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
 func (a *atom10Entry) entryTitle() string {
 	return sanitizer.StripTags(a.Title.String())
 }
@@ -177,9 +208,11 @@ func (a *atom10Entry) entryEnclosures() model.EnclosureList {
 		if _, found := duplicates[mediaContent.URL]; !found {
 			duplicates[mediaContent.URL] = true
 			enclosures = append(enclosures, &model.Enclosure{
-				URL:      mediaContent.URL,
-				MimeType: mediaContent.MimeType(),
-				Size:     mediaContent.Size(),
+				URL:       mediaContent.URL,
+				MimeType:  mediaContent.MimeType(),
+				Size:      mediaContent.Size(),
+				Duration:  mediaContent.DurationSeconds(),
+				PosterURL: mediaContent.PosterURL(),
 			})
 		}
 	}
@@ -209,6 +242,15 @@ func (a *atom10Entry) entryCommentsURL() string {
 	return ""
 }
 
+// The comments feed is the replies link pointing to a feed, the type defaults to Atom when omitted.
+func (a *atom10Entry) entryCommentsFeedURL() string {
+	commentsFeedURL := a.Links.firstLinkWithRelationAndType("replies", "", "application/atom+xml", "application/rss+xml")
+	if url.IsAbsoluteURL(commentsFeedURL) {
+		return commentsFeedURL
+	}
+	return ""
+}
+
 type atom10Text struct {
 	Type string `xml:"type,attr"`
 	Data string `xml:",chardata"`
@@ -813,6 +813,10 @@ func TestParseRepliesLinkRelationWithHTMLType(t *testing.T) {
 	if feed.Entries[0].CommentsURL != "http://www.example.org/comments.html" {
 		t.Errorf("Incorrect entry comments URL, got: %s", feed.Entries[0].CommentsURL)
 	}
+
+	if feed.Entries[0].CommentsFeedURL != "http://www.example.org/mycommentsfeed.xml" {
+		t.Errorf("Incorrect entry comments feed URL, got: %s", feed.Entries[0].CommentsFeedURL)
+	}
 }
 
 func TestParseRepliesLinkRelationWithXHTMLType(t *testing.T) {
@@ -937,3 +941,30 @@ func TestAbsoluteCommentsURL(t *testing.T) {
 		t.Errorf("Incorrect entry comments URL, got: %s", feed.Entries[0].CommentsURL)
 	}
 }
+
+func TestParseEntryWithCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<feed xmlns="http://www.w3.org/2005/Atom">
+		<title>My Example Feed</title>
+		<link href="http://www.example.org/myfeed" />
+		<entry>
+			<id>tag:entries.com,2005:1</id>
+			<title>My original entry</title>
+			<updated>2006-03-01T12:12:12Z</updated>
+			<link href="http://www.example.org/entries/1" />
+			<category term="go" label="Go" />
+			<category term="programming" />
+			<category />
+		</entry>
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := feed.Entries[0].Tags
+	if len(tags) != 2 || tags[0] != "Go" || tags[1] != "programming" {
+		t.Errorf("Incorrect entry tags, got: %v", tags)
+	}
+}
@@ -18,6 +18,9 @@ var (
 	errNotAuthorized    = "You are not authorized to access this resource (invalid username/password)"
 )
 
+// ErrResourceGone is returned when the remote server reports that the resource has been removed permanently (410).
+var ErrResourceGone = errors.NewLocalizedError("This feed has been permanently removed by its publisher (410 Gone)")
+
 // Exec executes a HTTP request and handles errors.
 func Exec(request *client.Client) (*client.Response, *errors.LocalizedError) {
 	response, err := request.Get()
@@ -28,6 +31,10 @@ func Exec(request *client.Client) (*client.Response, *errors.LocalizedError) {
 		return nil, errors.NewLocalizedError(errRequestFailed, err)
 	}
 
+	if response.IsGone() {
+		return nil, ErrResourceGone
+	}
+
 	if response.IsNotFound() {
 		return nil, errors.NewLocalizedError(errResourceNotFound)
 	}
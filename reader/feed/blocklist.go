@@ -0,0 +1,63 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"miniflux.app/errors"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+var errBlocked = "This feed has been blocked by the administrator (%s)"
+
+// CheckBlockedFeed returns an error when an administrator has blocked the feed URL or its domain.
+func (h *Handler) CheckBlockedFeed(feedURL string) *errors.LocalizedError {
+	blockedFeeds, err := h.store.BlockedFeeds()
+	if err != nil {
+		logger.Error("[Handler:CheckBlockedFeed] %v", err)
+		return nil
+	}
+
+	if blockedFeed := blockedFeeds.Match(feedURL); blockedFeed != nil {
+		return newBlockedFeedError(blockedFeed)
+	}
+
+	return nil
+}
+
+// DisableBlockedFeeds disables the existing subscriptions matching the blocked URL or domain.
+func (h *Handler) DisableBlockedFeeds(blockedFeed *model.BlockedFeed) (int, error) {
+	feeds, err := h.store.EnabledFeeds()
+	if err != nil {
+		return 0, err
+	}
+
+	blockedErr := newBlockedFeedError(blockedFeed)
+	count := 0
+	for _, feed := range feeds {
+		if !blockedFeed.Match(feed.FeedURL) {
+			continue
+		}
+
+		printer := locale.NewPrinter(h.store.UserLanguage(feed.UserID))
+		if err := h.store.DisableFeed(feed.UserID, feed.ID, blockedErr.Localize(printer)); err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+func newBlockedFeedError(blockedFeed *model.BlockedFeed) *errors.LocalizedError {
+	reason := blockedFeed.Reason
+	if reason == "" {
+		reason = blockedFeed.Pattern
+	}
+
+	return errors.NewLocalizedError(errBlocked, reason)
+}
@@ -0,0 +1,47 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"fmt"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/client"
+	"miniflux.app/model"
+	"miniflux.app/reader/browser"
+	"miniflux.app/reader/parser"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/timer"
+)
+
+// RefreshEntryComments downloads the comments feed of a followed entry and stores the comments.
+func (h *Handler) RefreshEntryComments(subscription *model.EntryCommentSubscription) error {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:RefreshEntryComments] entryID=%d", subscription.EntryID))
+
+	request := client.NewClientWithConfig(subscription.CommentsFeedURL, config.Opts)
+	response, requestErr := browser.Exec(request)
+	if requestErr != nil {
+		return requestErr
+	}
+
+	commentsFeed, parseErr := parser.ParseFeed(response.BodyAsString())
+	if parseErr != nil {
+		return parseErr
+	}
+
+	comments := make(model.EntryComments, 0, len(commentsFeed.Entries))
+	for _, entry := range commentsFeed.Entries {
+		comments = append(comments, &model.EntryComment{
+			Hash:    entry.Hash,
+			Author:  entry.Author,
+			URL:     entry.URL,
+			Content: sanitizer.Sanitize(entry.URL, entry.Content),
+			Date:    entry.Date,
+		})
+	}
+
+	return h.store.RefreshEntryComments(subscription.EntryID, comments)
+}
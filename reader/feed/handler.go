@@ -6,13 +6,16 @@ package feed // import "miniflux.app/reader/feed"
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"miniflux.app/config"
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
+	"miniflux.app/integration/ntfy"
 	"miniflux.app/locale"
 	"miniflux.app/logger"
+	"miniflux.app/mail"
 	"miniflux.app/model"
 	"miniflux.app/reader/browser"
 	"miniflux.app/reader/icon"
@@ -31,19 +34,30 @@ var (
 // Handler contains all the logic to create and refresh feeds.
 type Handler struct {
 	store *storage.Storage
+	tasks TaskRunner
+}
+
+// TaskRunner runs the background tasks started while refreshing feeds.
+type TaskRunner interface {
+	Run(task func())
 }
 
 // CreateFeed fetch, parse and store a new feed.
-func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool, userAgent, username, password, scraperRules, rewriteRules string, fetchViaProxy bool) (*model.Feed, error) {
+func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool, userAgent, username, password, scraperRules, rewriteRules string, fetchViaProxy bool, auth *model.FeedAuthentication) (*model.Feed, error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:CreateFeed] feedUrl=%s", url))
 
 	if !h.store.CategoryExists(userID, categoryID) {
 		return nil, errors.NewLocalizedError(errCategoryNotFound)
 	}
 
+	if blockedErr := h.CheckBlockedFeed(url); blockedErr != nil {
+		return nil, blockedErr
+	}
+
 	request := client.NewClientWithConfig(url, config.Opts)
 	request.WithCredentials(username, password)
 	request.WithUserAgent(userAgent)
+	withAuthentication(request, auth)
 
 	if fetchViaProxy {
 		request.WithProxy()
@@ -54,6 +68,24 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 		return nil, requestErr
 	}
 
+	return h.createFeed(userID, categoryID, response, crawler, userAgent, username, password, scraperRules, rewriteRules, fetchViaProxy, auth)
+}
+
+// CreateFeedFromResponse parses and stores a new feed from an already downloaded document, e.g. during the discovery.
+// Caching headers of the response are saved, so the next refresh can be a conditional request.
+func (h *Handler) CreateFeedFromResponse(userID, categoryID int64, response *client.Response, crawler bool, userAgent, username, password, scraperRules, rewriteRules string, fetchViaProxy bool, auth *model.FeedAuthentication) (*model.Feed, error) {
+	if !h.store.CategoryExists(userID, categoryID) {
+		return nil, errors.NewLocalizedError(errCategoryNotFound)
+	}
+
+	return h.createFeed(userID, categoryID, response, crawler, userAgent, username, password, scraperRules, rewriteRules, fetchViaProxy, auth)
+}
+
+func (h *Handler) createFeed(userID, categoryID int64, response *client.Response, crawler bool, userAgent, username, password, scraperRules, rewriteRules string, fetchViaProxy bool, auth *model.FeedAuthentication) (*model.Feed, error) {
+	if blockedErr := h.CheckBlockedFeed(response.EffectiveURL); blockedErr != nil {
+		return nil, blockedErr
+	}
+
 	if h.store.FeedURLExists(userID, response.EffectiveURL) {
 		return nil, errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
 	}
@@ -66,7 +98,9 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 	subscription.UserID = userID
 	subscription.WithCategoryID(categoryID)
 	subscription.WithBrowsingParameters(crawler, userAgent, username, password, scraperRules, rewriteRules, fetchViaProxy)
+	subscription.WithAuthentication(auth)
 	subscription.WithClientResponse(response)
+	subscription.WithResponseMetadata(response)
 	subscription.CheckedNow()
 
 	processor.ProcessFeedEntries(h.store, subscription)
@@ -77,6 +111,10 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 
 	logger.Debug("[Handler:CreateFeed] Feed saved with ID: %d", subscription.ID)
 
+	if storeErr := h.store.SyncSharedFeed(subscription.ID); storeErr != nil {
+		logger.Error("[Handler:CreateFeed] %v", storeErr)
+	}
+
 	checkFeedIcon(h.store, subscription.ID, subscription.SiteURL, fetchViaProxy)
 	return subscription, nil
 }
@@ -96,21 +134,48 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 		return errors.NewLocalizedError(errNotFound, feedID)
 	}
 
+	if originalFeed.SharedFeedID != 0 {
+		logger.Debug("[Handler:RefreshFeed] Feed #%d is a copy of the shared feed #%d", feedID, originalFeed.SharedFeedID)
+		return nil
+	}
+
+	if originalFeed.OrphanedSaves {
+		logger.Debug("[Handler:RefreshFeed] Feed #%d only keeps the starred entries of removed feeds", feedID)
+		return nil
+	}
+
+	if blockedErr := h.CheckBlockedFeed(originalFeed.FeedURL); blockedErr != nil {
+		logger.Info("[Handler:RefreshFeed] Feed #%d is blocked (%s)", feedID, originalFeed.FeedURL)
+		originalFeed.MarkAsBlocked(blockedErr.Localize(printer))
+		if storeErr := h.store.UpdateFeedError(originalFeed); storeErr != nil {
+			logger.Error("[Handler:RefreshFeed] %v", storeErr)
+		}
+		return blockedErr
+	}
+
 	weeklyEntryCount := 0
 	if config.Opts.PollingScheduler() == model.SchedulerEntryFrequency {
-		var weeklyCountErr error
-		weeklyEntryCount, weeklyCountErr = h.store.WeeklyFeedEntryCount(userID, feedID)
-		if weeklyCountErr != nil {
-			return weeklyCountErr
+		entryCounts, entryCountsErr := h.store.FeedEntryCounts(userID, feedID, config.Opts.SchedulerEntryFrequencyWindowDays())
+		if entryCountsErr != nil {
+			return entryCountsErr
 		}
+		weeklyEntryCount = entryCounts.WeeklyAverage()
 	}
 
 	originalFeed.CheckedNow()
 	originalFeed.ScheduleNextCheck(weeklyEntryCount)
 
+	if originalFeed.CronExpression != "" {
+		if err := originalFeed.ScheduleNextCronCheck(h.store.UserTimezone(userID)); err != nil {
+			logger.Error("[Handler:RefreshFeed] feedID=%d: %v", feedID, err)
+		}
+	}
+
 	request := client.NewClientWithConfig(originalFeed.FeedURL, config.Opts)
 	request.WithCredentials(originalFeed.Username, originalFeed.Password)
 	request.WithUserAgent(originalFeed.UserAgent)
+	request.WithEncoding(originalFeed.Encoding)
+	withAuthentication(request, originalFeed.Authentication())
 
 	if !originalFeed.IgnoreHTTPCache {
 		request.WithCacheHeaders(originalFeed.EtagHeader, originalFeed.LastModifiedHeader)
@@ -120,40 +185,104 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 		request.WithProxy()
 	}
 
-	response, requestErr := browser.Exec(request)
+	// Per-feed limits take precedence over the global HTTP client settings.
+	if originalFeed.RequestTimeout > 0 {
+		request.WithTimeout(originalFeed.RequestTimeout)
+	}
+
+	if originalFeed.MaxBodySize > 0 {
+		request.WithMaxBodySize(int64(originalFeed.MaxBodySize) * 1024 * 1024)
+	}
+
+	// Subscribers of the same URL share the document downloaded recently by one of them.
+	feedURL := originalFeed.FeedURL
+	shareContent := originalFeed.CanShareContent() && config.Opts.PollingSharedFetchMinutes() > 0
+
+	var response *client.Response
+	var requestErr *errors.LocalizedError
+	sharedContent := h.sharedFeedContent(originalFeed, shareContent)
+	if sharedContent != nil {
+		logger.Debug("[Handler:RefreshFeed] Feed #%d downloaded by another subscriber at %v", feedID, sharedContent.FetchedAt)
+		response = sharedContent.Response()
+	} else {
+		response, requestErr = browser.Exec(request)
+	}
+
+	if requestErr == browser.ErrResourceGone {
+		logger.Info("[Handler:RefreshFeed] Feed #%d is gone (%s)", feedID, originalFeed.FeedURL)
+		originalFeed.MarkAsDead(requestErr.Localize(printer))
+		if storeErr := h.store.UpdateFeedError(originalFeed); storeErr != nil {
+			logger.Error("[Handler:RefreshFeed] %v", storeErr)
+		}
+		return requestErr
+	}
+
 	if requestErr != nil {
-		originalFeed.WithError(requestErr.Localize(printer))
-		h.store.UpdateFeedError(originalFeed)
+		h.saveFeedError(printer, originalFeed, requestErr.Localize(printer))
 		return requestErr
 	}
 
+	originalFeed.WithResponseMetadata(response)
+
 	if h.store.AnotherFeedURLExists(userID, originalFeed.ID, response.EffectiveURL) {
 		storeErr := errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
-		originalFeed.WithError(storeErr.Error())
-		h.store.UpdateFeedError(originalFeed)
+		h.saveFeedError(printer, originalFeed, storeErr.Error())
 		return storeErr
 	}
 
+	redirectURL := ""
+	if response.PermanentRedirect {
+		redirectURL = response.EffectiveURL
+	}
+
+	if originalFeed.WithPermanentRedirect(redirectURL) {
+		logger.Info("[Handler:RefreshFeed] Feed #%d moved permanently from %s to %s", feedID, originalFeed.PreviousFeedURL, originalFeed.FeedURL)
+	}
+
 	if originalFeed.IgnoreHTTPCache || response.IsModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
 		logger.Debug("[Handler:RefreshFeed] Feed #%d has been modified", feedID)
 
-		updatedFeed, parseErr := parser.ParseFeed(response.BodyAsString())
+		body := response.BodyAsString()
+		updatedFeed, parseErr := parser.ParseFeed(body)
 		if parseErr != nil {
-			originalFeed.WithError(parseErr.Localize(printer))
-			h.store.UpdateFeedError(originalFeed)
+			h.saveFeedError(printer, originalFeed, parseErr.Localize(printer))
 			return parseErr
 		}
 
+		if shareContent && sharedContent == nil {
+			h.shareFeedContent(originalFeed.ID, model.NewFeedContent(feedURL, response, body))
+		}
+
+		// The custom title chosen by the user is stored separately and never overwritten.
+		if updatedFeed.Title != "" {
+			originalFeed.Title = updatedFeed.Title
+		}
+
+		// The feed URL advertised by the document (rel=self) is suggested to the user, or applied directly if configured.
+		selfURL := updatedFeed.FeedURL
+		if selfURL != "" && h.store.AnotherFeedURLExists(userID, originalFeed.ID, selfURL) {
+			selfURL = ""
+		}
+
+		originalFeed.WithSelfURL(selfURL)
+		if config.Opts.HasPollingApplySelfLink() && originalFeed.ApplyCanonicalURL() {
+			logger.Info("[Handler:RefreshFeed] Feed #%d URL changed from %s to %s (rel=self)", feedID, originalFeed.PreviousFeedURL, originalFeed.FeedURL)
+		}
 		originalFeed.Entries = updatedFeed.Entries
 		processor.ProcessFeedEntries(h.store, originalFeed)
 
 		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
-		if storeErr := h.store.RefreshFeedEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler); storeErr != nil {
-			originalFeed.WithError(storeErr.Error())
-			h.store.UpdateFeedError(originalFeed)
+		newEntries, storeErr := h.store.RefreshFeedEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler)
+		if storeErr != nil {
+			h.saveFeedError(printer, originalFeed, storeErr.Error())
 			return storeErr
 		}
 
+		if originalFeed.ArchivePages && len(newEntries) > 0 {
+			feed := *originalFeed
+			h.runTask(func() { archiveEntryWebPages(h.store, &feed, newEntries) })
+		}
+
 		// We update caching headers only if the feed has been modified,
 		// because some websites don't return the same headers when replying with a 304.
 		originalFeed.WithClientResponse(response)
@@ -165,17 +294,162 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 	originalFeed.ResetErrorCounter()
 
 	if storeErr := h.store.UpdateFeed(originalFeed); storeErr != nil {
-		originalFeed.WithError(storeErr.Error())
-		h.store.UpdateFeedError(originalFeed)
+		h.saveFeedError(printer, originalFeed, storeErr.Error())
 		return storeErr
 	}
 
+	if storeErr := h.store.SyncSharedFeed(originalFeed.ID); storeErr != nil {
+		logger.Error("[Handler:RefreshFeed] %v", storeErr)
+	}
+
 	return nil
 }
 
+// sharedFeedContent returns the document downloaded recently by another subscriber of the feed URL.
+func (h *Handler) sharedFeedContent(feed *model.Feed, shareContent bool) *model.FeedContent {
+	if !shareContent {
+		return nil
+	}
+
+	content, err := h.store.FeedContent(feed.FeedURL, config.Opts.PollingSharedFetchMinutes())
+	if err != nil {
+		logger.Error("[Handler:RefreshFeed] %v", err)
+		return nil
+	}
+
+	return content
+}
+
+// shareFeedContent refreshes the feeds of the other subscribers and keeps the downloaded document for them.
+// Nothing is stored when nobody else can use the document.
+func (h *Handler) shareFeedContent(feedID int64, content *model.FeedContent) {
+	subscribers, err := h.store.ScheduleFeedsWithSameURL(feedID, content.FeedURL)
+	if err != nil {
+		logger.Error("[Handler:RefreshFeed] %v", err)
+		return
+	}
+
+	if subscribers == 0 {
+		return
+	}
+
+	if err := h.store.SaveFeedContent(content); err != nil {
+		logger.Error("[Handler:RefreshFeed] %v", err)
+	}
+}
+
+// saveFeedError stores the refresh error and warns the user when the feed gets disabled because of it.
+func (h *Handler) saveFeedError(printer *locale.Printer, feed *model.Feed, message string) {
+	wasDisabled := feed.Disabled
+	feed.WithError(message)
+	feed.ApplyErrorBackoff()
+
+	if weeks := config.Opts.PollingErrorDisableAfterWeeks(); feed.Disabled && !wasDisabled {
+		logger.Info("[Handler:RefreshFeed] Feed #%d disabled after %d consecutive errors since %v", feed.ID, feed.ParsingErrorCount, *feed.FailingSince)
+		feed.ParsingErrorMsg = printer.Plural("error.feed_disabled_after_errors", weeks, weeks, message)
+	}
+
+	if err := h.store.UpdateFeedError(feed); err != nil {
+		logger.Error("[Handler:RefreshFeed] %v", err)
+	}
+
+	// The notification is sent once, when the errors become persistent.
+	// The threshold can't be higher than the parsing error limit, see the config parser.
+	if feed.NotifyErrors && feed.ParsingErrorCount == config.Opts.PollingErrorNotificationThreshold() {
+		notifiedFeed := *feed
+		h.runTask(func() { h.notifyFeedError(printer, &notifiedFeed) })
+	}
+}
+
+// notifyFeedError warns the user about a feed that keeps failing, by email and through the push integration.
+func (h *Handler) notifyFeedError(printer *locale.Printer, feed *model.Feed) {
+	subject := printer.Printf("email.feed_error.subject", feed.DisplayTitle())
+	feedURL := fmt.Sprintf("%s/feed/%d/edit", config.Opts.BaseURL(), feed.ID)
+	body := printer.Printf(
+		"email.feed_error.body",
+		feed.DisplayTitle(),
+		feed.FeedURL,
+		feed.ParsingErrorCount,
+		feed.ParsingErrorMsg,
+		feedURL,
+	)
+
+	user, err := h.store.UserByID(feed.UserID)
+	if err != nil || user == nil {
+		return
+	}
+
+	// Unverified addresses never receive notifications, they could belong to someone else.
+	if config.Opts.HasSMTP() && user.NotificationEmail != "" && user.NotificationEmailVerified {
+		client := mail.NewClient(
+			config.Opts.SMTPHost(),
+			config.Opts.SMTPPort(),
+			config.Opts.SMTPUsername(),
+			config.Opts.SMTPPassword(),
+			config.Opts.SMTPFrom(),
+		)
+
+		if err := client.Send([]string{user.NotificationEmail}, subject, body); err != nil {
+			logger.Error("[Handler:NotifyFeedError] feedID=%d: %v", feed.ID, err)
+		}
+	}
+
+	integration, err := h.store.Integration(feed.UserID)
+	if err != nil {
+		logger.Error("[Handler:NotifyFeedError] feedID=%d: %v", feed.ID, err)
+		return
+	}
+
+	if integration.NtfyEnabled {
+		client := ntfy.NewClient(integration.NtfyTopicURL, integration.NtfyToken)
+		if err := client.SendMessage(subject, body, feedURL); err != nil {
+			logger.Error("[Handler:NotifyFeedError] feedID=%d: %v", feed.ID, err)
+		}
+	}
+}
+
 // NewFeedHandler returns a feed handler.
 func NewFeedHandler(store *storage.Storage) *Handler {
-	return &Handler{store}
+	return &Handler{store: store}
+}
+
+// WithTaskRunner runs the background tasks with the given runner instead of the calling goroutine.
+func (h *Handler) WithTaskRunner(tasks TaskRunner) {
+	h.tasks = tasks
+}
+
+func (h *Handler) runTask(task func()) {
+	if h.tasks == nil {
+		task()
+		return
+	}
+
+	h.tasks.Run(task)
+}
+
+func withAuthentication(request *client.Client, auth *model.FeedAuthentication) {
+	if auth == nil {
+		return
+	}
+
+	if auth.HasOAuth2ClientCredentials() {
+		request.WithOAuth2ClientCredentials(auth.OAuth2TokenURL, auth.OAuth2ClientID, auth.OAuth2ClientSecret, strings.Fields(auth.OAuth2Scopes))
+	} else {
+		request.WithBearerToken(auth.BearerToken)
+	}
+}
+
+// archiveEntryWebPages keeps a copy of the web page of the new entries.
+func archiveEntryWebPages(store *storage.Storage, feed *model.Feed, entries model.Entries) {
+	for _, entry := range entries {
+		if entry.ID == 0 || store.HasEntrySnapshot(entry.ID) {
+			continue
+		}
+
+		if err := processor.ArchiveEntryWebPage(store, feed, entry); err != nil {
+			logger.Error("[Handler:RefreshFeed] Unable to archive %q (feed #%d): %v", entry.URL, feed.ID, err)
+		}
+	}
 }
 
 func checkFeedIcon(store *storage.Storage, feedID int64, websiteURL string, fetchViaProxy bool) {
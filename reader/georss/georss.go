@@ -0,0 +1,50 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package georss // import "miniflux.app/reader/georss"
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Location represents the geographic location of an item.
+// Specs:
+// - http://www.georss.org/simple.html
+// - https://www.w3.org/2003/01/geo/
+type Location struct {
+	GeoRSSPoint  string `xml:"http://www.georss.org/georss point"`
+	GeoLatitude  string `xml:"http://www.w3.org/2003/01/geo/wgs84_pos# lat"`
+	GeoLongitude string `xml:"http://www.w3.org/2003/01/geo/wgs84_pos# long"`
+}
+
+// Coordinates returns the latitude and the longitude of the item.
+func (e *Location) Coordinates() (latitude, longitude float64, found bool) {
+	if e.GeoRSSPoint != "" {
+		// GeoRSS points are written "latitude longitude", some feeds separate them with a comma.
+		parts := strings.Fields(strings.Replace(e.GeoRSSPoint, ",", " ", -1))
+		if len(parts) == 2 {
+			if latitude, longitude, found = parseCoordinates(parts[0], parts[1]); found {
+				return latitude, longitude, true
+			}
+		}
+	}
+
+	return parseCoordinates(e.GeoLatitude, e.GeoLongitude)
+}
+
+func parseCoordinates(latitudeValue, longitudeValue string) (float64, float64, bool) {
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(latitudeValue), 64)
+	if err != nil || math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return 0, 0, false
+	}
+
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(longitudeValue), 64)
+	if err != nil || math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return 0, 0, false
+	}
+
+	return latitude, longitude, true
+}
@@ -0,0 +1,36 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package georss // import "miniflux.app/reader/georss"
+
+import "testing"
+
+func TestCoordinates(t *testing.T) {
+	scenarios := []struct {
+		element   Location
+		latitude  float64
+		longitude float64
+		found     bool
+	}{
+		{Location{GeoRSSPoint: "45.256 -71.92"}, 45.256, -71.92, true},
+		{Location{GeoRSSPoint: " 45.256,  -71.92 "}, 45.256, -71.92, true},
+		{Location{GeoLatitude: "48.8583", GeoLongitude: "2.2945"}, 48.8583, 2.2945, true},
+		{Location{GeoRSSPoint: "invalid", GeoLatitude: "48.8583", GeoLongitude: "2.2945"}, 48.8583, 2.2945, true},
+		{Location{GeoRSSPoint: "95 10"}, 0, 0, false},
+		{Location{GeoRSSPoint: "10 -180.5"}, 0, 0, false},
+		{Location{GeoRSSPoint: "NaN 10"}, 0, 0, false},
+		{Location{GeoLatitude: "10", GeoLongitude: "NaN"}, 0, 0, false},
+		{Location{GeoLatitude: "+Inf", GeoLongitude: "10"}, 0, 0, false},
+		{Location{GeoLatitude: "10", GeoLongitude: "-Inf"}, 0, 0, false},
+		{Location{GeoLatitude: "48.8583"}, 0, 0, false},
+		{Location{}, 0, 0, false},
+	}
+
+	for _, scenario := range scenarios {
+		latitude, longitude, found := scenario.element.Coordinates()
+		if found != scenario.found || latitude != scenario.latitude || longitude != scenario.longitude {
+			t.Errorf(`Unexpected coordinates for %+v: %v %v %v`, scenario.element, latitude, longitude, found)
+		}
+	}
+}
@@ -41,6 +41,7 @@ type jsonItem struct {
 	DateModified  string           `json:"date_modified"`
 	Author        jsonAuthor       `json:"author"`
 	Attachments   []jsonAttachment `json:"attachments"`
+	Tags          []string         `json:"tags"`
 }
 
 type jsonAttachment struct {
@@ -144,12 +145,24 @@ func (j *jsonItem) GetEnclosures() model.EnclosureList {
 			URL:      attachment.URL,
 			MimeType: attachment.MimeType,
 			Size:     attachment.Size,
+			Duration: attachment.Duration,
 		})
 	}
 
 	return enclosures
 }
 
+func (j *jsonItem) GetTags() []string {
+	var tags []string
+	for _, tag := range j.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
 func (j *jsonItem) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = j.URL
@@ -159,6 +172,7 @@ func (j *jsonItem) Transform() *model.Entry {
 	entry.Content = j.GetContent()
 	entry.Title = strings.TrimSpace(j.GetTitle())
 	entry.Enclosures = j.GetEnclosures()
+	entry.Tags = j.GetTags()
 	return entry
 }
 
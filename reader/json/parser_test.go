@@ -170,6 +170,10 @@ func TestParsePodcast(t *testing.T) {
 	if feed.Entries[0].Enclosures[0].Size != 89970236 {
 		t.Errorf("Incorrect enclosure length, got: %d", feed.Entries[0].Enclosures[0].Size)
 	}
+
+	if feed.Entries[0].Enclosures[0].Duration != 6629 {
+		t.Errorf("Incorrect enclosure duration, got: %d", feed.Entries[0].Enclosures[0].Duration)
+	}
 }
 
 func TestParseEntryWithoutAttachmentURL(t *testing.T) {
@@ -409,6 +413,32 @@ func TestParseTruncateItemTitle(t *testing.T) {
 	}
 }
 
+func TestParseItemWithTags(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1",
+		"title": "My Example Feed",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"items": [
+			{
+				"id": "1",
+				"url": "https://example.org/item",
+				"tags": ["Go", " ", "Programming"]
+			}
+		]
+	}`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := feed.Entries[0].Tags
+	if len(tags) != 2 || tags[0] != "Go" || tags[1] != "Programming" {
+		t.Errorf("Incorrect entry tags, got: %v", tags)
+	}
+}
+
 func TestParseInvalidJSON(t *testing.T) {
 	data := `garbage`
 	_, err := Parse(bytes.NewBufferString(data))
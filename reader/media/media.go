@@ -78,10 +78,12 @@ type Group struct {
 
 // Content represents a XML element "media:content".
 type Content struct {
-	URL      string `xml:"url,attr"`
-	Type     string `xml:"type,attr"`
-	FileSize string `xml:"fileSize,attr"`
-	Medium   string `xml:"medium,attr"`
+	URL        string      `xml:"url,attr"`
+	Type       string      `xml:"type,attr"`
+	FileSize   string      `xml:"fileSize,attr"`
+	Medium     string      `xml:"medium,attr"`
+	Duration   string      `xml:"duration,attr"`
+	Thumbnails []Thumbnail `xml:"http://search.yahoo.com/mrss/ thumbnail"`
 }
 
 // MimeType returns the attachment mime type.
@@ -111,6 +113,25 @@ func (mc *Content) Size() int64 {
 	return size
 }
 
+// DurationSeconds returns the attachment duration in seconds.
+func (mc *Content) DurationSeconds() int {
+	if mc.Duration == "" {
+		return 0
+	}
+	duration, _ := strconv.Atoi(mc.Duration)
+	return duration
+}
+
+// PosterURL returns the first thumbnail defined inside the element.
+func (mc *Content) PosterURL() string {
+	for _, thumbnail := range mc.Thumbnails {
+		if thumbnail.URL != "" {
+			return thumbnail.URL
+		}
+	}
+	return ""
+}
+
 // Thumbnail represents a XML element "media:thumbnail".
 type Thumbnail struct {
 	URL string `xml:"url,attr"`
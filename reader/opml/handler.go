@@ -29,7 +29,7 @@ func (h *Handler) Export(userID int64) (string, error) {
 	var subscriptions SubcriptionList
 	for _, feed := range feeds {
 		subscriptions = append(subscriptions, &Subcription{
-			Title:        feed.Title,
+			Title:        feed.DisplayTitle(),
 			FeedURL:      feed.FeedURL,
 			SiteURL:      feed.SiteURL,
 			CategoryName: feed.Category.Title,
@@ -46,7 +46,18 @@ func (h *Handler) Import(userID int64, data io.Reader) error {
 		return err
 	}
 
+	blockedFeeds, storeErr := h.store.BlockedFeeds()
+	if storeErr != nil {
+		logger.Error("[OPML:Import] %v", storeErr)
+		return errors.New("unable to fetch blocked feeds")
+	}
+
 	for _, subscription := range subscriptions {
+		if blockedFeeds.Match(subscription.FeedURL) != nil {
+			logger.Info("[OPML:Import] Feed %q is blocked on this instance", subscription.FeedURL)
+			continue
+		}
+
 		if !h.store.FeedURLExists(userID, subscription.FeedURL) {
 			var category *model.Category
 			var err error
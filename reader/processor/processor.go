@@ -5,6 +5,7 @@
 package processor
 
 import (
+	"errors"
 	"time"
 
 	"miniflux.app/config"
@@ -14,47 +15,131 @@ import (
 	"miniflux.app/reader/rewrite"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/reader/scraper"
+	"miniflux.app/reader/snapshot"
 	"miniflux.app/storage"
 )
 
+// ErrSnapshotQuotaExceeded is returned when the web page copies of the user exceed SNAPSHOT_QUOTA.
+var ErrSnapshotQuotaExceeded = errors.New("processor: the storage quota for web page copies is exceeded")
+
 // ProcessFeedEntries downloads original web page for entries and apply filters.
 func ProcessFeedEntries(store *storage.Storage, feed *model.Feed) {
+	domainRules, err := store.DomainRules()
+	if err != nil {
+		logger.Error(`[Filter] Unable to fetch domain rules: %v`, err)
+	}
+
+	mutedKeywords, err := store.ActiveMutedKeywords(feed.UserID)
+	if err != nil {
+		logger.Error(`[Filter] Unable to fetch muted keywords: %v`, err)
+	}
+
+	user, err := store.UserByID(feed.UserID)
+	if err != nil || user == nil {
+		logger.Error(`[Filter] Unable to fetch user #%d: %v`, feed.UserID, err)
+		user = model.NewUser()
+	}
+
+	// The regular expressions are compiled once for all the entries of the feed.
+	autoStarKeywords := model.ParseKeywords(user.AutoStarKeywords)
+
+	var scores *scoredEntries
+	if feed.FetchScores {
+		scores = fetchScores(feed.Entries)
+	}
+
+	var filteredEntries model.Entries
 	for _, entry := range feed.Entries {
 		logger.Debug("[Feed #%d] Processing entry %s", feed.ID, entry.URL)
 
-		if feed.Crawler {
-			if !store.EntryURLExists(feed.ID, entry.URL) {
-				startTime := time.Now()
-				content, scraperErr := scraper.Fetch(entry.URL, feed.ScraperRules, feed.UserAgent)
-
-				if config.Opts.HasMetricsCollector() {
-					status := "success"
-					if scraperErr != nil {
-						status = "error"
-					}
-					metric.ScraperRequestDuration.WithLabelValues(status).Observe(time.Since(startTime).Seconds())
-				}
+		if model.MatchAuthor(entry.Author, feed.BlockedAuthors, user.BlockedAuthors) {
+			logger.Debug(`[Filter] Skip entry %q: the author %q is blocked`, entry.URL, entry.Author)
+			continue
+		}
 
+		if mutedKeyword := mutedKeywords.Match(entry); mutedKeyword != nil {
+			if mutedKeyword.Action == model.MutedKeywordActionDrop {
+				logger.Debug(`[Filter] Skip entry %q: muted by %q`, entry.URL, mutedKeyword.Pattern)
+				continue
+			}
+
+			entry.Status = model.EntryStatusRead
+		}
+
+		if feed.AutoStar || model.MatchAuthor(entry.Author, user.AutoStarAuthors) || autoStarKeywords.Match(entry) {
+			entry.Starred = true
+		}
+
+		// Entries without known score are kept.
+		if scores != nil && scores.has(entry) && entry.Score < feed.MinimumScore {
+			logger.Debug(`[Filter] Skip entry %q: score %d is lower than %d`, entry.URL, entry.Score, feed.MinimumScore)
+			continue
+		}
+
+		scraperRules, rewriteRules := applicableRules(domainRules, entry.URL, feed.ScraperRules, feed.RewriteRules)
+
+		// The existence of the entry is checked once, only when a request depends on it.
+		fetchWatchTime := config.Opts.FetchYouTubeWatchTime() && isYouTubeVideoURL(entry.URL)
+		isNewEntry := (feed.Crawler || fetchWatchTime) && !store.EntryURLExists(feed.ID, entry.URL)
+
+		if feed.Crawler && isNewEntry {
+			startTime := time.Now()
+			content, scraperErr := scraper.Fetch(entry.URL, scraperRules, feed.UserAgent)
+
+			if config.Opts.HasMetricsCollector() {
+				status := "success"
 				if scraperErr != nil {
-					logger.Error(`[Filter] Unable to crawl this entry: %q => %v`, entry.URL, scraperErr)
-				} else if content != "" {
-					// We replace the entry content only if the scraper doesn't return any error.
-					entry.Content = content
+					status = "error"
 				}
+				metric.ScraperRequestDuration.WithLabelValues(status).Observe(time.Since(startTime).Seconds())
+			}
+
+			if scraperErr != nil {
+				logger.Error(`[Filter] Unable to crawl this entry: %q => %v`, entry.URL, scraperErr)
+			} else if content != "" {
+				// We replace the entry content only if the scraper doesn't return any error.
+				entry.Content = content
 			}
 		}
 
-		entry.Content = rewrite.Rewriter(entry.URL, entry.Content, feed.RewriteRules)
+		if fetchWatchTime && isNewEntry {
+			if watchTime, err := fetchYouTubeWatchTime(entry.URL); err != nil {
+				logger.Error(`[Filter] Unable to fetch YouTube watch time: %q => %v`, entry.URL, err)
+			} else {
+				entry.ReadingTime = watchTime
+			}
+		}
+
+		entry.Content = rewrite.Rewriter(entry.URL, entry.Content, rewriteRules)
+
+		// Feeds often declare a generic or wrong type, players need the real one.
+		entry.Enclosures.NormalizeMimeTypes()
+		entry.Enclosures = entry.Enclosures.Deduplicate()
+		entry.Enclosures.AssignPosters()
+
+		var embedOrigin string
+		entry.Content, embedOrigin = rewriteYouTubeEmbeds(entry.Content, user.YouTubeEmbedURL)
 
 		// The sanitizer should always run at the end of the process to make sure unsafe HTML is filtered.
-		entry.Content = sanitizer.Sanitize(entry.URL, entry.Content)
+		entry.Content, entry.RemovedTrackers = sanitizer.SanitizeAndCountTrackers(entry.URL, entry.Content, embedOrigin)
+
+		filteredEntries = append(filteredEntries, entry)
 	}
+
+	feed.Entries = filteredEntries
 }
 
 // ProcessEntryWebPage downloads the entry web page and apply rewrite rules.
-func ProcessEntryWebPage(entry *model.Entry) error {
+func ProcessEntryWebPage(store *storage.Storage, entry *model.Entry) error {
+	domainRules, err := store.DomainRules()
+	if err != nil {
+		return err
+	}
+
+	scraperRules, rewriteRules := applicableRules(domainRules, entry.URL, entry.Feed.ScraperRules, entry.Feed.RewriteRules)
+
 	startTime := time.Now()
-	content, scraperErr := scraper.Fetch(entry.URL, entry.Feed.ScraperRules, entry.Feed.UserAgent)
+	content, scraperErr := scraper.Fetch(entry.URL, scraperRules, entry.Feed.UserAgent)
 	if config.Opts.HasMetricsCollector() {
 		status := "success"
 		if scraperErr != nil {
@@ -67,12 +152,66 @@ func ProcessEntryWebPage(entry *model.Entry) error {
 		return scraperErr
 	}
 
-	content = rewrite.Rewriter(entry.URL, content, entry.Feed.RewriteRules)
-	content = sanitizer.Sanitize(entry.URL, content)
+	content = rewrite.Rewriter(entry.URL, content, rewriteRules)
+
+	var embedOrigin string
+	if user, err := store.UserByID(entry.UserID); err == nil && user != nil {
+		content, embedOrigin = rewriteYouTubeEmbeds(content, user.YouTubeEmbedURL)
+	}
+
+	content, removedTrackers := sanitizer.SanitizeAndCountTrackers(entry.URL, content, embedOrigin)
 
 	if content != "" {
 		entry.Content = content
+		entry.RemovedTrackers = removedTrackers
 	}
 
 	return nil
 }
+
+// ArchiveEntryWebPage stores a self-contained copy of the entry web page, within the storage quota of the user.
+func ArchiveEntryWebPage(store *storage.Storage, feed *model.Feed, entry *model.Entry) error {
+	quota := config.Opts.SnapshotQuota()
+
+	var usedSize int64
+	if quota > 0 {
+		var err error
+		if usedSize, err = store.EntrySnapshotsSize(entry.UserID); err != nil {
+			return err
+		}
+
+		if usedSize >= quota {
+			return ErrSnapshotQuotaExceeded
+		}
+	}
+
+	content, err := snapshot.Capture(entry.URL, feed.UserAgent, feed.FetchViaProxy)
+	if err != nil {
+		return err
+	}
+
+	if quota > 0 && usedSize+int64(len(content)) > quota {
+		return ErrSnapshotQuotaExceeded
+	}
+
+	return store.SaveEntrySnapshot(&model.EntrySnapshot{
+		EntryID: entry.ID,
+		UserID:  entry.UserID,
+		Content: content,
+	})
+}
+
+// applicableRules returns the feed rules, or the instance-wide domain rules when the feed doesn't define any.
+func applicableRules(domainRules model.DomainRules, entryURL, scraperRules, rewriteRules string) (string, string) {
+	if rule := domainRules.Match(entryURL); rule != nil {
+		if scraperRules == "" {
+			scraperRules = rule.ScraperRules
+		}
+
+		if rewriteRules == "" {
+			rewriteRules = rule.RewriteRules
+		}
+	}
+
+	return scraperRules, rewriteRules
+}
@@ -0,0 +1,205 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+const (
+	// scoreRequestTimeout is the timeout in seconds of each score request.
+	scoreRequestTimeout = 10
+
+	// scoreFetchBudget is the time after which the remaining scores of a refresh are not fetched anymore.
+	scoreFetchBudget = 30 * time.Second
+
+	// maxScoreFetchers is the number of score requests running at the same time for a feed.
+	maxScoreFetchers = 4
+
+	// redditBatchSize is the maximum number of posts fetched in one Reddit request.
+	redditBatchSize = 100
+)
+
+var (
+	redditPostRegex     = regexp.MustCompile(`^https?://(?:www\.|old\.)?reddit\.com/r/\w+/comments/(\w+)`)
+	hackerNewsItemRegex = regexp.MustCompile(`^https?://news\.ycombinator\.com/item\?id=(\d+)`)
+
+	redditAPIURL     = "https://www.reddit.com"
+	hackerNewsAPIURL = "https://hacker-news.firebaseio.com/v0"
+)
+
+// scoredEntries keeps the entries whose score has been fetched, the other ones are not filtered by score.
+type scoredEntries struct {
+	sync.Mutex
+	entries map[*model.Entry]bool
+}
+
+func (s *scoredEntries) add(entries []*model.Entry, score, commentsCount int) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, entry := range entries {
+		entry.Score = score
+		entry.CommentsCount = commentsCount
+		s.entries[entry] = true
+	}
+}
+
+func (s *scoredEntries) has(entry *model.Entry) bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.entries[entry]
+}
+
+// fetchScores updates the score and the number of comments of Reddit and Hacker News entries.
+// Reddit posts are fetched by batches, Hacker News items in parallel, within the time budget of the refresh.
+func fetchScores(entries model.Entries) *scoredEntries {
+	scored := &scoredEntries{entries: make(map[*model.Entry]bool)}
+	redditPosts := make(map[string][]*model.Entry)
+	hackerNewsItems := make(map[string][]*model.Entry)
+	var redditIDs []string
+
+	for _, entry := range entries {
+		if matches := redditPostRegex.FindStringSubmatch(entry.URL); len(matches) == 2 {
+			postID := strings.ToLower(matches[1])
+			if _, found := redditPosts[postID]; !found {
+				redditIDs = append(redditIDs, postID)
+			}
+			redditPosts[postID] = append(redditPosts[postID], entry)
+			continue
+		}
+
+		for _, link := range []string{entry.CommentsURL, entry.URL} {
+			if matches := hackerNewsItemRegex.FindStringSubmatch(link); len(matches) == 2 {
+				hackerNewsItems[matches[1]] = append(hackerNewsItems[matches[1]], entry)
+				break
+			}
+		}
+	}
+
+	var tasks []func() error
+	for start := 0; start < len(redditIDs); start += redditBatchSize {
+		end := start + redditBatchSize
+		if end > len(redditIDs) {
+			end = len(redditIDs)
+		}
+
+		batch := redditIDs[start:end]
+		tasks = append(tasks, func() error { return fetchRedditScores(batch, redditPosts, scored) })
+	}
+
+	for itemID, itemEntries := range hackerNewsItems {
+		itemID, itemEntries := itemID, itemEntries
+		tasks = append(tasks, func() error { return fetchHackerNewsScores(itemID, itemEntries, scored) })
+	}
+
+	runScoreTasks(tasks, time.Now().Add(scoreFetchBudget))
+	return scored
+}
+
+// runScoreTasks runs the tasks with a limited number of goroutines, the tasks not started before the deadline are skipped.
+func runScoreTasks(tasks []func() error, deadline time.Time) {
+	queue := make(chan func() error)
+	var wg sync.WaitGroup
+
+	for i := 0; i < maxScoreFetchers && i < len(tasks); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range queue {
+				if err := task(); err != nil {
+					logger.Error(`[Filter] Unable to fetch score: %v`, err)
+				}
+			}
+		}()
+	}
+
+	for i, task := range tasks {
+		if time.Now().After(deadline) {
+			logger.Info(`[Filter] Score fetching budget exceeded, %d requests skipped`, len(tasks)-i)
+			break
+		}
+		queue <- task
+	}
+
+	close(queue)
+	wg.Wait()
+}
+
+func fetchRedditScores(postIDs []string, posts map[string][]*model.Entry, scored *scoredEntries) error {
+	var listing struct {
+		Data struct {
+			Children []struct {
+				Data struct {
+					ID          string `json:"id"`
+					Score       int    `json:"score"`
+					NumComments int    `json:"num_comments"`
+				} `json:"data"`
+			} `json:"children"`
+		} `json:"data"`
+	}
+
+	fullnames := make([]string, len(postIDs))
+	for i, postID := range postIDs {
+		fullnames[i] = "t3_" + postID
+	}
+
+	if err := fetchJSON(redditAPIURL+"/by_id/"+strings.Join(fullnames, ",")+".json", &listing); err != nil {
+		return fmt.Errorf("reddit: %v", err)
+	}
+
+	for _, child := range listing.Data.Children {
+		post := child.Data
+		scored.add(posts[strings.ToLower(post.ID)], post.Score, post.NumComments)
+	}
+
+	return nil
+}
+
+func fetchHackerNewsScores(itemID string, entries []*model.Entry, scored *scoredEntries) error {
+	var item *struct {
+		Score       int `json:"score"`
+		Descendants int `json:"descendants"`
+	}
+
+	if err := fetchJSON(hackerNewsAPIURL+"/item/"+itemID+".json", &item); err != nil {
+		return fmt.Errorf("hacker news: %v", err)
+	}
+
+	if item == nil {
+		return fmt.Errorf("hacker news: item not found: %s", itemID)
+	}
+
+	scored.add(entries, item.Score, item.Descendants)
+	return nil
+}
+
+func fetchJSON(url string, v interface{}) error {
+	clt := client.New(url)
+	clt.WithTimeout(scoreRequestTimeout)
+	response, err := clt.Get()
+	if err != nil {
+		return fmt.Errorf("unable to fetch %s: %v", url, err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("unable to fetch %s, status=%d", url, response.StatusCode)
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(v); err != nil {
+		return fmt.Errorf("unable to decode %s: %v", url, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestRedditPostRegex(t *testing.T) {
+	scenarios := map[string]string{
+		"https://www.reddit.com/r/golang/comments/abc123/some_title/": "https://www.reddit.com/r/golang/comments/abc123",
+		"https://old.reddit.com/r/golang/comments/abc123/":            "https://old.reddit.com/r/golang/comments/abc123",
+		"https://www.reddit.com/r/golang/":                            "",
+		"https://example.org/r/golang/comments/abc123/":               "",
+	}
+
+	for input, expected := range scenarios {
+		if result := redditPostRegex.FindString(input); result != expected {
+			t.Errorf(`Unexpected post URL for %q: got %q instead of %q`, input, result, expected)
+		}
+	}
+}
+
+func TestHackerNewsItemRegex(t *testing.T) {
+	matches := hackerNewsItemRegex.FindStringSubmatch("https://news.ycombinator.com/item?id=23456789")
+	if len(matches) != 2 || matches[1] != "23456789" {
+		t.Errorf(`Unexpected item ID: %v`, matches)
+	}
+
+	if hackerNewsItemRegex.MatchString("https://example.org/item?id=1") {
+		t.Error(`Other websites should not be detected as Hacker News`)
+	}
+}
+
+func TestFetchScores(t *testing.T) {
+	var redditRequests, hackerNewsRequests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/by_id/t3_abc123,t3_def456.json":
+			atomic.AddInt32(&redditRequests, 1)
+			fmt.Fprint(w, `{"data": {"children": [{"data": {"id": "abc123", "score": 42, "num_comments": 7}}, {"data": {"id": "def456", "score": 3, "num_comments": 1}}]}}`)
+		case "/item/123.json":
+			atomic.AddInt32(&hackerNewsRequests, 1)
+			fmt.Fprint(w, `{"score": 120, "descendants": 30}`)
+		case "/item/456.json":
+			atomic.AddInt32(&hackerNewsRequests, 1)
+			fmt.Fprint(w, `null`)
+		default:
+			t.Errorf(`Unexpected request: %s`, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	defer func(redditURL, hackerNewsURL string) {
+		redditAPIURL, hackerNewsAPIURL = redditURL, hackerNewsURL
+	}(redditAPIURL, hackerNewsAPIURL)
+	redditAPIURL, hackerNewsAPIURL = server.URL, server.URL
+
+	reddit1 := &model.Entry{URL: "https://www.reddit.com/r/golang/comments/abc123/title/"}
+	reddit2 := &model.Entry{URL: "https://old.reddit.com/r/golang/comments/def456/"}
+	hackerNews := &model.Entry{URL: "https://example.org/", CommentsURL: "https://news.ycombinator.com/item?id=123"}
+	deleted := &model.Entry{URL: "https://news.ycombinator.com/item?id=456"}
+	other := &model.Entry{URL: "https://example.org/article"}
+
+	scores := fetchScores(model.Entries{reddit1, reddit2, hackerNews, deleted, other})
+
+	if redditRequests != 1 || hackerNewsRequests != 2 {
+		t.Errorf(`Unexpected number of requests: reddit=%d hackernews=%d`, redditRequests, hackerNewsRequests)
+	}
+
+	if !scores.has(reddit1) || reddit1.Score != 42 || reddit1.CommentsCount != 7 {
+		t.Errorf(`Unexpected Reddit score: %d %d`, reddit1.Score, reddit1.CommentsCount)
+	}
+
+	if !scores.has(reddit2) || reddit2.Score != 3 {
+		t.Errorf(`Unexpected Reddit score: %d`, reddit2.Score)
+	}
+
+	if !scores.has(hackerNews) || hackerNews.Score != 120 || hackerNews.CommentsCount != 30 {
+		t.Errorf(`Unexpected Hacker News score: %d %d`, hackerNews.Score, hackerNews.CommentsCount)
+	}
+
+	if scores.has(deleted) || scores.has(other) {
+		t.Error(`Entries without score should not be considered as scored`)
+	}
+}
+
+func TestRunScoreTasksStopsAfterDeadline(t *testing.T) {
+	var calls int32
+	tasks := make([]func() error, 10)
+	for i := range tasks {
+		tasks[i] = func() error {
+			atomic.AddInt32(&calls, 1)
+			return nil
+		}
+	}
+
+	runScoreTasks(tasks, time.Now().Add(-time.Second))
+	if calls != 0 {
+		t.Errorf(`No task should run after the deadline, got %d`, calls)
+	}
+
+	runScoreTasks(tasks, time.Now().Add(time.Minute))
+	if calls != 10 {
+		t.Errorf(`All the tasks should run before the deadline, got %d`, calls)
+	}
+}
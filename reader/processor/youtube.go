@@ -0,0 +1,148 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/client"
+	"miniflux.app/model"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+var (
+	youtubeVideoRegex    = regexp.MustCompile(`youtube\.com/watch\?v=([\w-]+)`)
+	youtubeEmbedRegex    = regexp.MustCompile(`(?:https?:)?//www\.youtube(?:-nocookie)?\.com/embed/`)
+	iso8601DurationRegex = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+)
+
+func isYouTubeVideoURL(websiteURL string) bool {
+	return youtubeVideoRegex.MatchString(websiteURL)
+}
+
+// fetchYouTubeWatchTime returns the duration of a YouTube video in minutes.
+func fetchYouTubeWatchTime(websiteURL string) (int, error) {
+	matches := youtubeVideoRegex.FindStringSubmatch(websiteURL)
+	if len(matches) != 2 {
+		return 0, errors.New("youtube: unable to find the video ID")
+	}
+
+	var duration time.Duration
+	var err error
+	if apiKey := config.Opts.YouTubeAPIKey(); apiKey != "" {
+		duration, err = fetchYouTubeDurationFromAPI(matches[1], apiKey)
+	} else {
+		duration, err = fetchYouTubeDurationFromPage(websiteURL)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int(math.Ceil(duration.Minutes())), nil
+}
+
+func fetchYouTubeDurationFromPage(websiteURL string) (time.Duration, error) {
+	clt := client.NewClientWithConfig(websiteURL, config.Opts)
+	response, err := clt.Get()
+	if err != nil {
+		return 0, fmt.Errorf("youtube: unable to fetch video page: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return 0, fmt.Errorf("youtube: unable to fetch video page, status=%d", response.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(response.Body)
+	if err != nil {
+		return 0, fmt.Errorf("youtube: unable to parse video page: %v", err)
+	}
+
+	content, exists := doc.Find(`meta[itemprop="duration"]`).First().Attr("content")
+	if !exists {
+		return 0, errors.New("youtube: the video duration is missing from the page")
+	}
+
+	return parseISO8601Duration(content)
+}
+
+func fetchYouTubeDurationFromAPI(videoID, apiKey string) (time.Duration, error) {
+	values := url.Values{}
+	values.Set("part", "contentDetails")
+	values.Set("id", videoID)
+	values.Set("key", apiKey)
+
+	clt := client.NewClientWithConfig("https://www.googleapis.com/youtube/v3/videos?"+values.Encode(), config.Opts)
+	response, err := clt.Get()
+	if err != nil {
+		return 0, fmt.Errorf("youtube: unable to call the API: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return 0, fmt.Errorf("youtube: unable to call the API, status=%d", response.StatusCode)
+	}
+
+	var result struct {
+		Items []struct {
+			ContentDetails struct {
+				Duration string `json:"duration"`
+			} `json:"contentDetails"`
+		} `json:"items"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("youtube: unable to decode API response: %v", err)
+	}
+
+	if len(result.Items) == 0 {
+		return 0, fmt.Errorf("youtube: video %q not found", videoID)
+	}
+
+	return parseISO8601Duration(result.Items[0].ContentDetails.Duration)
+}
+
+// parseISO8601Duration parses durations like "PT1H2M3S" used by YouTube.
+func parseISO8601Duration(value string) (time.Duration, error) {
+	matches := iso8601DurationRegex.FindStringSubmatch(value)
+	if matches == nil || value == "P" || value == "PT" {
+		return 0, fmt.Errorf("youtube: invalid duration %q", value)
+	}
+
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var duration time.Duration
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(matches[i+1])
+		if err != nil {
+			return 0, fmt.Errorf("youtube: invalid duration %q", value)
+		}
+		duration += time.Duration(n) * unit
+	}
+
+	return duration, nil
+}
+
+// rewriteYouTubeEmbeds replaces YouTube players by the ones of an Invidious or Piped instance.
+// It runs before the sanitizer, which must then allow the instance returned with the content.
+func rewriteYouTubeEmbeds(content, embedURL string) (string, string) {
+	embedURL = model.NormalizeYouTubeEmbedURL(embedURL)
+	if embedURL == "" {
+		return content, ""
+	}
+
+	return youtubeEmbedRegex.ReplaceAllLiteralString(content, embedURL+"/embed/"), embedURL
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseISO8601Duration(t *testing.T) {
+	scenarios := map[string]time.Duration{
+		"PT4M13S":  4*time.Minute + 13*time.Second,
+		"PT1H2M3S": time.Hour + 2*time.Minute + 3*time.Second,
+		"PT45S":    45 * time.Second,
+		"P1DT2H":   26 * time.Hour,
+	}
+
+	for input, expected := range scenarios {
+		result, err := parseISO8601Duration(input)
+		if err != nil {
+			t.Errorf(`Unable to parse %q: %v`, input, err)
+		} else if result != expected {
+			t.Errorf(`Unexpected duration for %q: got %v instead of %v`, input, result, expected)
+		}
+	}
+
+	for _, input := range []string{"", "PT", "4M13S", "PT4X"} {
+		if _, err := parseISO8601Duration(input); err == nil {
+			t.Errorf(`Parsing %q should fail`, input)
+		}
+	}
+}
+
+func TestIsYouTubeVideoURL(t *testing.T) {
+	if !isYouTubeVideoURL("https://www.youtube.com/watch?v=dQw4w9WgXcQ") {
+		t.Error(`A YouTube video URL should be detected`)
+	}
+
+	if isYouTubeVideoURL("https://example.org/watch?v=1") {
+		t.Error(`Other websites should not be detected as YouTube`)
+	}
+}
+
+func TestRewriteYouTubeEmbeds(t *testing.T) {
+	input := `<iframe src="https://www.youtube.com/embed/dQw4w9WgXcQ"></iframe>`
+
+	expected := `<iframe src="https://yewtu.be/embed/dQw4w9WgXcQ"></iframe>`
+	if output, origin := rewriteYouTubeEmbeds(input, "https://yewtu.be/"); output != expected || origin != "https://yewtu.be" {
+		t.Errorf(`Unexpected output: %s (%s)`, output, origin)
+	}
+
+	if output, _ := rewriteYouTubeEmbeds(input, ""); output != input {
+		t.Errorf(`The content should not be modified without instance: %s`, output)
+	}
+
+	if output, origin := rewriteYouTubeEmbeds(input, `https://yewtu.be/" onload="alert(1)`); output != input || origin != "" {
+		t.Errorf(`The content should not be modified with an invalid instance: %s`, output)
+	}
+}
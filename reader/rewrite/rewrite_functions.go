@@ -11,6 +11,8 @@ import (
 	"regexp"
 	"strings"
 
+	"miniflux.app/logger"
+
 	"github.com/PuerkitoBio/goquery"
 )
 
@@ -197,3 +199,37 @@ func replaceTextLinks(input string) string {
 func replaceLineFeeds(input string) string {
 	return strings.Replace(input, "\n", "<br>", -1)
 }
+
+func replaceCustom(entryContent, searchTerm, replaceTerm string) string {
+	re, err := regexp.Compile(searchTerm)
+	if err != nil {
+		logger.Debug("[Rewrite] Invalid regular expression %q: %v", searchTerm, err)
+		return entryContent
+	}
+
+	return re.ReplaceAllString(entryContent, replaceTerm)
+}
+
+func removeCustom(entryContent, selector string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	doc.Find(selector).Remove()
+
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
+func addAttributeCustom(entryContent, selector, attribute, value string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	doc.Find(selector).SetAttr(attribute, value)
+
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
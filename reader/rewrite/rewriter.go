@@ -6,11 +6,18 @@ package rewrite // import "miniflux.app/reader/rewrite"
 
 import (
 	"strings"
+	"text/scanner"
+	"unicode"
 
 	"miniflux.app/logger"
 	"miniflux.app/url"
 )
 
+type rule struct {
+	name string
+	args []string
+}
+
 // Rewriter modify item contents with a set of rewriting rules.
 func Rewriter(entryURL, entryContent, customRewriteRules string) string {
 	rulesList := getPredefinedRewriteRules(entryURL)
@@ -18,33 +25,113 @@ func Rewriter(entryURL, entryContent, customRewriteRules string) string {
 		rulesList = customRewriteRules
 	}
 
-	rules := strings.Split(rulesList, ",")
-	rules = append(rules, "add_pdf_download_link")
+	rules := parseRules(rulesList)
+	rules = append(rules, rule{name: "add_pdf_download_link"})
 
 	logger.Debug(`[Rewrite] Applying rules %v for %q`, rules, entryURL)
 
 	for _, rule := range rules {
-		switch strings.TrimSpace(rule) {
-		case "add_image_title":
-			entryContent = addImageTitle(entryURL, entryContent)
-		case "add_mailto_subject":
-			entryContent = addMailtoSubject(entryURL, entryContent)
-		case "add_dynamic_image":
-			entryContent = addDynamicImage(entryURL, entryContent)
-		case "add_youtube_video":
-			entryContent = addYoutubeVideo(entryURL, entryContent)
-		case "add_invidious_video":
-			entryContent = addInvidiousVideo(entryURL, entryContent)
-		case "add_youtube_video_using_invidious_player":
-			entryContent = addYoutubeVideoUsingInvidiousPlayer(entryURL, entryContent)
-		case "add_pdf_download_link":
-			entryContent = addPDFLink(entryURL, entryContent)
-		case "nl2br":
-			entryContent = replaceLineFeeds(entryContent)
-		case "convert_text_link", "convert_text_links":
-			entryContent = replaceTextLinks(entryContent)
-		case "fix_medium_images":
-			entryContent = fixMediumImages(entryURL, entryContent)
+		entryContent = applyRule(entryURL, entryContent, rule)
+	}
+
+	return entryContent
+}
+
+func parseRules(rulesText string) (rules []rule) {
+	var currentRule rule
+	var inArgs bool
+
+	var s scanner.Scanner
+	s.Init(strings.NewReader(rulesText))
+	s.Mode = scanner.ScanIdents | scanner.ScanStrings | scanner.ScanRawStrings
+	s.IsIdentRune = func(ch rune, i int) bool {
+		return ch == '_' || unicode.IsLetter(ch) || (unicode.IsDigit(ch) && i > 0)
+	}
+	s.Error = func(s *scanner.Scanner, msg string) {}
+
+	for tok := s.Scan(); tok != scanner.EOF; tok = s.Scan() {
+		switch tok {
+		case scanner.Ident:
+			if !inArgs {
+				if currentRule.name != "" {
+					rules = append(rules, currentRule)
+				}
+				currentRule = rule{name: s.TokenText()}
+			}
+		case scanner.String, scanner.RawString:
+			if inArgs {
+				currentRule.args = append(currentRule.args, unquoteArgument(s.TokenText()))
+			}
+		case '(':
+			inArgs = true
+		case ')':
+			inArgs = false
+		}
+	}
+
+	if currentRule.name != "" {
+		rules = append(rules, currentRule)
+	}
+
+	return rules
+}
+
+// unquoteArgument removes the surrounding quotes of a rule argument.
+// Backslashes are kept as-is, except for escaped quotes, to make regular expressions easier to write.
+func unquoteArgument(text string) string {
+	if len(text) < 2 {
+		return text
+	}
+
+	if text[0] == '`' {
+		return text[1 : len(text)-1]
+	}
+
+	return strings.Replace(text[1:len(text)-1], `\"`, `"`, -1)
+}
+
+func applyRule(entryURL, entryContent string, rule rule) string {
+	switch rule.name {
+	case "add_image_title":
+		entryContent = addImageTitle(entryURL, entryContent)
+	case "add_mailto_subject":
+		entryContent = addMailtoSubject(entryURL, entryContent)
+	case "add_dynamic_image":
+		entryContent = addDynamicImage(entryURL, entryContent)
+	case "add_youtube_video":
+		entryContent = addYoutubeVideo(entryURL, entryContent)
+	case "add_invidious_video":
+		entryContent = addInvidiousVideo(entryURL, entryContent)
+	case "add_youtube_video_using_invidious_player":
+		entryContent = addYoutubeVideoUsingInvidiousPlayer(entryURL, entryContent)
+	case "add_pdf_download_link":
+		entryContent = addPDFLink(entryURL, entryContent)
+	case "nl2br":
+		entryContent = replaceLineFeeds(entryContent)
+	case "convert_text_link", "convert_text_links":
+		entryContent = replaceTextLinks(entryContent)
+	case "fix_medium_images":
+		entryContent = fixMediumImages(entryURL, entryContent)
+	case "replace":
+		// Format: replace("search-regex"|"replacement")
+		if len(rule.args) >= 2 {
+			entryContent = replaceCustom(entryContent, rule.args[0], rule.args[1])
+		} else {
+			logger.Debug("[Rewrite] Cannot find search and replace terms for replace rule %s", rule)
+		}
+	case "remove":
+		// Format: remove("css-selector")
+		if len(rule.args) >= 1 {
+			entryContent = removeCustom(entryContent, rule.args[0])
+		} else {
+			logger.Debug("[Rewrite] Cannot find selector for remove rule %s", rule)
+		}
+	case "add_attribute":
+		// Format: add_attribute("css-selector"|"attribute"|"value")
+		if len(rule.args) >= 3 {
+			entryContent = addAttributeCustom(entryContent, rule.args[0], rule.args[1], rule.args[2])
+		} else {
+			logger.Debug("[Rewrite] Cannot find selector, attribute and value for add_attribute rule %s", rule)
 		}
 	}
 
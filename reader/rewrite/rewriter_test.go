@@ -208,3 +208,71 @@ func TestMediumImage(t *testing.T) {
 		t.Errorf(`Not expected output: %s`, output)
 	}
 }
+
+func TestParseRules(t *testing.T) {
+	rules := parseRules(`add_image_title, replace("\d+"|"N"), remove(".ads, .promo"), nl2br`)
+	if len(rules) != 4 {
+		t.Fatalf(`Unexpected number of rules, got %d instead of %d`, len(rules), 4)
+	}
+
+	if rules[1].name != "replace" || len(rules[1].args) != 2 || rules[1].args[0] != `\d+` || rules[1].args[1] != "N" {
+		t.Errorf(`Unexpected replace rule: %v`, rules[1])
+	}
+
+	if rules[2].name != "remove" || len(rules[2].args) != 1 || rules[2].args[0] != ".ads, .promo" {
+		t.Errorf(`Unexpected remove rule: %v`, rules[2])
+	}
+
+	if rules[3].name != "nl2br" {
+		t.Errorf(`Unexpected rule: %v`, rules[3])
+	}
+}
+
+func TestRewriteReplaceCustom(t *testing.T) {
+	content := `<p>Visit 2 sites.</p><p>Visit 15 sites.</p>`
+	output := Rewriter("https://example.org/article", content, `replace("Visit (\d+)"|"Go to $1")`)
+	expected := `<p>Go to 2 sites.</p><p>Go to 15 sites.</p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteReplaceCustomWithInvalidRegex(t *testing.T) {
+	content := `<p>Some text.</p>`
+	output := Rewriter("https://example.org/article", content, `replace("(unclosed"|"x")`)
+
+	if content != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, content)
+	}
+}
+
+func TestRewriteRemoveCustom(t *testing.T) {
+	content := `<p>Some text.</p><div class="ads">Buy now!</div><p class="promo">Subscribe</p>`
+	output := Rewriter("https://example.org/article", content, `remove(".ads, .promo")`)
+	expected := `<p>Some text.</p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteAddAttributeCustom(t *testing.T) {
+	content := `<img data-src="https://example.org/image.png">`
+	output := Rewriter("https://example.org/article", content, `add_attribute("img"|"alt"|"Image")`)
+	expected := `<img data-src="https://example.org/image.png" alt="Image"/>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteCombinedCustomRules(t *testing.T) {
+	content := "<p>Line 1\nLine 2</p><div class=\"ads\">Ad</div>"
+	output := Rewriter("https://example.org/article", content, `remove(".ads"), nl2br`)
+	expected := `<p>Line 1<br>Line 2</p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
@@ -32,3 +32,12 @@ var predefinedRules = map[string]string{
 	"framatube.org":          "nl2br,convert_text_link",
 	"medium.com":             "fix_medium_images",
 }
+
+// PredefinedRules returns a copy of the built-in rules indexed by domain.
+func PredefinedRules() map[string]string {
+	rules := make(map[string]string, len(predefinedRules))
+	for domain, rule := range predefinedRules {
+		rules[domain] = rule
+	}
+	return rules
+}
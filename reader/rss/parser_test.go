@@ -251,6 +251,27 @@ func TestParseEntryWithMultipleAtomLinks(t *testing.T) {
 	}
 }
 
+func TestParseFeedURLIgnoresAtomHubLink(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss xmlns:atom="http://www.w3.org/2005/Atom" version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<atom:link href="https://pubsubhubbub.appspot.com/" rel="hub"></atom:link>
+			<atom:link href="https://example.org/rss" type="application/rss+xml" rel="self"></atom:link>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.FeedURL != "https://example.org/rss" {
+		t.Errorf("Incorrect feed URL, got: %s", feed.FeedURL)
+	}
+}
+
 func TestParseFeedURLWithAtomLink(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<rss xmlns:atom="http://www.w3.org/2005/Atom" version="2.0">
@@ -786,6 +807,71 @@ func TestParseEntryWithEnclosures(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithEnclosureDurations(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xmlns:media="http://search.yahoo.com/mrss/">
+		<channel>
+		<title>My Podcast Feed</title>
+		<link>http://example.org</link>
+		<item>
+			<title>Podcasting with RSS</title>
+			<link>http://www.example.org/entries/1</link>
+			<itunes:duration>1:02:03</itunes:duration>
+			<enclosure url="http://www.example.org/myaudiofile.mp3" length="12345" type="audio/mpeg" />
+			<media:content url="http://www.example.org/myvideofile.mp4" type="video/mp4" duration="120">
+				<media:thumbnail url="http://www.example.org/poster.jpg" />
+			</media:content>
+		</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries[0].Enclosures) != 2 {
+		t.Fatalf("Incorrect number of enclosures, got: %d", len(feed.Entries[0].Enclosures))
+	}
+
+	if feed.Entries[0].Enclosures[0].Duration != 3723 {
+		t.Errorf("Incorrect enclosure duration, got: %d", feed.Entries[0].Enclosures[0].Duration)
+	}
+
+	if feed.Entries[0].Enclosures[1].Duration != 120 {
+		t.Errorf("Incorrect media content duration, got: %d", feed.Entries[0].Enclosures[1].Duration)
+	}
+
+	if feed.Entries[0].Enclosures[1].PosterURL != "http://www.example.org/poster.jpg" {
+		t.Errorf("Incorrect media content poster, got: %s", feed.Entries[0].Enclosures[1].PosterURL)
+	}
+}
+
+func TestParseEntryWithGeoRSSPoint(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:georss="http://www.georss.org/georss">
+		<channel>
+		<title>Local News</title>
+		<link>http://example.org</link>
+		<item>
+			<title>Item</title>
+			<link>http://www.example.org/entries/1</link>
+			<georss:point>45.256 -71.92</georss:point>
+		</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := feed.Entries[0]
+	if !entry.HasLocation() || *entry.Latitude != 45.256 || *entry.Longitude != -71.92 {
+		t.Errorf("Incorrect entry location, got: %v %v", entry.Latitude, entry.Longitude)
+	}
+}
+
 func TestParseEntryWithEmptyEnclosureURL(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<rss version="2.0">
@@ -943,6 +1029,40 @@ func TestParseEntryWithInvalidCommentsURL(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithCommentsFeedURL(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:wfw="http://wellformedweb.org/CommentAPI/">
+		<channel>
+			<link>https://example.org/</link>
+			<item>
+				<title>Item 1</title>
+				<link>https://example.org/item1</link>
+				<wfw:commentRss>
+					https://example.org/item1/feed/
+				</wfw:commentRss>
+			</item>
+			<item>
+				<title>Item 2</title>
+				<link>https://example.org/item2</link>
+				<wfw:commentRss>/item2/feed/</wfw:commentRss>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].CommentsFeedURL != "https://example.org/item1/feed/" {
+		t.Errorf("Incorrect entry comments feed URL, got: %q", feed.Entries[0].CommentsFeedURL)
+	}
+
+	if feed.Entries[1].CommentsFeedURL != "" {
+		t.Errorf("Incorrect entry comments feed URL, got: %q", feed.Entries[1].CommentsFeedURL)
+	}
+}
+
 func TestParseInvalidXml(t *testing.T) {
 	data := `garbage`
 	_, err := Parse(bytes.NewBufferString(data))
@@ -1254,3 +1374,28 @@ func TestEntryDescriptionFromGooglePlayDescription(t *testing.T) {
 		t.Errorf(`Unexpected podcast content, got %q instead of %q`, result, expected)
 	}
 }
+
+func TestParseEntryWithCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<link>https://example.org/</link>
+			<item>
+				<link>https://example.org/item</link>
+				<category>Go</category>
+				<category domain="https://example.org/tags"> Programming </category>
+				<category></category>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := feed.Entries[0].Tags
+	if len(tags) != 2 || tags[0] != "Go" || tags[1] != "Programming" {
+		t.Errorf("Incorrect entry tags, got: %v", tags)
+	}
+}
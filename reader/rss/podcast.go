@@ -4,7 +4,11 @@
 
 package rss // import "miniflux.app/reader/rss"
 
-import "strings"
+import (
+	"strings"
+
+	"miniflux.app/model"
+)
 
 // PodcastFeedElement represents iTunes and GooglePlay feed XML elements.
 // Specs:
@@ -23,6 +27,7 @@ type PodcastEntryElement struct {
 	Subtitle              string `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd subtitle"`
 	Summary               string `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
 	GooglePlayDescription string `xml:"http://www.google.com/schemas/play-podcasts/1.0 description"`
+	ItunesDuration        string `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration"`
 }
 
 // PodcastOwner represents contact information for the podcast owner.
@@ -68,3 +73,8 @@ func (e *PodcastEntryElement) PodcastDescription() string {
 	}
 	return strings.TrimSpace(description)
 }
+
+// PodcastDuration returns the duration of the episode in seconds.
+func (e *PodcastEntryElement) PodcastDuration() int {
+	return model.ParseEnclosureDuration(e.ItunesDuration)
+}
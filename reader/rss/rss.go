@@ -15,6 +15,7 @@ import (
 	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/reader/date"
+	"miniflux.app/reader/georss"
 	"miniflux.app/reader/media"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/url"
@@ -83,7 +84,7 @@ func (r *rssFeed) siteURL() string {
 
 func (r *rssFeed) feedURL() string {
 	for _, element := range r.Links {
-		if element.XMLName.Space == "http://www.w3.org/2005/Atom" {
+		if element.XMLName.Space == "http://www.w3.org/2005/Atom" && element.Rel == "self" {
 			return strings.TrimSpace(element.Href)
 		}
 	}
@@ -151,25 +152,46 @@ type rssItem struct {
 	Authors        []rssAuthor      `xml:"author"`
 	CommentLinks   []rssCommentLink `xml:"comments"`
 	EnclosureLinks []rssEnclosure   `xml:"enclosure"`
+	Categories     []string         `xml:"category"`
 	DublinCoreElement
 	FeedBurnerElement
 	PodcastEntryElement
+	WellFormedWebElement
 	media.Element
+	georss.Location
 }
 
 func (r *rssItem) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = r.entryURL()
 	entry.CommentsURL = r.entryCommentsURL()
+	entry.CommentsFeedURL = r.entryCommentsFeedURL()
 	entry.Date = r.entryDate()
 	entry.Author = r.entryAuthor()
 	entry.Hash = r.entryHash()
 	entry.Content = r.entryContent()
 	entry.Title = r.entryTitle()
 	entry.Enclosures = r.entryEnclosures()
+	entry.Tags = r.entryTags()
+
+	if latitude, longitude, found := r.Coordinates(); found {
+		entry.SetLocation(latitude, longitude)
+	}
+
 	return entry
 }
 
+func (r *rssItem) entryTags() []string {
+	var tags []string
+	for _, category := range r.Categories {
+		if category = strings.TrimSpace(category); category != "" {
+			tags = append(tags, category)
+		}
+	}
+
+	return tags
+}
+
 func (r *rssItem) entryDate() time.Time {
 	value := r.PubDate
 	if r.DublinCoreDate != "" {
@@ -312,6 +334,7 @@ func (r *rssItem) entryEnclosures() model.EnclosureList {
 				URL:      enclosureURL,
 				MimeType: enclosure.Type,
 				Size:     enclosure.Size(),
+				Duration: r.PodcastDuration(),
 			})
 		}
 	}
@@ -320,9 +343,11 @@ func (r *rssItem) entryEnclosures() model.EnclosureList {
 		if _, found := duplicates[mediaContent.URL]; !found {
 			duplicates[mediaContent.URL] = true
 			enclosures = append(enclosures, &model.Enclosure{
-				URL:      mediaContent.URL,
-				MimeType: mediaContent.MimeType(),
-				Size:     mediaContent.Size(),
+				URL:       mediaContent.URL,
+				MimeType:  mediaContent.MimeType(),
+				Size:      mediaContent.Size(),
+				Duration:  mediaContent.DurationSeconds(),
+				PosterURL: mediaContent.PosterURL(),
 			})
 		}
 	}
@@ -356,6 +381,15 @@ func (r *rssItem) entryCommentsURL() string {
 	return ""
 }
 
+func (r *rssItem) entryCommentsFeedURL() string {
+	commentsFeedURL := strings.TrimSpace(r.WellFormedWebCommentRSS)
+	if url.IsAbsoluteURL(commentsFeedURL) {
+		return commentsFeedURL
+	}
+
+	return ""
+}
+
 func isValidLinkRelation(rel string) bool {
 	switch rel {
 	case "", "alternate", "enclosure", "related", "self", "via":
@@ -0,0 +1,10 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rss // import "miniflux.app/reader/rss"
+
+// WellFormedWebElement represents the Well-Formed Web Comment API XML elements.
+type WellFormedWebElement struct {
+	WellFormedWebCommentRSS string `xml:"http://wellformedweb.org/CommentAPI/ commentRss"`
+}
@@ -23,19 +23,28 @@ var (
 
 // Sanitize returns safe HTML.
 func Sanitize(baseURL, input string) string {
+	output, _ := SanitizeAndCountTrackers(baseURL, input)
+	return output
+}
+
+// SanitizeAndCountTrackers returns safe HTML and the number of trackers removed from the content.
+// Pixel trackers, resources loaded from known tracker hostnames, tracking scripts and ping attributes are counted.
+// Iframes are also accepted from the given origins, in addition to the built-in list.
+func SanitizeAndCountTrackers(baseURL, input string, iframeOrigins ...string) (string, int) {
 	tokenizer := html.NewTokenizer(bytes.NewBufferString(input))
 	var buffer bytes.Buffer
 	var tagStack []string
 	blacklistedTagDepth := 0
+	trackerCount := 0
 
 	for {
 		if tokenizer.Next() == html.ErrorToken {
 			err := tokenizer.Err()
 			if err == io.EOF {
-				return buffer.String()
+				return buffer.String(), trackerCount
 			}
 
-			return ""
+			return "", 0
 		}
 
 		token := tokenizer.Token()
@@ -48,9 +57,10 @@ func Sanitize(baseURL, input string) string {
 			buffer.WriteString(html.EscapeString(token.Data))
 		case html.StartTagToken:
 			tagName := token.DataAtom.String()
+			trackerCount += countTrackers(baseURL, tagName, token.Attr)
 
-			if !isPixelTracker(tagName, token.Attr) && isValidTag(tagName) {
-				attrNames, htmlAttributes := sanitizeAttributes(baseURL, tagName, token.Attr)
+			if !isTracker(baseURL, tagName, token.Attr) && isValidTag(tagName) {
+				attrNames, htmlAttributes := sanitizeAttributes(baseURL, tagName, token.Attr, iframeOrigins)
 
 				if hasRequiredAttributes(tagName, attrNames) {
 					if len(attrNames) > 0 {
@@ -73,8 +83,10 @@ func Sanitize(baseURL, input string) string {
 			}
 		case html.SelfClosingTagToken:
 			tagName := token.DataAtom.String()
-			if !isPixelTracker(tagName, token.Attr) && isValidTag(tagName) {
-				attrNames, htmlAttributes := sanitizeAttributes(baseURL, tagName, token.Attr)
+			trackerCount += countTrackers(baseURL, tagName, token.Attr)
+
+			if !isTracker(baseURL, tagName, token.Attr) && isValidTag(tagName) {
+				attrNames, htmlAttributes := sanitizeAttributes(baseURL, tagName, token.Attr, iframeOrigins)
 
 				if hasRequiredAttributes(tagName, attrNames) {
 					if len(attrNames) > 0 {
@@ -88,7 +100,7 @@ func Sanitize(baseURL, input string) string {
 	}
 }
 
-func sanitizeAttributes(baseURL, tagName string, attributes []html.Attribute) ([]string, string) {
+func sanitizeAttributes(baseURL, tagName string, attributes []html.Attribute, iframeOrigins []string) ([]string, string) {
 	var htmlAttrs, attrNames []string
 	var err error
 
@@ -105,7 +117,7 @@ func sanitizeAttributes(baseURL, tagName string, attributes []html.Attribute) ([
 
 		if isExternalResourceAttribute(attribute.Key) {
 			if tagName == "iframe" {
-				if isValidIframeSource(baseURL, attribute.Val) {
+				if isValidIframeSource(baseURL, attribute.Val) || hasIframeOrigin(attribute.Val, iframeOrigins) {
 					value = rewriteIframeURL(attribute.Val)
 				} else {
 					continue
@@ -181,17 +193,89 @@ func isExternalResourceAttribute(attribute string) bool {
 	}
 }
 
+func isTracker(baseURL, tagName string, attributes []html.Attribute) bool {
+	return isPixelTracker(tagName, attributes) || hasTrackerSource(baseURL, tagName, attributes)
+}
+
+// countTrackers returns the number of trackers found in the given tag.
+func countTrackers(baseURL, tagName string, attributes []html.Attribute) int {
+	count := 0
+
+	if isTracker(baseURL, tagName, attributes) {
+		count++
+	}
+
+	for _, attribute := range attributes {
+		if attribute.Key == "ping" {
+			count++
+		}
+	}
+
+	return count
+}
+
+func hasTrackerSource(baseURL, tagName string, attributes []html.Attribute) bool {
+	switch tagName {
+	case "img", "iframe", "script", "audio", "video", "source", "embed", "object":
+	default:
+		return false
+	}
+
+	for _, attribute := range attributes {
+		if attribute.Key == "src" || attribute.Key == "data" {
+			src, err := url.AbsoluteURL(baseURL, attribute.Val)
+			if err == nil && isTrackerResource(src) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isTrackerResource(src string) bool {
+	hostnames := []string{
+		"doubleclick.net",
+		"google-analytics.com",
+		"googletagmanager.com",
+		"googlesyndication.com",
+		"pixel.wp.com",
+		"stats.wordpress.com",
+		"pixel.quantserve.com",
+		"scorecardresearch.com",
+		"pixel.mathtag.com",
+		"pixel.facebook.com",
+		"analytics.twitter.com",
+		"pi.feedsportal.com",
+		"feeds.feedburner.com",
+		"feedblitz.com",
+		"rss.buysellads.com",
+		"mf.feeds.reuters.com",
+		"counter.yadro.ru",
+		"mc.yandex.ru",
+	}
+
+	domain := url.Domain(src)
+	for _, hostname := range hostnames {
+		if domain == hostname || strings.HasSuffix(domain, "."+hostname) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func isPixelTracker(tagName string, attributes []html.Attribute) bool {
 	if tagName == "img" {
 		hasHeight := false
 		hasWidth := false
 
 		for _, attribute := range attributes {
-			if attribute.Key == "height" && attribute.Val == "1" {
+			if attribute.Key == "height" && isTrackerDimension(attribute.Val) {
 				hasHeight = true
 			}
 
-			if attribute.Key == "width" && attribute.Val == "1" {
+			if attribute.Key == "width" && isTrackerDimension(attribute.Val) {
 				hasWidth = true
 			}
 		}
@@ -202,6 +286,15 @@ func isPixelTracker(tagName string, attributes []html.Attribute) bool {
 	return false
 }
 
+func isTrackerDimension(value string) bool {
+	switch strings.TrimSpace(value) {
+	case "0", "1", "0px", "1px":
+		return true
+	default:
+		return false
+	}
+}
+
 func hasRequiredAttributes(tagName string, attributes []string) bool {
 	elements := make(map[string][]string)
 	elements["a"] = []string{"href"}
@@ -331,6 +424,16 @@ func isValidIframeSource(baseURL, src string) bool {
 	return false
 }
 
+func hasIframeOrigin(src string, origins []string) bool {
+	for _, origin := range origins {
+		if origin != "" && strings.HasPrefix(src, strings.TrimSuffix(origin, "/")+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getTagAllowList() map[string][]string {
 	whitelist := make(map[string][]string)
 	whitelist["img"] = []string{"alt", "title", "src", "srcset", "sizes"}
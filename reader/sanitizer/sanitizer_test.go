@@ -143,6 +143,16 @@ func TestInvalidIFrame(t *testing.T) {
 	}
 }
 
+func TestIFrameWithAllowedOrigin(t *testing.T) {
+	input := `<iframe src="https://yewtu.be/embed/dQw4w9WgXcQ"></iframe><iframe src="https://yewtu.be.example.org/embed/dQw4w9WgXcQ"></iframe>`
+	expected := `<iframe src="https://yewtu.be/embed/dQw4w9WgXcQ" sandbox="allow-scripts allow-same-origin allow-popups" loading="lazy"></iframe></iframe>`
+	output, _ := SanitizeAndCountTrackers("http://example.com/", input, "https://yewtu.be")
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
 func TestInvalidURLScheme(t *testing.T) {
 	input := `<p>This link is <a src="file:///etc/passwd">not valid</a></p>`
 	expected := `<p>This link is not valid</p>`
@@ -510,3 +520,41 @@ func TestReplaceStyle(t *testing.T) {
 		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
 	}
 }
+
+func TestSanitizeAndCountTrackers(t *testing.T) {
+	input := `<p>Text</p>` +
+		`<img src="https://example.org/pixel.gif" width="1" height="1">` +
+		`<img src="https://www.google-analytics.com/collect?v=1">` +
+		`<script src="https://ssl.google-analytics.com/ga.js"></script>` +
+		`<a href="https://example.org/" ping="https://tracker.example.org/">Link</a>` +
+		`<img src="https://example.org/image.png">`
+	expected := `<p>Text</p><a href="https://example.org/" rel="noopener noreferrer" target="_blank" referrerpolicy="no-referrer">Link</a><img src="https://example.org/image.png" loading="lazy">`
+	output, count := SanitizeAndCountTrackers("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+
+	if count != 4 {
+		t.Errorf(`Wrong tracker count, got %d instead of %d`, count, 4)
+	}
+}
+
+func TestSanitizeWithoutTrackers(t *testing.T) {
+	input := `<p>Text <img src="https://example.org/image.png" width="100" height="1"></p>`
+	_, count := SanitizeAndCountTrackers("http://example.org/", input)
+
+	if count != 0 {
+		t.Errorf(`Wrong tracker count, got %d instead of %d`, count, 0)
+	}
+}
+
+func TestZeroSizePixelTracker(t *testing.T) {
+	input := `<p><img src="https://tracker1.example.org/" height="0" width="0"> and <img src="https://tracker2.example.org/" height="1px" width="1px"/></p>`
+	expected := `<p> and </p>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
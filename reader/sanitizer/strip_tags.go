@@ -7,6 +7,7 @@ package sanitizer // import "miniflux.app/reader/sanitizer"
 import (
 	"bytes"
 	"io"
+	"strings"
 
 	"golang.org/x/net/html"
 )
@@ -33,3 +34,15 @@ func StripTags(input string) string {
 		}
 	}
 }
+
+// Excerpt returns the beginning of the HTML input as plain text, truncated to the given number of characters.
+func Excerpt(input string, length int) string {
+	text := strings.Join(strings.Fields(StripTags(input)), " ")
+
+	runes := []rune(text)
+	if len(runes) > length {
+		return strings.TrimSpace(string(runes[:length])) + "…"
+	}
+
+	return text
+}
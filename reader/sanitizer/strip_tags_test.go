@@ -15,3 +15,18 @@ func TestStripTags(t *testing.T) {
 		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
 	}
 }
+
+func TestExcerpt(t *testing.T) {
+	scenarios := map[string]string{
+		`<p>Short   one</p>`:                  `Short one`,
+		`<p>Some <b>long</b></p> <p>text</p>`: `Some long…`,
+		`<p>Déjà vu, encore une fois</p>`:     `Déjà vu,…`,
+		``:                                    ``,
+	}
+
+	for input, expected := range scenarios {
+		if output := Excerpt(input, 9); output != expected {
+			t.Errorf(`Wrong excerpt for %q: %q instead of %q`, input, output, expected)
+		}
+	}
+}
@@ -46,3 +46,12 @@ var predefinedRules = map[string]string{
 	"zdnet.com":            "div.storyBody",
 	"openingsource.org":    "article.suxing-popup-gallery",
 }
+
+// PredefinedRules returns a copy of the built-in rules indexed by domain.
+func PredefinedRules() map[string]string {
+	rules := make(map[string]string, len(predefinedRules))
+	for domain, rule := range predefinedRules {
+		rules[domain] = rule
+	}
+	return rules
+}
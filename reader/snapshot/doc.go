@@ -0,0 +1,10 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package snapshot makes self-contained copies of web pages.
+
+*/
+package snapshot // import "miniflux.app/reader/snapshot"
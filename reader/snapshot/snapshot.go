@@ -0,0 +1,284 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package snapshot // import "miniflux.app/reader/snapshot"
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"miniflux.app/config"
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/url"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// Web pages bigger than this limit are not archived.
+	maxPageSize = 5 * 1024 * 1024
+
+	// Resources bigger than this limit are linked instead of being embedded.
+	maxResourceSize = 5 * 1024 * 1024
+
+	// Once this many bytes of data URLs have been embedded, the remaining resources are linked.
+	maxEmbeddedSize = 20 * 1024 * 1024
+
+	// Snapshots bigger than this limit are discarded.
+	maxSnapshotSize = 30 * 1024 * 1024
+)
+
+var (
+	cssURLRegex = regexp.MustCompile(`url\(\s*['"]?([^'")]+?)['"]?\s*\)`)
+
+	// Elements which are useless or unsafe in a static copy of the page.
+	removedElements = strings.Join([]string{
+		"script",
+		"noscript",
+		"iframe",
+		"frame",
+		"frameset",
+		"object",
+		"embed",
+		"base",
+		"meta[http-equiv]",
+		"meta[charset]",
+		"link[rel=preload]",
+		"link[rel=prefetch]",
+		"link[rel=modulepreload]",
+		"link[rel=preconnect]",
+		"link[rel=dns-prefetch]",
+		"picture source",
+	}, ", ")
+)
+
+type fetchFunc func(resourceURL string) (contentType string, body []byte, err error)
+
+// Capture downloads a web page with its images and stylesheets and returns a self-contained HTML document.
+func Capture(websiteURL, userAgent string, fetchViaProxy bool) ([]byte, error) {
+	response, err := get(websiteURL, userAgent, fetchViaProxy, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAllowedContentType(response.ContentType) {
+		return nil, fmt.Errorf("snapshot: this resource is not a HTML document (%s)", response.ContentType)
+	}
+
+	if err := response.EnsureUnicodeBody(); err != nil {
+		return nil, err
+	}
+
+	fetch := func(resourceURL string) (string, []byte, error) {
+		resource, err := get(resourceURL, userAgent, fetchViaProxy, maxResourceSize)
+		if err != nil {
+			return "", nil, err
+		}
+
+		body, err := ioutil.ReadAll(resource.Body)
+		return resource.ContentType, body, err
+	}
+
+	// The entry URL could redirect somewhere else.
+	return newDocument(response.Body, response.EffectiveURL, fetch, maxSnapshotSize)
+}
+
+func get(resourceURL, userAgent string, fetchViaProxy bool, maxBodySize int64) (*client.Response, error) {
+	clt := client.NewClientWithConfig(resourceURL, config.Opts)
+	if userAgent != "" {
+		clt.WithUserAgent(userAgent)
+	}
+
+	if fetchViaProxy {
+		clt.WithProxy()
+	}
+
+	if maxBodySize > 0 {
+		clt.WithMaxBodySize(maxBodySize)
+	}
+
+	response, err := clt.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if response.HasServerFailure() {
+		return nil, errors.New("snapshot: unable to download web page")
+	}
+
+	return response, nil
+}
+
+func newDocument(page io.Reader, pageURL string, fetch fetchFunc, maxSize int) ([]byte, error) {
+	document, err := goquery.NewDocumentFromReader(page)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &snapshot{pageURL: pageURL, fetch: fetch, resources: make(map[string]string)}
+
+	document.Find(removedElements).Remove()
+
+	document.Find("style").Each(func(i int, style *goquery.Selection) {
+		style.SetText(s.embedStylesheetResources(style.Text(), pageURL))
+	})
+
+	document.Find("link[href]").Each(func(i int, link *goquery.Selection) {
+		rel := strings.ToLower(link.AttrOr("rel", ""))
+		switch {
+		case strings.Contains(rel, "stylesheet"):
+			s.inlineStylesheet(link)
+		case strings.Contains(rel, "icon"):
+			link.SetAttr("href", s.embed(link.AttrOr("href", ""), pageURL, "image/"))
+		default:
+			link.SetAttr("href", s.absoluteURL(link.AttrOr("href", ""), pageURL))
+		}
+	})
+
+	document.Find("[style]").Each(func(i int, element *goquery.Selection) {
+		element.SetAttr("style", s.embedStylesheetResources(element.AttrOr("style", ""), pageURL))
+	})
+
+	document.Find("img").Each(func(i int, img *goquery.Selection) {
+		// Lazy loaded images keep the real source in a data attribute.
+		src := img.AttrOr("data-src", img.AttrOr("src", ""))
+		img.SetAttr("src", s.embed(src, pageURL, "image/"))
+		img.RemoveAttr("srcset")
+		img.RemoveAttr("data-src")
+		img.RemoveAttr("data-srcset")
+		img.RemoveAttr("loading")
+	})
+
+	document.Find("a[href], area[href]").Each(func(i int, link *goquery.Selection) {
+		link.SetAttr("href", s.absoluteURL(link.AttrOr("href", ""), pageURL))
+	})
+
+	document.Find("video[src], audio[src], video source[src], audio source[src], track[src]").Each(func(i int, media *goquery.Selection) {
+		media.SetAttr("src", s.absoluteURL(media.AttrOr("src", ""), pageURL))
+	})
+
+	document.Find("video[poster]").Each(func(i int, video *goquery.Selection) {
+		video.SetAttr("poster", s.embed(video.AttrOr("poster", ""), pageURL, "image/"))
+	})
+
+	// The page has been converted to UTF-8 by the HTTP client.
+	document.Find("head").PrependHtml(`<meta charset="utf-8">`)
+
+	output, err := document.Html()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) > maxSize {
+		return nil, fmt.Errorf("snapshot: the web page is too large (%d bytes)", len(output))
+	}
+
+	return []byte(output), nil
+}
+
+type snapshot struct {
+	pageURL      string
+	fetch        fetchFunc
+	resources    map[string]string
+	embeddedSize int
+}
+
+func (s *snapshot) inlineStylesheet(link *goquery.Selection) {
+	href := s.absoluteURL(link.AttrOr("href", ""), s.pageURL)
+	contentType, body, err := s.fetch(href)
+	if err != nil || !strings.HasPrefix(strings.ToLower(contentType), "text/css") {
+		logger.Debug(`[Snapshot] Unable to inline the stylesheet %q: %v`, href, err)
+		link.SetAttr("href", href)
+		return
+	}
+
+	// The link element becomes a style element to keep its position in the cascade.
+	media := link.AttrOr("media", "")
+	node := link.Get(0)
+	node.Data = "style"
+	node.DataAtom = atom.Style
+	node.Attr = nil
+	if media != "" {
+		node.Attr = []html.Attribute{{Key: "media", Val: media}}
+	}
+
+	css := s.embedStylesheetResources(string(body), href)
+	link.SetText(strings.Replace(css, "</style", `<\/style`, -1))
+}
+
+func (s *snapshot) embedStylesheetResources(stylesheet, stylesheetURL string) string {
+	return cssURLRegex.ReplaceAllStringFunc(stylesheet, func(match string) string {
+		resourceURL := cssURLRegex.FindStringSubmatch(match)[1]
+		return `url("` + s.embed(resourceURL, stylesheetURL, "") + `")`
+	})
+}
+
+// embed returns the resource as a data URL, or its absolute URL when it cannot be embedded.
+func (s *snapshot) embed(resourceURL, baseURL, contentTypePrefix string) string {
+	resourceURL = strings.TrimSpace(resourceURL)
+	if resourceURL == "" || strings.HasPrefix(resourceURL, "data:") || strings.HasPrefix(resourceURL, "#") {
+		return resourceURL
+	}
+
+	resourceURL = s.absoluteURL(resourceURL, baseURL)
+	if dataURL, found := s.resources[resourceURL]; found {
+		return dataURL
+	}
+
+	if s.embeddedSize >= maxEmbeddedSize {
+		return resourceURL
+	}
+
+	contentType, body, err := s.fetch(resourceURL)
+	if err != nil {
+		logger.Debug(`[Snapshot] Unable to embed %q: %v`, resourceURL, err)
+		return resourceURL
+	}
+
+	if contentType == "" {
+		contentType = http.DetectContentType(body)
+	}
+
+	if !strings.HasPrefix(strings.ToLower(contentType), contentTypePrefix) {
+		return resourceURL
+	}
+
+	dataURL := "data:" + strings.Replace(contentType, " ", "", -1) + ";base64," + base64.StdEncoding.EncodeToString(body)
+	if s.embeddedSize+len(dataURL) > maxEmbeddedSize {
+		return resourceURL
+	}
+
+	s.embeddedSize += len(dataURL)
+	s.resources[resourceURL] = dataURL
+	return dataURL
+}
+
+func (s *snapshot) absoluteURL(link, baseURL string) string {
+	link = strings.TrimSpace(link)
+	if link == "" || strings.HasPrefix(link, "#") || strings.HasPrefix(link, "data:") || strings.HasPrefix(link, "mailto:") {
+		return link
+	}
+
+	absoluteURL, err := url.AbsoluteURL(baseURL, link)
+	if err != nil {
+		return link
+	}
+
+	return absoluteURL
+}
+
+func isAllowedContentType(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	return strings.HasPrefix(contentType, "text/html") ||
+		strings.HasPrefix(contentType, "application/xhtml+xml")
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package snapshot // import "miniflux.app/reader/snapshot"
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+var resources = map[string]struct {
+	contentType string
+	body        string
+}{
+	"https://example.org/style.css":       {"text/css; charset=utf-8", `body { background: url(img/bg.png); }`},
+	"https://example.org/img/bg.png":      {"image/png", "bg"},
+	"https://example.org/images/cat.jpg":  {"image/jpeg", "cat"},
+	"https://example.org/images/lazy.jpg": {"image/jpeg", "lazy"},
+	"https://example.org/page.html":       {"text/html", "<p>not an image</p>"},
+}
+
+func fakeFetch(resourceURL string) (string, []byte, error) {
+	if resource, found := resources[resourceURL]; found {
+		return resource.contentType, []byte(resource.body), nil
+	}
+
+	return "", nil, errors.New("not found")
+}
+
+func capture(t *testing.T, input string) string {
+	output, err := newDocument(strings.NewReader(input), "https://example.org/articles/1", fakeFetch, maxSnapshotSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(output)
+}
+
+func TestDiscardTooLargeSnapshot(t *testing.T) {
+	input := `<html><head></head><body><img src="/images/cat.jpg"><p>` + strings.Repeat("a", 512) + `</p></body></html>`
+	if _, err := newDocument(strings.NewReader(input), "https://example.org/articles/1", fakeFetch, 256); err == nil {
+		t.Error(`A snapshot bigger than the limit should be discarded`)
+	}
+
+	if _, err := newDocument(strings.NewReader(input), "https://example.org/articles/1", fakeFetch, 1024); err != nil {
+		t.Errorf(`A snapshot smaller than the limit should be kept: %v`, err)
+	}
+}
+
+func TestEmbedImages(t *testing.T) {
+	output := capture(t, `<html><body>
+		<img src="/images/cat.jpg" srcset="/images/cat-2x.jpg 2x">
+		<img src="data:image/gif;base64,R0lGOD" data-src="/images/lazy.jpg">
+		<img src="/images/missing.jpg">
+		<img src="/page.html">
+	</body></html>`)
+
+	for _, expected := range []string{
+		`<img src="data:image/jpeg;base64,Y2F0"/>`,
+		`<img src="data:image/jpeg;base64,bGF6eQ=="/>`,
+		`<img src="https://example.org/images/missing.jpg"/>`,
+		`<img src="https://example.org/page.html"/>`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf(`Unable to find %q in %s`, expected, output)
+		}
+	}
+}
+
+func TestInlineStylesheets(t *testing.T) {
+	output := capture(t, `<html><head>
+		<link rel="stylesheet" href="/style.css" media="screen">
+		<link rel="stylesheet" href="/missing.css">
+	</head><body><div style="background-image: url('/images/cat.jpg')"></div></body></html>`)
+
+	for _, expected := range []string{
+		`<style media="screen">body { background: url("data:image/png;base64,Ymc="); }</style>`,
+		`<link rel="stylesheet" href="https://example.org/missing.css"/>`,
+		`<div style="background-image: url(&#34;data:image/jpeg;base64,Y2F0&#34;)"></div>`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf(`Unable to find %q in %s`, expected, output)
+		}
+	}
+}
+
+func TestRemoveScriptsAndMakeLinksAbsolute(t *testing.T) {
+	output := capture(t, `<html><head>
+		<meta charset="iso-8859-1">
+		<base href="https://cdn.example.org/">
+		<script src="/app.js"></script>
+	</head><body>
+		<a href="../about">About</a>
+		<a href="#top">Top</a>
+		<iframe src="https://example.org/embed"></iframe>
+	</body></html>`)
+
+	for _, unexpected := range []string{"<script", "<iframe", "<base", "iso-8859-1"} {
+		if strings.Contains(output, unexpected) {
+			t.Errorf(`Unexpected %q in %s`, unexpected, output)
+		}
+	}
+
+	for _, expected := range []string{
+		`<meta charset="utf-8"/>`,
+		`<a href="https://example.org/about">About</a>`,
+		`<a href="#top">Top</a>`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf(`Unable to find %q in %s`, expected, output)
+		}
+	}
+}
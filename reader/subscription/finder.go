@@ -13,6 +13,8 @@ import (
 	"miniflux.app/config"
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
+	"miniflux.app/integration/rssbridge"
+	"miniflux.app/logger"
 	"miniflux.app/reader/browser"
 	"miniflux.app/reader/parser"
 	"miniflux.app/url"
@@ -24,13 +26,19 @@ var (
 	errUnreadableDoc    = "Unable to analyze this page: %v"
 	youtubeChannelRegex = regexp.MustCompile(`youtube\.com/channel/(.*)`)
 	youtubeVideoRegex   = regexp.MustCompile(`youtube\.com/watch\?v=(.*)`)
+	youtubeFeedRegex    = regexp.MustCompile(`youtube\.com/feeds/videos\.xml\?channel_id=UC([\w-]+)$`)
 )
 
 // FindSubscriptions downloads and try to find one or more subscriptions from an URL.
-func FindSubscriptions(websiteURL, userAgent, username, password string, fetchViaProxy bool) (Subscriptions, *errors.LocalizedError) {
+// When the website doesn't expose any feed, RSS-Bridge is used to generate one if an endpoint is provided.
+func FindSubscriptions(websiteURL, userAgent, username, password string, fetchViaProxy bool, rssBridgeURL string) (Subscriptions, *errors.LocalizedError) {
 	websiteURL = findYoutubeChannelFeed(websiteURL)
 	websiteURL = parseYoutubeVideoPage(websiteURL)
 
+	if subscriptions := findYoutubeChannelSubscriptions(websiteURL); subscriptions != nil {
+		return subscriptions, nil
+	}
+
 	clt := client.NewClientWithConfig(websiteURL, config.Opts)
 	clt.WithCredentials(username, password)
 	clt.WithUserAgent(userAgent)
@@ -46,11 +54,15 @@ func FindSubscriptions(websiteURL, userAgent, username, password string, fetchVi
 
 	body := response.BodyAsString()
 	if format := parser.DetectFeedFormat(body); format != parser.FormatUnknown {
+		// Keep the response to avoid downloading the feed again when creating the subscription.
+		response.Body = strings.NewReader(body)
+
 		var subscriptions Subscriptions
 		subscriptions = append(subscriptions, &Subscription{
-			Title: response.EffectiveURL,
-			URL:   response.EffectiveURL,
-			Type:  format,
+			Title:    response.EffectiveURL,
+			URL:      response.EffectiveURL,
+			Type:     format,
+			Response: response,
 		})
 
 		return subscriptions, nil
@@ -61,7 +73,31 @@ func FindSubscriptions(websiteURL, userAgent, username, password string, fetchVi
 		return subscriptions, err
 	}
 
-	return tryWellKnownUrls(websiteURL, userAgent, username, password)
+	subscriptions, err = tryWellKnownUrls(websiteURL, userAgent, username, password)
+	if err != nil || subscriptions != nil || rssBridgeURL == "" {
+		return subscriptions, err
+	}
+
+	return findBridgeSubscriptions(rssBridgeURL, websiteURL), nil
+}
+
+func findBridgeSubscriptions(rssBridgeURL, websiteURL string) Subscriptions {
+	bridges, err := rssbridge.DetectBridges(rssBridgeURL, websiteURL)
+	if err != nil {
+		logger.Error("[FindSubscriptions] %v", err)
+		return nil
+	}
+
+	var subscriptions Subscriptions
+	for _, bridge := range bridges {
+		subscriptions = append(subscriptions, &Subscription{
+			Title: bridge.BridgeMeta.Name,
+			URL:   bridge.URL,
+			Type:  parser.FormatAtom,
+		})
+	}
+
+	return subscriptions
 }
 
 func parseWebPage(websiteURL string, data io.Reader) (Subscriptions, *errors.LocalizedError) {
@@ -137,6 +173,27 @@ func parseYoutubeVideoPage(websiteURL string) string {
 	return websiteURL
 }
 
+// findYoutubeChannelSubscriptions offers the uploads playlist of a YouTube channel in addition to the channel feed.
+func findYoutubeChannelSubscriptions(websiteURL string) Subscriptions {
+	matches := youtubeFeedRegex.FindStringSubmatch(websiteURL)
+	if len(matches) != 2 {
+		return nil
+	}
+
+	return Subscriptions{
+		&Subscription{
+			Title: "YouTube Channel",
+			URL:   fmt.Sprintf(`https://www.youtube.com/feeds/videos.xml?channel_id=UC%s`, matches[1]),
+			Type:  parser.FormatAtom,
+		},
+		&Subscription{
+			Title: "YouTube Uploads Playlist",
+			URL:   fmt.Sprintf(`https://www.youtube.com/feeds/videos.xml?playlist_id=UU%s`, matches[1]),
+			Type:  parser.FormatAtom,
+		},
+	}
+}
+
 func tryWellKnownUrls(websiteURL, userAgent, username, password string) (Subscriptions, *errors.LocalizedError) {
 	var subscriptions Subscriptions
 	knownURLs := map[string]string{
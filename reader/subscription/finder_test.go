@@ -4,7 +4,14 @@
 
 package subscription
 
-import "testing"
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
 
 func TestFindYoutubeChannelFeed(t *testing.T) {
 	scenarios := map[string]string{
@@ -19,3 +26,57 @@ func TestFindYoutubeChannelFeed(t *testing.T) {
 		}
 	}
 }
+
+func TestFindYoutubeChannelSubscriptions(t *testing.T) {
+	subscriptions := findYoutubeChannelSubscriptions("https://www.youtube.com/feeds/videos.xml?channel_id=UC-Qj80avWItNRjkZ41rzHyw")
+	if len(subscriptions) != 2 {
+		t.Fatalf(`Unexpected number of subscriptions: %d`, len(subscriptions))
+	}
+
+	if subscriptions[0].URL != "https://www.youtube.com/feeds/videos.xml?channel_id=UC-Qj80avWItNRjkZ41rzHyw" {
+		t.Errorf(`Unexpected channel feed: %s`, subscriptions[0].URL)
+	}
+
+	if subscriptions[1].URL != "https://www.youtube.com/feeds/videos.xml?playlist_id=UU-Qj80avWItNRjkZ41rzHyw" {
+		t.Errorf(`Unexpected uploads playlist feed: %s`, subscriptions[1].URL)
+	}
+
+	if subscriptions := findYoutubeChannelSubscriptions("http://example.org/feed"); subscriptions != nil {
+		t.Errorf(`Subscriptions should not be returned for other websites`)
+	}
+}
+
+func TestFindSubscriptionsKeepsFeedResponse(t *testing.T) {
+	// The test server listens on the loopback interface.
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWED_NETWORKS", "127.0.0.0/8,::1/128")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"1234"`)
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?><rss version="2.0"><channel><title>Example</title></channel></rss>`))
+	}))
+	defer ts.Close()
+
+	subscriptions, findErr := FindSubscriptions(ts.URL, "", "", "", false, "")
+	if findErr != nil {
+		t.Fatal(findErr)
+	}
+
+	if len(subscriptions) != 1 || subscriptions[0].Response == nil {
+		t.Fatal(`The feed response should be kept`)
+	}
+
+	if subscriptions[0].Response.ETag != `"1234"` {
+		t.Errorf(`Unexpected ETag: %q`, subscriptions[0].Response.ETag)
+	}
+
+	if body := subscriptions[0].Response.BodyAsString(); body == "" {
+		t.Error(`The response body should be readable again`)
+	}
+}
@@ -4,13 +4,20 @@
 
 package subscription // import "miniflux.app/reader/subscription"
 
-import "fmt"
+import (
+	"fmt"
+
+	"miniflux.app/http/client"
+)
 
 // Subscription represents a feed subscription.
 type Subscription struct {
 	Title string `json:"title"`
 	URL   string `json:"url"`
 	Type  string `json:"type"`
+
+	// Response is the already downloaded feed when the given URL was the feed itself.
+	Response *client.Response `json:"-"`
 }
 
 func (s Subscription) String() string {
@@ -17,6 +17,7 @@ import (
 	"miniflux.app/config"
 	"miniflux.app/fever"
 	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
 	"miniflux.app/logger"
 	"miniflux.app/reader/feed"
 	"miniflux.app/storage"
@@ -168,6 +169,8 @@ func setupHandler(store *storage.Storage, feedHandler *feed.Handler, pool *worke
 	router := mux.NewRouter()
 
 	if config.Opts.BasePath() != "" {
+		// The home page of the application is at "/reader/", not "/reader".
+		router.Handle(config.Opts.BasePath(), http.RedirectHandler(config.Opts.BasePath()+"/", http.StatusMovedPermanently))
 		router = router.PathPrefix(config.Opts.BasePath()).Subrouter()
 	}
 
@@ -190,7 +193,7 @@ func setupHandler(store *storage.Storage, feedHandler *feed.Handler, pool *worke
 	}).Name("healthcheck")
 
 	router.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
-		w.Write([]byte(version.Version))
+		json.OK(w, r, version.Current())
 	}).Name("version")
 
 	if config.Opts.HasMetricsCollector() {
@@ -9,7 +9,9 @@ import (
 	"net/http"
 
 	"miniflux.app/config"
+	"miniflux.app/crypto"
 	"miniflux.app/http/request"
+	"miniflux.app/http/response"
 	"miniflux.app/logger"
 )
 
@@ -19,6 +21,10 @@ func middleware(next http.Handler) http.Handler {
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, request.ClientIPContextKey, clientIP)
 
+		// A new nonce is generated for each page, it's used by the templates and the Content Security Policy.
+		nonce := crypto.GenerateRandomStringHex(16)
+		ctx = context.WithValue(ctx, request.NonceContextKey, nonce)
+
 		if r.Header.Get("X-Forwarded-Proto") == "https" {
 			config.Opts.HTTPS = true
 		}
@@ -34,6 +40,10 @@ func middleware(next http.Handler) http.Handler {
 			w.Header().Set("Strict-Transport-Security", "max-age=31536000")
 		}
 
+		w.Header().Set("Content-Security-Policy", response.ContentSecurityPolicyWithNonce(config.Opts.ContentSecurityPolicy(), nonce))
+		w.Header().Set("X-Frame-Options", config.Opts.FrameOptions())
+		w.Header().Set("Referrer-Policy", config.Opts.ReferrerPolicy())
+
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
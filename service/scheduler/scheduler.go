@@ -11,6 +11,7 @@ import (
 	"miniflux.app/logger"
 	"miniflux.app/metric"
 	"miniflux.app/model"
+	"miniflux.app/reader/feed"
 	"miniflux.app/storage"
 	"miniflux.app/worker"
 )
@@ -32,29 +33,85 @@ func Serve(store *storage.Storage, pool *worker.Pool) {
 		config.Opts.CleanupArchiveReadDays(),
 		config.Opts.CleanupArchiveUnreadDays(),
 		config.Opts.CleanupRemoveSessionsDays(),
+		config.Opts.CleanupUnusedFeedsMonths(),
+		config.Opts.DormantFeedsDays(),
+	)
+
+	go trendingScheduler(
+		store,
+		config.Opts.TrendingFrequencyMinutes(),
+	)
+
+	go commentsScheduler(
+		store,
+		pool,
+		config.Opts.PollingFrequency(),
 	)
 }
 
 func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency, batchSize int) {
 	for range time.Tick(time.Duration(frequency) * time.Minute) {
-		jobs, err := store.NewBatch(batchSize)
+		jobs, err := store.NewBatch(batchSize, config.Opts.PollingParsingErrorLimit())
 		if err != nil {
 			logger.Error("[Scheduler:Feed] %v", err)
 		} else {
 			logger.Debug("[Scheduler:Feed] Pushing %d jobs", len(jobs))
-			pool.Push(jobs)
+			if config.Opts.HasPollingSpreadBatch() {
+				spreadJobs(pool, jobs, time.Duration(frequency)*time.Minute)
+			} else {
+				pool.Push(jobs)
+			}
 		}
 	}
 }
 
-func cleanupScheduler(store *storage.Storage, frequency, archiveReadDays, archiveUnreadDays, sessionsDays int) {
+// spreadJobs pushes the jobs one by one at regular intervals over the given period.
+// The jobs are pushed by a separate goroutine with its own ticker to not delay the next batch.
+func spreadJobs(pool *worker.Pool, jobs model.JobList, period time.Duration) {
+	if len(jobs) == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(period / time.Duration(len(jobs)))
+		defer ticker.Stop()
+
+		for i, job := range jobs {
+			if i > 0 {
+				<-ticker.C
+			}
+			pool.Push(model.JobList{job})
+		}
+	}()
+}
+
+func cleanupScheduler(store *storage.Storage, frequency, archiveReadDays, archiveUnreadDays, sessionsDays, unusedFeedsMonths, dormantFeedsDays int) {
 	for range time.Tick(time.Duration(frequency) * time.Hour) {
 		nbSessions := store.CleanOldSessions(sessionsDays)
 		nbUserSessions := store.CleanOldUserSessions(sessionsDays)
 		logger.Info("[Scheduler:Cleanup] Cleaned %d sessions and %d user sessions", nbSessions, nbUserSessions)
 
+		// Clients syncing less often than the archiving period need a full synchronization anyway.
+		nbTombstones := store.CleanOldEntryTombstones(archiveReadDays)
+		logger.Info("[Scheduler:Cleanup] Cleaned %d entry tombstones", nbTombstones)
+
+		nbCommentSubscriptions := store.CleanExpiredEntryCommentSubscriptions()
+		logger.Info("[Scheduler:Cleanup] Cleaned %d expired comment subscriptions", nbCommentSubscriptions)
+
+		nbFeedContents := store.CleanFeedContents(config.Opts.PollingSharedFetchMinutes())
+		logger.Info("[Scheduler:Cleanup] Cleaned %d shared feed contents", nbFeedContents)
+
+		nbFeedEntryCounts := store.CleanOldFeedEntryCounts()
+		logger.Info("[Scheduler:Cleanup] Cleaned %d feed entry counts", nbFeedEntryCounts)
+
+		nbSentEmails := store.CleanOldSentEmails()
+		logger.Info("[Scheduler:Cleanup] Cleaned %d sent emails", nbSentEmails)
+
+		nbSnapshots := store.CleanOldEntrySnapshots(config.Opts.SnapshotRetentionDays())
+		logger.Info("[Scheduler:Cleanup] Cleaned %d entry snapshots", nbSnapshots)
+
 		startTime := time.Now()
-		if rowsAffected, err := store.ArchiveEntries(model.EntryStatusRead, archiveReadDays); err != nil {
+		if rowsAffected, err := store.ArchiveReadEntries(archiveReadDays, config.Opts.CleanupArchiveReadDaysMax()); err != nil {
 			logger.Error("[Scheduler:ArchiveReadEntries] %v", err)
 		} else {
 			logger.Info("[Scheduler:ArchiveReadEntries] %d entries changed", rowsAffected)
@@ -74,5 +131,68 @@ func cleanupScheduler(store *storage.Storage, frequency, archiveReadDays, archiv
 				metric.ArchiveEntriesDuration.WithLabelValues(model.EntryStatusUnread).Observe(time.Since(startTime).Seconds())
 			}
 		}
+
+		if rowsAffected, err := store.FlagUnusedFeeds(unusedFeedsMonths); err != nil {
+			logger.Error("[Scheduler:UnusedFeeds] %v", err)
+		} else {
+			logger.Info("[Scheduler:UnusedFeeds] %d feeds changed", rowsAffected)
+		}
+
+		if rowsAffected, err := store.FlagDormantFeeds(dormantFeedsDays); err != nil {
+			logger.Error("[Scheduler:DormantFeeds] %v", err)
+		} else {
+			logger.Info("[Scheduler:DormantFeeds] %d feeds changed", rowsAffected)
+		}
+	}
+}
+
+func trendingScheduler(store *storage.Storage, frequency int) {
+	for range time.Tick(time.Duration(frequency) * time.Minute) {
+		users, err := store.Users()
+		if err != nil {
+			logger.Error("[Scheduler:Trending] %v", err)
+			continue
+		}
+
+		for _, user := range users {
+			builder := store.NewEntryQueryBuilder(user.ID)
+			builder.WithoutStatus(model.EntryStatusRemoved)
+			builder.AfterDate(time.Now().Add(-24 * time.Hour))
+
+			entries, err := builder.GetEntries()
+			if err != nil {
+				logger.Error("[Scheduler:Trending] %v", err)
+				continue
+			}
+
+			topics := model.FindTrendingTopics(entries)
+			if err := store.UpdateTrendingTopics(user.ID, topics); err != nil {
+				logger.Error("[Scheduler:Trending] %v", err)
+				continue
+			}
+
+			logger.Debug("[Scheduler:Trending] Found %d topics for user #%d", len(topics), user.ID)
+		}
+	}
+}
+
+func commentsScheduler(store *storage.Storage, pool *worker.Pool, frequency int) {
+	handler := feed.NewFeedHandler(store)
+
+	for range time.Tick(time.Duration(frequency) * time.Minute) {
+		subscriptions, err := store.ActiveEntryCommentSubscriptions()
+		if err != nil {
+			logger.Error("[Scheduler:Comments] %v", err)
+			continue
+		}
+
+		for _, subscription := range subscriptions {
+			subscription := subscription
+			pool.Run(func() {
+				if err := handler.RefreshEntryComments(subscription); err != nil {
+					logger.Error("[Scheduler:Comments] Entry #%d: %v", subscription.EntryID, err)
+				}
+			})
+		}
 	}
 }
@@ -0,0 +1,125 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// AnnotationByID returns an annotation of the given user.
+func (s *Storage) AnnotationByID(userID, annotationID int64) (*model.Annotation, error) {
+	annotations, err := s.fetchAnnotations(`a.user_id=$1 AND a.id=$2`, userID, annotationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(annotations) == 0 {
+		return nil, nil
+	}
+
+	return annotations[0], nil
+}
+
+// EntryAnnotations returns the annotations written by the user on the given entry.
+func (s *Storage) EntryAnnotations(userID, entryID int64) (model.Annotations, error) {
+	return s.fetchAnnotations(`a.user_id=$1 AND a.entry_id=$2`, userID, entryID)
+}
+
+// Annotations returns all annotations of the given user.
+func (s *Storage) Annotations(userID int64) (model.Annotations, error) {
+	return s.fetchAnnotations(`a.user_id=$1`, userID)
+}
+
+func (s *Storage) fetchAnnotations(condition string, args ...interface{}) (model.Annotations, error) {
+	query := `
+		SELECT
+			a.id, a.user_id, a.entry_id, a.quote, a.note, a.hypothesis_id, a.created_at, e.url, e.title
+		FROM
+			annotations a
+		JOIN
+			entries e ON e.id=a.entry_id
+		WHERE
+			%s
+		ORDER BY a.created_at ASC, a.id ASC
+	`
+	rows, err := s.db.Query(fmt.Sprintf(query, condition), args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch annotations: %v`, err)
+	}
+	defer rows.Close()
+
+	annotations := make(model.Annotations, 0)
+	for rows.Next() {
+		var annotation model.Annotation
+		if err := rows.Scan(
+			&annotation.ID,
+			&annotation.UserID,
+			&annotation.EntryID,
+			&annotation.Quote,
+			&annotation.Note,
+			&annotation.HypothesisID,
+			&annotation.CreatedAt,
+			&annotation.EntryURL,
+			&annotation.EntryTitle,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch annotation row: %v`, err)
+		}
+
+		annotations = append(annotations, &annotation)
+	}
+
+	return annotations, nil
+}
+
+// CreateAnnotation inserts a new annotation.
+func (s *Storage) CreateAnnotation(annotation *model.Annotation) error {
+	query := `
+		INSERT INTO annotations
+			(user_id, entry_id, quote, note)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		annotation.UserID,
+		annotation.EntryID,
+		annotation.Quote,
+		annotation.Note,
+	).Scan(
+		&annotation.ID,
+		&annotation.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create annotation: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateAnnotationHypothesisID remembers the identifier of the annotation published on Hypothesis.
+func (s *Storage) UpdateAnnotationHypothesisID(annotationID int64, hypothesisID string) error {
+	query := `UPDATE annotations SET hypothesis_id=$1 WHERE id=$2`
+	if _, err := s.db.Exec(query, hypothesisID, annotationID); err != nil {
+		return fmt.Errorf(`store: unable to update annotation #%d: %v`, annotationID, err)
+	}
+
+	return nil
+}
+
+// RemoveAnnotation deletes an annotation.
+func (s *Storage) RemoveAnnotation(userID, annotationID int64) error {
+	query := `DELETE FROM annotations WHERE id=$1 AND user_id=$2`
+	_, err := s.db.Exec(query, annotationID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this annotation: %v`, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// BlockedFeedExists checks if the URL or the domain is already blocked.
+func (s *Storage) BlockedFeedExists(pattern string) bool {
+	var result bool
+	query := `SELECT true FROM blocked_feeds WHERE pattern=$1 LIMIT 1`
+	s.db.QueryRow(query, pattern).Scan(&result)
+	return result
+}
+
+// BlockedFeeds returns the feed URLs and the domains blocked on this instance.
+func (s *Storage) BlockedFeeds() (model.BlockedFeeds, error) {
+	query := `SELECT id, pattern, reason, created_at FROM blocked_feeds ORDER BY pattern ASC`
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch blocked feeds: %v`, err)
+	}
+	defer rows.Close()
+
+	blockedFeeds := make(model.BlockedFeeds, 0)
+	for rows.Next() {
+		var blockedFeed model.BlockedFeed
+		if err := rows.Scan(
+			&blockedFeed.ID,
+			&blockedFeed.Pattern,
+			&blockedFeed.Reason,
+			&blockedFeed.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch blocked feed row: %v`, err)
+		}
+
+		blockedFeeds = append(blockedFeeds, &blockedFeed)
+	}
+
+	return blockedFeeds, nil
+}
+
+// CreateBlockedFeed blocks a feed URL or a domain.
+func (s *Storage) CreateBlockedFeed(blockedFeed *model.BlockedFeed) error {
+	query := `
+		INSERT INTO blocked_feeds
+			(pattern, reason)
+		VALUES
+			($1, $2)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		blockedFeed.Pattern,
+		blockedFeed.Reason,
+	).Scan(
+		&blockedFeed.ID,
+		&blockedFeed.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to block feed: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveBlockedFeed allows again the subscriptions to a feed URL or a domain.
+func (s *Storage) RemoveBlockedFeed(blockedFeedID int64) error {
+	query := `DELETE FROM blocked_feeds WHERE id = $1`
+	_, err := s.db.Exec(query, blockedFeedID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this blocked feed: %v`, err)
+	}
+
+	return nil
+}
+
+// EnabledFeeds returns the ID, the owner and the URL of all enabled feeds of the instance.
+func (s *Storage) EnabledFeeds() (model.Feeds, error) {
+	query := `SELECT id, user_id, feed_url FROM feeds WHERE disabled is false`
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch enabled feeds: %v`, err)
+	}
+	defer rows.Close()
+
+	feeds := make(model.Feeds, 0)
+	for rows.Next() {
+		var feed model.Feed
+		if err := rows.Scan(&feed.ID, &feed.UserID, &feed.FeedURL); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch enabled feed row: %v`, err)
+		}
+
+		feeds = append(feeds, &feed)
+	}
+
+	return feeds, nil
+}
+
+// DisableFeed disables a feed with the reason shown to its owner.
+func (s *Storage) DisableFeed(userID, feedID int64, message string) error {
+	query := `UPDATE feeds SET disabled='t', parsing_error_msg=$1 WHERE id=$2 AND user_id=$3`
+	if _, err := s.db.Exec(query, message, feedID, userID); err != nil {
+		return fmt.Errorf(`store: unable to disable feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
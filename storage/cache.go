@@ -0,0 +1,76 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"miniflux.app/live"
+	"miniflux.app/logger"
+)
+
+const counterCacheTTL = 5 * time.Minute
+
+// Cache is a key/value store shared between instances, like Redis.
+type Cache interface {
+	Get(key string) (string, bool, error)
+	Set(key, value string, ttl time.Duration) error
+	Update(key string, ttl time.Duration, update func(value string) (string, error)) (bool, error)
+	Delete(keys ...string) error
+}
+
+// EnableCache stores application sessions and navigation counters in the given cache instead of PostgreSQL.
+func (s *Storage) EnableCache(cache Cache, sessionTTL time.Duration) {
+	s.cache = cache
+	s.sessionTTL = sessionTTL
+}
+
+func appSessionCacheKey(sessionID string) string {
+	return "miniflux:session:" + sessionID
+}
+
+func unreadCounterCacheKey(userID int64) string {
+	return fmt.Sprintf("miniflux:user:%d:unread", userID)
+}
+
+func errorCounterCacheKey(userID int64) string {
+	return fmt.Sprintf("miniflux:user:%d:errors", userID)
+}
+
+// cachedCounter returns the counter stored under the given key or computes and stores it.
+func (s *Storage) cachedCounter(key string, count func() int) int {
+	if s.cache == nil {
+		return count()
+	}
+
+	if value, found, err := s.cache.Get(key); err != nil {
+		logger.Error(`store: unable to use the cache: %v`, err)
+	} else if found {
+		if n, err := strconv.Atoi(value); err == nil {
+			return n
+		}
+	}
+
+	n := count()
+	if err := s.cache.Set(key, strconv.Itoa(n), counterCacheTTL); err != nil {
+		logger.Error(`store: unable to use the cache: %v`, err)
+	}
+
+	return n
+}
+
+// countersChanged removes the cached counters of the user after entries or feeds are modified
+// and notifies the open pages.
+func (s *Storage) countersChanged(userID int64) {
+	if s.cache != nil {
+		if err := s.cache.Delete(unreadCounterCacheKey(userID), errorCounterCacheKey(userID)); err != nil {
+			logger.Error(`store: unable to use the cache: %v`, err)
+		}
+	}
+
+	s.events.Publish(userID, live.EventCountersChanged, nil)
+}
@@ -32,8 +32,8 @@ func (s *Storage) CategoryExists(userID, categoryID int64) bool {
 func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 AND id=$2`
-	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title)
+	query := `SELECT id, user_id, title, hidden FROM categories WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title, &category.Hidden)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -47,10 +47,10 @@ func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 
 // FirstCategory returns the first category for the given user.
 func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
+	query := `SELECT id, user_id, title, hidden FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
 
 	var category model.Category
-	err := s.db.QueryRow(query, userID).Scan(&category.ID, &category.UserID, &category.Title)
+	err := s.db.QueryRow(query, userID).Scan(&category.ID, &category.UserID, &category.Title, &category.Hidden)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -66,8 +66,8 @@ func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
 func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 AND title=$2`
-	err := s.db.QueryRow(query, userID, title).Scan(&category.ID, &category.UserID, &category.Title)
+	query := `SELECT id, user_id, title, hidden FROM categories WHERE user_id=$1 AND title=$2`
+	err := s.db.QueryRow(query, userID, title).Scan(&category.ID, &category.UserID, &category.Title, &category.Hidden)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -81,7 +81,7 @@ func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category,
 
 // Categories returns all categories that belongs to the given user.
 func (s *Storage) Categories(userID int64) (model.Categories, error) {
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 ORDER BY title ASC`
+	query := `SELECT id, user_id, title, hidden FROM categories WHERE user_id=$1 ORDER BY title ASC`
 	rows, err := s.db.Query(query, userID)
 	if err != nil {
 		return nil, fmt.Errorf(`store: unable to fetch categories: %v`, err)
@@ -91,7 +91,7 @@ func (s *Storage) Categories(userID int64) (model.Categories, error) {
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.Hidden); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
@@ -108,6 +108,7 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 			c.id,
 			c.user_id,
 			c.title,
+			c.hidden,
 			(SELECT count(*) FROM feeds WHERE feeds.category_id=c.id) AS count
 		FROM categories c
 		WHERE
@@ -124,7 +125,7 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.FeedCount); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.Hidden, &category.FeedCount); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
@@ -138,9 +139,9 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 func (s *Storage) CreateCategory(category *model.Category) error {
 	query := `
 		INSERT INTO categories
-			(user_id, title)
+			(user_id, title, hidden)
 		VALUES
-			($1, $2)
+			($1, $2, $3)
 		RETURNING
 			id
 	`
@@ -148,6 +149,7 @@ func (s *Storage) CreateCategory(category *model.Category) error {
 		query,
 		category.UserID,
 		category.Title,
+		category.Hidden,
 	).Scan(&category.ID)
 
 	if err != nil {
@@ -159,10 +161,11 @@ func (s *Storage) CreateCategory(category *model.Category) error {
 
 // UpdateCategory updates an existing category.
 func (s *Storage) UpdateCategory(category *model.Category) error {
-	query := `UPDATE categories SET title=$1 WHERE id=$2 AND user_id=$3`
+	query := `UPDATE categories SET title=$1, hidden=$2 WHERE id=$3 AND user_id=$4`
 	_, err := s.db.Exec(
 		query,
 		category.Title,
+		category.Hidden,
 		category.ID,
 		category.UserID,
 	)
@@ -171,25 +174,127 @@ func (s *Storage) UpdateCategory(category *model.Category) error {
 		return fmt.Errorf(`store: unable to update category: %v`, err)
 	}
 
+	s.countersChanged(category.UserID)
+
 	return nil
 }
 
 // RemoveCategory deletes a category.
 func (s *Storage) RemoveCategory(userID, categoryID int64) error {
+	feedIDs, err := s.categoryFeedIDs(userID, categoryID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if err := keepStarredEntries(tx, userID, feedIDs, categoryID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// The feeds of the category and their copies in shared categories are deleted with it.
+	if err := createEntryTombstones(tx, userID, feedIDs); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	query := `DELETE FROM categories WHERE id = $1 AND user_id = $2`
-	result, err := s.db.Exec(query, categoryID, userID)
+	result, err := tx.Exec(query, categoryID, userID)
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf(`store: unable to remove this category: %v`, err)
 	}
 
 	count, err := result.RowsAffected()
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf(`store: unable to remove this category: %v`, err)
 	}
 
 	if count == 0 {
+		tx.Rollback()
 		return errors.New(`store: no category has been removed`)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	s.countersChanged(userID)
+
+	return nil
+}
+
+// RemoveCategoryAndMoveFeeds moves all the feeds of a category to another one and deletes the category.
+func (s *Storage) RemoveCategoryAndMoveFeeds(userID, categoryID, targetCategoryID int64) error {
+	if categoryID == targetCategoryID {
+		return errors.New(`store: unable to move feeds to the category being removed`)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	// The members of a shared category lose their copies of the feeds once they leave the category.
+	condition := `
+		shared_feed_id IN (SELECT id FROM feeds WHERE user_id=$1 AND category_id=$2) AND
+		user_id IN (SELECT user_id FROM category_members WHERE category_id=$2)
+	`
+	if err := removeSharedFeedCopies(tx, condition, userID, categoryID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	query := `
+		UPDATE
+			feeds
+		SET
+			category_id=$3
+		WHERE
+			user_id=$1 AND category_id=$2
+		AND
+			EXISTS (SELECT 1 FROM categories WHERE id=$3 AND user_id=$1)
+	`
+	if _, err := tx.Exec(query, userID, categoryID, targetCategoryID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to move feeds: %v`, err)
+	}
+
+	query = `DELETE FROM categories WHERE id=$1 AND user_id=$2 AND EXISTS (SELECT 1 FROM categories WHERE id=$3 AND user_id=$2)`
+	result, err := tx.Exec(query, categoryID, userID, targetCategoryID)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove this category: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove this category: %v`, err)
+	}
+
+	if count == 0 {
+		tx.Rollback()
+		return errors.New(`store: no category has been removed`)
+	}
+
+	memberIDs, err := syncSharedCategory(tx, userID, targetCategoryID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	s.countersChanged(userID)
+	s.membersCountersChanged(memberIDs)
+
 	return nil
 }
@@ -0,0 +1,545 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// CategoryMembers returns the users following a shared category, and the users invited to follow it.
+func (s *Storage) CategoryMembers(categoryID int64) (model.CategoryMembers, error) {
+	query := `
+		SELECT
+			m.category_id,
+			m.user_id,
+			u.username,
+			COALESCE(m.member_category_id, 0),
+			m.created_at,
+			m.accepted_at
+		FROM
+			category_members m
+		JOIN
+			users u ON u.id=m.user_id
+		WHERE
+			m.category_id=$1
+		ORDER BY
+			u.username ASC
+	`
+	rows, err := s.db.Query(query, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch category members: %v`, err)
+	}
+	defer rows.Close()
+
+	members := make(model.CategoryMembers, 0)
+	for rows.Next() {
+		var member model.CategoryMember
+		if err := rows.Scan(&member.CategoryID, &member.UserID, &member.Username, &member.MemberCategoryID, &member.CreatedAt, &member.AcceptedAt); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch category member row: %v`, err)
+		}
+
+		members = append(members, &member)
+	}
+
+	return members, nil
+}
+
+// CategorySharedBy returns the username of the owner when the category of the user is a copy of a shared category.
+func (s *Storage) CategorySharedBy(userID, categoryID int64) (string, error) {
+	query := `
+		SELECT
+			u.username
+		FROM
+			category_members m
+		JOIN
+			categories c ON c.id=m.category_id
+		JOIN
+			users u ON u.id=c.user_id
+		WHERE
+			m.user_id=$1 AND m.member_category_id=$2
+	`
+	var username string
+	err := s.db.QueryRow(query, userID, categoryID).Scan(&username)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf(`store: unable to fetch category owner: %v`, err)
+	default:
+		return username, nil
+	}
+}
+
+// CategoryInvitations returns the shared categories the user has been invited to follow.
+func (s *Storage) CategoryInvitations(userID int64) (model.CategoryInvitations, error) {
+	query := `
+		SELECT
+			c.id,
+			c.title,
+			u.username,
+			m.created_at
+		FROM
+			category_members m
+		JOIN
+			categories c ON c.id=m.category_id
+		JOIN
+			users u ON u.id=c.user_id
+		WHERE
+			m.user_id=$1 AND m.accepted_at IS NULL
+		ORDER BY
+			m.created_at ASC
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch category invitations: %v`, err)
+	}
+	defer rows.Close()
+
+	invitations := make(model.CategoryInvitations, 0)
+	for rows.Next() {
+		var invitation model.CategoryInvitation
+		if err := rows.Scan(&invitation.CategoryID, &invitation.Title, &invitation.OwnerUsername, &invitation.CreatedAt); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch category invitation row: %v`, err)
+		}
+
+		invitations = append(invitations, &invitation)
+	}
+
+	return invitations, nil
+}
+
+// AddCategoryMember invites another user to follow the category.
+// The feeds are copied to the account of the member only once the invitation is accepted.
+func (s *Storage) AddCategoryMember(category *model.Category, memberID int64) error {
+	query := `
+		INSERT INTO category_members
+			(category_id, user_id)
+		VALUES
+			($1, $2)
+		ON CONFLICT (category_id, user_id) DO NOTHING
+	`
+	if _, err := s.db.Exec(query, category.ID, memberID); err != nil {
+		return fmt.Errorf(`store: unable to add category member: %v`, err)
+	}
+
+	return nil
+}
+
+// AcceptCategoryInvitation adds the feeds of the shared category to a category with the same title
+// in the account of the member.
+func (s *Storage) AcceptCategoryInvitation(memberID, categoryID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	var ownerID int64
+	var title string
+	query := `
+		SELECT
+			c.user_id, c.title
+		FROM
+			category_members m
+		JOIN
+			categories c ON c.id=m.category_id
+		WHERE
+			m.category_id=$1 AND m.user_id=$2 AND m.accepted_at IS NULL
+	`
+	if err := tx.QueryRow(query, categoryID, memberID).Scan(&ownerID, &title); err != nil {
+		tx.Rollback()
+		if err == sql.ErrNoRows {
+			return errors.New(`store: no pending invitation for this category`)
+		}
+		return fmt.Errorf(`store: unable to fetch category invitation: %v`, err)
+	}
+
+	query = `
+		WITH new_category AS (
+			INSERT INTO categories
+				(user_id, title)
+			VALUES
+				($1, $2)
+			ON CONFLICT (user_id, title) DO NOTHING
+			RETURNING
+				id
+		)
+		SELECT id FROM new_category
+		UNION
+		SELECT id FROM categories WHERE user_id=$1 AND title=$2
+	`
+	var memberCategoryID int64
+	if err := tx.QueryRow(query, memberID, title).Scan(&memberCategoryID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to create member category: %v`, err)
+	}
+
+	query = `UPDATE category_members SET member_category_id=$1, accepted_at=now() WHERE category_id=$2 AND user_id=$3`
+	if _, err := tx.Exec(query, memberCategoryID, categoryID, memberID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to accept category invitation: %v`, err)
+	}
+
+	memberIDs, err := syncSharedCategory(tx, ownerID, categoryID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	s.membersCountersChanged(memberIDs)
+
+	return nil
+}
+
+// DeclineCategoryInvitation removes a pending invitation to follow a shared category.
+func (s *Storage) DeclineCategoryInvitation(memberID, categoryID int64) error {
+	query := `DELETE FROM category_members WHERE category_id=$1 AND user_id=$2 AND accepted_at IS NULL`
+	if _, err := s.db.Exec(query, categoryID, memberID); err != nil {
+		return fmt.Errorf(`store: unable to decline category invitation: %v`, err)
+	}
+
+	return nil
+}
+
+// LeaveSharedCategory stops following a shared category, memberCategoryID is the category holding the copies of its feeds.
+func (s *Storage) LeaveSharedCategory(memberID, memberCategoryID int64) error {
+	var categoryID int64
+	query := `SELECT category_id FROM category_members WHERE user_id=$1 AND member_category_id=$2`
+	err := s.db.QueryRow(query, memberID, memberCategoryID).Scan(&categoryID)
+	switch {
+	case err == sql.ErrNoRows:
+		return errors.New(`store: this category is not shared with the user`)
+	case err != nil:
+		return fmt.Errorf(`store: unable to fetch shared category: %v`, err)
+	}
+
+	return s.RemoveCategoryMember(categoryID, memberID)
+}
+
+// RemoveCategoryMember stops sharing the category with the user and removes the copies of its feeds.
+func (s *Storage) RemoveCategoryMember(categoryID, memberID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	err = removeSharedFeedCopies(tx, `user_id=$1 AND shared_feed_id IN (SELECT id FROM feeds WHERE category_id=$2)`, memberID, categoryID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// The feeds unsubscribed by the member are copied again if the member follows the category later.
+	query := `DELETE FROM shared_feed_exclusions WHERE user_id=$1 AND feed_id IN (SELECT id FROM feeds WHERE category_id=$2)`
+	if _, err := tx.Exec(query, memberID, categoryID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove shared feed exclusions: %v`, err)
+	}
+
+	query = `DELETE FROM category_members WHERE category_id=$1 AND user_id=$2`
+	if _, err := tx.Exec(query, categoryID, memberID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove category member: %v`, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	s.countersChanged(memberID)
+
+	return nil
+}
+
+// removeSharedFeedCopies deletes the copies of shared feeds matching the condition from the accounts of the members,
+// after keeping their starred entries, and records the tombstones of the other entries.
+func removeSharedFeedCopies(tx *sql.Tx, condition string, args ...interface{}) error {
+	feedIDs, err := queryIDs(tx, `SELECT id FROM feeds WHERE shared_feed_id IS NOT NULL AND `+condition, args...)
+	if err != nil {
+		return fmt.Errorf(`store: unable to fetch shared feed copies: %v`, err)
+	}
+
+	if len(feedIDs) == 0 {
+		return nil
+	}
+
+	if err := keepFeedCopiesStarredEntries(tx, feedIDs); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO entry_tombstones (user_id, entry_id)
+			SELECT user_id, id FROM entries WHERE feed_id=ANY($1)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.Exec(query, pq.Array(feedIDs)); err != nil {
+		return fmt.Errorf(`store: unable to create entry tombstones: %v`, err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM feeds WHERE id=ANY($1)`, pq.Array(feedIDs)); err != nil {
+		return fmt.Errorf(`store: unable to remove shared feeds: %v`, err)
+	}
+
+	return nil
+}
+
+// excludeSharedFeedCopies remembers the copies of shared feeds unsubscribed by the member,
+// so they are not copied again when the shared feed is refreshed.
+func excludeSharedFeedCopies(tx *sql.Tx, userID int64, feedIDs []int64) error {
+	query := `
+		INSERT INTO shared_feed_exclusions (user_id, feed_id)
+			SELECT user_id, shared_feed_id FROM feeds WHERE user_id=$1 AND id=ANY($2) AND shared_feed_id IS NOT NULL
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.Exec(query, userID, pq.Array(feedIDs)); err != nil {
+		return fmt.Errorf(`store: unable to exclude shared feeds: %v`, err)
+	}
+
+	return nil
+}
+
+// SyncSharedCategory copies all the feeds of a shared category to its members.
+func (s *Storage) SyncSharedCategory(userID, categoryID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	memberIDs, err := syncSharedCategory(tx, userID, categoryID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	s.membersCountersChanged(memberIDs)
+
+	return nil
+}
+
+// SyncSharedFeed makes sure each member of the category of the feed has a copy of it,
+// and gives them the entries they don't have yet with their own read and starred status.
+func (s *Storage) SyncSharedFeed(feedID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	memberIDs, err := syncSharedFeed(tx, feedID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	s.membersCountersChanged(memberIDs)
+
+	return nil
+}
+
+func (s *Storage) membersCountersChanged(memberIDs []int64) {
+	for _, memberID := range memberIDs {
+		s.countersChanged(memberID)
+	}
+}
+
+// syncSharedCategory synchronizes the feeds of the category and returns the members having a copy of them.
+func syncSharedCategory(tx *sql.Tx, userID, categoryID int64) ([]int64, error) {
+	query := `SELECT id FROM feeds WHERE user_id=$1 AND category_id=$2 AND shared_feed_id IS NULL`
+	feedIDs, err := queryIDs(tx, query, userID, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch category feeds: %v`, err)
+	}
+
+	var memberIDs []int64
+	seen := make(map[int64]bool)
+	for _, feedID := range feedIDs {
+		feedMemberIDs, err := syncSharedFeed(tx, feedID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, memberID := range feedMemberIDs {
+			if !seen[memberID] {
+				seen[memberID] = true
+				memberIDs = append(memberIDs, memberID)
+			}
+		}
+	}
+
+	return memberIDs, nil
+}
+
+// syncSharedFeed synchronizes the copies of the feed and returns the members having a copy of it.
+func syncSharedFeed(tx *sql.Tx, feedID int64) ([]int64, error) {
+	var shared bool
+	query := `
+		SELECT
+			EXISTS(SELECT 1 FROM feeds WHERE shared_feed_id=$1) OR
+			EXISTS(
+				SELECT 1 FROM category_members m JOIN feeds f ON f.category_id=m.category_id
+				WHERE f.id=$1 AND f.shared_feed_id IS NULL AND m.accepted_at IS NOT NULL
+			)
+	`
+	if err := tx.QueryRow(query, feedID).Scan(&shared); err != nil {
+		return nil, fmt.Errorf(`store: unable to check shared feed #%d: %v`, feedID, err)
+	}
+
+	if !shared {
+		return nil, nil
+	}
+
+	// The feed has been moved out of the shared category or the member has been removed.
+	condition := `
+		shared_feed_id=$1 AND
+		user_id NOT IN (
+			SELECT m.user_id FROM category_members m JOIN feeds f ON f.category_id=m.category_id
+			WHERE f.id=$1 AND m.accepted_at IS NOT NULL
+		)
+	`
+	if err := removeSharedFeedCopies(tx, condition, feedID); err != nil {
+		return nil, err
+	}
+
+	queries := []string{
+		// The feed URL could have changed after a permanent redirect.
+		`
+			UPDATE
+				feeds c
+			SET
+				feed_url=f.feed_url,
+				site_url=f.site_url,
+				checked_at=f.checked_at
+			FROM
+				feeds f
+			WHERE
+				f.id=$1 AND c.shared_feed_id=f.id AND
+				NOT EXISTS(SELECT 1 FROM feeds o WHERE o.user_id=c.user_id AND o.feed_url=f.feed_url AND o.id <> c.id)
+		`,
+		// Members already subscribed to the same feed keep their own subscription,
+		// and the feeds unsubscribed by a member are not copied again.
+		`
+			INSERT INTO feeds
+				(user_id, category_id, feed_url, site_url, title, checked_at, shared_feed_id)
+			SELECT
+				m.user_id, m.member_category_id, f.feed_url, f.site_url, f.title, f.checked_at, f.id
+			FROM
+				feeds f
+			JOIN
+				category_members m ON m.category_id=f.category_id
+			WHERE
+				f.id=$1 AND m.accepted_at IS NOT NULL AND
+				NOT EXISTS(SELECT 1 FROM feeds c WHERE c.shared_feed_id=f.id AND c.user_id=m.user_id) AND
+				NOT EXISTS(SELECT 1 FROM shared_feed_exclusions x WHERE x.feed_id=f.id AND x.user_id=m.user_id)
+			ON CONFLICT (user_id, feed_url) DO NOTHING
+		`,
+		`
+			INSERT INTO feed_icons
+				(feed_id, icon_id)
+			SELECT
+				c.id, i.icon_id
+			FROM
+				feeds c
+			JOIN
+				feed_icons i ON i.feed_id=c.shared_feed_id
+			WHERE
+				c.shared_feed_id=$1
+			ON CONFLICT DO NOTHING
+		`,
+		`
+			WITH copied_entries AS (
+				INSERT INTO entries
+					(user_id, feed_id, hash, title, url, comments_url, published_at, content, author, removed_trackers, reading_time, score, comments_count, tags, latitude, longitude, comments_feed_url, published_offset, changed_at, document_vectors)
+				SELECT
+					c.user_id, c.id, e.hash, e.title, e.url, e.comments_url, e.published_at, e.content, e.author, e.removed_trackers, e.reading_time, e.score, e.comments_count, e.tags, e.latitude, e.longitude, e.comments_feed_url, e.published_offset, now(), e.document_vectors
+				FROM
+					entries e
+				JOIN
+					feeds c ON c.shared_feed_id=e.feed_id
+				WHERE
+					e.feed_id=$1 AND e.status <> 'removed'
+				ON CONFLICT (feed_id, hash) DO NOTHING
+				RETURNING
+					id, user_id, hash
+			)
+			INSERT INTO enclosures
+				(user_id, entry_id, url, size, mime_type, duration, poster_url)
+			SELECT
+				c.user_id, c.id, x.url, x.size, x.mime_type, x.duration, x.poster_url
+			FROM
+				copied_entries c
+			JOIN
+				entries e ON e.feed_id=$1 AND e.hash=c.hash
+			JOIN
+				enclosures x ON x.entry_id=e.id
+		`,
+		// Removed entries are kept only while the original entry exists to avoid copying them again.
+		`
+			WITH deleted_entries AS (
+				DELETE FROM
+					entries
+				WHERE
+					feed_id IN (SELECT id FROM feeds WHERE shared_feed_id=$1) AND
+					status='removed' AND
+					hash NOT IN (SELECT hash FROM entries WHERE feed_id=$1)
+				RETURNING
+					id, user_id
+			)
+			INSERT INTO entry_tombstones (user_id, entry_id)
+				SELECT user_id, id FROM deleted_entries
+			ON CONFLICT DO NOTHING
+		`,
+	}
+
+	for _, query := range queries {
+		if _, err := tx.Exec(query, feedID); err != nil {
+			return nil, fmt.Errorf(`store: unable to sync shared feed #%d: %v`, feedID, err)
+		}
+	}
+
+	memberIDs, err := queryIDs(tx, `SELECT user_id FROM feeds WHERE shared_feed_id=$1`, feedID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch shared feed members: %v`, err)
+	}
+
+	return memberIDs, nil
+}
+
+// queryIDs returns the IDs selected by the query within the transaction.
+func queryIDs(tx *sql.Tx, query string, args ...interface{}) ([]int64, error) {
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
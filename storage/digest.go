@@ -0,0 +1,69 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+	"time"
+
+	"miniflux.app/model"
+)
+
+// Digest returns a summary of the unread entries received since the given date.
+func (s *Storage) Digest(userID int64, since time.Time, limit int) (*model.Digest, error) {
+	query := `
+		SELECT
+			c.id, c.title, count(*)
+		FROM
+			entries e
+		JOIN
+			feeds f ON f.id=e.feed_id
+		JOIN
+			categories c ON c.id=f.category_id
+		WHERE
+			e.user_id=$1 AND e.status=$2 AND e.created_at > $3 AND f.hide_globally is false AND c.hidden is false
+		GROUP BY
+			c.id, c.title
+		ORDER BY
+			count(*) DESC, lower(c.title) ASC
+	`
+	rows, err := s.db.Query(query, userID, model.EntryStatusUnread, since)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch digest categories: %v`, err)
+	}
+	defer rows.Close()
+
+	digest := &model.Digest{Since: since, Categories: make([]*model.DigestCategory, 0)}
+	for rows.Next() {
+		var category model.DigestCategory
+		if err := rows.Scan(&category.ID, &category.Title, &category.Count); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch digest category row: %v`, err)
+		}
+
+		digest.Total += category.Count
+		digest.Categories = append(digest.Categories, &category)
+	}
+
+	if digest.Total == 0 {
+		digest.Entries = make(model.Entries, 0)
+		return digest, nil
+	}
+
+	builder := s.NewEntryQueryBuilder(userID)
+	builder.WithStatus(model.EntryStatusUnread)
+	builder.WithGloballyVisible()
+	builder.AfterCreatedDate(since)
+	builder.WithoutContent()
+	builder.WithOrder("e.score DESC, e.published_at")
+	builder.WithDirection("desc")
+	builder.WithLimit(limit)
+
+	digest.Entries, err = builder.GetEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	return digest, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// DomainRuleExists checks if a rule already exists for the given domain.
+func (s *Storage) DomainRuleExists(domain string) bool {
+	var result bool
+	query := `SELECT true FROM domain_rules WHERE domain=$1`
+	s.db.QueryRow(query, domain).Scan(&result)
+	return result
+}
+
+// AnotherDomainRuleExists checks if another rule exists for the same domain.
+func (s *Storage) AnotherDomainRuleExists(ruleID int64, domain string) bool {
+	var result bool
+	query := `SELECT true FROM domain_rules WHERE id != $1 AND domain=$2`
+	s.db.QueryRow(query, ruleID, domain).Scan(&result)
+	return result
+}
+
+// DomainRuleByID returns a domain rule from the database.
+func (s *Storage) DomainRuleByID(ruleID int64) (*model.DomainRule, error) {
+	var rule model.DomainRule
+
+	query := `SELECT id, domain, rewrite_rules, scraper_rules, created_at FROM domain_rules WHERE id=$1`
+	err := s.db.QueryRow(query, ruleID).Scan(
+		&rule.ID,
+		&rule.Domain,
+		&rule.RewriteRules,
+		&rule.ScraperRules,
+		&rule.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch domain rule: %v`, err)
+	default:
+		return &rule, nil
+	}
+}
+
+// DomainRules returns all instance-wide domain rules.
+func (s *Storage) DomainRules() (model.DomainRules, error) {
+	query := `SELECT id, domain, rewrite_rules, scraper_rules, created_at FROM domain_rules ORDER BY domain ASC`
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch domain rules: %v`, err)
+	}
+	defer rows.Close()
+
+	rules := make(model.DomainRules, 0)
+	for rows.Next() {
+		var rule model.DomainRule
+		if err := rows.Scan(&rule.ID, &rule.Domain, &rule.RewriteRules, &rule.ScraperRules, &rule.CreatedAt); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch domain rule row: %v`, err)
+		}
+
+		rules = append(rules, &rule)
+	}
+
+	return rules, nil
+}
+
+// CreateDomainRule creates a new domain rule.
+func (s *Storage) CreateDomainRule(rule *model.DomainRule) error {
+	query := `
+		INSERT INTO domain_rules
+			(domain, rewrite_rules, scraper_rules)
+		VALUES
+			($1, $2, $3)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		rule.Domain,
+		rule.RewriteRules,
+		rule.ScraperRules,
+	).Scan(&rule.ID, &rule.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create domain rule: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateDomainRule updates an existing domain rule.
+func (s *Storage) UpdateDomainRule(rule *model.DomainRule) error {
+	query := `UPDATE domain_rules SET domain=$1, rewrite_rules=$2, scraper_rules=$3 WHERE id=$4`
+	_, err := s.db.Exec(
+		query,
+		rule.Domain,
+		rule.RewriteRules,
+		rule.ScraperRules,
+		rule.ID,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to update domain rule: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveDomainRule deletes a domain rule.
+func (s *Storage) RemoveDomainRule(ruleID int64) error {
+	query := `DELETE FROM domain_rules WHERE id=$1`
+	result, err := s.db.Exec(query, ruleID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this domain rule: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this domain rule: %v`, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no domain rule has been removed`)
+	}
+
+	return nil
+}
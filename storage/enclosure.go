@@ -9,6 +9,8 @@ import (
 	"fmt"
 
 	"miniflux.app/model"
+
+	"github.com/lib/pq"
 )
 
 // GetEnclosures returns all attachments for the given entry.
@@ -20,7 +22,9 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 			entry_id,
 			url,
 			size,
-			mime_type
+			mime_type,
+			duration,
+			poster_url
 		FROM
 			enclosures
 		WHERE
@@ -44,6 +48,8 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 			&enclosure.URL,
 			&enclosure.Size,
 			&enclosure.MimeType,
+			&enclosure.Duration,
+			&enclosure.PosterURL,
 		)
 
 		if err != nil {
@@ -56,6 +62,38 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 	return enclosures, nil
 }
 
+// AudioDurations returns the duration in seconds of the longest audio attachment of each given entry.
+func (s *Storage) AudioDurations(userID int64, entryIDs []int64) (map[int64]int, error) {
+	query := `
+		SELECT
+			entry_id, max(duration)
+		FROM
+			enclosures
+		WHERE
+			user_id=$1 AND entry_id=ANY($2) AND mime_type LIKE 'audio/%'
+		GROUP BY entry_id
+	`
+
+	rows, err := s.db.Query(query, userID, pq.Array(entryIDs))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch audio durations: %v`, err)
+	}
+	defer rows.Close()
+
+	durations := make(map[int64]int)
+	for rows.Next() {
+		var entryID int64
+		var duration int
+		if err := rows.Scan(&entryID, &duration); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch audio duration row: %v`, err)
+		}
+
+		durations[entryID] = duration
+	}
+
+	return durations, nil
+}
+
 func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error {
 	if enclosure.URL == "" {
 		return nil
@@ -63,9 +101,9 @@ func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error
 
 	query := `
 		INSERT INTO enclosures
-			(url, size, mime_type, entry_id, user_id)
+			(url, size, mime_type, duration, poster_url, entry_id, user_id)
 		VALUES
-			($1, $2, $3, $4, $5)
+			($1, $2, $3, $4, $5, $6, $7)
 		RETURNING
 			id
 	`
@@ -74,6 +112,8 @@ func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error
 		enclosure.URL,
 		enclosure.Size,
 		enclosure.MimeType,
+		enclosure.Duration,
+		enclosure.PosterURL,
 		enclosure.EntryID,
 		enclosure.UserID,
 	).Scan(&enclosure.ID)
@@ -47,16 +47,19 @@ func (s *Storage) CountAllEntries() map[string]int64 {
 
 // CountUnreadEntries returns the number of unread entries.
 func (s *Storage) CountUnreadEntries(userID int64) int {
-	builder := s.NewEntryQueryBuilder(userID)
-	builder.WithStatus(model.EntryStatusUnread)
+	return s.cachedCounter(unreadCounterCacheKey(userID), func() int {
+		builder := s.NewEntryQueryBuilder(userID)
+		builder.WithStatus(model.EntryStatusUnread)
+		builder.WithGloballyVisible()
 
-	n, err := builder.CountEntries()
-	if err != nil {
-		logger.Error(`store: unable to count unread entries for user #%d: %v`, userID, err)
-		return 0
-	}
+		n, err := builder.CountEntries()
+		if err != nil {
+			logger.Error(`store: unable to count unread entries for user #%d: %v`, userID, err)
+			return 0
+		}
 
-	return n
+		return n
+	})
 }
 
 // NewEntryQueryBuilder returns a new EntryQueryBuilder
@@ -75,11 +78,12 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 		UPDATE
 			entries
 		SET
-			content=$1
+			content=$1,
+			removed_trackers=$2
 		WHERE
-			id=$2 AND user_id=$3
+			id=$3 AND user_id=$4
 	`
-	_, err = tx.Exec(query, entry.Content, entry.ID, entry.UserID)
+	_, err = tx.Exec(query, entry.Content, entry.RemovedTrackers, entry.ID, entry.UserID)
 	if err != nil {
 		tx.Rollback()
 		return fmt.Errorf(`store: unable to update content of entry #%d: %v`, entry.ID, err)
@@ -104,11 +108,21 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 
 // createEntry add a new entry.
 func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
+	// Entries are unread and not starred by default unless the processor decided otherwise.
+	status := entry.Status
+	if status == "" {
+		status = model.EntryStatusUnread
+	}
+
+	if entry.DateOffset == nil {
+		entry.DateOffset = model.DateOffset(entry.Date)
+	}
+
 	query := `
 		INSERT INTO entries
-			(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, changed_at, document_vectors)
+			(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, removed_trackers, reading_time, score, comments_count, status, starred, tags, latitude, longitude, comments_feed_url, published_offset, changed_at, document_vectors)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($6, '') for 1000000)), 'B'))
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, now(), setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($6, '') for 1000000)), 'B'))
 		RETURNING
 			id, status
 	`
@@ -123,12 +137,27 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 		entry.Author,
 		entry.UserID,
 		entry.FeedID,
+		entry.RemovedTrackers,
+		entry.ReadingTime,
+		entry.Score,
+		entry.CommentsCount,
+		status,
+		entry.Starred,
+		pq.Array(entry.Tags),
+		entry.Latitude,
+		entry.Longitude,
+		entry.CommentsFeedURL,
+		entry.DateOffset,
 	).Scan(&entry.ID, &entry.Status)
 
 	if err != nil {
 		return fmt.Errorf(`store: unable to create entry %q (feed #%d): %v`, entry.URL, entry.FeedID, err)
 	}
 
+	if err := s.incrementFeedEntryCount(tx, entry); err != nil {
+		return err
+	}
+
 	for i := 0; i < len(entry.Enclosures); i++ {
 		entry.Enclosures[i].EntryID = entry.ID
 		entry.Enclosures[i].UserID = entry.UserID
@@ -144,6 +173,7 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 // updateEntry updates an entry when a feed is refreshed.
 // Note: we do not update the published date because some feeds do not contains any date,
 // it default to time.Now() which could change the order of items on the history page.
+// The change date is bumped only when the title, the URL or the content differ, for the clients syncing with changed_after.
 func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 	query := `
 		UPDATE
@@ -154,9 +184,17 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 			comments_url=$3,
 			content=$4,
 			author=$5,
+			removed_trackers=$6,
+			score=$7,
+			comments_count=$8,
+			tags=$9,
+			latitude=$10,
+			longitude=$11,
+			comments_feed_url=$12,
+			changed_at=CASE WHEN title IS DISTINCT FROM $1 OR url IS DISTINCT FROM $2 OR content IS DISTINCT FROM $4 THEN now() ELSE changed_at END,
 			document_vectors = setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($4, '') for 1000000)), 'B')
 		WHERE
-			user_id=$6 AND feed_id=$7 AND hash=$8
+			user_id=$13 AND feed_id=$14 AND hash=$15
 		RETURNING
 			id
 	`
@@ -167,6 +205,13 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 		entry.CommentsURL,
 		entry.Content,
 		entry.Author,
+		entry.RemovedTrackers,
+		entry.Score,
+		entry.CommentsCount,
+		pq.Array(entry.Tags),
+		entry.Latitude,
+		entry.Longitude,
+		entry.CommentsFeedURL,
 		entry.UserID,
 		entry.FeedID,
 		entry.Hash,
@@ -184,6 +229,25 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 	return s.updateEnclosures(tx, entry.UserID, entry.ID, entry.Enclosures)
 }
 
+// updateEntryScore refreshes the score and the number of comments of an existing entry.
+func (s *Storage) updateEntryScore(tx *sql.Tx, entry *model.Entry) error {
+	query := `
+		UPDATE
+			entries
+		SET
+			score=$1,
+			comments_count=$2
+		WHERE
+			user_id=$3 AND feed_id=$4 AND hash=$5
+	`
+	_, err := tx.Exec(query, entry.Score, entry.CommentsCount, entry.UserID, entry.FeedID, entry.Hash)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update score of entry %q: %v`, entry.URL, err)
+	}
+
+	return nil
+}
+
 // entryExists checks if an entry already exists based on its hash when refreshing a feed.
 func (s *Storage) entryExists(tx *sql.Tx, entry *model.Entry) bool {
 	var result bool
@@ -199,12 +263,19 @@ func (s *Storage) entryExists(tx *sql.Tx, entry *model.Entry) bool {
 // cleanupEntries deletes from the database entries marked as "removed" and not visible anymore in the feed.
 func (s *Storage) cleanupEntries(feedID int64, entryHashes []string) error {
 	query := `
-		DELETE FROM
-			entries
-		WHERE
-			feed_id=$1
-		AND
-			id IN (SELECT id FROM entries WHERE feed_id=$2 AND status=$3 AND NOT (hash=ANY($4)))
+		WITH deleted_entries AS (
+			DELETE FROM
+				entries
+			WHERE
+				feed_id=$1
+			AND
+				id IN (SELECT id FROM entries WHERE feed_id=$2 AND status=$3 AND NOT (hash=ANY($4)))
+			RETURNING
+				id, user_id
+		)
+		INSERT INTO entry_tombstones (user_id, entry_id)
+			SELECT user_id, id FROM deleted_entries
+		ON CONFLICT DO NOTHING
 	`
 	if _, err := s.db.Exec(query, feedID, feedID, model.EntryStatusRemoved, pq.Array(entryHashes)); err != nil {
 		return fmt.Errorf(`store: unable to cleanup entries: %v`, err)
@@ -213,8 +284,8 @@ func (s *Storage) cleanupEntries(feedID int64, entryHashes []string) error {
 	return nil
 }
 
-// RefreshFeedEntries updates feed entries while refreshing a feed.
-func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (err error) {
+// RefreshFeedEntries updates feed entries while refreshing a feed and returns the entries created.
+func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (newEntries model.Entries, err error) {
 	var entryHashes []string
 
 	for _, entry := range entries {
@@ -223,24 +294,30 @@ func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries
 
 		tx, err := s.db.Begin()
 		if err != nil {
-			return fmt.Errorf(`store: unable to start transaction: %v`, err)
+			return nil, fmt.Errorf(`store: unable to start transaction: %v`, err)
 		}
 
 		if s.entryExists(tx, entry) {
 			if updateExistingEntries {
 				err = s.updateEntry(tx, entry)
+			} else if entry.Score != 0 || entry.CommentsCount != 0 {
+				err = s.updateEntryScore(tx, entry)
 			}
 		} else {
 			err = s.createEntry(tx, entry)
+			if err == nil {
+				err = updateFeedLastEntryDate(tx, feedID)
+			}
+			newEntries = append(newEntries, entry)
 		}
 
 		if err != nil {
 			tx.Rollback()
-			return err
+			return nil, err
 		}
 
 		if err := tx.Commit(); err != nil {
-			return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+			return nil, fmt.Errorf(`store: unable to commit transaction: %v`, err)
 		}
 
 		entryHashes = append(entryHashes, entry.Hash)
@@ -252,9 +329,63 @@ func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries
 		}
 	}()
 
+	s.countersChanged(userID)
+
+	return newEntries, nil
+}
+
+// updateFeedLastEntryDate records the creation of an entry, the feed is not dormant anymore.
+func updateFeedLastEntryDate(tx *sql.Tx, feedID int64) error {
+	if _, err := tx.Exec(`UPDATE feeds SET last_entry_at=now(), dormant='f' WHERE id=$1`, feedID); err != nil {
+		return fmt.Errorf(`store: unable to update last entry date of feed #%d: %v`, feedID, err)
+	}
+
 	return nil
 }
 
+// ArchiveReadEntries changes the status of read entries to "removed" after the number of days chosen by each user,
+// bounded by maxDays, or after the given default number of days.
+func (s *Storage) ArchiveReadEntries(defaultDays, maxDays int) (int64, error) {
+	if defaultDays < 0 {
+		return 0, nil
+	}
+
+	query := `
+		UPDATE
+			entries
+		SET
+			status='removed',
+			changed_at=now()
+		WHERE
+			id=ANY(
+				SELECT
+					e.id
+				FROM
+					entries e
+				JOIN
+					users u ON u.id=e.user_id
+				WHERE
+					e.status=$1 AND e.starred is false AND e.share_code='' AND
+					e.published_at < now() - make_interval(days => CASE WHEN u.archive_read_days > 0 AND $3 > 0 THEN LEAST(u.archive_read_days, $3) ELSE $2 END)
+				ORDER BY
+					e.published_at ASC
+				LIMIT 5000
+			)
+	`
+
+	result, err := s.db.Exec(query, model.EntryStatusRead, defaultDays, maxDays)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to archive read entries: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to get the number of rows affected: %v`, err)
+	}
+
+	return count, nil
+}
+
 // ArchiveEntries changes the status of entries to "removed" after the given number of days.
 func (s *Storage) ArchiveEntries(status string, days int) (int64, error) {
 	if days < 0 {
@@ -265,7 +396,8 @@ func (s *Storage) ArchiveEntries(status string, days int) (int64, error) {
 		UPDATE
 			entries
 		SET
-			status='removed'
+			status='removed',
+			changed_at=now()
 		WHERE
 			id=ANY(SELECT id FROM entries WHERE status=$1 AND starred is false AND share_code='' AND published_at < now () - '%d days'::interval ORDER BY published_at ASC LIMIT 5000)
 	`
@@ -285,8 +417,7 @@ func (s *Storage) ArchiveEntries(status string, days int) (int64, error) {
 
 // SetEntriesStatus update the status of the given list of entries.
 func (s *Storage) SetEntriesStatus(userID int64, entryIDs []int64, status string) error {
-	query := `UPDATE entries SET status=$1, changed_at=now() WHERE user_id=$2 AND id=ANY($3)`
-	result, err := s.db.Exec(query, status, userID, pq.Array(entryIDs))
+	result, err := s.exec(querySetEntriesStatus, status, userID, pq.Array(entryIDs))
 	if err != nil {
 		return fmt.Errorf(`store: unable to update entries statuses %v: %v`, entryIDs, err)
 	}
@@ -300,13 +431,14 @@ func (s *Storage) SetEntriesStatus(userID int64, entryIDs []int64, status string
 		return errors.New(`store: nothing has been updated`)
 	}
 
+	s.countersChanged(userID)
+
 	return nil
 }
 
 // ToggleBookmark toggles entry bookmark value.
 func (s *Storage) ToggleBookmark(userID int64, entryID int64) error {
-	query := `UPDATE entries SET starred = NOT starred, changed_at=now() WHERE user_id=$1 AND id=$2`
-	result, err := s.db.Exec(query, userID, entryID)
+	result, err := s.exec(queryToggleBookmark, userID, entryID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to toggle bookmark flag for entry #%d: %v`, entryID, err)
 	}
@@ -323,6 +455,27 @@ func (s *Storage) ToggleBookmark(userID int64, entryID int64) error {
 	return nil
 }
 
+// UpdateEntryReadingPosition saves how far the user has read the entry.
+// The change date is not modified, it would change the order of the history.
+func (s *Storage) UpdateEntryReadingPosition(userID, entryID int64, position float64) error {
+	query := `UPDATE entries SET reading_position=$1 WHERE user_id=$2 AND id=$3`
+	result, err := s.db.Exec(query, position, userID, entryID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update reading position of entry #%d: %v`, entryID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to update reading position of entry #%d: %v`, entryID, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: nothing has been updated`)
+	}
+
+	return nil
+}
+
 // FlushHistory set all entries with the status "read" to "removed".
 func (s *Storage) FlushHistory(userID int64) error {
 	query := `
@@ -339,6 +492,8 @@ func (s *Storage) FlushHistory(userID int64) error {
 		return fmt.Errorf(`store: unable to flush history: %v`, err)
 	}
 
+	s.countersChanged(userID)
+
 	return nil
 }
 
@@ -353,6 +508,35 @@ func (s *Storage) MarkAllAsRead(userID int64) error {
 	count, _ := result.RowsAffected()
 	logger.Debug("[Storage:MarkAllAsRead] %d items marked as read", count)
 
+	s.countersChanged(userID)
+
+	return nil
+}
+
+// MarkGloballyVisibleFeedsAsRead updates all user entries to the read status, except the ones of feeds hidden from the global unread list.
+func (s *Storage) MarkGloballyVisibleFeedsAsRead(userID int64) error {
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1,
+			changed_at=now()
+		FROM
+			feeds
+		WHERE
+			entries.feed_id=feeds.id AND entries.user_id=$2 AND entries.status=$3 AND feeds.hide_globally is false AND
+			NOT EXISTS (SELECT 1 FROM categories c WHERE c.id=feeds.category_id AND c.hidden is true)
+	`
+	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread)
+	if err != nil {
+		return fmt.Errorf(`store: unable to mark globally visible entries as read: %v`, err)
+	}
+
+	count, _ := result.RowsAffected()
+	logger.Debug("[Storage:MarkGloballyVisibleFeedsAsRead] %d items marked as read", count)
+
+	s.countersChanged(userID)
+
 	return nil
 }
 
@@ -375,6 +559,8 @@ func (s *Storage) MarkFeedAsRead(userID, feedID int64, before time.Time) error {
 	count, _ := result.RowsAffected()
 	logger.Debug("[Storage:MarkFeedAsRead] %d items marked as read", count)
 
+	s.countersChanged(userID)
+
 	return nil
 }
 
@@ -403,6 +589,8 @@ func (s *Storage) MarkCategoryAsRead(userID, categoryID int64, before time.Time)
 	count, _ := result.RowsAffected()
 	logger.Debug("[Storage:MarkCategoryAsRead] %d items marked as read", count)
 
+	s.countersChanged(userID)
+
 	return nil
 }
 
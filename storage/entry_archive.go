@@ -0,0 +1,79 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// EntryArchives returns the snapshots of the given entry.
+func (s *Storage) EntryArchives(entryID int64) (model.EntryArchives, error) {
+	query := `
+		SELECT
+			id, user_id, entry_id, service, url, created_at
+		FROM
+			entry_archives
+		WHERE
+			entry_id=$1
+		ORDER BY service ASC
+	`
+	rows, err := s.db.Query(query, entryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry archives: %v`, err)
+	}
+	defer rows.Close()
+
+	archives := make(model.EntryArchives, 0)
+	for rows.Next() {
+		var archive model.EntryArchive
+		if err := rows.Scan(
+			&archive.ID,
+			&archive.UserID,
+			&archive.EntryID,
+			&archive.Service,
+			&archive.URL,
+			&archive.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry archive row: %v`, err)
+		}
+
+		archives = append(archives, &archive)
+	}
+
+	return archives, nil
+}
+
+// SaveEntryArchive stores the snapshot link, the previous snapshot of the same service is replaced.
+func (s *Storage) SaveEntryArchive(archive *model.EntryArchive) error {
+	query := `
+		INSERT INTO entry_archives
+			(user_id, entry_id, service, url)
+		VALUES
+			($1, $2, $3, $4)
+		ON CONFLICT (entry_id, service) DO UPDATE SET
+			url=EXCLUDED.url,
+			created_at=now()
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		archive.UserID,
+		archive.EntryID,
+		archive.Service,
+		archive.URL,
+	).Scan(
+		&archive.ID,
+		&archive.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to save entry archive: %v`, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// FollowEntryComments subscribes to the comments feed of the entry for the given number of days.
+func (s *Storage) FollowEntryComments(userID, entryID int64, days int) (*model.EntryCommentSubscription, error) {
+	query := `
+		INSERT INTO entry_comment_subscriptions
+			(entry_id, user_id, expires_at)
+		SELECT
+			id, user_id, now() + $3 * interval '1 day'
+		FROM
+			entries
+		WHERE
+			user_id=$1 AND id=$2 AND comments_feed_url <> ''
+		ON CONFLICT (entry_id) DO UPDATE SET
+			expires_at=EXCLUDED.expires_at
+	`
+	result, err := s.db.Exec(query, userID, entryID, days)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to follow entry comments: %v`, err)
+	}
+
+	if count, _ := result.RowsAffected(); count == 0 {
+		return nil, nil
+	}
+
+	return s.EntryCommentSubscription(userID, entryID)
+}
+
+// UnfollowEntryComments removes the subscription to the comments feed of the entry, fetched comments are kept.
+func (s *Storage) UnfollowEntryComments(userID, entryID int64) error {
+	query := `DELETE FROM entry_comment_subscriptions WHERE user_id=$1 AND entry_id=$2`
+	if _, err := s.db.Exec(query, userID, entryID); err != nil {
+		return fmt.Errorf(`store: unable to unfollow entry comments: %v`, err)
+	}
+
+	return nil
+}
+
+// EntryCommentSubscription returns the active subscription to the comments feed of the entry.
+func (s *Storage) EntryCommentSubscription(userID, entryID int64) (*model.EntryCommentSubscription, error) {
+	query := `
+		SELECT
+			s.entry_id,
+			s.user_id,
+			e.comments_feed_url,
+			s.expires_at,
+			s.checked_at
+		FROM
+			entry_comment_subscriptions s
+		JOIN
+			entries e ON e.id=s.entry_id
+		WHERE
+			s.user_id=$1 AND s.entry_id=$2 AND s.expires_at > now()
+	`
+	var subscription model.EntryCommentSubscription
+	err := s.db.QueryRow(query, userID, entryID).Scan(
+		&subscription.EntryID,
+		&subscription.UserID,
+		&subscription.CommentsFeedURL,
+		&subscription.ExpiresAt,
+		&subscription.CheckedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch entry comment subscription: %v`, err)
+	}
+
+	return &subscription, nil
+}
+
+// ActiveEntryCommentSubscriptions returns the subscriptions which are not expired yet.
+func (s *Storage) ActiveEntryCommentSubscriptions() ([]*model.EntryCommentSubscription, error) {
+	query := `
+		SELECT
+			s.entry_id,
+			s.user_id,
+			e.comments_feed_url,
+			s.expires_at,
+			s.checked_at
+		FROM
+			entry_comment_subscriptions s
+		JOIN
+			entries e ON e.id=s.entry_id
+		WHERE
+			s.expires_at > now()
+		ORDER BY
+			s.checked_at ASC NULLS FIRST
+	`
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry comment subscriptions: %v`, err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*model.EntryCommentSubscription
+	for rows.Next() {
+		var subscription model.EntryCommentSubscription
+		if err := rows.Scan(
+			&subscription.EntryID,
+			&subscription.UserID,
+			&subscription.CommentsFeedURL,
+			&subscription.ExpiresAt,
+			&subscription.CheckedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry comment subscription row: %v`, err)
+		}
+
+		subscriptions = append(subscriptions, &subscription)
+	}
+
+	return subscriptions, nil
+}
+
+// CleanExpiredEntryCommentSubscriptions removes the subscriptions which are expired.
+func (s *Storage) CleanExpiredEntryCommentSubscriptions() int64 {
+	result, err := s.db.Exec(`DELETE FROM entry_comment_subscriptions WHERE expires_at <= now()`)
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
+
+// RefreshEntryComments stores the new comments and updates the existing ones.
+func (s *Storage) RefreshEntryComments(entryID int64, comments model.EntryComments) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `
+		INSERT INTO entry_comments
+			(entry_id, hash, author, url, content, published_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (entry_id, hash) DO UPDATE SET
+			author=EXCLUDED.author,
+			url=EXCLUDED.url,
+			content=EXCLUDED.content
+	`
+	for _, comment := range comments {
+		if _, err := tx.Exec(query, entryID, comment.Hash, comment.Author, comment.URL, comment.Content, comment.Date); err != nil {
+			tx.Rollback()
+			return fmt.Errorf(`store: unable to save entry comment: %v`, err)
+		}
+	}
+
+	if _, err := tx.Exec(`UPDATE entry_comment_subscriptions SET checked_at=now() WHERE entry_id=$1`, entryID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to update entry comment subscription: %v`, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// EntryComments returns the comments fetched for the entry, the oldest first.
+func (s *Storage) EntryComments(entryID int64) (model.EntryComments, error) {
+	query := `
+		SELECT
+			id, entry_id, hash, author, url, content, published_at
+		FROM
+			entry_comments
+		WHERE
+			entry_id=$1
+		ORDER BY published_at ASC, id ASC
+	`
+	rows, err := s.db.Query(query, entryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry comments: %v`, err)
+	}
+	defer rows.Close()
+
+	comments := make(model.EntryComments, 0)
+	for rows.Next() {
+		var comment model.EntryComment
+		if err := rows.Scan(
+			&comment.ID,
+			&comment.EntryID,
+			&comment.Hash,
+			&comment.Author,
+			&comment.URL,
+			&comment.Content,
+			&comment.Date,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry comment row: %v`, err)
+		}
+
+		comments = append(comments, &comment)
+	}
+
+	return comments, nil
+}
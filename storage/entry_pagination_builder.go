@@ -21,13 +21,16 @@ type EntryPaginationBuilder struct {
 	args       []interface{}
 	entryID    int64
 	direction  string
+	withSearch bool
 }
 
 // WithSearchQuery adds full-text search query to the condition.
 func (e *EntryPaginationBuilder) WithSearchQuery(query string) {
 	if query != "" {
-		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ plainto_tsquery($%d)", len(e.args)+1))
-		e.args = append(e.args, query)
+		e.withSearch = true
+		conditions, args, _ := searchConditions(query, e.args)
+		e.conditions = append(e.conditions, conditions...)
+		e.args = args
 	}
 }
 
@@ -36,6 +39,11 @@ func (e *EntryPaginationBuilder) WithStarred() {
 	e.conditions = append(e.conditions, "e.starred is true")
 }
 
+// WithGloballyVisible excludes feeds and categories hidden from the global unread list.
+func (e *EntryPaginationBuilder) WithGloballyVisible() {
+	e.conditions = append(e.conditions, "f.hide_globally is false AND NOT EXISTS (SELECT 1 FROM categories hc WHERE hc.id=f.category_id AND hc.hidden is true)")
+}
+
 // WithFeedID adds feed_id to the condition.
 func (e *EntryPaginationBuilder) WithFeedID(feedID int64) {
 	if feedID != 0 {
@@ -116,8 +124,16 @@ func (e *EntryPaginationBuilder) getPrevNextID(tx *sql.Tx) (prevID int64, nextID
 	query := fmt.Sprintf(cte, subCondition, finalCondition)
 	e.args = append(e.args, e.entryID)
 
+	// The pagination is prepared once for each list, except for the searches whose conditions change with each query.
+	var row *sql.Row
+	if e.withSearch {
+		row = tx.QueryRow(query, e.args...)
+	} else {
+		row = e.store.txQueryRow(tx, query, e.args...)
+	}
+
 	var pID, nID sql.NullInt64
-	err = tx.QueryRow(query, e.args...).Scan(&pID, &nID)
+	err = row.Scan(&pID, &nID)
 	switch {
 	case err == sql.ErrNoRows:
 		return 0, 0, nil
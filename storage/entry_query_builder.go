@@ -5,6 +5,7 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"database/sql"
 	"fmt"
 	"strings"
 	"time"
@@ -12,40 +13,71 @@ import (
 	"github.com/lib/pq"
 
 	"miniflux.app/model"
-	"miniflux.app/timezone"
 )
 
 // EntryQueryBuilder builds a SQL query to fetch entries.
 type EntryQueryBuilder struct {
-	store      *Storage
-	args       []interface{}
-	conditions []string
-	order      string
-	direction  string
-	limit      int
-	offset     int
+	store          *Storage
+	args           []interface{}
+	conditions     []string
+	order          string
+	direction      string
+	limit          int
+	offset         int
+	withoutContent bool
+	withSearch     bool
+}
+
+// WithoutContent doesn't fetch the content of entries, lists don't need it.
+func (e *EntryQueryBuilder) WithoutContent() *EntryQueryBuilder {
+	e.withoutContent = true
+	return e
 }
 
 // WithSearchQuery adds full-text search query to the condition.
 func (e *EntryQueryBuilder) WithSearchQuery(query string) *EntryQueryBuilder {
 	if query != "" {
-		nArgs := len(e.args) + 1
-		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ plainto_tsquery($%d)", nArgs))
-		e.args = append(e.args, query)
-
-		// 0.0000001 = 0.1 / (seconds_in_a_day)
-		e.WithOrder(fmt.Sprintf("ts_rank(document_vectors, plainto_tsquery($%d)) - extract (epoch from now() - published_at)::float * 0.0000001", nArgs))
+		e.withSearch = true
+		conditions, args, tsQuery := searchConditions(query, e.args)
+		e.conditions = append(e.conditions, conditions...)
+		e.args = args
+
+		if tsQuery != "" {
+			// 0.0000001 = 0.1 / (seconds_in_a_day)
+			e.WithOrder(fmt.Sprintf("ts_rank(document_vectors, %s) - extract (epoch from now() - published_at)::float * 0.0000001", tsQuery))
+		} else {
+			e.WithOrder("e.published_at")
+		}
 		e.WithDirection("DESC")
 	}
 	return e
 }
 
+// WithEnclosureType keeps the entries having an attachment of the given type, or of any type.
+func (e *EntryQueryBuilder) WithEnclosureType(enclosureType string) *EntryQueryBuilder {
+	switch enclosureType {
+	case "":
+	case model.EnclosureTypeAny:
+		e.conditions = append(e.conditions, "EXISTS (SELECT 1 FROM enclosures en WHERE en.user_id=e.user_id AND en.entry_id=e.id)")
+	default:
+		e.conditions = append(e.conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM enclosures en WHERE en.user_id=e.user_id AND en.entry_id=e.id AND en.mime_type LIKE $%d)", len(e.args)+1))
+		e.args = append(e.args, enclosureType+"/%")
+	}
+	return e
+}
+
 // WithStarred adds starred filter.
 func (e *EntryQueryBuilder) WithStarred() *EntryQueryBuilder {
 	e.conditions = append(e.conditions, "e.starred is true")
 	return e
 }
 
+// WithGloballyVisible adds a filter to exclude entries of feeds and categories hidden from the global unread list.
+func (e *EntryQueryBuilder) WithGloballyVisible() *EntryQueryBuilder {
+	e.conditions = append(e.conditions, "f.hide_globally is false AND NOT EXISTS (SELECT 1 FROM categories hc WHERE hc.id=f.category_id AND hc.hidden is true)")
+	return e
+}
+
 // BeforeDate adds a condition < published_at
 func (e *EntryQueryBuilder) BeforeDate(date time.Time) *EntryQueryBuilder {
 	e.conditions = append(e.conditions, fmt.Sprintf("e.published_at < $%d", len(e.args)+1))
@@ -60,6 +92,20 @@ func (e *EntryQueryBuilder) AfterDate(date time.Time) *EntryQueryBuilder {
 	return e
 }
 
+// AfterChangedDate adds a condition > changed_at
+func (e *EntryQueryBuilder) AfterChangedDate(date time.Time) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("e.changed_at > $%d", len(e.args)+1))
+	e.args = append(e.args, date)
+	return e
+}
+
+// AfterCreatedDate adds a condition > created_at
+func (e *EntryQueryBuilder) AfterCreatedDate(date time.Time) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("e.created_at > $%d", len(e.args)+1))
+	e.args = append(e.args, date)
+	return e
+}
+
 // BeforeEntryID adds a condition < entryID.
 func (e *EntryQueryBuilder) BeforeEntryID(entryID int64) *EntryQueryBuilder {
 	if entryID != 0 {
@@ -178,10 +224,17 @@ func (e *EntryQueryBuilder) WithOffset(offset int) *EntryQueryBuilder {
 
 // CountEntries count the number of entries that match the condition.
 func (e *EntryQueryBuilder) CountEntries() (count int, err error) {
-	query := `SELECT count(*) FROM entries e LEFT JOIN feeds f ON f.id=e.feed_id WHERE %s`
-	condition := e.buildCondition()
+	query := fmt.Sprintf(`SELECT count(*) FROM entries e LEFT JOIN feeds f ON f.id=e.feed_id WHERE %s`, e.buildCondition())
+
+	// The counters are prepared once, except for the searches whose conditions change with each query.
+	var row *sql.Row
+	if e.withSearch {
+		row = e.store.db.QueryRow(query, e.args...)
+	} else {
+		row = e.store.queryRow(query, e.args...)
+	}
 
-	err = e.store.db.QueryRow(fmt.Sprintf(query, condition), e.args...).Scan(&count)
+	err = row.Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("unable to count entries: %v", err)
 	}
@@ -189,6 +242,37 @@ func (e *EntryQueryBuilder) CountEntries() (count int, err error) {
 	return count, nil
 }
 
+// AudioDuration returns the total duration in seconds of the matching entries,
+// counting the longest audio attachment of each entry.
+func (e *EntryQueryBuilder) AudioDuration() (int, error) {
+	query := `
+		SELECT
+			COALESCE(SUM(d.duration), 0)
+		FROM (
+			SELECT
+				max(en.duration) AS duration
+			FROM
+				entries e
+			LEFT JOIN
+				feeds f ON f.id=e.feed_id
+			JOIN
+				enclosures en ON en.entry_id=e.id AND en.user_id=e.user_id
+			WHERE
+				%s AND en.mime_type LIKE 'audio/%%'
+			GROUP BY e.id
+		) AS d
+	`
+	condition := e.buildCondition()
+
+	var duration int
+	err := e.store.queryRow(fmt.Sprintf(query, condition), e.args...).Scan(&duration)
+	if err != nil {
+		return 0, fmt.Errorf("unable to compute the audio duration: %v", err)
+	}
+
+	return duration, nil
+}
+
 // GetEntry returns a single entry that match the condition.
 func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
 	e.limit = 1
@@ -206,6 +290,27 @@ func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
 		return nil, err
 	}
 
+	entries[0].PrimaryEnclosure = entries[0].Enclosures.Primary()
+
+	entries[0].Archives, err = e.store.EntryArchives(entries[0].ID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries[0].HasSnapshot = e.store.HasEntrySnapshot(entries[0].ID)
+
+	if entries[0].CommentsFeedURL != "" {
+		entries[0].Comments, err = e.store.EntryComments(entries[0].ID)
+		if err != nil {
+			return nil, err
+		}
+
+		entries[0].CommentSubscription, err = e.store.EntryCommentSubscription(entries[0].UserID, entries[0].ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return entries[0], nil
 }
 
@@ -218,14 +323,25 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			e.feed_id,
 			e.hash,
 			e.published_at at time zone u.timezone,
+			e.changed_at,
 			e.title,
 			e.url,
 			e.comments_url,
+			e.comments_feed_url,
 			e.author,
 			e.share_code,
-			e.content,
+			%s,
 			e.status,
 			e.starred,
+			e.removed_trackers,
+			e.reading_time,
+			e.score,
+			e.comments_count,
+			e.reading_position,
+			e.tags,
+			e.latitude,
+			e.longitude,
+			e.published_offset,
 			f.title as feed_title,
 			f.feed_url,
 			f.site_url,
@@ -235,6 +351,10 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			f.rewrite_rules,
 			f.crawler,
 			f.user_agent,
+			f.hide_globally,
+			coalesce(f.custom_title, '') as custom_title,
+			f.fetch_scores,
+			f.open_external_link,
 			fi.icon_id,
 			u.timezone
 		FROM
@@ -250,9 +370,14 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 		WHERE %s %s
 	`
 
+	content := "e.content"
+	if e.withoutContent {
+		content = "'' as content"
+	}
+
 	condition := e.buildCondition()
 	sorting := e.buildSorting()
-	query = fmt.Sprintf(query, condition, sorting)
+	query = fmt.Sprintf(query, content, condition, sorting)
 
 	rows, err := e.store.db.Query(query, e.args...)
 	if err != nil {
@@ -276,14 +401,25 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.FeedID,
 			&entry.Hash,
 			&entry.Date,
+			&entry.ChangedAt,
 			&entry.Title,
 			&entry.URL,
 			&entry.CommentsURL,
+			&entry.CommentsFeedURL,
 			&entry.Author,
 			&entry.ShareCode,
 			&entry.Content,
 			&entry.Status,
 			&entry.Starred,
+			&entry.RemovedTrackers,
+			&entry.ReadingTime,
+			&entry.Score,
+			&entry.CommentsCount,
+			&entry.ReadingPosition,
+			pq.Array(&entry.Tags),
+			&entry.Latitude,
+			&entry.Longitude,
+			&entry.DateOffset,
 			&entry.Feed.Title,
 			&entry.Feed.FeedURL,
 			&entry.Feed.SiteURL,
@@ -294,6 +430,10 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Feed.RewriteRules,
 			&entry.Feed.Crawler,
 			&entry.Feed.UserAgent,
+			&entry.Feed.HideGlobally,
+			&entry.Feed.CustomTitle,
+			&entry.Feed.FetchScores,
+			&entry.Feed.OpenExternalLink,
 			&iconID,
 			&tz,
 		)
@@ -309,8 +449,7 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 		}
 
 		// Make sure that timestamp fields contains timezone information (API)
-		entry.Date = timezone.Convert(tz, entry.Date)
-		entry.Feed.CheckedAt = timezone.Convert(tz, entry.Feed.CheckedAt)
+		entry.UseTimezone(tz)
 
 		entry.Feed.ID = entry.FeedID
 		entry.Feed.UserID = entry.UserID
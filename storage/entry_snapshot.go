@@ -0,0 +1,96 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// HasEntrySnapshot checks if a copy of the entry web page exists.
+func (s *Storage) HasEntrySnapshot(entryID int64) bool {
+	var result bool
+	query := `SELECT true FROM entry_snapshots WHERE entry_id=$1`
+	s.db.QueryRow(query, entryID).Scan(&result)
+	return result
+}
+
+// EntrySnapshot returns the copy of the entry web page.
+func (s *Storage) EntrySnapshot(userID, entryID int64) (*model.EntrySnapshot, error) {
+	var snapshot model.EntrySnapshot
+	query := `SELECT entry_id, user_id, content, created_at FROM entry_snapshots WHERE user_id=$1 AND entry_id=$2`
+	err := s.db.QueryRow(query, userID, entryID).Scan(
+		&snapshot.EntryID,
+		&snapshot.UserID,
+		&snapshot.Content,
+		&snapshot.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch entry snapshot: %v`, err)
+	}
+
+	return &snapshot, nil
+}
+
+// SaveEntrySnapshot stores the copy of the entry web page, the previous copy is replaced.
+func (s *Storage) SaveEntrySnapshot(snapshot *model.EntrySnapshot) error {
+	query := `
+		INSERT INTO entry_snapshots
+			(entry_id, user_id, content, size)
+		VALUES
+			($1, $2, $3, $4)
+		ON CONFLICT (entry_id) DO UPDATE SET
+			content=EXCLUDED.content,
+			size=EXCLUDED.size,
+			created_at=now()
+		RETURNING
+			created_at
+	`
+	err := s.db.QueryRow(query, snapshot.EntryID, snapshot.UserID, snapshot.Content, len(snapshot.Content)).Scan(&snapshot.CreatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to save entry snapshot: %v`, err)
+	}
+
+	return nil
+}
+
+// EntrySnapshotsSize returns the number of bytes used by the copies of the web pages of the user.
+func (s *Storage) EntrySnapshotsSize(userID int64) (int64, error) {
+	var size int64
+	query := `SELECT coalesce(sum(size), 0) FROM entry_snapshots WHERE user_id=$1`
+	if err := s.db.QueryRow(query, userID).Scan(&size); err != nil {
+		return 0, fmt.Errorf(`store: unable to compute the size of entry snapshots: %v`, err)
+	}
+
+	return size, nil
+}
+
+// CleanOldEntrySnapshots removes the copies of web pages older than the given number of days, except for starred entries.
+func (s *Storage) CleanOldEntrySnapshots(days int) int64 {
+	if days <= 0 {
+		return 0
+	}
+
+	query := `
+		DELETE FROM
+			entry_snapshots
+		WHERE
+			created_at < now() - make_interval(days => $1) AND
+			entry_id NOT IN (SELECT id FROM entries WHERE starred is true AND user_id=entry_snapshots.user_id)
+	`
+	result, err := s.db.Exec(query, days)
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
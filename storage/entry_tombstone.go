@@ -0,0 +1,82 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// EntryTombstones returns the IDs of entries deleted or marked as removed after the given date.
+func (s *Storage) EntryTombstones(userID int64, after time.Time) ([]int64, error) {
+	query := `
+		SELECT entry_id FROM entry_tombstones WHERE user_id=$1 AND removed_at > $2
+		UNION
+		SELECT id FROM entries WHERE user_id=$1 AND status=$3 AND changed_at > $2
+		ORDER BY 1 ASC
+	`
+	rows, err := s.db.Query(query, userID, after, model.EntryStatusRemoved)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry tombstones: %v`, err)
+	}
+	defer rows.Close()
+
+	entryIDs := make([]int64, 0)
+	for rows.Next() {
+		var entryID int64
+		if err := rows.Scan(&entryID); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry tombstone row: %v`, err)
+		}
+
+		entryIDs = append(entryIDs, entryID)
+	}
+
+	return entryIDs, nil
+}
+
+// CleanOldEntryTombstones removes tombstones older than specified days.
+// The tombstones are kept when the number of days is not positive, i.e. when the read entries are never archived.
+func (s *Storage) CleanOldEntryTombstones(days int) int64 {
+	if days <= 0 {
+		return 0
+	}
+
+	query := `DELETE FROM entry_tombstones WHERE removed_at < now() - interval '%d days'`
+	result, err := s.db.Exec(fmt.Sprintf(query, days))
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
+
+// createEntryTombstones records the entries of the user feeds about to be deleted in the transaction,
+// including the entries of the copies of these feeds owned by the members of shared categories.
+func createEntryTombstones(tx *sql.Tx, userID int64, feedIDs []int64) error {
+	query := `
+		INSERT INTO entry_tombstones (user_id, entry_id)
+			SELECT
+				e.user_id, e.id
+			FROM
+				entries e
+			JOIN
+				feeds f ON f.id=e.feed_id
+			WHERE
+				(f.user_id=$1 AND f.id=ANY($2)) OR
+				f.shared_feed_id IN (SELECT id FROM feeds WHERE user_id=$1 AND id=ANY($2))
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.Exec(query, userID, pq.Array(feedIDs)); err != nil {
+		return fmt.Errorf(`store: unable to create entry tombstones: %v`, err)
+	}
+
+	return nil
+}
@@ -10,7 +10,8 @@ import (
 	"fmt"
 
 	"miniflux.app/model"
-	"miniflux.app/timezone"
+
+	"github.com/lib/pq"
 )
 
 var feedListQuery = `
@@ -34,6 +35,39 @@ var feedListQuery = `
 		f.ignore_http_cache,
 		f.fetch_via_proxy,
 		f.disabled,
+		f.open_external_link,
+		f.encoding,
+		f.bearer_token,
+		f.oauth2_token_url,
+		f.oauth2_client_id,
+		f.oauth2_client_secret,
+		f.oauth2_scopes,
+		f.fetch_scores,
+		f.minimum_score,
+		f.blocked_authors,
+		f.auto_star,
+		f.archive_pages,
+		coalesce(f.shared_feed_id, 0),
+		f.hide_globally,
+		f.notify_errors,
+		f.orphaned_saves,
+		f.cron_expression,
+		f.failing_since,
+		f.dead,
+		f.permanent_redirect_count,
+		f.previous_feed_url,
+		f.feed_url_updated_at,
+		f.canonical_feed_url,
+		f.last_fetch_url,
+		f.last_fetch_content_type,
+		f.last_fetch_size,
+		f.request_timeout,
+		f.max_body_size,
+		f.last_read_at,
+		f.unused,
+		f.last_entry_at,
+		f.dormant,
+		coalesce(f.custom_title, '') as custom_title,
 		f.category_id,
 		c.title as category_title,
 		fi.icon_id,
@@ -47,9 +81,9 @@ var feedListQuery = `
 	LEFT JOIN
 		users u ON u.id=f.user_id
 	WHERE
-		f.user_id=$1
+		f.user_id=$1 AND f.orphaned_saves is false
 	ORDER BY
-		f.parsing_error_count DESC, lower(f.title) ASC
+		f.parsing_error_count DESC, lower(coalesce(f.custom_title, f.title)) ASC
 `
 
 // FeedExists checks if the given feed exists.
@@ -120,19 +154,67 @@ func (s *Storage) CountFeeds(userID int64) int {
 
 // CountUserFeedsWithErrors returns the number of feeds with parsing errors that belong to the given user.
 func (s *Storage) CountUserFeedsWithErrors(userID int64) int {
-	query := `SELECT count(*) FROM feeds WHERE user_id=$1 AND parsing_error_count >= $2`
-	var result int
-	err := s.db.QueryRow(query, userID, maxParsingError).Scan(&result)
+	return s.cachedCounter(errorCounterCacheKey(userID), func() int {
+		var result int
+		err := s.queryRow(queryCountUserFeedsWithErrors, userID, maxParsingError).Scan(&result)
+		if err != nil {
+			return 0
+		}
+
+		return result
+	})
+}
+
+// FetchCounters returns the number of read and unread entries of each feed and category of the user.
+func (s *Storage) FetchCounters(userID int64) (*model.FeedCounters, error) {
+	query := `
+		SELECT
+			f.id, f.category_id, e.status, count(*)
+		FROM
+			entries e
+		JOIN
+			feeds f ON f.id=e.feed_id
+		WHERE
+			e.user_id=$1 AND e.status IN ($2, $3)
+		GROUP BY
+			f.id, f.category_id, e.status
+	`
+	rows, err := s.db.Query(query, userID, model.EntryStatusRead, model.EntryStatusUnread)
 	if err != nil {
-		return 0
+		return nil, fmt.Errorf(`store: unable to fetch feed counters: %v`, err)
 	}
+	defer rows.Close()
 
-	return result
+	counters := &model.FeedCounters{
+		ReadCounters:           make(map[int64]int),
+		UnreadCounters:         make(map[int64]int),
+		CategoryReadCounters:   make(map[int64]int),
+		CategoryUnreadCounters: make(map[int64]int),
+	}
+
+	for rows.Next() {
+		var feedID, categoryID int64
+		var status string
+		var count int
+		if err := rows.Scan(&feedID, &categoryID, &status, &count); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch feed counter row: %v`, err)
+		}
+
+		if status == model.EntryStatusRead {
+			counters.ReadCounters[feedID] = count
+			counters.CategoryReadCounters[categoryID] += count
+		} else {
+			counters.UnreadCounters[feedID] = count
+			counters.CategoryUnreadCounters[categoryID] += count
+		}
+	}
+
+	return counters, nil
 }
 
 // CountAllFeedsWithErrors returns the number of feeds with parsing errors.
 func (s *Storage) CountAllFeedsWithErrors() int {
-	query := `SELECT count(*) FROM feeds WHERE parsing_error_count >= $1`
+	query := `SELECT count(*) FROM feeds WHERE parsing_error_count >= $1 OR dead is true`
 	var result int
 	err := s.db.QueryRow(query, maxParsingError).Scan(&result)
 	if err != nil {
@@ -187,6 +269,39 @@ func (s *Storage) FeedsByCategoryWithCounters(userID, categoryID int64) (model.F
 			f.ignore_http_cache,
 			f.fetch_via_proxy,
 			f.disabled,
+			f.open_external_link,
+			f.encoding,
+			f.bearer_token,
+			f.oauth2_token_url,
+			f.oauth2_client_id,
+			f.oauth2_client_secret,
+			f.oauth2_scopes,
+			f.fetch_scores,
+			f.minimum_score,
+			f.blocked_authors,
+			f.auto_star,
+			f.archive_pages,
+			coalesce(f.shared_feed_id, 0),
+			f.hide_globally,
+			f.notify_errors,
+			f.orphaned_saves,
+			f.cron_expression,
+			f.failing_since,
+			f.dead,
+			f.permanent_redirect_count,
+			f.previous_feed_url,
+			f.feed_url_updated_at,
+			f.canonical_feed_url,
+			f.last_fetch_url,
+			f.last_fetch_content_type,
+			f.last_fetch_size,
+			f.request_timeout,
+			f.max_body_size,
+			f.last_read_at,
+			f.unused,
+			f.last_entry_at,
+			f.dormant,
+			coalesce(f.custom_title, '') as custom_title,
 			f.category_id,
 			c.title as category_title,
 			fi.icon_id,
@@ -200,9 +315,9 @@ func (s *Storage) FeedsByCategoryWithCounters(userID, categoryID int64) (model.F
 		LEFT JOIN
 			users u ON u.id=f.user_id
 		WHERE
-			f.user_id=$1 AND f.category_id=$2
+			f.user_id=$1 AND f.category_id=$2 AND f.orphaned_saves is false
 		ORDER BY
-			f.parsing_error_count DESC, lower(f.title) ASC
+			f.parsing_error_count DESC, lower(coalesce(f.custom_title, f.title)) ASC
 	`
 
 	counterQuery := `
@@ -224,7 +339,7 @@ func (s *Storage) FeedsByCategoryWithCounters(userID, categoryID int64) (model.F
 }
 
 func (s *Storage) fetchFeedCounter(query string, args ...interface{}) (unreadCounters map[int64]int, readCounters map[int64]int, err error) {
-	rows, err := s.db.Query(query, args...)
+	rows, err := s.query(query, args...)
 	if err != nil {
 		return nil, nil, fmt.Errorf(`store: unable to fetch feed counts: %v`, err)
 	}
@@ -297,6 +412,39 @@ func (s *Storage) fetchFeeds(feedQuery, counterQuery string, args ...interface{}
 			&feed.IgnoreHTTPCache,
 			&feed.FetchViaProxy,
 			&feed.Disabled,
+			&feed.OpenExternalLink,
+			&feed.Encoding,
+			&feed.BearerToken,
+			&feed.OAuth2TokenURL,
+			&feed.OAuth2ClientID,
+			&feed.OAuth2ClientSecret,
+			&feed.OAuth2Scopes,
+			&feed.FetchScores,
+			&feed.MinimumScore,
+			&feed.BlockedAuthors,
+			&feed.AutoStar,
+			&feed.ArchivePages,
+			&feed.SharedFeedID,
+			&feed.HideGlobally,
+			&feed.NotifyErrors,
+			&feed.OrphanedSaves,
+			&feed.CronExpression,
+			&feed.FailingSince,
+			&feed.Dead,
+			&feed.PermanentRedirectCount,
+			&feed.PreviousFeedURL,
+			&feed.FeedURLUpdatedAt,
+			&feed.CanonicalFeedURL,
+			&feed.LastFetchURL,
+			&feed.LastFetchContentType,
+			&feed.LastFetchSize,
+			&feed.RequestTimeout,
+			&feed.MaxBodySize,
+			&feed.LastReadAt,
+			&feed.Unused,
+			&feed.LastEntryAt,
+			&feed.Dormant,
+			&feed.CustomTitle,
 			&feed.Category.ID,
 			&feed.Category.Title,
 			&iconID,
@@ -321,7 +469,7 @@ func (s *Storage) fetchFeeds(feedQuery, counterQuery string, args ...interface{}
 			}
 		}
 
-		feed.CheckedAt = timezone.Convert(tz, feed.CheckedAt)
+		feed.UseTimezone(tz)
 		feed.Category.UserID = feed.UserID
 		feeds = append(feeds, &feed)
 	}
@@ -329,32 +477,6 @@ func (s *Storage) fetchFeeds(feedQuery, counterQuery string, args ...interface{}
 	return feeds, nil
 }
 
-// WeeklyFeedEntryCount returns the weekly entry count for a feed.
-func (s *Storage) WeeklyFeedEntryCount(userID, feedID int64) (int, error) {
-	query := `
-		SELECT
-			count(*)
-		FROM
-			entries
-		WHERE
-			entries.user_id=$1 AND 
-			entries.feed_id=$2 AND 
-			entries.published_at BETWEEN (now() - interval '1 week') AND now();
-	`
-
-	var weeklyCount int
-	err := s.db.QueryRow(query, userID, feedID).Scan(&weeklyCount)
-
-	switch {
-	case err == sql.ErrNoRows:
-		return 0, nil
-	case err != nil:
-		return 0, fmt.Errorf(`store: unable to fetch weekly count for feed #%d: %v`, feedID, err)
-	}
-
-	return weeklyCount, nil
-}
-
 // FeedByID returns a feed by the ID.
 func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 	var feed model.Feed
@@ -382,6 +504,39 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 			f.ignore_http_cache,
 			f.fetch_via_proxy,
 			f.disabled,
+			f.open_external_link,
+			f.encoding,
+			f.bearer_token,
+			f.oauth2_token_url,
+			f.oauth2_client_id,
+			f.oauth2_client_secret,
+			f.oauth2_scopes,
+			f.fetch_scores,
+			f.minimum_score,
+			f.blocked_authors,
+			f.auto_star,
+			f.archive_pages,
+			coalesce(f.shared_feed_id, 0),
+			f.hide_globally,
+			f.notify_errors,
+			f.orphaned_saves,
+			f.cron_expression,
+			f.failing_since,
+			f.dead,
+			f.permanent_redirect_count,
+			f.previous_feed_url,
+			f.feed_url_updated_at,
+			f.canonical_feed_url,
+			f.last_fetch_url,
+			f.last_fetch_content_type,
+			f.last_fetch_size,
+			f.request_timeout,
+			f.max_body_size,
+			f.last_read_at,
+			f.unused,
+			f.last_entry_at,
+			f.dormant,
+			coalesce(f.custom_title, '') as custom_title,
 			f.category_id,
 			c.title as category_title,
 			fi.icon_id,
@@ -414,6 +569,39 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 		&feed.IgnoreHTTPCache,
 		&feed.FetchViaProxy,
 		&feed.Disabled,
+		&feed.OpenExternalLink,
+		&feed.Encoding,
+		&feed.BearerToken,
+		&feed.OAuth2TokenURL,
+		&feed.OAuth2ClientID,
+		&feed.OAuth2ClientSecret,
+		&feed.OAuth2Scopes,
+		&feed.FetchScores,
+		&feed.MinimumScore,
+		&feed.BlockedAuthors,
+		&feed.AutoStar,
+		&feed.ArchivePages,
+		&feed.SharedFeedID,
+		&feed.HideGlobally,
+		&feed.NotifyErrors,
+		&feed.OrphanedSaves,
+		&feed.CronExpression,
+		&feed.FailingSince,
+		&feed.Dead,
+		&feed.PermanentRedirectCount,
+		&feed.PreviousFeedURL,
+		&feed.FeedURLUpdatedAt,
+		&feed.CanonicalFeedURL,
+		&feed.LastFetchURL,
+		&feed.LastFetchContentType,
+		&feed.LastFetchSize,
+		&feed.RequestTimeout,
+		&feed.MaxBodySize,
+		&feed.LastReadAt,
+		&feed.Unused,
+		&feed.LastEntryAt,
+		&feed.Dormant,
+		&feed.CustomTitle,
 		&feed.Category.ID,
 		&feed.Category.Title,
 		&iconID,
@@ -431,7 +619,7 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 		feed.Icon = &model.FeedIcon{FeedID: feed.ID, IconID: iconID.(int64)}
 	}
 
-	feed.CheckedAt = timezone.Convert(tz, feed.CheckedAt)
+	feed.UseTimezone(tz)
 	return &feed, nil
 }
 
@@ -453,10 +641,18 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 			disabled,
 			scraper_rules,
 			rewrite_rules,
-			fetch_via_proxy
+			fetch_via_proxy,
+			bearer_token,
+			oauth2_token_url,
+			oauth2_client_id,
+			oauth2_client_secret,
+			oauth2_scopes,
+			last_fetch_url,
+			last_fetch_content_type,
+			last_fetch_size
 		)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)
 		RETURNING
 			id
 	`
@@ -477,6 +673,14 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.ScraperRules,
 		feed.RewriteRules,
 		feed.FetchViaProxy,
+		feed.BearerToken,
+		feed.OAuth2TokenURL,
+		feed.OAuth2ClientID,
+		feed.OAuth2ClientSecret,
+		feed.OAuth2Scopes,
+		feed.LastFetchURL,
+		feed.LastFetchContentType,
+		feed.LastFetchSize,
 	).Scan(&feed.ID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create feed %q: %v`, feed.FeedURL, err)
@@ -503,6 +707,8 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		}
 	}
 
+	s.countersChanged(feed.UserID)
+
 	return nil
 }
 
@@ -530,9 +736,37 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 			disabled=$16,
 			next_check_at=$17,
 			ignore_http_cache=$18,
-			fetch_via_proxy=$19
+			fetch_via_proxy=$19,
+			open_external_link=$20,
+			encoding=$21,
+			bearer_token=$22,
+			oauth2_token_url=$23,
+			oauth2_client_id=$24,
+			oauth2_client_secret=$25,
+			oauth2_scopes=$26,
+			fetch_scores=$27,
+			minimum_score=$28,
+			blocked_authors=$29,
+			auto_star=$30,
+			custom_title=NULLIF($31, ''),
+			hide_globally=$32,
+			cron_expression=$33,
+			failing_since=$34,
+			dead=$35,
+			permanent_redirect_count=$36,
+			previous_feed_url=$37,
+			feed_url_updated_at=$38,
+			canonical_feed_url=$39,
+			last_fetch_url=$40,
+			last_fetch_content_type=$41,
+			last_fetch_size=$42,
+			request_timeout=$43,
+			max_body_size=$44,
+			archive_pages=$45,
+			notify_errors=$46,
+			orphaned_saves=$47
 		WHERE
-			id=$20 AND user_id=$21
+			id=$48 AND user_id=$49
 	`
 	_, err = s.db.Exec(query,
 		feed.FeedURL,
@@ -554,6 +788,34 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.NextCheckAt,
 		feed.IgnoreHTTPCache,
 		feed.FetchViaProxy,
+		feed.OpenExternalLink,
+		feed.Encoding,
+		feed.BearerToken,
+		feed.OAuth2TokenURL,
+		feed.OAuth2ClientID,
+		feed.OAuth2ClientSecret,
+		feed.OAuth2Scopes,
+		feed.FetchScores,
+		feed.MinimumScore,
+		feed.BlockedAuthors,
+		feed.AutoStar,
+		feed.CustomTitle,
+		feed.HideGlobally,
+		feed.CronExpression,
+		feed.FailingSince,
+		feed.Dead,
+		feed.PermanentRedirectCount,
+		feed.PreviousFeedURL,
+		feed.FeedURLUpdatedAt,
+		feed.CanonicalFeedURL,
+		feed.LastFetchURL,
+		feed.LastFetchContentType,
+		feed.LastFetchSize,
+		feed.RequestTimeout,
+		feed.MaxBodySize,
+		feed.ArchivePages,
+		feed.NotifyErrors,
+		feed.OrphanedSaves,
 		feed.ID,
 		feed.UserID,
 	)
@@ -562,6 +824,8 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		return fmt.Errorf(`store: unable to update feed #%d (%s): %v`, feed.ID, feed.FeedURL, err)
 	}
 
+	s.countersChanged(feed.UserID)
+
 	return nil
 }
 
@@ -574,15 +838,27 @@ func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 			parsing_error_msg=$1,
 			parsing_error_count=$2,
 			checked_at=$3,
-			next_check_at=$4
+			next_check_at=$4,
+			failing_since=$5,
+			disabled=$6,
+			dead=$7,
+			last_fetch_url=$8,
+			last_fetch_content_type=$9,
+			last_fetch_size=$10
 		WHERE
-			id=$5 AND user_id=$6
+			id=$11 AND user_id=$12
 	`
 	_, err = s.db.Exec(query,
 		feed.ParsingErrorMsg,
 		feed.ParsingErrorCount,
 		feed.CheckedAt,
 		feed.NextCheckAt,
+		feed.FailingSince,
+		feed.Disabled,
+		feed.Dead,
+		feed.LastFetchURL,
+		feed.LastFetchContentType,
+		feed.LastFetchSize,
 		feed.ID,
 		feed.UserID,
 	)
@@ -591,31 +867,297 @@ func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 		return fmt.Errorf(`store: unable to update feed error #%d (%s): %v`, feed.ID, feed.FeedURL, err)
 	}
 
+	s.countersChanged(feed.UserID)
+
 	return nil
 }
 
 // RemoveFeed removes a feed.
 func (s *Storage) RemoveFeed(userID, feedID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if err := keepStarredEntries(tx, userID, []int64{feedID}, 0); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := createEntryTombstones(tx, userID, []int64{feedID}); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := excludeSharedFeedCopies(tx, userID, []int64{feedID}); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	query := `DELETE FROM feeds WHERE id = $1 AND user_id = $2`
-	result, err := s.db.Exec(query, feedID, userID)
+	result, err := tx.Exec(query, feedID, userID)
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf(`store: unable to remove feed #%d: %v`, feedID, err)
 	}
 
 	count, err := result.RowsAffected()
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf(`store: unable to remove feed #%d: %v`, feedID, err)
 	}
 
 	if count == 0 {
+		tx.Rollback()
 		return errors.New(`store: no feed has been removed`)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	s.countersChanged(userID)
+
 	return nil
 }
 
 // ResetFeedErrors removes all feed errors.
 func (s *Storage) ResetFeedErrors() error {
-	_, err := s.db.Exec(`UPDATE feeds SET parsing_error_count=0, parsing_error_msg=''`)
-	return err
+	rows, err := s.db.Query(`
+		WITH updated AS (
+			UPDATE feeds SET parsing_error_count=0, parsing_error_msg='', failing_since=NULL, dead='f' RETURNING user_id
+		)
+		SELECT DISTINCT user_id FROM updated
+	`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var userIDs []int64
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		s.countersChanged(userID)
+	}
+
+	return nil
+}
+
+// FlagUnusedFeeds records the last reading activity of each feed and flags the feeds
+// without any read or starred entry for the given number of months.
+func (s *Storage) FlagUnusedFeeds(months int) (int64, error) {
+	if months <= 0 {
+		if _, err := s.db.Exec(`UPDATE feeds SET unused='f' WHERE unused is true`); err != nil {
+			return 0, fmt.Errorf(`store: unable to reset unused feeds: %v`, err)
+		}
+		return 0, nil
+	}
+
+	// Read entries are archived after a while, the date of the last reading is kept on the feed.
+	activityQuery := `
+		UPDATE
+			feeds f
+		SET
+			last_read_at=e.changed_at
+		FROM
+			(SELECT feed_id, max(changed_at) AS changed_at FROM entries WHERE status='read' OR starred is true GROUP BY feed_id) e
+		WHERE
+			e.feed_id=f.id AND (f.last_read_at IS NULL OR f.last_read_at < e.changed_at)
+	`
+	if _, err := s.db.Exec(activityQuery); err != nil {
+		return 0, fmt.Errorf(`store: unable to update feeds reading activity: %v`, err)
+	}
+
+	query := `
+		UPDATE
+			feeds
+		SET
+			unused=(coalesce(last_read_at, created_at) < now() - '%[1]d months'::interval)
+		WHERE
+			unused <> (coalesce(last_read_at, created_at) < now() - '%[1]d months'::interval)
+	`
+	result, err := s.db.Exec(fmt.Sprintf(query, months))
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to flag unused feeds: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to get the number of rows affected: %v`, err)
+	}
+
+	return count, nil
+}
+
+// FlagDormantFeeds flags the feeds without new entries during the given number of days.
+func (s *Storage) FlagDormantFeeds(days int) (int64, error) {
+	if days <= 0 {
+		if _, err := s.db.Exec(`UPDATE feeds SET dormant='f' WHERE dormant is true`); err != nil {
+			return 0, fmt.Errorf(`store: unable to reset dormant feeds: %v`, err)
+		}
+		return 0, nil
+	}
+
+	query := `
+		UPDATE
+			feeds
+		SET
+			dormant=(coalesce(last_entry_at, created_at) < now() - '%[1]d days'::interval)
+		WHERE
+			dormant <> (coalesce(last_entry_at, created_at) < now() - '%[1]d days'::interval)
+	`
+	result, err := s.db.Exec(fmt.Sprintf(query, days))
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to flag dormant feeds: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to get the number of rows affected: %v`, err)
+	}
+
+	return count, nil
+}
+
+// CountDormantFeeds returns the number of feeds without new entries for a while.
+func (s *Storage) CountDormantFeeds(userID int64) int {
+	var result int
+	err := s.db.QueryRow(`SELECT count(*) FROM feeds WHERE user_id=$1 AND dormant is true`, userID).Scan(&result)
+	if err != nil {
+		return 0
+	}
+
+	return result
+}
+
+// CountUnusedFeeds returns the number of feeds suggested for removal.
+func (s *Storage) CountUnusedFeeds(userID int64) int {
+	var result int
+	err := s.db.QueryRow(`SELECT count(*) FROM feeds WHERE user_id=$1 AND unused is true`, userID).Scan(&result)
+	if err != nil {
+		return 0
+	}
+
+	return result
+}
+
+// UnusedFeeds returns the feeds suggested for removal with their counters.
+func (s *Storage) UnusedFeeds(userID int64) (model.Feeds, error) {
+	feeds, err := s.FeedsWithCounters(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	unusedFeeds := make(model.Feeds, 0)
+	for _, feed := range feeds {
+		if feed.Unused {
+			unusedFeeds = append(unusedFeeds, feed)
+		}
+	}
+
+	return unusedFeeds, nil
+}
+
+// KeepFeeds removes the removal suggestion, the feeds are reviewed again after the same period.
+func (s *Storage) KeepFeeds(userID int64, feedIDs []int64) error {
+	query := `UPDATE feeds SET unused='f', last_read_at=now() WHERE user_id=$1 AND id=ANY($2)`
+	if _, err := s.db.Exec(query, userID, pq.Array(feedIDs)); err != nil {
+		return fmt.Errorf(`store: unable to keep feeds: %v`, err)
+	}
+
+	return nil
+}
+
+// MoveFeeds moves several feeds to another category of the same user.
+func (s *Storage) MoveFeeds(userID int64, feedIDs []int64, categoryID int64) (int64, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `
+		UPDATE
+			feeds
+		SET
+			category_id=$1
+		WHERE
+			user_id=$2 AND id=ANY($3) AND category_id <> $1
+		AND
+			EXISTS (SELECT 1 FROM categories WHERE id=$1 AND user_id=$2)
+		RETURNING
+			id
+	`
+	movedIDs, err := queryIDs(tx, query, categoryID, userID, pq.Array(feedIDs))
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf(`store: unable to move feeds: %v`, err)
+	}
+
+	// Members of the previous and the new category get their copies removed or created.
+	var memberIDs []int64
+	for _, feedID := range movedIDs {
+		feedMemberIDs, err := syncSharedFeed(tx, feedID)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+
+		memberIDs = append(memberIDs, feedMemberIDs...)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	s.countersChanged(userID)
+	s.membersCountersChanged(memberIDs)
+
+	return int64(len(movedIDs)), nil
+}
+
+// RemoveFeeds removes several feeds at once.
+func (s *Storage) RemoveFeeds(userID int64, feedIDs []int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if err := keepStarredEntries(tx, userID, feedIDs, 0); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := createEntryTombstones(tx, userID, feedIDs); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := excludeSharedFeedCopies(tx, userID, feedIDs); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM feeds WHERE id=ANY($1) AND user_id=$2`, pq.Array(feedIDs), userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove feeds: %v`, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	s.countersChanged(userID)
+
+	return nil
 }
@@ -0,0 +1,121 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+)
+
+// FeedContent returns the document downloaded from the feed URL less than the given number of minutes ago.
+func (s *Storage) FeedContent(feedURL string, minutes int) (*model.FeedContent, error) {
+	query := `
+		SELECT
+			feed_url,
+			effective_url,
+			permanent_redirect,
+			content_type,
+			etag_header,
+			last_modified_header,
+			content,
+			fetched_at
+		FROM
+			feed_contents
+		WHERE
+			url_hash=$1 AND fetched_at > now() - make_interval(mins => $2)
+	`
+	var content model.FeedContent
+	err := s.db.QueryRow(query, crypto.Hash(feedURL), minutes).Scan(
+		&content.FeedURL,
+		&content.EffectiveURL,
+		&content.PermanentRedirect,
+		&content.ContentType,
+		&content.EtagHeader,
+		&content.LastModifiedHeader,
+		&content.Content,
+		&content.FetchedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch feed content: %v`, err)
+	default:
+		return &content, nil
+	}
+}
+
+// SaveFeedContent stores the last document downloaded from the feed URL.
+func (s *Storage) SaveFeedContent(content *model.FeedContent) error {
+	query := `
+		INSERT INTO feed_contents
+			(url_hash, feed_url, effective_url, permanent_redirect, content_type, etag_header, last_modified_header, content, fetched_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, now())
+		ON CONFLICT (url_hash) DO UPDATE SET
+			effective_url=EXCLUDED.effective_url,
+			permanent_redirect=EXCLUDED.permanent_redirect,
+			content_type=EXCLUDED.content_type,
+			etag_header=EXCLUDED.etag_header,
+			last_modified_header=EXCLUDED.last_modified_header,
+			content=EXCLUDED.content,
+			fetched_at=EXCLUDED.fetched_at
+	`
+	_, err := s.db.Exec(
+		query,
+		crypto.Hash(content.FeedURL),
+		content.FeedURL,
+		content.EffectiveURL,
+		content.PermanentRedirect,
+		content.ContentType,
+		content.EtagHeader,
+		content.LastModifiedHeader,
+		content.Content,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to save feed content: %v`, err)
+	}
+
+	return nil
+}
+
+// ScheduleFeedsWithSameURL asks to refresh now the other subscriptions to the URL which can use the downloaded document,
+// and returns how many they are. The failing subscriptions keep the next check given by their error backoff.
+func (s *Storage) ScheduleFeedsWithSameURL(feedID int64, feedURL string) (int64, error) {
+	query := `
+		UPDATE
+			feeds
+		SET
+			next_check_at=least(next_check_at, now())
+		WHERE
+			feed_url=$1 AND id <> $2 AND parsing_error_count=0 AND
+			disabled is false AND dead is false AND shared_feed_id IS NULL AND cron_expression='' AND
+			coalesce(username, '')='' AND coalesce(password, '')='' AND coalesce(user_agent, '')='' AND encoding='' AND bearer_token='' AND oauth2_token_url='' AND
+			request_timeout=0 AND max_body_size=0 AND coalesce(fetch_via_proxy, false) is false
+	`
+	result, err := s.db.Exec(query, feedURL, feedID)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to schedule feeds: %v`, err)
+	}
+
+	count, _ := result.RowsAffected()
+	return count, nil
+}
+
+// CleanFeedContents removes the documents downloaded more than the given number of minutes ago.
+func (s *Storage) CleanFeedContents(minutes int) int64 {
+	result, err := s.db.Exec(`DELETE FROM feed_contents WHERE fetched_at <= now() - make_interval(mins => $1)`, minutes)
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
@@ -0,0 +1,79 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"miniflux.app/model"
+)
+
+// The daily counts are kept one year.
+const feedEntryCountRetentionDays = 365
+
+// FeedEntryCounts returns the number of entries published by the feed during each of the last days.
+func (s *Storage) FeedEntryCounts(userID, feedID int64, days int) (model.FeedEntryCounts, error) {
+	query := `
+		SELECT
+			c.day,
+			c.entry_count
+		FROM
+			feed_entry_counts c
+		JOIN
+			feeds f ON f.id=c.feed_id
+		WHERE
+			f.user_id=$1 AND c.feed_id=$2 AND c.day > (now() at time zone 'UTC')::date - $3::int
+	`
+
+	rows, err := s.db.Query(query, userID, feedID, days)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch entry counts of feed #%d: %v`, feedID, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var day time.Time
+		var count int
+		if err := rows.Scan(&day, &count); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch entry count row: %v`, err)
+		}
+		counts[day.Format(model.FeedEntryCountLayout)] = count
+	}
+
+	return model.NewFeedEntryCounts(counts, days, time.Now().UTC()), nil
+}
+
+// incrementFeedEntryCount counts a new entry on its publication day.
+func (s *Storage) incrementFeedEntryCount(tx *sql.Tx, entry *model.Entry) error {
+	query := `
+		INSERT INTO feed_entry_counts
+			(feed_id, day, entry_count)
+		VALUES
+			($1, $2::date, 1)
+		ON CONFLICT (feed_id, day) DO UPDATE SET entry_count = feed_entry_counts.entry_count + 1
+	`
+
+	day := entry.Date.UTC().Format(model.FeedEntryCountLayout)
+	if _, err := tx.Exec(query, entry.FeedID, day); err != nil {
+		return fmt.Errorf(`store: unable to count entry for feed #%d: %v`, entry.FeedID, err)
+	}
+
+	return nil
+}
+
+// CleanOldFeedEntryCounts removes the daily counts older than one year.
+func (s *Storage) CleanOldFeedEntryCounts() int64 {
+	query := `DELETE FROM feed_entry_counts WHERE day < (now() at time zone 'UTC')::date - $1::int`
+	result, err := s.db.Exec(query, feedEntryCountRetentionDays)
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
@@ -22,6 +22,10 @@ func (s *Storage) HasIcon(feedID int64) bool {
 
 // IconByID returns an icon by the ID.
 func (s *Storage) IconByID(iconID int64) (*model.Icon, error) {
+	if icon, found := s.icons.Get(iconID); found {
+		return icon.(*model.Icon), nil
+	}
+
 	var icon model.Icon
 	query := `SELECT id, hash, mime_type, content FROM icons WHERE id=$1`
 	err := s.db.QueryRow(query, iconID).Scan(&icon.ID, &icon.Hash, &icon.MimeType, &icon.Content)
@@ -31,6 +35,7 @@ func (s *Storage) IconByID(iconID int64) (*model.Icon, error) {
 		return nil, fmt.Errorf("Unable to fetch icon by hash: %v", err)
 	}
 
+	s.icons.Set(iconID, &icon)
 	return &icon, nil
 }
 
@@ -116,6 +121,59 @@ func (s *Storage) CreateFeedIcon(feedID int64, icon *model.Icon) error {
 	return nil
 }
 
+// UpdateFeedIcon replaces the icon of the given feed.
+func (s *Storage) UpdateFeedIcon(feedID int64, icon *model.Icon) error {
+	if err := s.RemoveFeedIcon(feedID); err != nil {
+		return err
+	}
+
+	return s.CreateFeedIcon(feedID, icon)
+}
+
+// RemoveFeedIcon detaches the icon from the given feed.
+func (s *Storage) RemoveFeedIcon(feedID int64) error {
+	_, err := s.db.Exec(`DELETE FROM feed_icons WHERE feed_id=$1`, feedID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove feed icon: %v`, err)
+	}
+
+	return nil
+}
+
+// IconsByFeedID returns the icons of all feeds of a user indexed by feed ID.
+func (s *Storage) IconsByFeedID(userID int64) (map[int64]*model.Icon, error) {
+	query := `
+		SELECT
+			feed_icons.feed_id,
+			icons.id,
+			icons.hash,
+			icons.mime_type,
+			icons.content
+		FROM icons
+		INNER JOIN feed_icons ON feed_icons.icon_id=icons.id
+		INNER JOIN feeds ON feeds.id=feed_icons.feed_id
+		WHERE
+			feeds.user_id=$1
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch feed icons: %v`, err)
+	}
+	defer rows.Close()
+
+	icons := make(map[int64]*model.Icon)
+	for rows.Next() {
+		var feedID int64
+		var icon model.Icon
+		if err := rows.Scan(&feedID, &icon.ID, &icon.Hash, &icon.MimeType, &icon.Content); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch feed icons row: %v`, err)
+		}
+		icons[feedID] = &icon
+	}
+
+	return icons, nil
+}
+
 // Icons returns all icons tht belongs to a user.
 func (s *Storage) Icons(userID int64) (model.Icons, error) {
 	query := `
@@ -9,6 +9,8 @@ import (
 	"fmt"
 
 	"miniflux.app/model"
+
+	"github.com/lib/pq"
 )
 
 // HasDuplicateFeverUsername checks if another user have the same fever username.
@@ -69,7 +71,58 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 			nunux_keeper_api_key,
 			pocket_enabled,
 			pocket_access_token,
-			pocket_consumer_key
+			pocket_consumer_key,
+			rssbridge_enabled,
+			rssbridge_url,
+			pinboard_category_tag,
+			pinboard_entry_tags,
+			pinboard_prompt_tags,
+			wallabag_tags,
+			wallabag_archive,
+			instapaper_folder_id,
+			pocket_category_tag,
+			custom_bookmark_enabled,
+			custom_bookmark_url,
+			custom_bookmark_method,
+			custom_bookmark_headers,
+			custom_bookmark_body,
+			markdown_enabled,
+			markdown_webdav_url,
+			markdown_webdav_username,
+			markdown_webdav_password,
+			markdown_starred,
+			hypothesis_enabled,
+			hypothesis_token,
+			hypothesis_group,
+			zotero_enabled,
+			zotero_library_type,
+			zotero_library_id,
+			zotero_api_key,
+			zotero_collection_key,
+			karakeep_enabled,
+			karakeep_url,
+			karakeep_api_key,
+			karakeep_tags,
+			espial_enabled,
+			espial_url,
+			espial_api_key,
+			espial_tags,
+			linkace_enabled,
+			linkace_url,
+			linkace_api_key,
+			linkace_tags,
+			linkace_lists,
+			linkace_category_tag,
+			linkace_is_private,
+			wayback_enabled,
+			archivebox_enabled,
+			archivebox_url,
+			archivebox_api_key,
+			archivebox_tags,
+			ntfy_enabled,
+			ntfy_topic_url,
+			ntfy_token,
+			instapaper_folders
 		FROM
 			integrations
 		WHERE
@@ -101,15 +154,67 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 		&integration.PocketEnabled,
 		&integration.PocketAccessToken,
 		&integration.PocketConsumerKey,
+		&integration.RSSBridgeEnabled,
+		&integration.RSSBridgeURL,
+		&integration.PinboardCategoryTag,
+		&integration.PinboardEntryTags,
+		&integration.PinboardPromptTags,
+		&integration.WallabagTags,
+		&integration.WallabagArchive,
+		&integration.InstapaperFolderID,
+		&integration.PocketCategoryTag,
+		&integration.CustomBookmarkEnabled,
+		&integration.CustomBookmarkURL,
+		&integration.CustomBookmarkMethod,
+		&integration.CustomBookmarkHeaders,
+		&integration.CustomBookmarkBody,
+		&integration.MarkdownEnabled,
+		&integration.MarkdownWebDAVURL,
+		&integration.MarkdownWebDAVUsername,
+		&integration.MarkdownWebDAVPassword,
+		&integration.MarkdownStarred,
+		&integration.HypothesisEnabled,
+		&integration.HypothesisToken,
+		&integration.HypothesisGroup,
+		&integration.ZoteroEnabled,
+		&integration.ZoteroLibraryType,
+		&integration.ZoteroLibraryID,
+		&integration.ZoteroAPIKey,
+		&integration.ZoteroCollectionKey,
+		&integration.KarakeepEnabled,
+		&integration.KarakeepURL,
+		&integration.KarakeepAPIKey,
+		&integration.KarakeepTags,
+		&integration.EspialEnabled,
+		&integration.EspialURL,
+		&integration.EspialAPIKey,
+		&integration.EspialTags,
+		&integration.LinkAceEnabled,
+		&integration.LinkAceURL,
+		&integration.LinkAceAPIKey,
+		&integration.LinkAceTags,
+		&integration.LinkAceLists,
+		&integration.LinkAceCategoryTag,
+		&integration.LinkAceIsPrivate,
+		&integration.WaybackEnabled,
+		&integration.ArchiveBoxEnabled,
+		&integration.ArchiveBoxURL,
+		&integration.ArchiveBoxAPIKey,
+		&integration.ArchiveBoxTags,
+		&integration.NtfyEnabled,
+		&integration.NtfyTopicURL,
+		&integration.NtfyToken,
+		&integration.InstapaperFolders,
 	)
 	switch {
 	case err == sql.ErrNoRows:
 		return &integration, nil
 	case err != nil:
 		return &integration, fmt.Errorf(`store: unable to fetch integration row: %v`, err)
-	default:
-		return &integration, nil
 	}
+
+	integration.CategoryRoutes, err = s.integrationRoutes(userID)
+	return &integration, err
 }
 
 // UpdateIntegration saves user integration settings.
@@ -140,11 +245,67 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 			nunux_keeper_api_key=$20,
 			pocket_enabled=$21,
 			pocket_access_token=$22,
-			pocket_consumer_key=$23
+			pocket_consumer_key=$23,
+			rssbridge_enabled=$24,
+			rssbridge_url=$25,
+			pinboard_category_tag=$26,
+			pinboard_entry_tags=$27,
+			pinboard_prompt_tags=$28,
+			wallabag_tags=$29,
+			wallabag_archive=$30,
+			instapaper_folder_id=$31,
+			pocket_category_tag=$32,
+			custom_bookmark_enabled=$33,
+			custom_bookmark_url=$34,
+			custom_bookmark_method=$35,
+			custom_bookmark_headers=$36,
+			custom_bookmark_body=$37,
+			markdown_enabled=$38,
+			markdown_webdav_url=$39,
+			markdown_webdav_username=$40,
+			markdown_webdav_password=$41,
+			markdown_starred=$42,
+			hypothesis_enabled=$43,
+			hypothesis_token=$44,
+			hypothesis_group=$45,
+			zotero_enabled=$46,
+			zotero_library_type=$47,
+			zotero_library_id=$48,
+			zotero_api_key=$49,
+			zotero_collection_key=$50,
+			karakeep_enabled=$51,
+			karakeep_url=$52,
+			karakeep_api_key=$53,
+			karakeep_tags=$54,
+			espial_enabled=$55,
+			espial_url=$56,
+			espial_api_key=$57,
+			espial_tags=$58,
+			linkace_enabled=$59,
+			linkace_url=$60,
+			linkace_api_key=$61,
+			linkace_tags=$62,
+			linkace_lists=$63,
+			linkace_category_tag=$64,
+			linkace_is_private=$65,
+			wayback_enabled=$66,
+			archivebox_enabled=$67,
+			archivebox_url=$68,
+			archivebox_api_key=$69,
+			archivebox_tags=$70,
+			ntfy_enabled=$71,
+			ntfy_topic_url=$72,
+			ntfy_token=$73,
+			instapaper_folders=$74
 		WHERE
-			user_id=$24
+			user_id=$75
 	`
-	_, err := s.db.Exec(
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	_, err = tx.Exec(
 		query,
 		integration.PinboardEnabled,
 		integration.PinboardToken,
@@ -169,13 +330,74 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 		integration.PocketEnabled,
 		integration.PocketAccessToken,
 		integration.PocketConsumerKey,
+		integration.RSSBridgeEnabled,
+		integration.RSSBridgeURL,
+		integration.PinboardCategoryTag,
+		integration.PinboardEntryTags,
+		integration.PinboardPromptTags,
+		integration.WallabagTags,
+		integration.WallabagArchive,
+		integration.InstapaperFolderID,
+		integration.PocketCategoryTag,
+		integration.CustomBookmarkEnabled,
+		integration.CustomBookmarkURL,
+		integration.CustomBookmarkMethod,
+		integration.CustomBookmarkHeaders,
+		integration.CustomBookmarkBody,
+		integration.MarkdownEnabled,
+		integration.MarkdownWebDAVURL,
+		integration.MarkdownWebDAVUsername,
+		integration.MarkdownWebDAVPassword,
+		integration.MarkdownStarred,
+		integration.HypothesisEnabled,
+		integration.HypothesisToken,
+		integration.HypothesisGroup,
+		integration.ZoteroEnabled,
+		integration.ZoteroLibraryType,
+		integration.ZoteroLibraryID,
+		integration.ZoteroAPIKey,
+		integration.ZoteroCollectionKey,
+		integration.KarakeepEnabled,
+		integration.KarakeepURL,
+		integration.KarakeepAPIKey,
+		integration.KarakeepTags,
+		integration.EspialEnabled,
+		integration.EspialURL,
+		integration.EspialAPIKey,
+		integration.EspialTags,
+		integration.LinkAceEnabled,
+		integration.LinkAceURL,
+		integration.LinkAceAPIKey,
+		integration.LinkAceTags,
+		integration.LinkAceLists,
+		integration.LinkAceCategoryTag,
+		integration.LinkAceIsPrivate,
+		integration.WaybackEnabled,
+		integration.ArchiveBoxEnabled,
+		integration.ArchiveBoxURL,
+		integration.ArchiveBoxAPIKey,
+		integration.ArchiveBoxTags,
+		integration.NtfyEnabled,
+		integration.NtfyTopicURL,
+		integration.NtfyToken,
+		integration.InstapaperFolders,
 		integration.UserID,
 	)
 
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf(`store: unable to update integration row: %v`, err)
 	}
 
+	if err := updateIntegrationRoutes(tx, integration.UserID, integration.CategoryRoutes); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
 	return nil
 }
 
@@ -200,7 +422,7 @@ func (s *Storage) HasSaveEntry(userID int64) (result bool) {
 		WHERE
 			user_id=$1
 		AND
-			(pinboard_enabled='t' OR instapaper_enabled='t' OR wallabag_enabled='t' OR nunux_keeper_enabled='t' OR pocket_enabled='t')
+			(pinboard_enabled='t' OR instapaper_enabled='t' OR wallabag_enabled='t' OR nunux_keeper_enabled='t' OR pocket_enabled='t' OR custom_bookmark_enabled='t')
 	`
 	if err := s.db.QueryRow(query, userID).Scan(&result); err != nil {
 		result = false
@@ -208,3 +430,93 @@ func (s *Storage) HasSaveEntry(userID int64) (result bool) {
 
 	return result
 }
+
+// HasSaveEntryTagsPrompt returns true if the user wants to type additional tags when saving articles.
+func (s *Storage) HasSaveEntryTagsPrompt(userID int64) (result bool) {
+	query := `SELECT true FROM integrations WHERE user_id=$1 AND pinboard_enabled='t' AND pinboard_prompt_tags='t'`
+	if err := s.db.QueryRow(query, userID).Scan(&result); err != nil {
+		result = false
+	}
+
+	return result
+}
+
+// integrationRoutes returns the categories to which each restricted service is limited.
+func (s *Storage) integrationRoutes(userID int64) (map[string][]int64, error) {
+	query := `
+		SELECT
+			rs.service,
+			r.category_id
+		FROM
+			integrations i
+		CROSS JOIN
+			unnest(i.restricted_services) AS rs(service)
+		LEFT JOIN
+			integration_routes r ON r.user_id=i.user_id AND r.service=rs.service
+		WHERE
+			i.user_id=$1
+		ORDER BY
+			rs.service, r.category_id
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch integration routes: %v`, err)
+	}
+	defer rows.Close()
+
+	routes := make(map[string][]int64)
+	for rows.Next() {
+		var service string
+		var categoryID sql.NullInt64
+		if err := rows.Scan(&service, &categoryID); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch integration route row: %v`, err)
+		}
+
+		// A restricted service keeps its restriction when all its categories have been removed.
+		if _, found := routes[service]; !found {
+			routes[service] = []int64{}
+		}
+
+		if categoryID.Valid {
+			routes[service] = append(routes[service], categoryID.Int64)
+		}
+	}
+
+	return routes, nil
+}
+
+// updateIntegrationRoutes replaces the routing table of the user, unknown categories are ignored.
+func updateIntegrationRoutes(tx *sql.Tx, userID int64, routes map[string][]int64) error {
+	if _, err := tx.Exec(`DELETE FROM integration_routes WHERE user_id=$1`, userID); err != nil {
+		return fmt.Errorf(`store: unable to remove integration routes: %v`, err)
+	}
+
+	query := `
+		INSERT INTO integration_routes
+			(user_id, service, category_id)
+		SELECT
+			$1, $2, id
+		FROM
+			categories
+		WHERE
+			user_id=$1 AND id=ANY($3)
+	`
+	var restrictedServices []string
+	for service, categoryIDs := range routes {
+		restrictedServices = append(restrictedServices, service)
+		if len(categoryIDs) == 0 {
+			continue
+		}
+
+		if _, err := tx.Exec(query, userID, service, pq.Array(categoryIDs)); err != nil {
+			return fmt.Errorf(`store: unable to create integration routes: %v`, err)
+		}
+	}
+
+	query = `UPDATE integrations SET restricted_services=$1 WHERE user_id=$2`
+	if _, err := tx.Exec(query, pq.Array(restrictedServices), userID); err != nil {
+		return fmt.Errorf(`store: unable to update restricted services: %v`, err)
+	}
+
+	return nil
+}
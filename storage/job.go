@@ -12,8 +12,9 @@ import (
 
 const maxParsingError = 3
 
-// NewBatch returns a serie of jobs.
-func (s *Storage) NewBatch(batchSize int) (jobs model.JobList, err error) {
+// NewBatch returns a serie of jobs, feeds with more than errorLimit consecutive errors are skipped unless errorLimit is 0.
+// Dead feeds are never refreshed automatically, copies of shared feeds are updated with the original feed.
+func (s *Storage) NewBatch(batchSize, errorLimit int) (jobs model.JobList, err error) {
 	query := `
 		SELECT
 			id,
@@ -21,10 +22,10 @@ func (s *Storage) NewBatch(batchSize int) (jobs model.JobList, err error) {
 		FROM
 			feeds
 		WHERE
-			parsing_error_count < $1 AND disabled is false AND next_check_at < now()
+			($1 = 0 OR parsing_error_count < $1) AND disabled is false AND dead is false AND shared_feed_id IS NULL AND next_check_at < now()
 		ORDER BY next_check_at ASC LIMIT %d
 	`
-	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), maxParsingError)
+	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), errorLimit)
 }
 
 // NewUserBatch returns a serie of jobs but only for a given user.
@@ -38,12 +39,27 @@ func (s *Storage) NewUserBatch(userID int64, batchSize int) (jobs model.JobList,
 		FROM
 			feeds
 		WHERE
-			user_id=$1 AND disabled is false
+			user_id=$1 AND disabled is false AND dead is false AND shared_feed_id IS NULL
 		ORDER BY next_check_at ASC LIMIT %d
 	`
 	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), userID)
 }
 
+// NewCategoryBatch returns a serie of jobs for all enabled feeds of a category.
+func (s *Storage) NewCategoryBatch(userID, categoryID int64) (jobs model.JobList, err error) {
+	query := `
+		SELECT
+			id,
+			user_id
+		FROM
+			feeds
+		WHERE
+			user_id=$1 AND category_id=$2 AND disabled is false AND dead is false AND shared_feed_id IS NULL
+		ORDER BY next_check_at ASC
+	`
+	return s.fetchBatchRows(query, userID, categoryID)
+}
+
 func (s *Storage) fetchBatchRows(query string, args ...interface{}) (jobs model.JobList, err error) {
 	rows, err := s.db.Query(query, args...)
 	if err != nil {
@@ -0,0 +1,104 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// MutedKeywordExists checks if the user already muted the same pattern.
+func (s *Storage) MutedKeywordExists(userID int64, pattern string) bool {
+	var result bool
+	query := `SELECT true FROM muted_keywords WHERE user_id=$1 AND pattern=$2 LIMIT 1`
+	s.db.QueryRow(query, userID, pattern).Scan(&result)
+	return result
+}
+
+// MutedKeywords returns all muted keywords of the given user, including expired ones.
+func (s *Storage) MutedKeywords(userID int64) (model.MutedKeywords, error) {
+	return s.fetchMutedKeywords(`user_id=$1`, userID)
+}
+
+// ActiveMutedKeywords returns the muted keywords that are not expired.
+func (s *Storage) ActiveMutedKeywords(userID int64) (model.MutedKeywords, error) {
+	return s.fetchMutedKeywords(`user_id=$1 AND (expires_at IS NULL OR expires_at > now())`, userID)
+}
+
+func (s *Storage) fetchMutedKeywords(condition string, args ...interface{}) (model.MutedKeywords, error) {
+	query := `
+		SELECT
+			id, user_id, pattern, action, expires_at, created_at
+		FROM
+			muted_keywords
+		WHERE
+			%s
+		ORDER BY pattern ASC
+	`
+	rows, err := s.db.Query(fmt.Sprintf(query, condition), args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch muted keywords: %v`, err)
+	}
+	defer rows.Close()
+
+	mutedKeywords := make(model.MutedKeywords, 0)
+	for rows.Next() {
+		var mutedKeyword model.MutedKeyword
+		if err := rows.Scan(
+			&mutedKeyword.ID,
+			&mutedKeyword.UserID,
+			&mutedKeyword.Pattern,
+			&mutedKeyword.Action,
+			&mutedKeyword.ExpiresAt,
+			&mutedKeyword.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch muted keyword row: %v`, err)
+		}
+
+		mutedKeywords = append(mutedKeywords, &mutedKeyword)
+	}
+
+	return mutedKeywords, nil
+}
+
+// CreateMutedKeyword inserts a new muted keyword.
+func (s *Storage) CreateMutedKeyword(mutedKeyword *model.MutedKeyword) error {
+	query := `
+		INSERT INTO muted_keywords
+			(user_id, pattern, action, expires_at)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		mutedKeyword.UserID,
+		mutedKeyword.Pattern,
+		mutedKeyword.Action,
+		mutedKeyword.ExpiresAt,
+	).Scan(
+		&mutedKeyword.ID,
+		&mutedKeyword.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create muted keyword: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveMutedKeyword deletes a muted keyword.
+func (s *Storage) RemoveMutedKeyword(userID, mutedKeywordID int64) error {
+	query := `DELETE FROM muted_keywords WHERE id = $1 AND user_id = $2`
+	_, err := s.db.Exec(query, mutedKeywordID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this muted keyword: %v`, err)
+	}
+
+	return nil
+}
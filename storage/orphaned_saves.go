@@ -0,0 +1,194 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/locale"
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// keepStarredEntries moves the starred entries of feeds about to be removed in the transaction to the
+// orphaned saves feed, for the owner and for the members having a copy of the feeds. removedCategoryID is
+// the category being removed with its feeds, or 0.
+func keepStarredEntries(tx *sql.Tx, userID int64, feedIDs []int64, removedCategoryID int64) error {
+	if err := keepUserStarredEntries(tx, userID, feedIDs, removedCategoryID); err != nil {
+		return err
+	}
+
+	query := `SELECT id FROM feeds WHERE shared_feed_id IN (SELECT id FROM feeds WHERE user_id=$1 AND id=ANY($2))`
+	copyIDs, err := queryIDs(tx, query, userID, pq.Array(feedIDs))
+	if err != nil {
+		return fmt.Errorf(`store: unable to fetch shared feed copies: %v`, err)
+	}
+
+	return keepFeedCopiesStarredEntries(tx, copyIDs)
+}
+
+// keepFeedCopiesStarredEntries moves the starred entries of shared feed copies about to be removed
+// to the orphaned saves feed of each member.
+func keepFeedCopiesStarredEntries(tx *sql.Tx, copyIDs []int64) error {
+	if len(copyIDs) == 0 {
+		return nil
+	}
+
+	rows, err := tx.Query(`SELECT user_id, id FROM feeds WHERE id=ANY($1)`, pq.Array(copyIDs))
+	if err != nil {
+		return fmt.Errorf(`store: unable to fetch shared feed copies: %v`, err)
+	}
+
+	copies := make(map[int64][]int64)
+	for rows.Next() {
+		var memberID, feedID int64
+		if err := rows.Scan(&memberID, &feedID); err != nil {
+			rows.Close()
+			return fmt.Errorf(`store: unable to fetch shared feed copy: %v`, err)
+		}
+		copies[memberID] = append(copies[memberID], feedID)
+	}
+	rows.Close()
+
+	for memberID, memberFeedIDs := range copies {
+		if err := keepUserStarredEntries(tx, memberID, memberFeedIDs, 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func keepUserStarredEntries(tx *sql.Tx, userID int64, feedIDs []int64, removedCategoryID int64) error {
+	var keep bool
+	var language string
+	if err := tx.QueryRow(`SELECT keep_starred_entries, language FROM users WHERE id=$1`, userID).Scan(&keep, &language); err != nil {
+		return fmt.Errorf(`store: unable to fetch user #%d: %v`, userID, err)
+	}
+
+	if !keep {
+		return nil
+	}
+
+	var orphanedFeedID, orphanedCategoryID int64
+	query := `SELECT id, category_id FROM feeds WHERE user_id=$1 AND orphaned_saves is true`
+	err := tx.QueryRow(query, userID).Scan(&orphanedFeedID, &orphanedCategoryID)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return fmt.Errorf(`store: unable to fetch orphaned saves feed: %v`, err)
+	}
+
+	var count int
+	query = `SELECT count(*) FROM entries WHERE user_id=$1 AND feed_id=ANY($2) AND feed_id <> $3 AND starred is true`
+	if err := tx.QueryRow(query, userID, pq.Array(feedIDs), orphanedFeedID).Scan(&count); err != nil {
+		return fmt.Errorf(`store: unable to count starred entries: %v`, err)
+	}
+
+	// The orphaned saves must not disappear with the category being removed.
+	mustMove := orphanedFeedID != 0 && removedCategoryID != 0 && orphanedCategoryID == removedCategoryID
+	if count == 0 && !mustMove {
+		return nil
+	}
+
+	title := locale.NewPrinter(language).Printf("page.orphaned_saves.title")
+	categoryID, err := orphanedSavesCategory(tx, userID, removedCategoryID, title)
+	if err != nil {
+		return err
+	}
+
+	if mustMove {
+		query = `UPDATE feeds SET category_id=$1 WHERE id=$2`
+		if _, err := tx.Exec(query, categoryID, orphanedFeedID); err != nil {
+			return fmt.Errorf(`store: unable to move orphaned saves feed: %v`, err)
+		}
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	if orphanedFeedID == 0 {
+		query = `
+			INSERT INTO feeds
+				(user_id, category_id, feed_url, site_url, title, disabled, orphaned_saves)
+			VALUES
+				($1, $2, $3, $3, $4, 't', 't')
+			RETURNING
+				id
+		`
+		err := tx.QueryRow(query, userID, categoryID, model.OrphanedSavesFeedURL, title).Scan(&orphanedFeedID)
+		if err != nil {
+			return fmt.Errorf(`store: unable to create orphaned saves feed: %v`, err)
+		}
+	}
+
+	// Only one entry per hash can be moved, the same article could be starred in several feeds.
+	query = `
+		UPDATE
+			entries
+		SET
+			feed_id=$1,
+			changed_at=now()
+		WHERE
+			id IN (
+				SELECT DISTINCT ON (e.hash)
+					e.id
+				FROM
+					entries e
+				WHERE
+					e.user_id=$2 AND e.feed_id=ANY($3) AND e.feed_id <> $1 AND e.starred is true AND
+					NOT EXISTS (SELECT 1 FROM entries o WHERE o.feed_id=$1 AND o.hash=e.hash)
+				ORDER BY
+					e.hash, e.id
+			)
+	`
+	if _, err := tx.Exec(query, orphanedFeedID, userID, pq.Array(feedIDs)); err != nil {
+		return fmt.Errorf(`store: unable to keep starred entries: %v`, err)
+	}
+
+	return nil
+}
+
+// orphanedSavesCategory returns a category of the user other than the one being removed,
+// a new category is created when the user has no other category.
+func orphanedSavesCategory(tx *sql.Tx, userID, removedCategoryID int64, title string) (int64, error) {
+	var categoryID int64
+	query := `SELECT id FROM categories WHERE user_id=$1 AND id <> $2 ORDER BY title ASC LIMIT 1`
+	err := tx.QueryRow(query, userID, removedCategoryID).Scan(&categoryID)
+	switch {
+	case err == sql.ErrNoRows:
+		query = `INSERT INTO categories (user_id, title) VALUES ($1, $2) RETURNING id`
+		if err := tx.QueryRow(query, userID, title).Scan(&categoryID); err != nil {
+			return 0, fmt.Errorf(`store: unable to create orphaned saves category: %v`, err)
+		}
+	case err != nil:
+		return 0, fmt.Errorf(`store: unable to fetch category: %v`, err)
+	}
+
+	return categoryID, nil
+}
+
+// categoryFeedIDs returns the feeds removed with the category, the orphaned saves feed is moved to another category.
+func (s *Storage) categoryFeedIDs(userID, categoryID int64) ([]int64, error) {
+	rows, err := s.db.Query(`SELECT id FROM feeds WHERE user_id=$1 AND category_id=$2 AND orphaned_saves is false`, userID, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch category feeds: %v`, err)
+	}
+	defer rows.Close()
+
+	var feedIDs []int64
+	for rows.Next() {
+		var feedID int64
+		if err := rows.Scan(&feedID); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch category feed: %v`, err)
+		}
+		feedIDs = append(feedIDs, feedID)
+	}
+
+	return feedIDs, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import "sync"
+
+// RunParallel executes the given queries concurrently, each one on its own connection of the pool,
+// and waits until all of them are done. The first error returned by a query is returned.
+// This reduces the rendering time of pages that need several independent queries when the database is far away.
+func (s *Storage) RunParallel(queries ...func() error) error {
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	wg.Add(len(queries))
+	for _, query := range queries {
+		go func(query func() error) {
+			defer wg.Done()
+			if err := query(); err != nil {
+				once.Do(func() { firstErr = err })
+			}
+		}(query)
+	}
+
+	wg.Wait()
+	return firstErr
+}
+
+// NavigationCounters returns the number of unread entries and the number of feeds with errors shown in the menu.
+func (s *Storage) NavigationCounters(userID int64) (countUnread, countErrorFeeds int) {
+	s.RunParallel(
+		func() error {
+			countUnread = s.CountUnreadEntries(userID)
+			return nil
+		},
+		func() error {
+			countErrorFeeds = s.CountUserFeedsWithErrors(userID)
+			return nil
+		},
+	)
+	return countUnread, countErrorFeeds
+}
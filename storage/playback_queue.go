@@ -0,0 +1,128 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// PlaybackQueue returns the queued entries of the user with their first audio attachment, in playback order.
+func (s *Storage) PlaybackQueue(userID int64) (model.PlaybackQueue, error) {
+	query := `
+		SELECT
+			q.entry_id,
+			e.title,
+			f.id,
+			f.title,
+			a.url,
+			a.mime_type,
+			a.duration
+		FROM
+			playback_queue q
+		JOIN
+			entries e ON e.id=q.entry_id
+		JOIN
+			feeds f ON f.id=e.feed_id
+		JOIN LATERAL (
+			SELECT
+				en.url, en.mime_type, en.duration
+			FROM
+				enclosures en
+			WHERE
+				en.user_id=e.user_id AND en.entry_id=e.id AND en.mime_type LIKE 'audio/%'
+			ORDER BY en.id ASC
+			LIMIT 1
+		) a ON true
+		WHERE
+			q.user_id=$1
+		ORDER BY
+			q.position ASC, q.entry_id ASC
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch playback queue: %v`, err)
+	}
+	defer rows.Close()
+
+	queue := make(model.PlaybackQueue, 0)
+	for rows.Next() {
+		var item model.QueueItem
+		if err := rows.Scan(
+			&item.EntryID,
+			&item.Title,
+			&item.FeedID,
+			&item.FeedTitle,
+			&item.AudioURL,
+			&item.MimeType,
+			&item.Duration,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch playback queue row: %v`, err)
+		}
+
+		// Stored positions may have gaps after a removal.
+		item.Position = len(queue)
+		queue = append(queue, &item)
+	}
+
+	return queue, nil
+}
+
+// AddToPlaybackQueue appends an entry at the end of the queue, queuing the same entry twice is a no-op.
+func (s *Storage) AddToPlaybackQueue(userID, entryID int64) error {
+	query := `
+		INSERT INTO playback_queue
+			(user_id, entry_id, position)
+		VALUES
+			($1, $2, (SELECT COALESCE(MAX(position) + 1, 0) FROM playback_queue WHERE user_id=$1))
+		ON CONFLICT (user_id, entry_id) DO NOTHING
+	`
+	if _, err := s.db.Exec(query, userID, entryID); err != nil {
+		return fmt.Errorf(`store: unable to add entry #%d to the playback queue: %v`, entryID, err)
+	}
+
+	return nil
+}
+
+// RemoveFromPlaybackQueue removes an entry from the queue.
+func (s *Storage) RemoveFromPlaybackQueue(userID, entryID int64) error {
+	query := `DELETE FROM playback_queue WHERE user_id=$1 AND entry_id=$2`
+	if _, err := s.db.Exec(query, userID, entryID); err != nil {
+		return fmt.Errorf(`store: unable to remove entry #%d from the playback queue: %v`, entryID, err)
+	}
+
+	return nil
+}
+
+// ReorderPlaybackQueue puts the given entries first, in the given order, the other queued entries keep their order after them.
+func (s *Storage) ReorderPlaybackQueue(userID int64, entryIDs []int64) error {
+	query := `
+		UPDATE
+			playback_queue q
+		SET
+			position=o.rank
+		FROM (
+			SELECT
+				entry_id,
+				row_number() OVER (
+					ORDER BY COALESCE(array_position($2::bigint[], entry_id), cardinality($2::bigint[]) + 1), position, entry_id
+				) AS rank
+			FROM
+				playback_queue
+			WHERE
+				user_id=$1
+		) o
+		WHERE
+			q.user_id=$1 AND q.entry_id=o.entry_id
+	`
+	if _, err := s.db.Exec(query, userID, pq.Array(entryIDs)); err != nil {
+		return fmt.Errorf(`store: unable to reorder the playback queue: %v`, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// CountPreferences returns the number of preferences saved by the user in all namespaces.
+func (s *Storage) CountPreferences(userID int64) (int, error) {
+	var count int
+	err := s.db.QueryRow(`SELECT count(*) FROM user_preferences WHERE user_id=$1`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to count preferences: %v`, err)
+	}
+
+	return count, nil
+}
+
+// Preferences returns the preferences of the given namespace.
+func (s *Storage) Preferences(userID int64, namespace string) (model.Preferences, error) {
+	all, err := s.fetchPreferences(`user_id=$1 AND namespace=$2`, userID, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if preferences, found := all[namespace]; found {
+		return preferences, nil
+	}
+
+	return make(model.Preferences), nil
+}
+
+// AllPreferences returns the preferences of all namespaces.
+func (s *Storage) AllPreferences(userID int64) (model.AllPreferences, error) {
+	return s.fetchPreferences(`user_id=$1`, userID)
+}
+
+func (s *Storage) fetchPreferences(condition string, args ...interface{}) (model.AllPreferences, error) {
+	query := `SELECT namespace, key, value FROM user_preferences WHERE %s ORDER BY namespace ASC, key ASC`
+	rows, err := s.db.Query(fmt.Sprintf(query, condition), args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch preferences: %v`, err)
+	}
+	defer rows.Close()
+
+	all := make(model.AllPreferences)
+	for rows.Next() {
+		var namespace, key string
+		var value []byte
+
+		if err := rows.Scan(&namespace, &key, &value); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch preference row: %v`, err)
+		}
+
+		if _, found := all[namespace]; !found {
+			all[namespace] = make(model.Preferences)
+		}
+
+		all[namespace][key] = json.RawMessage(value)
+	}
+
+	return all, nil
+}
+
+// UpdatePreferences saves the given keys of the namespace, null values remove the keys.
+func (s *Storage) UpdatePreferences(userID int64, namespace string, preferences model.Preferences) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	for key, value := range preferences {
+		if model.IsPreferenceRemoval(value) {
+			_, err = tx.Exec(
+				`DELETE FROM user_preferences WHERE user_id=$1 AND namespace=$2 AND key=$3`,
+				userID, namespace, key,
+			)
+		} else {
+			_, err = tx.Exec(`
+				INSERT INTO user_preferences
+					(user_id, namespace, key, value)
+				VALUES
+					($1, $2, $3, $4)
+				ON CONFLICT (user_id, namespace, key) DO UPDATE
+					SET value=EXCLUDED.value, updated_at=now()`,
+				userID, namespace, key, string(value),
+			)
+		}
+
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf(`store: unable to update preference %q: %v`, key, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// RemovePreferences deletes all the preferences of the namespace.
+func (s *Storage) RemovePreferences(userID int64, namespace string) error {
+	_, err := s.db.Exec(`DELETE FROM user_preferences WHERE user_id=$1 AND namespace=$2`, userID, namespace)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove preferences: %v`, err)
+	}
+
+	return nil
+}
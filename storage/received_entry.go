@@ -0,0 +1,157 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"miniflux.app/model"
+)
+
+const receivedEntryQuery = `
+	SELECT
+		r.id,
+		r.sender_id,
+		u.username,
+		r.recipient_id,
+		r.title,
+		r.url,
+		r.author,
+		r.content,
+		r.feed_title,
+		r.published_at,
+		r.message,
+		r.created_at
+	FROM
+		received_entries r
+	JOIN
+		users u ON u.id=r.sender_id
+`
+
+// CreateReceivedEntry stores an entry sent to another user.
+func (s *Storage) CreateReceivedEntry(entry *model.ReceivedEntry) error {
+	query := `
+		INSERT INTO received_entries
+			(sender_id, recipient_id, title, url, author, content, feed_title, published_at, message)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		entry.SenderID,
+		entry.RecipientID,
+		entry.Title,
+		entry.URL,
+		entry.Author,
+		entry.Content,
+		entry.FeedTitle,
+		entry.Date,
+		entry.Message,
+	).Scan(
+		&entry.ID,
+		&entry.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create received entry: %v`, err)
+	}
+
+	return nil
+}
+
+// ReceivedEntries returns the entries sent to the user, the most recent first.
+func (s *Storage) ReceivedEntries(recipientID int64) (model.ReceivedEntries, error) {
+	rows, err := s.db.Query(receivedEntryQuery+` WHERE r.recipient_id=$1 ORDER BY r.created_at DESC`, recipientID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch received entries: %v`, err)
+	}
+	defer rows.Close()
+
+	entries := make(model.ReceivedEntries, 0)
+	for rows.Next() {
+		entry, err := scanReceivedEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch received entry row: %v`, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// ReceivedEntry returns an entry sent to the user.
+func (s *Storage) ReceivedEntry(recipientID, entryID int64) (*model.ReceivedEntry, error) {
+	row := s.db.QueryRow(receivedEntryQuery+` WHERE r.recipient_id=$1 AND r.id=$2`, recipientID, entryID)
+	entry, err := scanReceivedEntry(row)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch received entry: %v`, err)
+	}
+
+	return entry, nil
+}
+
+// CountReceivedEntries returns the number of entries sent to the user.
+func (s *Storage) CountReceivedEntries(recipientID int64) int {
+	var count int
+	s.db.QueryRow(`SELECT count(*) FROM received_entries WHERE recipient_id=$1`, recipientID).Scan(&count)
+	return count
+}
+
+// CountSentEntriesSince returns the number of entries sent by the user since the given time.
+func (s *Storage) CountSentEntriesSince(senderID int64, since time.Time) (int, error) {
+	var count int
+	query := `SELECT count(*) FROM received_entries WHERE sender_id=$1 AND created_at >= $2`
+	if err := s.db.QueryRow(query, senderID, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`store: unable to count sent entries: %v`, err)
+	}
+
+	return count, nil
+}
+
+// RemoveReceivedEntry deletes an entry sent to the user.
+func (s *Storage) RemoveReceivedEntry(recipientID, entryID int64) error {
+	query := `DELETE FROM received_entries WHERE recipient_id=$1 AND id=$2`
+	if _, err := s.db.Exec(query, recipientID, entryID); err != nil {
+		return fmt.Errorf(`store: unable to remove received entry: %v`, err)
+	}
+
+	return nil
+}
+
+type receivedEntryScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanReceivedEntry(row receivedEntryScanner) (*model.ReceivedEntry, error) {
+	var entry model.ReceivedEntry
+	err := row.Scan(
+		&entry.ID,
+		&entry.SenderID,
+		&entry.SenderUsername,
+		&entry.RecipientID,
+		&entry.Title,
+		&entry.URL,
+		&entry.Author,
+		&entry.Content,
+		&entry.FeedTitle,
+		&entry.Date,
+		&entry.Message,
+		&entry.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// FeedRecommendations returns the feeds subscribed by other users in categories having the same name as the user ones.
+// Feeds requiring credentials and feeds already subscribed by the user are excluded.
+func (s *Storage) FeedRecommendations(userID int64, minSubscribers, limit int) (model.FeedRecommendations, error) {
+	query := `
+		SELECT
+			c.id,
+			c.title,
+			f.feed_url,
+			min(f.site_url),
+			min(f.title),
+			count(DISTINCT f.user_id) AS subscribers
+		FROM
+			feeds f
+		JOIN
+			categories oc ON oc.id=f.category_id
+		JOIN
+			categories c ON c.user_id=$1 AND lower(c.title)=lower(oc.title)
+		WHERE
+			f.user_id <> $1 AND
+			f.disabled='f' AND
+			f.username='' AND
+			f.password='' AND
+			f.bearer_token='' AND
+			f.oauth2_client_secret='' AND
+			NOT EXISTS (SELECT 1 FROM feeds uf WHERE uf.user_id=$1 AND uf.feed_url=f.feed_url)
+		GROUP BY
+			c.id, c.title, f.feed_url
+		HAVING
+			count(DISTINCT f.user_id) >= $2
+		ORDER BY
+			subscribers DESC, c.title ASC
+		LIMIT $3
+	`
+	rows, err := s.db.Query(query, userID, minSubscribers, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch feed recommendations: %v`, err)
+	}
+	defer rows.Close()
+
+	recommendations := make(model.FeedRecommendations, 0)
+	for rows.Next() {
+		var recommendation model.FeedRecommendation
+		if err := rows.Scan(
+			&recommendation.CategoryID,
+			&recommendation.CategoryTitle,
+			&recommendation.FeedURL,
+			&recommendation.SiteURL,
+			&recommendation.Title,
+			&recommendation.Subscribers,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch feed recommendation row: %v`, err)
+		}
+
+		recommendations = append(recommendations, &recommendation)
+	}
+
+	return recommendations, nil
+}
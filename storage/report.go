@@ -0,0 +1,72 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// DatabaseReport returns the database size and the resources used by each user, largest first.
+func (s *Storage) DatabaseReport() (*model.DatabaseReport, error) {
+	report := &model.DatabaseReport{Users: make([]*model.UserReport, 0)}
+
+	err := s.db.QueryRow(`SELECT pg_database_size(current_database())`).Scan(&report.DatabaseSize)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch database size: %v`, err)
+	}
+
+	query := `
+		SELECT
+			u.id,
+			u.username,
+			u.is_admin,
+			u.last_login_at,
+			u.last_seen_at,
+			coalesce(f.count, 0),
+			coalesce(e.count, 0),
+			coalesce(e.size, 0) + coalesce(a.size, 0)
+		FROM
+			users u
+		LEFT JOIN
+			(SELECT user_id, count(*) AS count FROM feeds GROUP BY user_id) f ON f.user_id=u.id
+		LEFT JOIN
+			(SELECT user_id, count(*) AS count, sum(pg_column_size(entries.*)) AS size FROM entries GROUP BY user_id) e ON e.user_id=u.id
+		LEFT JOIN
+			(SELECT user_id, sum(pg_column_size(enclosures.*)) AS size FROM enclosures GROUP BY user_id) a ON a.user_id=u.id
+		ORDER BY
+			8 DESC, u.username ASC
+	`
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch user reports: %v`, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user model.UserReport
+		err := rows.Scan(
+			&user.UserID,
+			&user.Username,
+			&user.IsAdmin,
+			&user.LastLoginAt,
+			&user.LastSeenAt,
+			&user.FeedCount,
+			&user.EntryCount,
+			&user.StorageBytes,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch user report row: %v`, err)
+		}
+
+		report.FeedCount += user.FeedCount
+		report.EntryCount += user.EntryCount
+		report.StorageBytes += user.StorageBytes
+		report.Users = append(report.Users, &user)
+	}
+
+	return report, nil
+}
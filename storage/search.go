@@ -0,0 +1,214 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// AddSearchHistory remembers a search query and forgets the oldest ones.
+func (s *Storage) AddSearchHistory(userID int64, searchQuery string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `
+		INSERT INTO search_history
+			(user_id, query)
+		VALUES
+			($1, $2)
+		ON CONFLICT (user_id, query) DO UPDATE SET searched_at=now()
+	`
+	if _, err := tx.Exec(query, userID, searchQuery); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to add search history: %v`, err)
+	}
+
+	query = `
+		DELETE FROM
+			search_history
+		WHERE
+			user_id=$1 AND id NOT IN (
+				SELECT id FROM search_history WHERE user_id=$1 ORDER BY searched_at DESC LIMIT $2
+			)
+	`
+	if _, err := tx.Exec(query, userID, model.SearchHistoryMaxSize); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to trim search history: %v`, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// SearchHistory returns the recent search queries of the user, the most recent first.
+func (s *Storage) SearchHistory(userID int64) ([]string, error) {
+	query := `SELECT query FROM search_history WHERE user_id=$1 ORDER BY searched_at DESC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch search history: %v`, err)
+	}
+	defer rows.Close()
+
+	history := make([]string, 0)
+	for rows.Next() {
+		var searchQuery string
+		if err := rows.Scan(&searchQuery); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch search history row: %v`, err)
+		}
+
+		history = append(history, searchQuery)
+	}
+
+	return history, nil
+}
+
+// ClearSearchHistory forgets all recent searches of the user.
+func (s *Storage) ClearSearchHistory(userID int64) error {
+	if _, err := s.db.Exec(`DELETE FROM search_history WHERE user_id=$1`, userID); err != nil {
+		return fmt.Errorf(`store: unable to clear search history: %v`, err)
+	}
+
+	return nil
+}
+
+const savedSearchQuery = `
+	SELECT
+		s.id,
+		s.user_id,
+		s.query,
+		coalesce(s.feed_id, 0),
+		coalesce(s.category_id, 0),
+		coalesce(nullif(f.custom_title, ''), f.title, ''),
+		coalesce(c.title, ''),
+		s.created_at
+	FROM
+		saved_searches s
+	LEFT JOIN
+		feeds f ON f.id=s.feed_id
+	LEFT JOIN
+		categories c ON c.id=s.category_id
+`
+
+// SavedSearchExists checks if the user already saved the same query with the same scope.
+func (s *Storage) SavedSearchExists(userID int64, searchQuery string, feedID, categoryID int64) bool {
+	var result bool
+	query := `
+		SELECT
+			true
+		FROM
+			saved_searches
+		WHERE
+			user_id=$1 AND query=$2 AND coalesce(feed_id, 0)=$3 AND coalesce(category_id, 0)=$4
+		LIMIT 1
+	`
+	s.db.QueryRow(query, userID, searchQuery, feedID, categoryID).Scan(&result)
+	return result
+}
+
+// SavedSearches returns the saved searches of the user.
+func (s *Storage) SavedSearches(userID int64) (model.SavedSearches, error) {
+	rows, err := s.db.Query(savedSearchQuery+` WHERE s.user_id=$1 ORDER BY s.query ASC, s.id ASC`, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch saved searches: %v`, err)
+	}
+	defer rows.Close()
+
+	savedSearches := make(model.SavedSearches, 0)
+	for rows.Next() {
+		savedSearch, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch saved search row: %v`, err)
+		}
+
+		savedSearches = append(savedSearches, savedSearch)
+	}
+
+	return savedSearches, nil
+}
+
+// SavedSearchByID returns a saved search of the user.
+func (s *Storage) SavedSearchByID(userID, savedSearchID int64) (*model.SavedSearch, error) {
+	row := s.db.QueryRow(savedSearchQuery+` WHERE s.user_id=$1 AND s.id=$2`, userID, savedSearchID)
+	savedSearch, err := scanSavedSearch(row)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch saved search: %v`, err)
+	}
+
+	return savedSearch, nil
+}
+
+// CreateSavedSearch saves a search query, saving the same query with the same scope twice returns the existing one.
+func (s *Storage) CreateSavedSearch(savedSearch *model.SavedSearch) error {
+	query := `
+		INSERT INTO saved_searches
+			(user_id, query, feed_id, category_id)
+		VALUES
+			($1, $2, NULLIF($3::bigint, 0), NULLIF($4::int, 0))
+		ON CONFLICT (user_id, query, coalesce(feed_id, 0), coalesce(category_id, 0)) DO UPDATE SET query=excluded.query
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		savedSearch.UserID,
+		savedSearch.Query,
+		savedSearch.FeedID,
+		savedSearch.CategoryID,
+	).Scan(
+		&savedSearch.ID,
+		&savedSearch.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create saved search: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveSavedSearch deletes a saved search.
+func (s *Storage) RemoveSavedSearch(userID, savedSearchID int64) error {
+	query := `DELETE FROM saved_searches WHERE id=$1 AND user_id=$2`
+	if _, err := s.db.Exec(query, savedSearchID, userID); err != nil {
+		return fmt.Errorf(`store: unable to remove this saved search: %v`, err)
+	}
+
+	return nil
+}
+
+type savedSearchScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSavedSearch(row savedSearchScanner) (*model.SavedSearch, error) {
+	var savedSearch model.SavedSearch
+	err := row.Scan(
+		&savedSearch.ID,
+		&savedSearch.UserID,
+		&savedSearch.Query,
+		&savedSearch.FeedID,
+		&savedSearch.CategoryID,
+		&savedSearch.FeedTitle,
+		&savedSearch.CategoryTitle,
+		&savedSearch.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &savedSearch, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+	"strings"
+
+	"miniflux.app/model"
+)
+
+// searchConditions translates a search query into SQL conditions, the placeholders are numbered after the given arguments.
+// The returned tsquery expression is used to rank the results, it's empty when the query has no full-text part.
+// The title and author conditions use the trigram indexes when available, the content is only searched with the full-text index.
+// The feed condition matches the title displayed to the user, custom or not.
+func searchConditions(query string, args []interface{}) ([]string, []interface{}, string) {
+	searchQuery := model.ParseSearchQuery(query)
+
+	var conditions []string
+	placeholder := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	var tsQueries []string
+	if len(searchQuery.Terms) > 0 {
+		tsQueries = append(tsQueries, fmt.Sprintf("plainto_tsquery(%s)", placeholder(strings.Join(searchQuery.Terms, " "))))
+	}
+
+	for _, phrase := range searchQuery.Phrases {
+		tsQueries = append(tsQueries, fmt.Sprintf("phraseto_tsquery(%s)", placeholder(phrase)))
+	}
+
+	tsQuery := ""
+	if len(tsQueries) > 0 {
+		tsQuery = "(" + strings.Join(tsQueries, " && ") + ")"
+		conditions = append(conditions, "e.document_vectors @@ "+tsQuery)
+	}
+
+	for _, regex := range searchQuery.Regexes {
+		conditions = append(conditions, fmt.Sprintf("e.title ~* %s", placeholder(regex)))
+	}
+
+	for _, title := range searchQuery.Titles {
+		conditions = append(conditions, fmt.Sprintf("e.title ILIKE %s", placeholder(containsPattern(title))))
+	}
+
+	for _, regex := range searchQuery.TitleRegexes {
+		conditions = append(conditions, fmt.Sprintf("e.title ~* %s", placeholder(regex)))
+	}
+
+	for _, author := range searchQuery.Authors {
+		conditions = append(conditions, fmt.Sprintf("e.author ILIKE %s", placeholder(containsPattern(author))))
+	}
+
+	for _, regex := range searchQuery.AuthorRegexes {
+		conditions = append(conditions, fmt.Sprintf("e.author ~* %s", placeholder(regex)))
+	}
+
+	for _, feed := range searchQuery.Feeds {
+		conditions = append(conditions, fmt.Sprintf("coalesce(nullif(f.custom_title, ''), f.title) ILIKE %s", placeholder(containsPattern(feed))))
+	}
+
+	for _, tag := range searchQuery.Tags {
+		conditions = append(conditions, fmt.Sprintf("e.tags @> ARRAY[%s]::text[]", placeholder(tag)))
+	}
+
+	if searchQuery.Before != nil {
+		conditions = append(conditions, fmt.Sprintf("e.published_at < %s", placeholder(*searchQuery.Before)))
+	}
+
+	if searchQuery.After != nil {
+		conditions = append(conditions, fmt.Sprintf("e.published_at >= %s", placeholder(*searchQuery.After)))
+	}
+
+	return conditions, args, tsQuery
+}
+
+// containsPattern returns a LIKE pattern matching the text anywhere, the wildcards of the text are escaped.
+func containsPattern(text string) string {
+	return "%" + likeEscaper.Replace(text) + "%"
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
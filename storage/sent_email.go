@@ -0,0 +1,42 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+	"time"
+)
+
+// CreateSentEmail records an email sent by the user, to limit the number of emails sent through the instance.
+func (s *Storage) CreateSentEmail(userID int64, recipients int) error {
+	query := `INSERT INTO sent_emails (user_id, recipients) VALUES ($1, $2)`
+	if _, err := s.db.Exec(query, userID, recipients); err != nil {
+		return fmt.Errorf(`store: unable to record sent email: %v`, err)
+	}
+
+	return nil
+}
+
+// CountSentEmailRecipientsSince returns the number of addresses the user sent emails to since the given time.
+func (s *Storage) CountSentEmailRecipientsSince(userID int64, since time.Time) (int, error) {
+	var count int
+	query := `SELECT coalesce(sum(recipients), 0) FROM sent_emails WHERE user_id=$1 AND created_at >= $2`
+	if err := s.db.QueryRow(query, userID, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`store: unable to count sent emails: %v`, err)
+	}
+
+	return count, nil
+}
+
+// CleanOldSentEmails removes the records which are no longer needed by the rate limit.
+func (s *Storage) CleanOldSentEmails() int64 {
+	result, err := s.db.Exec(`DELETE FROM sent_emails WHERE created_at < now() - interval '1 day'`)
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
@@ -6,6 +6,7 @@ package storage // import "miniflux.app/storage"
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	"miniflux.app/crypto"
@@ -44,6 +45,13 @@ func (s *Storage) CreateAppSession() (*model.Session, error) {
 }
 
 func (s *Storage) createAppSession(session *model.Session) (*model.Session, error) {
+	if s.cache != nil {
+		if err := s.setCachedAppSession(session.ID, session.Data); err != nil {
+			return nil, fmt.Errorf(`store: unable to create app session: %v`, err)
+		}
+		return session, nil
+	}
+
 	query := `INSERT INTO sessions (id, data) VALUES ($1, $2)`
 	_, err := s.db.Exec(query, session.ID, session.Data)
 	if err != nil {
@@ -55,6 +63,13 @@ func (s *Storage) createAppSession(session *model.Session) (*model.Session, erro
 
 // UpdateAppSessionField updates only one session field.
 func (s *Storage) UpdateAppSessionField(sessionID, field string, value interface{}) error {
+	if s.cache != nil {
+		if err := s.updateCachedAppSessionField(sessionID, field, value); err != nil {
+			return fmt.Errorf(`store: unable to update session field: %v`, err)
+		}
+		return nil
+	}
+
 	query := `
 		UPDATE
 			sessions
@@ -73,6 +88,10 @@ func (s *Storage) UpdateAppSessionField(sessionID, field string, value interface
 
 // AppSession returns the given session.
 func (s *Storage) AppSession(id string) (*model.Session, error) {
+	if s.cache != nil {
+		return s.cachedAppSession(id)
+	}
+
 	var session model.Session
 
 	query := "SELECT id, data FROM sessions WHERE id=$1"
@@ -92,6 +111,7 @@ func (s *Storage) AppSession(id string) (*model.Session, error) {
 }
 
 // FlushAllSessions removes all sessions from the database.
+// Application sessions stored in the cache are not removed, they only expire.
 func (s *Storage) FlushAllSessions() (err error) {
 	_, err = s.db.Exec(`DELETE FROM user_sessions`)
 	if err != nil {
@@ -122,3 +142,45 @@ func (s *Storage) CleanOldSessions(days int) int64 {
 	n, _ := result.RowsAffected()
 	return n
 }
+
+func (s *Storage) cachedAppSession(id string) (*model.Session, error) {
+	value, found, err := s.cache.Get(appSessionCacheKey(id))
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch session: %v`, err)
+	case !found:
+		return nil, fmt.Errorf(`store: session not found: %s`, id)
+	}
+
+	var data model.SessionData
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch session: %v`, err)
+	}
+
+	return &model.Session{ID: id, Data: &data}, nil
+}
+
+func (s *Storage) setCachedAppSession(id string, data interface{}) error {
+	value, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return s.cache.Set(appSessionCacheKey(id), string(value), s.sessionTTL)
+}
+
+// updateCachedAppSessionField mimics the jsonb_set() query: the value is always stored as text.
+// The cache applies the change atomically, concurrent requests of the same session don't overwrite each other.
+func (s *Storage) updateCachedAppSessionField(id, field string, value interface{}) error {
+	_, err := s.cache.Update(appSessionCacheKey(id), s.sessionTTL, func(current string) (string, error) {
+		data := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(current), &data); err != nil {
+			return "", err
+		}
+
+		data[field] = fmt.Sprint(value)
+		updated, err := json.Marshal(data)
+		return string(updated), err
+	})
+	return err
+}
@@ -0,0 +1,98 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"sync"
+
+	"miniflux.app/logger"
+)
+
+// Hot queries which are prepared once when the statement cache is enabled.
+const (
+	queryCountUserFeedsWithErrors = `SELECT count(*) FROM feeds WHERE user_id=$1 AND (parsing_error_count >= $2 OR dead is true)`
+	querySetEntriesStatus         = `UPDATE entries SET status=$1, changed_at=now() WHERE user_id=$2 AND id=ANY($3)`
+	queryToggleBookmark           = `UPDATE entries SET starred = NOT starred, changed_at=now() WHERE user_id=$1 AND id=$2`
+)
+
+// statementCache keeps the prepared statements of the hot queries: status updates, counters and entry pagination.
+// The queries given to the cache are static or built from a fixed set of filters, so the number of statements is bounded.
+// The queries with search conditions depend on the search terms and are never cached.
+// database/sql prepares each statement again on every connection of the pool the first time it is used there.
+type statementCache struct {
+	sync.Mutex
+	statements map[string]*sql.Stmt
+}
+
+func (c *statementCache) get(db *sql.DB, query string) (*sql.Stmt, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if stmt, found := c.statements[query]; found {
+		return stmt, nil
+	}
+
+	stmt, err := db.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.statements[query] = stmt
+	return stmt, nil
+}
+
+// EnableStatementCache reuses prepared statements for hot queries.
+// It must not be used with connection poolers that do not support prepared statements (e.g. PgBouncer in transaction mode).
+func (s *Storage) EnableStatementCache() {
+	s.statements = &statementCache{statements: make(map[string]*sql.Stmt)}
+}
+
+// statement returns the cached prepared statement of the query, or nil when the cache is disabled.
+func (s *Storage) statement(query string) *sql.Stmt {
+	if s.statements == nil {
+		return nil
+	}
+
+	stmt, err := s.statements.get(s.db, query)
+	if err != nil {
+		logger.Error(`store: unable to prepare statement: %v`, err)
+		return nil
+	}
+
+	return stmt
+}
+
+func (s *Storage) exec(query string, args ...interface{}) (sql.Result, error) {
+	if stmt := s.statement(query); stmt != nil {
+		return stmt.Exec(args...)
+	}
+
+	return s.db.Exec(query, args...)
+}
+
+func (s *Storage) query(query string, args ...interface{}) (*sql.Rows, error) {
+	if stmt := s.statement(query); stmt != nil {
+		return stmt.Query(args...)
+	}
+
+	return s.db.Query(query, args...)
+}
+
+func (s *Storage) queryRow(query string, args ...interface{}) *sql.Row {
+	if stmt := s.statement(query); stmt != nil {
+		return stmt.QueryRow(args...)
+	}
+
+	return s.db.QueryRow(query, args...)
+}
+
+func (s *Storage) txQueryRow(tx *sql.Tx, query string, args ...interface{}) *sql.Row {
+	if stmt := s.statement(query); stmt != nil {
+		return tx.Stmt(stmt).QueryRow(args...)
+	}
+
+	return tx.QueryRow(query, args...)
+}
@@ -6,14 +6,40 @@ package storage // import "miniflux.app/storage"
 
 import (
 	"database/sql"
+	"time"
+
+	"miniflux.app/cache"
+	"miniflux.app/live"
 )
 
+// maxCachedIcons is the number of icons kept in memory, icons never change once stored.
+const maxCachedIcons = 1000
+
 // Storage handles all operations related to the database.
 type Storage struct {
-	db *sql.DB
+	db         *sql.DB
+	statements *statementCache
+	cache      Cache
+	sessionTTL time.Duration
+	icons      *cache.LRU
+	events     *live.Hub
 }
 
 // NewStorage returns a new Storage.
 func NewStorage(db *sql.DB) *Storage {
-	return &Storage{db}
+	return &Storage{
+		db:     db,
+		icons:  cache.NewLRU(maxCachedIcons),
+		events: live.NewHub(),
+	}
+}
+
+// Events returns the hub used to notify the open pages of users.
+func (s *Storage) Events() *live.Hub {
+	return s.events
+}
+
+// DatabaseStats returns the statistics of the connection pool.
+func (s *Storage) DatabaseStats() sql.DBStats {
+	return s.db.Stats()
 }
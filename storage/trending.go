@@ -0,0 +1,112 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// UpdateTrendingTopics replaces the trending topics of the given user.
+func (s *Storage) UpdateTrendingTopics(userID int64, topics model.TrendingTopics) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if _, err := tx.Exec(`DELETE FROM trending_entries WHERE user_id=$1`, userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove trending entries: %v`, err)
+	}
+
+	query := `INSERT INTO trending_entries (user_id, entry_id, topic_id, score) VALUES ($1, $2, $3, $4)`
+	for topicID, topic := range topics {
+		for _, entry := range topic.Entries {
+			if _, err := tx.Exec(query, userID, entry.ID, topicID, topic.Score); err != nil {
+				tx.Rollback()
+				return fmt.Errorf(`store: unable to create trending entry: %v`, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// TrendingTopics returns the trending topics computed for the given user.
+func (s *Storage) TrendingTopics(userID int64) (model.TrendingTopics, error) {
+	query := `
+		SELECT
+			entry_id, topic_id, score
+		FROM
+			trending_entries
+		WHERE
+			user_id=$1
+		ORDER BY
+			score DESC, topic_id ASC
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch trending entries: %v`, err)
+	}
+	defer rows.Close()
+
+	var entryIDs []int64
+	topicsByID := make(map[int]*model.TrendingTopic)
+	topicIDByEntry := make(map[int64]int)
+	topics := make(model.TrendingTopics, 0)
+	for rows.Next() {
+		var entryID int64
+		var topicID, score int
+		if err := rows.Scan(&entryID, &topicID, &score); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch trending entry row: %v`, err)
+		}
+
+		if _, found := topicsByID[topicID]; !found {
+			topicsByID[topicID] = &model.TrendingTopic{Score: score}
+			topics = append(topics, topicsByID[topicID])
+		}
+
+		entryIDs = append(entryIDs, entryID)
+		topicIDByEntry[entryID] = topicID
+	}
+
+	if len(entryIDs) == 0 {
+		return topics, nil
+	}
+
+	builder := s.NewEntryQueryBuilder(userID)
+	builder.WithEntryIDs(entryIDs)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithOrder("published_at")
+	builder.WithDirection("asc")
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		topic := topicsByID[topicIDByEntry[entry.ID]]
+		if topic.Title == "" {
+			topic.Title = entry.Title
+		}
+		topic.Entries = append(topic.Entries, entry)
+	}
+
+	// Removed entries could leave topics without enough entries.
+	filteredTopics := make(model.TrendingTopics, 0, len(topics))
+	for _, topic := range topics {
+		if len(topic.Entries) > 1 {
+			filteredTopics = append(filteredTopics, topic)
+		}
+	}
+
+	return filteredTopics, nil
+}
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"miniflux.app/logger"
 	"miniflux.app/model"
@@ -38,6 +39,27 @@ func (s *Storage) SetLastLogin(userID int64) error {
 	return nil
 }
 
+// UpdateUserVisit records the activity of the user, the last activity before a long enough
+// break is kept as the previous visit.
+func (s *Storage) UpdateUserVisit(userID int64) error {
+	now := time.Now()
+	query := `
+		UPDATE
+			users
+		SET
+			previous_visit_at = CASE WHEN last_seen_at < $2 THEN last_seen_at ELSE previous_visit_at END,
+			last_seen_at = $3
+		WHERE
+			id=$1 AND (last_seen_at IS NULL OR last_seen_at < $4)
+	`
+	_, err := s.db.Exec(query, userID, now.Add(-model.VisitTimeout), now, now.Add(-time.Minute))
+	if err != nil {
+		return fmt.Errorf(`store: unable to update last visit: %v`, err)
+	}
+
+	return nil
+}
+
 // UserExists checks if a user exists by using the given username.
 func (s *Storage) UserExists(username string) bool {
 	var result bool
@@ -76,7 +98,7 @@ func (s *Storage) CreateUser(user *model.User) (err error) {
 		VALUES
 			(LOWER($1), $2, $3, $4)
 		RETURNING
-			id, username, is_admin, language, theme, timezone, entry_direction, entries_per_page, keyboard_shortcuts, show_reading_time
+			id, username, is_admin, language, theme, timezone, entry_direction, entries_per_page, keyboard_shortcuts, show_reading_time, mark_read_on_original_link, youtube_embed_url, blocked_authors, auto_star_keywords, auto_star_authors, email_recipients, home_page, entry_timezone, timestamp_format, notification_email, notification_email_verified, keep_starred_entries, archive_read_days, accept_received_entries
 	`
 
 	err = s.db.QueryRow(query, user.Username, password, user.IsAdmin, extra).Scan(
@@ -90,6 +112,20 @@ func (s *Storage) CreateUser(user *model.User) (err error) {
 		&user.EntriesPerPage,
 		&user.KeyboardShortcuts,
 		&user.ShowReadingTime,
+		&user.MarkReadOnOriginalLink,
+		&user.YouTubeEmbedURL,
+		&user.BlockedAuthors,
+		&user.AutoStarKeywords,
+		&user.AutoStarAuthors,
+		&user.EmailRecipients,
+		&user.HomePage,
+		&user.EntryTimezone,
+		&user.TimestampFormat,
+		&user.NotificationEmail,
+		&user.NotificationEmailVerified,
+		&user.KeepStarredEntries,
+		&user.ArchiveReadDays,
+		&user.AcceptReceivedEntries,
 	)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create user: %v`, err)
@@ -139,9 +175,24 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				entry_direction=$7,
 				entries_per_page=$8,
 				keyboard_shortcuts=$9,
-				show_reading_time=$10
+				show_reading_time=$10,
+				mark_read_on_original_link=$11,
+				youtube_embed_url=$12,
+				blocked_authors=$13,
+				auto_star_keywords=$14,
+				auto_star_authors=$15,
+				email_recipients=$16,
+				home_page=$17,
+				entry_timezone=$18,
+				timestamp_format=$19,
+				notification_email=$20,
+				notification_email_verified=(notification_email=$20 AND notification_email_verified),
+				notification_email_token=CASE WHEN notification_email=$20 THEN notification_email_token ELSE '' END,
+				keep_starred_entries=$21,
+				archive_read_days=$22,
+				accept_received_entries=$23
 			WHERE
-				id=$11
+				id=$24
 		`
 
 		_, err = s.db.Exec(
@@ -156,6 +207,19 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.EntriesPerPage,
 			user.KeyboardShortcuts,
 			user.ShowReadingTime,
+			user.MarkReadOnOriginalLink,
+			user.YouTubeEmbedURL,
+			user.BlockedAuthors,
+			user.AutoStarKeywords,
+			user.AutoStarAuthors,
+			user.EmailRecipients,
+			user.HomePage,
+			user.EntryTimezone,
+			user.TimestampFormat,
+			user.NotificationEmail,
+			user.KeepStarredEntries,
+			user.ArchiveReadDays,
+			user.AcceptReceivedEntries,
 			user.ID,
 		)
 		if err != nil {
@@ -172,9 +236,24 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				entry_direction=$6,
 				entries_per_page=$7,
 				keyboard_shortcuts=$8,
-				show_reading_time=$9
+				show_reading_time=$9,
+				mark_read_on_original_link=$10,
+				youtube_embed_url=$11,
+				blocked_authors=$12,
+				auto_star_keywords=$13,
+				auto_star_authors=$14,
+				email_recipients=$15,
+				home_page=$16,
+				entry_timezone=$17,
+				timestamp_format=$18,
+				notification_email=$19,
+				notification_email_verified=(notification_email=$19 AND notification_email_verified),
+				notification_email_token=CASE WHEN notification_email=$19 THEN notification_email_token ELSE '' END,
+				keep_starred_entries=$20,
+				archive_read_days=$21,
+				accept_received_entries=$22
 			WHERE
-				id=$10
+				id=$23
 		`
 
 		_, err := s.db.Exec(
@@ -188,6 +267,19 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.EntriesPerPage,
 			user.KeyboardShortcuts,
 			user.ShowReadingTime,
+			user.MarkReadOnOriginalLink,
+			user.YouTubeEmbedURL,
+			user.BlockedAuthors,
+			user.AutoStarKeywords,
+			user.AutoStarAuthors,
+			user.EmailRecipients,
+			user.HomePage,
+			user.EntryTimezone,
+			user.TimestampFormat,
+			user.NotificationEmail,
+			user.KeepStarredEntries,
+			user.ArchiveReadDays,
+			user.AcceptReceivedEntries,
 			user.ID,
 		)
 
@@ -213,6 +305,16 @@ func (s *Storage) UserLanguage(userID int64) (language string) {
 	return language
 }
 
+// UserTimezone returns the timezone of the given user.
+func (s *Storage) UserTimezone(userID int64) (timezone string) {
+	err := s.db.QueryRow(`SELECT timezone FROM users WHERE id = $1`, userID).Scan(&timezone)
+	if err != nil {
+		return "UTC"
+	}
+
+	return timezone
+}
+
 // UserByID finds a user by the ID.
 func (s *Storage) UserByID(userID int64) (*model.User, error) {
 	query := `
@@ -227,7 +329,23 @@ func (s *Storage) UserByID(userID int64) (*model.User, error) {
 			entries_per_page,
 			keyboard_shortcuts,
 			show_reading_time,
+			mark_read_on_original_link,
+			youtube_embed_url,
+			blocked_authors,
+			auto_star_keywords,
+			auto_star_authors,
+			email_recipients,
+			home_page,
+			entry_timezone,
+			timestamp_format,
+			notification_email,
+			notification_email_verified,
+			keep_starred_entries,
+			archive_read_days,
+			accept_received_entries,
 			last_login_at,
+			last_seen_at,
+			previous_visit_at,
 			extra
 		FROM
 			users
@@ -251,7 +369,23 @@ func (s *Storage) UserByUsername(username string) (*model.User, error) {
 			entries_per_page,
 			keyboard_shortcuts,
 			show_reading_time,
+			mark_read_on_original_link,
+			youtube_embed_url,
+			blocked_authors,
+			auto_star_keywords,
+			auto_star_authors,
+			email_recipients,
+			home_page,
+			entry_timezone,
+			timestamp_format,
+			notification_email,
+			notification_email_verified,
+			keep_starred_entries,
+			archive_read_days,
+			accept_received_entries,
 			last_login_at,
+			last_seen_at,
+			previous_visit_at,
 			extra
 		FROM
 			users
@@ -275,7 +409,23 @@ func (s *Storage) UserByExtraField(field, value string) (*model.User, error) {
 			entries_per_page,
 			keyboard_shortcuts,
 			show_reading_time,
+			mark_read_on_original_link,
+			youtube_embed_url,
+			blocked_authors,
+			auto_star_keywords,
+			auto_star_authors,
+			email_recipients,
+			home_page,
+			entry_timezone,
+			timestamp_format,
+			notification_email,
+			notification_email_verified,
+			keep_starred_entries,
+			archive_read_days,
+			accept_received_entries,
 			last_login_at,
+			last_seen_at,
+			previous_visit_at,
 			extra
 		FROM
 			users
@@ -299,7 +449,23 @@ func (s *Storage) UserByAPIKey(token string) (*model.User, error) {
 			u.entries_per_page,
 			u.keyboard_shortcuts,
 			u.show_reading_time,
+			u.mark_read_on_original_link,
+			u.youtube_embed_url,
+			u.blocked_authors,
+			u.auto_star_keywords,
+			u.auto_star_authors,
+			u.email_recipients,
+			u.home_page,
+			u.entry_timezone,
+			u.timestamp_format,
+			u.notification_email,
+			u.notification_email_verified,
+			u.keep_starred_entries,
+			u.archive_read_days,
+			u.accept_received_entries,
 			u.last_login_at,
+			u.last_seen_at,
+			u.previous_visit_at,
 			u.extra
 		FROM
 			users u
@@ -326,7 +492,23 @@ func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, err
 		&user.EntriesPerPage,
 		&user.KeyboardShortcuts,
 		&user.ShowReadingTime,
+		&user.MarkReadOnOriginalLink,
+		&user.YouTubeEmbedURL,
+		&user.BlockedAuthors,
+		&user.AutoStarKeywords,
+		&user.AutoStarAuthors,
+		&user.EmailRecipients,
+		&user.HomePage,
+		&user.EntryTimezone,
+		&user.TimestampFormat,
+		&user.NotificationEmail,
+		&user.NotificationEmailVerified,
+		&user.KeepStarredEntries,
+		&user.ArchiveReadDays,
+		&user.AcceptReceivedEntries,
 		&user.LastLoginAt,
+		&user.LastSeenAt,
+		&user.PreviousVisitAt,
 		&extra,
 	)
 
@@ -392,7 +574,23 @@ func (s *Storage) Users() (model.Users, error) {
 			entries_per_page,
 			keyboard_shortcuts,
 			show_reading_time,
+			mark_read_on_original_link,
+			youtube_embed_url,
+			blocked_authors,
+			auto_star_keywords,
+			auto_star_authors,
+			email_recipients,
+			home_page,
+			entry_timezone,
+			timestamp_format,
+			notification_email,
+			notification_email_verified,
+			keep_starred_entries,
+			archive_read_days,
+			accept_received_entries,
 			last_login_at,
+			last_seen_at,
+			previous_visit_at,
 			extra
 		FROM
 			users
@@ -419,7 +617,23 @@ func (s *Storage) Users() (model.Users, error) {
 			&user.EntriesPerPage,
 			&user.KeyboardShortcuts,
 			&user.ShowReadingTime,
+			&user.MarkReadOnOriginalLink,
+			&user.YouTubeEmbedURL,
+			&user.BlockedAuthors,
+			&user.AutoStarKeywords,
+			&user.AutoStarAuthors,
+			&user.EmailRecipients,
+			&user.HomePage,
+			&user.EntryTimezone,
+			&user.TimestampFormat,
+			&user.NotificationEmail,
+			&user.NotificationEmailVerified,
+			&user.KeepStarredEntries,
+			&user.ArchiveReadDays,
+			&user.AcceptReceivedEntries,
 			&user.LastLoginAt,
+			&user.LastSeenAt,
+			&user.PreviousVisitAt,
 			&extra,
 		)
 
@@ -476,6 +690,36 @@ func (s *Storage) HasPassword(userID int64) (bool, error) {
 	return false, nil
 }
 
+// SetNotificationEmailToken stores the token sent to the notification email address to verify it.
+func (s *Storage) SetNotificationEmailToken(userID int64, token string) error {
+	query := `UPDATE users SET notification_email_token=$1, notification_email_verified='f' WHERE id=$2 AND notification_email <> ''`
+	if _, err := s.db.Exec(query, token, userID); err != nil {
+		return fmt.Errorf(`store: unable to update notification email token: %v`, err)
+	}
+
+	return nil
+}
+
+// VerifyNotificationEmail marks the notification email address as verified if the token matches.
+func (s *Storage) VerifyNotificationEmail(userID int64, token string) (bool, error) {
+	query := `
+		UPDATE
+			users
+		SET
+			notification_email_verified='t',
+			notification_email_token=''
+		WHERE
+			id=$1 AND notification_email <> '' AND notification_email_token <> '' AND notification_email_token=$2
+	`
+	result, err := s.db.Exec(query, userID, token)
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to verify notification email: %v`, err)
+	}
+
+	count, _ := result.RowsAffected()
+	return count > 0, nil
+}
+
 func hashPassword(password string) (string, error) {
 	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	return string(bytes), err
@@ -7,7 +7,7 @@ var templateCommonMap = map[string]string{
 <div class="pagination">
     <div class="pagination-prev">
         {{ if .prevEntry }}
-            <a href="{{ .prevEntryRoute }}{{ if .searchQuery }}?q={{ .searchQuery }}{{ end }}" title="{{ .prevEntry.Title }}" data-page="previous" rel="prev">{{ t "pagination.previous" }}</a>
+            <a href="{{ .prevEntryRoute }}{{ if .searchQuery }}?q={{ .searchQuery }}{{ with .searchScope }}{{ if .FeedID }}&amp;feed_id={{ .FeedID }}{{ end }}{{ if .CategoryID }}&amp;category_id={{ .CategoryID }}{{ end }}{{ end }}{{ end }}" title="{{ .prevEntry.Title }}" data-page="previous" rel="prev">{{ t "pagination.previous" }}</a>
         {{ else }}
             {{ t "pagination.previous" }}
         {{ end }}
@@ -15,7 +15,7 @@ var templateCommonMap = map[string]string{
 
     <div class="pagination-next">
         {{ if .nextEntry }}
-            <a href="{{ .nextEntryRoute }}{{ if .searchQuery }}?q={{ .searchQuery }}{{ end }}" title="{{ .nextEntry.Title }}" data-page="next" rel="next">{{ t "pagination.next" }}</a>
+            <a href="{{ .nextEntryRoute }}{{ if .searchQuery }}?q={{ .searchQuery }}{{ with .searchScope }}{{ if .FeedID }}&amp;feed_id={{ .FeedID }}{{ end }}{{ if .CategoryID }}&amp;category_id={{ .CategoryID }}{{ end }}{{ end }}{{ end }}" title="{{ .nextEntry.Title }}" data-page="next" rel="next">{{ t "pagination.next" }}</a>
         {{ else }}
             {{ t "pagination.next" }}
         {{ end }}
@@ -24,16 +24,27 @@ var templateCommonMap = map[string]string{
 {{ end }}
 `,
 	"feed_list": `{{ define "feed_list" }}
+    {{ if .categories }}
+    <div id="feed-move-targets" class="feed-move-targets" data-move-url="{{ route "moveFeeds" }}" data-label-loading="{{ t "page.feeds.move.loading" }}">
+        <span class="feed-move-hint" data-label-hint="{{ t "page.feeds.move.hint" }}" data-label-count="{{ t "page.feeds.move.selected" }}">{{ t "page.feeds.move.hint" }}</span>
+        <ul>
+            {{ range .categories }}
+            <li><a href="{{ route "categoryFeeds" "categoryID" .ID }}" data-move-category-id="{{ .ID }}">{{ .Title }}</a></li>
+            {{ end }}
+        </ul>
+    </div>
+    {{ end }}
     <div class="items">
         {{ range .feeds }}
-        <article class="item {{ if ne .ParsingErrorCount 0 }}feed-parsing-error{{ end }}">
+        <article class="item {{ if or .Dead (ne .ParsingErrorCount 0) }}feed-parsing-error{{ end }}"{{ if $.categories }} draggable="true" data-feed-id="{{ .ID }}"{{ end }}>
             <div class="item-header" dir="auto">
                 <span class="item-title">
                     {{ if .Icon }}
-                        <img src="{{ route "icon" "iconID" .Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Title }}">
+                        <img src="{{ route "icon" "iconID" .Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .DisplayTitle }}">
                     {{ end }}
                     {{ if .Disabled }} 🚫 {{ end }}
-                    <a href="{{ route "feedEntries" "feedID" .ID }}">{{ .Title }}</a>
+                    <a href="{{ route "feedEntries" "feedID" .ID }}">{{ .DisplayTitle }}</a>
+                    {{ if .Dormant }}<span class="feed-dormant" title="{{ t "page.feeds.dormant_title" }}">{{ t "page.feeds.dormant" }}</span>{{ end }}
                 </span>
                 <span class="feed-entries-counter">
                     (<span title="{{ t "page.feeds.unread_counter" }}">{{ .UnreadCount }}</span>/<span title="{{ t "page.feeds.read_counter" }}">{{ .ReadCount }}</span>)
@@ -48,10 +59,17 @@ var templateCommonMap = map[string]string{
                         <a href="{{ .SiteURL | safeURL  }}" title="{{ .SiteURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ domain .SiteURL }}</a>
                     </li>
                     <li>
-                        {{ t "page.feeds.last_check" }} <time datetime="{{ isodate .CheckedAt }}" title="{{ isodate .CheckedAt }}">{{ elapsed $.user.Timezone .CheckedAt }}</time>
+                        {{ t "page.feeds.last_check" }} {{ template "timestamp" dict "user" $.user "date" .CheckedAt "absolute" (isodate .CheckedAt) }}
                     </li>
                 </ul>
                 <ul class="item-meta-icons">
+                    {{ if $.categories }}
+                    <li>
+                        <label class="item-select">
+                            <input type="checkbox" data-select-feed="true" value="{{ .ID }}"> {{ t "entry.select.label" }}
+                        </label>
+                    </li>
+                    {{ end }}
                     <li>
                         <a href="{{ route "refreshFeed" "feedID" .ID }}">{{ template "icon_refresh" }}<span class="icon-label">{{ t "menu.refresh_feed" }}</span></a>
                     </li>
@@ -74,7 +92,12 @@ var templateCommonMap = map[string]string{
                     {{ end }}
                 </ul>
             </div>
-            {{ if ne .ParsingErrorCount 0 }}
+            {{ if .Dead }}
+                <div class="parsing-error">
+                    <strong class="feed-dead">{{ t "page.feeds.dead" }}</strong>
+                    - <small class="parsing-error-message">{{ .ParsingErrorMsg }}</small>
+                </div>
+            {{ else if ne .ParsingErrorCount 0 }}
                 <div class="parsing-error">
                     <strong title="{{ .ParsingErrorMsg }}" class="parsing-error-count">{{ plural "page.feeds.error_count" .ParsingErrorCount .ParsingErrorCount }}</strong>
                     - <small class="parsing-error-message">{{ .ParsingErrorMsg }}</small>
@@ -93,6 +116,11 @@ var templateCommonMap = map[string]string{
     <li>
         <a href="{{ route "addSubscription" }}">{{ t "menu.add_feed" }}</a>
     </li>
+    {{ if hasFeedRecommendations }}
+    <li>
+        <a href="{{ route "feedRecommendations" }}">{{ t "menu.feed_recommendations" }}</a>
+    </li>
+    {{ end }}
     <li>
         <a href="{{ route "export" }}">{{ t "menu.export" }}</a>
     </li>
@@ -157,6 +185,29 @@ SOFTWARE.
     <line x1="12" y1="4" x2="12" y2="16" />
 </svg>
 {{ end }}
+{{ define "icon_archive" }}
+<svg xmlns="http://www.w3.org/2000/svg" class="icon icon-tabler icon-tabler-archive" width="24" height="24" viewBox="0 0 24 24" stroke-width="2" stroke="currentColor" fill="none" stroke-linecap="round" stroke-linejoin="round">
+    <path stroke="none" d="M0 0h24v24H0z"/>
+    <rect x="3" y="4" width="18" height="4" rx="2" />
+    <path d="M5 8v10a2 2 0 0 0 2 2h10a2 2 0 0 0 2 -2v-10" />
+    <line x1="10" y1="12" x2="14" y2="12" />
+</svg>
+{{ end }}
+{{ define "icon_email" }}
+<svg xmlns="http://www.w3.org/2000/svg" class="icon icon-tabler icon-tabler-mail" width="24" height="24" viewBox="0 0 24 24" stroke-width="2" stroke="currentColor" fill="none" stroke-linecap="round" stroke-linejoin="round">
+    <path stroke="none" d="M0 0h24v24H0z"/>
+    <rect x="3" y="5" width="18" height="14" rx="2" />
+    <polyline points="3 7 12 13 21 7" />
+</svg>
+{{ end }}
+{{ define "icon_print" }}
+<svg xmlns="http://www.w3.org/2000/svg" class="icon icon-tabler icon-tabler-printer" width="24" height="24" viewBox="0 0 24 24" stroke-width="2" stroke="currentColor" fill="none" stroke-linecap="round" stroke-linejoin="round">
+    <path stroke="none" d="M0 0h24v24H0z"/>
+    <path d="M17 17h2a2 2 0 0 0 2 -2v-4a2 2 0 0 0 -2 -2h-14a2 2 0 0 0 -2 2v4a2 2 0 0 0 2 2h2" />
+    <path d="M17 9v-4a2 2 0 0 0 -2 -2h-6a2 2 0 0 0 -2 2v4" />
+    <rect x="7" y="13" width="10" height="8" rx="2" />
+</svg>
+{{ end }}
 {{ define "icon_scraper" }}
 <svg xmlns="http://www.w3.org/2000/svg" class="icon icon-tabler icon-tabler-cloud-download" width="24" height="24" viewBox="0 0 24 24" stroke-width="2" stroke="currentColor" fill="none" stroke-linecap="round" stroke-linejoin="round">
     <path stroke="none" d="M0 0h24v24H0z"/>
@@ -237,18 +288,30 @@ SOFTWARE.
 <div class="item-meta">
     <ul class="item-meta-info">
         <li>
-            <a href="{{ route "feedEntries" "feedID" .entry.Feed.ID }}" title="{{ .entry.Feed.SiteURL }}">{{ truncate .entry.Feed.Title 35 }}</a>
+            <a href="{{ route "feedEntries" "feedID" .entry.Feed.ID }}" title="{{ .entry.Feed.SiteURL }}">{{ truncate .entry.Feed.DisplayTitle 35 }}</a>
         </li>
         <li>
-            <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed .user.Timezone .entry.Date }}</time>
+            {{ template "timestamp" dict "user" .user "date" .entry.Date "absolute" (entryDate .user .entry) }}
         </li>
         {{ if .user.ShowReadingTime }}
         <li>
             <span>
+            {{ if .entry.ReadingTime }}
+            {{ plural "entry.video_duration" .entry.ReadingTime .entry.ReadingTime }}
+            {{ else }}
             {{ plural "entry.estimated_reading_time" (timeToRead .entry.Content) (timeToRead .entry.Content) }}
+            {{ end }}
             </span>
         </li>
         {{ end }}
+        {{ if .entry.Feed.FetchScores }}
+        <li>
+            <span>{{ plural "entry.score" .entry.Score .entry.Score }}</span>
+        </li>
+        <li>
+            <span>{{ plural "entry.comments_count" .entry.CommentsCount .entry.CommentsCount }}</span>
+        </li>
+        {{ end }}
     </ul>
     <ul class="item-meta-icons">
         <li>
@@ -257,6 +320,8 @@ SOFTWARE.
                 data-toggle-status="true"
                 data-label-read="✔&nbsp;{{ t "entry.status.read" }}"
                 data-label-unread="✘&nbsp;{{ t "entry.status.unread" }}"
+                data-toast-unread="{{ t "entry.status.toast.unread" }}"
+                data-toast-read="{{ t "entry.status.toast.read" }}"
                 data-value="{{ if eq .entry.Status "read" }}read{{ else }}unread{{ end }}"
                 ><span class="icon-label">{{ if eq .entry.Status "read" }}✘&nbsp;{{ t "entry.status.unread" }}{{ else }}✔&nbsp;{{ t "entry.status.read" }}{{ end }}</span></a>
         </li>
@@ -278,6 +343,11 @@ SOFTWARE.
             </li>
         {{ end }}
         {{ if .hasSaveEntry }}
+            <li>
+                <label class="item-select">
+                    <input type="checkbox" data-select-entry="true" value="{{ .entry.ID }}"> {{ t "entry.select.label" }}
+                </label>
+            </li>
             <li>
                 <a href="#"
                     title="{{ t "entry.save.title" }}"
@@ -285,6 +355,7 @@ SOFTWARE.
                     data-save-url="{{ route "saveEntry" "entryID" .entry.ID }}"
                     data-label-loading="{{ t "entry.state.saving" }}"
                     data-label-done="{{ t "entry.save.completed" }}"
+                    data-label-prompt-tags="{{ t "entry.save.prompt_tags" }}"
                     >{{ template "icon_save" }}<span class="icon-label">{{ t "entry.save.label" }}</span></a>
             </li>
         {{ end }}
@@ -311,7 +382,7 @@ SOFTWARE.
 `,
 	"layout": `{{ define "base" }}
 <!DOCTYPE html>
-<html>
+<html lang="{{ htmlLanguage }}" dir="{{ direction }}">
 <head>
     <meta charset="utf-8">
     <title>{{template "title" .}} - Miniflux</title>
@@ -323,7 +394,6 @@ SOFTWARE.
     <link rel="manifest" href="{{ route "webManifest" }}" crossorigin="use-credentials"/>
 
     <meta name="robots" content="noindex,nofollow">
-    <meta name="referrer" content="no-referrer">
     <meta name="google" content="notranslate">
 
     <!-- Favicons -->
@@ -345,21 +415,26 @@ SOFTWARE.
     {{ end }}
 
     <meta name="theme-color" content="{{ theme_color .theme }}">
-    <link rel="stylesheet" type="text/css" href="{{ route "stylesheet" "name" .theme }}?{{ .theme_checksum }}">
+    <link rel="stylesheet" type="text/css" href="{{ route "stylesheet" "name" .theme }}?{{ .theme_checksum }}" nonce="{{ .nonce }}">
     {{ if .user }} {{ if ne (index .user.Extra "custom_css") ("") }}
-    <link rel="stylesheet" type="text/css" href="{{ route "stylesheet" "name" "custom_css" }}">
+    <link rel="stylesheet" type="text/css" href="{{ route "stylesheet" "name" "custom_css" }}" nonce="{{ .nonce }}">
     {{ end }}{{ end }}
 
-    <script type="text/javascript" src="{{ route "javascript" "name" "app" }}?{{ .app_js_checksum }}" defer></script>
-    <script type="text/javascript" src="{{ route "javascript" "name" "service-worker" }}?{{ .sw_js_checksum }}" defer id="service-worker-script"></script>
+    <script type="text/javascript" src="{{ route "javascript" "name" "app" }}?{{ .app_js_checksum }}" nonce="{{ .nonce }}" defer></script>
+    <script type="text/javascript" src="{{ route "javascript" "name" "service-worker" }}?{{ .sw_js_checksum }}" nonce="{{ .nonce }}" defer id="service-worker-script"></script>
 </head>
 <body
     data-entries-status-url="{{ route "updateEntriesStatus" }}"
     data-refresh-all-feeds-url="{{ route "refreshAllFeeds" }}"
-    {{ if .user }}{{ if not .user.KeyboardShortcuts }}data-disable-keyboard-shortcuts="true"{{ end }}{{ end }}>
-    <div class="toast-wrap">
+    {{ if .user }}{{ if not .user.KeyboardShortcuts }}data-disable-keyboard-shortcuts="true"{{ end }}{{ end }}
+    {{ if .user }}{{ if .user.MarkReadOnOriginalLink }}data-mark-read-on-original-link="true"{{ end }}{{ end }}
+    {{ if .user }}data-live-updates-url="{{ route "liveUpdates" }}"{{ end }}
+    {{ if .promptSaveEntryTags }}data-prompt-save-entry-tags="true"{{ end }}>
+    <a href="#main" class="skip-to-content-link">{{ t "menu.skip_to_content" }}</a>
+    <div class="toast-wrap" aria-hidden="true">
         <span class="toast-msg"></span>
     </div>
+    <div id="status-announcer" class="sr-only" role="status" aria-live="polite" aria-atomic="true"></div>
     {{ if .user }}
     <header class="header">
         <nav>
@@ -368,30 +443,32 @@ SOFTWARE.
             </div>
             <ul>
                 <li {{ if eq .menu "unread" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g u" }}">
-                    <a href="{{ route "unread" }}" data-page="unread">{{ t "menu.unread" }}
-                      {{ if gt .countUnread 0 }}
-                          <span class="unread-counter-wrapper">(<span class="unread-counter">{{ .countUnread }}</span>)</span>
-                      {{ end }}
+                    <a href="{{ route "unread" }}" data-page="unread" {{ if eq .menu "unread" }}aria-current="page"{{ end }}>{{ t "menu.unread" }}
+                      <span class="unread-counter-wrapper" {{ if eq .countUnread 0 }}hidden{{ end }}>(<span class="unread-counter">{{ .countUnread }}</span>)</span>
                     </a>
                 </li>
                 <li {{ if eq .menu "starred" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g b" }}">
-                    <a href="{{ route "starred" }}" data-page="starred">{{ t "menu.starred" }}</a>
+                    <a href="{{ route "starred" }}" data-page="starred" {{ if eq .menu "starred" }}aria-current="page"{{ end }}>{{ t "menu.starred" }}</a>
+                </li>
+                <li {{ if eq .menu "trending" }}class="active"{{ end }}>
+                    <a href="{{ route "trending" }}" data-page="trending" {{ if eq .menu "trending" }}aria-current="page"{{ end }}>{{ t "menu.trending" }}</a>
+                </li>
+                <li {{ if eq .menu "podcasts" }}class="active"{{ end }}>
+                    <a href="{{ route "podcasts" }}" data-page="podcasts" {{ if eq .menu "podcasts" }}aria-current="page"{{ end }}>{{ t "menu.podcasts" }}</a>
                 </li>
                 <li {{ if eq .menu "history" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g h" }}">
-                    <a href="{{ route "history" }}" data-page="history">{{ t "menu.history" }}</a>
+                    <a href="{{ route "history" }}" data-page="history" {{ if eq .menu "history" }}aria-current="page"{{ end }}>{{ t "menu.history" }}</a>
                 </li>
                 <li {{ if eq .menu "feeds" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g f" }}">
-                    <a href="{{ route "feeds" }}" data-page="feeds">{{ t "menu.feeds" }}
-                      {{ if gt .countErrorFeeds 0 }}
-                          <span class="error-feeds-counter-wrapper">(<span class="error-feeds-counter">{{ .countErrorFeeds }}</span>)</span>
-                      {{ end }}
+                    <a href="{{ route "feeds" }}" data-page="feeds" {{ if eq .menu "feeds" }}aria-current="page"{{ end }}>{{ t "menu.feeds" }}
+                      <span class="error-feeds-counter-wrapper" {{ if eq .countErrorFeeds 0 }}hidden{{ end }}>(<span class="error-feeds-counter">{{ .countErrorFeeds }}</span>)</span>
                     </a>
                 </li>
                 <li {{ if eq .menu "categories" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g c" }}">
-                    <a href="{{ route "categories" }}" data-page="categories">{{ t "menu.categories" }}</a>
+                    <a href="{{ route "categories" }}" data-page="categories" {{ if eq .menu "categories" }}aria-current="page"{{ end }}>{{ t "menu.categories" }}</a>
                 </li>
                 <li {{ if eq .menu "settings" }}class="active"{{ end }} title="{{ t "tooltip.keyboard_shortcuts" "g s" }}">
-                    <a href="{{ route "settings" }}" data-page="settings">{{ t "menu.settings" }}</a>
+                    <a href="{{ route "settings" }}" data-page="settings" {{ if eq .menu "settings" }}aria-current="page"{{ end }}>{{ t "menu.settings" }}</a>
                 </li>
                 <li>
                     <a href="{{ route "logout" }}" title="{{ t "tooltip.logged_user" .user.Username }}">{{ t "menu.logout" }}</a>
@@ -402,7 +479,8 @@ SOFTWARE.
                     <a href="#" data-action="search">&laquo;&nbsp;{{ t "search.label" }}</a>
                 </div>
                 <form action="{{ route "searchEntries" }}" class="search-form {{ if $.searchQuery }}has-search-query{{ end }}">
-                    <input type="search" name="q" id="search-input" placeholder="{{ t "search.placeholder" }}" {{ if $.searchQuery }}value="{{ .searchQuery }}"{{ end }} required>
+                    <input type="search" name="q" id="search-input" placeholder="{{ t "search.placeholder" }}" {{ if $.searchQuery }}value="{{ .searchQuery }}"{{ end }} list="search-suggestions" autocomplete="off" data-suggestions-url="{{ route "searchSuggestions" }}" required>
+                    <datalist id="search-suggestions"></datalist>
                 </form>
             </div>
         </nav>
@@ -414,13 +492,25 @@ SOFTWARE.
     {{ if .flashErrorMessage }}
         <div class="flash-error-message alert alert-error">{{ .flashErrorMessage }}</div>
     {{ end }}
-    <main>
+    <main id="main" tabindex="-1">
         {{template "content" .}}
     </main>
+    {{ if .hasSaveEntry }}
+    <div id="bulk-actions" class="bulk-actions" hidden>
+        <span class="bulk-actions-count" data-label-count="{{ t "entry.bulk.selected" }}"></span>
+        <a href="#"
+            data-action="saveSelectedEntries"
+            data-save-url="{{ route "saveEntries" }}"
+            data-label-loading="{{ t "entry.state.saving" }}"
+            data-label-prompt-tags="{{ t "entry.save.prompt_tags" }}"
+            data-toast-done="{{ t "entry.bulk.save.completed" }}">{{ t "entry.bulk.save" }}</a>
+        <a href="#" data-action="clearSelectedEntries">{{ t "entry.bulk.clear" }}</a>
+    </div>
+    {{ end }}
     <template id="keyboard-shortcuts">
-        <div id="modal-left">
-            <a href="#" class="btn-close-modal">x</a>
-            <h3>{{ t "page.keyboard_shortcuts.title" }}</h3>
+        <div id="modal-left" aria-labelledby="keyboard-shortcuts-title">
+            <a href="#" class="btn-close-modal" aria-label="{{ t "action.close" }}">x</a>
+            <h3 id="keyboard-shortcuts-title">{{ t "page.keyboard_shortcuts.title" }}</h3>
 
             <div class="keyboard-shortcuts">
                 <p>{{ t "page.keyboard_shortcuts.subtitle.sections" }}</p>
@@ -452,6 +542,7 @@ SOFTWARE.
                     <li>{{ t "page.keyboard_shortcuts.open_item" }} = <strong>o</strong></li>
                     <li>{{ t "page.keyboard_shortcuts.open_original" }} = <strong>v</strong></li>
                     <li>{{ t "page.keyboard_shortcuts.open_original_same_window" }} = <strong>V</strong></li>
+                    <li>{{ t "page.keyboard_shortcuts.open_original_background" }} = <strong>b</strong></li>
                     <li>{{ t "page.keyboard_shortcuts.open_comments" }} = <strong>c</strong></li>
                     <li>{{ t "page.keyboard_shortcuts.open_comments_same_window" }} = <strong>C</strong></li>
                     <li>{{ t "page.keyboard_shortcuts.toggle_read_status" }} = <strong>m</strong></li>
@@ -475,7 +566,7 @@ SOFTWARE.
 <div class="pagination">
     <div class="pagination-prev">
         {{ if .ShowPrev }}
-            <a href="{{ .Route }}{{ if gt .PrevOffset 0 }}?offset={{ .PrevOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}{{ else }}{{ if .SearchQuery }}?q={{ .SearchQuery }}{{ end }}{{ end }}" data-page="previous" rel="prev">{{ t "pagination.previous" }}</a>
+            <a href="{{ .Route }}{{ if gt .PrevOffset 0 }}?offset={{ .PrevOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ if .SearchFeedID }}&amp;feed_id={{ .SearchFeedID }}{{ end }}{{ if .SearchCategoryID }}&amp;category_id={{ .SearchCategoryID }}{{ end }}{{ end }}{{ else }}{{ if .SearchQuery }}?q={{ .SearchQuery }}{{ if .SearchFeedID }}&amp;feed_id={{ .SearchFeedID }}{{ end }}{{ if .SearchCategoryID }}&amp;category_id={{ .SearchCategoryID }}{{ end }}{{ end }}{{ end }}" data-page="previous" rel="prev">{{ t "pagination.previous" }}</a>
         {{ else }}
             {{ t "pagination.previous" }}
         {{ end }}
@@ -483,7 +574,7 @@ SOFTWARE.
 
     <div class="pagination-next">
         {{ if .ShowNext }}
-            <a href="{{ .Route }}?offset={{ .NextOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}" data-page="next" rel="next">{{ t "pagination.next" }}</a>
+            <a href="{{ .Route }}?offset={{ .NextOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ if .SearchFeedID }}&amp;feed_id={{ .SearchFeedID }}{{ end }}{{ if .SearchCategoryID }}&amp;category_id={{ .SearchCategoryID }}{{ end }}{{ end }}" data-page="next" rel="next">{{ t "pagination.next" }}</a>
         {{ else }}
             {{ t "pagination.next" }}
         {{ end }}
@@ -502,6 +593,9 @@ SOFTWARE.
     <li>
         <a href="{{ route "apiKeys" }}">{{ t "menu.api_keys" }}</a>
     </li>
+    <li>
+        <a href="{{ route "mutedKeywords" }}">{{ t "menu.muted_keywords" }}</a>
+    </li>
     <li>
         <a href="{{ route "sessions" }}">{{ t "menu.sessions" }}</a>
     </li>
@@ -509,21 +603,37 @@ SOFTWARE.
         <li>
             <a href="{{ route "users" }}">{{ t "menu.users" }}</a>
         </li>
+        <li>
+            <a href="{{ route "reports" }}">{{ t "menu.reports" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "blockedFeeds" }}">{{ t "menu.blocked_feeds" }}</a>
+        </li>
     {{ end }}
     <li>
         <a href="{{ route "about" }}">{{ t "menu.about" }}</a>
     </li>
 </ul>
 {{ end }}`,
+	"timestamp": `{{ define "timestamp" }}
+{{- $timezone := "UTC" }}{{ if .user }}{{ $timezone = .user.Timezone }}{{ end -}}
+{{- if and .user (eq .user.TimestampFormat "absolute") -}}
+<time datetime="{{ isodate .date }}" title="{{ elapsed $timezone .date }}" data-alternate-format="true">{{ .absolute }}</time>
+{{- else -}}
+<time datetime="{{ isodate .date }}" title="{{ .absolute }}" data-alternate-format="true">{{ elapsed $timezone .date }}</time>
+{{- end -}}
+{{ end }}
+`,
 }
 
 var templateCommonMapChecksums = map[string]string{
-	"entry_pagination": "cdca9cf12586e41e5355190b06d9168f57f77b85924d1e63b13524bc15abcbf6",
-	"feed_list":        "931e43d328a116318c510de5658c688cd940b934c86b6ec82a472e1f81e020ae",
-	"feed_menu":        "318d8662dda5ca9dfc75b909c8461e79c86fb5082df1428f67aaf856f19f4b50",
-	"icons":            "3dbe754a98f524a227111191d76b8c6944711b13613cc548ee9e9808fe0bffb4",
-	"item_meta":        "8306adf3ef9966de3e3dc74ca1042e51d778b027ab8cf0a60a2e94a0115982dc",
-	"layout":           "e44dd40d22aec224b9f3d29391e21d4c066f8ecebbd3678a9b4832d014fffe65",
-	"pagination":       "7b61288e86283c4cf0dc83bcbf8bf1c00c7cb29e60201c8c0b633b2450d2911f",
-	"settings_menu":    "e2b777630c0efdbc529800303c01d6744ed3af80ec505ac5a5b3f99c9b989156",
+	"entry_pagination": "447ce28b7fbd4b3895c28d0c60a61d25a16f3cf6dcc6876ea1edc3aadb174278",
+	"feed_list":        "819a69825669ada4a93e7b4fd295594b16489fb2b8126495fbe4ea20181ffe96",
+	"feed_menu":        "fe78dfdc929e8766d7b2e389f56ecfbf0f98d948cda0b0ccb05189c9702f5386",
+	"icons":            "2894d604dae2fc411ba572a97a2123e6d9c5690989d9a7129ae2c73e7bcff987",
+	"item_meta":        "8d78b8dd4a6a996f670f88446c62683c1f0e59118c625a06d2712991ed1d9d9a",
+	"layout":           "ea5de2c68bfad723760ccb2262bb6d0d3b587daa3176058505bdcebb11891cdb",
+	"pagination":       "81bf746bd872a52dcf6885b4223e0ff74d0766c060e04d2428cb262fb0805095",
+	"settings_menu":    "36887c73aa56a02b55bf5ddf0424f88ba69c3b1c1ba62087c65ec088996d2665",
+	"timestamp":        "96f8f8ded13063ce4d400d779cb2665ac08b56b9b602a04a1f33c0a684a1960a",
 }
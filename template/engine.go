@@ -68,6 +68,12 @@ func (e *Engine) Render(name, language string, data interface{}) []byte {
 		"timeToRead": func(content string) int {
 			return timeToRead(content)
 		},
+		"htmlLanguage": func() string {
+			return locale.HTMLLanguage(language)
+		},
+		"direction": func() string {
+			return locale.Direction(language)
+		},
 	})
 
 	var b bytes.Buffer
@@ -10,6 +10,7 @@ import (
 	"html/template"
 	"math"
 	"net/mail"
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -35,6 +36,8 @@ type funcMap struct {
 func (f *funcMap) Map() template.FuncMap {
 	return template.FuncMap{
 		"formatFileSize": formatFileSize,
+		"formatDuration": formatDuration,
+		"mapURL":         mapURL,
 		"dict":           dict,
 		"hasKey":         hasKey,
 		"truncate":       truncate,
@@ -48,6 +51,12 @@ func (f *funcMap) Map() template.FuncMap {
 		"hasOAuth2Provider": func(provider string) bool {
 			return config.Opts.OAuth2Provider() == provider
 		},
+		"hasFeedRecommendations": func() bool {
+			return config.Opts.HasFeedRecommendations()
+		},
+		"hasEmailSharing": func() bool {
+			return config.Opts.HasEmailSharing()
+		},
 		"route": func(name string, args ...interface{}) string {
 			return route.Path(f.router, name, args...)
 		},
@@ -81,9 +90,12 @@ func (f *funcMap) Map() template.FuncMap {
 		"isodate": func(ts time.Time) string {
 			return ts.Format("2006-01-02 15:04:05")
 		},
+		"entryDate": entryDate,
 		"theme_color": func(theme string) string {
 			return model.ThemeColor(theme)
 		},
+		"contentLanguage": contentLanguage,
+		"textDirection":   textDirection,
 
 		// These functions are overrided at runtime after the parsing.
 		"elapsed": func(timezone string, t time.Time) string {
@@ -98,6 +110,12 @@ func (f *funcMap) Map() template.FuncMap {
 		"timeToRead": func(content string) int {
 			return 0
 		},
+		"htmlLanguage": func() string {
+			return ""
+		},
+		"direction": func() string {
+			return ""
+		},
 	}
 }
 
@@ -142,6 +160,30 @@ func isEmail(str string) bool {
 	return true
 }
 
+// entryDate returns the publication date of the entry in the timezone chosen by the user,
+// the dates without known offset are always displayed in the timezone of the user.
+func entryDate(user *model.User, entry *model.Entry) string {
+	tz, setting := "UTC", model.EntryTimezoneLocal
+	if user != nil {
+		tz, setting = user.Timezone, user.EntryTimezone
+	}
+
+	local := timezone.Convert(tz, entry.Date).Format("2006-01-02 15:04:05")
+	original, found := entry.OriginalDate()
+	if !found {
+		return local
+	}
+
+	switch setting {
+	case model.EntryTimezoneOriginal:
+		return original.Format("2006-01-02 15:04:05 -07:00")
+	case model.EntryTimezoneBoth:
+		return local + " (" + original.Format("2006-01-02 15:04:05 -07:00") + ")"
+	default:
+		return local
+	}
+}
+
 func elapsedTime(printer *locale.Printer, tz string, t time.Time) string {
 	if t.IsZero() {
 		return printer.Printf("time_elapsed.not_yet")
@@ -225,6 +267,45 @@ func formatFileSize(b int64) string {
 		float64(b)/float64(div), "KMGTPE"[exp])
 }
 
+// formatDuration returns a duration in seconds as "H:MM:SS" or "M:SS".
+func formatDuration(seconds int) string {
+	if seconds < 3600 {
+		return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+}
+
+// mapURL returns a link to OpenStreetMap centered on the given coordinates.
+func mapURL(latitude, longitude *float64) string {
+	if latitude == nil || longitude == nil {
+		return ""
+	}
+
+	lat := strconv.FormatFloat(*latitude, 'f', -1, 64)
+	lon := strconv.FormatFloat(*longitude, 'f', -1, 64)
+	return fmt.Sprintf("https://www.openstreetmap.org/?mlat=%s&mlon=%s#map=14/%s/%s", lat, lon, lat, lon)
+}
+
+// contentLanguage returns the detected language code of the given HTML content, or an empty string when unsure.
+func contentLanguage(content string) string {
+	languageInfo := getlang.FromString(sanitizer.StripTags(content))
+	if languageInfo.Confidence() < 0.5 {
+		return ""
+	}
+
+	return languageInfo.LanguageCode()
+}
+
+// textDirection returns the text direction to use for the given language code.
+func textDirection(languageCode string) string {
+	switch languageCode {
+	case "ar", "ckb", "dv", "fa", "he", "ps", "sd", "ug", "ur", "yi":
+		return "rtl"
+	default:
+		return "auto"
+	}
+}
+
 func timeToRead(content string) int {
 	sanitizedContent := sanitizer.StripTags(content)
 	languageInfo := getlang.FromString(sanitizedContent)
@@ -12,6 +12,7 @@ import (
 
 	"miniflux.app/config"
 	"miniflux.app/locale"
+	"miniflux.app/model"
 
 	"github.com/gorilla/mux"
 )
@@ -101,6 +102,34 @@ func TestIsEmail(t *testing.T) {
 	}
 }
 
+func TestEntryDate(t *testing.T) {
+	offset := -5 * 3600
+	entry := &model.Entry{Date: time.Date(2020, 3, 1, 15, 0, 0, 0, time.UTC), DateOffset: &offset}
+	user := &model.User{Timezone: "Europe/Paris"}
+
+	scenarios := map[string]string{
+		model.EntryTimezoneLocal:    "2020-03-01 16:00:00",
+		model.EntryTimezoneOriginal: "2020-03-01 10:00:00 -05:00",
+		model.EntryTimezoneBoth:     "2020-03-01 16:00:00 (2020-03-01 10:00:00 -05:00)",
+	}
+
+	for setting, expected := range scenarios {
+		user.EntryTimezone = setting
+		if result := entryDate(user, entry); result != expected {
+			t.Errorf(`Unexpected date for %q, got %q instead of %q`, setting, result, expected)
+		}
+	}
+
+	entry.DateOffset = nil
+	if result := entryDate(user, entry); result != "2020-03-01 16:00:00" {
+		t.Errorf(`Dates without offset should be in the timezone of the user, got %q`, result)
+	}
+
+	if result := entryDate(nil, entry); result != "2020-03-01 15:00:00" {
+		t.Errorf(`Dates should be in UTC without user, got %q`, result)
+	}
+}
+
 func TestElapsedTime(t *testing.T) {
 	printer := locale.NewPrinter("en_US")
 	var dt = []struct {
@@ -333,3 +362,60 @@ func TestFormatFileSize(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatDuration(t *testing.T) {
+	scenarios := map[int]string{
+		0:    "0:00",
+		59:   "0:59",
+		754:  "12:34",
+		3723: "1:02:03",
+	}
+
+	for input, expected := range scenarios {
+		if result := formatDuration(input); result != expected {
+			t.Errorf(`Unexpected result, got %q instead of %q for %d`, result, expected, input)
+		}
+	}
+}
+
+func TestMapURL(t *testing.T) {
+	if result := mapURL(nil, nil); result != "" {
+		t.Errorf(`Unexpected result without coordinates: %q`, result)
+	}
+
+	latitude, longitude := 45.256, -71.92
+	expected := "https://www.openstreetmap.org/?mlat=45.256&mlon=-71.92#map=14/45.256/-71.92"
+	if result := mapURL(&latitude, &longitude); result != expected {
+		t.Errorf(`Unexpected result, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestContentLanguage(t *testing.T) {
+	scenarios := map[string]string{
+		`<p>هذا نص باللغة العربية لاختبار اتجاه الكتابة في المقالات.</p>`:     "ar",
+		`<p>זהו טקסט בעברית כדי לבדוק את כיוון הכתיבה של המאמרים.</p>`:        "he",
+		`<p>This is an English sentence used to test the text direction.</p>`: "en",
+	}
+
+	for input, expected := range scenarios {
+		if actual := contentLanguage(input); actual != expected {
+			t.Errorf(`Unexpected language for %q, got %q instead of %q`, input, actual, expected)
+		}
+	}
+}
+
+func TestTextDirection(t *testing.T) {
+	scenarios := map[string]string{
+		"ar": "rtl",
+		"fa": "rtl",
+		"he": "rtl",
+		"en": "auto",
+		"":   "auto",
+	}
+
+	for input, expected := range scenarios {
+		if actual := textDirection(input); actual != expected {
+			t.Errorf(`Unexpected direction for %q, got %q instead of %q`, input, actual, expected)
+		}
+	}
+}
@@ -19,6 +19,16 @@ var templateViewsMap = map[string]string{
     </ul>
 </div>
 
+<div class="panel">
+    <h3>{{ t "page.about.features" }}</h3>
+    <ul>
+        <li><strong>{{ t "page.about.image_proxy" }}</strong> {{ if .features.ImageProxy }}{{ t "page.about.enabled" }}{{ else }}{{ t "page.about.disabled" }}{{ end }}</li>
+        <li><strong>{{ t "page.about.search_backend" }}</strong> {{ .features.SearchBackend }}</li>
+        <li><strong>{{ t "page.about.websub" }}</strong> {{ if .features.WebSub }}{{ t "page.about.enabled" }}{{ else }}{{ t "page.about.disabled" }}{{ end }}</li>
+        <li><strong>{{ t "page.about.integrations" }}</strong> {{ if .features.Integrations }}{{ range $i, $name := .features.Integrations }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}{{ else }}{{ t "page.about.disabled" }}{{ end }}</li>
+    </ul>
+</div>
+
 <div class="panel">
     <h3>{{ t "page.about.credits" }}</h3>
     <ul>
@@ -168,6 +178,64 @@ var templateViewsMap = map[string]string{
     <a href="{{ route "createAPIKey" }}" class="button button-primary">{{ t "menu.create_api_key" }}</a>
 </p>
 
+{{ end }}
+`,
+	"blocked_feeds": `{{ define "title"}}{{ t "page.blocked_feeds.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.blocked_feeds.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+<p class="form-help">{{ t "page.blocked_feeds.help" }}</p>
+
+{{ if .blockedFeeds }}
+<table>
+    <tr>
+        <th>{{ t "page.blocked_feeds.table.pattern" }}</th>
+        <th>{{ t "page.blocked_feeds.table.reason" }}</th>
+        <th>{{ t "page.blocked_feeds.table.created_at" }}</th>
+        <th>{{ t "page.blocked_feeds.table.actions" }}</th>
+    </tr>
+    {{ range .blockedFeeds }}
+    <tr>
+        <td><code>{{ .Pattern }}</code></td>
+        <td>{{ .Reason }}</td>
+        <td><time datetime="{{ isodate .CreatedAt }}" title="{{ isodate .CreatedAt }}">{{ elapsed $.user.Timezone .CreatedAt }}</time></td>
+        <td>
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "removeBlockedFeed" "blockedFeedID" .ID }}">{{ t "action.remove" }}</a>
+        </td>
+    </tr>
+    {{ end }}
+</table>
+<br>
+{{ end }}
+
+<form action="{{ route "saveBlockedFeed" }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label for="form-pattern">{{ t "form.blocked_feed.label.pattern" }}</label>
+    <input type="text" name="pattern" id="form-pattern" value="{{ .form.Pattern }}" placeholder="example.org" spellcheck="false" required>
+    <div class="form-help">{{ t "form.blocked_feed.help.pattern" }}</div>
+
+    <label for="form-reason">{{ t "form.blocked_feed.label.reason" }}</label>
+    <input type="text" name="reason" id="form-reason" value="{{ .form.Reason }}">
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.block_feed" }}</button>
+    </div>
+</form>
 {{ end }}
 `,
 	"bookmark_entries": `{{ define "title"}}{{ t "page.starred.title" }} ({{ .total }}){{ end }}
@@ -186,9 +254,13 @@ var templateViewsMap = map[string]string{
             <div class="item-header" dir="auto">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
-                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    {{ if .Feed.OpenExternalLink }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true" data-open-external-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "starredEntry" "entryID" .ID }}">{{ .Title }}</a>
                     {{ end }}
-                    <a href="{{ route "starredEntry" "entryID" .ID }}">{{ .Title }}</a>
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -210,9 +282,40 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "createCategory" }}">{{ t "menu.create_category" }}</a>
         </li>
+        {{ if .countHidden }}
+        <li>
+            <a href="{{ route "hiddenCategories" }}">{{ t "menu.hidden_categories" }} ({{ .countHidden }})</a>
+        </li>
+        {{ end }}
     </ul>
 </section>
 
+{{ range .invitations }}
+<div class="panel">
+    <p>{{ t "page.categories.invitation" .OwnerUsername .Title }}</p>
+    <ul class="item-meta-icons">
+        <li>
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "acceptCategoryInvitation" "categoryID" .CategoryID }}">{{ t "action.accept_invitation" }}</a>
+        </li>
+        <li>
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "declineCategoryInvitation" "categoryID" .CategoryID }}">{{ t "action.decline_invitation" }}</a>
+        </li>
+    </ul>
+</div>
+{{ end }}
+
 {{ if not .categories }}
     <p class="alert alert-error">{{ t "alert.no_category" }}</p>
 {{ else }}
@@ -241,6 +344,15 @@ var templateViewsMap = map[string]string{
                     <li>
                         <a href="{{ route "editCategory" "categoryID" .ID }}">{{ template "icon_edit" }}<span class="icon-label">{{ t "menu.edit_category" }}</span></a>
                     </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "hideCategory" "categoryID" .ID }}">{{ template "icon_archive" }}<span class="icon-label">{{ t "action.hide_category" }}</span></a>
+                    </li>
                     {{ if eq .FeedCount 0 }}
                     <li>
                         <a href="#"
@@ -251,6 +363,10 @@ var templateViewsMap = map[string]string{
                             data-label-loading="{{ t "confirm.loading" }}"
                             data-url="{{ route "removeCategory" "categoryID" .ID }}">{{ template "icon_delete" }}<span class="icon-label">{{ t "action.remove" }}</span></a>
                     </li>
+                    {{ else }}
+                    <li>
+                        <a href="{{ route "removeCategoryPage" "categoryID" .ID }}">{{ template "icon_delete" }}<span class="icon-label">{{ t "action.remove" }}</span></a>
+                    </li>
                     {{ end }}
                 </ul>
             </div>
@@ -291,6 +407,10 @@ var templateViewsMap = map[string]string{
             <a href="{{ route "categoryFeeds" "categoryID" .category.ID }}">{{ t "menu.feeds" }}</a>
         </li>
     </ul>
+    <form action="{{ route "searchEntries" }}" class="scoped-search-form">
+        <input type="hidden" name="category_id" value="{{ .category.ID }}">
+        <input type="search" name="q" placeholder="{{ t "search.placeholder_category" }}" list="search-suggestions" autocomplete="off" data-suggestions-url="{{ route "searchSuggestions" }}" aria-label="{{ t "search.placeholder_category" }}" required>
+    </form>
 </section>
 
 {{ if not .entries }}
@@ -302,9 +422,13 @@ var templateViewsMap = map[string]string{
             <div class="item-header" dir="auto">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
-                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    {{ if .Feed.OpenExternalLink }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true" data-open-external-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "categoryEntry" "categoryID" .Feed.Category.ID "entryID" .ID }}">{{ .Title }}</a>
                     {{ end }}
-                    <a href="{{ route "categoryEntry" "categoryID" .Feed.Category.ID "entryID" .ID }}">{{ .Title }}</a>
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -355,6 +479,10 @@ var templateViewsMap = map[string]string{
                 data-redirect-url="{{ route "categories" }}"
                 data-url="{{ route "removeCategory" "categoryID" .category.ID }}">{{ t "action.remove" }}</a>
         </li>
+        {{ else }}
+        <li>
+            <a href="{{ route "removeCategoryPage" "categoryID" .category.ID }}">{{ t "action.remove" }}</a>
+        </li>
         {{ end }}
     </ul>
 </section>
@@ -362,7 +490,7 @@ var templateViewsMap = map[string]string{
 {{ if not .feeds }}
     <p class="alert">{{ t "alert.no_feed_in_category" }}</p>
 {{ else }}
-    {{ template "feed_list" dict "user" .user "feeds" .feeds "ParsingErrorCount" .ParsingErrorCount }}
+    {{ template "feed_list" dict "user" .user "feeds" .feeds "categories" .categories "ParsingErrorCount" .ParsingErrorCount }}
 {{ end }}
 
 {{ end }}
@@ -456,6 +584,39 @@ var templateViewsMap = map[string]string{
     </div>
 </form>
 {{ end }}
+`,
+	"create_muted_keyword": `{{ define "title"}}{{ t "page.new_muted_keyword.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.new_muted_keyword.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+<form action="{{ route "saveMutedKeyword" }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label for="form-pattern">{{ t "form.muted_keyword.label.pattern" }}</label>
+    <input type="text" name="pattern" id="form-pattern" value="{{ .form.Pattern }}" spellcheck="false" required autofocus>
+
+    <label for="form-action">{{ t "form.muted_keyword.label.action" }}</label>
+    <select id="form-action" name="action">
+        <option value="drop" {{ if eq "drop" .form.Action }}selected="selected"{{ end }}>{{ t "form.muted_keyword.select.drop" }}</option>
+        <option value="read" {{ if eq "read" .form.Action }}selected="selected"{{ end }}>{{ t "form.muted_keyword.select.read" }}</option>
+    </select>
+
+    <label for="form-expires-at">{{ t "form.muted_keyword.label.expires_at" }}</label>
+    <input type="date" name="expires_at" id="form-expires-at" value="{{ .form.ExpiresAt }}" placeholder="YYYY-MM-DD">
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "mutedKeywords" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
 `,
 	"create_user": `{{ define "title"}}{{ t "page.new_user.title" }}{{ end }}
 
@@ -487,6 +648,57 @@ var templateViewsMap = map[string]string{
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "users" }}">{{ t "action.cancel" }}</a>
     </div>
 </form>
+{{ end }}
+`,
+	"digest": `{{ define "title"}}{{ t "page.digest.title" }} ({{ .digest.Total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.digest.title" }} ({{ .digest.Total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ .continueURL }}">{{ t "page.digest.continue" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .digest.Total }}
+    <p class="alert alert-info">{{ t "alert.no_digest_entry" }}</p>
+{{ else }}
+    <p class="digest-since">{{ t "page.digest.since" }} <time datetime="{{ isodate .digest.Since }}" title="{{ isodate .digest.Since }}">{{ elapsed .user.Timezone .digest.Since }}</time></p>
+
+    <ul class="digest-categories">
+        {{ range .digest.Categories }}
+        <li>
+            <a href="{{ route "categoryEntries" "categoryID" .ID }}">{{ .Title }}</a>
+            <span class="digest-count">{{ plural "page.digest.entry_count" .Count .Count }}</span>
+        </li>
+        {{ end }}
+    </ul>
+
+    <h2>{{ t "page.digest.top_entries" }}</h2>
+    <div class="items">
+        {{ range .entries }}
+        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+            <div class="item-header" dir="auto">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    {{ if .Feed.OpenExternalLink }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true" data-open-external-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "feedEntry" "feedID" .Feed.ID "entryID" .ID }}">{{ .Title }}</a>
+                    {{ end }}
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
 {{ end }}
 `,
 	"edit_category": `{{ define "title"}}{{ t "page.edit_category.title" .category.Title }}{{ end }}
@@ -521,13 +733,71 @@ var templateViewsMap = map[string]string{
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
     </div>
 </form>
+
+{{ if .sharedBy }}
+<div class="panel">
+    <p>{{ t "page.edit_category.shared_by" .sharedBy }}</p>
+    <a href="#"
+        data-confirm="true"
+        data-label-question="{{ t "confirm.question" }}"
+        data-label-yes="{{ t "confirm.yes" }}"
+        data-label-no="{{ t "confirm.no" }}"
+        data-label-loading="{{ t "confirm.loading" }}"
+        data-url="{{ route "leaveSharedCategory" "categoryID" .category.ID }}">{{ t "action.leave_shared_category" }}</a>
+</div>
+{{ else }}
+<h3>{{ t "page.edit_category.members" }}</h3>
+<p class="form-help">{{ t "page.edit_category.members_help" }}</p>
+
+{{ if .members }}
+<table>
+    <tr>
+        <th class="column-40">{{ t "page.users.username" }}</th>
+        <th>{{ t "page.edit_category.member_since" }}</th>
+        <th>{{ t "page.users.actions" }}</th>
+    </tr>
+    {{ range .members }}
+    <tr>
+        <td>{{ .Username }}</td>
+        <td>
+            {{ if .IsPending }}
+                {{ t "page.edit_category.invitation_pending" }}
+            {{ else }}
+                <time datetime="{{ isodate .AcceptedAt }}" title="{{ isodate .AcceptedAt }}">{{ elapsed $.user.Timezone .AcceptedAt }}</time>
+            {{ end }}
+        </td>
+        <td>
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "removeCategoryMember" "categoryID" $.category.ID "userID" .UserID }}">{{ t "action.remove" }}</a>
+        </td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+
+<form action="{{ route "addCategoryMember" "categoryID" .category.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    <label for="form-member-username">{{ t "form.category.label.member_username" }}</label>
+    <input type="text" name="username" id="form-member-username" autocapitalize="off" spellcheck="false" required>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.share_category" }}</button>
+    </div>
+</form>
+{{ end }}
 {{ end }}
 `,
-	"edit_feed": `{{ define "title"}}{{ t "page.edit_feed.title" .feed.Title }}{{ end }}
+	"edit_feed": `{{ define "title"}}{{ t "page.edit_feed.title" .feed.DisplayTitle }}{{ end }}
 
 {{ define "content"}}
 <section class="page-header">
-    <h1 dir="auto">{{ .feed.Title }}</h1>
+    <h1 dir="auto">{{ .feed.DisplayTitle }}</h1>
     <ul>
         <li>
             <a href="{{ route "feeds" }}">{{ t "menu.feeds" }}</a>
@@ -544,13 +814,28 @@ var templateViewsMap = map[string]string{
 {{ if not .categories }}
     <p class="alert alert-error">{{ t "page.add_feed.no_category" }}</p>
 {{ else }}
-    {{ if ne .feed.ParsingErrorCount 0 }}
+    {{ if .feed.Dead }}
+    <div class="alert alert-error">
+        <h3>{{ t "alert.feed_dead" }}</h3>
+        <p>{{ t .feed.ParsingErrorMsg }}</p>
+    </div>
+    {{ else if ne .feed.ParsingErrorCount 0 }}
     <div class="alert alert-error">
         <h3>{{ t "page.edit_feed.last_parsing_error" }}</h3>
         <p>{{ t .feed.ParsingErrorMsg }}</p>
     </div>
     {{ end }}
 
+    {{ if .feed.CanonicalFeedURL }}
+    <div class="alert alert-info">
+        <p>{{ t "page.edit_feed.canonical_feed_url" .feed.CanonicalFeedURL }}</p>
+        <form action="{{ route "applyCanonicalFeedURL" "feedID" .feed.ID }}" method="post">
+            <input type="hidden" name="csrf" value="{{ .csrf }}">
+            <button type="submit" class="button button-primary">{{ t "action.use_canonical_feed_url" }}</button>
+        </form>
+    </div>
+    {{ end }}
+
     <form action="{{ route "updateFeed" "feedID" .feed.ID }}" method="post" autocomplete="off">
         <input type="hidden" name="csrf" value="{{ .csrf }}">
 
@@ -560,12 +845,18 @@ var templateViewsMap = map[string]string{
 
         <label for="form-title">{{ t "form.feed.label.title" }}</label>
         <input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
+        {{ if .feed.CustomTitle }}
+            <p class="form-help">{{ t "form.feed.help.original_title" .feed.Title }}</p>
+        {{ end }}
 
         <label for="form-site-url">{{ t "form.feed.label.site_url" }}</label>
         <input type="url" name="site_url" id="form-site-url" placeholder="https://domain.tld/" value="{{ .form.SiteURL }}" required>
 
         <label for="form-feed-url">{{ t "form.feed.label.feed_url" }}</label>
         <input type="url" name="feed_url" id="form-feed-url" placeholder="https://domain.tld/" value="{{ .form.FeedURL }}" required>
+        {{ if .feed.FeedURLUpdatedAt }}
+            <p class="form-help">{{ t "form.feed.help.previous_feed_url" .feed.PreviousFeedURL }} (<time datetime="{{ isodate .feed.FeedURLUpdatedAt }}">{{ isodate .feed.FeedURLUpdatedAt }}</time>)</p>
+        {{ end }}
 
         <label for="form-feed-username">{{ t "form.feed.label.feed_username" }}</label>
         <input type="text" name="feed_username" id="form-feed-username" value="{{ .form.Username }}">
@@ -580,6 +871,21 @@ var templateViewsMap = map[string]string{
         -->
         <input type="text" name="feed_password" id="form-feed-password" value="{{ .form.Password }}">
 
+        <label for="form-bearer-token">{{ t "form.feed.label.bearer_token" }}</label>
+        <input type="text" name="bearer_token" id="form-bearer-token" value="{{ .form.BearerToken }}" spellcheck="false">
+
+        <label for="form-oauth2-token-url">{{ t "form.feed.label.oauth2_token_url" }}</label>
+        <input type="url" name="oauth2_token_url" id="form-oauth2-token-url" placeholder="https://domain.tld/oauth/token" value="{{ .form.OAuth2TokenURL }}">
+
+        <label for="form-oauth2-client-id">{{ t "form.feed.label.oauth2_client_id" }}</label>
+        <input type="text" name="oauth2_client_id" id="form-oauth2-client-id" value="{{ .form.OAuth2ClientID }}" spellcheck="false">
+
+        <label for="form-oauth2-client-secret">{{ t "form.feed.label.oauth2_client_secret" }}</label>
+        <input type="text" name="oauth2_client_secret" id="form-oauth2-client-secret" value="{{ .form.OAuth2ClientSecret }}" spellcheck="false">
+
+        <label for="form-oauth2-scopes">{{ t "form.feed.label.oauth2_scopes" }}</label>
+        <input type="text" name="oauth2_scopes" id="form-oauth2-scopes" value="{{ .form.OAuth2Scopes }}" spellcheck="false">
+
 	    <label for="form-user-agent">{{ t "form.feed.label.user_agent" }}</label>
 	    <input type="text" name="user_agent" id="form-user-agent" placeholder="{{ .defaultUserAgent }}" value="{{ .form.UserAgent }}">
 
@@ -589,6 +895,9 @@ var templateViewsMap = map[string]string{
         <label for="form-rewrite-rules">{{ t "form.feed.label.rewrite_rules" }}</label>
         <input type="text" name="rewrite_rules" id="form-rewrite-rules" value="{{ .form.RewriteRules }}">
 
+        <label for="form-encoding">{{ t "form.feed.label.encoding" }}</label>
+        <input type="text" name="encoding" id="form-encoding" placeholder="windows-1252" value="{{ .form.Encoding }}" spellcheck="false">
+
         <label for="form-category">{{ t "form.feed.label.category" }}</label>
         <select id="form-category" name="category_id">
         {{ range .categories }}
@@ -602,6 +911,29 @@ var templateViewsMap = map[string]string{
         <label><input type="checkbox" name="fetch_via_proxy" value="1" {{ if .form.FetchViaProxy }}checked{{ end }}> {{ t "form.feed.label.fetch_via_proxy" }}</label>
         {{ end }}
         <label><input type="checkbox" name="disabled" value="1" {{ if .form.Disabled }}checked{{ end }}> {{ t "form.feed.label.disabled" }}</label>
+        <label><input type="checkbox" name="open_external_link" value="1" {{ if .form.OpenExternalLink }}checked{{ end }}> {{ t "form.feed.label.open_external_link" }}</label>
+        <label><input type="checkbox" name="fetch_scores" value="1" {{ if .form.FetchScores }}checked{{ end }}> {{ t "form.feed.label.fetch_scores" }}</label>
+        <label><input type="checkbox" name="auto_star" value="1" {{ if .form.AutoStar }}checked{{ end }}> {{ t "form.feed.label.auto_star" }}</label>
+        <label><input type="checkbox" name="archive_pages" value="1" {{ if .form.ArchivePages }}checked{{ end }}> {{ t "form.feed.label.archive_pages" }}</label>
+        <label><input type="checkbox" name="hide_globally" value="1" {{ if .form.HideGlobally }}checked{{ end }}> {{ t "form.feed.label.hide_globally" }}</label>
+        <label><input type="checkbox" name="notify_errors" value="1" {{ if .form.NotifyErrors }}checked{{ end }}> {{ t "form.feed.label.notify_errors" }}</label>
+
+        <label for="form-cron-expression">{{ t "form.feed.label.cron_expression" }}</label>
+        <input type="text" name="cron_expression" id="form-cron-expression" value="{{ .form.CronExpression }}" placeholder="30 7 * * mon-fri" spellcheck="false">
+        <p class="form-help">{{ t "form.feed.help.cron_expression" }}</p>
+
+        <label for="form-request-timeout">{{ t "form.feed.label.request_timeout" }}</label>
+        <input type="number" name="request_timeout" id="form-request-timeout" value="{{ .form.RequestTimeout }}" min="0" max="{{ .requestTimeoutMax }}">
+
+        <label for="form-max-body-size">{{ t "form.feed.label.max_body_size" }}</label>
+        <input type="number" name="max_body_size" id="form-max-body-size" value="{{ .form.MaxBodySize }}" min="0" max="{{ .maxBodySizeMax }}">
+        <p class="form-help">{{ t "form.feed.help.http_client_limits" }}</p>
+
+        <label for="form-minimum-score">{{ t "form.feed.label.minimum_score" }}</label>
+        <input type="number" name="minimum_score" id="form-minimum-score" value="{{ .form.MinimumScore }}" min="0">
+
+        <label for="form-blocked-authors">{{ t "form.feed.label.blocked_authors" }}</label>
+        <textarea name="blocked_authors" id="form-blocked-authors" cols="40" rows="5" spellcheck="false">{{ .form.BlockedAuthors }}</textarea>
 
         <div class="buttons">
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "feeds" }}">{{ t "action.cancel" }}</a>
@@ -613,6 +945,11 @@ var templateViewsMap = map[string]string{
             <li><strong>{{ t "page.edit_feed.last_check" }} </strong><time datetime="{{ isodate .feed.CheckedAt }}" title="{{ isodate .feed.CheckedAt }}">{{ elapsed $.user.Timezone .feed.CheckedAt }}</time></li>
             <li><strong>{{ t "page.edit_feed.etag_header" }} </strong>{{ if .feed.EtagHeader }}{{ .feed.EtagHeader }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
             <li><strong>{{ t "page.edit_feed.last_modified_header" }} </strong>{{ if .feed.LastModifiedHeader }}{{ .feed.LastModifiedHeader }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
+            {{ if .feed.LastFetchURL }}
+            <li><strong>{{ t "page.edit_feed.last_fetch_url" }} </strong><a href="{{ .feed.LastFetchURL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .feed.LastFetchURL }}</a></li>
+            <li><strong>{{ t "page.edit_feed.last_fetch_content_type" }} </strong>{{ if .feed.LastFetchContentType }}{{ .feed.LastFetchContentType }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
+            <li><strong>{{ t "page.edit_feed.last_fetch_size" }} </strong>{{ plural "page.edit_feed.bytes" .feed.LastFetchSize .feed.LastFetchSize }}</li>
+            {{ end }}
         </ul>
     </div>
 
@@ -666,7 +1003,9 @@ var templateViewsMap = map[string]string{
 	"entry": `{{ define "title"}}{{ .entry.Title }}{{ end }}
 
 {{ define "content"}}
-<section class="entry" data-id="{{ .entry.ID }}">
+{{ $lang := contentLanguage .entry.Content }}
+<section class="entry" data-id="{{ .entry.ID }}" dir="{{ textDirection $lang }}"{{ if $lang }} lang="{{ $lang }}"{{ end }}
+    {{ if .user }}data-reading-position="{{ .entry.ReadingPosition }}" data-reading-position-url="{{ route "updateReadingPosition" "entryID" .entry.ID }}"{{ end }}>
     <header class="entry-header">
         <h1 dir="auto">
             <a href="{{ .entry.URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .entry.Title }}</a>
@@ -706,6 +1045,7 @@ var templateViewsMap = map[string]string{
                             data-label-loading="{{ t "entry.state.saving" }}"
                             data-label-done="{{ t "entry.save.completed" }}"
                             data-toast-done="{{ t "entry.save.toast.completed" }}"
+                            data-label-prompt-tags="{{ t "entry.save.prompt_tags" }}"
                             >{{ template "icon_save" }}<span class="icon-label">{{ t "entry.save.label" }}</span></a>
                     </li>
                 {{ end }}
@@ -728,6 +1068,40 @@ var templateViewsMap = map[string]string{
                         data-label-loading="{{ t "entry.state.loading" }}"
                         >{{ template "icon_scraper" }}<span class="icon-label">{{ t "entry.scraper.label" }}</span></a>
                 </li>
+                <li>
+                    {{ if .entry.HasSnapshot }}
+                        <a href="{{ route "entrySnapshot" "entryID" .entry.ID }}"
+                            title="{{ t "entry.snapshot.title" }}"
+                            target="_blank">{{ template "icon_archive" }}<span class="icon-label">{{ t "entry.snapshot.label" }}</span></a>
+                    {{ else }}
+                        <a href="#"
+                            title="{{ t "entry.snapshot.create.title" }}"
+                            data-archive-page-entry="true"
+                            data-archive-page-url="{{ route "archiveEntryWebPage" "entryID" .entry.ID }}"
+                            data-label-loading="{{ t "entry.state.archiving" }}"
+                            data-label-done="{{ t "entry.snapshot.label" }}"
+                            data-toast-done="{{ t "entry.snapshot.create.toast" }}"
+                            >{{ template "icon_archive" }}<span class="icon-label">{{ t "entry.snapshot.create.label" }}</span></a>
+                    {{ end }}
+                </li>
+                <li>
+                    <a href="{{ route "entrySend" "entryID" .entry.ID }}"
+                        title="{{ t "entry.send.title" }}"
+                        >{{ template "icon_share" }}<span class="icon-label">{{ t "entry.send.label" }}</span></a>
+                </li>
+                {{ if hasEmailSharing }}
+                    <li>
+                        <a href="{{ route "entryEmail" "entryID" .entry.ID }}"
+                            title="{{ t "entry.email.title" }}"
+                            >{{ template "icon_email" }}<span class="icon-label">{{ t "entry.email.label" }}</span></a>
+                    </li>
+                {{ end }}
+                <li>
+                    <a href="#"
+                        title="{{ t "entry.print.title" }}"
+                        data-action="print"
+                        >{{ template "icon_print" }}<span class="icon-label">{{ t "entry.print.label" }}</span></a>
+                </li>
                 {{ if .entry.CommentsURL }}
                     <li>
                         <a href="{{ .entry.CommentsURL | safeURL }}"
@@ -745,12 +1119,12 @@ var templateViewsMap = map[string]string{
         <div class="entry-meta" dir="auto">
             <span class="entry-website">
                 {{ if and .user (ne .entry.Feed.Icon.IconID 0) }}
-                    <img src="{{ route "icon" "iconID" .entry.Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .entry.Feed.Title }}">
+                    <img src="{{ route "icon" "iconID" .entry.Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .entry.Feed.DisplayTitle }}">
                 {{ end }}
                 {{ if .user }}
-                    <a href="{{ route "feedEntries" "feedID" .entry.Feed.ID }}">{{ .entry.Feed.Title }}</a>
+                    <a href="{{ route "feedEntries" "feedID" .entry.Feed.ID }}">{{ .entry.Feed.DisplayTitle }}</a>
                 {{ else }}
-                    <a href="{{ .entry.Feed.SiteURL | safeURL }}">{{ .entry.Feed.Title }}</a>
+                    <a href="{{ .entry.Feed.SiteURL | safeURL }}">{{ .entry.Feed.DisplayTitle }}</a>
                 {{ end }}
             </span>
             {{ if .entry.Author }}
@@ -767,15 +1141,32 @@ var templateViewsMap = map[string]string{
                     <a href="{{ route "categoryEntries" "categoryID" .entry.Feed.Category.ID }}">{{ .entry.Feed.Category.Title }}</a>
                 </span>
             {{ end }}
+            {{ if .entry.HasLocation }}
+                <span class="entry-location">
+                    <a href="{{ mapURL .entry.Latitude .entry.Longitude | safeURL }}" title="{{ t "entry.location.title" }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ t "entry.location.label" }}</a>
+                </span>
+            {{ end }}
+            {{ if and .user .entry.Archives }}
+                <span class="entry-archives" title="{{ t "entry.archives.title" }}">
+                    {{ t "entry.archives.label" }}
+                    {{ range .entry.Archives }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .ServiceTitle }}</a>
+                    {{ end }}
+                </span>
+            {{ end }}
         </div>
         <div class="entry-date">
-            {{ if .user }}
-                <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed $.user.Timezone .entry.Date }}</time>
-            {{ else }}
-                <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed "UTC" .entry.Date }}</time>
-            {{ end }}
+            {{ template "timestamp" dict "user" .user "date" .entry.Date "absolute" (entryDate .user .entry) }}
         </div>
     </header>
+    <dl class="entry-print-meta">
+        <dt>{{ t "entry.print.source" }}</dt>
+        <dd>{{ .entry.Feed.DisplayTitle }}</dd>
+        <dt>{{ t "entry.print.url" }}</dt>
+        <dd>{{ .entry.URL }}</dd>
+        <dt>{{ t "entry.print.date" }}</dt>
+        <dd><time datetime="{{ isodate .entry.Date }}">{{ entryDate $.user .entry }}</time></dd>
+    </dl>
     {{ if gt (len .entry.Content) 120 }}
     {{ if .user }}
     <div class="pagination-top">
@@ -783,7 +1174,7 @@ var templateViewsMap = map[string]string{
     </div>
     {{ end }}
     {{ end }}
-    <article class="entry-content" dir="auto">
+    <article class="entry-content" dir="{{ textDirection $lang }}">
         {{ if .user }}
             {{ noescape (proxyFilter .entry.Content) }}
         {{ else }}
@@ -801,10 +1192,17 @@ var templateViewsMap = map[string]string{
                         <audio controls preload="metadata">
                             <source src="{{ .URL | safeURL }}" type="{{ .MimeType }}">
                         </audio>
+                        {{ if $.user }}
+                        <a href="#"
+                            data-queue-add-url="{{ route "addToPlaybackQueue" "entryID" $.entry.ID }}"
+                            data-label-loading="{{ t "entry.state.saving" }}"
+                            data-label-done="{{ t "entry.state.queued" }}">{{ t "action.add_to_queue" }}</a>
+                        {{ end }}
                     </div>
                 {{ else if hasPrefix .MimeType "video/" }}
                     <div class="enclosure-video">
-                        <video controls preload="metadata">
+                        <video controls preload="none" playsinline
+                            {{- if .PosterURL }}{{ if $.user }} poster="{{ proxyURL .PosterURL }}"{{ else }} poster="{{ .PosterURL | safeURL }}"{{ end }}{{ end }}>
                             <source src="{{ .URL | safeURL }}" type="{{ .MimeType }}">
                         </video>
                     </div>
@@ -820,13 +1218,50 @@ var templateViewsMap = map[string]string{
 
                 <div class="entry-enclosure-download">
                     <a href="{{ .URL | safeURL }}" title="{{ t "action.download" }}{{ if gt .Size 0 }} - {{ formatFileSize .Size }}{{ end }} ({{ .MimeType }})" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .URL | safeURL  }}</a>
-                    <small>{{ if gt .Size 0 }} - <strong>{{ formatFileSize .Size }}</strong>{{ end }}</small>
+                    <small>{{ if gt .Size 0 }} - <strong>{{ formatFileSize .Size }}</strong>{{ end }}{{ if gt .Duration 0 }} - <strong>{{ formatDuration .Duration }}</strong>{{ end }}</small>
                 </div>
             </div>
             {{ end }}
         {{ end }}
         </details>
     {{ end }}
+    {{ if and .user .entry.CommentsFeedURL }}
+    <details class="entry-comments" {{ if .entry.CommentSubscription }}open{{ end }}>
+        <summary>{{ t "page.entry.comments" }} ({{ len .entry.Comments }})</summary>
+        <p class="entry-comments-follow">
+            {{ if .entry.CommentSubscription }}
+                {{ plural "page.entry.comments.following" .entry.CommentSubscription.RemainingDays .entry.CommentSubscription.RemainingDays }}
+                <a href="#"
+                    data-follow-comments="true"
+                    data-url="{{ route "unfollowEntryComments" "entryID" .entry.ID }}"
+                    data-label-loading="{{ t "entry.state.saving" }}">{{ t "page.entry.comments.unfollow" }}</a>
+            {{ else }}
+                <a href="#"
+                    data-follow-comments="true"
+                    data-url="{{ route "followEntryComments" "entryID" .entry.ID }}"
+                    data-label-loading="{{ t "entry.state.loading" }}">{{ t "page.entry.comments.follow" }}</a>
+            {{ end }}
+        </p>
+        {{ range .entry.Comments }}
+        <article class="entry-comment">
+            <header class="entry-comment-meta">
+                {{ if .Author }}<strong>{{ .Author }}</strong>{{ end }}
+                {{ if .URL }}
+                    <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer"><time datetime="{{ isodate .Date }}" title="{{ isodate .Date }}">{{ elapsed $.user.Timezone .Date }}</time></a>
+                {{ else }}
+                    <time datetime="{{ isodate .Date }}" title="{{ isodate .Date }}">{{ elapsed $.user.Timezone .Date }}</time>
+                {{ end }}
+            </header>
+            <div class="entry-comment-content">{{ noescape (proxyFilter .Content) }}</div>
+        </article>
+        {{ end }}
+    </details>
+    {{ end }}
+    {{ if gt .entry.RemovedTrackers 0 }}
+    <footer class="entry-footer">
+        <small>{{ plural "entry.removed_trackers" .entry.RemovedTrackers .entry.RemovedTrackers }}</small>
+    </footer>
+    {{ end }}
 </section>
 
 {{ if .user }}
@@ -836,37 +1271,99 @@ var templateViewsMap = map[string]string{
 {{ end }}
 {{ end }}
 `,
-	"feed_entries": `{{ define "title"}}{{ .feed.Title }} ({{ .total }}){{ end }}
+	"entry_email": `{{ define "title"}}{{ t "page.entry_email.title" }}{{ end }}
 
 {{ define "content"}}
 <section class="page-header">
-    <h1 dir="auto">
-        <a href="{{ .feed.SiteURL | safeURL  }}" title="{{ .feed.SiteURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .feed.Title }}</a> 
-        ({{ .total }})
-    </h1>
-    <ul>
-        {{ if .entries }}
-        <li>
-            <a href="#"
-                data-action="markPageAsRead"
-                data-label-question="{{ t "confirm.question" }}"
-                data-label-yes="{{ t "confirm.yes" }}"
-                data-label-no="{{ t "confirm.no" }}"
-                data-label-loading="{{ t "confirm.loading" }}"
-                data-show-only-unread="{{ if .showOnlyUnreadEntries }}1{{ end }}">{{ t "menu.mark_page_as_read" }}</a>
-        </li>
-        {{ end }}
-        {{ if .showOnlyUnreadEntries }}
-        <li>
-            <a href="{{ route "feedEntriesAll" "feedID" .feed.ID }}">{{ t "menu.show_all_entries" }}</a>
-        </li>
-        {{ else }}
-        <li>
-            <a href="{{ route "feedEntries" "feedID" .feed.ID }}">{{ t "menu.show_only_unread_entries" }}</a>
-        </li>
-        {{ end }}
-        <li>
-            <a href="{{ route "refreshFeed" "feedID" .feed.ID }}">{{ t "menu.refresh_feed" }}</a>
+    <h1>{{ t "page.entry_email.title" }}</h1>
+</section>
+
+<form action="{{ route "sendEntryByEmail" "entryID" .entry.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <div class="panel">
+        <a href="{{ .entry.URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .entry.Title }}</a>
+        <div class="form-help">{{ .entry.Feed.DisplayTitle }}</div>
+    </div>
+
+    <label for="form-recipients">{{ t "form.entry_email.label.recipients" }}</label>
+    <input type="text" name="recipients" id="form-recipients" value="{{ .form.Recipients }}" placeholder="alice@example.org, bob@example.org" spellcheck="false" required autofocus>
+
+    <label for="form-note">{{ t "form.entry_email.label.note" }}</label>
+    <textarea name="note" id="form-note" cols="40" rows="5">{{ .form.Note }}</textarea>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.sending" }}">{{ t "action.send" }}</button> {{ t "action.or" }} <a href="{{ route "feedEntry" "feedID" .entry.FeedID "entryID" .entry.ID }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
+`,
+	"entry_send": `{{ define "title"}}{{ t "page.entry_send.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.entry_send.title" }}</h1>
+</section>
+
+<form action="{{ route "sendEntryToUser" "entryID" .entry.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <div class="panel">
+        <a href="{{ .entry.URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .entry.Title }}</a>
+        <div class="form-help">{{ .entry.Feed.DisplayTitle }}</div>
+    </div>
+
+    <label for="form-username">{{ t "form.entry_send.label.username" }}</label>
+    <input type="text" name="username" id="form-username" value="{{ .form.Username }}" autocapitalize="off" spellcheck="false" required autofocus>
+
+    <label for="form-message">{{ t "form.entry_send.label.message" }}</label>
+    <textarea name="message" id="form-message" cols="40" rows="5">{{ .form.Message }}</textarea>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.sending" }}">{{ t "action.send" }}</button> {{ t "action.or" }} <a href="{{ route "feedEntry" "feedID" .entry.FeedID "entryID" .entry.ID }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
+`,
+	"feed_entries": `{{ define "title"}}{{ .feed.DisplayTitle }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1 dir="auto">
+        <a href="{{ .feed.SiteURL | safeURL  }}" title="{{ .feed.SiteURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .feed.DisplayTitle }}</a> 
+        ({{ .total }})
+    </h1>
+    <ul>
+        {{ if .entries }}
+        <li>
+            <a href="#"
+                data-action="markPageAsRead"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-show-only-unread="{{ if .showOnlyUnreadEntries }}1{{ end }}">{{ t "menu.mark_page_as_read" }}</a>
+        </li>
+        {{ end }}
+        {{ if .showOnlyUnreadEntries }}
+        <li>
+            <a href="{{ route "feedEntriesAll" "feedID" .feed.ID }}">{{ t "menu.show_all_entries" }}</a>
+        </li>
+        {{ else }}
+        <li>
+            <a href="{{ route "feedEntries" "feedID" .feed.ID }}">{{ t "menu.show_only_unread_entries" }}</a>
+        </li>
+        {{ end }}
+        <li>
+            <a href="{{ route "refreshFeed" "feedID" .feed.ID }}">{{ t "menu.refresh_feed" }}</a>
         </li>
         <li>
             <a href="{{ route "editFeed" "feedID" .feed.ID }}">{{ t "menu.edit_feed" }}</a>
@@ -883,9 +1380,26 @@ var templateViewsMap = map[string]string{
                 data-redirect-url="{{ route "feeds" }}">{{ t "action.remove_feed" }}</a>
         </li>
     </ul>
+    <form action="{{ route "searchEntries" }}" class="scoped-search-form">
+        <input type="hidden" name="feed_id" value="{{ .feed.ID }}">
+        <input type="search" name="q" placeholder="{{ t "search.placeholder_feed" }}" list="search-suggestions" autocomplete="off" data-suggestions-url="{{ route "searchSuggestions" }}" aria-label="{{ t "search.placeholder_feed" }}" required>
+    </form>
+    {{ if .entryCounts }}
+    <div class="feed-volume" title="{{ plural "page.feed_entries.volume" .entryCounts.Total .entryCounts.Total (len .entryCounts) }}">
+        <svg class="feed-volume-sparkline" width="120" height="20" viewBox="0 0 120 20" preserveAspectRatio="none" aria-hidden="true">
+            <polyline points="{{ .entryCounts.SparklinePoints 120 20 }}" />
+        </svg>
+        <span>{{ plural "page.feed_entries.volume" .entryCounts.Total .entryCounts.Total (len .entryCounts) }}</span>
+    </div>
+    {{ end }}
 </section>
 
-{{ if ne .feed.ParsingErrorCount 0 }}
+{{ if .feed.Dead }}
+<div class="alert alert-error">
+    <h3>{{ t "alert.feed_dead" }}</h3>
+    <p>{{ t .feed.ParsingErrorMsg }}</p>
+</div>
+{{ else if ne .feed.ParsingErrorCount 0 }}
 <div class="alert alert-error">
     <h3>{{ t "alert.feed_error" }}</h3>
     <p>{{ t .feed.ParsingErrorMsg }}</p>
@@ -905,9 +1419,13 @@ var templateViewsMap = map[string]string{
             <div class="item-header" dir="auto">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
-                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    {{ if .Feed.OpenExternalLink }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true" data-open-external-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "feedEntry" "feedID" .Feed.ID "entryID" .ID }}">{{ .Title }}</a>
                     {{ end }}
-                    <a href="{{ route "feedEntry" "feedID" .Feed.ID "entryID" .ID }}">{{ .Title }}</a>
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -933,6 +1451,47 @@ var templateViewsMap = map[string]string{
     {{ template "pagination" .pagination }}
 {{ end }}
 
+{{ end }}
+`,
+	"feed_recommendations": `{{ define "title"}}{{ t "page.feed_recommendations.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.feed_recommendations.title" }}</h1>
+    {{ template "feed_menu" }}
+</section>
+
+{{ if not .recommendations }}
+    <p class="alert">{{ t "alert.no_feed_recommendation" }}</p>
+{{ else }}
+    <table>
+    <tr>
+        <th>{{ t "page.feed_recommendations.table.feed" }}</th>
+        <th>{{ t "page.feed_recommendations.table.category" }}</th>
+        <th>{{ t "page.feed_recommendations.table.subscribers" }}</th>
+        <th>{{ t "page.feed_recommendations.table.actions" }}</th>
+    </tr>
+    {{ range .recommendations }}
+    <tr>
+        <td>
+            <a href="{{ .SiteURL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Title }}</a>
+            <br><small><a href="{{ .FeedURL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .FeedURL }}</a></small>
+        </td>
+        <td>{{ .CategoryTitle }}</td>
+        <td>{{ .Subscribers }}</td>
+        <td>
+            <form action="{{ route "chooseSubscription" }}" method="POST">
+                <input type="hidden" name="csrf" value="{{ $.csrf }}">
+                <input type="hidden" name="url" value="{{ .FeedURL }}">
+                <input type="hidden" name="category_id" value="{{ .CategoryID }}">
+                <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.subscribe" }}</button>
+            </form>
+        </td>
+    </tr>
+    {{ end }}
+    </table>
+{{ end }}
+
 {{ end }}
 `,
 	"feeds": `{{ define "title"}}{{ t "page.feeds.title" }} ({{ .total }}){{ end }}
@@ -943,10 +1502,81 @@ var templateViewsMap = map[string]string{
     {{ template "feed_menu" }}
 </section>
 
+{{ if .showOnlyDormantFeeds }}
+    <p class="alert alert-info">
+        {{ plural "alert.dormant_feeds_filter" .dormantDays .dormantDays }}
+        <a href="{{ route "feeds" }}">{{ t "menu.show_all_feeds" }}</a>
+    </p>
+{{ else if .countDormantFeeds }}
+    <p class="alert alert-info">
+        <a href="{{ route "feeds" }}?filter=dormant">{{ plural "alert.dormant_feeds" .countDormantFeeds .countDormantFeeds }}</a>
+    </p>
+{{ end }}
+
+{{ if .countUnusedFeeds }}
+    <p class="alert alert-info">
+        <a href="{{ route "unusedFeeds" }}">{{ plural "alert.unused_feeds" .countUnusedFeeds .countUnusedFeeds }}</a>
+    </p>
+{{ end }}
+
 {{ if not .feeds }}
     <p class="alert">{{ t "alert.no_feed" }}</p>
 {{ else }}
-    {{ template "feed_list" dict "user" .user "feeds" .feeds "ParsingErrorCount" .ParsingErrorCount }}
+    {{ template "feed_list" dict "user" .user "feeds" .feeds "categories" .categories "ParsingErrorCount" .ParsingErrorCount }}
+{{ end }}
+
+{{ end }}
+`,
+	"hidden_categories": `{{ define "title"}}{{ t "page.hidden_categories.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.hidden_categories.title" }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "categories" }}">{{ t "menu.categories" }}</a>
+        </li>
+    </ul>
+</section>
+
+<p class="form-help">{{ t "page.hidden_categories.help" }}</p>
+
+{{ if not .categories }}
+    <p class="alert">{{ t "alert.no_hidden_category" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .categories }}
+        <article class="item">
+            <div class="item-header" dir="auto">
+                <span class="item-title">
+                    <a href="{{ route "categoryEntries" "categoryID" .ID }}">{{ .Title }}</a>
+                </span>
+                (<span title="{{ if eq .FeedCount 0 }}{{ t "page.categories.no_feed" }}{{ else }}{{ plural "page.categories.feed_count" .FeedCount .FeedCount }}{{ end }}">{{ .FeedCount }}</span>)
+            </div>
+            <div class="item-meta">
+                <ul class="item-meta-info">
+                    <li>
+                        {{ if eq .FeedCount 0 }}{{ t "page.categories.no_feed" }}{{ else }}{{ plural "page.categories.feed_count" .FeedCount .FeedCount }}{{ end }}
+                    </li>
+                </ul>
+                <ul class="item-meta-icons">
+                    <li>
+                        <a href="{{ route "categoryFeeds" "categoryID" .ID }}">{{ template "icon_feeds" }}<span class="icon-label">{{ t "page.categories.feeds" }}</span></a>
+                    </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "unhideCategory" "categoryID" .ID }}">{{ template "icon_entries" }}<span class="icon-label">{{ t "action.unhide_category" }}</span></a>
+                    </li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
 {{ end }}
 
 {{ end }}
@@ -970,12 +1600,18 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "sharedEntries" }}">{{ t "menu.shared_entries" }}</a>
         </li>
+        <li>
+            <a href="{{ route "receivedEntries" }}">{{ t "menu.received_entries" }}</a>
+        </li>
     </ul>
     {{ else }}
     <ul>
         <li>
             <a href="{{ route "sharedEntries" }}">{{ t "menu.shared_entries" }}</a>
         </li>
+        <li>
+            <a href="{{ route "receivedEntries" }}">{{ t "menu.received_entries" }}</a>
+        </li>
     </ul>
     {{ end }}
 </section>
@@ -989,9 +1625,13 @@ var templateViewsMap = map[string]string{
             <div class="item-header" dir="auto">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
-                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    {{ if .Feed.OpenExternalLink }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true" data-open-external-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "readEntry" "entryID" .ID }}">{{ .Title }}</a>
                     {{ end }}
-                    <a href="{{ route "readEntry" "entryID" .ID }}">{{ .Title }}</a>
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -1086,6 +1726,18 @@ var templateViewsMap = map[string]string{
         <label for="form-pinboard-tags">{{ t "form.integration.pinboard_tags" }}</label>
         <input type="text" name="pinboard_tags" id="form-pinboard-tags" value="{{ .form.PinboardTags }}">
 
+        <label>
+            <input type="checkbox" name="pinboard_category_tag" value="1" {{ if .form.PinboardCategoryTag }}checked{{ end }}> {{ t "form.integration.pinboard_category_tag" }}
+        </label>
+
+        <label>
+            <input type="checkbox" name="pinboard_entry_tags" value="1" {{ if .form.PinboardEntryTags }}checked{{ end }}> {{ t "form.integration.pinboard_entry_tags" }}
+        </label>
+
+        <label>
+            <input type="checkbox" name="pinboard_prompt_tags" value="1" {{ if .form.PinboardPromptTags }}checked{{ end }}> {{ t "form.integration.pinboard_prompt_tags" }}
+        </label>
+
         <label>
             <input type="checkbox" name="pinboard_mark_as_unread" value="1" {{ if .form.PinboardMarkAsUnread }}checked{{ end }}> {{ t "form.integration.pinboard_bookmark" }}
         </label>
@@ -1107,6 +1759,22 @@ var templateViewsMap = map[string]string{
         <label for="form-instapaper-password">{{ t "form.integration.instapaper_password" }}</label>
         <input type="password" name="instapaper_password" id="form-instapaper-password" value="{{ .form.InstapaperPassword }}" autocomplete="new-password">
 
+        {{ if .instapaperFolders }}
+        <label for="form-instapaper-folder">{{ t "form.integration.instapaper_folder" }}</label>
+        <select id="form-instapaper-folder" name="instapaper_folder_id">
+            <option value="">{{ t "form.integration.instapaper_folder_unread" }}</option>
+        {{ range .instapaperFolders }}
+            <option value="{{ .ID }}" {{ if eq (printf "%d" .ID) $.form.InstapaperFolderID }}selected="selected"{{ end }}>{{ .Title }}</option>
+        {{ end }}
+        </select>
+        {{ else if .hasInstapaperFullAPI }}
+        <label for="form-instapaper-folder">{{ t "form.integration.instapaper_folder_id" }}</label>
+        <input type="text" name="instapaper_folder_id" id="form-instapaper-folder" value="{{ .form.InstapaperFolderID }}" inputmode="numeric">
+        <p class="form-help">{{ t "form.integration.instapaper_folder_help" }}</p>
+        {{ else }}
+        <input type="hidden" name="instapaper_folder_id" value="{{ .form.InstapaperFolderID }}">
+        {{ end }}
+
         <div class="buttons">
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
         </div>
@@ -1130,6 +1798,10 @@ var templateViewsMap = map[string]string{
             <p><a href="{{ route "pocketAuthorize" }}">{{ t "form.integration.pocket_connect_link" }}</a></p>
         {{ end }}
 
+        <label>
+            <input type="checkbox" name="pocket_category_tag" value="1" {{ if .form.PocketCategoryTag }}checked{{ end }}> {{ t "form.integration.pocket_category_tag" }}
+        </label>
+
         <div class="buttons">
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
         </div>
@@ -1156,98 +1828,821 @@ var templateViewsMap = map[string]string{
         <label for="form-wallabag-password">{{ t "form.integration.wallabag_password" }}</label>
         <input type="password" name="wallabag_password" id="form-wallabag-password" value="{{ .form.WallabagPassword }}" autocomplete="new-password">
 
+        <label for="form-wallabag-tags">{{ t "form.integration.wallabag_tags" }}</label>
+        <input type="text" name="wallabag_tags" id="form-wallabag-tags" value="{{ .form.WallabagTags }}" placeholder="miniflux, to-read">
+
+        <label>
+            <input type="checkbox" name="wallabag_archive" value="1" {{ if .form.WallabagArchive }}checked{{ end }}> {{ t "form.integration.wallabag_archive" }}
+        </label>
+
         <div class="buttons">
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
         </div>
     </div>
 
-    <h3>Nunux Keeper</h3>
+    <h3>Karakeep</h3>
     <div class="form-section">
         <label>
-            <input type="checkbox" name="nunux_keeper_enabled" value="1" {{ if .form.NunuxKeeperEnabled }}checked{{ end }}> {{ t "form.integration.nunux_keeper_activate" }}
+            <input type="checkbox" name="karakeep_enabled" value="1" {{ if .form.KarakeepEnabled }}checked{{ end }}> {{ t "form.integration.karakeep_activate" }}
         </label>
 
-        <label for="form-nunux-keeper-url">{{ t "form.integration.nunux_keeper_endpoint" }}</label>
-        <input type="url" name="nunux_keeper_url" id="form-nunux-keeper-url" value="{{ .form.NunuxKeeperURL }}" placeholder="https://api.nunux.org/keeper">
+        <label for="form-karakeep-url">{{ t "form.integration.karakeep_endpoint" }}</label>
+        <input type="url" name="karakeep_url" id="form-karakeep-url" value="{{ .form.KarakeepURL }}" placeholder="https://karakeep.example.org/">
+
+        <label for="form-karakeep-api-key">{{ t "form.integration.karakeep_api_key" }}</label>
+        <input type="password" name="karakeep_api_key" id="form-karakeep-api-key" value="{{ .form.KarakeepAPIKey }}" autocomplete="new-password">
+
+        <label for="form-karakeep-tags">{{ t "form.integration.karakeep_tags" }}</label>
+        <input type="text" name="karakeep_tags" id="form-karakeep-tags" value="{{ .form.KarakeepTags }}" placeholder="miniflux, to-read">
 
-        <label for="form-nunux-keeper-api-key">{{ t "form.integration.nunux_keeper_api_key" }}</label>
-        <input type="text" name="nunux_keeper_api_key" id="form-nunux-keeper-api-key" value="{{ .form.NunuxKeeperAPIKey }}">
-        
         <div class="buttons">
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
         </div>
     </div>
 
-</form>
+    <h3>Espial</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="espial_enabled" value="1" {{ if .form.EspialEnabled }}checked{{ end }}> {{ t "form.integration.espial_activate" }}
+        </label>
 
-<h3>{{ t "page.integration.bookmarklet" }}</h3>
-<div class="panel">
-    <p>{{ t "page.integration.bookmarklet.help" }}</p>
+        <label for="form-espial-url">{{ t "form.integration.espial_endpoint" }}</label>
+        <input type="url" name="espial_url" id="form-espial-url" value="{{ .form.EspialURL }}" placeholder="https://espial.example.org/">
 
-    <div class="bookmarklet">
-        <a href="javascript:location.href='{{ rootURL }}{{ route "bookmarklet" }}?uri='+encodeURIComponent(window.location.href)">{{ t "page.integration.bookmarklet.name" }}</a>
+        <label for="form-espial-api-key">{{ t "form.integration.espial_api_key" }}</label>
+        <input type="password" name="espial_api_key" id="form-espial-api-key" value="{{ .form.EspialAPIKey }}" autocomplete="new-password">
+
+        <label for="form-espial-tags">{{ t "form.integration.espial_tags" }}</label>
+        <input type="text" name="espial_tags" id="form-espial-tags" value="{{ .form.EspialTags }}" placeholder="miniflux to-read">
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
     </div>
 
-    <p>{{ t "page.integration.bookmarklet.instructions" }}</p>
-</div>
+    <h3>LinkAce</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="linkace_enabled" value="1" {{ if .form.LinkAceEnabled }}checked{{ end }}> {{ t "form.integration.linkace_activate" }}
+        </label>
 
-{{ end }}
-`,
-	"login": `{{ define "title"}}{{ t "page.login.title" }}{{ end }}
+        <label for="form-linkace-url">{{ t "form.integration.linkace_endpoint" }}</label>
+        <input type="url" name="linkace_url" id="form-linkace-url" value="{{ .form.LinkAceURL }}" placeholder="https://links.example.org/">
 
-{{ define "content"}}
-<section class="login-form">
-    <form action="{{ route "checkLogin" }}" method="post">
-        <input type="hidden" name="csrf" value="{{ .csrf }}">
+        <label for="form-linkace-api-key">{{ t "form.integration.linkace_api_key" }}</label>
+        <input type="password" name="linkace_api_key" id="form-linkace-api-key" value="{{ .form.LinkAceAPIKey }}" autocomplete="new-password">
 
-        {{ if .errorMessage }}
-            <div class="alert alert-error">{{ t .errorMessage }}</div>
-        {{ end }}
+        <label for="form-linkace-tags">{{ t "form.integration.linkace_tags" }}</label>
+        <input type="text" name="linkace_tags" id="form-linkace-tags" value="{{ .form.LinkAceTags }}" placeholder="miniflux, to-read">
 
-        <label for="form-username">{{ t "form.user.label.username" }}</label>
-        <input type="text" name="username" id="form-username" value="{{ .form.Username }}" required autofocus>
+        <label for="form-linkace-lists">{{ t "form.integration.linkace_lists" }}</label>
+        <input type="text" name="linkace_lists" id="form-linkace-lists" value="{{ .form.LinkAceLists }}" placeholder="1, 4" spellcheck="false">
 
-        <label for="form-password">{{ t "form.user.label.password" }}</label>
-        <input type="password" name="password" id="form-password" value="{{ .form.Password }}" required>
+        <label>
+            <input type="checkbox" name="linkace_category_tag" value="1" {{ if .form.LinkAceCategoryTag }}checked{{ end }}> {{ t "form.integration.linkace_category_tag" }}
+        </label>
+
+        <label>
+            <input type="checkbox" name="linkace_is_private" value="1" {{ if .form.LinkAceIsPrivate }}checked{{ end }}> {{ t "form.integration.linkace_is_private" }}
+        </label>
 
         <div class="buttons">
-            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.login" }}</button>
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
         </div>
-    </form>
-    {{ if hasOAuth2Provider "google" }}
-    <div class="oauth2">
-        <a href="{{ route "oauth2Redirect" "provider" "google" }}">{{ t "page.login.google_signin" }}</a>
     </div>
-    {{ else if hasOAuth2Provider "oidc" }}
-    <div class="oauth2">
-        <a href="{{ route "oauth2Redirect" "provider" "oidc" }}">{{ t "page.login.oidc_signin" }}</a>
-    </div>
-    {{ end }}
-</section>
-<footer id="prompt-home-screen">
-    <a href="#" id="btn-add-to-home-screen">★ {{ t "action.home_screen" }}</a>
-</footer>
-{{ end }}
-`,
-	"search_entries": `{{ define "title"}}{{ t "page.search.title" }} ({{ .total }}){{ end }}
 
-{{ define "content"}}
-<section class="page-header">
-    <h1>{{ t "page.search.title" }} ({{ .total }})</h1>
-</section>
+    <h3>Nunux Keeper</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="nunux_keeper_enabled" value="1" {{ if .form.NunuxKeeperEnabled }}checked{{ end }}> {{ t "form.integration.nunux_keeper_activate" }}
+        </label>
 
-{{ if not .entries }}
-    <p class="alert alert-info">{{ t "alert.no_search_result" }}</p>
-{{ else }}
+        <label for="form-nunux-keeper-url">{{ t "form.integration.nunux_keeper_endpoint" }}</label>
+        <input type="url" name="nunux_keeper_url" id="form-nunux-keeper-url" value="{{ .form.NunuxKeeperURL }}" placeholder="https://api.nunux.org/keeper">
+
+        <label for="form-nunux-keeper-api-key">{{ t "form.integration.nunux_keeper_api_key" }}</label>
+        <input type="text" name="nunux_keeper_api_key" id="form-nunux-keeper-api-key" value="{{ .form.NunuxKeeperAPIKey }}">
+        
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
+    </div>
+
+    <h3>{{ t "form.integration.custom_bookmark" }}</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="custom_bookmark_enabled" value="1" {{ if .form.CustomBookmarkEnabled }}checked{{ end }}> {{ t "form.integration.custom_bookmark_activate" }}
+        </label>
+
+        <label for="form-custom-bookmark-url">{{ t "form.integration.custom_bookmark_endpoint" }}</label>
+        <input type="url" name="custom_bookmark_url" id="form-custom-bookmark-url" value="{{ .form.CustomBookmarkURL }}" placeholder="https://bookmarks.example.org/api/bookmarks">
+
+        <label for="form-custom-bookmark-method">{{ t "form.integration.custom_bookmark_method" }}</label>
+        <select id="form-custom-bookmark-method" name="custom_bookmark_method">
+            <option value="POST" {{ if eq .form.CustomBookmarkMethod "POST" }}selected="selected"{{ end }}>POST</option>
+            <option value="PUT" {{ if eq .form.CustomBookmarkMethod "PUT" }}selected="selected"{{ end }}>PUT</option>
+            <option value="PATCH" {{ if eq .form.CustomBookmarkMethod "PATCH" }}selected="selected"{{ end }}>PATCH</option>
+        </select>
+
+        <label for="form-custom-bookmark-headers">{{ t "form.integration.custom_bookmark_headers" }}</label>
+        <textarea name="custom_bookmark_headers" id="form-custom-bookmark-headers" cols="40" rows="3" spellcheck="false" placeholder="X-Api-Key: secret">{{ .form.CustomBookmarkHeaders }}</textarea>
+
+        <label for="form-custom-bookmark-body">{{ t "form.integration.custom_bookmark_body" }}</label>
+        <textarea name="custom_bookmark_body" id="form-custom-bookmark-body" cols="40" rows="5" spellcheck="false" placeholder="{{ .customBookmarkDefaultBody }}">{{ .form.CustomBookmarkBody }}</textarea>
+        <p class="form-help">{{ t "form.integration.custom_bookmark_body_help" }} <code>{{ "{{ .URL }}" }}</code>, <code>{{ "{{ .Title }}" }}</code>, <code>{{ "{{ .Content }}" }}</code>, <code>{{ "{{ .Author }}" }}</code>, <code>{{ "{{ .CommentsURL }}" }}</code>, <code>{{ "{{ .Feed }}" }}</code>, <code>{{ "{{ .FeedURL }}" }}</code>, <code>{{ "{{ .Category }}" }}</code>, <code>{{ "{{ .Tags }}" }}</code>, <code>{{ "{{ .Date }}" }}</code></p>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
+    </div>
+
+    <h3>{{ t "form.integration.markdown" }}</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="markdown_enabled" value="1" {{ if .form.MarkdownEnabled }}checked{{ end }}> {{ t "form.integration.markdown_activate" }}
+        </label>
+
+        <label>
+            <input type="checkbox" name="markdown_starred" value="1" {{ if .form.MarkdownStarred }}checked{{ end }}> {{ t "form.integration.markdown_starred" }}
+        </label>
+
+        <label for="form-markdown-webdav-url">{{ t "form.integration.markdown_webdav_url" }}</label>
+        <input type="url" name="markdown_webdav_url" id="form-markdown-webdav-url" value="{{ .form.MarkdownWebDAVURL }}" placeholder="https://cloud.example.org/remote.php/dav/files/me/Notes/">
+        {{ if .hasMarkdownExportDir }}
+        <p class="form-help">{{ t "form.integration.markdown_local_help" }}</p>
+        {{ end }}
+
+        <label for="form-markdown-webdav-username">{{ t "form.integration.markdown_webdav_username" }}</label>
+        <input type="text" name="markdown_webdav_username" id="form-markdown-webdav-username" value="{{ .form.MarkdownWebDAVUsername }}">
+
+        <label for="form-markdown-webdav-password">{{ t "form.integration.markdown_webdav_password" }}</label>
+        <input type="password" name="markdown_webdav_password" id="form-markdown-webdav-password" value="{{ .form.MarkdownWebDAVPassword }}" autocomplete="new-password">
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
+    </div>
+
+    <h3>Wayback Machine</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="wayback_enabled" value="1" {{ if .form.WaybackEnabled }}checked{{ end }}> {{ t "form.integration.wayback_activate" }}
+        </label>
+        <p class="form-help">{{ t "form.integration.wayback_help" }}</p>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
+    </div>
+
+    <h3>ArchiveBox</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="archivebox_enabled" value="1" {{ if .form.ArchiveBoxEnabled }}checked{{ end }}> {{ t "form.integration.archivebox_activate" }}
+        </label>
+
+        <label for="form-archivebox-url">{{ t "form.integration.archivebox_endpoint" }}</label>
+        <input type="url" name="archivebox_url" id="form-archivebox-url" value="{{ .form.ArchiveBoxURL }}" placeholder="https://archivebox.example.org" spellcheck="false">
+
+        <label for="form-archivebox-api-key">{{ t "form.integration.archivebox_api_key" }}</label>
+        <input type="password" name="archivebox_api_key" id="form-archivebox-api-key" value="{{ .form.ArchiveBoxAPIKey }}" autocomplete="new-password">
+
+        <label for="form-archivebox-tags">{{ t "form.integration.archivebox_tags" }}</label>
+        <input type="text" name="archivebox_tags" id="form-archivebox-tags" value="{{ .form.ArchiveBoxTags }}" spellcheck="false">
+        <p class="form-help">{{ t "form.integration.archivebox_help" }}</p>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
+    </div>
+
+    <h3>ntfy</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="ntfy_enabled" value="1" {{ if .form.NtfyEnabled }}checked{{ end }}> {{ t "form.integration.ntfy_activate" }}
+        </label>
+
+        <label for="form-ntfy-topic-url">{{ t "form.integration.ntfy_topic_url" }}</label>
+        <input type="url" name="ntfy_topic_url" id="form-ntfy-topic-url" value="{{ .form.NtfyTopicURL }}" placeholder="https://ntfy.sh/my-topic" spellcheck="false">
+
+        <label for="form-ntfy-token">{{ t "form.integration.ntfy_token" }}</label>
+        <input type="password" name="ntfy_token" id="form-ntfy-token" value="{{ .form.NtfyToken }}" autocomplete="new-password">
+        <p class="form-help">{{ t "form.integration.ntfy_help" }}</p>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
+    </div>
+
+    <h3>Zotero</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="zotero_enabled" value="1" {{ if .form.ZoteroEnabled }}checked{{ end }}> {{ t "form.integration.zotero_activate" }}
+        </label>
+
+        <label for="form-zotero-library-type">{{ t "form.integration.zotero_library_type" }}</label>
+        <select id="form-zotero-library-type" name="zotero_library_type">
+            <option value="user" {{ if ne .form.ZoteroLibraryType "group" }}selected="selected"{{ end }}>{{ t "form.integration.zotero_library_user" }}</option>
+            <option value="group" {{ if eq .form.ZoteroLibraryType "group" }}selected="selected"{{ end }}>{{ t "form.integration.zotero_library_group" }}</option>
+        </select>
+
+        <label for="form-zotero-library-id">{{ t "form.integration.zotero_library_id" }}</label>
+        <input type="text" name="zotero_library_id" id="form-zotero-library-id" value="{{ .form.ZoteroLibraryID }}" inputmode="numeric" spellcheck="false">
+
+        <label for="form-zotero-api-key">{{ t "form.integration.zotero_api_key" }}</label>
+        <input type="password" name="zotero_api_key" id="form-zotero-api-key" value="{{ .form.ZoteroAPIKey }}" autocomplete="new-password">
+
+        <label for="form-zotero-collection-key">{{ t "form.integration.zotero_collection_key" }}</label>
+        <input type="text" name="zotero_collection_key" id="form-zotero-collection-key" value="{{ .form.ZoteroCollectionKey }}" spellcheck="false">
+        <p class="form-help">{{ t "form.integration.zotero_help" }}</p>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
+    </div>
+
+    <h3>Hypothesis</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="hypothesis_enabled" value="1" {{ if .form.HypothesisEnabled }}checked{{ end }}> {{ t "form.integration.hypothesis_activate" }}
+        </label>
+
+        <label for="form-hypothesis-token">{{ t "form.integration.hypothesis_token" }}</label>
+        <input type="password" name="hypothesis_token" id="form-hypothesis-token" value="{{ .form.HypothesisToken }}" autocomplete="new-password">
+        <p class="form-help">{{ t "form.integration.hypothesis_token_help" }}</p>
+
+        <label for="form-hypothesis-group">{{ t "form.integration.hypothesis_group" }}</label>
+        <input type="text" name="hypothesis_group" id="form-hypothesis-group" value="{{ .form.HypothesisGroup }}" spellcheck="false">
+        <p class="form-help">{{ t "form.integration.hypothesis_group_help" }}</p>
+
+        <p class="form-help">{{ t "form.integration.hypothesis_export_help" }} <code>/v1/annotations/export</code></p>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
+    </div>
+
+    <h3>RSS-Bridge</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="rssbridge_enabled" value="1" {{ if .form.RSSBridgeEnabled }}checked{{ end }}> {{ t "form.integration.rssbridge_activate" }}
+        </label>
+
+        <label for="form-rssbridge-url">{{ t "form.integration.rssbridge_url" }}</label>
+        <input type="url" name="rssbridge_url" id="form-rssbridge-url" value="{{ .form.RSSBridgeURL }}" placeholder="https://rss-bridge.example.org/">
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
+    </div>
+
+    {{ if .categories }}
+    <h3>{{ t "form.integration.routing" }}</h3>
+    <div class="form-section">
+        <p class="form-help">{{ t "form.integration.routing_help" }}</p>
+
+        {{ range .routableServices }}
+        {{ $service := .Key }}
+        <label for="form-route-{{ $service }}">{{ t .Name }}</label>
+        <label><input type="checkbox" name="restrict_{{ $service }}" value="1" {{ if $.form.IsRestricted $service }}checked{{ end }}> {{ t "form.integration.routing_restrict" }}</label>
+        <select id="form-route-{{ $service }}" name="route_{{ $service }}" multiple size="4">
+        {{ range $.categories }}
+            <option value="{{ .ID }}" {{ if $.form.HasCategoryRoute $service .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+        {{ end }}
+        </select>
+        {{ end }}
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        </div>
+    </div>
+    {{ end }}
+
+</form>
+
+<h3>{{ t "page.integration.bookmarklet" }}</h3>
+<div class="panel">
+    <p>{{ t "page.integration.bookmarklet.help" }}</p>
+
+    <div class="bookmarklet">
+        <a href="javascript:location.href='{{ rootURL }}{{ route "bookmarklet" }}?uri='+encodeURIComponent(window.location.href)">{{ t "page.integration.bookmarklet.name" }}</a>
+    </div>
+
+    <p>{{ t "page.integration.bookmarklet.instructions" }}</p>
+</div>
+
+{{ end }}
+`,
+	"login": `{{ define "title"}}{{ t "page.login.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="login-form">
+    <form action="{{ route "checkLogin" }}" method="post">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <label for="form-username">{{ t "form.user.label.username" }}</label>
+        <input type="text" name="username" id="form-username" value="{{ .form.Username }}" required autofocus>
+
+        <label for="form-password">{{ t "form.user.label.password" }}</label>
+        <input type="password" name="password" id="form-password" value="{{ .form.Password }}" required>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.login" }}</button>
+        </div>
+    </form>
+    {{ if hasOAuth2Provider "google" }}
+    <div class="oauth2">
+        <a href="{{ route "oauth2Redirect" "provider" "google" }}">{{ t "page.login.google_signin" }}</a>
+    </div>
+    {{ else if hasOAuth2Provider "oidc" }}
+    <div class="oauth2">
+        <a href="{{ route "oauth2Redirect" "provider" "oidc" }}">{{ t "page.login.oidc_signin" }}</a>
+    </div>
+    {{ end }}
+</section>
+<footer id="prompt-home-screen">
+    <a href="#" id="btn-add-to-home-screen">★ {{ t "action.home_screen" }}</a>
+</footer>
+{{ end }}
+`,
+	"muted_keywords": `{{ define "title"}}{{ t "page.muted_keywords.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.muted_keywords.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+<p class="form-help">{{ t "page.muted_keywords.help" }}</p>
+
+{{ if .mutedKeywords }}
+<table>
+    <tr>
+        <th>{{ t "page.muted_keywords.table.pattern" }}</th>
+        <th>{{ t "page.muted_keywords.table.action" }}</th>
+        <th>{{ t "page.muted_keywords.table.expires_at" }}</th>
+        <th>{{ t "page.muted_keywords.table.actions" }}</th>
+    </tr>
+    {{ range .mutedKeywords }}
+    <tr>
+        <td><code>{{ .Pattern }}</code></td>
+        <td>{{ if eq .Action "read" }}{{ t "form.muted_keyword.select.read" }}{{ else }}{{ t "form.muted_keyword.select.drop" }}{{ end }}</td>
+        <td>
+            {{ if .ExpiresAt }}
+                <time datetime="{{ isodate .ExpiresAt }}" title="{{ isodate .ExpiresAt }}">{{ if .IsExpired }}{{ t "page.muted_keywords.expired" }}{{ else }}{{ .ExpiresAt.Format "2006-01-02" }}{{ end }}</time>
+            {{ else }}
+                {{ t "page.muted_keywords.never_expires" }}
+            {{ end }}
+        </td>
+        <td>
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "removeMutedKeyword" "mutedKeywordID" .ID }}">{{ t "action.remove" }}</a>
+        </td>
+    </tr>
+    {{ end }}
+</table>
+<br>
+{{ end }}
+
+<p>
+    <a href="{{ route "createMutedKeyword" }}" class="button button-primary">{{ t "menu.create_muted_keyword" }}</a>
+</p>
+
+{{ end }}
+`,
+	"playback_queue": `{{ define "title"}}{{ t "page.playback_queue.title" }} ({{ len .queue }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.playback_queue.title" }} ({{ len .queue }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "podcasts" }}">{{ t "menu.podcasts" }}</a>
+        </li>
+    </ul>
+    {{ if gt .queue.Duration 0 }}
+    <p class="podcasts-duration">{{ t "page.podcasts.total_duration" (formatDuration .queue.Duration) }}</p>
+    {{ end }}
+</section>
+
+{{ if not .queue }}
+    <p class="alert alert-info">{{ t "alert.no_queued_entry" }}</p>
+{{ else }}
+<div id="playback-queue" class="playback-queue" data-reorder-url="{{ route "reorderPlaybackQueue" }}">
+    <div class="playback-queue-player">
+        <audio controls preload="none"></audio>
+        <p>
+            <a href="#" data-queue-action="play-all">{{ t "action.play_queue" }}</a>
+            <span class="playback-queue-now-playing" dir="auto"></span>
+        </p>
+    </div>
+    <ol class="playback-queue-items">
+        {{ range .queue }}
+        <li data-entry-id="{{ .EntryID }}"
+            data-audio-url="{{ .AudioURL | safeURL }}"
+            data-title="{{ .Title }}"
+            data-remove-url="{{ route "removeFromPlaybackQueue" "entryID" .EntryID }}">
+            <a href="#" data-queue-action="play" dir="auto">{{ .Title }}</a>
+            <span class="category">{{ .FeedTitle }}</span>
+            {{ if gt .Duration 0 }}<span class="item-duration">{{ formatDuration .Duration }}</span>{{ end }}
+            <span class="playback-queue-actions">
+                <a href="#" data-queue-action="up" title="{{ t "action.move_up" }}">&uarr;</a>
+                <a href="#" data-queue-action="down" title="{{ t "action.move_down" }}">&darr;</a>
+                <a href="{{ route "feedEntry" "feedID" .FeedID "entryID" .EntryID }}">{{ t "action.open" }}</a>
+                <a href="#" data-queue-action="remove">{{ t "action.remove" }}</a>
+            </span>
+        </li>
+        {{ end }}
+    </ol>
+</div>
+{{ end }}
+
+{{ end }}
+`,
+	"podcast_entries": `{{ define "title"}}{{ t "page.podcasts.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.podcasts.title" }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "playbackQueue" }}">{{ t "menu.playback_queue" }}</a>
+        </li>
+    </ul>
+    {{ if gt .totalDuration 0 }}
+    <p class="podcasts-duration">{{ t "page.podcasts.total_duration" (formatDuration .totalDuration) }}</p>
+    {{ end }}
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_podcast_entry" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+            <div class="item-header" dir="auto">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    <a href="{{ route "feedEntry" "feedID" .Feed.ID "entryID" .ID }}">{{ .Title }}</a>
+                </span>
+                {{ with index $.durations .ID }}<span class="item-duration">{{ formatDuration . }}</span>{{ end }}
+                <span class="item-queue">
+                    <a href="#"
+                    data-queue-add-url="{{ route "addToPlaybackQueue" "entryID" .ID }}"
+                    data-label-loading="{{ t "entry.state.saving" }}"
+                    data-label-done="{{ t "entry.state.queued" }}">{{ t "action.add_to_queue" }}</a>
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+        </article>
+        {{ end }}
+    </div>
+    {{ template "pagination" .pagination }}
+{{ end }}
+
+{{ end }}
+`,
+	"received_entries": `{{ define "title"}}{{ t "page.received_entries.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.received_entries.title" }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "history" }}">{{ t "menu.history" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "sharedEntries" }}">{{ t "menu.shared_entries" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_received_entry" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item" data-id="{{ .ID }}">
+            <div class="item-header" dir="auto">
+                <span class="item-title">
+                    <a href="{{ route "receivedEntry" "receivedEntryID" .ID }}">{{ .Title }}</a>
+                </span>
+            </div>
+            {{ if .Message }}
+                <blockquote class="received-entry-message" dir="auto">{{ .Message }}</blockquote>
+            {{ end }}
+            <div class="item-meta">
+                <ul class="item-meta-info">
+                    <li>{{ t "page.received_entries.sender" .SenderUsername }}</li>
+                    {{ if .FeedTitle }}
+                        <li>{{ truncate .FeedTitle 35 }}</li>
+                    {{ end }}
+                    <li>
+                        <time datetime="{{ isodate .CreatedAt }}" title="{{ isodate .CreatedAt }}">{{ elapsed $.user.Timezone .CreatedAt }}</time>
+                    </li>
+                </ul>
+                <ul class="item-meta-icons">
+                    <li>
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ template "icon_original" }}<span class="icon-label">{{ t "entry.original.label" }}</span></a>
+                    </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-url="{{ route "removeReceivedEntry" "receivedEntryID" .ID }}"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}">{{ template "icon_delete" }}<span class="icon-label">{{ t "action.remove" }}</span></a>
+                    </li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
+{{ end }}
+`,
+	"received_entry": `{{ define "title"}}{{ .entry.Title }}{{ end }}
+
+{{ define "content"}}
+{{ $lang := contentLanguage .entry.Content }}
+<section class="entry" data-id="{{ .entry.ID }}" dir="{{ textDirection $lang }}"{{ if $lang }} lang="{{ $lang }}"{{ end }}>
+    <header class="entry-header">
+        <h1 dir="auto">
+            <a href="{{ .entry.URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .entry.Title }}</a>
+        </h1>
+        <div class="entry-actions">
+            <ul>
+                <li>
+                    <a href="{{ route "receivedEntries" }}">{{ t "page.received_entries.title" }}</a>
+                </li>
+                <li>
+                    <a href="#"
+                        data-confirm="true"
+                        data-url="{{ route "removeReceivedEntry" "receivedEntryID" .entry.ID }}"
+                        data-label-question="{{ t "confirm.question" }}"
+                        data-label-yes="{{ t "confirm.yes" }}"
+                        data-label-no="{{ t "confirm.no" }}"
+                        data-label-loading="{{ t "confirm.loading" }}">{{ template "icon_delete" }}<span class="icon-label">{{ t "action.remove" }}</span></a>
+                </li>
+            </ul>
+        </div>
+        <div class="entry-meta" dir="auto">
+            {{ if .entry.FeedTitle }}
+                <span class="entry-website">{{ .entry.FeedTitle }}</span>
+            {{ end }}
+            {{ if .entry.Author }}
+                <span class="entry-author">– <em>{{ .entry.Author }}</em></span>
+            {{ end }}
+        </div>
+        <div class="entry-date">
+            {{ template "timestamp" dict "user" .user "date" .entry.Date "absolute" (isodate .entry.Date) }}
+        </div>
+    </header>
+    <div class="panel" dir="auto">
+        <strong>{{ t "page.received_entries.sender" .entry.SenderUsername }}</strong>
+        {{ if .entry.Message }}
+            <blockquote class="received-entry-message">{{ .entry.Message }}</blockquote>
+        {{ end }}
+    </div>
+    <article class="entry-content" dir="{{ textDirection $lang }}">
+        {{ noescape (proxyFilter .entry.Content) }}
+    </article>
+</section>
+{{ end }}
+`,
+	"remove_category": `{{ define "title"}}{{ t "page.remove_category.title" .category.Title }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1 dir="auto">{{ t "page.remove_category.title" .category.Title }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "categories" }}">{{ t "menu.categories" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "categoryFeeds" "categoryID" .category.ID }}">{{ t "menu.feeds" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .categories }}
+    <p class="alert alert-error">{{ t "alert.no_category_to_move_feeds" }}</p>
+{{ else }}
+<form action="{{ route "removeCategory" "categoryID" .category.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <p class="form-help">{{ plural "page.remove_category.help" .category.FeedCount .category.FeedCount }}</p>
+
+    <label for="form-target-category">{{ t "form.category.label.move_feeds_to" }}</label>
+    <select id="form-target-category" name="target_category_id" required autofocus>
+        {{ range .categories }}
+        <option value="{{ .ID }}" {{ if eq .ID $.targetCategoryID }}selected="selected"{{ end }}>{{ .Title }}</option>
+        {{ end }}
+    </select>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.move_feeds_and_remove" }}</button> {{ t "action.or" }} <a href="{{ route "categoryFeeds" "categoryID" .category.ID }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
+{{ end }}
+`,
+	"reports": `{{ define "title"}}{{ t "page.reports.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.reports.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+<div class="panel">
+    <ul>
+        <li><strong>{{ t "page.reports.database_size" }}</strong>: {{ formatFileSize .report.DatabaseSize }}</li>
+        <li><strong>{{ t "page.reports.storage" }}</strong>: {{ formatFileSize .report.StorageBytes }}</li>
+        <li><strong>{{ t "page.reports.feeds" }}</strong>: {{ .report.FeedCount }}</li>
+        <li><strong>{{ t "page.reports.entries" }}</strong>: {{ .report.EntryCount }}</li>
+    </ul>
+</div>
+
+<table>
+    <tr>
+        <th class="column-20">{{ t "page.users.username" }}</th>
+        <th>{{ t "page.reports.feeds" }}</th>
+        <th>{{ t "page.reports.entries" }}</th>
+        <th>{{ t "page.reports.storage" }}</th>
+        <th>{{ t "page.reports.last_activity" }}</th>
+    </tr>
+    {{ range .report.Users }}
+    <tr>
+        <td>{{ .Username }}</td>
+        <td>{{ .FeedCount }}</td>
+        <td>{{ .EntryCount }}</td>
+        <td>{{ formatFileSize .StorageBytes }} ({{ $.report.Share . }}%)</td>
+        <td>
+            {{ with .LastActivityAt }}
+                <time datetime="{{ isodate . }}" title="{{ isodate . }}">{{ elapsed $.user.Timezone . }}</time>
+            {{ else }}
+                {{ t "page.users.never_logged" }}
+            {{ end }}
+        </td>
+    </tr>
+    {{ end }}
+</table>
+
+{{ end }}
+`,
+	"saved_searches": `{{ define "title"}}{{ t "page.saved_searches.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.saved_searches.title" }}</h1>
+    {{ if .searchHistory }}
+    <ul>
+        <li>
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "clearSearchHistory" }}">{{ t "menu.clear_search_history" }}</a>
+        </li>
+    </ul>
+    {{ end }}
+</section>
+
+{{ if not .savedSearches }}
+    <p class="alert">{{ t "alert.no_saved_search" }}</p>
+{{ else }}
+<table>
+    <tr>
+        <th>{{ t "page.saved_searches.table.query" }}</th>
+        <th>{{ t "page.saved_searches.table.actions" }}</th>
+    </tr>
+    {{ range .savedSearches }}
+    <tr>
+        <td>
+            <a href="{{ route "searchEntries" }}?q={{ .Query }}{{ if .FeedID }}&amp;feed_id={{ .FeedID }}{{ end }}{{ if .CategoryID }}&amp;category_id={{ .CategoryID }}{{ end }}">{{ .Query }}</a>
+            {{ if .FeedID }}
+                <div class="form-help">{{ t "page.search.scope_feed" .FeedTitle }}</div>
+            {{ else if .CategoryID }}
+                <div class="form-help">{{ t "page.search.scope_category" .CategoryTitle }}</div>
+            {{ end }}
+        </td>
+        <td>
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "removeSavedSearch" "savedSearchID" .ID }}">{{ t "action.remove" }}</a>
+        </td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+
+{{ if .searchHistory }}
+<h3>{{ t "page.saved_searches.recent" }}</h3>
+<table>
+    <tr>
+        <th>{{ t "page.saved_searches.table.query" }}</th>
+        <th>{{ t "page.saved_searches.table.actions" }}</th>
+    </tr>
+    {{ range .searchHistory }}
+    <tr>
+        <td><a href="{{ route "searchEntries" }}?q={{ . }}">{{ . }}</a></td>
+        <td>
+            <a href="#"
+                data-save-search="true"
+                data-save-url="{{ route "saveSearch" }}"
+                data-query="{{ . }}"
+                data-label-loading="{{ t "entry.state.saving" }}"
+                data-label-done="{{ t "page.search.saved" }}">{{ t "action.save_search" }}</a>
+        </td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+
+{{ end }}
+`,
+	"search_entries": `{{ define "title"}}{{ t "page.search.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.search.title" }} ({{ .total }})</h1>
+    <ul>
+        {{ if .searchQuery }}
+        <li>
+            {{ if .isSavedSearch }}
+                {{ t "page.search.saved" }}
+            {{ else }}
+                <a href="#"
+                    data-save-search="true"
+                    data-save-url="{{ route "saveSearch" }}"
+                    data-query="{{ .searchQuery }}"
+                    data-feed-id="{{ .searchScope.FeedID }}"
+                    data-category-id="{{ .searchScope.CategoryID }}"
+                    data-label-loading="{{ t "entry.state.saving" }}"
+                    data-label-done="{{ t "page.search.saved" }}">{{ t "action.save_search" }}</a>
+            {{ end }}
+        </li>
+        {{ end }}
+        <li>
+            <a href="{{ route "savedSearches" }}">{{ t "menu.saved_searches" }}</a>
+        </li>
+        {{ if or .searchScope.Feed .searchScope.Category }}
+        <li>
+            {{ if .searchScope.Feed }}
+                <a href="{{ route "feedEntries" "feedID" .searchScope.Feed.ID }}">{{ t "page.search.scope_feed" .searchScope.Feed.DisplayTitle }}</a>
+            {{ else }}
+                <a href="{{ route "categoryEntries" "categoryID" .searchScope.Category.ID }}">{{ t "page.search.scope_category" .searchScope.Category.Title }}</a>
+            {{ end }}
+        </li>
+        <li>
+            <a href="{{ route "searchEntries" }}?q={{ .searchQuery }}">{{ t "page.search.all_entries" }}</a>
+        </li>
+        {{ end }}
+    </ul>
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_search_result" }}</p>
+    <p class="form-help">{{ t "page.search.syntax_help" }}</p>
+{{ else }}
     <div class="items">
         {{ range .entries }}
         <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
             <div class="item-header" dir="auto">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
-                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    {{ if .Feed.OpenExternalLink }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true" data-open-external-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "searchEntry" "entryID" .ID }}?q={{ $.searchQuery }}{{ with $.searchScope }}{{ if .FeedID }}&amp;feed_id={{ .FeedID }}{{ end }}{{ if .CategoryID }}&amp;category_id={{ .CategoryID }}{{ end }}{{ end }}">{{ .Title }}</a>
                     {{ end }}
-                    <a href="{{ route "searchEntry" "entryID" .ID }}?q={{ $.searchQuery }}">{{ .Title }}</a>
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
@@ -1350,6 +2745,47 @@ var templateViewsMap = map[string]string{
         <option value="desc" {{ if eq "desc" $.form.EntryDirection }}selected="selected"{{ end }}>{{ t "form.prefs.select.recent_first" }}</option>
     </select>
 
+    <label for="form-home-page">{{ t "form.prefs.label.home_page" }}</label>
+    <select id="form-home-page" name="home_page">
+    {{ range .homePages }}
+        <option value="{{ . }}" {{ if eq . $.form.HomePage }}selected="selected"{{ end }}>{{ t (printf "menu.%s" .) }}</option>
+    {{ end }}
+    {{ if .categories }}
+        <optgroup label="{{ t "form.prefs.select.home_page_category" }}">
+        {{ range .categories }}
+            {{ $value := printf "category:%d" .ID }}
+            <option value="{{ $value }}" {{ if eq $value $.form.HomePage }}selected="selected"{{ end }}>{{ .Title }}</option>
+        {{ end }}
+        </optgroup>
+    {{ end }}
+    {{ if .savedSearches }}
+        <optgroup label="{{ t "menu.saved_searches" }}">
+        {{ range .savedSearches }}
+            {{ $value := printf "saved_search:%d" .ID }}
+            <option value="{{ $value }}" {{ if eq $value $.form.HomePage }}selected="selected"{{ end }}>{{ .Query }}{{ if .FeedTitle }} ({{ .FeedTitle }}){{ else if .CategoryTitle }} ({{ .CategoryTitle }}){{ end }}</option>
+        {{ end }}
+        </optgroup>
+    {{ end }}
+        <option value="search" {{ if eq "search" $.form.HomePage }}selected="selected"{{ end }}>{{ t "form.prefs.select.home_page_search" }}</option>
+    </select>
+
+    <label for="form-home-page-search">{{ t "form.prefs.label.home_page_search" }}</label>
+    <input type="text" name="home_page_search" id="form-home-page-search" value="{{ .form.HomePageSearch }}" spellcheck="false">
+
+    <label for="form-entry-timezone">{{ t "form.prefs.label.entry_timezone" }}</label>
+    <select id="form-entry-timezone" name="entry_timezone">
+    {{ range .entryTimezones }}
+        <option value="{{ . }}" {{ if eq . $.form.EntryTimezone }}selected="selected"{{ end }}>{{ t (printf "form.prefs.select.entry_timezone_%s" .) }}</option>
+    {{ end }}
+    </select>
+
+    <label for="form-timestamp-format">{{ t "form.prefs.label.timestamp_format" }}</label>
+    <select id="form-timestamp-format" name="timestamp_format">
+    {{ range .timestampFormats }}
+        <option value="{{ . }}" {{ if eq . $.form.TimestampFormat }}selected="selected"{{ end }}>{{ t (printf "form.prefs.select.timestamp_%s" .) }}</option>
+    {{ end }}
+    </select>
+
     <label for="form-entries-per-page">{{ t "form.prefs.label.entries_per_page" }}</label>
     <input type="number" name="entries_per_page" id="form-entries-per-page" value="{{ .form.EntriesPerPage }}" min="1">
 
@@ -1357,6 +2793,56 @@ var templateViewsMap = map[string]string{
     
     <label><input type="checkbox" name="show_reading_time" value="1" {{ if .form.ShowReadingTime }}checked{{ end }}> {{ t "form.prefs.label.show_reading_time" }}</label>
 
+    <label><input type="checkbox" name="mark_read_on_original_link" value="1" {{ if .form.MarkReadOnOriginalLink }}checked{{ end }}> {{ t "form.prefs.label.mark_read_on_original_link" }}</label>
+
+    <label><input type="checkbox" name="keep_starred_entries" value="1" {{ if .form.KeepStarredEntries }}checked{{ end }}> {{ t "form.prefs.label.keep_starred_entries" }}</label>
+
+    <label><input type="checkbox" name="accept_received_entries" value="1" {{ if .form.AcceptReceivedEntries }}checked{{ end }}> {{ t "form.prefs.label.accept_received_entries" }}</label>
+
+    {{ if and (gt .archiveReadDaysMax 0) (ge .archiveReadDays 0) }}
+    <label for="form-archive-read-days">{{ t "form.prefs.label.archive_read_days" }}</label>
+    <input type="number" name="archive_read_days" id="form-archive-read-days" value="{{ if .form.ArchiveReadDays }}{{ .form.ArchiveReadDays }}{{ end }}" min="0" max="{{ .archiveReadDaysMax }}" placeholder="{{ .archiveReadDays }}">
+    <p class="form-help">{{ t "form.prefs.help.archive_read_days" .archiveReadDays .archiveReadDaysMax }}</p>
+    {{ end }}
+
+    <label for="form-youtube-embed-url">{{ t "form.prefs.label.youtube_embed_url" }}</label>
+    <input type="url" name="youtube_embed_url" id="form-youtube-embed-url" value="{{ .form.YouTubeEmbedURL }}" placeholder="https://yewtu.be">
+
+    <label for="form-blocked-authors">{{ t "form.prefs.label.blocked_authors" }}</label>
+    <textarea name="blocked_authors" id="form-blocked-authors" cols="40" rows="5" spellcheck="false">{{ .form.BlockedAuthors }}</textarea>
+
+    <label for="form-auto-star-keywords">{{ t "form.prefs.label.auto_star_keywords" }}</label>
+    <textarea name="auto_star_keywords" id="form-auto-star-keywords" cols="40" rows="5" spellcheck="false">{{ .form.AutoStarKeywords }}</textarea>
+
+    <label for="form-auto-star-authors">{{ t "form.prefs.label.auto_star_authors" }}</label>
+    <textarea name="auto_star_authors" id="form-auto-star-authors" cols="40" rows="5" spellcheck="false">{{ .form.AutoStarAuthors }}</textarea>
+
+    {{ if hasEmailSharing }}
+    <label for="form-email-recipients">{{ t "form.prefs.label.email_recipients" }}</label>
+    <input type="text" name="email_recipients" id="form-email-recipients" value="{{ .form.EmailRecipients }}" placeholder="alice@example.org, bob@example.org" spellcheck="false">
+    {{ else }}
+    <input type="hidden" name="email_recipients" value="{{ .form.EmailRecipients }}">
+    {{ end }}
+
+    {{ if hasEmailSharing }}
+    <label for="form-notification-email">{{ t "form.prefs.label.notification_email" }}</label>
+    <input type="email" name="notification_email" id="form-notification-email" value="{{ .form.NotificationEmail }}" placeholder="alice@example.org" spellcheck="false">
+    {{ if and .user.NotificationEmail (not .user.NotificationEmailVerified) }}
+    <p class="form-help">
+        {{ t "form.prefs.help.notification_email_unverified" }}
+        <a href="#"
+            data-confirm="true"
+            data-label-question="{{ t "confirm.question" }}"
+            data-label-yes="{{ t "confirm.yes" }}"
+            data-label-no="{{ t "confirm.no" }}"
+            data-label-loading="{{ t "confirm.loading" }}"
+            data-url="{{ route "sendNotificationEmailVerification" }}">{{ t "action.send_verification_link" }}</a>
+    </p>
+    {{ end }}
+    {{ else }}
+    <input type="hidden" name="notification_email" value="{{ .form.NotificationEmail }}">
+    {{ end }}
+
     <label>{{t "form.prefs.label.custom_css" }}</label><textarea name="custom_css" cols="40" rows="5">{{ .form.CustomCSS }}</textarea>
     <div class="buttons">
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
@@ -1402,6 +2888,9 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "sharedEntries" }}">{{ t "menu.shared_entries" }}</a>
         </li>
+        <li>
+            <a href="{{ route "receivedEntries" }}">{{ t "menu.received_entries" }}</a>
+        </li>
     </ul>
     {{ end }}
 </section>
@@ -1415,9 +2904,13 @@ var templateViewsMap = map[string]string{
             <div class="item-header" dir="auto">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
-                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    {{ if .Feed.OpenExternalLink }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true" data-open-external-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "readEntry" "entryID" .ID }}">{{ .Title }}</a>
                     {{ end }}
-                    <a href="{{ route "readEntry" "entryID" .ID }}">{{ .Title }}</a>
                     {{ if .ShareCode }}
                         <a href="{{ route "sharedEntry" "shareCode" .ShareCode }}"
                             title="{{ t "entry.shared_entry.title" }}"
@@ -1429,10 +2922,10 @@ var templateViewsMap = map[string]string{
             <div class="item-meta">
                 <ul class="item-meta-info">
                     <li>
-                        <a href="{{ route "feedEntries" "feedID" .Feed.ID }}" title="{{ .Feed.SiteURL }}">{{ truncate .Feed.Title 35 }}</a>
+                        <a href="{{ route "feedEntries" "feedID" .Feed.ID }}" title="{{ .Feed.SiteURL }}">{{ truncate .Feed.DisplayTitle 35 }}</a>
                     </li>
                     <li>
-                        <time datetime="{{ isodate .Date }}" title="{{ isodate .Date }}">{{ elapsed $.user.Timezone .Date }}</time>
+                        {{ template "timestamp" dict "user" $.user "date" .Date "absolute" (entryDate $.user .) }}
                     </li>
                 </ul>
                 <ul class="item-meta-icons">
@@ -1453,6 +2946,83 @@ var templateViewsMap = map[string]string{
     </div>
 {{ end }}
 
+{{ end }}
+`,
+	"today_entries": `{{ define "title"}}{{ t "page.today.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.today.title" }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "unread" }}">{{ t "menu.unread" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_today_entry" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+            <div class="item-header" dir="auto">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    {{ if .Feed.OpenExternalLink }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true" data-open-external-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "feedEntry" "feedID" .Feed.ID "entryID" .ID }}">{{ .Title }}</a>
+                    {{ end }}
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+        </article>
+        {{ end }}
+    </div>
+    {{ template "pagination" .pagination }}
+{{ end }}
+
+{{ end }}
+`,
+	"trending_entries": `{{ define "title"}}{{ t "page.trending.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.trending.title" }} ({{ .total }})</h1>
+</section>
+
+{{ if not .topics }}
+    <p class="alert alert-info">{{ t "alert.no_trending_topic" }}</p>
+{{ else }}
+    {{ range .topics }}
+    <h3>{{ .Title }} <small>{{ plural "page.trending.feed_count" .Score .Score }}</small></h3>
+    <div class="items">
+        {{ range .Entries }}
+        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+            <div class="item-header" dir="auto">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    {{ if .Feed.OpenExternalLink }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true" data-open-external-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "feedEntry" "feedID" .Feed.ID "entryID" .ID }}">{{ .Title }}</a>
+                    {{ end }}
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+        </article>
+        {{ end }}
+    </div>
+    {{ end }}
+{{ end }}
+
 {{ end }}
 `,
 	"unread_entries": `{{ define "title"}}{{ t "page.unread.title" }} {{ if gt .countUnread 0 }}({{ .countUnread }}){{ end }} {{ end }}
@@ -1460,8 +3030,11 @@ var templateViewsMap = map[string]string{
 {{ define "content"}}
 <section class="page-header">
     <h1>{{ t "page.unread.title" }} (<span class="unread-counter">{{ .countUnread }}</span>)</h1>
-    {{ if .entries }}
     <ul>
+        <li>
+            <a href="{{ route "today" }}">{{ t "menu.today" }}</a>
+        </li>
+    {{ if .entries }}
         <li>
             <a href="#"
                 data-action="markPageAsRead"
@@ -1481,8 +3054,8 @@ var templateViewsMap = map[string]string{
                 data-label-no="{{ t "confirm.no" }}"
                 data-label-loading="{{ t "confirm.loading" }}">{{ t "menu.mark_all_as_read" }}</a>
         </li>
-    </ul>
     {{ end }}
+    </ul>
 </section>
 
 {{ if not .entries }}
@@ -1490,17 +3063,35 @@ var templateViewsMap = map[string]string{
 {{ else }}
     <div class="items hide-read-items">
         {{ range .entries }}
-        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+        {{ $duplicates := index $.duplicates .ID }}
+        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}"{{ if $duplicates }} data-duplicate-ids="{{ range $i, $duplicate := $duplicates }}{{ if $i }},{{ end }}{{ $duplicate.ID }}{{ end }}"{{ end }}>
             <div class="item-header" dir="auto">
                 <span class="item-title">
                     {{ if ne .Feed.Icon.IconID 0 }}
-                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.DisplayTitle }}">
+                    {{ end }}
+                    {{ if .Feed.OpenExternalLink }}
+                        <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true" data-open-external-link="true">{{ .Title }}</a>
+                    {{ else }}
+                        <a href="{{ route "unreadEntry" "entryID" .ID }}">{{ .Title }}</a>
                     {{ end }}
-                    <a href="{{ route "unreadEntry" "entryID" .ID }}">{{ .Title }}</a>
                 </span>
                 <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
             </div>
             {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+            {{ if $duplicates }}
+            <details class="item-duplicates">
+                <summary>{{ plural "entry.duplicates" (len $duplicates) (len $duplicates) }}</summary>
+                <ul>
+                    {{ range $duplicates }}
+                    <li>
+                        <a href="{{ route "unreadEntry" "entryID" .ID }}">{{ .Title }}</a>
+                        &mdash; <a href="{{ route "feedEntries" "feedID" .Feed.ID }}" title="{{ .Feed.SiteURL }}">{{ truncate .Feed.DisplayTitle 35 }}</a>
+                    </li>
+                    {{ end }}
+                </ul>
+            </details>
+            {{ end }}
         </article>
         {{ end }}
     </div>
@@ -1522,6 +3113,68 @@ var templateViewsMap = map[string]string{
 {{ end }}
 
 {{ end }}`,
+	"unused_feeds": `{{ define "title"}}{{ t "page.unused_feeds.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.unused_feeds.title" }} ({{ .total }})</h1>
+    {{ template "feed_menu" }}
+</section>
+
+{{ if .errorMessage }}
+    <div class="alert alert-error">{{ t .errorMessage }}</div>
+{{ end }}
+
+{{ if not .feeds }}
+    <p class="alert alert-info">{{ t "alert.no_unused_feed" }}</p>
+{{ else }}
+    <p>{{ plural "page.unused_feeds.description" .months .months }}</p>
+
+    <form action="{{ route "updateUnusedFeeds" }}" method="post" class="unused-feeds">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        <div class="items">
+            {{ range .feeds }}
+            <article class="item">
+                <div class="item-header" dir="auto">
+                    <label class="item-title">
+                        <input type="checkbox" name="feed_id" value="{{ .ID }}" checked>
+                        {{ if .Icon }}
+                            <img src="{{ route "icon" "iconID" .Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .DisplayTitle }}">
+                        {{ end }}
+                        <a href="{{ route "feedEntries" "feedID" .ID }}">{{ .DisplayTitle }}</a>
+                    </label>
+                    <span class="feed-entries-counter">
+                        (<span title="{{ t "page.feeds.unread_counter" }}">{{ .UnreadCount }}</span>/<span title="{{ t "page.feeds.read_counter" }}">{{ .ReadCount }}</span>)
+                    </span>
+                    <span class="category">
+                        <a href="{{ route "categoryEntries" "categoryID" .Category.ID }}">{{ .Category.Title }}</a>
+                    </span>
+                </div>
+                <div class="item-meta">
+                    <ul class="item-meta-info">
+                        <li>
+                            {{ if .LastReadAt }}
+                                {{ t "page.unused_feeds.last_read" }} <time datetime="{{ isodate .LastReadAt }}" title="{{ isodate .LastReadAt }}">{{ elapsed $.user.Timezone .LastReadAt }}</time>
+                            {{ else }}
+                                {{ t "page.unused_feeds.never_read" }}
+                            {{ end }}
+                        </li>
+                    </ul>
+                </div>
+            </article>
+            {{ end }}
+        </div>
+
+        <div class="buttons">
+            <button type="submit" name="action" value="remove" class="button button-danger">{{ t "action.unsubscribe_selected" }}</button>
+            <button type="submit" name="action" value="keep" class="button">{{ t "action.keep_selected" }}</button>
+        </div>
+    </form>
+{{ end }}
+
+{{ end }}
+`,
 	"users": `{{ define "title"}}{{ t "page.users.title" }}{{ end }}
 
 {{ define "content"}}
@@ -1578,31 +3231,49 @@ var templateViewsMap = map[string]string{
 }
 
 var templateViewsMapChecksums = map[string]string{
-	"about":               "4035658497363d7af7f79be83190404eb21ec633fe8ec636bdfc219d9fc78cfc",
-	"add_subscription":    "63961a83964acca354bc30eaae1f5e80f410ae4091af8da317380d4298f79032",
-	"api_keys":            "27d401b31a72881d5232486ba17eb47edaf5246eaedce81de88698c15ebb2284",
-	"bookmark_entries":    "892fe6cbf5a3301416dfb76e62935b495ca194275cfe113105a85b40ce7c200f",
-	"categories":          "9dfc3cb7bb91c7750753fe962ee4540dd1843e5f75f9e0a575ee964f6f9923e9",
-	"category_entries":    "8fa0e0b8f85e2572c40dee855b6d636207c3561086b234c93100673774c06746",
-	"category_feeds":      "07154127087f9b127f7290abad6020c35ad9ceb2490b869120b7628bc4413808",
-	"choose_subscription": "22109d760ea8079c491561d0106f773c885efbf66f87d81fcf8700218260d2a0",
-	"create_api_key":      "5f74d4e92a6684927f5305096378c8be278159a5cd88ce652c7be3280a7d1685",
-	"create_category":     "6b22b5ce51abf4e225e23a79f81be09a7fb90acb265e93a8faf9446dff74018d",
-	"create_user":         "9b73a55233615e461d1f07d99ad1d4d3b54532588ab960097ba3e090c85aaf3a",
-	"edit_category":       "b1c0b38f1b714c5d884edcd61e5b5295a5f1c8b71c469b35391e4dcc97cc6d36",
-	"edit_feed":           "7e86275f8e9325ddbffe79f6db871e58ad86d08c396e9b2ff8af69a09c4bf63b",
-	"edit_user":           "c692db9de1a084c57b93e95a14b041d39bf489846cbb91fc982a62b72b77062a",
-	"entry":               "c503dcf77de37090b9f05352bb9d99729085eec6e7bc22be94f2b4b244b4e48c",
-	"feed_entries":        "ea5b88e3ad6b166d83b70e021d7b420d025f80decb6e24c79d13f8ce7c910b04",
-	"feeds":               "ec7d3fa96735bd8422ba69ef0927dcccddc1cc51327e0271f0312d3f881c64fd",
-	"history_entries":     "341f0da8b6c27a8377901aa80bb1d5c923672af32f689d36de14deabce5c737f",
-	"import":              "1b59b3bd55c59fcbc6fbb346b414dcdd26d1b4e0c307e437bb58b3f92ef01ad1",
-	"integrations":        "7d0d936a60b50371e9b0ff411ca31a646a5897bc84894febb09cd4b08fc91f2b",
-	"login":               "79ff2ca488c0a19b37c8fa227a21f73e94472eb357a51a077197c852f7713f11",
-	"search_entries":      "c0786ddc6b17e865007b975eefb97417935cbc601f5917cca1ee0d3f584594bc",
-	"sessions":            "5d5c677bddbd027e0b0c9f7a0dd95b66d9d95b4e130959f31fb955b926c2201c",
-	"settings":            "a4d3df17e6abc75881ec1ca5f92a9c2cfe24e3e08e5d844df848b4d6aaa1bbc0",
-	"shared_entries":      "1494d81e46f6af534a73cf6a91f8dfda1932a477bb3a70143513896ac0f0220b",
-	"unread_entries":      "fbb368f70ee78bd605ac4c13707bd79ea50c6980248da0ac3830253b36ea83ad",
-	"users":               "d7ff52efc582bbad10504f4a04fa3adcc12d15890e45dff51cac281e0c446e45",
+	"about":                "b3284f44b4deb7875b6f6c3eeac9c184710c33976b93cb22042c599b78af4eda",
+	"add_subscription":     "63961a83964acca354bc30eaae1f5e80f410ae4091af8da317380d4298f79032",
+	"api_keys":             "27d401b31a72881d5232486ba17eb47edaf5246eaedce81de88698c15ebb2284",
+	"blocked_feeds":        "ef64f1624d4dcde3c6b2462312b856bee330d27d01aa343e1a3a0c3fe2703451",
+	"bookmark_entries":     "1759312487d29931948954815008f5f8d79f51b12f252e09c0b81ae62ad729e8",
+	"categories":           "7811252be2abc39de6c77832a4cc03813bde0cb2c056ac85bd71b93a53027793",
+	"category_entries":     "14ef8a66862f632941231003497717999d79c475bf1b34708ffc7ea2a0255ae2",
+	"category_feeds":       "0216a2bea7e5b11733fdec4a9090461fbc51839710fffec3b057509c5d986f4e",
+	"choose_subscription":  "22109d760ea8079c491561d0106f773c885efbf66f87d81fcf8700218260d2a0",
+	"create_api_key":       "5f74d4e92a6684927f5305096378c8be278159a5cd88ce652c7be3280a7d1685",
+	"create_category":      "6b22b5ce51abf4e225e23a79f81be09a7fb90acb265e93a8faf9446dff74018d",
+	"create_muted_keyword": "3be28b96661497de39de2d5d715de970391508bdbc4a5e45aabf48dacdcddf9a",
+	"create_user":          "9b73a55233615e461d1f07d99ad1d4d3b54532588ab960097ba3e090c85aaf3a",
+	"digest":               "66fdb93f7cfa1c1f5e72f61279b3b6ed2c6fd3634dc28fbf18094209933fe8b7",
+	"edit_category":        "aae4fbaba805b00bc934891d0139a185e5a5575f60042fe1056993283f997290",
+	"edit_feed":            "7c05e7ddac7348ae0b1ac1bca9cdcb94f451c096c01f4782f266e623c967b7f3",
+	"edit_user":            "c692db9de1a084c57b93e95a14b041d39bf489846cbb91fc982a62b72b77062a",
+	"entry":                "c3c832da9e028cf39bc615ad2b376a64be7c503e14a8749756be77f83e26923d",
+	"entry_email":          "a06323be386960b740b64f35a3077679f8aa2b6153e3ec0ff318d1fa15cbdf8d",
+	"entry_send":           "15f7e9ed4e9a80d0162a5f4a79c74fac6028fd0638d743baff93b2f642864b9e",
+	"feed_entries":         "8a3b92c8edf4194bb560c2937f6ec30f126b46673c0c99ff68cef9c709f97e50",
+	"feed_recommendations": "a3d3629b21da5d67890c45a4fa152246b44a199e1a120c352628bb1aeffde943",
+	"feeds":                "695a605df33ae6ad30f3aa6c28dced4a330dd77b78905ef6f44d955d99d559f8",
+	"hidden_categories":    "2d41df069719b3ffb729996b9f59a61a4f89d9300c8cddade7a718046c37af87",
+	"history_entries":      "e258eec3faef8f6b6809bdd69683440db539c34721e5755d71d73dc9b325334b",
+	"import":               "1b59b3bd55c59fcbc6fbb346b414dcdd26d1b4e0c307e437bb58b3f92ef01ad1",
+	"integrations":         "53911a282f39d19553df52db3848417fcc2c59b436fa154182106d93443b7e09",
+	"login":                "79ff2ca488c0a19b37c8fa227a21f73e94472eb357a51a077197c852f7713f11",
+	"muted_keywords":       "580d2a6dd9e3e47b763dfd96510e4a552c63280d4b51d64f3cdf5f0dca0de31a",
+	"playback_queue":       "26cf7d60548583e4f941b6b3bfe46cd918e9c2c5c809449d586894f40e88dea3",
+	"podcast_entries":      "9adee3fa3b06e026f008bc76889da90fe85614719551b4c62653027d847f45f5",
+	"received_entries":     "0c989b8f74056128ab807f59cb3d48bf30fb5c664e6466a13d94be4589503ca1",
+	"received_entry":       "93788c58b430b163a5ec0ceef05dbe470dbf25b4a2aabbbfc1397bbbc3db05b5",
+	"remove_category":      "96f68d5ab1185da025fc9d19a0fcbcc414170b1ba12e50029280c33bf4dd10e4",
+	"reports":              "f79dc905233911770710718e958daa2dac5429fb5bd9554d31b1e921e84a1d7c",
+	"saved_searches":       "8d9beaea50a355e6e370e4d624a99b5a8717f30be24c938c676519b120e55085",
+	"search_entries":       "a5e29b77a1acb7fed549907e671cc20f274e0f2dc4b3ecdaa5c3b6ba5e97f687",
+	"sessions":             "5d5c677bddbd027e0b0c9f7a0dd95b66d9d95b4e130959f31fb955b926c2201c",
+	"settings":             "a0f989afe2efeb760e887c5a1a1b564b54bb7080811677f910ce18cde4854d3d",
+	"shared_entries":       "22911e2066eabefa49bba8862db7d0918b5bd0b0f4e82a0424eda154d99f1465",
+	"today_entries":        "1bb556946ac2cca05d54002e129cbf0572e4cdd764ec270661135ed3d7776bb0",
+	"trending_entries":     "6846a8cecbcdaa76a79fcda349b04f3bb03647d32d4f12b9c80fdfd746a6037f",
+	"unread_entries":       "c2552ca3c2ed72f27cd4dd79fe2a0323d1515c3df256448730e26c09f1e98c8e",
+	"unused_feeds":         "e6cc58cf7ea9951d3c6cdbefee87b339fbb4a68a5ff6347d61167a5c13661e72",
+	"users":                "d7ff52efc582bbad10504f4a04fa3adcc12d15890e45dff51cac281e0c446e45",
 }
@@ -0,0 +1,52 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestVersion(t *testing.T) {
+	client := miniflux.New(testBaseURL, "", "")
+	info, err := client.Version()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Version == "" || info.Commit == "" || info.BuildDate == "" {
+		t.Fatalf(`Invalid version information: %+v`, info)
+	}
+}
+
+func TestAbout(t *testing.T) {
+	client := createClient(t)
+	about, err := client.About()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if about.Version == "" {
+		t.Fatalf(`The version should be returned: %+v`, about)
+	}
+
+	if about.Limits.PollingFrequency == 0 || about.Limits.BatchSize == 0 {
+		t.Fatalf(`Invalid limits: %+v`, about.Limits)
+	}
+
+	if about.Features.PollingScheduler == "" {
+		t.Fatalf(`Invalid features: %+v`, about.Features)
+	}
+}
+
+func TestAboutWithoutCredentials(t *testing.T) {
+	client := miniflux.New(testBaseURL, "", "")
+	if _, err := client.About(); err == nil {
+		t.Fatal(`The about endpoint should require authentication`)
+	}
+}
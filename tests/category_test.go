@@ -124,6 +124,42 @@ func TestListCategories(t *testing.T) {
 	}
 }
 
+func TestHideCategory(t *testing.T) {
+	client := createClient(t)
+
+	category, err := client.CreateCategory("Seasonal")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	category, err = client.SetCategoryHidden(category.ID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !category.Hidden || category.Title != "Seasonal" {
+		t.Fatalf(`Unexpected category: %+v`, category)
+	}
+
+	category, err = client.UpdateCategory(category.ID, "Winter")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !category.Hidden {
+		t.Error(`Renaming a category should not make it visible again`)
+	}
+
+	category, err = client.SetCategoryHidden(category.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if category.Hidden {
+		t.Error(`The category should be visible`)
+	}
+}
+
 func TestDeleteCategory(t *testing.T) {
 	client := createClient(t)
 
@@ -138,6 +174,44 @@ func TestDeleteCategory(t *testing.T) {
 	}
 }
 
+func TestDeleteCategoryAndMoveFeeds(t *testing.T) {
+	client := createClient(t)
+	feed, category := createFeed(t, client)
+
+	target, err := client.CreateCategory("Target category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteCategoryAndMoveFeeds(category.ID, category.ID); err == nil {
+		t.Fatal(`Moving the feeds to the removed category should not be allowed`)
+	}
+
+	if err := client.DeleteCategoryAndMoveFeeds(category.ID, target.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	movedFeed, err := client.Feed(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if movedFeed.Category.ID != target.ID {
+		t.Errorf(`The feed should be in the category #%d instead of #%d`, target.ID, movedFeed.Category.ID)
+	}
+
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range categories {
+		if c.ID == category.ID {
+			t.Error(`The category should have been removed`)
+		}
+	}
+}
+
 func TestCannotDeleteCategoryOfAnotherUser(t *testing.T) {
 	client := createClient(t)
 	categories, err := client.Categories()
@@ -151,3 +225,30 @@ func TestCannotDeleteCategoryOfAnotherUser(t *testing.T) {
 		t.Fatal(`Removing a category that belongs to another user should be forbidden`)
 	}
 }
+
+func TestRefreshCategory(t *testing.T) {
+	client := createClient(t)
+	feed, category := createFeed(t, client)
+
+	job, err := client.RefreshCategory(category.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(job.FeedIDs) != 1 || job.FeedIDs[0] != feed.ID {
+		t.Fatalf(`Invalid job feed IDs, got %v instead of [%d]`, job.FeedIDs, feed.ID)
+	}
+}
+
+func TestCannotRefreshCategoryOfAnotherUser(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client = createClient(t)
+	if _, err := client.RefreshCategory(categories[0].ID); err == nil {
+		t.Fatal(`Refreshing a category that belongs to another user should be forbidden`)
+	}
+}
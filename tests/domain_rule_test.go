@@ -0,0 +1,78 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestBuiltinDomainRules(t *testing.T) {
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	rules, err := client.BuiltinDomainRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules.RewriteRules) == 0 || len(rules.ScraperRules) == 0 {
+		t.Fatalf(`Built-in rules should not be empty`)
+	}
+}
+
+func TestCreateUpdateAndDeleteDomainRule(t *testing.T) {
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	domain := getRandomUsername() + ".example.org"
+
+	rule, err := client.CreateDomainRule(domain, "nl2br", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rule.ID == 0 || rule.Domain != domain || rule.RewriteRules != "nl2br" {
+		t.Fatalf(`Invalid domain rule: %+v`, rule)
+	}
+
+	if _, err := client.CreateDomainRule(domain, "nl2br", ""); err == nil {
+		t.Fatal(`Duplicated domain rules should not be allowed`)
+	}
+
+	rule, err = client.UpdateDomainRule(rule.ID, domain, "", "article")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rule.RewriteRules != "" || rule.ScraperRules != "article" {
+		t.Fatalf(`Domain rule not updated: %+v`, rule)
+	}
+
+	if err := client.DeleteDomainRule(rule.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	rules, err := client.DomainRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range rules {
+		if r.ID == rule.ID {
+			t.Fatal(`The domain rule should be removed`)
+		}
+	}
+}
+
+func TestDomainRulesAreForbiddenForStandardUsers(t *testing.T) {
+	client := createClient(t)
+	if _, err := client.DomainRules(); err == nil {
+		t.Fatal(`Standard users should not be able to list domain rules`)
+	}
+
+	if _, err := client.CreateDomainRule("example.org", "nl2br", ""); err == nil {
+		t.Fatal(`Standard users should not be able to create domain rules`)
+	}
+}
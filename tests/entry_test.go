@@ -7,7 +7,9 @@
 package tests
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	miniflux "miniflux.app/client"
 )
@@ -93,6 +95,33 @@ func TestGetAllEntries(t *testing.T) {
 	}
 }
 
+func TestGetEntriesWithFields(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Fields: []string{"id", "title"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total == 0 {
+		t.Fatal(`Invalid number of entries`)
+	}
+
+	entry := result.Entries[0]
+	if entry.ID == 0 || entry.Title == "" {
+		t.Fatalf(`The selected fields should be returned: %+v`, entry)
+	}
+
+	if entry.Content != "" || entry.Feed != nil {
+		t.Fatalf(`Only the selected fields should be returned: %+v`, entry)
+	}
+
+	if _, err := client.Entries(&miniflux.Filter{Fields: []string{"unknown"}}); err == nil {
+		t.Fatal(`An invalid field should be rejected`)
+	}
+}
+
 func TestFilterEntriesByCategory(t *testing.T) {
 	client := createClient(t)
 	category, err := client.CreateCategory("Test Filter by Category")
@@ -200,6 +229,74 @@ func TestSearchEntries(t *testing.T) {
 	}
 }
 
+func TestSearchEntriesWithinFeedAndCategory(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateFeed(testFeedURL, categories[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherCategory, err := client.CreateCategory("Other category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := client.Entries(&miniflux.Filter{Search: "2.0.8", FeedID: feedID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 {
+		t.Fatalf(`We should have only one entry in the feed instead of %d`, results.Total)
+	}
+
+	results, err = client.Entries(&miniflux.Filter{Search: "2.0.8", CategoryID: categories[0].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 {
+		t.Fatalf(`We should have only one entry in the category instead of %d`, results.Total)
+	}
+
+	results, err = client.Entries(&miniflux.Filter{Search: "2.0.8", CategoryID: otherCategory.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 0 {
+		t.Fatalf(`We should have no entry in the other category instead of %d`, results.Total)
+	}
+}
+
+func TestSearchEntriesWithQualifiers(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	results, err := client.Entries(&miniflux.Filter{Search: "feed:" + testFeedTitle + " 2.0.8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 {
+		t.Fatalf(`We should have only one entry instead of %d`, results.Total)
+	}
+
+	results, err = client.Entries(&miniflux.Filter{Search: "before:2000-01-01 2.0.8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 0 {
+		t.Fatalf(`We should have no entry published before 2000 instead of %d`, results.Total)
+	}
+}
+
 func TestInvalidFilters(t *testing.T) {
 	client := createClient(t)
 	createFeed(t, client)
@@ -218,6 +315,44 @@ func TestInvalidFilters(t *testing.T) {
 	if err == nil {
 		t.Fatal(`Using invalid order should raise an error`)
 	}
+
+	_, err = client.Entries(&miniflux.Filter{HasEnclosure: "invalid"})
+	if err == nil {
+		t.Fatal(`Using invalid enclosure type should raise an error`)
+	}
+}
+
+func TestFilterEntriesByEnclosureType(t *testing.T) {
+	client := createClient(t)
+	createPodcastFeed(t, client)
+
+	results, err := client.Entries(&miniflux.Filter{HasEnclosure: "audio"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total == 0 {
+		t.Fatal(`The podcast feed should have entries with an audio attachment`)
+	}
+
+	for _, result := range results.Entries {
+		// Entry lists don't include the attachments.
+		entry, err := client.Entry(result.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		hasAudio := false
+		for _, enclosure := range entry.Enclosures {
+			if strings.HasPrefix(enclosure.MimeType, "audio/") {
+				hasAudio = true
+			}
+		}
+
+		if !hasAudio {
+			t.Fatalf(`The entry %d has no audio attachment`, entry.ID)
+		}
+	}
 }
 
 func TestGetEntry(t *testing.T) {
@@ -305,6 +440,130 @@ func TestToggleBookmark(t *testing.T) {
 	}
 }
 
+func TestKeepStarredEntriesWhenRemovingFeed(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	user, err := client.Me()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keep := true
+	if _, err := client.UpdateUser(user.ID, &miniflux.UserModification{KeepStarredEntries: &keep}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.FeedEntries(feed.ID, &miniflux.Filter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Entries) < 2 {
+		t.Fatal(`The feed should have at least two entries`)
+	}
+
+	starredEntry, otherEntry := result.Entries[0], result.Entries[1]
+	if err := client.ToggleBookmark(starredEntry.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteFeed(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := client.Entry(starredEntry.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !entry.Starred || entry.Content != starredEntry.Content {
+		t.Error(`The starred entry should be kept with its content`)
+	}
+
+	if entry.Feed == nil || !entry.Feed.OrphanedSaves {
+		t.Errorf(`The starred entry should belong to the orphaned saves feed, got %+v`, entry.Feed)
+	}
+
+	if _, err := client.Entry(otherEntry.ID); err == nil {
+		t.Error(`The entry that was not starred should be removed with the feed`)
+	}
+}
+
+func TestKeepStarredEntriesWhenRemovingTheOnlyCategory(t *testing.T) {
+	client := createClient(t)
+	feed, category := createFeed(t, client)
+
+	user, err := client.Me()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keep := true
+	if _, err := client.UpdateUser(user.ID, &miniflux.UserModification{KeepStarredEntries: &keep}); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.FeedEntries(feed.ID, &miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	starredEntry := result.Entries[0]
+	if err := client.ToggleBookmark(starredEntry.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteCategory(category.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := client.Entry(starredEntry.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !entry.Starred || entry.Feed == nil || !entry.Feed.OrphanedSaves || entry.Feed.Category.ID == category.ID {
+		t.Errorf(`The starred entry should be kept in a new category, got %+v`, entry.Feed)
+	}
+
+	feeds, err := client.Feeds()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feeds) != 0 {
+		t.Errorf(`The orphaned saves feed should not be listed with the feeds, got %d feeds`, len(feeds))
+	}
+}
+
+func TestUpdateReadingPosition(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.UpdateReadingPosition(result.Entries[0].ID, 0.5); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := client.Entry(result.Entries[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.ReadingPosition != 0.5 {
+		t.Fatalf(`Unexpected reading position, got %v`, entry.ReadingPosition)
+	}
+
+	if err := client.UpdateReadingPosition(result.Entries[0].ID, 2); err == nil {
+		t.Fatal(`A position greater than 1 should be rejected`)
+	}
+}
+
 func TestHistoryOrder(t *testing.T) {
 	client := createClient(t)
 	createFeed(t, client)
@@ -330,3 +589,306 @@ func TestHistoryOrder(t *testing.T) {
 		t.Fatal("The entry that we just read should be at the top of the history")
 	}
 }
+
+func TestIncrementalSync(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(time.Second)
+	changedAfter := time.Now().Unix()
+	time.Sleep(time.Second)
+
+	entryID := result.Entries[0].ID
+	if err := client.UpdateEntries([]int64{entryID}, miniflux.EntryStatusRemoved); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err := client.Entries(&miniflux.Filter{ChangedAfter: changedAfter})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if changes.Total != 1 || changes.Entries[0].ID != entryID {
+		t.Fatalf(`Only the removed entry should be returned, got %d entries`, changes.Total)
+	}
+
+	tombstones, err := client.EntryTombstones(changedAfter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tombstones) != 1 || tombstones[0] != entryID {
+		t.Fatalf(`Unexpected tombstones: %v`, tombstones)
+	}
+
+	if err := client.DeleteFeed(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tombstones, err = client.EntryTombstones(changedAfter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tombstones) != result.Total {
+		t.Fatalf(`All entries of the removed feed should have a tombstone, got %d instead of %d`, len(tombstones), result.Total)
+	}
+}
+
+func TestEntryTombstonesAfterCategoryRemoval(t *testing.T) {
+	client := createClient(t)
+	category, err := client.CreateCategory("Test Tombstones")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateFeed(testFeedURL, category.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.FeedEntries(feedID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(time.Second)
+	changedAfter := time.Now().Unix()
+	time.Sleep(time.Second)
+
+	if err := client.DeleteCategory(category.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tombstones, err := client.EntryTombstones(changedAfter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total == 0 || len(tombstones) != result.Total {
+		t.Fatalf(`All entries of the feeds of the removed category should have a tombstone, got %d instead of %d`, len(tombstones), result.Total)
+	}
+}
+
+func TestGetEntriesByIDs(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryIDs := []int64{result.Entries[0].ID, result.Entries[1].ID}
+	batch, err := client.EntriesByIDs(entryIDs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if batch.Total != 2 || len(batch.Entries) != 2 {
+		t.Fatalf(`Invalid number of entries, got %d`, batch.Total)
+	}
+
+	if batch.Entries[0].Feed == nil {
+		t.Fatal(`The entries should contain the feed`)
+	}
+
+	if _, err := client.EntriesByIDs(nil); err == nil {
+		t.Fatal(`An empty list of IDs should be rejected`)
+	}
+}
+
+func TestGetGloballyVisibleEntries(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	hideGlobally := true
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{HideGlobally: &hideGlobally}); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := client.Entries(&miniflux.Filter{Status: miniflux.EntryStatusUnread})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total == 0 {
+		t.Fatal(`Entries of hidden feeds should be returned without filter`)
+	}
+
+	results, err = client.Entries(&miniflux.Filter{Status: miniflux.EntryStatusUnread, GloballyVisible: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 0 {
+		t.Fatalf(`Entries of hidden feeds should be excluded, got %d entries`, results.Total)
+	}
+}
+
+func TestGetDigest(t *testing.T) {
+	client := createClient(t)
+	_, category := createFeed(t, client)
+
+	digest, err := client.Digest(time.Time{}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if digest.Total == 0 || len(digest.Categories) != 1 || digest.Categories[0].ID != category.ID {
+		t.Fatalf(`Unexpected digest: %+v`, digest)
+	}
+
+	if len(digest.Entries) == 0 || len(digest.Entries) > 5 {
+		t.Errorf(`Unexpected number of entries: %d`, len(digest.Entries))
+	}
+
+	digest, err = client.Digest(time.Now().Add(time.Hour), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if digest.Total != 0 || len(digest.Entries) != 0 {
+		t.Errorf(`No entry should be received in the future: %+v`, digest)
+	}
+}
+
+func TestAnnotations(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := result.Entries[0]
+	if _, err := client.CreateAnnotation(entry.ID, "", " "); err == nil {
+		t.Fatal(`An empty annotation should be rejected`)
+	}
+
+	annotation, err := client.CreateAnnotation(entry.ID, "Highlighted text", "My note")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if annotation.EntryID != entry.ID || annotation.EntryURL != entry.URL {
+		t.Fatalf(`Unexpected annotation: %+v`, annotation)
+	}
+
+	annotations, err := client.EntryAnnotations(entry.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(annotations) != 1 || annotations[0].Quote != "Highlighted text" {
+		t.Fatalf(`Unexpected annotations: %+v`, annotations)
+	}
+
+	export, err := client.ExportAnnotations()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(export), `"AnnotationCollection"`) || !strings.Contains(string(export), `"My note"`) {
+		t.Fatalf(`Unexpected export: %s`, export)
+	}
+
+	if err := client.DeleteAnnotation(annotation.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	annotations, err = client.EntryAnnotations(entry.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(annotations) != 0 {
+		t.Fatalf(`The annotation should be removed`)
+	}
+}
+
+func TestPlaybackQueue(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+	createPodcastFeed(t, client)
+
+	queue, err := client.PlaybackQueue()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queue) != 0 {
+		t.Fatalf(`The playback queue should be empty, got %d entries`, len(queue))
+	}
+
+	results, err := client.FeedEntries(feed.ID, &miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total == 0 {
+		t.Fatal(`The feed should have entries`)
+	}
+
+	if err := client.AddToPlaybackQueue(results.Entries[0].ID); err == nil {
+		t.Fatal(`An entry without audio attachment should not be queued`)
+	}
+
+	results, err = client.Entries(&miniflux.Filter{HasEnclosure: "audio", Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results.Entries) != 2 {
+		t.Fatalf(`The podcast feed should have at least two audio entries, got %d`, len(results.Entries))
+	}
+
+	first, second := results.Entries[0].ID, results.Entries[1].ID
+	for _, entryID := range []int64{first, second} {
+		if err := client.AddToPlaybackQueue(entryID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := client.ReorderPlaybackQueue([]int64{second, first}); err != nil {
+		t.Fatal(err)
+	}
+
+	queue, err = client.PlaybackQueue()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queue) != 2 {
+		t.Fatalf(`The playback queue should have 2 entries, got %d`, len(queue))
+	}
+
+	for position, entryID := range []int64{second, first} {
+		if queue[position].EntryID != entryID || queue[position].Position != position {
+			t.Fatalf(`Unexpected queue item at position %d: entry #%d at position %d`, position, queue[position].EntryID, queue[position].Position)
+		}
+
+		if queue[position].AudioURL == "" {
+			t.Fatalf(`The queued entry #%d should have an audio URL`, entryID)
+		}
+	}
+
+	if err := client.RemoveFromPlaybackQueue(second); err != nil {
+		t.Fatal(err)
+	}
+
+	queue, err = client.PlaybackQueue()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queue) != 1 || queue[0].EntryID != first || queue[0].Position != 0 {
+		t.Fatalf(`Only the entry #%d should remain in the playback queue, got %+v`, first, queue)
+	}
+}
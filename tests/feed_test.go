@@ -7,8 +7,10 @@
 package tests
 
 import (
+	"io/ioutil"
 	"strings"
 	"testing"
+	"time"
 
 	miniflux "miniflux.app/client"
 )
@@ -299,6 +301,58 @@ func TestUpdateFeedCategory(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedCustomTitle(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	customTitle := "My custom title"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{CustomTitle: &customTitle})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.CustomTitle != customTitle {
+		t.Fatalf(`Wrong custom title, got %q instead of %q`, updatedFeed.CustomTitle, customTitle)
+	}
+
+	if err := client.RefreshFeed(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	updatedFeed, err = client.Feed(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Title != testFeedTitle {
+		t.Fatalf(`The original title should be kept, got %q instead of %q`, updatedFeed.Title, testFeedTitle)
+	}
+
+	if updatedFeed.CustomTitle != customTitle {
+		t.Fatalf(`The custom title should not be overwritten, got %q`, updatedFeed.CustomTitle)
+	}
+}
+
+func TestUpdateFeedCronExpression(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	expression := "30 7 * * mon-fri"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{CronExpression: &expression})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.CronExpression != expression {
+		t.Fatalf(`Wrong cron expression, got %q instead of %q`, updatedFeed.CronExpression, expression)
+	}
+
+	expression = "every day"
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{CronExpression: &expression}); err == nil {
+		t.Fatal(`Invalid cron expressions should be rejected`)
+	}
+}
+
 func TestDeleteFeed(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
@@ -315,6 +369,43 @@ func TestRefreshFeed(t *testing.T) {
 	}
 }
 
+func TestRefreshFeedJob(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	job, err := client.StartFeedRefresh(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if job.ID == "" {
+		t.Fatal(`The job ID should not be empty`)
+	}
+
+	if len(job.FeedIDs) != 1 || job.FeedIDs[0] != feed.ID {
+		t.Fatalf(`Invalid job feed IDs, got %v`, job.FeedIDs)
+	}
+
+	for i := 0; i < 30 && job.FinishedAt == nil; i++ {
+		time.Sleep(time.Second)
+		if job, err = client.RefreshJob(job.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if job.Status != miniflux.RefreshJobStatusDone {
+		t.Fatalf(`Invalid job status, got %q with errors %v`, job.Status, job.Errors)
+	}
+
+	if job.Completed != 1 {
+		t.Fatalf(`Invalid number of completed feeds, got %d`, job.Completed)
+	}
+
+	if _, err := client.RefreshJob("invalid"); err != miniflux.ErrNotFound {
+		t.Fatal(`Unknown jobs should return a 404`)
+	}
+}
+
 func TestGetFeed(t *testing.T) {
 	client := createClient(t)
 	feed, category := createFeed(t, client)
@@ -372,6 +463,42 @@ func TestGetFeedIconNotFound(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedIcon(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	svg := `<svg xmlns="http://www.w3.org/2000/svg" width="16" height="16"><rect width="16" height="16"/></svg>`
+	feedIcon, err := client.UpdateFeedIcon(feed.ID, ioutil.NopCloser(strings.NewReader(svg)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feedIcon.FeedID != feed.ID || feedIcon.MimeType != "image/svg+xml" {
+		t.Fatalf(`Invalid feed icon, got %+v`, feedIcon)
+	}
+
+	feedIcons, err := client.FeedIcons()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feedIcons) != 1 || feedIcons[0].ID != feedIcon.ID {
+		t.Fatalf(`Invalid feed icons, got %+v`, feedIcons)
+	}
+
+	if _, err := client.UpdateFeedIcon(feed.ID, ioutil.NopCloser(strings.NewReader("not an image"))); err == nil {
+		t.Fatal(`Uploading something else than an image should be rejected`)
+	}
+
+	if err := client.DeleteFeedIcon(feed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.FeedIcon(feed.ID); err != miniflux.ErrNotFound {
+		t.Fatal(`The feed icon should be removed`)
+	}
+}
+
 func TestGetFeeds(t *testing.T) {
 	client := createClient(t)
 	feed, category := createFeed(t, client)
@@ -413,3 +540,91 @@ func TestGetFeeds(t *testing.T) {
 		t.Fatalf(`Invalid feed category title, got "%v" instead of "%v"`, feeds[0].Category.Title, category.Title)
 	}
 }
+
+func TestFetchCounters(t *testing.T) {
+	client := createClient(t)
+	feed, category := createFeed(t, client)
+
+	results, err := client.FeedEntries(feed.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counters, err := client.FetchCounters()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if counters.UnreadCounters[feed.ID] != results.Total {
+		t.Fatalf(`Invalid unread counter, got %d instead of %d`, counters.UnreadCounters[feed.ID], results.Total)
+	}
+
+	if counters.CategoryUnreadCounters[category.ID] != results.Total {
+		t.Fatalf(`Invalid category unread counter, got %d instead of %d`, counters.CategoryUnreadCounters[category.ID], results.Total)
+	}
+
+	if counters.ReadCounters[feed.ID] != 0 {
+		t.Fatalf(`Invalid read counter, got %d`, counters.ReadCounters[feed.ID])
+	}
+}
+
+func TestGetFeedEntryCounts(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	entryCounts, err := client.FeedEntryCounts(feed.ID, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entryCounts) != 30 {
+		t.Fatalf(`Invalid number of days, got %d instead of 30`, len(entryCounts))
+	}
+
+	if _, err := client.FeedEntryCounts(feed.ID, 0); err == nil {
+		t.Fatal(`An invalid number of days should be rejected`)
+	}
+}
+
+func TestMoveFeeds(t *testing.T) {
+	client := createClient(t)
+	feed, category := createFeed(t, client)
+
+	target, err := client.CreateCategory("Move Target")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherClient := createClient(t)
+	otherFeed, _ := createFeed(t, otherClient)
+
+	moved, err := client.MoveFeeds([]int64{feed.ID, otherFeed.ID}, target.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if moved != 1 {
+		t.Errorf(`Only the feed of the user should be moved, got %d`, moved)
+	}
+
+	updatedFeed, err := client.Feed(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Category.ID != target.ID {
+		t.Errorf(`The feed should be in the category %d instead of %d`, target.ID, updatedFeed.Category.ID)
+	}
+
+	if moved, err := client.MoveFeeds([]int64{feed.ID}, target.ID); err != nil || moved != 0 {
+		t.Errorf(`A feed already in the category should not be moved again, got %d (%v)`, moved, err)
+	}
+
+	if _, err := client.MoveFeeds([]int64{feed.ID}, category.ID+1000000); err == nil {
+		t.Error(`Moving feeds to an unknown category should fail`)
+	}
+
+	if _, err := client.MoveFeeds(make([]int64, 101), target.ID); err == nil {
+		t.Error(`Moving too many feeds at once should fail`)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestUpdateIntegration(t *testing.T) {
+	client := createClient(t)
+
+	integration, err := client.Integration()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if integration.WallabagEnabled {
+		t.Fatal(`Integrations should be disabled by default`)
+	}
+
+	enabled := true
+	wallabagURL := "https://wallabag.example.org/"
+	integration, err = client.UpdateIntegration(&miniflux.IntegrationModification{WallabagEnabled: &enabled, WallabagURL: &wallabagURL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !integration.WallabagEnabled || integration.WallabagURL != wallabagURL {
+		t.Fatalf(`Wallabag settings not updated: %+v`, integration)
+	}
+
+	pinboardTags := "miniflux"
+	integration, err = client.UpdateIntegration(&miniflux.IntegrationModification{PinboardTags: &pinboardTags})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !integration.WallabagEnabled || integration.PinboardTags != pinboardTags {
+		t.Fatalf(`Settings missing from the payload should be kept: %+v`, integration)
+	}
+
+	if err := client.ResetIntegration(); err != nil {
+		t.Fatal(err)
+	}
+
+	integration, err = client.Integration()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if integration.WallabagEnabled || integration.PinboardTags != "" {
+		t.Fatalf(`Settings should be removed: %+v`, integration)
+	}
+}
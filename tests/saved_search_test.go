@@ -0,0 +1,128 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCreateSavedSearchWithFeedScope(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	savedSearch, err := client.CreateSavedSearch("miniflux", feed.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if savedSearch.ID == 0 {
+		t.Fatalf(`Invalid saved search ID, got "%v"`, savedSearch.ID)
+	}
+
+	if savedSearch.Query != "miniflux" {
+		t.Fatalf(`Invalid query, got "%v"`, savedSearch.Query)
+	}
+
+	if savedSearch.FeedID != feed.ID {
+		t.Fatalf(`Invalid feed ID, got "%v" instead of "%v"`, savedSearch.FeedID, feed.ID)
+	}
+
+	if savedSearch.FeedTitle != feed.Title {
+		t.Fatalf(`Invalid feed title, got "%v" instead of "%v"`, savedSearch.FeedTitle, feed.Title)
+	}
+}
+
+func TestCreateDuplicatedSavedSearch(t *testing.T) {
+	client := createClient(t)
+	feed, category := createFeed(t, client)
+
+	savedSearch, err := client.CreateSavedSearch("miniflux", feed.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	duplicatedSearch, err := client.CreateSavedSearch("  miniflux  ", feed.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if duplicatedSearch.ID != savedSearch.ID {
+		t.Fatalf(`The same search should be saved once, got "%v" and "%v"`, savedSearch.ID, duplicatedSearch.ID)
+	}
+
+	otherScopeSearch, err := client.CreateSavedSearch("miniflux", 0, category.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if otherScopeSearch.ID == savedSearch.ID {
+		t.Fatal(`The same query with another scope should be saved separately`)
+	}
+}
+
+func TestCreateSavedSearchWithTooLongQuery(t *testing.T) {
+	client := createClient(t)
+	_, err := client.CreateSavedSearch(strings.Repeat("a", 256), 0, 0)
+	if err == nil {
+		t.Fatal(`Too long queries should not be saved`)
+	}
+}
+
+func TestCreateSavedSearchWithUnknownFeed(t *testing.T) {
+	client := createClient(t)
+	_, err := client.CreateSavedSearch("miniflux", 123456789, 0)
+	if err == nil {
+		t.Fatal(`The scope of the saved search should belong to the user`)
+	}
+}
+
+func TestGetSavedSearches(t *testing.T) {
+	client := createClient(t)
+	savedSearch, err := client.CreateSavedSearch("miniflux", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	savedSearches, err := client.SavedSearches()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(savedSearches) != 1 {
+		t.Fatalf(`Invalid number of saved searches, got "%v"`, len(savedSearches))
+	}
+
+	if savedSearches[0].ID != savedSearch.ID {
+		t.Fatalf(`Invalid saved search ID, got "%v" instead of "%v"`, savedSearches[0].ID, savedSearch.ID)
+	}
+}
+
+func TestDeleteSavedSearch(t *testing.T) {
+	client := createClient(t)
+	savedSearch, err := client.CreateSavedSearch("miniflux", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteSavedSearch(savedSearch.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteSavedSearch(savedSearch.ID); err == nil {
+		t.Fatal(`Removing an unknown saved search should fail`)
+	}
+
+	savedSearches, err := client.SavedSearches()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(savedSearches) != 0 {
+		t.Fatalf(`Invalid number of saved searches, got "%v"`, len(savedSearches))
+	}
+}
@@ -23,6 +23,7 @@ const (
 	testFeedTitle         = "Miniflux"
 	testSubscriptionTitle = "Miniflux Releases"
 	testWebsiteURL        = "https://miniflux.app/"
+	testPodcastFeedURL    = "https://changelog.com/gotime/feed"
 )
 
 func getRandomUsername() string {
@@ -46,12 +47,21 @@ func createClient(t *testing.T) *miniflux.Client {
 }
 
 func createFeed(t *testing.T, client *miniflux.Client) (*miniflux.Feed, *miniflux.Category) {
+	return subscribeToFeed(t, client, testFeedURL)
+}
+
+// createPodcastFeed subscribes to a feed whose entries have audio attachments.
+func createPodcastFeed(t *testing.T, client *miniflux.Client) (*miniflux.Feed, *miniflux.Category) {
+	return subscribeToFeed(t, client, testPodcastFeedURL)
+}
+
+func subscribeToFeed(t *testing.T, client *miniflux.Client, feedURL string) (*miniflux.Feed, *miniflux.Category) {
 	categories, err := client.Categories()
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	feedID, err := client.CreateFeed(testFeedURL, categories[0].ID)
+	feedID, err := client.CreateFeed(feedURL, categories[0].ID)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -38,6 +38,10 @@ func TestGetCurrentLoggedUser(t *testing.T) {
 	if user.Username != testAdminUsername {
 		t.Fatalf(`Invalid username, got %q`, user.Username)
 	}
+
+	if user.Features == nil || user.Features.SearchBackend == "" {
+		t.Fatalf(`The features should be returned, got %+v`, user.Features)
+	}
 }
 
 func TestGetUsers(t *testing.T) {
@@ -286,6 +290,78 @@ func TestUpdateUserThemeWithInvalidValue(t *testing.T) {
 	}
 }
 
+func TestUpdateUserHomePage(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	user, err := client.CreateUser(username, testStandardPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.HomePage != "unread" {
+		t.Fatalf(`Unexpected default home page: %q`, user.HomePage)
+	}
+
+	homePage := "search:golang"
+	user, err = client.UpdateUser(user.ID, &miniflux.UserModification{HomePage: &homePage})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.HomePage != homePage {
+		t.Fatalf(`Unable to update user home page: got %q instead of %q`, user.HomePage, homePage)
+	}
+
+	homePage = "settings"
+	if _, err = client.UpdateUser(user.ID, &miniflux.UserModification{HomePage: &homePage}); err == nil {
+		t.Fatal(`Updating a user home page with an invalid value should raise an error`)
+	}
+}
+
+func TestUserPreferences(t *testing.T) {
+	username := getRandomUsername()
+	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	if _, err := client.CreateUser(username, testStandardPassword, false); err != nil {
+		t.Fatal(err)
+	}
+
+	client = miniflux.New(testBaseURL, username, testStandardPassword)
+	preferences, err := client.UpdatePreferences("com.example.reader", map[string]interface{}{"font_size": 16, "theme": "dark"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(preferences["font_size"]) != "16" || string(preferences["theme"]) != `"dark"` {
+		t.Fatalf(`Unexpected preferences: %v`, preferences)
+	}
+
+	preferences, err = client.UpdatePreferences("com.example.reader", map[string]interface{}{"theme": nil})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found := preferences["theme"]; found || len(preferences) != 1 {
+		t.Fatalf(`The theme preference should have been removed: %v`, preferences)
+	}
+
+	if err := client.DeletePreferences("com.example.reader"); err != nil {
+		t.Fatal(err)
+	}
+
+	preferences, err = client.Preferences("com.example.reader")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(preferences) != 0 {
+		t.Fatalf(`The namespace should be empty: %v`, preferences)
+	}
+
+	if _, err := client.UpdatePreferences("invalid namespace", map[string]interface{}{"key": 1}); err == nil {
+		t.Fatal(`Invalid namespaces should be rejected`)
+	}
+}
+
 func TestCannotCreateDuplicateUser(t *testing.T) {
 	username := getRandomUsername()
 	client := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
@@ -394,3 +470,38 @@ func TestCannotDeleteUserAsNonAdmin(t *testing.T) {
 		t.Fatal(`A "Forbidden" error should be raised`)
 	}
 }
+
+func TestDatabaseReport(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	me, err := client.Me()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.DatabaseReport(); err == nil {
+		t.Fatal(`Standard users should not be able to see the reports`)
+	}
+
+	admin := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	report, err := admin.DatabaseReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.DatabaseSize == 0 || report.StorageBytes > report.DatabaseSize {
+		t.Errorf(`Unexpected sizes: %+v`, report)
+	}
+
+	for _, user := range report.Users {
+		if user.UserID == me.ID {
+			if user.FeedCount != 1 || user.EntryCount == 0 || user.StorageBytes == 0 {
+				t.Errorf(`Unexpected user report: %+v`, user)
+			}
+			return
+		}
+	}
+
+	t.Errorf(`The user is missing from the report`)
+}
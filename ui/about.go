@@ -7,8 +7,10 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/model"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 	"miniflux.app/version"
@@ -21,10 +23,17 @@ func (h *handler) showAboutPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	integration, err := h.store.Integration(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("version", version.Version)
 	view.Set("build_date", version.BuildDate)
+	view.Set("features", model.NewFeatures(config.Opts.ProxyImages(), integration))
 	view.Set("menu", "settings")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -0,0 +1,33 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+)
+
+func (h *handler) removeBlockedFeed(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	if err := h.store.RemoveBlockedFeed(request.RouteInt64Param(r, "blockedFeedID")); err != nil {
+		logger.Error("[UI:RemoveBlockedFeed] %v", err)
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "blockedFeeds"))
+}
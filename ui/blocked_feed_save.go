@@ -0,0 +1,82 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) saveBlockedFeed(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	blockedFeeds, err := h.store.BlockedFeeds()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	blockedFeedForm := form.NewBlockedFeedForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	blockedFeeds.UseTimezone(user.Timezone)
+	view.Set("blockedFeeds", blockedFeeds)
+	view.Set("form", blockedFeedForm)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	if err := blockedFeedForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("blocked_feeds"))
+		return
+	}
+
+	if h.store.BlockedFeedExists(blockedFeedForm.Pattern) {
+		view.Set("errorMessage", "error.blocked_feed_already_exists")
+		html.OK(w, r, view.Render("blocked_feeds"))
+		return
+	}
+
+	blockedFeed := &model.BlockedFeed{
+		Pattern: blockedFeedForm.Pattern,
+		Reason:  blockedFeedForm.Reason,
+	}
+
+	if err := h.store.CreateBlockedFeed(blockedFeed); err != nil {
+		logger.Error("[UI:SaveBlockedFeed] %v", err)
+		view.Set("errorMessage", "error.unable_to_block_feed")
+		html.OK(w, r, view.Render("blocked_feeds"))
+		return
+	}
+
+	count, err := h.feedHandler.DisableBlockedFeeds(blockedFeed)
+	if err != nil {
+		logger.Error("[UI:SaveBlockedFeed] %v", err)
+	}
+
+	sess.NewFlashMessage(locale.NewPrinter(user.Language).Plural("alert.blocked_feed_created", count, count))
+	html.Redirect(w, r, route.Path(h.router, "blockedFeeds"))
+}
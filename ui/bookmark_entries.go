@@ -54,6 +54,7 @@ func (h *handler) showStarredPage(w http.ResponseWriter, r *http.Request) {
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	view.Set("hasSaveEntry", h.store.HasSaveEntry(user.ID))
+	view.Set("promptSaveEntryTags", h.store.HasSaveEntryTagsPrompt(user.ID))
 
 	html.OK(w, r, view.Render("bookmark_entries"))
 }
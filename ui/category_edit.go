@@ -40,8 +40,22 @@ func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
 		Title: category.Title,
 	}
 
+	members, err := h.store.CategoryMembers(category.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sharedBy, err := h.store.CategorySharedBy(user.ID, category.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	view.Set("form", categoryForm)
 	view.Set("category", category)
+	view.Set("members", members)
+	view.Set("sharedBy", sharedBy)
 	view.Set("menu", "categories")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
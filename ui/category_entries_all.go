@@ -66,6 +66,7 @@ func (h *handler) showCategoryEntriesAllPage(w http.ResponseWriter, r *http.Requ
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	view.Set("hasSaveEntry", h.store.HasSaveEntry(user.ID))
+	view.Set("promptSaveEntryTags", h.store.HasSaveEntryTagsPrompt(user.ID))
 	view.Set("showOnlyUnreadEntries", false)
 
 	html.OK(w, r, view.Render("category_entries"))
@@ -9,6 +9,7 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/model"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 )
@@ -32,21 +33,45 @@ func (h *handler) showCategoryFeedsPage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	feeds, err := h.store.FeedsByCategoryWithCounters(user.ID, categoryID)
+	var feeds model.Feeds
+	var categories model.Categories
+	var countUnread, countErrorFeeds int
+	err = h.store.RunParallel(
+		func() (err error) {
+			feeds, err = h.store.FeedsByCategoryWithCounters(user.ID, categoryID)
+			return err
+		},
+		func() (err error) {
+			categories, err = h.store.Categories(user.ID)
+			return err
+		},
+		func() error {
+			countUnread, countErrorFeeds = h.store.NavigationCounters(user.ID)
+			return nil
+		},
+	)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	otherCategories := make(model.Categories, 0, len(categories))
+	for _, c := range categories {
+		if c.ID != category.ID {
+			otherCategories = append(otherCategories, c)
+		}
+	}
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("category", category)
+	view.Set("categories", otherCategories)
 	view.Set("feeds", feeds)
 	view.Set("total", len(feeds))
 	view.Set("menu", "categories")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+	view.Set("countUnread", countUnread)
+	view.Set("countErrorFeeds", countErrorFeeds)
 
 	html.OK(w, r, view.Render("category_feeds"))
 }
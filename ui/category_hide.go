@@ -0,0 +1,88 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showHiddenCategoriesPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	var categories model.Categories
+	var countUnread, countErrorFeeds int
+	err = h.store.RunParallel(
+		func() (err error) {
+			categories, err = h.store.CategoriesWithFeedCount(user.ID)
+			return err
+		},
+		func() error {
+			countUnread, countErrorFeeds = h.store.NavigationCounters(user.ID)
+			return nil
+		},
+	)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categories = categories.Hidden()
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("categories", categories)
+	view.Set("total", len(categories))
+	view.Set("menu", "categories")
+	view.Set("user", user)
+	view.Set("countUnread", countUnread)
+	view.Set("countErrorFeeds", countErrorFeeds)
+
+	html.OK(w, r, view.Render("hidden_categories"))
+}
+
+func (h *handler) hideCategory(w http.ResponseWriter, r *http.Request) {
+	h.setCategoryHidden(w, r, true)
+}
+
+func (h *handler) unhideCategory(w http.ResponseWriter, r *http.Request) {
+	h.setCategoryHidden(w, r, false)
+}
+
+func (h *handler) setCategoryHidden(w http.ResponseWriter, r *http.Request, hidden bool) {
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	category, err := h.store.Category(request.UserID(r), categoryID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	category.Hidden = hidden
+	if err := h.store.UpdateCategory(category); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if hidden {
+		html.Redirect(w, r, route.Path(h.router, "categories"))
+	} else {
+		html.Redirect(w, r, route.Path(h.router, "hiddenCategories"))
+	}
+}
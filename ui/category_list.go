@@ -9,6 +9,7 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/model"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 )
@@ -20,20 +21,41 @@ func (h *handler) showCategoryListPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	categories, err := h.store.CategoriesWithFeedCount(user.ID)
+	var categories model.Categories
+	var invitations model.CategoryInvitations
+	var countUnread, countErrorFeeds int
+	err = h.store.RunParallel(
+		func() (err error) {
+			categories, err = h.store.CategoriesWithFeedCount(user.ID)
+			return err
+		},
+		func() (err error) {
+			invitations, err = h.store.CategoryInvitations(user.ID)
+			return err
+		},
+		func() error {
+			countUnread, countErrorFeeds = h.store.NavigationCounters(user.ID)
+			return nil
+		},
+	)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	countHidden := len(categories.Hidden())
+	categories = categories.Visible()
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("categories", categories)
+	view.Set("invitations", invitations)
 	view.Set("total", len(categories))
+	view.Set("countHidden", countHidden)
 	view.Set("menu", "categories")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+	view.Set("countUnread", countUnread)
+	view.Set("countErrorFeeds", countErrorFeeds)
 
 	html.OK(w, r, view.Render("categories"))
 }
@@ -0,0 +1,121 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/ui/session"
+)
+
+func (h *handler) addCategoryMember(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	category, err := h.store.Category(user.ID, categoryID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	printer := locale.NewPrinter(user.Language)
+	editCategoryURL := route.Path(h.router, "editCategory", "categoryID", category.ID)
+
+	// The copy of a shared category cannot be shared again, its feeds are never fetched.
+	sharedBy, err := h.store.CategorySharedBy(user.ID, category.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if sharedBy != "" {
+		sess.NewFlashErrorMessage(printer.Printf("error.category_shared_with_you", sharedBy))
+		html.Redirect(w, r, editCategoryURL)
+		return
+	}
+
+	member, err := h.store.UserByUsername(strings.TrimSpace(r.FormValue("username")))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if member == nil || member.ID == user.ID {
+		sess.NewFlashErrorMessage(printer.Printf("error.category_member_not_found"))
+		html.Redirect(w, r, editCategoryURL)
+		return
+	}
+
+	if err := h.store.AddCategoryMember(category, member.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess.NewFlashMessage(printer.Printf("alert.category_invitation_sent", member.Username))
+	html.Redirect(w, r, editCategoryURL)
+}
+
+func (h *handler) removeCategoryMember(w http.ResponseWriter, r *http.Request) {
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	if !h.store.CategoryExists(request.UserID(r), categoryID) {
+		html.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveCategoryMember(categoryID, request.RouteInt64Param(r, "userID")); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "editCategory", "categoryID", categoryID))
+}
+
+func (h *handler) acceptCategoryInvitation(w http.ResponseWriter, r *http.Request) {
+	if err := h.store.AcceptCategoryInvitation(request.UserID(r), request.RouteInt64Param(r, "categoryID")); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "categories"))
+}
+
+func (h *handler) declineCategoryInvitation(w http.ResponseWriter, r *http.Request) {
+	if err := h.store.DeclineCategoryInvitation(request.UserID(r), request.RouteInt64Param(r, "categoryID")); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "categories"))
+}
+
+func (h *handler) leaveSharedCategory(w http.ResponseWriter, r *http.Request) {
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	if !h.store.CategoryExists(request.UserID(r), categoryID) {
+		html.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.LeaveSharedCategory(request.UserID(r), categoryID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "editCategory", "categoryID", categoryID))
+}
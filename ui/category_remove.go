@@ -10,9 +10,14 @@ import (
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
 )
 
-func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
+func (h *handler) showRemoveCategoryPage(w http.ResponseWriter, r *http.Request) {
 	user, err := h.store.UserByID(request.UserID(r))
 	if err != nil {
 		html.ServerError(w, r, err)
@@ -20,7 +25,7 @@ func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
 	}
 
 	categoryID := request.RouteInt64Param(r, "categoryID")
-	category, err := h.store.Category(request.UserID(r), categoryID)
+	category, otherCategories, err := h.removableCategory(user.ID, categoryID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -31,10 +36,93 @@ func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.store.RemoveCategory(user.ID, category.ID); err != nil {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("category", category)
+	view.Set("categories", otherCategories)
+	view.Set("targetCategoryID", int64(0))
+	view.Set("menu", "categories")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("remove_category"))
+}
+
+func (h *handler) removeCategory(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
-	html.Redirect(w, r, route.Path(h.router, "categories"))
+	categoryID := request.RouteInt64Param(r, "categoryID")
+	category, otherCategories, err := h.removableCategory(user.ID, categoryID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	// Empty categories are removed right away, the others must give their feeds to another category.
+	if category.FeedCount == 0 {
+		if err := h.store.RemoveCategory(user.ID, category.ID); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		html.Redirect(w, r, route.Path(h.router, "categories"))
+		return
+	}
+
+	removeForm := form.NewCategoryRemoveForm(r, category.ID)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("category", category)
+	view.Set("categories", otherCategories)
+	view.Set("targetCategoryID", removeForm.TargetCategoryID)
+	view.Set("menu", "categories")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	if err := removeForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("remove_category"))
+		return
+	}
+
+	if err := h.store.RemoveCategoryAndMoveFeeds(user.ID, category.ID, removeForm.TargetCategoryID); err != nil {
+		logger.Error("[UI:RemoveCategory] %v", err)
+		view.Set("errorMessage", "error.unable_to_remove_category")
+		html.OK(w, r, view.Render("remove_category"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "categoryFeeds", "categoryID", removeForm.TargetCategoryID))
+}
+
+// removableCategory returns the category with its feed count and the other categories of the user.
+func (h *handler) removableCategory(userID, categoryID int64) (*model.Category, model.Categories, error) {
+	categories, err := h.store.CategoriesWithFeedCount(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var category *model.Category
+	var otherCategories model.Categories
+	for _, c := range categories {
+		if c.ID == categoryID {
+			category = c
+		} else {
+			otherCategories = append(otherCategories, c)
+		}
+	}
+
+	return category, otherCategories, nil
 }
@@ -37,10 +37,24 @@ func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 
 	categoryForm := form.NewCategoryForm(r)
 
+	members, err := h.store.CategoryMembers(category.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sharedBy, err := h.store.CategorySharedBy(user.ID, category.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", categoryForm)
 	view.Set("category", category)
+	view.Set("members", members)
+	view.Set("sharedBy", sharedBy)
 	view.Set("menu", "categories")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
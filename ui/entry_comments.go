@@ -0,0 +1,47 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+)
+
+func (h *handler) followEntryComments(w http.ResponseWriter, r *http.Request) {
+	subscription, err := h.store.FollowEntryComments(
+		request.UserID(r),
+		request.RouteInt64Param(r, "entryID"),
+		config.Opts.CommentsFollowDays(),
+	)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if subscription == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	// The existing comments are shown right away, the scheduler fetches the new ones afterwards.
+	if err := h.feedHandler.RefreshEntryComments(subscription); err != nil {
+		logger.Error("[UI:FollowEntryComments] Entry #%d: %v", subscription.EntryID, err)
+	}
+
+	json.OK(w, r, map[string]int{"days": subscription.RemainingDays()})
+}
+
+func (h *handler) unfollowEntryComments(w http.ResponseWriter, r *http.Request) {
+	if err := h.store.UnfollowEntryComments(request.UserID(r), request.RouteInt64Param(r, "entryID")); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
@@ -0,0 +1,177 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"bytes"
+	"net/http"
+	"text/template"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/mail"
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+const entryEmailExcerptLength = 500
+
+var entryEmailTemplate = template.Must(template.New("entry_email").Parse(`{{ .Title }}
+{{ .URL }}
+{{ if .Note }}
+{{ .Note }}
+{{ end }}{{ if .Excerpt }}
+{{ .Excerpt }}
+{{ end }}
+-- 
+{{ .Signature }}
+`))
+
+func (h *handler) showEntryEmailPage(w http.ResponseWriter, r *http.Request) {
+	if !config.Opts.HasEmailSharing() {
+		html.NotFound(w, r)
+		return
+	}
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	entry, err := h.entryToShare(r, user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", &form.EntryEmailForm{Recipients: user.EmailRecipients})
+	view.Set("entry", entry)
+	view.Set("menu", "unread")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("entry_email"))
+}
+
+func (h *handler) sendEntryByEmail(w http.ResponseWriter, r *http.Request) {
+	if !config.Opts.HasEmailSharing() {
+		html.NotFound(w, r)
+		return
+	}
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	entry, err := h.entryToShare(r, user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	entryEmailForm := form.NewEntryEmailForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", entryEmailForm)
+	view.Set("entry", entry)
+	view.Set("menu", "unread")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	if err := entryEmailForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("entry_email"))
+		return
+	}
+
+	recipients := entryEmailForm.RecipientList()
+	sentRecipients, err := h.store.CountSentEmailRecipientsSince(user.ID, time.Now().Add(-time.Hour))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if sentRecipients+len(recipients) > config.Opts.SMTPMaxRecipientsPerHour() {
+		view.Set("errorMessage", "error.too_many_sent_emails")
+		html.OK(w, r, view.Render("entry_email"))
+		return
+	}
+
+	printer := locale.NewPrinter(user.Language)
+	body, err := entryEmailBody(entry, entryEmailForm.Note, printer.Printf("email.entry.signature", user.Username, entry.Feed.DisplayTitle()))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	client := mail.NewClient(
+		config.Opts.SMTPHost(),
+		config.Opts.SMTPPort(),
+		config.Opts.SMTPUsername(),
+		config.Opts.SMTPPassword(),
+		config.Opts.SMTPFrom(),
+	)
+
+	if err := client.Send(recipients, entry.Title, body); err != nil {
+		logger.Error("[UI:SendEntryByEmail] %v", err)
+		view.Set("errorMessage", "error.unable_to_send_email")
+		html.OK(w, r, view.Render("entry_email"))
+		return
+	}
+
+	if err := h.store.CreateSentEmail(user.ID, len(recipients)); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess.NewFlashMessage(printer.Printf("alert.entry_sent_by_email"))
+	html.Redirect(w, r, route.Path(h.router, "feedEntry", "feedID", entry.FeedID, "entryID", entry.ID))
+}
+
+func (h *handler) entryToShare(r *http.Request, userID int64) (*model.Entry, error) {
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(request.RouteInt64Param(r, "entryID"))
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	return builder.GetEntry()
+}
+
+func entryEmailBody(entry *model.Entry, note, signature string) (string, error) {
+	var buffer bytes.Buffer
+	err := entryEmailTemplate.Execute(&buffer, map[string]string{
+		"Title":     entry.Title,
+		"URL":       entry.URL,
+		"Note":      note,
+		"Excerpt":   sanitizer.Excerpt(entry.Content, entryEmailExcerptLength),
+		"Signature": signature,
+	})
+
+	return buffer.String(), err
+}
@@ -13,7 +13,15 @@ import (
 	"miniflux.app/model"
 )
 
+const maxSaveEntriesBatchSize = 100
+
 func (h *handler) saveEntry(w http.ResponseWriter, r *http.Request) {
+	tags, err := decodeSaveEntryPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
 	entryID := request.RouteInt64Param(r, "entryID")
 	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
 	builder.WithEntryID(entryID)
@@ -37,8 +45,45 @@ func (h *handler) saveEntry(w http.ResponseWriter, r *http.Request) {
 	}
 
 	go func() {
-		integration.SendEntry(entry, settings)
+		integration.SendEntryWithTags(entry, settings, tags)
+		integration.ArchiveEntries(h.store, model.Entries{entry}, settings)
 	}()
 
 	json.Created(w, r, map[string]string{"message": "saved"})
 }
+
+func (h *handler) saveEntries(w http.ResponseWriter, r *http.Request) {
+	entryIDs, tags, err := decodeSaveEntriesPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithEntryIDs(entryIDs)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if len(entries) == 0 {
+		json.NotFound(w, r)
+		return
+	}
+
+	settings, err := h.store.Integration(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	go func() {
+		integration.SendEntriesWithTags(entries, settings, tags)
+		integration.ArchiveEntries(h.store, entries, settings)
+	}()
+
+	json.Created(w, r, map[string]int{"saved": len(entries)})
+}
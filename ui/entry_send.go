@@ -0,0 +1,114 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showEntrySendPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	entry, err := h.entryToShare(r, user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", &form.EntrySendForm{})
+	view.Set("entry", entry)
+	view.Set("menu", "unread")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("entry_send"))
+}
+
+func (h *handler) sendEntryToUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	entry, err := h.entryToShare(r, user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	entrySendForm := form.NewEntrySendForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", entrySendForm)
+	view.Set("entry", entry)
+	view.Set("menu", "unread")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	if err := entrySendForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("entry_send"))
+		return
+	}
+
+	sentEntries, err := h.store.CountSentEntriesSince(user.ID, time.Now().Add(-time.Hour))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if sentEntries >= model.MaxSentEntriesPerHour {
+		view.Set("errorMessage", "error.too_many_sent_entries")
+		html.OK(w, r, view.Render("entry_send"))
+		return
+	}
+
+	recipient, err := h.store.UserByUsername(entrySendForm.Username)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	// Unknown users and users who do not accept entries get the same response, the usernames are not disclosed.
+	if recipient != nil && recipient.ID != user.ID && recipient.AcceptReceivedEntries {
+		if err := h.store.CreateReceivedEntry(model.NewReceivedEntry(entry, recipient.ID, entrySendForm.Message)); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
+	sess.NewFlashMessage(locale.NewPrinter(user.Language).Printf("alert.entry_sent_to_user", entrySendForm.Username))
+	html.Redirect(w, r, route.Path(h.router, "feedEntry", "feedID", entry.FeedID, "entryID", entry.ID))
+}
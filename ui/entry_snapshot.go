@@ -0,0 +1,72 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"strconv"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/response/json"
+	"miniflux.app/http/route"
+	"miniflux.app/model"
+	"miniflux.app/reader/processor"
+)
+
+// The copy may contain anything found on the original page, scripts and external requests are blocked.
+const snapshotContentSecurityPolicy = "default-src 'none'; img-src data: *; media-src *; style-src 'unsafe-inline' data:; font-src data:; sandbox allow-popups allow-popups-to-escape-sandbox"
+
+func (h *handler) archiveEntryWebPage(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := processor.ArchiveEntryWebPage(h.store, entry.Feed, entry); err != nil {
+		if err == processor.ErrSnapshotQuotaExceeded {
+			json.BadRequest(w, r, err)
+			return
+		}
+
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, map[string]string{"url": route.Path(h.router, "entrySnapshot", "entryID", entry.ID)})
+}
+
+func (h *handler) showEntrySnapshot(w http.ResponseWriter, r *http.Request) {
+	snapshot, err := h.store.EntrySnapshot(request.UserID(r), request.RouteInt64Param(r, "entryID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if snapshot == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	etag := strconv.FormatInt(snapshot.CreatedAt.UnixNano(), 10)
+	response.New(w, r).WithPrivateCaching(etag, func(b *response.Builder) {
+		b.WithHeader("Content-Type", "text/html; charset=utf-8")
+		b.WithHeader("Content-Security-Policy", snapshotContentSecurityPolicy)
+		b.WithBody(snapshot.Content)
+		b.Write()
+	})
+}
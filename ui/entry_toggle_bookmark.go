@@ -9,14 +9,38 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/integration"
+	"miniflux.app/logger"
+	"miniflux.app/model"
 )
 
 func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
 	entryID := request.RouteInt64Param(r, "entryID")
-	if err := h.store.ToggleBookmark(request.UserID(r), entryID); err != nil {
+	if err := h.store.ToggleBookmark(userID, entryID); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
+	h.sendStarredEntry(userID, entryID)
 	json.OK(w, r, "OK")
 }
+
+// sendStarredEntry exports the entry to the integrations interested in starred entries.
+func (h *handler) sendStarredEntry(userID, entryID int64) {
+	entry, err := h.store.NewEntryQueryBuilder(userID).WithEntryID(entryID).GetEntry()
+	if err != nil || entry == nil || !entry.Starred {
+		return
+	}
+
+	settings, err := h.store.Integration(userID)
+	if err != nil {
+		logger.Error("[UI:ToggleBookmark] %v", err)
+		return
+	}
+
+	go func() {
+		integration.SendStarredEntry(entry, settings)
+		integration.ArchiveEntries(h.store, model.Entries{entry}, settings)
+	}()
+}
@@ -7,6 +7,7 @@ package ui // import "miniflux.app/ui"
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
@@ -24,11 +25,16 @@ func (h *handler) updateEntriesStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.store.SetEntriesStatus(request.UserID(r), entryIDs, status)
+	userID := request.UserID(r)
+	err = h.store.SetEntriesStatus(userID, entryIDs, status)
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
-	json.OK(w, r, "OK")
+	// The other tabs of the browser use the server time to ignore outdated changes.
+	json.OK(w, r, &entriesStatusResponse{
+		ChangedAt: time.Now().UnixNano() / int64(time.Millisecond),
+		Unread:    h.store.CountUnreadEntries(userID),
+	})
 }
@@ -0,0 +1,44 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+)
+
+func (h *handler) applyCanonicalFeedURL(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	feedID := request.RouteInt64Param(r, "feedID")
+
+	feed, err := h.store.FeedByID(userID, feedID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	if h.store.AnotherFeedURLExists(userID, feed.ID, feed.CanonicalFeedURL) {
+		html.BadRequest(w, r, errors.New("This feed URL is already used by another feed"))
+		return
+	}
+
+	if feed.ApplyCanonicalURL() {
+		if err := h.store.UpdateFeed(feed); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "editFeed", "feedID", feed.ID))
+}
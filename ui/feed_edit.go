@@ -42,19 +42,36 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	feedForm := form.FeedForm{
-		SiteURL:         feed.SiteURL,
-		FeedURL:         feed.FeedURL,
-		Title:           feed.Title,
-		ScraperRules:    feed.ScraperRules,
-		RewriteRules:    feed.RewriteRules,
-		Crawler:         feed.Crawler,
-		UserAgent:       feed.UserAgent,
-		CategoryID:      feed.Category.ID,
-		Username:        feed.Username,
-		Password:        feed.Password,
-		IgnoreHTTPCache: feed.IgnoreHTTPCache,
-		FetchViaProxy:   feed.FetchViaProxy,
-		Disabled:        feed.Disabled,
+		SiteURL:            feed.SiteURL,
+		FeedURL:            feed.FeedURL,
+		Title:              feed.DisplayTitle(),
+		ScraperRules:       feed.ScraperRules,
+		RewriteRules:       feed.RewriteRules,
+		Crawler:            feed.Crawler,
+		UserAgent:          feed.UserAgent,
+		CategoryID:         feed.Category.ID,
+		Username:           feed.Username,
+		Password:           feed.Password,
+		IgnoreHTTPCache:    feed.IgnoreHTTPCache,
+		FetchViaProxy:      feed.FetchViaProxy,
+		Disabled:           feed.Disabled,
+		OpenExternalLink:   feed.OpenExternalLink,
+		Encoding:           feed.Encoding,
+		BearerToken:        feed.BearerToken,
+		OAuth2TokenURL:     feed.OAuth2TokenURL,
+		OAuth2ClientID:     feed.OAuth2ClientID,
+		OAuth2ClientSecret: feed.OAuth2ClientSecret,
+		OAuth2Scopes:       feed.OAuth2Scopes,
+		FetchScores:        feed.FetchScores,
+		MinimumScore:       feed.MinimumScore,
+		BlockedAuthors:     feed.BlockedAuthors,
+		AutoStar:           feed.AutoStar,
+		ArchivePages:       feed.ArchivePages,
+		HideGlobally:       feed.HideGlobally,
+		NotifyErrors:       feed.NotifyErrors,
+		CronExpression:     feed.CronExpression,
+		RequestTimeout:     feed.RequestTimeout,
+		MaxBodySize:        feed.MaxBodySize,
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
@@ -67,6 +84,8 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	view.Set("defaultUserAgent", client.DefaultUserAgent)
+	view.Set("requestTimeoutMax", config.MaxHTTPClientTimeout)
+	view.Set("maxBodySizeMax", config.MaxHTTPClientMaxBodySize)
 	view.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyConfigured())
 
 	html.OK(w, r, view.Render("edit_feed"))
@@ -7,8 +7,10 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/model"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 )
@@ -20,20 +22,54 @@ func (h *handler) showFeedsPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	feeds, err := h.store.FeedsWithCounters(user.ID)
+	var feeds model.Feeds
+	var categories model.Categories
+	var countUnread, countErrorFeeds, countUnusedFeeds, countDormantFeeds int
+	err = h.store.RunParallel(
+		func() (err error) {
+			feeds, err = h.store.FeedsWithCounters(user.ID)
+			return err
+		},
+		func() (err error) {
+			categories, err = h.store.Categories(user.ID)
+			return err
+		},
+		func() error {
+			countUnread, countErrorFeeds = h.store.NavigationCounters(user.ID)
+			return nil
+		},
+		func() error {
+			countUnusedFeeds = h.store.CountUnusedFeeds(user.ID)
+			return nil
+		},
+		func() error {
+			countDormantFeeds = h.store.CountDormantFeeds(user.ID)
+			return nil
+		},
+	)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	showOnlyDormantFeeds := request.QueryStringParam(r, "filter", "") == "dormant"
+	if showOnlyDormantFeeds {
+		feeds = feeds.Dormant()
+	}
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("feeds", feeds)
+	view.Set("categories", categories)
 	view.Set("total", len(feeds))
+	view.Set("countUnusedFeeds", countUnusedFeeds)
+	view.Set("countDormantFeeds", countDormantFeeds)
+	view.Set("dormantDays", config.Opts.DormantFeedsDays())
+	view.Set("showOnlyDormantFeeds", showOnlyDormantFeeds)
 	view.Set("menu", "feeds")
 	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+	view.Set("countUnread", countUnread)
+	view.Set("countErrorFeeds", countErrorFeeds)
 
 	html.OK(w, r, view.Render("feeds"))
 }
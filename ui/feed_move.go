@@ -0,0 +1,34 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) moveFeeds(w http.ResponseWriter, r *http.Request) {
+	feedIDs, categoryID, err := decodeMoveFeedsPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	if !h.store.CategoryExists(userID, categoryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	moved, err := h.store.MoveFeeds(userID, feedIDs, categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, map[string]int64{"moved": moved})
+}
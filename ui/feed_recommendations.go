@@ -0,0 +1,48 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+const maxFeedRecommendations = 50
+
+func (h *handler) showFeedRecommendationsPage(w http.ResponseWriter, r *http.Request) {
+	if !config.Opts.HasFeedRecommendations() {
+		html.NotFound(w, r)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	recommendations, err := h.store.FeedRecommendations(user.ID, model.FeedRecommendationMinSubscribers, maxFeedRecommendations)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	view.Set("recommendations", recommendations)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("feed_recommendations"))
+}
@@ -10,15 +10,19 @@ import (
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/live"
 	"miniflux.app/logger"
 )
 
 func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
-	if err := h.feedHandler.RefreshFeed(request.UserID(r), feedID); err != nil {
+	userID := request.UserID(r)
+	if err := h.feedHandler.RefreshFeed(userID, feedID); err != nil {
 		logger.Error("[UI:RefreshFeed] %v", err)
 	}
 
+	h.store.Events().Publish(userID, live.EventFeedRefreshed, feedID)
+
 	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feedID))
 }
 
@@ -7,6 +7,7 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/config"
 	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
@@ -54,6 +55,8 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	view.Set("defaultUserAgent", client.DefaultUserAgent)
+	view.Set("requestTimeoutMax", config.MaxHTTPClientTimeout)
+	view.Set("maxBodySizeMax", config.MaxHTTPClientMaxBodySize)
 
 	if err := feedForm.ValidateModification(); err != nil {
 		view.Set("errorMessage", err.Error())
@@ -61,7 +64,12 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.store.UpdateFeed(feedForm.Merge(feed))
+	feedForm.Merge(feed)
+	if feed.CronExpression != "" {
+		feed.ScheduleNextCronCheck(user.Timezone)
+	}
+
+	err = h.store.UpdateFeed(feed)
 	if err != nil {
 		logger.Error("[UI:UpdateFeed] %v", err)
 		view.Set("errorMessage", "error.unable_to_update_feed")
@@ -0,0 +1,40 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// BlockedFeedForm represents the form used by administrators to block a feed URL or a domain.
+type BlockedFeedForm struct {
+	Pattern string
+	Reason  string
+}
+
+// Validate makes sure the form values are valid.
+func (b BlockedFeedForm) Validate() error {
+	if b.Pattern == "" {
+		return errors.NewLocalizedError("error.fields_mandatory")
+	}
+
+	if err := model.ValidateBlockedFeed(b.Pattern); err != nil {
+		return errors.NewLocalizedError("error.invalid_blocked_feed")
+	}
+
+	return nil
+}
+
+// NewBlockedFeedForm returns a new BlockedFeedForm.
+func NewBlockedFeedForm(r *http.Request) *BlockedFeedForm {
+	return &BlockedFeedForm{
+		Pattern: model.NormalizeBlockedFeedPattern(r.FormValue("pattern")),
+		Reason:  strings.TrimSpace(r.FormValue("reason")),
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestValidateBlockedFeedForm(t *testing.T) {
+	scenarios := []struct {
+		form  BlockedFeedForm
+		valid bool
+	}{
+		{BlockedFeedForm{Pattern: "example.org"}, true},
+		{BlockedFeedForm{Pattern: "https://example.org/feed.xml", Reason: "Malware"}, true},
+		{BlockedFeedForm{Pattern: "", Reason: "Malware"}, false},
+		{BlockedFeedForm{Pattern: "example.org/feed.xml"}, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := scenario.form.Validate()
+		if scenario.valid && err != nil {
+			t.Errorf(`The form %+v should be valid: %v`, scenario.form, err)
+		}
+
+		if !scenario.valid && err == nil {
+			t.Errorf(`The form %+v should be invalid`, scenario.form)
+		}
+	}
+}
+
+func TestNewBlockedFeedForm(t *testing.T) {
+	values := url.Values{"pattern": {" Spam.Example.org "}, "reason": {" Abuse "}}
+	r := httptest.NewRequest("POST", "/blocked-feeds", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := NewBlockedFeedForm(r)
+	if form.Pattern != "spam.example.org" || form.Reason != "Abuse" {
+		t.Errorf(`Unexpected form values: %+v`, form)
+	}
+}
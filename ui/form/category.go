@@ -6,6 +6,7 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"net/http"
+	"strconv"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
@@ -36,3 +37,35 @@ func NewCategoryForm(r *http.Request) *CategoryForm {
 		Title: r.FormValue("title"),
 	}
 }
+
+// CategoryRemoveForm represents the removal of a category that still contains feeds.
+type CategoryRemoveForm struct {
+	CategoryID       int64
+	TargetCategoryID int64
+}
+
+// Validate makes sure the form values are valid.
+func (c CategoryRemoveForm) Validate() error {
+	if c.TargetCategoryID == 0 {
+		return errors.NewLocalizedError("error.category_remove_target")
+	}
+
+	if c.TargetCategoryID == c.CategoryID {
+		return errors.NewLocalizedError("error.category_remove_target")
+	}
+
+	return nil
+}
+
+// NewCategoryRemoveForm returns a new CategoryRemoveForm.
+func NewCategoryRemoveForm(r *http.Request, categoryID int64) *CategoryRemoveForm {
+	targetCategoryID, err := strconv.ParseInt(r.FormValue("target_category_id"), 10, 64)
+	if err != nil || targetCategoryID < 0 {
+		targetCategoryID = 0
+	}
+
+	return &CategoryRemoveForm{
+		CategoryID:       categoryID,
+		TargetCategoryID: targetCategoryID,
+	}
+}
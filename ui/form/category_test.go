@@ -0,0 +1,58 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestValidateCategoryRemoveForm(t *testing.T) {
+	scenarios := []struct {
+		form  CategoryRemoveForm
+		valid bool
+	}{
+		{CategoryRemoveForm{CategoryID: 1, TargetCategoryID: 2}, true},
+		{CategoryRemoveForm{CategoryID: 1, TargetCategoryID: 0}, false},
+		{CategoryRemoveForm{CategoryID: 1, TargetCategoryID: 1}, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := scenario.form.Validate()
+		if scenario.valid && err != nil {
+			t.Errorf(`The form %+v should be valid: %v`, scenario.form, err)
+		}
+
+		if !scenario.valid && err == nil {
+			t.Errorf(`The form %+v should be invalid`, scenario.form)
+		}
+	}
+}
+
+func TestNewCategoryRemoveForm(t *testing.T) {
+	scenarios := map[string]int64{
+		"3":       3,
+		"":        0,
+		"invalid": 0,
+		"-4":      0,
+	}
+
+	for input, expected := range scenarios {
+		values := url.Values{"target_category_id": {input}}
+		r := httptest.NewRequest("POST", "/category/1/remove", strings.NewReader(values.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		form := NewCategoryRemoveForm(r, 1)
+		if form.CategoryID != 1 {
+			t.Errorf(`Unexpected category ID: %d`, form.CategoryID)
+		}
+
+		if form.TargetCategoryID != expected {
+			t.Errorf(`Unexpected target category for %q: got %d instead of %d`, input, form.TargetCategoryID, expected)
+		}
+	}
+}
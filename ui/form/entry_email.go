@@ -0,0 +1,47 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/mail"
+)
+
+// EntryEmailForm represents the form used to share an entry by email.
+type EntryEmailForm struct {
+	Recipients string
+	Note       string
+}
+
+// Validate makes sure the form values are valid.
+func (e EntryEmailForm) Validate() error {
+	recipients, err := mail.ParseRecipients(e.Recipients)
+	if err != nil {
+		return errors.NewLocalizedError("error.invalid_email_recipients")
+	}
+
+	if len(recipients) == 0 {
+		return errors.NewLocalizedError("error.fields_mandatory")
+	}
+
+	return nil
+}
+
+// RecipientList returns the parsed email addresses.
+func (e EntryEmailForm) RecipientList() []string {
+	recipients, _ := mail.ParseRecipients(e.Recipients)
+	return recipients
+}
+
+// NewEntryEmailForm returns a new EntryEmailForm.
+func NewEntryEmailForm(r *http.Request) *EntryEmailForm {
+	return &EntryEmailForm{
+		Recipients: strings.TrimSpace(r.FormValue("recipients")),
+		Note:       strings.TrimSpace(r.FormValue("note")),
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/errors"
+)
+
+// EntrySendForm represents the form used to send an entry to another user.
+type EntrySendForm struct {
+	Username string
+	Message  string
+}
+
+// Validate makes sure the form values are valid.
+func (e EntrySendForm) Validate() error {
+	if e.Username == "" {
+		return errors.NewLocalizedError("error.user_mandatory_fields")
+	}
+
+	return nil
+}
+
+// NewEntrySendForm returns a new EntrySendForm.
+func NewEntrySendForm(r *http.Request) *EntrySendForm {
+	return &EntrySendForm{
+		Username: strings.TrimSpace(r.FormValue("username")),
+		Message:  strings.TrimSpace(r.FormValue("message")),
+	}
+}
@@ -7,26 +7,46 @@ package form // import "miniflux.app/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
+
+	"golang.org/x/net/html/charset"
 )
 
 // FeedForm represents a feed form in the UI
 type FeedForm struct {
-	FeedURL         string
-	SiteURL         string
-	Title           string
-	ScraperRules    string
-	RewriteRules    string
-	Crawler         bool
-	UserAgent       string
-	CategoryID      int64
-	Username        string
-	Password        string
-	IgnoreHTTPCache bool
-	FetchViaProxy   bool
-	Disabled        bool
+	FeedURL            string
+	SiteURL            string
+	Title              string
+	ScraperRules       string
+	RewriteRules       string
+	Crawler            bool
+	UserAgent          string
+	CategoryID         int64
+	Username           string
+	Password           string
+	IgnoreHTTPCache    bool
+	FetchViaProxy      bool
+	Disabled           bool
+	OpenExternalLink   bool
+	Encoding           string
+	BearerToken        string
+	OAuth2TokenURL     string
+	OAuth2ClientID     string
+	OAuth2ClientSecret string
+	OAuth2Scopes       string
+	FetchScores        bool
+	MinimumScore       int
+	BlockedAuthors     string
+	AutoStar           bool
+	ArchivePages       bool
+	HideGlobally       bool
+	NotifyErrors       bool
+	CronExpression     string
+	RequestTimeout     int
+	MaxBodySize        int
 }
 
 // ValidateModification validates FeedForm fields
@@ -34,13 +54,28 @@ func (f FeedForm) ValidateModification() error {
 	if f.FeedURL == "" || f.SiteURL == "" || f.Title == "" || f.CategoryID == 0 {
 		return errors.NewLocalizedError("error.fields_mandatory")
 	}
+
+	if f.Encoding != "" {
+		if enc, _ := charset.Lookup(f.Encoding); enc == nil {
+			return errors.NewLocalizedError("error.invalid_encoding")
+		}
+	}
+
+	if err := model.ValidateCronExpression(f.CronExpression); err != nil {
+		return errors.NewLocalizedError("error.invalid_cron_expression")
+	}
+
+	if err := model.ValidateRequestLimits(f.RequestTimeout, f.MaxBodySize); err != nil {
+		return errors.NewLocalizedError("error.invalid_feed_request_limits")
+	}
+
 	return nil
 }
 
 // Merge updates the fields of the given feed.
 func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.Category.ID = f.CategoryID
-	feed.Title = f.Title
+	feed.WithCustomTitle(f.Title)
 	feed.SiteURL = f.SiteURL
 	feed.FeedURL = f.FeedURL
 	feed.ScraperRules = f.ScraperRules
@@ -49,11 +84,29 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.UserAgent = f.UserAgent
 	feed.ParsingErrorCount = 0
 	feed.ParsingErrorMsg = ""
+	feed.Dead = false
 	feed.Username = f.Username
 	feed.Password = f.Password
 	feed.IgnoreHTTPCache = f.IgnoreHTTPCache
 	feed.FetchViaProxy = f.FetchViaProxy
 	feed.Disabled = f.Disabled
+	feed.OpenExternalLink = f.OpenExternalLink
+	feed.Encoding = f.Encoding
+	feed.BearerToken = f.BearerToken
+	feed.OAuth2TokenURL = f.OAuth2TokenURL
+	feed.OAuth2ClientID = f.OAuth2ClientID
+	feed.OAuth2ClientSecret = f.OAuth2ClientSecret
+	feed.OAuth2Scopes = f.OAuth2Scopes
+	feed.FetchScores = f.FetchScores
+	feed.MinimumScore = f.MinimumScore
+	feed.BlockedAuthors = f.BlockedAuthors
+	feed.AutoStar = f.AutoStar
+	feed.ArchivePages = f.ArchivePages
+	feed.HideGlobally = f.HideGlobally
+	feed.NotifyErrors = f.NotifyErrors
+	feed.CronExpression = f.CronExpression
+	feed.RequestTimeout = f.RequestTimeout
+	feed.MaxBodySize = f.MaxBodySize
 	return feed
 }
 
@@ -64,19 +117,51 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		categoryID = 0
 	}
 
+	minimumScore, err := strconv.Atoi(r.FormValue("minimum_score"))
+	if err != nil {
+		minimumScore = 0
+	}
+
+	requestTimeout, err := strconv.Atoi(r.FormValue("request_timeout"))
+	if err != nil {
+		requestTimeout = 0
+	}
+
+	maxBodySize, err := strconv.Atoi(r.FormValue("max_body_size"))
+	if err != nil {
+		maxBodySize = 0
+	}
+
 	return &FeedForm{
-		FeedURL:         r.FormValue("feed_url"),
-		SiteURL:         r.FormValue("site_url"),
-		Title:           r.FormValue("title"),
-		ScraperRules:    r.FormValue("scraper_rules"),
-		UserAgent:       r.FormValue("user_agent"),
-		RewriteRules:    r.FormValue("rewrite_rules"),
-		Crawler:         r.FormValue("crawler") == "1",
-		CategoryID:      int64(categoryID),
-		Username:        r.FormValue("feed_username"),
-		Password:        r.FormValue("feed_password"),
-		IgnoreHTTPCache: r.FormValue("ignore_http_cache") == "1",
-		FetchViaProxy:   r.FormValue("fetch_via_proxy") == "1",
-		Disabled:        r.FormValue("disabled") == "1",
+		FeedURL:            r.FormValue("feed_url"),
+		SiteURL:            r.FormValue("site_url"),
+		Title:              r.FormValue("title"),
+		ScraperRules:       r.FormValue("scraper_rules"),
+		UserAgent:          r.FormValue("user_agent"),
+		RewriteRules:       r.FormValue("rewrite_rules"),
+		Crawler:            r.FormValue("crawler") == "1",
+		CategoryID:         int64(categoryID),
+		Username:           r.FormValue("feed_username"),
+		Password:           r.FormValue("feed_password"),
+		IgnoreHTTPCache:    r.FormValue("ignore_http_cache") == "1",
+		FetchViaProxy:      r.FormValue("fetch_via_proxy") == "1",
+		Disabled:           r.FormValue("disabled") == "1",
+		OpenExternalLink:   r.FormValue("open_external_link") == "1",
+		Encoding:           strings.TrimSpace(r.FormValue("encoding")),
+		BearerToken:        r.FormValue("bearer_token"),
+		OAuth2TokenURL:     r.FormValue("oauth2_token_url"),
+		OAuth2ClientID:     r.FormValue("oauth2_client_id"),
+		OAuth2ClientSecret: r.FormValue("oauth2_client_secret"),
+		OAuth2Scopes:       r.FormValue("oauth2_scopes"),
+		FetchScores:        r.FormValue("fetch_scores") == "1",
+		MinimumScore:       minimumScore,
+		BlockedAuthors:     r.FormValue("blocked_authors"),
+		AutoStar:           r.FormValue("auto_star") == "1",
+		ArchivePages:       r.FormValue("archive_pages") == "1",
+		HideGlobally:       r.FormValue("hide_globally") == "1",
+		NotifyErrors:       r.FormValue("notify_errors") == "1",
+		CronExpression:     strings.TrimSpace(r.FormValue("cron_expression")),
+		RequestTimeout:     requestTimeout,
+		MaxBodySize:        maxBodySize,
 	}
 }
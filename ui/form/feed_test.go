@@ -0,0 +1,82 @@
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestFeedFormWithValidEncoding(t *testing.T) {
+	feedForm := &FeedForm{
+		FeedURL:    "https://example.org/feed.xml",
+		SiteURL:    "https://example.org/",
+		Title:      "Example",
+		CategoryID: 1,
+		Encoding:   "windows-1252",
+	}
+
+	if err := feedForm.ValidateModification(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFeedFormWithInvalidEncoding(t *testing.T) {
+	feedForm := &FeedForm{
+		FeedURL:    "https://example.org/feed.xml",
+		SiteURL:    "https://example.org/",
+		Title:      "Example",
+		CategoryID: 1,
+		Encoding:   "invalid-encoding",
+	}
+
+	if err := feedForm.ValidateModification(); err == nil {
+		t.Error("Validation should fail with an unknown encoding")
+	}
+}
+
+func TestFeedFormWithInvalidCronExpression(t *testing.T) {
+	feedForm := &FeedForm{
+		FeedURL:        "https://example.org/feed.xml",
+		SiteURL:        "https://example.org/",
+		Title:          "Example",
+		CategoryID:     1,
+		CronExpression: "every monday",
+	}
+
+	if err := feedForm.ValidateModification(); err == nil {
+		t.Error("Validation should fail with an invalid cron expression")
+	}
+
+	feedForm.CronExpression = "30 7 * * mon-fri"
+	if err := feedForm.ValidateModification(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFeedFormWithHTTPClientLimits(t *testing.T) {
+	values := url.Values{}
+	values.Set("feed_url", "https://example.org/feed.xml")
+	values.Set("site_url", "https://example.org/")
+	values.Set("title", "Example")
+	values.Set("category_id", "1")
+	values.Set("request_timeout", "90")
+	values.Set("max_body_size", "-5")
+
+	r := httptest.NewRequest("POST", "/feed/1/update", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	feedForm := NewFeedForm(r)
+	if feedForm.RequestTimeout != 90 {
+		t.Errorf(`Unexpected request timeout, got %d`, feedForm.RequestTimeout)
+	}
+
+	if err := feedForm.ValidateModification(); err == nil {
+		t.Error(`Validation should fail with a negative size`)
+	}
+
+	feedForm.MaxBodySize = 0
+	if err := feedForm.ValidateModification(); err != nil {
+		t.Errorf(`A size of 0 should fallback to the global setting: %v`, err)
+	}
+}
@@ -6,34 +6,88 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
+	"miniflux.app/integration/custombookmark"
 	"miniflux.app/model"
 )
 
 // IntegrationForm represents user integration settings form.
 type IntegrationForm struct {
-	PinboardEnabled      bool
-	PinboardToken        string
-	PinboardTags         string
-	PinboardMarkAsUnread bool
-	InstapaperEnabled    bool
-	InstapaperUsername   string
-	InstapaperPassword   string
-	FeverEnabled         bool
-	FeverUsername        string
-	FeverPassword        string
-	WallabagEnabled      bool
-	WallabagURL          string
-	WallabagClientID     string
-	WallabagClientSecret string
-	WallabagUsername     string
-	WallabagPassword     string
-	NunuxKeeperEnabled   bool
-	NunuxKeeperURL       string
-	NunuxKeeperAPIKey    string
-	PocketEnabled        bool
-	PocketAccessToken    string
-	PocketConsumerKey    string
+	PinboardEnabled        bool
+	PinboardToken          string
+	PinboardTags           string
+	PinboardMarkAsUnread   bool
+	InstapaperEnabled      bool
+	InstapaperUsername     string
+	InstapaperPassword     string
+	FeverEnabled           bool
+	FeverUsername          string
+	FeverPassword          string
+	WallabagEnabled        bool
+	WallabagURL            string
+	WallabagClientID       string
+	WallabagClientSecret   string
+	WallabagUsername       string
+	WallabagPassword       string
+	NunuxKeeperEnabled     bool
+	NunuxKeeperURL         string
+	NunuxKeeperAPIKey      string
+	PocketEnabled          bool
+	PocketAccessToken      string
+	PocketConsumerKey      string
+	RSSBridgeEnabled       bool
+	RSSBridgeURL           string
+	PinboardCategoryTag    bool
+	PinboardEntryTags      bool
+	PinboardPromptTags     bool
+	WallabagTags           string
+	WallabagArchive        bool
+	InstapaperFolderID     string
+	PocketCategoryTag      bool
+	CustomBookmarkEnabled  bool
+	CustomBookmarkURL      string
+	CustomBookmarkMethod   string
+	CustomBookmarkHeaders  string
+	CustomBookmarkBody     string
+	CategoryRoutes         map[string][]int64
+	MarkdownEnabled        bool
+	MarkdownWebDAVURL      string
+	MarkdownWebDAVUsername string
+	MarkdownWebDAVPassword string
+	MarkdownStarred        bool
+	HypothesisEnabled      bool
+	HypothesisToken        string
+	HypothesisGroup        string
+	ZoteroEnabled          bool
+	ZoteroLibraryType      string
+	ZoteroLibraryID        string
+	ZoteroAPIKey           string
+	ZoteroCollectionKey    string
+	KarakeepEnabled        bool
+	KarakeepURL            string
+	KarakeepAPIKey         string
+	KarakeepTags           string
+	EspialEnabled          bool
+	EspialURL              string
+	EspialAPIKey           string
+	EspialTags             string
+	LinkAceEnabled         bool
+	LinkAceURL             string
+	LinkAceAPIKey          string
+	LinkAceTags            string
+	LinkAceLists           string
+	LinkAceCategoryTag     bool
+	LinkAceIsPrivate       bool
+	WaybackEnabled         bool
+	ArchiveBoxEnabled      bool
+	ArchiveBoxURL          string
+	ArchiveBoxAPIKey       string
+	ArchiveBoxTags         string
+	NtfyEnabled            bool
+	NtfyTopicURL           string
+	NtfyToken              string
 }
 
 // Merge copy form values to the model.
@@ -60,32 +114,167 @@ func (i IntegrationForm) Merge(integration *model.Integration) {
 	integration.PocketEnabled = i.PocketEnabled
 	integration.PocketAccessToken = i.PocketAccessToken
 	integration.PocketConsumerKey = i.PocketConsumerKey
+	integration.RSSBridgeEnabled = i.RSSBridgeEnabled
+	integration.RSSBridgeURL = i.RSSBridgeURL
+	integration.PinboardCategoryTag = i.PinboardCategoryTag
+	integration.PinboardEntryTags = i.PinboardEntryTags
+	integration.PinboardPromptTags = i.PinboardPromptTags
+	integration.WallabagTags = i.WallabagTags
+	integration.WallabagArchive = i.WallabagArchive
+	integration.InstapaperFolderID = i.InstapaperFolderID
+	integration.PocketCategoryTag = i.PocketCategoryTag
+	integration.CustomBookmarkEnabled = i.CustomBookmarkEnabled
+	integration.CustomBookmarkURL = i.CustomBookmarkURL
+	integration.CustomBookmarkMethod = i.CustomBookmarkMethod
+	integration.CustomBookmarkHeaders = i.CustomBookmarkHeaders
+	integration.CustomBookmarkBody = i.CustomBookmarkBody
+	integration.CategoryRoutes = i.CategoryRoutes
+	integration.MarkdownEnabled = i.MarkdownEnabled
+	integration.MarkdownWebDAVURL = i.MarkdownWebDAVURL
+	integration.MarkdownWebDAVUsername = i.MarkdownWebDAVUsername
+	integration.MarkdownWebDAVPassword = i.MarkdownWebDAVPassword
+	integration.MarkdownStarred = i.MarkdownStarred
+	integration.HypothesisEnabled = i.HypothesisEnabled
+	integration.HypothesisToken = i.HypothesisToken
+	integration.HypothesisGroup = i.HypothesisGroup
+	integration.ZoteroEnabled = i.ZoteroEnabled
+	integration.ZoteroLibraryType = i.ZoteroLibraryType
+	integration.ZoteroLibraryID = i.ZoteroLibraryID
+	integration.ZoteroAPIKey = i.ZoteroAPIKey
+	integration.ZoteroCollectionKey = i.ZoteroCollectionKey
+	integration.KarakeepEnabled = i.KarakeepEnabled
+	integration.KarakeepURL = i.KarakeepURL
+	integration.KarakeepAPIKey = i.KarakeepAPIKey
+	integration.KarakeepTags = i.KarakeepTags
+	integration.EspialEnabled = i.EspialEnabled
+	integration.EspialURL = i.EspialURL
+	integration.EspialAPIKey = i.EspialAPIKey
+	integration.EspialTags = i.EspialTags
+	integration.LinkAceEnabled = i.LinkAceEnabled
+	integration.LinkAceURL = i.LinkAceURL
+	integration.LinkAceAPIKey = i.LinkAceAPIKey
+	integration.LinkAceTags = i.LinkAceTags
+	integration.LinkAceLists = i.LinkAceLists
+	integration.LinkAceCategoryTag = i.LinkAceCategoryTag
+	integration.LinkAceIsPrivate = i.LinkAceIsPrivate
+	integration.WaybackEnabled = i.WaybackEnabled
+	integration.ArchiveBoxEnabled = i.ArchiveBoxEnabled
+	integration.ArchiveBoxURL = i.ArchiveBoxURL
+	integration.ArchiveBoxAPIKey = i.ArchiveBoxAPIKey
+	integration.ArchiveBoxTags = i.ArchiveBoxTags
+	integration.NtfyEnabled = i.NtfyEnabled
+	integration.NtfyTopicURL = i.NtfyTopicURL
+	integration.NtfyToken = i.NtfyToken
+}
+
+// IsRestricted returns true if the service only receives the entries of some categories.
+func (i IntegrationForm) IsRestricted(service string) bool {
+	_, restricted := i.CategoryRoutes[service]
+	return restricted
+}
+
+// HasCategoryRoute returns true if the service is restricted to the given category.
+func (i IntegrationForm) HasCategoryRoute(service string, categoryID int64) bool {
+	for _, id := range i.CategoryRoutes[service] {
+		if id == categoryID {
+			return true
+		}
+	}
+
+	return false
 }
 
 // NewIntegrationForm returns a new AuthForm.
 func NewIntegrationForm(r *http.Request) *IntegrationForm {
+	r.ParseForm()
+
+	categoryRoutes := make(map[string][]int64)
+	for _, service := range model.RoutableServices {
+		if r.FormValue("restrict_"+service.Key) != "1" {
+			continue
+		}
+
+		categoryRoutes[service.Key] = []int64{}
+		for _, value := range r.Form["route_"+service.Key] {
+			if categoryID, err := strconv.ParseInt(value, 10, 64); err == nil && categoryID > 0 {
+				categoryRoutes[service.Key] = append(categoryRoutes[service.Key], categoryID)
+			}
+		}
+	}
+
 	return &IntegrationForm{
-		PinboardEnabled:      r.FormValue("pinboard_enabled") == "1",
-		PinboardToken:        r.FormValue("pinboard_token"),
-		PinboardTags:         r.FormValue("pinboard_tags"),
-		PinboardMarkAsUnread: r.FormValue("pinboard_mark_as_unread") == "1",
-		InstapaperEnabled:    r.FormValue("instapaper_enabled") == "1",
-		InstapaperUsername:   r.FormValue("instapaper_username"),
-		InstapaperPassword:   r.FormValue("instapaper_password"),
-		FeverEnabled:         r.FormValue("fever_enabled") == "1",
-		FeverUsername:        r.FormValue("fever_username"),
-		FeverPassword:        r.FormValue("fever_password"),
-		WallabagEnabled:      r.FormValue("wallabag_enabled") == "1",
-		WallabagURL:          r.FormValue("wallabag_url"),
-		WallabagClientID:     r.FormValue("wallabag_client_id"),
-		WallabagClientSecret: r.FormValue("wallabag_client_secret"),
-		WallabagUsername:     r.FormValue("wallabag_username"),
-		WallabagPassword:     r.FormValue("wallabag_password"),
-		NunuxKeeperEnabled:   r.FormValue("nunux_keeper_enabled") == "1",
-		NunuxKeeperURL:       r.FormValue("nunux_keeper_url"),
-		NunuxKeeperAPIKey:    r.FormValue("nunux_keeper_api_key"),
-		PocketEnabled:        r.FormValue("pocket_enabled") == "1",
-		PocketAccessToken:    r.FormValue("pocket_access_token"),
-		PocketConsumerKey:    r.FormValue("pocket_consumer_key"),
+		PinboardEnabled:        r.FormValue("pinboard_enabled") == "1",
+		PinboardToken:          r.FormValue("pinboard_token"),
+		PinboardTags:           r.FormValue("pinboard_tags"),
+		PinboardMarkAsUnread:   r.FormValue("pinboard_mark_as_unread") == "1",
+		InstapaperEnabled:      r.FormValue("instapaper_enabled") == "1",
+		InstapaperUsername:     r.FormValue("instapaper_username"),
+		InstapaperPassword:     r.FormValue("instapaper_password"),
+		FeverEnabled:           r.FormValue("fever_enabled") == "1",
+		FeverUsername:          r.FormValue("fever_username"),
+		FeverPassword:          r.FormValue("fever_password"),
+		WallabagEnabled:        r.FormValue("wallabag_enabled") == "1",
+		WallabagURL:            r.FormValue("wallabag_url"),
+		WallabagClientID:       r.FormValue("wallabag_client_id"),
+		WallabagClientSecret:   r.FormValue("wallabag_client_secret"),
+		WallabagUsername:       r.FormValue("wallabag_username"),
+		WallabagPassword:       r.FormValue("wallabag_password"),
+		NunuxKeeperEnabled:     r.FormValue("nunux_keeper_enabled") == "1",
+		NunuxKeeperURL:         r.FormValue("nunux_keeper_url"),
+		NunuxKeeperAPIKey:      r.FormValue("nunux_keeper_api_key"),
+		PocketEnabled:          r.FormValue("pocket_enabled") == "1",
+		PocketAccessToken:      r.FormValue("pocket_access_token"),
+		PocketConsumerKey:      r.FormValue("pocket_consumer_key"),
+		RSSBridgeEnabled:       r.FormValue("rssbridge_enabled") == "1",
+		RSSBridgeURL:           r.FormValue("rssbridge_url"),
+		PinboardCategoryTag:    r.FormValue("pinboard_category_tag") == "1",
+		PinboardEntryTags:      r.FormValue("pinboard_entry_tags") == "1",
+		PinboardPromptTags:     r.FormValue("pinboard_prompt_tags") == "1",
+		WallabagTags:           r.FormValue("wallabag_tags"),
+		WallabagArchive:        r.FormValue("wallabag_archive") == "1",
+		InstapaperFolderID:     r.FormValue("instapaper_folder_id"),
+		PocketCategoryTag:      r.FormValue("pocket_category_tag") == "1",
+		CustomBookmarkEnabled:  r.FormValue("custom_bookmark_enabled") == "1",
+		CustomBookmarkURL:      r.FormValue("custom_bookmark_url"),
+		CustomBookmarkMethod:   custombookmark.NormalizeMethod(r.FormValue("custom_bookmark_method")),
+		CustomBookmarkHeaders:  r.FormValue("custom_bookmark_headers"),
+		CustomBookmarkBody:     r.FormValue("custom_bookmark_body"),
+		CategoryRoutes:         categoryRoutes,
+		MarkdownEnabled:        r.FormValue("markdown_enabled") == "1",
+		MarkdownWebDAVURL:      r.FormValue("markdown_webdav_url"),
+		MarkdownWebDAVUsername: r.FormValue("markdown_webdav_username"),
+		MarkdownWebDAVPassword: r.FormValue("markdown_webdav_password"),
+		MarkdownStarred:        r.FormValue("markdown_starred") == "1",
+		HypothesisEnabled:      r.FormValue("hypothesis_enabled") == "1",
+		HypothesisToken:        r.FormValue("hypothesis_token"),
+		HypothesisGroup:        strings.TrimSpace(r.FormValue("hypothesis_group")),
+		ZoteroEnabled:          r.FormValue("zotero_enabled") == "1",
+		ZoteroLibraryType:      r.FormValue("zotero_library_type"),
+		ZoteroLibraryID:        r.FormValue("zotero_library_id"),
+		ZoteroAPIKey:           r.FormValue("zotero_api_key"),
+		ZoteroCollectionKey:    r.FormValue("zotero_collection_key"),
+		KarakeepEnabled:        r.FormValue("karakeep_enabled") == "1",
+		KarakeepURL:            r.FormValue("karakeep_url"),
+		KarakeepAPIKey:         r.FormValue("karakeep_api_key"),
+		KarakeepTags:           r.FormValue("karakeep_tags"),
+		EspialEnabled:          r.FormValue("espial_enabled") == "1",
+		EspialURL:              r.FormValue("espial_url"),
+		EspialAPIKey:           r.FormValue("espial_api_key"),
+		EspialTags:             r.FormValue("espial_tags"),
+		LinkAceEnabled:         r.FormValue("linkace_enabled") == "1",
+		LinkAceURL:             r.FormValue("linkace_url"),
+		LinkAceAPIKey:          r.FormValue("linkace_api_key"),
+		LinkAceTags:            r.FormValue("linkace_tags"),
+		LinkAceLists:           r.FormValue("linkace_lists"),
+		LinkAceCategoryTag:     r.FormValue("linkace_category_tag") == "1",
+		LinkAceIsPrivate:       r.FormValue("linkace_is_private") == "1",
+		WaybackEnabled:         r.FormValue("wayback_enabled") == "1",
+		ArchiveBoxEnabled:      r.FormValue("archivebox_enabled") == "1",
+		ArchiveBoxURL:          r.FormValue("archivebox_url"),
+		ArchiveBoxAPIKey:       r.FormValue("archivebox_api_key"),
+		ArchiveBoxTags:         r.FormValue("archivebox_tags"),
+		NtfyEnabled:            r.FormValue("ntfy_enabled") == "1",
+		NtfyTopicURL:           strings.TrimSpace(r.FormValue("ntfy_topic_url")),
+		NtfyToken:              r.FormValue("ntfy_token"),
 	}
 }
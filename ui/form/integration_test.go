@@ -0,0 +1,37 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewIntegrationFormCategoryRoutes(t *testing.T) {
+	values := url.Values{
+		"restrict_pinboard": {"1"},
+		"route_pinboard":    {"2", "invalid", "3"},
+		"restrict_pocket":   {"1"},
+		"route_wallabag":    {"4"},
+	}
+	r := httptest.NewRequest("POST", "/integration", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := NewIntegrationForm(r)
+	if !reflect.DeepEqual(form.CategoryRoutes["pinboard"], []int64{2, 3}) {
+		t.Errorf(`Unexpected Pinboard categories: %v`, form.CategoryRoutes["pinboard"])
+	}
+
+	if !form.IsRestricted("pocket") || len(form.CategoryRoutes["pocket"]) != 0 {
+		t.Error(`Pocket should be restricted to no category`)
+	}
+
+	if form.IsRestricted("wallabag") {
+		t.Error(`The categories of a service which is not restricted should be ignored`)
+	}
+}
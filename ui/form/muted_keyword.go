@@ -0,0 +1,63 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+const mutedKeywordDateFormat = "2006-01-02"
+
+// MutedKeywordForm represents the muted keyword form.
+type MutedKeywordForm struct {
+	Pattern   string
+	Action    string
+	ExpiresAt string
+}
+
+// Validate makes sure the form values are valid.
+func (m MutedKeywordForm) Validate() error {
+	if m.Pattern == "" || m.Action == "" {
+		return errors.NewLocalizedError("error.fields_mandatory")
+	}
+
+	if err := model.ValidateMutedKeyword(m.Pattern, m.Action); err != nil {
+		return errors.NewLocalizedError("error.invalid_muted_keyword")
+	}
+
+	if m.ExpiresAt != "" {
+		if _, err := time.Parse(mutedKeywordDateFormat, m.ExpiresAt); err != nil {
+			return errors.NewLocalizedError("error.invalid_expiration_date")
+		}
+	}
+
+	return nil
+}
+
+// ExpirationTime returns the end of the expiration day in the user location, or nil if the mute never expires.
+func (m MutedKeywordForm) ExpirationTime(location *time.Location) *time.Time {
+	day, err := time.ParseInLocation(mutedKeywordDateFormat, m.ExpiresAt, location)
+	if err != nil {
+		return nil
+	}
+
+	// The mute is still active during the whole expiration day, AddDate keeps the right day length on DST changes.
+	expiresAt := day.AddDate(0, 0, 1).Add(-time.Second)
+	return &expiresAt
+}
+
+// NewMutedKeywordForm returns a new MutedKeywordForm.
+func NewMutedKeywordForm(r *http.Request) *MutedKeywordForm {
+	return &MutedKeywordForm{
+		Pattern:   strings.TrimSpace(r.FormValue("pattern")),
+		Action:    r.FormValue("action"),
+		ExpiresAt: r.FormValue("expires_at"),
+	}
+}
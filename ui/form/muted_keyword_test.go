@@ -0,0 +1,61 @@
+package form // import "miniflux.app/ui/form"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateMutedKeywordForm(t *testing.T) {
+	scenarios := []struct {
+		form  MutedKeywordForm
+		valid bool
+	}{
+		{MutedKeywordForm{Pattern: "spoiler", Action: "drop"}, true},
+		{MutedKeywordForm{Pattern: "game of (thrones|throne)", Action: "read", ExpiresAt: "2020-12-31"}, true},
+		{MutedKeywordForm{Pattern: "", Action: "drop"}, false},
+		{MutedKeywordForm{Pattern: "spoiler", Action: "delete"}, false},
+		{MutedKeywordForm{Pattern: "(unclosed", Action: "drop"}, false},
+		{MutedKeywordForm{Pattern: "spoiler", Action: "drop", ExpiresAt: "tomorrow"}, false},
+	}
+
+	for _, scenario := range scenarios {
+		err := scenario.form.Validate()
+		if scenario.valid && err != nil {
+			t.Errorf(`The form %+v should be valid: %v`, scenario.form, err)
+		}
+
+		if !scenario.valid && err == nil {
+			t.Errorf(`The form %+v should be invalid`, scenario.form)
+		}
+	}
+}
+
+func TestMutedKeywordExpirationTime(t *testing.T) {
+	form := MutedKeywordForm{ExpiresAt: "2020-12-31"}
+	expiresAt := form.ExpirationTime(time.UTC)
+	if expiresAt == nil {
+		t.Fatal(`The expiration time should be defined`)
+	}
+
+	expected := time.Date(2020, 12, 31, 23, 59, 59, 0, time.UTC)
+	if !expiresAt.Equal(expected) {
+		t.Errorf(`Unexpected expiration time: %v`, expiresAt)
+	}
+
+	location, err := time.LoadLocation("America/Montreal")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The day of the switch to daylight saving time only has 23 hours.
+	form = MutedKeywordForm{ExpiresAt: "2020-03-08"}
+	expected = time.Date(2020, 3, 8, 23, 59, 59, 0, location)
+	if expiresAt = form.ExpirationTime(location); expiresAt == nil || !expiresAt.Equal(expected) {
+		t.Errorf(`Unexpected expiration time in the user timezone: %v`, expiresAt)
+	}
+
+	form = MutedKeywordForm{}
+	if form.ExpirationTime(time.UTC) != nil {
+		t.Error(`A mute without date should never expire`)
+	}
+}
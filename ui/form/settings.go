@@ -7,24 +7,53 @@ package form // import "miniflux.app/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
+	"miniflux.app/config"
 	"miniflux.app/errors"
+	"miniflux.app/mail"
 	"miniflux.app/model"
 )
 
 // SettingsForm represents the settings form.
 type SettingsForm struct {
-	Username          string
-	Password          string
-	Confirmation      string
-	Theme             string
-	Language          string
-	Timezone          string
-	EntryDirection    string
-	EntriesPerPage    int
-	KeyboardShortcuts bool
-	ShowReadingTime   bool
-	CustomCSS         string
+	Username               string
+	Password               string
+	Confirmation           string
+	Theme                  string
+	Language               string
+	Timezone               string
+	EntryDirection         string
+	EntriesPerPage         int
+	KeyboardShortcuts      bool
+	ShowReadingTime        bool
+	MarkReadOnOriginalLink bool
+	YouTubeEmbedURL        string
+	BlockedAuthors         string
+	AutoStarKeywords       string
+	AutoStarAuthors        string
+	EmailRecipients        string
+	HomePage               string
+	HomePageSearch         string
+	EntryTimezone          string
+	TimestampFormat        string
+	NotificationEmail      string
+	KeepStarredEntries     bool
+	ArchiveReadDays        int
+	AcceptReceivedEntries  bool
+	CustomCSS              string
+}
+
+// HomePageSetting returns the home page as stored in the user settings, the search query is appended to the search page.
+func (s *SettingsForm) HomePageSetting() string {
+	switch s.HomePage {
+	case "":
+		return model.DefaultHomePage
+	case model.HomePageSearch:
+		return model.HomePageSearch + ":" + s.HomePageSearch
+	default:
+		return s.HomePage
+	}
 }
 
 // Merge updates the fields of the given user.
@@ -37,8 +66,27 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.EntriesPerPage = s.EntriesPerPage
 	user.KeyboardShortcuts = s.KeyboardShortcuts
 	user.ShowReadingTime = s.ShowReadingTime
+	user.MarkReadOnOriginalLink = s.MarkReadOnOriginalLink
+	user.YouTubeEmbedURL = model.NormalizeYouTubeEmbedURL(s.YouTubeEmbedURL)
+	user.BlockedAuthors = s.BlockedAuthors
+	user.AutoStarKeywords = s.AutoStarKeywords
+	user.AutoStarAuthors = s.AutoStarAuthors
+	user.EmailRecipients = s.EmailRecipients
+	user.NotificationEmail = s.NotificationEmail
+	user.KeepStarredEntries = s.KeepStarredEntries
+	user.ArchiveReadDays = s.ArchiveReadDays
+	user.AcceptReceivedEntries = s.AcceptReceivedEntries
+	user.HomePage = s.HomePageSetting()
 	user.Extra["custom_css"] = s.CustomCSS
 
+	if s.EntryTimezone != "" {
+		user.EntryTimezone = s.EntryTimezone
+	}
+
+	if s.TimestampFormat != "" {
+		user.TimestampFormat = s.TimestampFormat
+	}
+
 	if s.Password != "" {
 		user.Password = s.Password
 	}
@@ -56,6 +104,43 @@ func (s *SettingsForm) Validate() error {
 		return errors.NewLocalizedError("error.entries_per_page_invalid")
 	}
 
+	if s.YouTubeEmbedURL != "" && !model.IsValidYouTubeEmbedURL(s.YouTubeEmbedURL) {
+		return errors.NewLocalizedError("error.invalid_youtube_embed_url")
+	}
+
+	if model.ValidateKeywords(s.AutoStarKeywords) != nil {
+		return errors.NewLocalizedError("error.invalid_auto_star_keywords")
+	}
+
+	if _, err := mail.ParseRecipients(s.EmailRecipients); err != nil {
+		return errors.NewLocalizedError("error.invalid_email_recipients")
+	}
+
+	if s.NotificationEmail != "" {
+		if recipients, err := mail.ParseRecipients(s.NotificationEmail); err != nil || len(recipients) != 1 {
+			return errors.NewLocalizedError("error.invalid_notification_email")
+		}
+	}
+
+	if model.ValidateHomePage(s.HomePageSetting()) != nil {
+		return errors.NewLocalizedError("error.invalid_home_page")
+	}
+
+	if s.EntryTimezone != "" && model.ValidateEntryTimezone(s.EntryTimezone) != nil {
+		return errors.NewLocalizedError("error.invalid_entry_timezone")
+	}
+
+	if s.TimestampFormat != "" && model.ValidateTimestampFormat(s.TimestampFormat) != nil {
+		return errors.NewLocalizedError("error.invalid_timestamp_format")
+	}
+
+	if s.ArchiveReadDays != 0 {
+		s.ArchiveReadDays = model.ClampArchiveReadDays(s.ArchiveReadDays, config.Opts.CleanupArchiveReadDaysMax())
+		if err := model.ValidateArchiveReadDays(s.ArchiveReadDays, config.Opts.CleanupArchiveReadDaysMax()); err != nil {
+			return err
+		}
+	}
+
 	if s.Confirmation == "" {
 		// Firefox insists on auto-completing the password field.
 		// If the confirmation field is blank, the user probably
@@ -80,17 +165,35 @@ func NewSettingsForm(r *http.Request) *SettingsForm {
 	if err != nil {
 		entriesPerPage = 0
 	}
+	archiveReadDays, err := strconv.ParseInt(r.FormValue("archive_read_days"), 10, 64)
+	if err != nil {
+		archiveReadDays = 0
+	}
 	return &SettingsForm{
-		Username:          r.FormValue("username"),
-		Password:          r.FormValue("password"),
-		Confirmation:      r.FormValue("confirmation"),
-		Theme:             r.FormValue("theme"),
-		Language:          r.FormValue("language"),
-		Timezone:          r.FormValue("timezone"),
-		EntryDirection:    r.FormValue("entry_direction"),
-		EntriesPerPage:    int(entriesPerPage),
-		KeyboardShortcuts: r.FormValue("keyboard_shortcuts") == "1",
-		ShowReadingTime:   r.FormValue("show_reading_time") == "1",
-		CustomCSS:         r.FormValue("custom_css"),
+		Username:               r.FormValue("username"),
+		Password:               r.FormValue("password"),
+		Confirmation:           r.FormValue("confirmation"),
+		Theme:                  r.FormValue("theme"),
+		Language:               r.FormValue("language"),
+		Timezone:               r.FormValue("timezone"),
+		EntryDirection:         r.FormValue("entry_direction"),
+		EntriesPerPage:         int(entriesPerPage),
+		KeyboardShortcuts:      r.FormValue("keyboard_shortcuts") == "1",
+		ShowReadingTime:        r.FormValue("show_reading_time") == "1",
+		MarkReadOnOriginalLink: r.FormValue("mark_read_on_original_link") == "1",
+		YouTubeEmbedURL:        strings.TrimSuffix(strings.TrimSpace(r.FormValue("youtube_embed_url")), "/"),
+		BlockedAuthors:         r.FormValue("blocked_authors"),
+		AutoStarKeywords:       r.FormValue("auto_star_keywords"),
+		AutoStarAuthors:        r.FormValue("auto_star_authors"),
+		EmailRecipients:        strings.TrimSpace(r.FormValue("email_recipients")),
+		HomePage:               r.FormValue("home_page"),
+		HomePageSearch:         strings.TrimSpace(r.FormValue("home_page_search")),
+		EntryTimezone:          r.FormValue("entry_timezone"),
+		TimestampFormat:        r.FormValue("timestamp_format"),
+		NotificationEmail:      strings.TrimSpace(r.FormValue("notification_email")),
+		KeepStarredEntries:     r.FormValue("keep_starred_entries") == "1",
+		ArchiveReadDays:        int(archiveReadDays),
+		AcceptReceivedEntries:  r.FormValue("accept_received_entries") == "1",
+		CustomCSS:              r.FormValue("custom_css"),
 	}
 }
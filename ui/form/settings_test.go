@@ -2,6 +2,9 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
 )
 
 func TestValid(t *testing.T) {
@@ -79,3 +82,155 @@ func TestEntriesPerPageNotValid(t *testing.T) {
 		t.Error("Validate should return an error")
 	}
 }
+
+func TestEmailRecipientsNotValid(t *testing.T) {
+	settings := &SettingsForm{
+		Username:        "user",
+		Theme:           "default",
+		Language:        "en_US",
+		Timezone:        "UTC",
+		EntryDirection:  "asc",
+		EntriesPerPage:  50,
+		EmailRecipients: "alice@example.org, not an address",
+	}
+
+	err := settings.Validate()
+	if err == nil {
+		t.Error("Validate should return an error")
+	}
+}
+
+func TestHomePageSetting(t *testing.T) {
+	scenarios := map[string]*SettingsForm{
+		"unread":        {},
+		"starred":       {HomePage: "starred"},
+		"category:42":   {HomePage: "category:42"},
+		"search:golang": {HomePage: "search", HomePageSearch: "golang"},
+	}
+
+	for expected, settings := range scenarios {
+		if result := settings.HomePageSetting(); result != expected {
+			t.Errorf(`Unexpected home page, got %q instead of %q`, result, expected)
+		}
+	}
+}
+
+func TestHomePageSearchEmpty(t *testing.T) {
+	settings := &SettingsForm{
+		Username:       "user",
+		Theme:          "default",
+		Language:       "en_US",
+		Timezone:       "UTC",
+		EntryDirection: "asc",
+		EntriesPerPage: 50,
+		HomePage:       "search",
+	}
+
+	if err := settings.Validate(); err == nil {
+		t.Error("Validation should fail without search query")
+	}
+}
+
+func TestInvalidTimestampFormat(t *testing.T) {
+	settings := &SettingsForm{
+		Username:        "user",
+		Theme:           "default",
+		Language:        "en_US",
+		Timezone:        "UTC",
+		EntryDirection:  "asc",
+		EntriesPerPage:  50,
+		TimestampFormat: "iso",
+	}
+
+	err := settings.Validate()
+	if err == nil {
+		t.Error("Validate should return an error")
+	}
+}
+
+func TestInvalidNotificationEmail(t *testing.T) {
+	settings := &SettingsForm{
+		Username:          "user",
+		Theme:             "default",
+		Language:          "en_US",
+		Timezone:          "UTC",
+		EntryDirection:    "asc",
+		EntriesPerPage:    50,
+		NotificationEmail: "alice@example.org, bob@example.org",
+	}
+
+	err := settings.Validate()
+	if err == nil {
+		t.Error("Validate should return an error")
+	}
+}
+
+func TestKeepStarredEntriesSetting(t *testing.T) {
+	settings := &SettingsForm{KeepStarredEntries: true}
+	user := settings.Merge(model.NewUser())
+
+	if !user.KeepStarredEntries {
+		t.Error(`The setting should be copied to the user`)
+	}
+}
+
+func TestAcceptReceivedEntriesSetting(t *testing.T) {
+	settings := &SettingsForm{AcceptReceivedEntries: true}
+	user := settings.Merge(model.NewUser())
+
+	if !user.AcceptReceivedEntries {
+		t.Error(`The setting should be copied to the user`)
+	}
+}
+
+func TestArchiveReadDaysNotValid(t *testing.T) {
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	for _, days := range []int{-1, -30} {
+		settings := &SettingsForm{
+			Username:        "user",
+			Theme:           "default",
+			Language:        "en_US",
+			Timezone:        "UTC",
+			EntryDirection:  "asc",
+			EntriesPerPage:  50,
+			ArchiveReadDays: days,
+		}
+
+		if err := settings.Validate(); err == nil {
+			t.Errorf(`Validate should return an error for %d days`, days)
+		}
+	}
+}
+
+func TestArchiveReadDaysAboveMaximum(t *testing.T) {
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	settings := &SettingsForm{
+		Username:        "user",
+		Theme:           "default",
+		Language:        "en_US",
+		Timezone:        "UTC",
+		EntryDirection:  "asc",
+		EntriesPerPage:  50,
+		ArchiveReadDays: config.Opts.CleanupArchiveReadDaysMax() + 1,
+	}
+
+	if err := settings.Validate(); err != nil {
+		t.Fatalf(`A number of days above the maximum should be limited instead of rejected: %v`, err)
+	}
+
+	if settings.ArchiveReadDays != config.Opts.CleanupArchiveReadDaysMax() {
+		t.Errorf(`Unexpected number of days, got %d`, settings.ArchiveReadDays)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+
+	"miniflux.app/errors"
+)
+
+// Actions available on the feeds suggested for removal.
+const (
+	UnusedFeedsActionRemove = "remove"
+	UnusedFeedsActionKeep   = "keep"
+)
+
+// UnusedFeedsForm represents the review of the feeds suggested for removal.
+type UnusedFeedsForm struct {
+	FeedIDs []int64
+	Action  string
+}
+
+// Validate makes sure the form values are valid.
+func (u UnusedFeedsForm) Validate() error {
+	if len(u.FeedIDs) == 0 {
+		return errors.NewLocalizedError("error.no_feed_selected")
+	}
+
+	if u.Action != UnusedFeedsActionRemove && u.Action != UnusedFeedsActionKeep {
+		return errors.NewLocalizedError("error.fields_mandatory")
+	}
+
+	return nil
+}
+
+// NewUnusedFeedsForm returns a new UnusedFeedsForm.
+func NewUnusedFeedsForm(r *http.Request) *UnusedFeedsForm {
+	r.ParseForm()
+
+	var feedIDs []int64
+	for _, value := range r.Form["feed_id"] {
+		if feedID, err := strconv.ParseInt(value, 10, 64); err == nil && feedID > 0 {
+			feedIDs = append(feedIDs, feedID)
+		}
+	}
+
+	return &UnusedFeedsForm{
+		FeedIDs: feedIDs,
+		Action:  r.FormValue("action"),
+	}
+}
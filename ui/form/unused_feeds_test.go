@@ -0,0 +1,41 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestNewUnusedFeedsForm(t *testing.T) {
+	values := url.Values{"feed_id": {"1", "invalid", "-2", "3"}, "action": {"remove"}}
+	r, _ := http.NewRequest(http.MethodPost, "/feeds/unused", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := NewUnusedFeedsForm(r)
+	if len(form.FeedIDs) != 2 || form.FeedIDs[0] != 1 || form.FeedIDs[1] != 3 {
+		t.Errorf(`Unexpected feed IDs: %v`, form.FeedIDs)
+	}
+
+	if err := form.Validate(); err != nil {
+		t.Errorf(`The form should be valid: %v`, err)
+	}
+}
+
+func TestValidateUnusedFeedsForm(t *testing.T) {
+	scenarios := []UnusedFeedsForm{
+		{FeedIDs: nil, Action: UnusedFeedsActionKeep},
+		{FeedIDs: []int64{1}, Action: ""},
+		{FeedIDs: []int64{1}, Action: "archive"},
+	}
+
+	for _, form := range scenarios {
+		if err := form.Validate(); err == nil {
+			t.Errorf(`The form %+v should be invalid`, form)
+		}
+	}
+}
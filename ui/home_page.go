@@ -0,0 +1,71 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"miniflux.app/http/route"
+	"miniflux.app/model"
+)
+
+// homePageURL returns the page chosen by the user to start reading, the unread entries are shown by default.
+func (h *handler) homePageURL(user *model.User) string {
+	page, argument := model.ParseHomePage(user.HomePage)
+
+	switch page {
+	case model.HomePageToday, model.HomePageStarred, model.HomePageHistory, model.HomePageTrending, model.HomePageFeeds, model.HomePageCategories:
+		return route.Path(h.router, page)
+	case model.HomePageCategory:
+		// The category may have been removed since the setting was saved.
+		categoryID := model.HomePageCategoryID(user.HomePage)
+		if h.store.CategoryExists(user.ID, categoryID) {
+			return route.Path(h.router, "categoryEntries", "categoryID", categoryID)
+		}
+	case model.HomePageSearch:
+		return route.Path(h.router, "searchEntries") + "?q=" + url.QueryEscape(argument)
+	case model.HomePageSavedSearch:
+		// The saved search may have been removed since the setting was saved.
+		savedSearch, err := h.store.SavedSearchByID(user.ID, model.HomePageSavedSearchID(user.HomePage))
+		if err == nil && savedSearch != nil {
+			return h.savedSearchURL(savedSearch)
+		}
+	}
+
+	return route.Path(h.router, "unread")
+}
+
+// savedSearchURL returns the search page of a saved search, limited to its feed or category.
+func (h *handler) savedSearchURL(savedSearch *model.SavedSearch) string {
+	values := url.Values{}
+	values.Set("q", savedSearch.Query)
+	if savedSearch.FeedID > 0 {
+		values.Set("feed_id", strconv.FormatInt(savedSearch.FeedID, 10))
+	}
+
+	if savedSearch.CategoryID > 0 {
+		values.Set("category_id", strconv.FormatInt(savedSearch.CategoryID, 10))
+	}
+
+	return route.Path(h.router, "searchEntries") + "?" + values.Encode()
+}
+
+// loginRedirectURL returns the digest of the missed entries when the user comes back after a break,
+// the home page otherwise.
+func (h *handler) loginRedirectURL(user *model.User) string {
+	if user.IsReturningVisit(time.Now()) {
+		builder := h.store.NewEntryQueryBuilder(user.ID)
+		builder.WithStatus(model.EntryStatusUnread)
+		builder.WithGloballyVisible()
+		builder.AfterCreatedDate(*user.LastSeenAt)
+		if count, err := builder.CountEntries(); err == nil && count > 0 {
+			return route.Path(h.router, "digest")
+		}
+	}
+
+	return h.homePageURL(user)
+}
@@ -10,6 +10,8 @@ import (
 	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/integration/custombookmark"
+	"miniflux.app/model"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -29,28 +31,85 @@ func (h *handler) showIntegrationPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	integrationForm := form.IntegrationForm{
-		PinboardEnabled:      integration.PinboardEnabled,
-		PinboardToken:        integration.PinboardToken,
-		PinboardTags:         integration.PinboardTags,
-		PinboardMarkAsUnread: integration.PinboardMarkAsUnread,
-		InstapaperEnabled:    integration.InstapaperEnabled,
-		InstapaperUsername:   integration.InstapaperUsername,
-		InstapaperPassword:   integration.InstapaperPassword,
-		FeverEnabled:         integration.FeverEnabled,
-		FeverUsername:        integration.FeverUsername,
-		FeverPassword:        integration.FeverPassword,
-		WallabagEnabled:      integration.WallabagEnabled,
-		WallabagURL:          integration.WallabagURL,
-		WallabagClientID:     integration.WallabagClientID,
-		WallabagClientSecret: integration.WallabagClientSecret,
-		WallabagUsername:     integration.WallabagUsername,
-		WallabagPassword:     integration.WallabagPassword,
-		NunuxKeeperEnabled:   integration.NunuxKeeperEnabled,
-		NunuxKeeperURL:       integration.NunuxKeeperURL,
-		NunuxKeeperAPIKey:    integration.NunuxKeeperAPIKey,
-		PocketEnabled:        integration.PocketEnabled,
-		PocketAccessToken:    integration.PocketAccessToken,
-		PocketConsumerKey:    integration.PocketConsumerKey,
+		PinboardEnabled:        integration.PinboardEnabled,
+		PinboardToken:          integration.PinboardToken,
+		PinboardTags:           integration.PinboardTags,
+		PinboardMarkAsUnread:   integration.PinboardMarkAsUnread,
+		InstapaperEnabled:      integration.InstapaperEnabled,
+		InstapaperUsername:     integration.InstapaperUsername,
+		InstapaperPassword:     integration.InstapaperPassword,
+		FeverEnabled:           integration.FeverEnabled,
+		FeverUsername:          integration.FeverUsername,
+		FeverPassword:          integration.FeverPassword,
+		WallabagEnabled:        integration.WallabagEnabled,
+		WallabagURL:            integration.WallabagURL,
+		WallabagClientID:       integration.WallabagClientID,
+		WallabagClientSecret:   integration.WallabagClientSecret,
+		WallabagUsername:       integration.WallabagUsername,
+		WallabagPassword:       integration.WallabagPassword,
+		NunuxKeeperEnabled:     integration.NunuxKeeperEnabled,
+		NunuxKeeperURL:         integration.NunuxKeeperURL,
+		NunuxKeeperAPIKey:      integration.NunuxKeeperAPIKey,
+		PocketEnabled:          integration.PocketEnabled,
+		PocketAccessToken:      integration.PocketAccessToken,
+		PocketConsumerKey:      integration.PocketConsumerKey,
+		RSSBridgeEnabled:       integration.RSSBridgeEnabled,
+		RSSBridgeURL:           integration.RSSBridgeURL,
+		PinboardCategoryTag:    integration.PinboardCategoryTag,
+		PinboardEntryTags:      integration.PinboardEntryTags,
+		PinboardPromptTags:     integration.PinboardPromptTags,
+		WallabagTags:           integration.WallabagTags,
+		WallabagArchive:        integration.WallabagArchive,
+		InstapaperFolderID:     integration.InstapaperFolderID,
+		PocketCategoryTag:      integration.PocketCategoryTag,
+		CustomBookmarkEnabled:  integration.CustomBookmarkEnabled,
+		CustomBookmarkURL:      integration.CustomBookmarkURL,
+		CustomBookmarkMethod:   integration.CustomBookmarkMethod,
+		CustomBookmarkHeaders:  integration.CustomBookmarkHeaders,
+		CustomBookmarkBody:     integration.CustomBookmarkBody,
+		CategoryRoutes:         integration.CategoryRoutes,
+		MarkdownEnabled:        integration.MarkdownEnabled,
+		MarkdownWebDAVURL:      integration.MarkdownWebDAVURL,
+		MarkdownWebDAVUsername: integration.MarkdownWebDAVUsername,
+		MarkdownWebDAVPassword: integration.MarkdownWebDAVPassword,
+		MarkdownStarred:        integration.MarkdownStarred,
+		HypothesisEnabled:      integration.HypothesisEnabled,
+		HypothesisToken:        integration.HypothesisToken,
+		HypothesisGroup:        integration.HypothesisGroup,
+		ZoteroEnabled:          integration.ZoteroEnabled,
+		ZoteroLibraryType:      integration.ZoteroLibraryType,
+		ZoteroLibraryID:        integration.ZoteroLibraryID,
+		ZoteroAPIKey:           integration.ZoteroAPIKey,
+		ZoteroCollectionKey:    integration.ZoteroCollectionKey,
+		KarakeepEnabled:        integration.KarakeepEnabled,
+		KarakeepURL:            integration.KarakeepURL,
+		KarakeepAPIKey:         integration.KarakeepAPIKey,
+		KarakeepTags:           integration.KarakeepTags,
+		EspialEnabled:          integration.EspialEnabled,
+		EspialURL:              integration.EspialURL,
+		EspialAPIKey:           integration.EspialAPIKey,
+		EspialTags:             integration.EspialTags,
+		LinkAceEnabled:         integration.LinkAceEnabled,
+		LinkAceURL:             integration.LinkAceURL,
+		LinkAceAPIKey:          integration.LinkAceAPIKey,
+		LinkAceTags:            integration.LinkAceTags,
+		LinkAceLists:           integration.LinkAceLists,
+		LinkAceCategoryTag:     integration.LinkAceCategoryTag,
+		LinkAceIsPrivate:       integration.LinkAceIsPrivate,
+		WaybackEnabled:         integration.WaybackEnabled,
+		ArchiveBoxEnabled:      integration.ArchiveBoxEnabled,
+		ArchiveBoxURL:          integration.ArchiveBoxURL,
+		ArchiveBoxAPIKey:       integration.ArchiveBoxAPIKey,
+		ArchiveBoxTags:         integration.ArchiveBoxTags,
+		NtfyEnabled:            integration.NtfyEnabled,
+		NtfyTopicURL:           integration.NtfyTopicURL,
+		NtfyToken:              integration.NtfyToken,
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
@@ -61,6 +120,12 @@ func (h *handler) showIntegrationPage(w http.ResponseWriter, r *http.Request) {
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	view.Set("hasPocketConsumerKeyConfigured", config.Opts.PocketConsumerKey("") != "")
+	view.Set("hasInstapaperFullAPI", config.Opts.HasInstapaperFullAPI())
+	view.Set("instapaperFolders", integration.InstapaperFolders)
+	view.Set("customBookmarkDefaultBody", custombookmark.DefaultBodyTemplate)
+	view.Set("hasMarkdownExportDir", config.Opts.MarkdownExportDir() != "")
+	view.Set("categories", categories)
+	view.Set("routableServices", model.RoutableServices)
 
 	html.OK(w, r, view.Render("integrations"))
 }
@@ -5,14 +5,20 @@
 package ui // import "miniflux.app/ui"
 
 import (
-	"crypto/md5"
-	"fmt"
 	"net/http"
 
-	"miniflux.app/http/response/html"
+	"miniflux.app/config"
 	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/integration/custombookmark"
+	"miniflux.app/integration/instapaper"
+	"miniflux.app/integration/linkace"
+	"miniflux.app/integration/markdown"
+	"miniflux.app/integration/ntfy"
+	"miniflux.app/integration/zotero"
 	"miniflux.app/locale"
+	"miniflux.app/model"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 )
@@ -41,12 +47,101 @@ func (h *handler) updateIntegration(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if integration.FeverEnabled {
-		integration.FeverToken = fmt.Sprintf("%x", md5.Sum([]byte(integration.FeverUsername+":"+integration.FeverPassword)))
-	} else {
-		integration.FeverToken = ""
+	if integration.CustomBookmarkEnabled {
+		err := custombookmark.Validate(
+			integration.CustomBookmarkURL,
+			integration.CustomBookmarkMethod,
+			integration.CustomBookmarkHeaders,
+			integration.CustomBookmarkBody,
+		)
+
+		if err != nil {
+			sess.NewFlashErrorMessage(printer.Printf("error.custom_bookmark_invalid", err))
+			html.Redirect(w, r, route.Path(h.router, "integrations"))
+			return
+		}
+	}
+
+	if integration.MarkdownEnabled {
+		if integration.MarkdownWebDAVURL != "" {
+			if err := markdown.ValidateWebDAVURL(integration.MarkdownWebDAVURL); err != nil {
+				sess.NewFlashErrorMessage(printer.Printf("error.markdown_invalid", err))
+				html.Redirect(w, r, route.Path(h.router, "integrations"))
+				return
+			}
+		} else if config.Opts.MarkdownExportDir() == "" {
+			sess.NewFlashErrorMessage(printer.Printf("error.markdown_webdav_url_required"))
+			html.Redirect(w, r, route.Path(h.router, "integrations"))
+			return
+		}
+	}
+
+	if integration.ArchiveBoxEnabled && (integration.ArchiveBoxURL == "" || integration.ArchiveBoxAPIKey == "") {
+		sess.NewFlashErrorMessage(printer.Printf("error.archivebox_credentials_required"))
+		html.Redirect(w, r, route.Path(h.router, "integrations"))
+		return
+	}
+
+	if integration.HypothesisEnabled && integration.HypothesisToken == "" {
+		sess.NewFlashErrorMessage(printer.Printf("error.hypothesis_token_required"))
+		html.Redirect(w, r, route.Path(h.router, "integrations"))
+		return
+	}
+
+	if integration.HypothesisEnabled && integration.HypothesisGroup == "" {
+		sess.NewFlashErrorMessage(printer.Printf("error.hypothesis_group_required"))
+		html.Redirect(w, r, route.Path(h.router, "integrations"))
+		return
+	}
+
+	if integration.LinkAceEnabled {
+		if _, err := linkace.ParseListIDs(integration.LinkAceLists); err != nil {
+			sess.NewFlashErrorMessage(printer.Printf("error.linkace_invalid_lists", err))
+			html.Redirect(w, r, route.Path(h.router, "integrations"))
+			return
+		}
+	}
+
+	if integration.NtfyEnabled {
+		if err := ntfy.ValidateTopicURL(integration.NtfyTopicURL); err != nil {
+			sess.NewFlashErrorMessage(printer.Printf("error.ntfy_invalid_topic_url"))
+			html.Redirect(w, r, route.Path(h.router, "integrations"))
+			return
+		}
 	}
 
+	// The credentials are checked once here, the folders are kept to render the page without calling Instapaper.
+	integration.InstapaperFolders = nil
+	if integration.InstapaperEnabled && config.Opts.HasInstapaperFullAPI() {
+		client := instapaper.NewClient(
+			integration.InstapaperUsername,
+			integration.InstapaperPassword,
+			config.Opts.InstapaperConsumerKey(),
+			config.Opts.InstapaperConsumerSecret(),
+		)
+
+		folders, err := client.Folders()
+		if err != nil {
+			sess.NewFlashErrorMessage(printer.Printf("error.instapaper_invalid", err))
+			html.Redirect(w, r, route.Path(h.router, "integrations"))
+			return
+		}
+
+		for _, folder := range folders {
+			integration.InstapaperFolders = append(integration.InstapaperFolders, &model.InstapaperFolder{ID: folder.ID, Title: folder.Title})
+		}
+	}
+
+	if integration.ZoteroEnabled {
+		if err := zotero.Validate(integration.ZoteroLibraryType, integration.ZoteroLibraryID, integration.ZoteroAPIKey); err != nil {
+			sess.NewFlashErrorMessage(printer.Printf("error.zotero_invalid", err))
+			html.Redirect(w, r, route.Path(h.router, "integrations"))
+			return
+		}
+	}
+
+	integration.UpdateFeverToken()
+
 	err = h.store.UpdateIntegration(integration)
 	if err != nil {
 		html.ServerError(w, r, err)
@@ -0,0 +1,115 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/live"
+	"miniflux.app/logger"
+
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// Several counter changes happening at once, like during a refresh, are sent as a single event.
+	liveCountersDelay = time.Second
+
+	// A message is sent regularly to keep the connection open through proxies.
+	liveKeepAliveInterval = 30 * time.Second
+)
+
+type liveCounters struct {
+	Unread     int `json:"unread"`
+	ErrorFeeds int `json:"error_feeds"`
+}
+
+func (h *handler) liveUpdates(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	server := websocket.Server{
+		Handshake: checkLiveUpdatesOrigin,
+		Handler: func(conn *websocket.Conn) {
+			defer conn.Close()
+			h.sendLiveUpdates(conn, userID)
+		},
+	}
+
+	server.ServeHTTP(w, r)
+}
+
+func (h *handler) sendLiveUpdates(conn *websocket.Conn, userID int64) {
+	events := h.store.Events().Subscribe(userID)
+	defer h.store.Events().Unsubscribe(userID, events)
+
+	// The timeouts of the HTTP server still apply to the hijacked connection.
+	conn.SetDeadline(time.Time{})
+
+	// The browser doesn't send anything, reading only detects when the connection is closed.
+	closed := make(chan struct{})
+	go func() {
+		io.Copy(ioutil.Discard, conn)
+		close(closed)
+	}()
+
+	keepAlive := time.NewTicker(liveKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	var counters <-chan time.Time
+
+	for {
+		var event live.Event
+
+		select {
+		case <-closed:
+			return
+		case <-keepAlive.C:
+			event = live.Event{Type: "keepalive"}
+		case <-counters:
+			counters = nil
+			event = live.Event{Type: live.EventCountersChanged, Data: liveCounters{
+				Unread:     h.store.CountUnreadEntries(userID),
+				ErrorFeeds: h.store.CountUserFeedsWithErrors(userID),
+			}}
+		case event = <-events:
+			if event.Type == live.EventCountersChanged {
+				if counters == nil {
+					counters = time.After(liveCountersDelay)
+				}
+				continue
+			}
+		}
+
+		conn.SetWriteDeadline(time.Now().Add(liveKeepAliveInterval))
+		if err := websocket.JSON.Send(conn, event); err != nil {
+			logger.Debug("[UI:LiveUpdates] userID=%d: %v", userID, err)
+			return
+		}
+	}
+}
+
+// checkLiveUpdatesOrigin prevents other websites from opening a connection with the cookies of the user.
+func checkLiveUpdatesOrigin(wsConfig *websocket.Config, r *http.Request) error {
+	if wsConfig.Origin == nil {
+		return errors.New("websocket: missing origin")
+	}
+
+	if wsConfig.Origin.Host == r.Host {
+		return nil
+	}
+
+	if rootURL, err := url.Parse(config.Opts.RootURL()); err == nil && wsConfig.Origin.Host == rootURL.Host {
+		return nil
+	}
+
+	return errors.New("websocket: invalid origin")
+}
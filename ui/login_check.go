@@ -7,7 +7,6 @@ import (
 	"miniflux.app/http/cookie"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
-	"miniflux.app/http/route"
 	"miniflux.app/logger"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
@@ -53,12 +52,7 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 	sess.SetLanguage(user.Language)
 	sess.SetTheme(user.Theme)
 
-	http.SetCookie(w, cookie.New(
-		cookie.CookieUserSessionID,
-		sessionToken,
-		config.Opts.HTTPS,
-		config.Opts.BasePath(),
-	))
+	http.SetCookie(w, cookie.New(cookie.CookieUserSessionID, sessionToken, config.Opts))
 
-	html.Redirect(w, r, route.Path(h.router, "unread"))
+	html.Redirect(w, r, h.loginRedirectURL(user))
 }
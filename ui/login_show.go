@@ -16,7 +16,13 @@ import (
 
 func (h *handler) showLoginPage(w http.ResponseWriter, r *http.Request) {
 	if request.IsAuthenticated(r) {
-		html.Redirect(w, r, route.Path(h.router, "unread"))
+		user, err := h.store.UserByID(request.UserID(r))
+		if err != nil || user == nil {
+			html.Redirect(w, r, route.Path(h.router, "unread"))
+			return
+		}
+
+		html.Redirect(w, r, h.homePageURL(user))
 		return
 	}
 
@@ -31,11 +31,7 @@ func (h *handler) logout(w http.ResponseWriter, r *http.Request) {
 		logger.Error("[UI:Logout] %v", err)
 	}
 
-	http.SetCookie(w, cookie.Expired(
-		cookie.CookieUserSessionID,
-		config.Opts.HTTPS,
-		config.Opts.BasePath(),
-	))
+	http.SetCookie(w, cookie.Expired(cookie.CookieUserSessionID, config.Opts))
 
 	html.Redirect(w, r, route.Path(h.router, "login"))
 }
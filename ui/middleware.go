@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	"miniflux.app/config"
 	"miniflux.app/http/cookie"
@@ -45,6 +46,10 @@ func (m *middleware) handleUserSession(next http.Handler) http.Handler {
 		} else {
 			logger.Debug("[UI:UserSession] %s", session)
 
+			if err := m.store.UpdateUserVisit(session.UserID); err != nil {
+				logger.Error("[UI:UserSession] %v", err)
+			}
+
 			ctx := r.Context()
 			ctx = context.WithValue(ctx, request.UserIDContextKey, session.UserID)
 			ctx = context.WithValue(ctx, request.IsAuthenticatedContextKey, true)
@@ -78,7 +83,7 @@ func (m *middleware) handleAppSession(next http.Handler) http.Handler {
 				}
 			}
 
-			http.SetCookie(w, cookie.New(cookie.CookieAppSessionID, session.ID, config.Opts.HTTPS, config.Opts.BasePath()))
+			http.SetCookie(w, cookie.New(cookie.CookieAppSessionID, session.ID, config.Opts))
 		} else {
 			logger.Debug("[UI:AppSession] %s", session)
 		}
@@ -114,7 +119,7 @@ func (m *middleware) handleAppSession(next http.Handler) http.Handler {
 }
 
 func (m *middleware) getAppSessionValueFromCookie(r *http.Request) *model.Session {
-	cookieValue := request.CookieValue(r, cookie.CookieAppSessionID)
+	cookieValue := request.CookieValue(r, cookie.Name(cookie.CookieAppSessionID, config.Opts))
 	if cookieValue == "" {
 		return nil
 	}
@@ -150,7 +155,7 @@ func (m *middleware) isPublicRoute(r *http.Request) bool {
 }
 
 func (m *middleware) getUserSessionFromCookie(r *http.Request) *model.UserSession {
-	cookieValue := request.CookieValue(r, cookie.CookieUserSessionID)
+	cookieValue := request.CookieValue(r, cookie.Name(cookie.CookieUserSessionID, config.Opts))
 	if cookieValue == "" {
 		return nil
 	}
@@ -161,6 +166,13 @@ func (m *middleware) getUserSessionFromCookie(r *http.Request) *model.UserSessio
 		return nil
 	}
 
+	// The session is kept in the database until the cleanup job, the cookie could be older than the lifetime.
+	lifetime := time.Duration(config.Opts.SessionLifetimeDays()) * 24 * time.Hour
+	if session != nil && time.Since(session.CreatedAt) > lifetime {
+		logger.Debug("[UI:UserSession] Session expired: %s", session)
+		return nil
+	}
+
 	return session
 }
 
@@ -216,12 +228,7 @@ func (m *middleware) handleAuthProxy(next http.Handler) http.Handler {
 		sess.SetLanguage(user.Language)
 		sess.SetTheme(user.Theme)
 
-		http.SetCookie(w, cookie.New(
-			cookie.CookieUserSessionID,
-			sessionToken,
-			config.Opts.HTTPS,
-			config.Opts.BasePath(),
-		))
+		http.SetCookie(w, cookie.New(cookie.CookieUserSessionID, sessionToken, config.Opts))
 
 		html.Redirect(w, r, route.Path(m.router, "unread"))
 	})
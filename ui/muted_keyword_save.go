@@ -0,0 +1,65 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/timezone"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) saveMutedKeyword(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	mutedKeywordForm := form.NewMutedKeywordForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", mutedKeywordForm)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	if err := mutedKeywordForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("create_muted_keyword"))
+		return
+	}
+
+	if h.store.MutedKeywordExists(user.ID, mutedKeywordForm.Pattern) {
+		view.Set("errorMessage", "error.muted_keyword_already_exists")
+		html.OK(w, r, view.Render("create_muted_keyword"))
+		return
+	}
+
+	mutedKeyword := &model.MutedKeyword{
+		UserID:    user.ID,
+		Pattern:   mutedKeywordForm.Pattern,
+		Action:    mutedKeywordForm.Action,
+		ExpiresAt: mutedKeywordForm.ExpirationTime(timezone.Now(user.Timezone).Location()),
+	}
+
+	if err = h.store.CreateMutedKeyword(mutedKeyword); err != nil {
+		logger.Error("[UI:SaveMutedKeyword] %v", err)
+		view.Set("errorMessage", "error.unable_to_create_muted_keyword")
+		html.OK(w, r, view.Render("create_muted_keyword"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "mutedKeywords"))
+}
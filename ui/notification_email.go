@@ -0,0 +1,88 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/mail"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+)
+
+func (h *handler) sendNotificationEmailVerification(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	printer := locale.NewPrinter(user.Language)
+
+	if err := h.mailNotificationEmailToken(user); err != nil {
+		logger.Error("[UI:SendNotificationEmailVerification] %v", err)
+		sess.NewFlashErrorMessage(printer.Printf("error.unable_to_send_email"))
+	} else {
+		sess.NewFlashMessage(printer.Printf("alert.notification_email_verification_sent"))
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "settings"))
+}
+
+func (h *handler) verifyNotificationEmail(w http.ResponseWriter, r *http.Request) {
+	verified, err := h.store.VerifyNotificationEmail(request.UserID(r), request.RouteStringParam(r, "token"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	printer := locale.NewPrinter(request.UserLanguage(r))
+
+	if verified {
+		sess.NewFlashMessage(printer.Printf("alert.notification_email_verified"))
+	} else {
+		sess.NewFlashErrorMessage(printer.Printf("error.invalid_notification_email_token"))
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "settings"))
+}
+
+// mailNotificationEmailToken sends a link to the notification email address, the address is used once the link is opened.
+func (h *handler) mailNotificationEmailToken(user *model.User) error {
+	if user.NotificationEmail == "" || !config.Opts.HasSMTP() {
+		return nil
+	}
+
+	token := crypto.GenerateRandomStringHex(20)
+	if err := h.store.SetNotificationEmailToken(user.ID, token); err != nil {
+		return err
+	}
+
+	printer := locale.NewPrinter(user.Language)
+	verificationURL := config.Opts.RootURL() + route.Path(h.router, "verifyNotificationEmail", "token", token)
+
+	client := mail.NewClient(
+		config.Opts.SMTPHost(),
+		config.Opts.SMTPPort(),
+		config.Opts.SMTPUsername(),
+		config.Opts.SMTPPassword(),
+		config.Opts.SMTPFrom(),
+	)
+
+	return client.Send(
+		[]string{user.NotificationEmail},
+		printer.Printf("email.notification_email_verification.subject"),
+		printer.Printf("email.notification_email_verification.body", user.Username, verificationURL),
+	)
+}
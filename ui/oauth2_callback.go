@@ -119,12 +119,7 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 	sess.SetLanguage(user.Language)
 	sess.SetTheme(user.Theme)
 
-	http.SetCookie(w, cookie.New(
-		cookie.CookieUserSessionID,
-		sessionToken,
-		config.Opts.HTTPS,
-		config.Opts.BasePath(),
-	))
-
-	html.Redirect(w, r, route.Path(h.router, "unread"))
+	http.SetCookie(w, cookie.New(cookie.CookieUserSessionID, sessionToken, config.Opts))
+
+	html.Redirect(w, r, h.loginRedirectURL(user))
 }
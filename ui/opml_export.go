@@ -7,9 +7,10 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/crypto"
 	"miniflux.app/http/request"
+	"miniflux.app/http/response"
 	"miniflux.app/http/response/html"
-	"miniflux.app/http/response/xml"
 	"miniflux.app/reader/opml"
 )
 
@@ -20,5 +21,10 @@ func (h *handler) exportFeeds(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	xml.Attachment(w, r, "feeds.opml", opml)
+	response.New(w, r).WithPrivateCaching(crypto.Hash(opml), func(b *response.Builder) {
+		b.WithHeader("Content-Type", "text/xml; charset=utf-8")
+		b.WithAttachment("feeds.opml")
+		b.WithBody(opml)
+		b.Write()
+	})
 }
@@ -14,6 +14,9 @@ type pagination struct {
 	NextOffset   int
 	PrevOffset   int
 	SearchQuery  string
+
+	SearchFeedID     int64
+	SearchCategoryID int64
 }
 
 func getPagination(route string, total, offset, nbItemsPerPage int) pagination {
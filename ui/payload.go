@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"miniflux.app/model"
 )
@@ -31,3 +32,117 @@ func decodeEntryStatusPayload(r io.ReadCloser) (entryIDs []int64, status string,
 
 	return p.EntryIDs, p.Status, nil
 }
+
+type entriesStatusResponse struct {
+	ChangedAt int64 `json:"changed_at"`
+	Unread    int   `json:"unread"`
+}
+
+func decodeReadingPositionPayload(r io.ReadCloser) (float64, error) {
+	type payload struct {
+		Position float64 `json:"position"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return 0, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if err := model.ValidateReadingPosition(p.Position); err != nil {
+		return 0, err
+	}
+
+	return p.Position, nil
+}
+
+// decodeSaveEntryPayload returns the tags typed when saving an entry, the request body is optional.
+func decodeSaveEntryPayload(r io.ReadCloser) (string, error) {
+	type payload struct {
+		Tags string `json:"tags"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil && err != io.EOF {
+		return "", fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return p.Tags, nil
+}
+
+// decodeSaveEntriesPayload returns the selected entries and the tags typed when saving them.
+func decodeSaveEntriesPayload(r io.ReadCloser) ([]int64, string, error) {
+	type payload struct {
+		EntryIDs []int64 `json:"entry_ids"`
+		Tags     string  `json:"tags"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, "", fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if len(p.EntryIDs) == 0 {
+		return nil, "", fmt.Errorf("no entry selected")
+	}
+
+	if len(p.EntryIDs) > maxSaveEntriesBatchSize {
+		return nil, "", fmt.Errorf("too many entries selected, the limit is %d", maxSaveEntriesBatchSize)
+	}
+
+	return p.EntryIDs, p.Tags, nil
+}
+
+// decodeMoveFeedsPayload returns the feeds dropped on a category and the destination category.
+func decodeMoveFeedsPayload(r io.ReadCloser) ([]int64, int64, error) {
+	type payload struct {
+		FeedIDs    []int64 `json:"feed_ids"`
+		CategoryID int64   `json:"category_id"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, 0, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if err := model.ValidateFeedsMove(p.FeedIDs, p.CategoryID); err != nil {
+		return nil, 0, err
+	}
+
+	return p.FeedIDs, p.CategoryID, nil
+}
+
+// decodeSaveSearchPayload returns the search query to save with its scope.
+func decodeSaveSearchPayload(r io.ReadCloser) (*model.SavedSearch, error) {
+	type payload struct {
+		Query      string `json:"query"`
+		FeedID     int64  `json:"feed_id"`
+		CategoryID int64  `json:"category_id"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	savedSearch := &model.SavedSearch{
+		Query:      strings.TrimSpace(p.Query),
+		FeedID:     p.FeedID,
+		CategoryID: p.CategoryID,
+	}
+
+	if err := model.ValidateSearchQuery(savedSearch.Query); err != nil {
+		return nil, err
+	}
+
+	return savedSearch, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestDecodeMoveFeedsPayload(t *testing.T) {
+	feedIDs, categoryID, err := decodeMoveFeedsPayload(ioutil.NopCloser(strings.NewReader(`{"feed_ids": [1, 2], "category_id": 3}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feedIDs) != 2 || feedIDs[0] != 1 || categoryID != 3 {
+		t.Errorf(`Unexpected payload: %v, %d`, feedIDs, categoryID)
+	}
+
+	tooManyFeeds := strings.Repeat("1,", model.MaxMovedFeeds) + "1"
+	payloads := []string{
+		`{"feed_ids": [], "category_id": 3}`,
+		`{"feed_ids": [1], "category_id": 0}`,
+		fmt.Sprintf(`{"feed_ids": [%s], "category_id": 3}`, tooManyFeeds),
+		`{"feed_ids": "1"}`,
+	}
+
+	for _, payload := range payloads {
+		if _, _, err := decodeMoveFeedsPayload(ioutil.NopCloser(strings.NewReader(payload))); err == nil {
+			t.Errorf(`The payload %.40q should generate an error`, payload)
+		}
+	}
+}
+
+func TestDecodeSaveSearchPayload(t *testing.T) {
+	savedSearch, err := decodeSaveSearchPayload(ioutil.NopCloser(strings.NewReader(`{"query": " golang ", "feed_id": 4}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if savedSearch.Query != "golang" || savedSearch.FeedID != 4 || savedSearch.CategoryID != 0 {
+		t.Errorf(`Unexpected payload: %+v`, savedSearch)
+	}
+
+	payloads := []string{
+		`{"query": "  "}`,
+		fmt.Sprintf(`{"query": %q}`, strings.Repeat("a", model.SearchQueryMaxLength+1)),
+		`{"query": 1}`,
+	}
+
+	for _, payload := range payloads {
+		if _, err := decodeSaveSearchPayload(ioutil.NopCloser(strings.NewReader(payload))); err == nil {
+			t.Errorf(`The payload %.40q should generate an error`, payload)
+		}
+	}
+}
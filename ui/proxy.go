@@ -20,12 +20,6 @@ import (
 )
 
 func (h *handler) imageProxy(w http.ResponseWriter, r *http.Request) {
-	// If we receive a "If-None-Match" header, we assume the image is already stored in browser cache.
-	if r.Header.Get("If-None-Match") != "" {
-		w.WriteHeader(http.StatusNotModified)
-		return
-	}
-
 	encodedURL := request.RouteStringParam(r, "encodedURL")
 	if encodedURL == "" {
 		html.BadRequest(w, r, errors.New("No URL provided"))
@@ -38,36 +32,51 @@ func (h *handler) imageProxy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	imageURL := string(decodedURL)
-	logger.Debug(`[Proxy] Fetching %q`, imageURL)
+	// The ETag only depends on the URL, a conditional request doesn't need to fetch the image again.
+	etag := crypto.HashFromBytes(decodedURL)
 
-	req, err := http.NewRequest("GET", imageURL, nil)
-	if err != nil {
-		html.ServerError(w, r, err)
-		return
-	}
-	req.Header.Add("User-Agent", client.DefaultUserAgent)
-	req.Header.Add("Connection", "close")
+	response.New(w, r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
+		imageURL := string(decodedURL)
+		logger.Debug(`[Proxy] Fetching %q`, imageURL)
 
-	clt := &http.Client{
-		Timeout: time.Duration(config.Opts.HTTPClientTimeout()) * time.Second,
-	}
+		req, err := http.NewRequest("GET", imageURL, nil)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
 
-	resp, err := clt.Do(req)
-	if err != nil {
-		html.ServerError(w, r, err)
-		return
-	}
-	defer resp.Body.Close()
+		policy := client.NewNetworkPolicy(
+			config.Opts.HTTPClientAllowedSchemes(),
+			config.Opts.HTTPClientAllowedNetworks(),
+			config.Opts.HTTPClientDeniedNetworks(),
+		)
 
-	if resp.StatusCode != http.StatusOK {
-		html.NotFound(w, r)
-		return
-	}
+		if err := policy.CheckURL(req.URL); err != nil {
+			html.Forbidden(w, r)
+			return
+		}
 
-	etag := crypto.HashFromBytes(decodedURL)
+		req.Header.Add("User-Agent", client.DefaultUserAgent)
+		req.Header.Add("Connection", "close")
+
+		clt := &http.Client{
+			Timeout:       time.Duration(config.Opts.HTTPClientTimeout()) * time.Second,
+			Transport:     policy.Transport(),
+			CheckRedirect: policy.CheckRedirect,
+		}
+
+		resp, err := clt.Do(req)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			html.NotFound(w, r)
+			return
+		}
 
-	response.New(w, r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
 		b.WithHeader("Content-Type", resp.Header.Get("Content-Type"))
 		b.WithBody(resp.Body)
 		b.WithoutCompression()
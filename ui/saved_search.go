@@ -0,0 +1,123 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/response/json"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showSavedSearchesPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	savedSearches, err := h.store.SavedSearches(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	searchHistory, err := h.store.SearchHistory(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("savedSearches", savedSearches)
+	view.Set("searchHistory", searchHistory)
+	view.Set("menu", "search")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("saved_searches"))
+}
+
+func (h *handler) saveSearch(w http.ResponseWriter, r *http.Request) {
+	savedSearch, err := decodeSaveSearchPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	savedSearch.UserID = request.UserID(r)
+	if savedSearch.FeedID != 0 && !h.store.FeedExists(savedSearch.UserID, savedSearch.FeedID) {
+		json.BadRequest(w, r, errors.New("this feed does not exist"))
+		return
+	}
+
+	if savedSearch.CategoryID != 0 && !h.store.CategoryExists(savedSearch.UserID, savedSearch.CategoryID) {
+		json.BadRequest(w, r, errors.New("this category does not exist"))
+		return
+	}
+
+	if err := h.store.CreateSavedSearch(savedSearch); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, savedSearch)
+}
+
+func (h *handler) removeSavedSearch(w http.ResponseWriter, r *http.Request) {
+	savedSearchID := request.RouteInt64Param(r, "savedSearchID")
+	if err := h.store.RemoveSavedSearch(request.UserID(r), savedSearchID); err != nil {
+		logger.Error("[UI:RemoveSavedSearch] %v", err)
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "savedSearches"))
+}
+
+func (h *handler) clearSearchHistory(w http.ResponseWriter, r *http.Request) {
+	if err := h.store.ClearSearchHistory(request.UserID(r)); err != nil {
+		logger.Error("[UI:ClearSearchHistory] %v", err)
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "savedSearches"))
+}
+
+// showSearchSuggestions returns the saved searches followed by the recent ones.
+func (h *handler) showSearchSuggestions(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	savedSearches, err := h.store.SavedSearches(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	searchHistory, err := h.store.SearchHistory(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	seen := make(map[string]bool)
+	suggestions := make([]string, 0, len(savedSearches)+len(searchHistory))
+	for _, savedSearch := range savedSearches {
+		seen[savedSearch.Query] = true
+		suggestions = append(suggestions, savedSearch.Query)
+	}
+
+	for _, searchQuery := range searchHistory {
+		if !seen[searchQuery] {
+			suggestions = append(suggestions, searchQuery)
+		}
+	}
+
+	json.OK(w, r, suggestions)
+}
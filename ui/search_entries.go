@@ -10,6 +10,7 @@ import (
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/logger"
 	"miniflux.app/model"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -22,10 +23,23 @@ func (h *handler) showSearchEntriesPage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	scope, err := h.getSearchScope(r, user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !scope.isValid() {
+		html.NotFound(w, r)
+		return
+	}
+
 	searchQuery := request.QueryStringParam(r, "q", "")
 	offset := request.QueryIntParam(r, "offset", 0)
 	builder := h.store.NewEntryQueryBuilder(user.ID)
 	builder.WithSearchQuery(searchQuery)
+	builder.WithFeedID(scope.FeedID)
+	builder.WithCategoryID(scope.CategoryID)
 	builder.WithoutStatus(model.EntryStatusRemoved)
 	builder.WithOffset(offset)
 	builder.WithLimit(user.EntriesPerPage)
@@ -42,12 +56,23 @@ func (h *handler) showSearchEntriesPage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// The queries too long to be saved are not remembered either.
+	if offset == 0 && model.ValidateSearchQuery(searchQuery) == nil {
+		if err := h.store.AddSearchHistory(user.ID, searchQuery); err != nil {
+			logger.Error("[UI:SearchEntries] %v", err)
+		}
+	}
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	pagination := getPagination(route.Path(h.router, "searchEntries"), count, offset, user.EntriesPerPage)
 	pagination.SearchQuery = searchQuery
+	pagination.SearchFeedID = scope.FeedID
+	pagination.SearchCategoryID = scope.CategoryID
 
 	view.Set("searchQuery", searchQuery)
+	view.Set("searchScope", scope)
+	view.Set("isSavedSearch", h.store.SavedSearchExists(user.ID, searchQuery, scope.FeedID, scope.CategoryID))
 	view.Set("entries", entries)
 	view.Set("total", count)
 	view.Set("pagination", pagination)
@@ -56,6 +81,7 @@ func (h *handler) showSearchEntriesPage(w http.ResponseWriter, r *http.Request)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
 	view.Set("hasSaveEntry", h.store.HasSaveEntry(user.ID))
+	view.Set("promptSaveEntryTags", h.store.HasSaveEntryTagsPrompt(user.ID))
 
 	html.OK(w, r, view.Render("search_entries"))
 }
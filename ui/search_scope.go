@@ -0,0 +1,47 @@
+// Copyright 2018 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/model"
+)
+
+// searchScope restricts a search to a feed or a category.
+type searchScope struct {
+	FeedID     int64
+	CategoryID int64
+	Feed       *model.Feed
+	Category   *model.Category
+}
+
+func (h *handler) getSearchScope(r *http.Request, userID int64) (*searchScope, error) {
+	scope := &searchScope{
+		FeedID:     request.QueryInt64Param(r, "feed_id", 0),
+		CategoryID: request.QueryInt64Param(r, "category_id", 0),
+	}
+
+	var err error
+	if scope.FeedID > 0 {
+		if scope.Feed, err = h.store.FeedByID(userID, scope.FeedID); err != nil {
+			return nil, err
+		}
+	}
+
+	if scope.CategoryID > 0 {
+		if scope.Category, err = h.store.Category(userID, scope.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	return scope, nil
+}
+
+// isValid returns false when the feed or the category doesn't belong to the user.
+func (s *searchScope) isValid() bool {
+	return (s.FeedID == 0 || s.Feed != nil) && (s.CategoryID == 0 || s.Category != nil)
+}
@@ -7,6 +7,7 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/locale"
@@ -26,16 +27,35 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	homePage, homePageSearch := user.HomePage, ""
+	if page, argument := model.ParseHomePage(user.HomePage); page == model.HomePageSearch {
+		homePage, homePageSearch = page, argument
+	}
+
 	settingsForm := form.SettingsForm{
-		Username:          user.Username,
-		Theme:             user.Theme,
-		Language:          user.Language,
-		Timezone:          user.Timezone,
-		EntryDirection:    user.EntryDirection,
-		EntriesPerPage:    user.EntriesPerPage,
-		KeyboardShortcuts: user.KeyboardShortcuts,
-		ShowReadingTime:   user.ShowReadingTime,
-		CustomCSS:         user.Extra["custom_css"],
+		Username:               user.Username,
+		Theme:                  user.Theme,
+		Language:               user.Language,
+		Timezone:               user.Timezone,
+		EntryDirection:         user.EntryDirection,
+		EntriesPerPage:         user.EntriesPerPage,
+		KeyboardShortcuts:      user.KeyboardShortcuts,
+		ShowReadingTime:        user.ShowReadingTime,
+		MarkReadOnOriginalLink: user.MarkReadOnOriginalLink,
+		YouTubeEmbedURL:        user.YouTubeEmbedURL,
+		BlockedAuthors:         user.BlockedAuthors,
+		AutoStarKeywords:       user.AutoStarKeywords,
+		AutoStarAuthors:        user.AutoStarAuthors,
+		EmailRecipients:        user.EmailRecipients,
+		HomePage:               homePage,
+		HomePageSearch:         homePageSearch,
+		EntryTimezone:          user.EntryTimezone,
+		TimestampFormat:        user.TimestampFormat,
+		NotificationEmail:      user.NotificationEmail,
+		KeepStarredEntries:     user.KeepStarredEntries,
+		ArchiveReadDays:        model.ClampArchiveReadDays(user.ArchiveReadDays, config.Opts.CleanupArchiveReadDaysMax()),
+		AcceptReceivedEntries:  user.AcceptReceivedEntries,
+		CustomCSS:              user.Extra["custom_css"],
 	}
 
 	timezones, err := h.store.Timezones()
@@ -44,10 +64,29 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	savedSearches, err := h.store.SavedSearches(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	view.Set("form", settingsForm)
 	view.Set("themes", model.Themes())
+	view.Set("homePages", model.HomePages())
+	view.Set("entryTimezones", model.EntryTimezones())
+	view.Set("timestampFormats", model.TimestampFormats())
+	view.Set("categories", categories)
+	view.Set("savedSearches", savedSearches)
 	view.Set("languages", locale.AvailableLanguages())
 	view.Set("timezones", timezones)
+	view.Set("archiveReadDays", config.Opts.CleanupArchiveReadDays())
+	view.Set("archiveReadDaysMax", config.Opts.CleanupArchiveReadDaysMax())
 	view.Set("menu", "settings")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -7,6 +7,7 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
@@ -34,12 +35,31 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	savedSearches, err := h.store.SavedSearches(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	settingsForm := form.NewSettingsForm(r)
 
 	view.Set("form", settingsForm)
 	view.Set("themes", model.Themes())
+	view.Set("homePages", model.HomePages())
+	view.Set("entryTimezones", model.EntryTimezones())
+	view.Set("timestampFormats", model.TimestampFormats())
+	view.Set("categories", categories)
+	view.Set("savedSearches", savedSearches)
 	view.Set("languages", locale.AvailableLanguages())
 	view.Set("timezones", timezones)
+	view.Set("archiveReadDays", config.Opts.CleanupArchiveReadDays())
+	view.Set("archiveReadDaysMax", config.Opts.CleanupArchiveReadDaysMax())
 	view.Set("menu", "settings")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -57,6 +77,8 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	notificationEmailChanged := user.NotificationEmail != settingsForm.NotificationEmail
+
 	err = h.store.UpdateUser(settingsForm.Merge(user))
 	if err != nil {
 		logger.Error("[UI:UpdateSettings] %v", err)
@@ -65,6 +87,12 @@ func (h *handler) updateSettings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if notificationEmailChanged {
+		if err := h.mailNotificationEmailToken(user); err != nil {
+			logger.Error("[UI:UpdateSettings] %v", err)
+		}
+	}
+
 	sess.SetLanguage(user.Language)
 	sess.SetTheme(user.Theme)
 	sess.NewFlashMessage(locale.NewPrinter(request.UserLanguage(r)).Printf("alert.prefs_saved"))
@@ -12,6 +12,7 @@ import (
 	"miniflux.app/http/response"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/live"
 	"miniflux.app/storage"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -19,22 +20,28 @@ import (
 
 func (h *handler) createSharedEntry(w http.ResponseWriter, r *http.Request) {
 	entryID := request.RouteInt64Param(r, "entryID")
-	shareCode, err := h.store.EntryShareCode(request.UserID(r), entryID)
+	userID := request.UserID(r)
+	shareCode, err := h.store.EntryShareCode(userID, entryID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	h.store.Events().Publish(userID, live.EventEntryShared, entryID)
+
 	html.Redirect(w, r, route.Path(h.router, "sharedEntry", "shareCode", shareCode))
 }
 
 func (h *handler) unshareEntry(w http.ResponseWriter, r *http.Request) {
 	entryID := request.RouteInt64Param(r, "entryID")
-	if err := h.store.UnshareEntry(request.UserID(r), entryID); err != nil {
+	userID := request.UserID(r)
+	if err := h.store.UnshareEntry(userID, entryID); err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	h.store.Events().Publish(userID, live.EventEntryUnshared, entryID)
+
 	html.Redirect(w, r, route.Path(h.router, "sharedEntries"))
 }
 
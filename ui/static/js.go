@@ -3,11 +3,11 @@
 package static // import "miniflux.app/ui/static"
 
 var Javascripts = map[string]string{
-	"app":            `!function(){'use strict';class a{static isVisible(a){return a.offsetParent!==null}static openNewTab(b){let a=window.open("");a.opener=null,a.location=b,a.focus()}static scrollPageTo(a){let d=window.pageYOffset,b=document.documentElement.clientHeight,c=d+b,e=a.offsetTop+a.offsetHeight;(c-e<0||c-a.offsetTop>b)&&window.scrollTo(0,a.offsetTop-10)}static getVisibleElements(c){let a=document.querySelectorAll(c),b=[];for(let c=0;c<a.length;c++)this.isVisible(a[c])&&b.push(a[c]);return b}static findParent(a,b){for(;a&&a!==document;a=a.parentNode)if(a.classList.contains(b))return a;return null}static hasPassiveEventListenerOption(){var b=!1,a;try{a=Object.defineProperty({},"passive",{get:function(){b=!0}}),window.addEventListener("test",a,a),window.removeEventListener("test",a,a)}catch(a){b=!1}return b}}class K{constructor(){this.reset()}reset(){this.touch={start:{x:-1,y:-1},move:{x:-1,y:-1},element:null}}calculateDistance(){if(this.touch.start.x>=-1&&this.touch.move.x>=-1){let a=Math.abs(this.touch.move.x-this.touch.start.x),b=Math.abs(this.touch.move.y-this.touch.start.y);if(a>30&&b<70)return this.touch.move.x-this.touch.start.x}return 0}findElement(b){return b.classList.contains("touch-item")?b:a.findParent(b,"touch-item")}onTouchStart(a){if(a.touches===void 0||a.touches.length!==1)return;this.reset(),this.touch.start.x=a.touches[0].clientX,this.touch.start.y=a.touches[0].clientY,this.touch.element=this.findElement(a.touches[0].target)}onTouchMove(a){if(a.touches===void 0||a.touches.length!==1||this.element===null)return;this.touch.move.x=a.touches[0].clientX,this.touch.move.y=a.touches[0].clientY;let b=this.calculateDistance(),c=Math.abs(b);if(c>0){let d=1-(c>75?.9:c/75*.9),e=b>75?75:b<-75?-75:b;this.touch.element.style.opacity=d,this.touch.element.style.transform="translateX("+e+"px)",a.preventDefault()}}onTouchEnd(a){if(a.touches===void 0)return;if(this.touch.element!==null){let a=Math.abs(this.calculateDistance());a>75&&n(this.touch.element),this.touch.element.style.opacity=1,this.touch.element.style.transform="none"}this.reset()}listen(){let e=document.querySelectorAll(".touch-item"),c=a.hasPassiveEventListenerOption();e.forEach(a=>{a.addEventListener("touchstart",a=>this.onTouchStart(a),!!c&&{passive:!0}),a.addEventListener("touchmove",a=>this.onTouchMove(a),!!c&&{passive:!1}),a.addEventListener("touchend",a=>this.onTouchEnd(a),!!c&&{passive:!0}),a.addEventListener("touchcancel",()=>this.reset(),!!c&&{passive:!0})});let d=document.querySelector(".entry-content");if(d){let a={previous:null,next:null};const e=(c,d)=>{const e=a[c];e===null?a[c]=setTimeout(()=>{a[c]=null},200):(d.preventDefault(),b(c))};d.addEventListener("touchend",a=>{a.changedTouches[0].clientX>=d.offsetWidth/2?e("next",a):e("previous",a)},!!c&&{passive:!1}),d.addEventListener("touchmove",b=>{Object.keys(a).forEach(b=>a[b]=null)})}}}class A{constructor(){this.queue=[],this.shortcuts={},this.triggers=[]}on(a,b){this.shortcuts[a]=b,this.triggers.push(a.split(" ")[0])}listen(){document.onkeydown=a=>{let b=this.getKey(a);if(this.isEventIgnored(a,b)||this.isModifierKeyDown(a))return;a.preventDefault(),this.queue.push(b);for(let c in this.shortcuts){let d=c.split(" ");if(d.every((a,b)=>a===this.queue[b])){this.queue=[],this.shortcuts[c](a);return}if(d.length===1&&b===d[0]){this.queue=[],this.shortcuts[c](a);return}}this.queue.length>=2&&(this.queue=[])}}isEventIgnored(a,b){return a.target.tagName==="INPUT"||a.target.tagName==="TEXTAREA"||this.queue.length<1&&!this.triggers.includes(b)}isModifierKeyDown(a){return a.getModifierState("Control")||a.getModifierState("Alt")||a.getModifierState("Meta")}getKey(b){const a={Esc:'Escape',Up:'ArrowUp',Down:'ArrowDown',Left:'ArrowLeft',Right:'ArrowRight'};for(let c in a)if(a.hasOwnProperty(c)&&c===b.key)return a[c];return b.key}}class d{constructor(a){this.callback=null,this.url=a,this.options={method:"POST",cache:"no-cache",credentials:"include",body:null,headers:new Headers({"Content-Type":"application/json","X-Csrf-Token":this.getCsrfToken()})}}withHttpMethod(a){return this.options.method=a,this}withBody(a){return this.options.body=JSON.stringify(a),this}withCallback(a){return this.callback=a,this}getCsrfToken(){let a=document.querySelector("meta[name=X-CSRF-Token]");return a!==null?a.getAttribute("value"):""}execute(){fetch(new Request(this.url,this.options)).then(a=>{this.callback&&this.callback(a)})}}class g{static exists(){return document.getElementById("modal-container")!==null}static open(c){if(g.exists())return;let a=document.createElement("div");a.id="modal-container",a.appendChild(document.importNode(c,!0)),document.body.appendChild(a);let b=document.querySelector("a.btn-close-modal");b!==null&&(b.onclick=a=>{a.preventDefault(),g.close()})}static close(){let a=document.getElementById("modal-container");a!==null&&a.parentNode.removeChild(a)}}function c(a,b,c){let d=document.querySelectorAll(a);d.forEach(a=>{a.onclick=a=>{c||a.preventDefault(),b(a)}})}function x(){let b=document.querySelector(".header nav ul");a.isVisible(b)?b.style.display="none":b.style.display="block";let c=document.querySelector(".header .search");a.isVisible(c)?c.style.display="none":c.style.display="block"}function M(b){let a=b.target;a.tagName==="A"?window.location.href=a.getAttribute("href"):window.location.href=a.querySelector("a").getAttribute("href")}function L(){let a=document.querySelectorAll("form");a.forEach(a=>{a.onsubmit=()=>{let b=a.querySelector("button");b&&(b.innerHTML=b.dataset.labelLoading,b.disabled=!0)}})}function s(b){b.preventDefault(),b.stopPropagation();let c=document.querySelector(".search-toggle-switch");c&&(c.style.display="none");let d=document.querySelector(".search-form");d&&(d.style.display="block");let a=document.getElementById("search-input");a&&(a.focus(),a.value="")}function I(){let a=document.getElementById("keyboard-shortcuts");a!==null&&g.open(a.content)}function p(){let d=a.getVisibleElements(".items .item"),c=[];d.forEach(a=>{a.classList.add("item-status-read"),c.push(parseInt(a.dataset.id,10))}),c.length>0&&k(c,"read",()=>{let a=document.querySelector("a[data-action=markPageAsRead]"),c=!1;a&&(c=a.dataset.showOnlyUnread||!1),c?window.location.reload():b("next",!0)})}function o(b){let c=!b,a=i(b);a&&(n(a,c),e()&&a.classList.contains('current-item')&&h())}function n(b,d){let g=parseInt(b.dataset.id,10),a=b.querySelector("a[data-toggle-status]"),c=a.dataset.value,e=c==="read"?"unread":"read";k([g],e),c==="read"?(a.innerHTML='<span class="icon-label">'+a.dataset.labelRead+'</span>',a.dataset.value="unread",d&&f(a.dataset.toastUnread)):(a.innerHTML='<span class="icon-label">'+a.dataset.labelUnread+'</span>',a.dataset.value="read",d&&f(a.dataset.toastRead)),b.classList.contains("item-status-"+c)&&(b.classList.remove("item-status-"+c),b.classList.add("item-status-"+e))}function F(a){if(a.classList.contains("item-status-unread")){a.classList.remove("item-status-unread"),a.classList.add("item-status-read");let b=parseInt(a.dataset.id,10);k([b],"read")}}function C(){let b=document.body.dataset.refreshAllFeedsUrl,a=new d(b);a.withCallback(()=>{window.location.reload()}),a.withHttpMethod("GET"),a.execute()}function k(c,b,e){let f=document.body.dataset.entriesStatusUrl,a=new d(f);a.withBody({entry_ids:c,status:b}),a.withCallback(e),a.execute(),b==="read"?G(1):H(1)}function r(a){let c=!a,b=i(a);b&&B(b.querySelector("a[data-save-entry]"),c)}function B(a,c){if(!a)return;if(a.dataset.completed)return;let e=a.innerHTML;a.innerHTML='<span class="icon-label">'+a.dataset.labelLoading+'</span>';let b=new d(a.dataset.saveUrl);b.withCallback(()=>{a.innerHTML=e,a.dataset.completed=!0,c&&f(a.dataset.toastDone)}),b.execute()}function t(a){let c=!a,b=i(a);b&&N(b,c)}function N(e,b){let a=e.querySelector("a[data-toggle-bookmark]");if(!a)return;a.innerHTML='<span class="icon-label">'+a.dataset.labelLoading+'</span>';let c=new d(a.dataset.bookmarkUrl);c.withCallback(()=>{a.dataset.value==="star"?(a.innerHTML='<span class="icon-label">'+a.dataset.labelStar+'</span>',a.dataset.value="unstar",b&&f(a.dataset.toastUnstar)):(a.innerHTML='<span class="icon-label">'+a.dataset.labelUnstar+'</span>',a.dataset.value="star",b&&f(a.dataset.toastStar))}),c.execute()}function v(){if(e())return;let a=document.querySelector("a[data-fetch-content-entry]");if(!a)return;let c=a.innerHTML;a.innerHTML='<span class="icon-label">'+a.dataset.labelLoading+'</span>';let b=new d(a.dataset.fetchContentUrl);b.withCallback(b=>{a.innerHTML=c,b.json().then(a=>{a.hasOwnProperty("content")&&(document.querySelector(".entry-content").innerHTML=a.content)})}),b.execute()}function w(d){let b=document.querySelector(".entry h1 a");if(b!==null){d?window.location.href=b.getAttribute("href"):a.openNewTab(b.getAttribute("href"));return}let c=document.querySelector(".current-item a[data-original-link]");if(c!==null){a.openNewTab(c.getAttribute("href"));let b=document.querySelector(".current-item");document.location.href!=document.querySelector('a[data-page=starred]').href&&h(),F(b)}}function m(b){if(e()){let b=document.querySelector(".current-item a[data-comments-link]");b!==null&&a.openNewTab(b.getAttribute("href"))}else{let c=document.querySelector("a[data-comments-link]");if(c!==null){b?window.location.href=c.getAttribute("href"):a.openNewTab(c.getAttribute("href"));return}}}function y(){let a=document.querySelector(".current-item .item-title a");a!==null&&(window.location.href=a.getAttribute("href"))}function z(){let a=document.querySelectorAll("[data-action=remove-feed]");if(a.length===1){let b=a[0],c=new d(b.dataset.url);c.withCallback(()=>{b.dataset.redirectUrl?window.location.href=b.dataset.redirectUrl:window.location.reload()}),c.execute()}}function b(b,c){let a=document.querySelector("a[data-page="+b+"]");a?document.location.href=a.href:c&&window.location.reload()}function j(){e()?E():b("previous")}function l(){e()?h():b("next")}function D(){if(J()){let a=document.querySelector("span.entry-website a");a!==null&&(window.location.href=a.href)}else b('feeds')}function E(){let b=a.getVisibleElements(".items .item");if(b.length===0)return;if(document.querySelector(".current-item")===null){b[0].classList.add("current-item"),b[0].querySelector('.item-header a').focus();return}for(let c=0;c<b.length;c++)if(b[c].classList.contains("current-item")){b[c].classList.remove("current-item");let d;c-1>=0?d=b[c-1]:d=b[b.length-1],d.classList.add("current-item"),a.scrollPageTo(d),d.querySelector('.item-header a').focus();break}}function h(){let b=a.getVisibleElements(".items .item");if(b.length===0)return;if(document.querySelector(".current-item")===null){b[0].classList.add("current-item"),b[0].querySelector('.item-header a').focus();return}for(let c=0;c<b.length;c++)if(b[c].classList.contains("current-item")){b[c].classList.remove("current-item");let d;c+1<b.length?d=b[c+1]:d=b[0],d.classList.add("current-item"),a.scrollPageTo(d),d.querySelector('.item-header a').focus();break}}function G(a){q(b=>b-a)}function H(a){q(b=>b+a)}function q(a){let b=document.querySelectorAll("span.unread-counter");if(b.forEach(b=>{let c=parseInt(b.textContent,10);b.innerHTML=a(c)}),window.location.href.endsWith('/unread')){let b=parseInt(document.title.split('(')[1],10),c=a(b);document.title=document.title.replace(/(.*?)\(\d+\)(.*?)/,function(d,a,b,e,f){return a+'('+c+')'+b})}}function J(){return document.querySelector("section.entry")!==null}function e(){return document.querySelector(".items")!==null}function i(b){return e()?b?a.findParent(b,"item"):document.querySelector(".current-item"):document.querySelector(".entry")}function u(a,f){a.tagName!='A'&&(a=a.parentNode),a.style.display="none";let e=a.parentNode,b=document.createElement("span"),c=document.createElement("a");c.href="#",c.appendChild(document.createTextNode(a.dataset.labelYes)),c.onclick=d=>{d.preventDefault();let c=document.createElement("span");c.className="loading",c.appendChild(document.createTextNode(a.dataset.labelLoading)),b.remove(),e.appendChild(c),f(a.dataset.url,a.dataset.redirectUrl)};let d=document.createElement("a");d.href="#",d.appendChild(document.createTextNode(a.dataset.labelNo)),d.onclick=c=>{c.preventDefault(),a.style.display="inline",b.remove()},b.className="confirm",b.appendChild(document.createTextNode(a.dataset.labelQuestion+" ")),b.appendChild(c),b.appendChild(document.createTextNode(", ")),b.appendChild(d),e.appendChild(b)}function f(a){if(!a)return;document.querySelector('.toast-wrap .toast-msg').innerHTML=a;let b=document.querySelector('.toast-wrap');b.classList.remove('toastAnimate'),setTimeout(function(){b.classList.add('toastAnimate')},100)}document.addEventListener("DOMContentLoaded",function(){if(L(),!document.querySelector("body[data-disable-keyboard-shortcuts=true]")){let a=new A;a.on("g u",()=>b("unread")),a.on("g b",()=>b("starred")),a.on("g h",()=>b("history")),a.on("g f",()=>D()),a.on("g c",()=>b("categories")),a.on("g s",()=>b("settings")),a.on("ArrowLeft",()=>j()),a.on("ArrowRight",()=>l()),a.on("k",()=>j()),a.on("p",()=>j()),a.on("j",()=>l()),a.on("n",()=>l()),a.on("h",()=>b("previous")),a.on("l",()=>b("next")),a.on("o",()=>y()),a.on("v",()=>w()),a.on("V",()=>w(!0)),a.on("c",()=>m()),a.on("C",()=>m(!0)),a.on("m",()=>o()),a.on("A",()=>p()),a.on("s",()=>r()),a.on("d",()=>v()),a.on("f",()=>t()),a.on("R",()=>C()),a.on("?",()=>I()),a.on("#",()=>z()),a.on("/",a=>s(a)),a.on("Escape",()=>g.close()),a.listen()}let a=new K;if(a.listen(),c("a[data-save-entry]",a=>r(a.target)),c("a[data-toggle-bookmark]",a=>t(a.target)),c("a[data-fetch-content-entry]",()=>v()),c("a[data-action=search]",a=>s(a)),c("a[data-action=markPageAsRead]",()=>u(event.target,()=>p())),c("a[data-toggle-status]",a=>o(a.target)),c("a[data-confirm]",a=>u(a.target,(c,a)=>{let b=new d(c);b.withCallback(()=>{a?window.location.href=a:window.location.reload()}),b.execute()})),document.documentElement.clientWidth<600&&(c(".logo",()=>x()),c(".header nav li",a=>M(a))),"serviceWorker"in navigator){let a=document.getElementById("service-worker-script");a&&navigator.serviceWorker.register(a.src)}window.addEventListener('beforeinstallprompt',c=>{c.preventDefault();let a=c;const b=document.getElementById('prompt-home-screen');if(b){b.style.display="block";const c=document.getElementById('btn-add-to-home-screen');c&&c.addEventListener('click',c=>{c.preventDefault(),a.prompt(),a.userChoice.then(()=>{a=null,b.style.display="none"})})}})})}()`,
+	"app":            `!function(){'use strict';class b{static isVisible(a){return a.offsetParent!==null}static openNewTab(b,c){let a=window.open("");a.opener=null,a.location=b,c?window.focus():a.focus()}static scrollPageTo(a){let d=window.pageYOffset,b=document.documentElement.clientHeight,c=d+b,e=a.offsetTop+a.offsetHeight;(c-e<0||c-a.offsetTop>b)&&window.scrollTo(0,a.offsetTop-10)}static getVisibleElements(c){let a=document.querySelectorAll(c),b=[];for(let c=0;c<a.length;c++)this.isVisible(a[c])&&b.push(a[c]);return b}static findParent(a,b){for(;a&&a!==document;a=a.parentNode)if(a.classList.contains(b))return a;return null}static hasPassiveEventListenerOption(){var b=!1,a;try{a=Object.defineProperty({},"passive",{get:function(){b=!0}}),window.addEventListener("test",a,a),window.removeEventListener("test",a,a)}catch(a){b=!1}return b}}class an{constructor(){this.reset()}reset(){this.touch={start:{x:-1,y:-1},move:{x:-1,y:-1},element:null}}calculateDistance(){if(this.touch.start.x>=-1&&this.touch.move.x>=-1){let a=Math.abs(this.touch.move.x-this.touch.start.x),b=Math.abs(this.touch.move.y-this.touch.start.y);if(a>30&&b<70)return this.touch.move.x-this.touch.start.x}return 0}findElement(a){return a.classList.contains("touch-item")?a:b.findParent(a,"touch-item")}onTouchStart(a){if(a.touches===void 0||a.touches.length!==1)return;this.reset(),this.touch.start.x=a.touches[0].clientX,this.touch.start.y=a.touches[0].clientY,this.touch.element=this.findElement(a.touches[0].target)}onTouchMove(a){if(a.touches===void 0||a.touches.length!==1||this.element===null)return;this.touch.move.x=a.touches[0].clientX,this.touch.move.y=a.touches[0].clientY;let b=this.calculateDistance(),c=Math.abs(b);if(c>0){let d=1-(c>75?.9:c/75*.9),e=b>75?75:b<-75?-75:b;this.touch.element.style.opacity=d,this.touch.element.style.transform="translateX("+e+"px)",a.preventDefault()}}onTouchEnd(a){if(a.touches===void 0)return;if(this.touch.element!==null){let a=Math.abs(this.calculateDistance());a>75&&s(this.touch.element),this.touch.element.style.opacity=1,this.touch.element.style.transform="none"}this.reset()}listen(){let e=document.querySelectorAll(".touch-item"),a=b.hasPassiveEventListenerOption();e.forEach(b=>{b.addEventListener("touchstart",a=>this.onTouchStart(a),!!a&&{passive:!0}),b.addEventListener("touchmove",a=>this.onTouchMove(a),!!a&&{passive:!1}),b.addEventListener("touchend",a=>this.onTouchEnd(a),!!a&&{passive:!0}),b.addEventListener("touchcancel",()=>this.reset(),!!a&&{passive:!0})});let c=document.querySelector(".entry-content");if(c){let b={previous:null,next:null};const e=(a,c)=>{const e=b[a];e===null?b[a]=setTimeout(()=>{b[a]=null},200):(c.preventDefault(),d(a))};c.addEventListener("touchend",a=>{a.changedTouches[0].clientX>=c.offsetWidth/2?e("next",a):e("previous",a)},!!a&&{passive:!1}),c.addEventListener("touchmove",a=>{Object.keys(b).forEach(a=>b[a]=null)})}}}class am{constructor(){this.queue=[],this.shortcuts={},this.triggers=[]}on(a,b){this.shortcuts[a]=b,this.triggers.push(a.split(" ")[0])}listen(){document.onkeydown=a=>{let b=this.getKey(a);if(this.isEventIgnored(a,b)||this.isModifierKeyDown(a))return;a.preventDefault(),this.queue.push(b);for(let c in this.shortcuts){let d=c.split(" ");if(d.every((a,b)=>a===this.queue[b])){this.queue=[],this.shortcuts[c](a);return}if(d.length===1&&b===d[0]){this.queue=[],this.shortcuts[c](a);return}}this.queue.length>=2&&(this.queue=[])}}isEventIgnored(a,b){return a.target.tagName==="INPUT"||a.target.tagName==="TEXTAREA"||this.queue.length<1&&!this.triggers.includes(b)}isModifierKeyDown(a){return a.getModifierState("Control")||a.getModifierState("Alt")||a.getModifierState("Meta")}getKey(b){const a={Esc:'Escape',Up:'ArrowUp',Down:'ArrowDown',Left:'ArrowLeft',Right:'ArrowRight'};for(let c in a)if(a.hasOwnProperty(c)&&c===b.key)return a[c];return b.key}}class c{constructor(a){this.callback=null,this.url=a,this.options={method:"POST",cache:"no-cache",credentials:"include",body:null,headers:new Headers({"Content-Type":"application/json","X-Csrf-Token":this.getCsrfToken()})}}withHttpMethod(a){return this.options.method=a,this}withBody(a){return this.options.body=JSON.stringify(a),this}withCallback(a){return this.callback=a,this}getCsrfToken(){let a=document.querySelector("meta[name=X-CSRF-Token]");return a!==null?a.getAttribute("value"):""}execute(){fetch(new Request(this.url,this.options)).then(a=>{this.callback&&this.callback(a)})}}class e{static exists(){return document.getElementById("modal-container")!==null}static open(d){if(e.exists())return;e.previousFocus=document.activeElement;let a=document.createElement("div");a.id="modal-container",a.setAttribute("role","dialog"),a.setAttribute("aria-modal","true"),a.appendChild(document.importNode(d,!0)),document.body.appendChild(a);let c=a.querySelector("[aria-labelledby]");c!==null&&a.setAttribute("aria-labelledby",c.getAttribute("aria-labelledby")),a.addEventListener("keydown",b=>e.trapFocus(a,b));let b=document.querySelector("a.btn-close-modal");b!==null&&(b.onclick=a=>{a.preventDefault(),e.close()},b.focus())}static close(){let a=document.getElementById("modal-container");a!==null&&a.parentNode.removeChild(a),e.previousFocus&&(e.previousFocus.focus(),e.previousFocus=null)}static trapFocus(e,a){if(a.key!=="Tab")return;let b=e.querySelectorAll('a[href], button, input, select, textarea, [tabindex]:not([tabindex="-1"])');if(b.length===0)return;let c=b[0],d=b[b.length-1];a.shiftKey&&document.activeElement===c?(a.preventDefault(),d.focus()):!a.shiftKey&&document.activeElement===d&&(a.preventDefault(),c.focus())}}class ak{constructor(a){this.url=new URL(a,window.location.href),this.url.protocol=this.url.protocol==="https:"?"wss:":"ws:",this.retryDelay=1e3,this.stale=!1}connect(){let a=new WebSocket(this.url.href);a.onopen=()=>{this.retryDelay=1e3},a.onmessage=a=>{this.onEvent(JSON.parse(a.data))},a.onclose=()=>{setTimeout(()=>this.connect(),this.retryDelay),this.retryDelay=Math.min(this.retryDelay*2,6e4)}}listen(){this.connect(),document.addEventListener("visibilitychange",()=>{!document.hidden&&this.stale&&window.location.reload()})}onEvent(a){switch(a.type){case"counters":j(()=>a.data.unread),this.toggleCounter(".unread-counter-wrapper",a.data.unread),this.updateCounter(".error-feeds-counter",a.data.error_feeds),this.toggleCounter(".error-feeds-counter-wrapper",a.data.error_feeds);break;case"feed_refreshed":{let b=window.location.pathname;(b.endsWith("/feeds")||b.includes("/feed/"+a.data+"/"))&&this.reloadWhenVisible();break}case"entry_shared":case"entry_unshared":window.location.pathname.endsWith("/shares")&&this.reloadWhenVisible();break}}updateCounter(a,b){document.querySelectorAll(a).forEach(a=>{a.textContent=b})}toggleCounter(a,b){document.querySelectorAll(a).forEach(a=>{a.hidden=b===0})}reloadWhenVisible(){document.hidden&&(this.stale=!0)}}class aj{constructor(){this.storageKey="miniflux-entries-status",this.channel=null,this.lastChanges={},this.lastCounterChange=0}listen(){"BroadcastChannel"in window?(this.channel=new BroadcastChannel(this.storageKey),this.channel.onmessage=a=>this.onMessage(a.data)):window.addEventListener("storage",a=>{a.key===this.storageKey&&a.newValue&&this.onMessage(JSON.parse(a.newValue))})}publish(b,c,d,e){let a={entry_ids:b,status:c,changed_at:d,unread:e};this.record(a),this.channel?this.channel.postMessage(a):window.localStorage&&window.localStorage.setItem(this.storageKey,JSON.stringify(a))}record(a){let b=a.entry_ids.filter(b=>(this.lastChanges[b]||0)<a.changed_at);return b.forEach(b=>{this.lastChanges[b]=a.changed_at}),a.changed_at>this.lastCounterChange&&(this.lastCounterChange=a.changed_at,j(()=>a.unread)),b}onMessage(a){this.record(a).forEach(b=>{document.querySelectorAll(".item[data-id='"+b+"'], .entry[data-id='"+b+"']").forEach(b=>{t(b,a.status)})})}}class ai{constructor(a){this.url=a.dataset.readingPositionUrl,this.content=a.querySelector(".entry-content"),this.savedPosition=parseFloat(a.dataset.readingPosition)||0,this.timer=null}currentPosition(){let a=this.content.getBoundingClientRect();return a.height===0?0:Math.min(1,Math.max(0,-a.top/a.height))}restore(){if(this.savedPosition>0&&window.location.hash===""){let a=this.content.getBoundingClientRect();window.scrollTo(0,window.pageYOffset+a.top+this.savedPosition*a.height)}}save(){let a=Math.round(this.currentPosition()*1e3)/1e3;if(Math.abs(a-this.savedPosition)<.01)return;this.savedPosition=a;let b=new c(this.url);b.withBody({position:a}),b.execute()}listen(){if(!this.content)return;window.addEventListener("load",()=>this.restore()),window.addEventListener("scroll",()=>{clearTimeout(this.timer),this.timer=setTimeout(()=>this.save(),2e3)},{passive:!0})}}class ag{constructor(a){this.container=a,this.player=a.querySelector("audio"),this.nowPlaying=a.querySelector(".playback-queue-now-playing"),this.list=a.querySelector(".playback-queue-items"),this.current=null}items(){return Array.from(this.list.querySelectorAll("li[data-entry-id]"))}play(a){if(!a)return;this.items().forEach(a=>a.classList.remove("current-item")),a.classList.add("current-item"),this.current=a,this.player.src=a.dataset.audioUrl,this.nowPlaying.textContent=a.dataset.title,this.player.play()}next(){let a=this.current;if(!a)return;let b=this.items(),c=b[b.indexOf(a)+1];i([parseInt(a.dataset.entryId,10)],"read"),this.remove(a),c?this.play(c):(this.current=null,this.nowPlaying.textContent="")}remove(a){a===this.current&&(this.player.pause(),this.current=null,this.nowPlaying.textContent="");let b=new c(a.dataset.removeUrl);b.execute(),a.remove()}move(d,e){let a=this.items(),b=a.indexOf(d)+e;if(b<0||b>=a.length)return;e<0?this.list.insertBefore(d,a[b]):this.list.insertBefore(a[b],d);let f=new c(this.container.dataset.reorderUrl);f.withBody({entry_ids:this.items().map(a=>parseInt(a.dataset.entryId,10))}),f.execute()}listen(){this.player.addEventListener("ended",()=>this.next()),this.container.addEventListener("click",c=>{let b=c.target.closest("a[data-queue-action]");if(!b)return;c.preventDefault();let a=b.closest("li[data-entry-id]");switch(b.dataset.queueAction){case"play-all":this.play(this.items()[0]);break;case"play":this.play(a);break;case"up":this.move(a,-1);break;case"down":this.move(a,1);break;case"remove":this.remove(a);break}})}}const u=new aj;function a(a,b,c){let d=document.querySelectorAll(a);d.forEach(a=>{a.onclick=a=>{c||a.preventDefault(),b(a)}})}function ad(){let a=document.querySelector(".header nav ul");b.isVisible(a)?a.style.display="none":a.style.display="block";let c=document.querySelector(".header .search");b.isVisible(c)?c.style.display="none":c.style.display="block"}function aa(b){let a=b.target;a.tagName==="A"?window.location.href=a.getAttribute("href"):window.location.href=a.querySelector("a").getAttribute("href")}function $(){let a=document.querySelectorAll("form");a.forEach(a=>{a.onsubmit=()=>{let b=a.querySelector("button");b&&(b.innerHTML=b.dataset.labelLoading,b.disabled=!0)}})}function G(b){b.preventDefault(),b.stopPropagation();let c=document.querySelector(".search-toggle-switch");c&&(c.style.display="none");let d=document.querySelector(".search-form");d&&(d.style.display="block");let a=document.getElementById("search-input");a&&(a.focus(),a.value="")}function _(){let a=document.getElementById("keyboard-shortcuts");a!==null&&e.open(a.content)}function q(){let c=b.getVisibleElements(".items .item"),a=[];c.forEach(b=>{b.classList.add("item-status-read"),a=a.concat(k(b))}),a.length>0&&i(a,"read",()=>{let a=document.querySelector("a[data-action=markPageAsRead]"),b=!1;a&&(b=a.dataset.showOnlyUnread||!1),b?window.location.reload():d("next",!0)})}function z(b){let c=!b,a=p(b);a&&(s(a,c),g()&&a.classList.contains('current-item')&&l())}function s(a,e){let b=a.querySelector("a[data-toggle-status]"),g=b.dataset.value,c=g==="read"?"unread":"read";i(k(a),c),t(a,c);let d=c==="read"?b.dataset.toastRead:b.dataset.toastUnread;e?f(d):y(d)}function t(b,c){let d=c==="read"?"unread":"read",a=b.querySelector("a[data-toggle-status]");if(a){let b=c==="read"?a.dataset.labelUnread:a.dataset.labelRead;a.innerHTML='<span class="icon-label">'+b+'</span>',a.dataset.value=c}b.classList.contains("item-status-"+d)&&(b.classList.remove("item-status-"+d),b.classList.add("item-status-"+c))}function h(a){a.classList.contains("item-status-unread")&&(a.classList.remove("item-status-unread"),a.classList.add("item-status-read"),i(k(a),"read"))}function k(a){let b=[parseInt(a.dataset.id,10)];return a.dataset.duplicateIds&&a.dataset.duplicateIds.split(",").forEach(a=>b.push(parseInt(a,10))),b}function X(a){let c=null,b=()=>clearTimeout(c);a.addEventListener("touchstart",()=>{c=setTimeout(()=>{let b=a.textContent;a.textContent=a.title,a.title=b},500)},{passive:!0}),a.addEventListener("touchend",b),a.addEventListener("touchmove",b),a.addEventListener("touchcancel",b)}function W(){let b=document.body.dataset.refreshAllFeedsUrl,a=new c(b);a.withCallback(()=>{window.location.reload()}),a.withHttpMethod("GET"),a.execute()}function i(d,a,e){let f=document.body.dataset.entriesStatusUrl,b=new c(f);b.withBody({entry_ids:d,status:a}),b.withCallback(b=>{let c=()=>{e&&e(b)};if(!b.ok){c();return}b.json().then(b=>{u.publish(d,a,b.changed_at,b.unread)}).catch(()=>{}).then(c)}),b.execute(),a==="read"?ae(1):af(1)}function r(a){let c=!a,b=p(a);b&&T(b.querySelector("a[data-save-entry]"),c)}function T(a,e){if(!a)return;if(a.dataset.completed)return;let b="";if(document.body.dataset.promptSaveEntryTags==="true"){if(b=window.prompt(a.dataset.labelPromptTags,""),b===null)return}let g=a.innerHTML;a.innerHTML='<span class="icon-label">'+a.dataset.labelLoading+'</span>';let d=new c(a.dataset.saveUrl);d.withBody({tags:b}),d.withCallback(()=>{a.innerHTML=g,a.dataset.completed=!0,e&&f(a.dataset.toastDone)}),d.execute()}function B(){let a=document.getElementById("bulk-actions");if(!a)return;let b=C().length,c=a.querySelector(".bulk-actions-count");c.textContent=c.dataset.labelCount.replace("%d",b),a.hidden=b===0}function C(){let a=[];return document.querySelectorAll("input[data-select-entry]:checked").forEach(b=>{a.push(parseInt(b.value,10))}),a}function D(){document.querySelectorAll("input[data-select-entry]:checked").forEach(a=>{a.checked=!1}),B()}function Q(a){let e=C();if(e.length===0)return;let b="";if(document.body.dataset.promptSaveEntryTags==="true"){if(b=window.prompt(a.dataset.labelPromptTags,""),b===null)return}let g=a.innerHTML;a.innerHTML=a.dataset.labelLoading;let d=new c(a.dataset.saveUrl);d.withBody({entry_ids:e,tags:b}),d.withCallback(()=>{a.innerHTML=g,D(),f(a.dataset.toastDone)}),d.execute()}function O(){let b=document.getElementById("feed-move-targets");if(!b)return;let c=o().length,a=b.querySelector(".feed-move-hint");a.textContent=c===0?a.dataset.labelHint:a.dataset.labelCount.replace("%d",c)}function o(){let a=[];return document.querySelectorAll("input[data-select-feed]:checked").forEach(b=>{a.push(parseInt(b.value,10))}),a}function K(a){let c=parseInt(a.currentTarget.dataset.feedId,10),b=o();b.includes(c)||(b=[c]),a.dataTransfer.effectAllowed="move",a.dataTransfer.setData("text/plain",b.join(","))}function I(a){a.preventDefault(),a.currentTarget.classList.remove("feed-move-target-active");let b=a.dataTransfer.getData("text/plain").split(",").map(a=>parseInt(a,10)).filter(a=>a>0);H(b,a.currentTarget)}function J(a){let b=o();b.length>0&&(a.preventDefault(),H(b,a.target))}function H(d,e){let a=document.getElementById("feed-move-targets");if(!a||d.length===0)return;e.textContent=a.dataset.labelLoading;let b=new c(a.dataset.moveUrl);b.withBody({feed_ids:d,category_id:parseInt(e.dataset.moveCategoryId,10)}),b.withCallback(()=>window.location.reload()),b.execute()}function L(a){if(a.dataset.completed)return;a.textContent=a.dataset.labelLoading;let b=new c(a.dataset.saveUrl);b.withBody({query:a.dataset.query,feed_id:parseInt(a.dataset.feedId||"0",10),category_id:parseInt(a.dataset.categoryId||"0",10)}),b.withCallback(()=>{a.textContent=a.dataset.labelDone,a.dataset.completed=!0}),b.execute()}function M(a){if(a.dataset.completed)return;a.textContent=a.dataset.labelLoading;let b=new c(a.dataset.queueAddUrl);b.withCallback(()=>{a.textContent=a.dataset.labelDone,a.dataset.completed=!0}),b.execute()}function N(d){let a=document.getElementById(d.getAttribute("list"));if(!a||a.dataset.loaded)return;a.dataset.loaded=!0;let b=new c(d.dataset.suggestionsUrl);b.withHttpMethod("GET"),b.withCallback(b=>{b.json().then(b=>{b.forEach(c=>{let b=document.createElement("option");b.value=c,a.appendChild(b)})})}),b.execute()}function F(a){let c=!a,b=p(a);b&&P(b,c)}function P(e,b){let a=e.querySelector("a[data-toggle-bookmark]");if(!a)return;a.innerHTML='<span class="icon-label">'+a.dataset.labelLoading+'</span>';let d=new c(a.dataset.bookmarkUrl);d.withCallback(()=>{a.dataset.value==="star"?(a.innerHTML='<span class="icon-label">'+a.dataset.labelStar+'</span>',a.dataset.value="unstar",b&&f(a.dataset.toastUnstar)):(a.innerHTML='<span class="icon-label">'+a.dataset.labelUnstar+'</span>',a.dataset.value="star",b&&f(a.dataset.toastStar))}),d.execute()}function E(){if(g())return;let a=document.querySelector("a[data-fetch-content-entry]");if(!a)return;let d=a.innerHTML;a.innerHTML='<span class="icon-label">'+a.dataset.labelLoading+'</span>';let b=new c(a.dataset.fetchContentUrl);b.withCallback(b=>{a.innerHTML=d,b.json().then(a=>{a.hasOwnProperty("content")&&(document.querySelector(".entry-content").innerHTML=a.content)})}),b.execute()}function R(){let a=document.querySelector("a[data-archive-page-entry]");if(!a||a.dataset.completed)return;let d=a.innerHTML;a.innerHTML='<span class="icon-label">'+a.dataset.labelLoading+'</span>';let b=new c(a.dataset.archivePageUrl);b.withCallback(b=>{a.innerHTML=d,b.json().then(b=>{b.hasOwnProperty("url")&&(a.dataset.completed=!0,a.onclick=null,a.href=b.url,a.target="_blank",a.querySelector(".icon-label").textContent=a.dataset.labelDone,f(a.dataset.toastDone))})}),b.execute()}function S(a){a.textContent=a.dataset.labelLoading;let b=new c(a.dataset.url);b.withCallback(()=>window.location.reload()),b.execute()}function w(d){let a=document.querySelector(".entry h1 a");if(a!==null){d?window.location.href=a.getAttribute("href"):b.openNewTab(a.getAttribute("href"));return}let c=document.querySelector(".current-item a[data-original-link]");if(c!==null){b.openNewTab(c.getAttribute("href"));let a=document.querySelector(".current-item");document.location.href!=document.querySelector('a[data-page=starred]').href&&l(),h(a)}}function U(){let a=document.querySelector(".current-item a[data-original-link], .entry h1 a");if(a!==null){b.openNewTab(a.getAttribute("href"),!0);let c=document.querySelector(".current-item");c!==null&&x()&&h(c)}}function V(c){if(!x())return;let a=b.findParent(c,"item");a!==null&&h(a)}function x(){return document.querySelector("body[data-mark-read-on-original-link=true]")!==null}function A(a){if(g()){let a=document.querySelector(".current-item a[data-comments-link]");a!==null&&b.openNewTab(a.getAttribute("href"))}else{let c=document.querySelector("a[data-comments-link]");if(c!==null){a?window.location.href=c.getAttribute("href"):b.openNewTab(c.getAttribute("href"));return}}}function Y(){let a=document.querySelector(".current-item .item-title a");a!==null&&(a.dataset.openExternalLink?(b.openNewTab(a.getAttribute("href")),h(document.querySelector(".current-item"))):window.location.href=a.getAttribute("href"))}function Z(){let a=document.querySelectorAll("[data-action=remove-feed]");if(a.length===1){let b=a[0],d=new c(b.dataset.url);d.withCallback(()=>{b.dataset.redirectUrl?window.location.href=b.dataset.redirectUrl:window.location.reload()}),d.execute()}}function d(b,c){let a=document.querySelector("a[data-page="+b+"]");a?document.location.href=a.href:c&&window.location.reload()}function n(){g()?ac():d("previous")}function m(){g()?l():d("next")}function ab(){if(ah()){let a=document.querySelector("span.entry-website a");a!==null&&(window.location.href=a.href)}else d('feeds')}function ac(){let a=b.getVisibleElements(".items .item");if(a.length===0)return;if(document.querySelector(".current-item")===null){a[0].classList.add("current-item"),a[0].querySelector('.item-header a').focus();return}for(let c=0;c<a.length;c++)if(a[c].classList.contains("current-item")){a[c].classList.remove("current-item");let d;c-1>=0?d=a[c-1]:d=a[a.length-1],d.classList.add("current-item"),b.scrollPageTo(d),d.querySelector('.item-header a').focus();break}}function l(){let a=b.getVisibleElements(".items .item");if(a.length===0)return;if(document.querySelector(".current-item")===null){a[0].classList.add("current-item"),a[0].querySelector('.item-header a').focus();return}for(let c=0;c<a.length;c++)if(a[c].classList.contains("current-item")){a[c].classList.remove("current-item");let d;c+1<a.length?d=a[c+1]:d=a[0],d.classList.add("current-item"),b.scrollPageTo(d),d.querySelector('.item-header a').focus();break}}function ae(a){j(b=>b-a)}function af(a){j(b=>b+a)}function j(a){let b=document.querySelectorAll("span.unread-counter");if(b.forEach(b=>{let c=parseInt(b.textContent,10);b.innerHTML=a(c)}),window.location.href.endsWith('/unread')){let b=parseInt(document.title.split('(')[1],10),c=a(b);document.title=document.title.replace(/(.*?)\(\d+\)(.*?)/,function(d,a,b,e,f){return a+'('+c+')'+b})}}function ah(){return document.querySelector("section.entry")!==null}function g(){return document.querySelector(".items")!==null}function p(a){return g()?a?b.findParent(a,"item"):document.querySelector(".current-item"):document.querySelector(".entry")}function v(a,f){a.tagName!='A'&&(a=a.parentNode),a.style.display="none";let e=a.parentNode,b=document.createElement("span"),c=document.createElement("a");c.href="#",c.appendChild(document.createTextNode(a.dataset.labelYes)),c.onclick=d=>{d.preventDefault();let c=document.createElement("span");c.className="loading",c.appendChild(document.createTextNode(a.dataset.labelLoading)),b.remove(),e.appendChild(c),f(a.dataset.url,a.dataset.redirectUrl)};let d=document.createElement("a");d.href="#",d.appendChild(document.createTextNode(a.dataset.labelNo)),d.onclick=c=>{c.preventDefault(),a.style.display="inline",b.remove()},b.className="confirm",b.appendChild(document.createTextNode(a.dataset.labelQuestion+" ")),b.appendChild(c),b.appendChild(document.createTextNode(", ")),b.appendChild(d),e.appendChild(b)}function al(){document.querySelectorAll(".entry-content img[loading=lazy]").forEach(a=>{a.loading="eager"}),window.print()}function f(a){if(!a)return;y(a),document.querySelector('.toast-wrap .toast-msg').innerHTML=a;let b=document.querySelector('.toast-wrap');b.classList.remove('toastAnimate'),setTimeout(function(){b.classList.add('toastAnimate')},100)}function y(a){let b=document.getElementById("status-announcer");if(!a||!b)return;b.innerHTML=a}document.addEventListener("DOMContentLoaded",function(){if($(),!document.querySelector("body[data-disable-keyboard-shortcuts=true]")){let a=new am;a.on("g u",()=>d("unread")),a.on("g b",()=>d("starred")),a.on("g h",()=>d("history")),a.on("g f",()=>ab()),a.on("g c",()=>d("categories")),a.on("g s",()=>d("settings")),a.on("ArrowLeft",()=>n()),a.on("ArrowRight",()=>m()),a.on("k",()=>n()),a.on("p",()=>n()),a.on("j",()=>m()),a.on("n",()=>m()),a.on("h",()=>d("previous")),a.on("l",()=>d("next")),a.on("o",()=>Y()),a.on("v",()=>w()),a.on("V",()=>w(!0)),a.on("b",()=>U()),a.on("c",()=>A()),a.on("C",()=>A(!0)),a.on("m",()=>z()),a.on("A",()=>q()),a.on("s",()=>r()),a.on("d",()=>E()),a.on("f",()=>F()),a.on("R",()=>W()),a.on("?",()=>_()),a.on("#",()=>Z()),a.on("/",a=>G(a)),a.on("Escape",()=>e.close()),a.listen()}let j=new an;j.listen(),u.listen();let f=document.querySelector("section.entry[data-reading-position-url]");if(f){let a=new ai(f);a.listen()}let g=document.getElementById("playback-queue");if(g){let a=new ag(g);a.listen()}let i=document.body.dataset.liveUpdatesUrl;if(i&&"WebSocket"in window){let a=new ak(i);a.listen()}if(a("a[data-save-entry]",a=>r(a.target)),a("a[data-toggle-bookmark]",a=>F(a.target)),a("a[data-action=saveSelectedEntries]",a=>Q(a.target)),a("a[data-action=clearSelectedEntries]",()=>D()),document.querySelectorAll("input[data-select-entry]").forEach(a=>{a.addEventListener("change",()=>B())}),document.querySelectorAll("input[data-select-feed]").forEach(a=>{a.addEventListener("change",()=>O())}),document.querySelectorAll("article[data-feed-id]").forEach(a=>{a.addEventListener("dragstart",a=>K(a))}),document.querySelectorAll("a[data-move-category-id]").forEach(a=>{a.addEventListener("dragover",b=>{b.preventDefault(),a.classList.add("feed-move-target-active")}),a.addEventListener("dragleave",()=>a.classList.remove("feed-move-target-active")),a.addEventListener("drop",a=>I(a))}),a("a[data-move-category-id]",a=>J(a),!0),a("a[data-fetch-content-entry]",()=>E()),a("a[data-save-search]",a=>L(a.target)),a("a[data-queue-add-url]",a=>M(a.target)),document.querySelectorAll("input[data-suggestions-url]").forEach(a=>{a.addEventListener("focus",()=>N(a))}),a("a[data-archive-page-entry]",()=>R()),a("a[data-follow-comments]",a=>S(a.target)),a("a[data-action=search]",a=>G(a)),a("a[data-action=print]",()=>al()),a("a[data-action=markPageAsRead]",()=>v(event.target,()=>q())),a("a[data-toggle-status]",a=>z(a.target)),a(".item a[data-original-link]",a=>V(a.target),!0),a(".item a[data-open-external-link]",a=>h(b.findParent(a.target,"item")),!0),document.querySelectorAll("time[data-alternate-format]").forEach(a=>{X(a)}),a("a[data-confirm]",a=>v(a.target,(d,a)=>{let b=new c(d);b.withCallback(()=>{a?window.location.href=a:window.location.reload()}),b.execute()})),document.documentElement.clientWidth<600&&(a(".logo",()=>ad()),a(".header nav li",a=>aa(a))),"serviceWorker"in navigator){let a=document.getElementById("service-worker-script");a&&navigator.serviceWorker.register(a.src)}window.addEventListener('beforeinstallprompt',c=>{c.preventDefault();let a=c;const b=document.getElementById('prompt-home-screen');if(b){b.style.display="block";const c=document.getElementById('btn-add-to-home-screen');c&&c.addEventListener('click',c=>{c.preventDefault(),a.prompt(),a.userChoice.then(()=>{a=null,b.style.display="none"})})}})})}()`,
 	"service-worker": `self.addEventListener("fetch",a=>{a.request.url.includes("/feed/icon/")&&a.respondWith(caches.open("feed_icons").then(b=>b.match(a.request).then(c=>c||fetch(a.request).then(c=>(b.put(a.request,c.clone()),c)))))})`,
 }
 
 var JavascriptsChecksums = map[string]string{
-	"app":            "a612279b20903dfb01dd78f76474e6eafdf483b983ffd68fa6cdcbf5f2607be4",
+	"app":            "477e99c49b6fe7cc87ec654d9bafe38a39cf2acc1f8509d0e4e09aa24f6a0206",
 	"service-worker": "730f10dc6a52e0bd9271da0c3b0103368893f3feb0a092fd585ac5b7abedb4ac",
 }
@@ -1,3 +1,5 @@
+const entryStatusSynchronizer = new EntryStatusSynchronizer();
+
 // OnClick attaches a listener to the elements that match the selector.
 function onClick(selector, callback, noPreventDefault) {
     let elements = document.querySelectorAll(selector);
@@ -92,7 +94,7 @@ function markPageAsRead() {
 
     items.forEach((element) => {
         element.classList.add("item-status-read");
-        entryIDs.push(parseInt(element.dataset.id, 10));
+        entryIDs = entryIDs.concat(getEntryIDs(element));
     });
 
     if (entryIDs.length > 0) {
@@ -128,31 +130,36 @@ function handleEntryStatus(element) {
 
 // Change the entry status to the opposite value.
 function toggleEntryStatus(element, toasting) {
-    let entryID = parseInt(element.dataset.id, 10);
     let link = element.querySelector("a[data-toggle-status]");
 
     let currentStatus = link.dataset.value;
     let newStatus = currentStatus === "read" ? "unread" : "read";
 
-    updateEntriesStatus([entryID], newStatus);
+    updateEntriesStatus(getEntryIDs(element), newStatus);
+    setEntryStatus(element, newStatus);
 
-    if (currentStatus === "read") {
-        link.innerHTML = '<span class="icon-label">' + link.dataset.labelRead + '</span>';
-        link.dataset.value = "unread";
-        if (toasting) {
-            toast(link.dataset.toastUnread);
-        }
+    let message = newStatus === "read" ? link.dataset.toastRead : link.dataset.toastUnread;
+    if (toasting) {
+        toast(message);
     } else {
-        link.innerHTML = '<span class="icon-label">' + link.dataset.labelUnread + '</span>';
-        link.dataset.value = "read";
-        if (toasting) {
-            toast(link.dataset.toastRead);
-        }
+        announce(message);
+    }
+}
+
+// Update the status displayed for the entry without sending any request.
+function setEntryStatus(element, status) {
+    let currentStatus = status === "read" ? "unread" : "read";
+
+    let link = element.querySelector("a[data-toggle-status]");
+    if (link) {
+        let label = status === "read" ? link.dataset.labelUnread : link.dataset.labelRead;
+        link.innerHTML = '<span class="icon-label">' + label + '</span>';
+        link.dataset.value = status;
     }
 
     if (element.classList.contains("item-status-" + currentStatus)) {
         element.classList.remove("item-status-" + currentStatus);
-        element.classList.add("item-status-" + newStatus);
+        element.classList.add("item-status-" + status);
     }
 }
 
@@ -162,12 +169,39 @@ function markEntryAsRead(element) {
         element.classList.remove("item-status-unread");
         element.classList.add("item-status-read");
 
-        let entryID = parseInt(element.dataset.id, 10);
-        updateEntriesStatus([entryID], "read");
+        updateEntriesStatus(getEntryIDs(element), "read");
+    }
+}
+
+// Return the entry ID of the element, followed by the IDs of its duplicates.
+function getEntryIDs(element) {
+    let entryIDs = [parseInt(element.dataset.id, 10)];
+    if (element.dataset.duplicateIds) {
+        element.dataset.duplicateIds.split(",").forEach((id) => entryIDs.push(parseInt(id, 10)));
     }
+
+    return entryIDs;
 }
 
 // Send the Ajax request to refresh all feeds in the background
+// Show the other format of the timestamp on long-press, the title is not displayed on touch screens.
+function handleTimestampLongPress(element) {
+    let timer = null;
+    let cancel = () => clearTimeout(timer);
+
+    element.addEventListener("touchstart", () => {
+        timer = setTimeout(() => {
+            let text = element.textContent;
+            element.textContent = element.title;
+            element.title = text;
+        }, 500);
+    }, {passive: true});
+
+    element.addEventListener("touchend", cancel);
+    element.addEventListener("touchmove", cancel);
+    element.addEventListener("touchcancel", cancel);
+}
+
 function handleRefreshAllFeeds() {
     let url = document.body.dataset.refreshAllFeedsUrl;
     let request = new RequestBuilder(url);
@@ -185,7 +219,22 @@ function updateEntriesStatus(entryIDs, status, callback) {
     let url = document.body.dataset.entriesStatusUrl;
     let request = new RequestBuilder(url);
     request.withBody({entry_ids: entryIDs, status: status});
-    request.withCallback(callback);
+    request.withCallback((response) => {
+        let done = () => {
+            if (callback) {
+                callback(response);
+            }
+        };
+
+        if (!response.ok) {
+            done();
+            return;
+        }
+
+        response.json().then((data) => {
+            entryStatusSynchronizer.publish(entryIDs, status, data.changed_at, data.unread);
+        }).catch(() => {}).then(done);
+    });
     request.execute();
 
     if (status === "read") {
@@ -214,10 +263,19 @@ function saveEntry(element, toasting) {
         return;
     }
 
+    let tags = "";
+    if (document.body.dataset.promptSaveEntryTags === "true") {
+        tags = window.prompt(element.dataset.labelPromptTags, "");
+        if (tags === null) {
+            return;
+        }
+    }
+
     let previousInnerHTML = element.innerHTML;
     element.innerHTML = '<span class="icon-label">' + element.dataset.labelLoading + '</span>';
 
     let request = new RequestBuilder(element.dataset.saveUrl);
+    request.withBody({tags: tags});
     request.withCallback(() => {
         element.innerHTML = previousInnerHTML;
         element.dataset.completed = true;
@@ -228,6 +286,192 @@ function saveEntry(element, toasting) {
     request.execute();
 }
 
+// Show the bulk actions bar while entries are selected in the list view.
+function handleEntrySelection() {
+    let bar = document.getElementById("bulk-actions");
+    if (!bar) {
+        return;
+    }
+
+    let count = getSelectedEntryIDs().length;
+    let counter = bar.querySelector(".bulk-actions-count");
+    counter.textContent = counter.dataset.labelCount.replace("%d", count);
+    bar.hidden = count === 0;
+}
+
+function getSelectedEntryIDs() {
+    let entryIDs = [];
+    document.querySelectorAll("input[data-select-entry]:checked").forEach((element) => {
+        entryIDs.push(parseInt(element.value, 10));
+    });
+
+    return entryIDs;
+}
+
+function clearSelectedEntries() {
+    document.querySelectorAll("input[data-select-entry]:checked").forEach((element) => {
+        element.checked = false;
+    });
+
+    handleEntrySelection();
+}
+
+// Send all the selected entries to the third-party services in one request.
+function saveSelectedEntries(element) {
+    let entryIDs = getSelectedEntryIDs();
+    if (entryIDs.length === 0) {
+        return;
+    }
+
+    let tags = "";
+    if (document.body.dataset.promptSaveEntryTags === "true") {
+        tags = window.prompt(element.dataset.labelPromptTags, "");
+        if (tags === null) {
+            return;
+        }
+    }
+
+    let previousInnerHTML = element.innerHTML;
+    element.innerHTML = element.dataset.labelLoading;
+
+    let request = new RequestBuilder(element.dataset.saveUrl);
+    request.withBody({entry_ids: entryIDs, tags: tags});
+    request.withCallback(() => {
+        element.innerHTML = previousInnerHTML;
+        clearSelectedEntries();
+        toast(element.dataset.toastDone);
+    });
+    request.execute();
+}
+
+// Update the hint of the category bar while feeds are selected in the feed list.
+function handleFeedSelection() {
+    let container = document.getElementById("feed-move-targets");
+    if (!container) {
+        return;
+    }
+
+    let count = getSelectedFeedIDs().length;
+    let hint = container.querySelector(".feed-move-hint");
+    hint.textContent = count === 0 ? hint.dataset.labelHint : hint.dataset.labelCount.replace("%d", count);
+}
+
+function getSelectedFeedIDs() {
+    let feedIDs = [];
+    document.querySelectorAll("input[data-select-feed]:checked").forEach((element) => {
+        feedIDs.push(parseInt(element.value, 10));
+    });
+
+    return feedIDs;
+}
+
+// Drag the feed, or all the selected feeds when the dragged one is part of the selection.
+function handleFeedDragStart(event) {
+    let feedID = parseInt(event.currentTarget.dataset.feedId, 10);
+    let feedIDs = getSelectedFeedIDs();
+    if (!feedIDs.includes(feedID)) {
+        feedIDs = [feedID];
+    }
+
+    event.dataTransfer.effectAllowed = "move";
+    event.dataTransfer.setData("text/plain", feedIDs.join(","));
+}
+
+function handleFeedDrop(event) {
+    event.preventDefault();
+    event.currentTarget.classList.remove("feed-move-target-active");
+
+    let feedIDs = event.dataTransfer.getData("text/plain").split(",")
+        .map((value) => parseInt(value, 10))
+        .filter((feedID) => feedID > 0);
+
+    moveFeeds(feedIDs, event.currentTarget);
+}
+
+// Clicking a category moves the selected feeds, without selection the link opens the category.
+function handleFeedMoveClick(event) {
+    let feedIDs = getSelectedFeedIDs();
+    if (feedIDs.length > 0) {
+        event.preventDefault();
+        moveFeeds(feedIDs, event.target);
+    }
+}
+
+// Send the Ajax request and reload the page to show the new categories.
+function moveFeeds(feedIDs, element) {
+    let container = document.getElementById("feed-move-targets");
+    if (!container || feedIDs.length === 0) {
+        return;
+    }
+
+    element.textContent = container.dataset.labelLoading;
+
+    let request = new RequestBuilder(container.dataset.moveUrl);
+    request.withBody({feed_ids: feedIDs, category_id: parseInt(element.dataset.moveCategoryId, 10)});
+    request.withCallback(() => window.location.reload());
+    request.execute();
+}
+
+// Send the Ajax request to save the current search.
+function saveSearch(element) {
+    if (element.dataset.completed) {
+        return;
+    }
+
+    element.textContent = element.dataset.labelLoading;
+
+    let request = new RequestBuilder(element.dataset.saveUrl);
+    request.withBody({
+        query: element.dataset.query,
+        feed_id: parseInt(element.dataset.feedId || "0", 10),
+        category_id: parseInt(element.dataset.categoryId || "0", 10)
+    });
+    request.withCallback(() => {
+        element.textContent = element.dataset.labelDone;
+        element.dataset.completed = true;
+    });
+    request.execute();
+}
+
+// Send the Ajax request to append an audio entry to the playback queue.
+function addToPlaybackQueue(element) {
+    if (element.dataset.completed) {
+        return;
+    }
+
+    element.textContent = element.dataset.labelLoading;
+
+    let request = new RequestBuilder(element.dataset.queueAddUrl);
+    request.withCallback(() => {
+        element.textContent = element.dataset.labelDone;
+        element.dataset.completed = true;
+    });
+    request.execute();
+}
+
+// Fill the suggestions of the search boxes with the saved and recent searches, only once per page.
+function loadSearchSuggestions(input) {
+    let datalist = document.getElementById(input.getAttribute("list"));
+    if (!datalist || datalist.dataset.loaded) {
+        return;
+    }
+
+    datalist.dataset.loaded = true;
+
+    let request = new RequestBuilder(input.dataset.suggestionsUrl);
+    request.withHttpMethod("GET");
+    request.withCallback((response) => {
+        response.json().then((suggestions) => {
+            suggestions.forEach((suggestion) => {
+                let option = document.createElement("option");
+                option.value = suggestion;
+                datalist.appendChild(option);
+            });
+        });
+    });
+    request.execute();
+}
+
 // Handle bookmark from the list view and entry view.
 function handleBookmark(element) {
     let toasting = !element;
@@ -292,6 +536,43 @@ function handleFetchOriginalContent() {
     request.execute();
 }
 
+// Ask the server to keep a copy of the web page, the link then opens the copy.
+function handleArchiveWebPage() {
+    let element = document.querySelector("a[data-archive-page-entry]");
+    if (!element || element.dataset.completed) {
+        return;
+    }
+
+    let previousInnerHTML = element.innerHTML;
+    element.innerHTML = '<span class="icon-label">' + element.dataset.labelLoading + '</span>';
+
+    let request = new RequestBuilder(element.dataset.archivePageUrl);
+    request.withCallback((response) => {
+        element.innerHTML = previousInnerHTML;
+
+        response.json().then((data) => {
+            if (data.hasOwnProperty("url")) {
+                element.dataset.completed = true;
+                element.onclick = null;
+                element.href = data.url;
+                element.target = "_blank";
+                element.querySelector(".icon-label").textContent = element.dataset.labelDone;
+                toast(element.dataset.toastDone);
+            }
+        });
+    });
+    request.execute();
+}
+
+// Follow or stop following the comments of the entry, the page is reloaded to show them.
+function handleFollowComments(element) {
+    element.textContent = element.dataset.labelLoading;
+
+    let request = new RequestBuilder(element.dataset.url);
+    request.withCallback(() => window.location.reload());
+    request.execute();
+}
+
 function openOriginalLink(openLinkInCurrentTab) {
     let entryLink = document.querySelector(".entry h1 a");
     if (entryLink !== null) {
@@ -316,6 +597,35 @@ function openOriginalLink(openLinkInCurrentTab) {
     }
 }
 
+// Open the original link in a background tab without moving the list position.
+function openOriginalLinkInBackground() {
+    let currentItemOriginalLink = document.querySelector(".current-item a[data-original-link], .entry h1 a");
+    if (currentItemOriginalLink !== null) {
+        DomHelper.openNewTab(currentItemOriginalLink.getAttribute("href"), true);
+
+        let currentItem = document.querySelector(".current-item");
+        if (currentItem !== null && isMarkReadOnOriginalLinkEnabled()) {
+            markEntryAsRead(currentItem);
+        }
+    }
+}
+
+// Mark the entry as read when the user clicks on the original link, if enabled in the settings.
+function handleOriginalLinkClick(element) {
+    if (!isMarkReadOnOriginalLinkEnabled()) {
+        return;
+    }
+
+    let currentItem = DomHelper.findParent(element, "item");
+    if (currentItem !== null) {
+        markEntryAsRead(currentItem);
+    }
+}
+
+function isMarkReadOnOriginalLinkEnabled() {
+    return document.querySelector("body[data-mark-read-on-original-link=true]") !== null;
+}
+
 function openCommentLink(openLinkInCurrentTab) {
     if (!isListView()) {
         let entryLink = document.querySelector("a[data-comments-link]");
@@ -338,7 +648,12 @@ function openCommentLink(openLinkInCurrentTab) {
 function openSelectedItem() {
     let currentItemLink = document.querySelector(".current-item .item-title a");
     if (currentItemLink !== null) {
-        window.location.href = currentItemLink.getAttribute("href");
+        if (currentItemLink.dataset.openExternalLink) {
+            DomHelper.openNewTab(currentItemLink.getAttribute("href"));
+            markEntryAsRead(document.querySelector(".current-item"));
+        } else {
+            window.location.href = currentItemLink.getAttribute("href");
+        }
     }
 }
 
@@ -560,8 +875,19 @@ function handleConfirmationMessage(linkElement, callback) {
     containerElement.appendChild(questionElement);
 }
 
+// Open the browser print dialog, which also allows saving the entry as PDF.
+function printEntry() {
+    // Lazy images outside of the viewport would be missing from the printed page.
+    document.querySelectorAll(".entry-content img[loading=lazy]").forEach((element) => {
+        element.loading = "eager";
+    });
+
+    window.print();
+}
+
 function toast(msg) {
     if (!msg) return;
+    announce(msg);
     document.querySelector('.toast-wrap .toast-msg').innerHTML = msg;
     let toastWrapper = document.querySelector('.toast-wrap');
     toastWrapper.classList.remove('toastAnimate');
@@ -569,3 +895,11 @@ function toast(msg) {
         toastWrapper.classList.add('toastAnimate');
     }, 100);
 }
+
+// Read the message to screen reader users through the ARIA live region.
+function announce(msg) {
+    let announcer = document.getElementById("status-announcer");
+    if (!msg || !announcer) return;
+
+    announcer.innerHTML = msg;
+}
@@ -20,6 +20,7 @@ document.addEventListener("DOMContentLoaded", function () {
         keyboardHandler.on("o", () => openSelectedItem());
         keyboardHandler.on("v", () => openOriginalLink());
         keyboardHandler.on("V", () => openOriginalLink(true));
+        keyboardHandler.on("b", () => openOriginalLinkInBackground());
         keyboardHandler.on("c", () => openCommentLink());
         keyboardHandler.on("C", () => openCommentLink(true));
         keyboardHandler.on("m", () => handleEntryStatus());
@@ -38,12 +39,67 @@ document.addEventListener("DOMContentLoaded", function () {
     let touchHandler = new TouchHandler();
     touchHandler.listen();
 
+    entryStatusSynchronizer.listen();
+
+    let entryElement = document.querySelector("section.entry[data-reading-position-url]");
+    if (entryElement) {
+        let readingPositionHandler = new ReadingPositionHandler(entryElement);
+        readingPositionHandler.listen();
+    }
+
+    let playbackQueueElement = document.getElementById("playback-queue");
+    if (playbackQueueElement) {
+        let playbackQueueHandler = new PlaybackQueueHandler(playbackQueueElement);
+        playbackQueueHandler.listen();
+    }
+
+    let liveUpdatesURL = document.body.dataset.liveUpdatesUrl;
+    if (liveUpdatesURL && "WebSocket" in window) {
+        let liveUpdateHandler = new LiveUpdateHandler(liveUpdatesURL);
+        liveUpdateHandler.listen();
+    }
+
     onClick("a[data-save-entry]", (event) => handleSaveEntry(event.target));
     onClick("a[data-toggle-bookmark]", (event) => handleBookmark(event.target));
+    onClick("a[data-action=saveSelectedEntries]", (event) => saveSelectedEntries(event.target));
+    onClick("a[data-action=clearSelectedEntries]", () => clearSelectedEntries());
+
+    document.querySelectorAll("input[data-select-entry]").forEach((element) => {
+        element.addEventListener("change", () => handleEntrySelection());
+    });
+    document.querySelectorAll("input[data-select-feed]").forEach((element) => {
+        element.addEventListener("change", () => handleFeedSelection());
+    });
+    document.querySelectorAll("article[data-feed-id]").forEach((element) => {
+        element.addEventListener("dragstart", (event) => handleFeedDragStart(event));
+    });
+    document.querySelectorAll("a[data-move-category-id]").forEach((element) => {
+        element.addEventListener("dragover", (event) => {
+            event.preventDefault();
+            element.classList.add("feed-move-target-active");
+        });
+        element.addEventListener("dragleave", () => element.classList.remove("feed-move-target-active"));
+        element.addEventListener("drop", (event) => handleFeedDrop(event));
+    });
+    onClick("a[data-move-category-id]", (event) => handleFeedMoveClick(event), true);
     onClick("a[data-fetch-content-entry]", () => handleFetchOriginalContent());
+    onClick("a[data-save-search]", (event) => saveSearch(event.target));
+    onClick("a[data-queue-add-url]", (event) => addToPlaybackQueue(event.target));
+    document.querySelectorAll("input[data-suggestions-url]").forEach((element) => {
+        element.addEventListener("focus", () => loadSearchSuggestions(element));
+    });
+    onClick("a[data-archive-page-entry]", () => handleArchiveWebPage());
+    onClick("a[data-follow-comments]", (event) => handleFollowComments(event.target));
     onClick("a[data-action=search]", (event) => setFocusToSearchInput(event));
+    onClick("a[data-action=print]", () => printEntry());
     onClick("a[data-action=markPageAsRead]", () => handleConfirmationMessage(event.target, () => markPageAsRead()));
     onClick("a[data-toggle-status]", (event) => handleEntryStatus(event.target));
+    onClick(".item a[data-original-link]", (event) => handleOriginalLinkClick(event.target), true);
+    onClick(".item a[data-open-external-link]", (event) => markEntryAsRead(DomHelper.findParent(event.target, "item")), true);
+
+    document.querySelectorAll("time[data-alternate-format]").forEach((element) => {
+        handleTimestampLongPress(element);
+    });
 
     onClick("a[data-confirm]", (event) => handleConfirmationMessage(event.target, (url, redirectURL) => {
         let request = new RequestBuilder(url);
@@ -3,11 +3,16 @@ class DomHelper {
         return element.offsetParent !== null;
     }
 
-    static openNewTab(url) {
+    static openNewTab(url, background) {
         let win = window.open("");
         win.opener = null;
         win.location = url;
-        win.focus();
+
+        if (background) {
+            window.focus();
+        } else {
+            win.focus();
+        }
     }
 
     static scrollPageTo(element) {
@@ -0,0 +1,60 @@
+// Share the entry status changes with the other tabs of the browser.
+class EntryStatusSynchronizer {
+    constructor() {
+        this.storageKey = "miniflux-entries-status";
+        this.channel = null;
+        this.lastChanges = {};
+        this.lastCounterChange = 0;
+    }
+
+    listen() {
+        if ("BroadcastChannel" in window) {
+            this.channel = new BroadcastChannel(this.storageKey);
+            this.channel.onmessage = (event) => this.onMessage(event.data);
+        } else {
+            // Older browsers only notify the other tabs when the local storage is modified.
+            window.addEventListener("storage", (event) => {
+                if (event.key === this.storageKey && event.newValue) {
+                    this.onMessage(JSON.parse(event.newValue));
+                }
+            });
+        }
+    }
+
+    publish(entryIDs, status, changedAt, unread) {
+        let message = {entry_ids: entryIDs, status: status, changed_at: changedAt, unread: unread};
+        this.record(message);
+
+        if (this.channel) {
+            this.channel.postMessage(message);
+        } else if (window.localStorage) {
+            window.localStorage.setItem(this.storageKey, JSON.stringify(message));
+        }
+    }
+
+    // Keep the most recent server time of each entry, the returned list contains the entries changed by this message.
+    record(message) {
+        let changedEntryIDs = message.entry_ids.filter((entryID) => {
+            return (this.lastChanges[entryID] || 0) < message.changed_at;
+        });
+
+        changedEntryIDs.forEach((entryID) => {
+            this.lastChanges[entryID] = message.changed_at;
+        });
+
+        if (message.changed_at > this.lastCounterChange) {
+            this.lastCounterChange = message.changed_at;
+            updateUnreadCounterValue(() => message.unread);
+        }
+
+        return changedEntryIDs;
+    }
+
+    onMessage(message) {
+        this.record(message).forEach((entryID) => {
+            document.querySelectorAll(".item[data-id='" + entryID + "'], .entry[data-id='" + entryID + "']").forEach((element) => {
+                setEntryStatus(element, message.status);
+            });
+        });
+    }
+}
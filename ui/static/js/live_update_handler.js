@@ -0,0 +1,78 @@
+class LiveUpdateHandler {
+    constructor(url) {
+        this.url = new URL(url, window.location.href);
+        this.url.protocol = this.url.protocol === "https:" ? "wss:" : "ws:";
+        this.retryDelay = 1000;
+        this.stale = false;
+    }
+
+    connect() {
+        let socket = new WebSocket(this.url.href);
+
+        socket.onopen = () => {
+            this.retryDelay = 1000;
+        };
+
+        socket.onmessage = (event) => {
+            this.onEvent(JSON.parse(event.data));
+        };
+
+        socket.onclose = () => {
+            setTimeout(() => this.connect(), this.retryDelay);
+            this.retryDelay = Math.min(this.retryDelay * 2, 60000);
+        };
+    }
+
+    listen() {
+        this.connect();
+
+        document.addEventListener("visibilitychange", () => {
+            if (!document.hidden && this.stale) {
+                window.location.reload();
+            }
+        });
+    }
+
+    onEvent(event) {
+        switch (event.type) {
+            case "counters":
+                updateUnreadCounterValue(() => event.data.unread);
+                this.toggleCounter(".unread-counter-wrapper", event.data.unread);
+                this.updateCounter(".error-feeds-counter", event.data.error_feeds);
+                this.toggleCounter(".error-feeds-counter-wrapper", event.data.error_feeds);
+                break;
+            case "feed_refreshed": {
+                let path = window.location.pathname;
+                if (path.endsWith("/feeds") || path.includes("/feed/" + event.data + "/")) {
+                    this.reloadWhenVisible();
+                }
+                break;
+            }
+            case "entry_shared":
+            case "entry_unshared":
+                if (window.location.pathname.endsWith("/shares")) {
+                    this.reloadWhenVisible();
+                }
+                break;
+        }
+    }
+
+    updateCounter(selector, value) {
+        document.querySelectorAll(selector).forEach((element) => {
+            element.textContent = value;
+        });
+    }
+
+    toggleCounter(selector, value) {
+        document.querySelectorAll(selector).forEach((element) => {
+            element.hidden = value === 0;
+        });
+    }
+
+    // The page visible to the user is not modified, other tabs are reloaded when they are displayed again.
+    reloadWhenVisible() {
+        if (document.hidden) {
+            this.stale = true;
+        }
+    }
+}
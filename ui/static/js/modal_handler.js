@@ -8,17 +8,30 @@ class ModalHandler {
             return;
         }
 
+        ModalHandler.previousFocus = document.activeElement;
+
         let container = document.createElement("div");
         container.id = "modal-container";
+        container.setAttribute("role", "dialog");
+        container.setAttribute("aria-modal", "true");
         container.appendChild(document.importNode(fragment, true));
         document.body.appendChild(container);
 
+        let labelledBy = container.querySelector("[aria-labelledby]");
+        if (labelledBy !== null) {
+            container.setAttribute("aria-labelledby", labelledBy.getAttribute("aria-labelledby"));
+        }
+
+        container.addEventListener("keydown", (event) => ModalHandler.trapFocus(container, event));
+
         let closeButton = document.querySelector("a.btn-close-modal");
         if (closeButton !== null) {
             closeButton.onclick = (event) => {
                 event.preventDefault();
                 ModalHandler.close();
             };
+
+            closeButton.focus();
         }
     }
 
@@ -27,5 +40,33 @@ class ModalHandler {
         if (container !== null) {
             container.parentNode.removeChild(container);
         }
+
+        if (ModalHandler.previousFocus) {
+            ModalHandler.previousFocus.focus();
+            ModalHandler.previousFocus = null;
+        }
+    }
+
+    // Keep the keyboard focus inside the modal while it is open.
+    static trapFocus(container, event) {
+        if (event.key !== "Tab") {
+            return;
+        }
+
+        let elements = container.querySelectorAll('a[href], button, input, select, textarea, [tabindex]:not([tabindex="-1"])');
+        if (elements.length === 0) {
+            return;
+        }
+
+        let first = elements[0];
+        let last = elements[elements.length - 1];
+
+        if (event.shiftKey && document.activeElement === first) {
+            event.preventDefault();
+            last.focus();
+        } else if (!event.shiftKey && document.activeElement === last) {
+            event.preventDefault();
+            first.focus();
+        }
     }
 }
@@ -0,0 +1,111 @@
+// Play the queued audio entries one after the other and keep the queue in sync with the server.
+class PlaybackQueueHandler {
+    constructor(container) {
+        this.container = container;
+        this.player = container.querySelector("audio");
+        this.nowPlaying = container.querySelector(".playback-queue-now-playing");
+        this.list = container.querySelector(".playback-queue-items");
+        this.current = null;
+    }
+
+    items() {
+        return Array.from(this.list.querySelectorAll("li[data-entry-id]"));
+    }
+
+    play(item) {
+        if (!item) {
+            return;
+        }
+
+        this.items().forEach((element) => element.classList.remove("current-item"));
+        item.classList.add("current-item");
+
+        this.current = item;
+        this.player.src = item.dataset.audioUrl;
+        this.nowPlaying.textContent = item.dataset.title;
+        this.player.play();
+    }
+
+    // The finished entry is marked as read and leaves the queue, then the next one starts.
+    next() {
+        let finished = this.current;
+        if (!finished) {
+            return;
+        }
+
+        let items = this.items();
+        let nextItem = items[items.indexOf(finished) + 1];
+
+        updateEntriesStatus([parseInt(finished.dataset.entryId, 10)], "read");
+        this.remove(finished);
+
+        if (nextItem) {
+            this.play(nextItem);
+        } else {
+            this.current = null;
+            this.nowPlaying.textContent = "";
+        }
+    }
+
+    remove(item) {
+        if (item === this.current) {
+            this.player.pause();
+            this.current = null;
+            this.nowPlaying.textContent = "";
+        }
+
+        let request = new RequestBuilder(item.dataset.removeUrl);
+        request.execute();
+        item.remove();
+    }
+
+    move(item, offset) {
+        let items = this.items();
+        let index = items.indexOf(item) + offset;
+        if (index < 0 || index >= items.length) {
+            return;
+        }
+
+        if (offset < 0) {
+            this.list.insertBefore(item, items[index]);
+        } else {
+            this.list.insertBefore(items[index], item);
+        }
+
+        let request = new RequestBuilder(this.container.dataset.reorderUrl);
+        request.withBody({entry_ids: this.items().map((element) => parseInt(element.dataset.entryId, 10))});
+        request.execute();
+    }
+
+    listen() {
+        this.player.addEventListener("ended", () => this.next());
+
+        this.container.addEventListener("click", (event) => {
+            let link = event.target.closest("a[data-queue-action]");
+            if (!link) {
+                return;
+            }
+
+            event.preventDefault();
+
+            let item = link.closest("li[data-entry-id]");
+            switch (link.dataset.queueAction) {
+                case "play-all":
+                    this.play(this.items()[0]);
+                    break;
+                case "play":
+                    this.play(item);
+                    break;
+                case "up":
+                    this.move(item, -1);
+                    break;
+                case "down":
+                    this.move(item, 1);
+                    break;
+                case "remove":
+                    this.remove(item);
+                    break;
+            }
+        });
+    }
+}
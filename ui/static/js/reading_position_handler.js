@@ -0,0 +1,53 @@
+// Save how far the entry has been read and scroll back to this position when the entry is opened again.
+class ReadingPositionHandler {
+    constructor(entry) {
+        this.url = entry.dataset.readingPositionUrl;
+        this.content = entry.querySelector(".entry-content");
+        this.savedPosition = parseFloat(entry.dataset.readingPosition) || 0;
+        this.timer = null;
+    }
+
+    // Fraction of the content above the top of the window.
+    currentPosition() {
+        let rect = this.content.getBoundingClientRect();
+        if (rect.height === 0) {
+            return 0;
+        }
+
+        return Math.min(1, Math.max(0, -rect.top / rect.height));
+    }
+
+    restore() {
+        if (this.savedPosition > 0 && window.location.hash === "") {
+            let rect = this.content.getBoundingClientRect();
+            window.scrollTo(0, window.pageYOffset + rect.top + this.savedPosition * rect.height);
+        }
+    }
+
+    save() {
+        let position = Math.round(this.currentPosition() * 1000) / 1000;
+        if (Math.abs(position - this.savedPosition) < 0.01) {
+            return;
+        }
+
+        this.savedPosition = position;
+
+        let request = new RequestBuilder(this.url);
+        request.withBody({position: position});
+        request.execute();
+    }
+
+    listen() {
+        if (!this.content) {
+            return;
+        }
+
+        // Images change the height of the content, the page has to be fully loaded.
+        window.addEventListener("load", () => this.restore());
+
+        window.addEventListener("scroll", () => {
+            clearTimeout(this.timer);
+            this.timer = setTimeout(() => this.save(), 2000);
+        }, {passive: true});
+    }
+}
@@ -7,6 +7,7 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
 	"miniflux.app/http/route"
@@ -25,6 +26,7 @@ func (h *handler) showWebManifest(w http.ResponseWriter, r *http.Request) {
 		Description     string            `json:"description"`
 		ShortName       string            `json:"short_name"`
 		StartURL        string            `json:"start_url"`
+		Scope           string            `json:"scope"`
 		Icons           []webManifestIcon `json:"icons"`
 		Display         string            `json:"display"`
 		ThemeColor      string            `json:"theme_color"`
@@ -38,6 +40,7 @@ func (h *handler) showWebManifest(w http.ResponseWriter, r *http.Request) {
 		Description:     "Minimalist Feed Reader",
 		Display:         "minimal-ui",
 		StartURL:        route.Path(h.router, "unread"),
+		Scope:           config.Opts.BasePath() + "/",
 		ThemeColor:      themeColor,
 		BackgroundColor: themeColor,
 		Icons: []webManifestIcon{
@@ -13,6 +13,7 @@ import (
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/model"
 	"miniflux.app/reader/subscription"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
@@ -51,12 +52,25 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if blockedErr := h.feedHandler.CheckBlockedFeed(subscriptionForm.URL); blockedErr != nil {
+		v.Set("form", subscriptionForm)
+		v.Set("errorMessage", blockedErr)
+		html.OK(w, r, v.Render("add_subscription"))
+		return
+	}
+
+	var rssBridgeURL string
+	if integration, err := h.store.Integration(user.ID); err == nil && integration.RSSBridgeEnabled {
+		rssBridgeURL = integration.RSSBridgeURL
+	}
+
 	subscriptions, findErr := subscription.FindSubscriptions(
 		subscriptionForm.URL,
 		subscriptionForm.UserAgent,
 		subscriptionForm.Username,
 		subscriptionForm.Password,
 		subscriptionForm.FetchViaProxy,
+		rssBridgeURL,
 	)
 	if findErr != nil {
 		logger.Error("[UI:SubmitSubscription] %s", findErr)
@@ -75,18 +89,37 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 		v.Set("errorMessage", "error.subscription_not_found")
 		html.OK(w, r, v.Render("add_subscription"))
 	case n == 1:
-		feed, err := h.feedHandler.CreateFeed(
-			user.ID,
-			subscriptionForm.CategoryID,
-			subscriptions[0].URL,
-			subscriptionForm.Crawler,
-			subscriptionForm.UserAgent,
-			subscriptionForm.Username,
-			subscriptionForm.Password,
-			subscriptionForm.ScraperRules,
-			subscriptionForm.RewriteRules,
-			subscriptionForm.FetchViaProxy,
-		)
+		var feed *model.Feed
+		var err error
+		if subscriptions[0].Response != nil {
+			feed, err = h.feedHandler.CreateFeedFromResponse(
+				user.ID,
+				subscriptionForm.CategoryID,
+				subscriptions[0].Response,
+				subscriptionForm.Crawler,
+				subscriptionForm.UserAgent,
+				subscriptionForm.Username,
+				subscriptionForm.Password,
+				subscriptionForm.ScraperRules,
+				subscriptionForm.RewriteRules,
+				subscriptionForm.FetchViaProxy,
+				nil,
+			)
+		} else {
+			feed, err = h.feedHandler.CreateFeed(
+				user.ID,
+				subscriptionForm.CategoryID,
+				subscriptions[0].URL,
+				subscriptionForm.Crawler,
+				subscriptionForm.UserAgent,
+				subscriptionForm.Username,
+				subscriptionForm.Password,
+				subscriptionForm.ScraperRules,
+				subscriptionForm.RewriteRules,
+				subscriptionForm.FetchViaProxy,
+				nil,
+			)
+		}
 		if err != nil {
 			v.Set("form", subscriptionForm)
 			v.Set("errorMessage", err)
@@ -32,16 +32,30 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/icon/{filename}", handler.showAppIcon).Name("appIcon").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/manifest.json", handler.showWebManifest).Name("webManifest").Methods(http.MethodGet)
 
+	// Live updates.
+	uiRouter.HandleFunc("/live", handler.liveUpdates).Name("liveUpdates").Methods(http.MethodGet)
+
 	// New subscription pages.
 	uiRouter.HandleFunc("/subscribe", handler.showAddSubscriptionPage).Name("addSubscription").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/subscribe", handler.submitSubscription).Name("submitSubscription").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/subscriptions", handler.showChooseSubscriptionPage).Name("chooseSubscription").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/recommendations", handler.showFeedRecommendationsPage).Name("feedRecommendations").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/bookmarklet", handler.bookmarklet).Name("bookmarklet").Methods(http.MethodGet)
 
 	// Unread page.
 	uiRouter.HandleFunc("/mark-all-as-read", handler.markAllAsRead).Name("markAllAsRead").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/unread", handler.showUnreadPage).Name("unread").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/unread/entry/{entryID}", handler.showUnreadEntryPage).Name("unreadEntry").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/today", handler.showTodayPage).Name("today").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/digest", handler.showDigestPage).Name("digest").Methods(http.MethodGet)
+
+	// Trending page.
+	uiRouter.HandleFunc("/trending", handler.showTrendingPage).Name("trending").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/podcasts", handler.showPodcastsPage).Name("podcasts").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/queue", handler.showPlaybackQueuePage).Name("playbackQueue").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/queue/reorder", handler.reorderPlaybackQueue).Name("reorderPlaybackQueue").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/queue/{entryID}/add", handler.addToPlaybackQueue).Name("addToPlaybackQueue").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/queue/{entryID}/remove", handler.removeFromPlaybackQueue).Name("removeFromPlaybackQueue").Methods(http.MethodPost)
 
 	// History pages.
 	uiRouter.HandleFunc("/history", handler.showHistoryPage).Name("history").Methods(http.MethodGet)
@@ -55,16 +69,25 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	// Search pages.
 	uiRouter.HandleFunc("/search", handler.showSearchEntriesPage).Name("searchEntries").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/search/entry/{entryID}", handler.showSearchEntryPage).Name("searchEntry").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/search/suggestions", handler.showSearchSuggestions).Name("searchSuggestions").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/search/save", handler.saveSearch).Name("saveSearch").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/search/history/clear", handler.clearSearchHistory).Name("clearSearchHistory").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/searches", handler.showSavedSearchesPage).Name("savedSearches").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/searches/{savedSearchID}/remove", handler.removeSavedSearch).Name("removeSavedSearch").Methods(http.MethodPost)
 
 	// Feed listing pages.
 	uiRouter.HandleFunc("/feeds", handler.showFeedsPage).Name("feeds").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feeds/refresh", handler.refreshAllFeeds).Name("refreshAllFeeds").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/feeds/unused", handler.showUnusedFeedsPage).Name("unusedFeeds").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/feeds/unused", handler.updateUnusedFeeds).Name("updateUnusedFeeds").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/feeds/move", handler.moveFeeds).Name("moveFeeds").Methods(http.MethodPost)
 
 	// Individual feed pages.
 	uiRouter.HandleFunc("/feed/{feedID}/refresh", handler.refreshFeed).Name("refreshFeed").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feed/{feedID}/edit", handler.showEditFeedPage).Name("editFeed").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feed/{feedID}/remove", handler.removeFeed).Name("removeFeed").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/feed/{feedID}/update", handler.updateFeed).Name("updateFeed").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/feed/{feedID}/canonical-url", handler.applyCanonicalFeedURL).Name("applyCanonicalFeedURL").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/feed/{feedID}/entries", handler.showFeedEntriesPage).Name("feedEntries").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feed/{feedID}/entries/all", handler.showFeedEntriesAllPage).Name("feedEntriesAll").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feed/{feedID}/entry/{entryID}", handler.showFeedEntryPage).Name("feedEntry").Methods(http.MethodGet)
@@ -74,6 +97,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	// Category pages.
 	uiRouter.HandleFunc("/category/{categoryID}/entry/{entryID}", handler.showCategoryEntryPage).Name("categoryEntry").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/categories", handler.showCategoryListPage).Name("categories").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/categories/hidden", handler.showHiddenCategoriesPage).Name("hiddenCategories").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/category/create", handler.showCreateCategoryPage).Name("createCategory").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/category/save", handler.saveCategory).Name("saveCategory").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/category/{categoryID}/feeds", handler.showCategoryFeedsPage).Name("categoryFeeds").Methods(http.MethodGet)
@@ -81,23 +105,45 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/category/{categoryID}/entries/all", handler.showCategoryEntriesAllPage).Name("categoryEntriesAll").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/category/{categoryID}/edit", handler.showEditCategoryPage).Name("editCategory").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/category/{categoryID}/update", handler.updateCategory).Name("updateCategory").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/category/{categoryID}/hide", handler.hideCategory).Name("hideCategory").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/category/{categoryID}/unhide", handler.unhideCategory).Name("unhideCategory").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/category/{categoryID}/remove", handler.showRemoveCategoryPage).Name("removeCategoryPage").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/category/{categoryID}/remove", handler.removeCategory).Name("removeCategory").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/category/{categoryID}/members", handler.addCategoryMember).Name("addCategoryMember").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/category/{categoryID}/members/{userID}/remove", handler.removeCategoryMember).Name("removeCategoryMember").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/category/{categoryID}/invitation/accept", handler.acceptCategoryInvitation).Name("acceptCategoryInvitation").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/category/{categoryID}/invitation/decline", handler.declineCategoryInvitation).Name("declineCategoryInvitation").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/category/{categoryID}/leave", handler.leaveSharedCategory).Name("leaveSharedCategory").Methods(http.MethodPost)
 
 	// Entry pages.
 	uiRouter.HandleFunc("/entry/status", handler.updateEntriesStatus).Name("updateEntriesStatus").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/entry/save/{entryID}", handler.saveEntry).Name("saveEntry").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entries/save", handler.saveEntries).Name("saveEntries").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/entry/download/{entryID}", handler.fetchContent).Name("fetchContent").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entry/snapshot/{entryID}", handler.archiveEntryWebPage).Name("archiveEntryWebPage").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entry/snapshot/{entryID}", handler.showEntrySnapshot).Name("entrySnapshot").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/entry/comments/{entryID}/follow", handler.followEntryComments).Name("followEntryComments").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entry/comments/{entryID}/unfollow", handler.unfollowEntryComments).Name("unfollowEntryComments").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/proxy/{encodedURL}", handler.imageProxy).Name("proxy").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/entry/bookmark/{entryID}", handler.toggleBookmark).Name("toggleBookmark").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entry/position/{entryID}", handler.updateReadingPosition).Name("updateReadingPosition").Methods(http.MethodPost)
 
 	// Share pages.
 	uiRouter.HandleFunc("/entry/share/{entryID}", handler.createSharedEntry).Name("shareEntry").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/entry/email/{entryID}", handler.showEntryEmailPage).Name("entryEmail").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/entry/email/{entryID}", handler.sendEntryByEmail).Name("sendEntryByEmail").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/entry/unshare/{entryID}", handler.unshareEntry).Name("unshareEntry").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/share/{shareCode}", handler.sharedEntry).Name("sharedEntry").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/shares", handler.sharedEntries).Name("sharedEntries").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/entry/send/{entryID}", handler.showEntrySendPage).Name("entrySend").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/entry/send/{entryID}", handler.sendEntryToUser).Name("sendEntryToUser").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/received", handler.showReceivedEntries).Name("receivedEntries").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/received/{receivedEntryID}", handler.showReceivedEntry).Name("receivedEntry").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/received/{receivedEntryID}/remove", handler.removeReceivedEntry).Name("removeReceivedEntry").Methods(http.MethodPost)
 
 	// User pages.
 	uiRouter.HandleFunc("/users", handler.showUsersPage).Name("users").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/reports", handler.showReportsPage).Name("reports").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/user/create", handler.showCreateUserPage).Name("createUser").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/user/save", handler.saveUser).Name("saveUser").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/users/{userID}/edit", handler.showEditUserPage).Name("editUser").Methods(http.MethodGet)
@@ -107,6 +153,8 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	// Settings pages.
 	uiRouter.HandleFunc("/settings", handler.showSettingsPage).Name("settings").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/settings", handler.updateSettings).Name("updateSettings").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/settings/notification-email/verification", handler.sendNotificationEmailVerification).Name("sendNotificationEmailVerification").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/settings/notification-email/verify/{token}", handler.verifyNotificationEmail).Name("verifyNotificationEmail").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/integrations", handler.showIntegrationPage).Name("integrations").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/integration", handler.updateIntegration).Name("updateIntegration").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/integration/pocket/authorize", handler.pocketAuthorize).Name("pocketAuthorize").Methods(http.MethodGet)
@@ -123,6 +171,17 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/keys/create", handler.showCreateAPIKeyPage).Name("createAPIKey").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/keys/save", handler.saveAPIKey).Name("saveAPIKey").Methods(http.MethodPost)
 
+	// Muted keywords pages.
+	uiRouter.HandleFunc("/muted-keywords", handler.showMutedKeywordsPage).Name("mutedKeywords").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/muted-keywords/{mutedKeywordID}/remove", handler.removeMutedKeyword).Name("removeMutedKeyword").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/muted-keywords/create", handler.showCreateMutedKeywordPage).Name("createMutedKeyword").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/muted-keywords/save", handler.saveMutedKeyword).Name("saveMutedKeyword").Methods(http.MethodPost)
+
+	// Blocked feeds pages.
+	uiRouter.HandleFunc("/blocked-feeds", handler.showBlockedFeedsPage).Name("blockedFeeds").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/blocked-feeds/save", handler.saveBlockedFeed).Name("saveBlockedFeed").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/blocked-feeds/{blockedFeedID}/remove", handler.removeBlockedFeed).Name("removeBlockedFeed").Methods(http.MethodPost)
+
 	// OPML pages.
 	uiRouter.HandleFunc("/export", handler.exportFeeds).Name("export").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/import", handler.showImportPage).Name("import").Methods(http.MethodGet)
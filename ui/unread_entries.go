@@ -26,9 +26,26 @@ func (h *handler) showUnreadPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	offset := request.QueryIntParam(r, "offset", 0)
-	builder := h.store.NewEntryQueryBuilder(user.ID)
-	builder.WithStatus(model.EntryStatusUnread)
-	countUnread, err := builder.CountEntries()
+	var countUnread, countErrorFeeds int
+	var hasSaveEntry, promptSaveEntryTags bool
+	err = h.store.RunParallel(
+		func() (err error) {
+			builder := h.store.NewEntryQueryBuilder(user.ID)
+			builder.WithStatus(model.EntryStatusUnread)
+			builder.WithGloballyVisible()
+			countUnread, err = builder.CountEntries()
+			return err
+		},
+		func() error {
+			countErrorFeeds = h.store.CountUserFeedsWithErrors(user.ID)
+			return nil
+		},
+		func() error {
+			hasSaveEntry = h.store.HasSaveEntry(user.ID)
+			promptSaveEntryTags = h.store.HasSaveEntryTagsPrompt(user.ID)
+			return nil
+		},
+	)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
@@ -38,8 +55,9 @@ func (h *handler) showUnreadPage(w http.ResponseWriter, r *http.Request) {
 		offset = 0
 	}
 
-	builder = h.store.NewEntryQueryBuilder(user.ID)
+	builder := h.store.NewEntryQueryBuilder(user.ID)
 	builder.WithStatus(model.EntryStatusUnread)
+	builder.WithGloballyVisible()
 	builder.WithOrder(model.DefaultSortingOrder)
 	builder.WithDirection(user.EntryDirection)
 	builder.WithOffset(offset)
@@ -50,13 +68,26 @@ func (h *handler) showUnreadPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Only the first entry of each cluster is listed, the other ones are shown as duplicates.
+	clusters := model.ClusterEntries(entries)
+	entries = make(model.Entries, 0, len(clusters))
+	duplicates := make(map[int64]model.Entries)
+	for _, cluster := range clusters {
+		entries = append(entries, cluster[0])
+		if len(cluster) > 1 {
+			duplicates[cluster[0].ID] = cluster[1:]
+		}
+	}
+
 	view.Set("entries", entries)
+	view.Set("duplicates", duplicates)
 	view.Set("pagination", getPagination(route.Path(h.router, "unread"), countUnread, offset, user.EntriesPerPage))
 	view.Set("menu", "unread")
 	view.Set("user", user)
 	view.Set("countUnread", countUnread)
-	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
-	view.Set("hasSaveEntry", h.store.HasSaveEntry(user.ID))
+	view.Set("countErrorFeeds", countErrorFeeds)
+	view.Set("hasSaveEntry", hasSaveEntry)
+	view.Set("promptSaveEntryTags", promptSaveEntryTags)
 
 	html.OK(w, r, view.Render("unread_entries"))
 }
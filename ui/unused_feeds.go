@@ -0,0 +1,78 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showUnusedFeedsPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	h.renderUnusedFeedsPage(w, r, user, "")
+}
+
+func (h *handler) updateUnusedFeeds(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	unusedFeedsForm := form.NewUnusedFeedsForm(r)
+	if err := unusedFeedsForm.Validate(); err != nil {
+		h.renderUnusedFeedsPage(w, r, user, err.Error())
+		return
+	}
+
+	if unusedFeedsForm.Action == form.UnusedFeedsActionRemove {
+		err = h.store.RemoveFeeds(user.ID, unusedFeedsForm.FeedIDs)
+	} else {
+		err = h.store.KeepFeeds(user.ID, unusedFeedsForm.FeedIDs)
+	}
+
+	if err != nil {
+		logger.Error("[UI:UpdateUnusedFeeds] %v", err)
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "unusedFeeds"))
+}
+
+func (h *handler) renderUnusedFeedsPage(w http.ResponseWriter, r *http.Request, user *model.User, errorMessage string) {
+	feeds, err := h.store.UnusedFeeds(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("feeds", feeds)
+	view.Set("total", len(feeds))
+	view.Set("months", config.Opts.CleanupUnusedFeedsMonths())
+	view.Set("errorMessage", errorMessage)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("unused_feeds"))
+}
@@ -37,6 +37,7 @@ func New(tpl *template.Engine, r *http.Request, sess *session.Session) *View {
 	theme := request.UserTheme(r)
 	b.params["menu"] = ""
 	b.params["csrf"] = request.CSRF(r)
+	b.params["nonce"] = request.Nonce(r)
 	b.params["flashMessage"] = sess.FlashMessage(request.FlashMessage(r))
 	b.params["flashErrorMessage"] = sess.FlashErrorMessage(request.FlashErrorMessage(r))
 	b.params["theme"] = theme
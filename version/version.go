@@ -7,5 +7,20 @@ package version // import "miniflux.app/version"
 // Version of the application (generated with "make build")
 var Version = "2.0.x-dev"
 
+// Commit is the Git commit of the build (generated with "make build")
+var Commit = "undefined"
+
 // BuildDate is generated with "make build"
 var BuildDate = "undefined"
+
+// Info represents the build information of the application.
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+}
+
+// Current returns the build information of the running application.
+func Current() *Info {
+	return &Info{Version: Version, Commit: Commit, BuildDate: BuildDate}
+}
@@ -12,6 +12,7 @@ import (
 // Pool handles a pool of workers.
 type Pool struct {
 	queue chan model.Job
+	tasks chan func()
 }
 
 // Push send a list of jobs to the queue.
@@ -21,15 +22,37 @@ func (p *Pool) Push(jobs model.JobList) {
 	}
 }
 
+// Run sends a background task to the workers, the task runs right away in the
+// calling goroutine when the task queue is full.
+func (p *Pool) Run(task func()) {
+	select {
+	case p.tasks <- task:
+	default:
+		task()
+	}
+}
+
+// TryRun sends a background task to the workers, it returns false without running
+// the task when the task queue is full.
+func (p *Pool) TryRun(task func()) bool {
+	select {
+	case p.tasks <- task:
+		return true
+	default:
+		return false
+	}
+}
+
 // NewPool creates a pool of background workers.
 func NewPool(feedHandler *feed.Handler, nbWorkers int) *Pool {
 	workerPool := &Pool{
 		queue: make(chan model.Job),
+		tasks: make(chan func(), nbWorkers),
 	}
 
 	for i := 0; i < nbWorkers; i++ {
 		worker := &Worker{id: i, feedHandler: feedHandler}
-		go worker.Run(workerPool.queue)
+		go worker.Run(workerPool.queue, workerPool.tasks)
 	}
 
 	return workerPool
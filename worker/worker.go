@@ -20,12 +20,19 @@ type Worker struct {
 	feedHandler *feed.Handler
 }
 
-// Run wait for a job and refresh the given feed.
-func (w *Worker) Run(c chan model.Job) {
+// Run wait for a job and refresh the given feed, or for a background task.
+func (w *Worker) Run(c chan model.Job, tasks chan func()) {
 	logger.Debug("[Worker] #%d started", w.id)
 
 	for {
-		job := <-c
+		var job model.Job
+		select {
+		case job = <-c:
+		case task := <-tasks:
+			task()
+			continue
+		}
+
 		logger.Debug("[Worker #%d] Received feed #%d for user #%d", w.id, job.FeedID, job.UserID)
 
 		startTime := time.Now()